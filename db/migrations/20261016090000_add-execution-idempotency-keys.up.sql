@@ -0,0 +1,23 @@
+begin;
+
+--
+-- Written outside of the transaction the execution runs in,
+-- so there is no foreign key to workflow_node_executions:
+-- the execution row is locked FOR UPDATE while it runs,
+-- and checking the foreign key would wait for that lock.
+--
+CREATE TABLE workflow_node_execution_idempotency_keys (
+  execution_id uuid NOT NULL,
+  key          CHARACTER VARYING(64) NOT NULL,
+  workflow_id  uuid NOT NULL,
+  node_id      CHARACTER VARYING(128) NOT NULL,
+  result       jsonb,
+  created_at   TIMESTAMP NOT NULL,
+  updated_at   TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (execution_id, key)
+);
+
+CREATE INDEX idx_workflow_node_execution_idempotency_keys_node ON workflow_node_execution_idempotency_keys(workflow_id, node_id);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE workflow_node_queue_items ADD COLUMN reschedule_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE workflow_node_queue_items ADD COLUMN run_at TIMESTAMP;
+
+commit;
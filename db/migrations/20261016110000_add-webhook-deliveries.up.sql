@@ -0,0 +1,19 @@
+begin;
+
+--
+-- Webhook deliveries already handled by each node,
+-- so a retried delivery does not run the same node twice.
+--
+CREATE TABLE webhook_deliveries (
+  webhook_id  uuid NOT NULL,
+  delivery_id CHARACTER VARYING(128) NOT NULL,
+  workflow_id uuid NOT NULL,
+  node_id     CHARACTER VARYING(128) NOT NULL,
+  created_at  TIMESTAMP NOT NULL,
+
+  CONSTRAINT uq_webhook_deliveries_node UNIQUE (webhook_id, delivery_id, workflow_id, node_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_created_at ON webhook_deliveries(created_at);
+
+commit;
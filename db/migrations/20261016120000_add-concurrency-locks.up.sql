@@ -0,0 +1,17 @@
+begin;
+
+--
+-- Concurrency keys held by executions.
+-- Rows are written outside of the execution transaction,
+-- so executions in different instances see each other's locks.
+--
+CREATE TABLE concurrency_locks (
+  lock_key   CHARACTER VARYING(255) NOT NULL,
+  holder     CHARACTER VARYING(128) NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (lock_key)
+);
+
+commit;
@@ -207,6 +207,18 @@ CREATE SEQUENCE public.casbin_rule_id_seq
 ALTER SEQUENCE public.casbin_rule_id_seq OWNED BY public.casbin_rule.id;
 
 
+--
+-- Name: concurrency_locks; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.concurrency_locks (
+    lock_key character varying(255) NOT NULL,
+    holder character varying(128) NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: data_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -370,6 +382,19 @@ CREATE TABLE public.users (
 );
 
 
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.webhook_deliveries (
+    webhook_id uuid NOT NULL,
+    delivery_id character varying(128) NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: webhooks; Type: TABLE; Schema: public; Owner: -
 --
@@ -406,6 +431,21 @@ CREATE TABLE public.workflow_events (
 );
 
 
+--
+-- Name: workflow_node_execution_idempotency_keys; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_idempotency_keys (
+    execution_id uuid NOT NULL,
+    key character varying(64) NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    result jsonb,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: workflow_node_execution_kvs; Type: TABLE; Schema: public; Owner: -
 --
@@ -455,7 +495,9 @@ CREATE TABLE public.workflow_node_queue_items (
     node_id character varying(128) NOT NULL,
     root_event_id uuid,
     event_id uuid,
-    created_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone NOT NULL,
+    reschedule_attempts integer DEFAULT 0 NOT NULL,
+    run_at timestamp without time zone
 );
 
 
@@ -648,6 +690,14 @@ ALTER TABLE ONLY public.casbin_rule
     ADD CONSTRAINT casbin_rule_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: concurrency_locks concurrency_locks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.concurrency_locks
+    ADD CONSTRAINT concurrency_locks_pkey PRIMARY KEY (lock_key);
+
+
 --
 -- Name: data_migrations data_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -800,6 +850,14 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: webhook_deliveries uq_webhook_deliveries_node; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.webhook_deliveries
+    ADD CONSTRAINT uq_webhook_deliveries_node UNIQUE (webhook_id, delivery_id, workflow_id, node_id);
+
+
 --
 -- Name: webhooks webhooks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -816,6 +874,14 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_idempotency_keys workflow_node_execution_idempotency_keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_idempotency_keys
+    ADD CONSTRAINT workflow_node_execution_idempotency_keys_pkey PRIMARY KEY (execution_id, key);
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1026,6 +1092,13 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX idx_role_metadata_lookup ON public.role_metadata USING btree (role_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_webhook_deliveries_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_webhook_deliveries_created_at ON public.webhook_deliveries USING btree (created_at);
+
+
 --
 -- Name: idx_webhooks_app_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1061,6 +1134,13 @@ CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (st
 CREATE INDEX idx_workflow_events_workflow_node_id ON public.workflow_events USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_execution_idempotency_keys_node; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_idempotency_keys_node ON public.workflow_node_execution_idempotency_keys USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_execution_kvs_ekv; Type: INDEX; Schema: public; Owner: -
 --
@@ -1515,7 +1595,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260131134819	f
+20261016120000	f
 \.
 
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -35,20 +36,42 @@ type KeyValue struct {
 	Value string `json:"value"`
 }
 
+// SecretKeyRef is stored in YAML as: { secret: "name", key: "keyName" }.
+type SecretKeyRef struct {
+	Secret string `json:"secret" mapstructure:"secret"`
+	Key    string `json:"key" mapstructure:"key"`
+}
+
+func (r SecretKeyRef) IsSet() bool {
+	return r.Secret != "" && r.Key != ""
+}
+
 type Spec struct {
-	Method          string      `json:"method"`
-	URL             string      `json:"url"`
-	QueryParams     *[]KeyValue `json:"queryParams,omitempty"`
-	Headers         *[]Header   `json:"headers,omitempty"`
-	ContentType     *string     `json:"contentType,omitempty"`
-	JSON            *any        `json:"json,omitempty"`
-	XML             *string     `json:"xml,omitempty"`
-	Text            *string     `json:"text,omitempty"`
-	FormData        *[]KeyValue `json:"formData,omitempty"`
-	SuccessCodes    *string     `json:"successCodes,omitempty"`
-	TimeoutStrategy *string     `json:"timeoutStrategy,omitempty"`
-	TimeoutSeconds  *int        `json:"timeoutSeconds,omitempty"`
-	Retries         *int        `json:"retries,omitempty"`
+	Method          string        `json:"method"`
+	URL             string        `json:"url"`
+	QueryParams     *[]KeyValue   `json:"queryParams,omitempty"`
+	Headers         *[]Header     `json:"headers,omitempty"`
+	BearerToken     *SecretKeyRef `json:"bearerToken,omitempty"`
+	ContentType     *string       `json:"contentType,omitempty"`
+	JSON            *any          `json:"json,omitempty"`
+	XML             *string       `json:"xml,omitempty"`
+	Text            *string       `json:"text,omitempty"`
+	FormData        *[]KeyValue   `json:"formData,omitempty"`
+	SuccessCodes    *string       `json:"successCodes,omitempty"`
+	RouteByStatus   bool          `json:"routeByStatus,omitempty"`
+	TimeoutStrategy *string       `json:"timeoutStrategy,omitempty"`
+	TimeoutSeconds  *int          `json:"timeoutSeconds,omitempty"`
+	Retries         *int          `json:"retries,omitempty"`
+}
+
+/*
+ * Output channels used when routing responses by status code.
+ */
+var statusOutputChannels = []core.OutputChannel{
+	{Name: "2xx", Label: "2xx", Description: "Successful responses"},
+	{Name: "3xx", Label: "3xx", Description: "Redirect responses"},
+	{Name: "4xx", Label: "4xx", Description: "Client error responses"},
+	{Name: "5xx", Label: "5xx", Description: "Server error responses"},
 }
 
 type RetryMetadata struct {
@@ -96,6 +119,7 @@ func (e *HTTP) Documentation() string {
 - **Method**: HTTP method to use
 - **Query Parameters**: Optional URL query parameters
 - **Headers**: Custom HTTP headers (header names cannot use expressions)
+- **Bearer Token**: Stored credential sent in the ` + "`Authorization: Bearer`" + ` header
 - **Body**: Request body in various formats:
   - **JSON**: Structured JSON payload
   - **Form Data**: URL-encoded form data
@@ -116,6 +140,12 @@ Configure timeout and retry behavior:
 - **Exponential backoff**: Timeout increases with each retry (capped at 120s)
 - **Success codes**: Define which status codes are considered successful (default: 2xx)
 
+## Routing by Status Code
+
+Enable **Route by status code** to branch on the response status.
+The response is emitted on the 2xx, 3xx, 4xx, or 5xx output channel, and the execution does not fail for unsuccessful status codes.
+Retries still apply before the response is emitted.
+
 ## Output Events
 
 - **http.request.finished**: Emitted on successful request
@@ -176,6 +206,11 @@ func (e *HTTP) Setup(ctx core.SetupContext) error {
 }
 
 func (e *HTTP) OutputChannels(configuration any) []core.OutputChannel {
+	spec := Spec{}
+	if err := mapstructure.Decode(configuration, &spec); err == nil && spec.RouteByStatus {
+		return statusOutputChannels
+	}
+
 	return []core.OutputChannel{core.DefaultOutputChannel}
 }
 
@@ -273,6 +308,14 @@ func (e *HTTP) Configuration() []configuration.Field {
 			},
 			Default: "[{\"name\": \"X-Foo\", \"value\": \"Bar\"}]",
 		},
+		{
+			Name:        "bearerToken",
+			Label:       "Bearer Token",
+			Type:        configuration.FieldTypeSecretKey,
+			Required:    false,
+			Togglable:   true,
+			Description: "Stored credential sent as a bearer token in the Authorization header",
+		},
 		{
 			Name:        "contentType",
 			Label:       "Body",
@@ -377,6 +420,14 @@ func (e *HTTP) Configuration() []configuration.Field {
 			Description: "Comma-separated list of success status codes (e.g., 200, 201, 2xx). Leave empty for default 2xx behavior",
 			Default:     "2xx",
 		},
+		{
+			Name:        "routeByStatus",
+			Type:        configuration.FieldTypeBool,
+			Label:       "Route by status code",
+			Required:    false,
+			Default:     false,
+			Description: "Emit the response on a 2xx, 3xx, 4xx, or 5xx output channel instead of failing on unsuccessful status codes",
+		},
 		{
 			Name:        "timeoutStrategy",
 			Type:        configuration.FieldTypeSelect,
@@ -494,13 +545,18 @@ func (e *HTTP) Execute(ctx core.ExecutionContext) error {
 func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMetadata RetryMetadata) error {
 	currentTimeout := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, retryMetadata.TimeoutSeconds, retryMetadata.Attempt)
 
-	resp, err := e.executeRequest(spec, currentTimeout)
+	bearerToken, err := e.resolveBearerToken(ctx.Secrets, spec)
+	if err != nil {
+		return e.handleRequestError(ctx, spec, err, retryMetadata.Attempt+1)
+	}
+
+	resp, err := e.executeRequest(spec, bearerToken, currentTimeout)
 	if err != nil {
 		if retryMetadata.Attempt < retryMetadata.MaxRetries {
 			return e.scheduleRetry(ctx, err.Error(), retryMetadata)
 		}
 
-		return e.handleRequestError(ctx, err, retryMetadata.Attempt+1)
+		return e.handleRequestError(ctx, spec, err, retryMetadata.Attempt+1)
 	}
 
 	var isSuccess bool
@@ -556,6 +612,7 @@ func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
 		Metadata:       ctx.Metadata,
 		Requests:       ctx.Requests,
 		Auth:           ctx.Auth,
+		Secrets:        ctx.Secrets,
 	}
 
 	return e.executeHTTPRequest(execCtx, spec, retryMetadata)
@@ -577,7 +634,28 @@ func (e *HTTP) calculateTimeoutForAttempt(strategy string, timeoutSeconds int, a
 	return baseTimeout
 }
 
-func (e *HTTP) executeRequest(spec Spec, timeout time.Duration) (*http.Response, error) {
+func (e *HTTP) resolveBearerToken(secrets core.SecretsContext, spec Spec) (string, error) {
+	if spec.BearerToken == nil || !spec.BearerToken.IsSet() {
+		return "", nil
+	}
+
+	if secrets == nil {
+		return "", fmt.Errorf("bearer token could not be resolved: secrets are not available")
+	}
+
+	token, err := secrets.GetKey(spec.BearerToken.Secret, spec.BearerToken.Key)
+	if err != nil {
+		if errors.Is(err, core.ErrSecretKeyNotFound) {
+			return "", fmt.Errorf("bearer token could not be resolved from the selected credential")
+		}
+
+		return "", fmt.Errorf("failed to resolve bearer token: %w", err)
+	}
+
+	return string(token), nil
+}
+
+func (e *HTTP) executeRequest(spec Spec, bearerToken string, timeout time.Duration) (*http.Response, error) {
 	var body io.Reader
 	var contentType string
 	var err error
@@ -616,6 +694,10 @@ func (e *HTTP) executeRequest(spec Spec, timeout time.Duration) (*http.Response,
 		req.Header.Set("Content-Type", contentType)
 	}
 
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
 	if spec.Headers != nil {
 		for _, header := range *spec.Headers {
 			req.Header.Set(header.Name, header.Value)
@@ -630,7 +712,7 @@ func (e *HTTP) executeRequest(spec Spec, timeout time.Duration) (*http.Response,
 	return resp, nil
 }
 
-func (e *HTTP) handleRequestError(ctx core.ExecutionContext, err error, totalAttempts int) error {
+func (e *HTTP) handleRequestError(ctx core.ExecutionContext, spec Spec, err error, totalAttempts int) error {
 	// Get current metadata and update with final result
 	metadata := ctx.Metadata.Get()
 	var retryMetadata RetryMetadata
@@ -643,6 +725,14 @@ func (e *HTTP) handleRequestError(ctx core.ExecutionContext, err error, totalAtt
 		ctx.Metadata.Set(retryMetadata)
 	}
 
+	//
+	// Without a response, there is no status code to route on,
+	// and the default output channel does not exist.
+	//
+	if spec.RouteByStatus {
+		return ctx.ExecutionState.Fail(models.CanvasNodeExecutionResultReasonError, fmt.Sprintf("Request failed after %d attempts: %v", totalAttempts, err))
+	}
+
 	errorResponse := map[string]any{
 		"error":    err.Error(),
 		"attempts": totalAttempts,
@@ -741,6 +831,10 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 		eventType = "http.request.failed"
 	}
 
+	if spec.RouteByStatus {
+		return ctx.ExecutionState.Emit(statusOutputChannel(resp.StatusCode), eventType, []any{response})
+	}
+
 	if !isSuccess {
 		ctx.ExecutionState.Fail(models.CanvasNodeExecutionResultReasonError, fmt.Sprintf("HTTP request failed with status %d", resp.StatusCode))
 		return nil
@@ -759,6 +853,23 @@ func (e *HTTP) processResponse(ctx core.ExecutionContext, resp *http.Response, s
 	return nil
 }
 
+/*
+ * Informational responses are never returned by the HTTP client,
+ * so anything below 300 is routed to the 2xx channel.
+ */
+func statusOutputChannel(statusCode int) string {
+	switch {
+	case statusCode >= 500:
+		return "5xx"
+	case statusCode >= 400:
+		return "4xx"
+	case statusCode >= 300:
+		return "3xx"
+	}
+
+	return "2xx"
+}
+
 func (e *HTTP) matchesSuccessCode(statusCode int, successCodes string) bool {
 	if successCodes == "" {
 		successCodes = "2xx"
@@ -583,6 +583,81 @@ func TestHTTP__Execute__HTTPError(t *testing.T) {
 	// because the function returns early after calling Fail()
 }
 
+func TestHTTP__Execute__BearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cr3t", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := &HTTP{}
+	config := map[string]any{
+		"method":      "GET",
+		"url":         server.URL,
+		"bearerToken": map[string]any{"secret": "api", "key": "token"},
+	}
+
+	t.Run("token is sent in the authorization header", func(t *testing.T) {
+		ctx, stateCtx, _ := createExecutionContext(config)
+		ctx.Secrets = &contexts.SecretsContext{
+			Values: map[string]map[string][]byte{"api": {"token": []byte("s3cr3t")}},
+		}
+
+		require.NoError(t, h.Execute(ctx))
+		assert.True(t, stateCtx.Passed)
+	})
+
+	t.Run("missing secret key -> execution fails", func(t *testing.T) {
+		ctx, stateCtx, _ := createExecutionContext(config)
+		ctx.Secrets = &contexts.SecretsContext{}
+
+		require.NoError(t, h.Execute(ctx))
+		assert.False(t, stateCtx.Passed)
+		assert.Contains(t, stateCtx.FailureMessage, "bearer token could not be resolved")
+	})
+}
+
+func TestHTTP__Execute__RouteByStatus(t *testing.T) {
+	h := &HTTP{}
+
+	t.Run("output channels are the status classes", func(t *testing.T) {
+		channels := h.OutputChannels(map[string]any{"routeByStatus": true})
+		require.Len(t, channels, 4)
+		assert.Equal(t, "2xx", channels[0].Name)
+		assert.Equal(t, "5xx", channels[3].Name)
+
+		assert.Equal(t, []core.OutputChannel{core.DefaultOutputChannel}, h.OutputChannels(map[string]any{}))
+	})
+
+	for _, tc := range []struct {
+		status    int
+		channel   string
+		eventType string
+	}{
+		{status: http.StatusCreated, channel: "2xx", eventType: "http.request.finished"},
+		{status: http.StatusNotFound, channel: "4xx", eventType: "http.request.failed"},
+		{status: http.StatusServiceUnavailable, channel: "5xx", eventType: "http.request.failed"},
+	} {
+		t.Run(tc.channel, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			ctx, stateCtx, _ := createExecutionContext(map[string]any{
+				"method":        "GET",
+				"url":           server.URL,
+				"routeByStatus": true,
+			})
+
+			require.NoError(t, h.Execute(ctx))
+			assert.True(t, stateCtx.Passed)
+			assert.Equal(t, tc.channel, stateCtx.Channel)
+			assert.Equal(t, tc.eventType, stateCtx.Type)
+		})
+	}
+}
+
 func TestHTTP__Execute__InvalidURL(t *testing.T) {
 	h := &HTTP{}
 
@@ -20,6 +20,7 @@ const (
 	FieldTypeTimezone    = "timezone"
 	FieldTypeDaysOfWeek  = "days-of-week"
 	FieldTypeTimeRange   = "time-range"
+	FieldTypeJSON        = "json"
 
 	/*
 	 * Special field types
@@ -111,6 +112,7 @@ type TypeOptions struct {
 	DayInYear        *DayInYearTypeOptions        `json:"day_in_year,omitempty"`
 	Cron             *CronTypeOptions             `json:"cron,omitempty"`
 	Timezone         *TimezoneTypeOptions         `json:"timezone,omitempty"`
+	JSON             *JSONTypeOptions             `json:"json,omitempty"`
 }
 
 /*
@@ -203,6 +205,13 @@ type CronTypeOptions struct {
 	AllowedFields []string `json:"allowed_fields,omitempty"` // Optional: limit which cron fields are allowed
 }
 
+/*
+ * JSONTypeOptions specifies an optional JSON Schema for json fields
+ */
+type JSONTypeOptions struct {
+	Schema map[string]any `json:"schema,omitempty"`
+}
+
 /*
  * TimezoneTypeOptions specifies constraints for timezone fields
  */
@@ -0,0 +1,141 @@
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const jsonSchemaURL = "field-schema.json"
+
+var jsonSchemaPrinter = message.NewPrinter(language.English)
+
+/*
+ * ParseJSON parses the value of a JSON field.
+ * The value is either a JSON string, or an already parsed value.
+ * Syntax errors include the line and column of the error.
+ */
+func ParseJSON(value any) (any, error) {
+	text, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	var parsed any
+	err := json.Unmarshal([]byte(text), &parsed)
+	if err == nil {
+		return parsed, nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, column := jsonPosition(text, syntaxErr.Offset)
+		return nil, fmt.Errorf("invalid JSON at line %d, column %d: %s", line, column, syntaxErr.Error())
+	}
+
+	return nil, fmt.Errorf("invalid JSON: %v", err)
+}
+
+/*
+ * encoding/json reports the offset right after the invalid character.
+ * jsonPosition converts it into the 1-based line and column of that character.
+ */
+func jsonPosition(text string, offset int64) (int, int) {
+	position := int(min(max(offset-1, 0), int64(len(text))))
+	before := text[:position]
+	line := strings.Count(before, "\n") + 1
+	column := position - strings.LastIndex(before, "\n")
+	return line, column
+}
+
+func validateJSON(field Field, value any) error {
+	//
+	// Expressions are only resolved at execution time,
+	// so we replace them with placeholders to check the syntax,
+	// and skip the schema validation.
+	//
+	hasExpressions := false
+	if text, ok := value.(string); ok && expressionPlaceholderRegex.MatchString(text) {
+		hasExpressions = true
+		value = expressionPlaceholderRegex.ReplaceAllString(text, "{}")
+	}
+
+	parsed, err := ParseJSON(value)
+	if err != nil {
+		return err
+	}
+
+	if hasExpressions || field.TypeOptions == nil || field.TypeOptions.JSON == nil || field.TypeOptions.JSON.Schema == nil {
+		return nil
+	}
+
+	return validateJSONSchema(field.TypeOptions.JSON.Schema, parsed)
+}
+
+func validateJSONSchema(schema map[string]any, value any) error {
+	schemaDoc, err := normalizeJSON(schema)
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %v", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	err = compiler.AddResource(jsonSchemaURL, schemaDoc)
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %v", err)
+	}
+
+	compiled, err := compiler.Compile(jsonSchemaURL)
+	if err != nil {
+		return fmt.Errorf("invalid JSON schema: %v", err)
+	}
+
+	instance, err := normalizeJSON(value)
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	err = compiled.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return fmt.Errorf("does not match schema: %v", err)
+	}
+
+	return fmt.Errorf("does not match schema: %s", strings.Join(schemaViolations(validationErr), "; "))
+}
+
+/*
+ * The schema library expects values decoded with its own decoder,
+ * which keeps numbers as json.Number.
+ */
+func normalizeJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonschema.UnmarshalJSON(bytes.NewReader(data))
+}
+
+func schemaViolations(err *jsonschema.ValidationError) []string {
+	if len(err.Causes) == 0 {
+		location := "/" + strings.Join(err.InstanceLocation, "/")
+		return []string{fmt.Sprintf("at '%s': %s", location, err.ErrorKind.LocalizedString(jsonSchemaPrinter))}
+	}
+
+	violations := []string{}
+	for _, cause := range err.Causes {
+		violations = append(violations, schemaViolations(cause)...)
+	}
+
+	return violations
+}
@@ -0,0 +1,67 @@
+package configuration
+
+const RedactedValue = "[REDACTED]"
+
+/*
+ * Redact returns a copy of the configuration where the values
+ * of sensitive and secret fields are replaced with RedactedValue,
+ * so the configuration can be safely included in logs.
+ */
+func Redact(fields []Field, config map[string]any) map[string]any {
+	redacted := make(map[string]any, len(config))
+	for name, value := range config {
+		redacted[name] = value
+	}
+
+	for _, field := range fields {
+		value, ok := config[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		if field.Sensitive || field.Type == FieldTypeSecretKey {
+			redacted[field.Name] = RedactedValue
+			continue
+		}
+
+		redacted[field.Name] = redactNested(field, value)
+	}
+
+	return redacted
+}
+
+func redactNested(field Field, value any) any {
+	if field.TypeOptions == nil {
+		return value
+	}
+
+	if field.TypeOptions.Object != nil && len(field.TypeOptions.Object.Schema) > 0 {
+		if obj, ok := value.(map[string]any); ok {
+			return Redact(field.TypeOptions.Object.Schema, obj)
+		}
+
+		return value
+	}
+
+	list := field.TypeOptions.List
+	if list == nil || list.ItemDefinition == nil || len(list.ItemDefinition.Schema) == 0 {
+		return value
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return value
+	}
+
+	redacted := make([]any, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(map[string]any); ok {
+			redacted = append(redacted, Redact(list.ItemDefinition.Schema, obj))
+			continue
+		}
+
+		redacted = append(redacted, item)
+	}
+
+	return redacted
+}
@@ -0,0 +1,52 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	fields := []Field{
+		{Name: "url", Type: FieldTypeString},
+		{Name: "token", Type: FieldTypeString, Sensitive: true},
+		{Name: "credential", Type: FieldTypeSecretKey},
+		{
+			Name: "headers",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type: FieldTypeObject,
+						Schema: []Field{
+							{Name: "name", Type: FieldTypeString},
+							{Name: "value", Type: FieldTypeString, Sensitive: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config := map[string]any{
+		"url":        "https://example.com",
+		"token":      "abc123",
+		"credential": map[string]any{"secret": "prod", "key": "api-key"},
+		"headers":    []any{map[string]any{"name": "Authorization", "value": "Bearer abc123"}},
+		"extra":      "kept",
+	}
+
+	redacted := Redact(fields, config)
+
+	assert.Equal(t, map[string]any{
+		"url":        "https://example.com",
+		"token":      RedactedValue,
+		"credential": RedactedValue,
+		"headers":    []any{map[string]any{"name": "Authorization", "value": RedactedValue}},
+		"extra":      "kept",
+	}, redacted)
+
+	// original configuration is not modified
+	assert.Equal(t, "abc123", config["token"])
+	assert.Equal(t, "Bearer abc123", config["headers"].([]any)[0].(map[string]any)["value"])
+}
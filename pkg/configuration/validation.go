@@ -666,6 +666,9 @@ func validateFieldValue(field Field, value any) error {
 
 	case FieldTypeTimezone:
 		return validateTimezone(field, value)
+
+	case FieldTypeJSON:
+		return validateJSON(field, value)
 	}
 
 	return nil
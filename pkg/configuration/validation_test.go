@@ -305,6 +305,93 @@ func TestValidateConfiguration_TimeRange(t *testing.T) {
 	}
 }
 
+func TestValidateConfiguration_JSON(t *testing.T) {
+	fields := []Field{
+		{
+			Name:     "payload",
+			Type:     FieldTypeJSON,
+			Required: true,
+			TypeOptions: &TypeOptions{
+				JSON: &JSONTypeOptions{
+					Schema: map[string]any{
+						"type":     "object",
+						"required": []any{"environment"},
+						"properties": map[string]any{
+							"environment": map[string]any{"type": "string"},
+							"replicas":    map[string]any{"type": "integer", "minimum": 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]any
+		expectedError string
+	}{
+		{
+			name:   "valid JSON string",
+			config: map[string]any{"payload": `{"environment": "production", "replicas": 2}`},
+		},
+		{
+			name:   "already parsed value",
+			config: map[string]any{"payload": map[string]any{"environment": "staging"}},
+		},
+		{
+			name:   "expressions skip the schema",
+			config: map[string]any{"payload": `{"replicas": {{ $.data.replicas }}}`},
+		},
+		{
+			name:          "invalid JSON reports line and column",
+			config:        map[string]any{"payload": "{\n  \"environment\": \"production\",\n  \"replicas\": ,\n}"},
+			expectedError: "invalid JSON at line 3, column 15",
+		},
+		{
+			name:          "missing required property",
+			config:        map[string]any{"payload": `{"replicas": 2}`},
+			expectedError: "missing property 'environment'",
+		},
+		{
+			name:          "wrong property type",
+			config:        map[string]any{"payload": `{"environment": "production", "replicas": 0}`},
+			expectedError: "at '/replicas'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfiguration(fields, tt.config)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	t.Run("string is parsed", func(t *testing.T) {
+		value, err := ParseJSON(`{"a": [1, 2]}`)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": []any{1.0, 2.0}}, value)
+	})
+
+	t.Run("parsed value is returned as is", func(t *testing.T) {
+		value, err := ParseJSON([]any{"a"})
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"a"}, value)
+	})
+
+	t.Run("syntax error on the first line", func(t *testing.T) {
+		_, err := ParseJSON(`{"a" 1}`)
+		assert.ErrorContains(t, err, "line 1, column 6")
+	})
+}
+
 func TestValidateTime_CustomFormat(t *testing.T) {
 	tests := []struct {
 		name        string
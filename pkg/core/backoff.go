@@ -0,0 +1,34 @@
+package core
+
+import "time"
+
+/*
+ * Backoff computes increasing intervals for polling,
+ * so polls start fast and slow down over time.
+ * If Max is zero, intervals are not capped.
+ */
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+/*
+ * Interval returns the interval to wait before the given attempt, starting at 1.
+ * The interval doubles on every attempt, up to the maximum.
+ */
+func (b Backoff) Interval(attempt int) time.Duration {
+	interval := b.Initial
+	for i := 1; i < attempt; i++ {
+		if b.Max > 0 && interval >= b.Max {
+			break
+		}
+
+		interval *= 2
+	}
+
+	if b.Max > 0 && interval > b.Max {
+		return b.Max
+	}
+
+	return interval
+}
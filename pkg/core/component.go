@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"time"
@@ -123,6 +124,49 @@ type Component interface {
 	Cleanup(ctx SetupContext) error
 }
 
+/*
+ * ComponentWithInputs is implemented by components
+ * that declare which data from upstream events they read.
+ * Components that do not implement it declare no inputs.
+ */
+type ComponentWithInputs interface {
+	Component
+
+	/*
+	 * The inputs read by the component.
+	 * Each input is a configuration field whose value usually
+	 * comes from an expression on the upstream event.
+	 */
+	Inputs() []InputField
+}
+
+type InputField struct {
+	/*
+	 * The name of the configuration field that reads the input.
+	 */
+	Name string
+
+	Description string
+
+	/*
+	 * Whether the component can't run without the input.
+	 */
+	Required bool
+}
+
+/*
+ * ComponentInputs returns the inputs declared by a component,
+ * or nothing, if the component does not declare any.
+ */
+func ComponentInputs(component Component) []InputField {
+	withInputs, ok := component.(ComponentWithInputs)
+	if !ok {
+		return nil
+	}
+
+	return withInputs.Inputs()
+}
+
 type OutputChannel struct {
 	Name        string
 	Label       string
@@ -153,6 +197,14 @@ type ExecutionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	Idempotency    IdempotencyContext
+
+	//
+	// Carries the trace of the execution,
+	// so spans created by the component are part of it.
+	// It may be nil, so use context.Background() in that case.
+	//
+	Context context.Context
 }
 
 /*
@@ -178,6 +230,13 @@ type SetupContext struct {
 	Requests      RequestContext
 	Auth          AuthContext
 	Integration   IntegrationContext
+
+	//
+	// Example payloads of the nodes upstream of this one,
+	// by node name and by node ID, like expressions reference them with $.
+	// It is nil when the upstream nodes are not known.
+	//
+	Upstream map[string]any
 }
 
 /*
@@ -201,6 +260,13 @@ type ExecutionStateContext interface {
 	 */
 	Emit(channel, payloadType string, payloads []any) error
 
+	/*
+	 * Emits payloads to the specified channel, without passing the execution,
+	 * so executions with a large output can emit it in parts.
+	 * The execution still needs to be passed or failed at the end.
+	 */
+	EmitPartial(channel, payloadType string, payloads []any) error
+
 	/*
 	 * Pass the execution, without emitting any payloads from it.
 	 */
@@ -211,6 +277,46 @@ type ExecutionStateContext interface {
 	 * No payloads are emitted.
 	 */
 	Fail(reason, message string) error
+
+	/*
+	 * Pass the execution, emitting an ErrorEvent for the error to the specified channel,
+	 * so the failure can be routed to other nodes.
+	 */
+	EmitError(channel string, err error) error
+}
+
+/*
+ * IdempotencyContext allows components to protect side effects
+ * from being repeated when an execution is attempted again.
+ *
+ * Records are committed right away, outside of the transaction the execution runs in,
+ * so they are still there if that transaction is rolled back, and the execution retried.
+ */
+type IdempotencyContext interface {
+
+	/*
+	 * Records the key, before the side effect happens.
+	 * If an earlier attempt of the execution already recorded it,
+	 * the returned claim says so, with the result that attempt recorded, if any.
+	 */
+	Claim(key string) (*IdempotencyClaim, error)
+
+	/*
+	 * Records the result of the side effect protected by the key.
+	 */
+	Complete(key string, result any) error
+}
+
+type IdempotencyClaim struct {
+	/*
+	 * Whether an earlier attempt of the execution recorded the key.
+	 */
+	Existing bool
+
+	/*
+	 * The result recorded by the earlier attempt, if it got that far.
+	 */
+	Result any
 }
 
 /*
@@ -225,6 +331,15 @@ type RequestContext interface {
 	ScheduleActionCall(actionName string, parameters map[string]any, interval time.Duration) error
 }
 
+/*
+ * Scheduling this action runs Execute again later,
+ * so components can wait on something without blocking the executor.
+ * The execution goes back to pending, and the executor runs it
+ * with the same configuration, input, and metadata.
+ * Components do not need to declare it in Actions().
+ */
+const RetryExecutionAction = "retryExecution"
+
 /*
  * Custom action definition for a component.
  */
@@ -251,6 +366,7 @@ type ActionContext struct {
 	Requests       RequestContext
 	Integration    IntegrationContext
 	Notifications  NotificationContext
+	Secrets        SecretsContext
 }
 
 /*
@@ -295,6 +411,20 @@ type ProcessQueueContext struct {
 	//
 	DefaultProcessing func() (*uuid.UUID, error)
 
+	//
+	// RescheduleAfter leaves the queue item in the queue,
+	// and processes it again after the interval.
+	// Used by components that wait for something before creating an execution.
+	//
+	RescheduleAfter func(interval time.Duration) error
+
+	//
+	// Number of times the queue item was rescheduled with RescheduleAfter.
+	// It is stored with the queue item, so it survives restarts,
+	// and can be used with a Backoff to increase the interval between attempts.
+	//
+	RescheduleAttempts int
+
 	//
 	// CountDistinctIncomingSources returns the number of distinct upstream
 	// source nodes connected to this node (ignoring multiple channels from the
@@ -0,0 +1,58 @@
+package core
+
+import "errors"
+
+const (
+	ErrorPayloadType     = "node.error"
+	UnknownErrorCategory = "unknown"
+)
+
+/*
+ * Components that can continue on error emit error events on this channel,
+ * with ExecutionStateContext.EmitError(), instead of failing the execution.
+ */
+var ErrorOutputChannel = OutputChannel{
+	Name:        "error",
+	Label:       "Error",
+	Description: "Emitted when the component fails and continue on error is enabled",
+}
+
+/*
+ * Errors describe their category, and whether retrying may succeed,
+ * by implementing these interfaces. Wrapped errors are checked too,
+ * so a component can wrap a categorized error with more context.
+ */
+type CategorizedError interface {
+	error
+	Category() string
+}
+
+type RetryableError interface {
+	error
+	Retryable() bool
+}
+
+/*
+ * ErrorEvent is the payload of error events.
+ */
+type ErrorEvent struct {
+	Message   string `json:"message"`
+	Category  string `json:"category"`
+	Retryable bool   `json:"retryable"`
+}
+
+func NewErrorEvent(err error) ErrorEvent {
+	event := ErrorEvent{Message: err.Error(), Category: UnknownErrorCategory}
+
+	var categorized CategorizedError
+	if errors.As(err, &categorized) {
+		event.Category = categorized.Category()
+	}
+
+	var retryable RetryableError
+	if errors.As(err, &retryable) {
+		event.Retryable = retryable.Retryable()
+	}
+
+	return event
+}
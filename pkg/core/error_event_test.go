@@ -0,0 +1,30 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCategoryError struct{}
+
+func (e *testCategoryError) Error() string    { return "rate limited" }
+func (e *testCategoryError) Category() string { return "rate_limited" }
+func (e *testCategoryError) Retryable() bool  { return true }
+
+func Test__NewErrorEvent(t *testing.T) {
+	t.Run("plain error -> unknown category, not retryable", func(t *testing.T) {
+		assert.Equal(t, ErrorEvent{Message: "boom", Category: UnknownErrorCategory}, NewErrorEvent(errors.New("boom")))
+	})
+
+	t.Run("wrapped categorized error -> category and retryable flag", func(t *testing.T) {
+		err := fmt.Errorf("failed to create comment: %w", &testCategoryError{})
+		assert.Equal(t, ErrorEvent{
+			Message:   "failed to create comment: rate limited",
+			Category:  "rate_limited",
+			Retryable: true,
+		}, NewErrorEvent(err))
+	})
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+const (
+	RunIfFieldName     = "runIf"
+	SkippedPayloadType = "node.skipped"
+)
+
+/*
+ * Every component supports conditional execution.
+ * The registry adds RunIfField to the configuration of every component,
+ * and SkippedOutputChannel to its output channels, with WithRunIf and WithSkippedChannel.
+ * The field is evaluated by the node executor before Execute() is called.
+ */
+var RunIfField = configuration.Field{
+	Name:        RunIfFieldName,
+	Label:       "Run If",
+	Type:        configuration.FieldTypeString,
+	Togglable:   true,
+	Placeholder: "e.g., {{ $.data.action == \"opened\" }}",
+	Description: "Only run when this is truthy. Otherwise, a skipped event is emitted on the skipped channel.",
+}
+
+var SkippedOutputChannel = OutputChannel{
+	Name:        "skipped",
+	Label:       "Skipped",
+	Description: "Emitted when the run if condition is falsy",
+}
+
+/*
+ * Values are resolved from expressions into strings,
+ * so these are the strings considered falsy, compared case-insensitively.
+ * Any other value is truthy.
+ */
+var falsyValues = []string{"", "false", "0", "no", "off", "null", "nil", "<nil>"}
+
+/*
+ * WithRunIf returns the configuration fields with RunIfField at the end,
+ * unless the component already declares it.
+ */
+func WithRunIf(fields []configuration.Field) []configuration.Field {
+	if SupportsRunIf(fields) {
+		return fields
+	}
+
+	return append(slices.Clone(fields), RunIfField)
+}
+
+/*
+ * WithSkippedChannel returns the output channels with SkippedOutputChannel at the end,
+ * unless the component already declares it.
+ */
+func WithSkippedChannel(channels []OutputChannel) []OutputChannel {
+	hasSkipped := slices.ContainsFunc(channels, func(channel OutputChannel) bool {
+		return channel.Name == SkippedOutputChannel.Name
+	})
+
+	if hasSkipped {
+		return channels
+	}
+
+	return append(slices.Clone(channels), SkippedOutputChannel)
+}
+
+func SupportsRunIf(fields []configuration.Field) bool {
+	return slices.ContainsFunc(fields, func(field configuration.Field) bool {
+		return field.Name == RunIfFieldName
+	})
+}
+
+/*
+ * ShouldRun evaluates the resolved run if condition of a configuration.
+ * A condition that is not set - the field is toggled off - always runs.
+ */
+func ShouldRun(config any) bool {
+	values, ok := config.(map[string]any)
+	if !ok {
+		return true
+	}
+
+	value, ok := values[RunIfFieldName]
+	if !ok || value == nil {
+		return true
+	}
+
+	return IsTruthy(value)
+}
+
+func IsTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return !slices.Contains(falsyValues, strings.ToLower(strings.TrimSpace(v)))
+	default:
+		return IsTruthy(fmt.Sprintf("%v", v))
+	}
+}
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+func Test__SupportsRunIf(t *testing.T) {
+	assert.True(t, SupportsRunIf([]configuration.Field{{Name: "body"}, RunIfField}))
+	assert.False(t, SupportsRunIf([]configuration.Field{{Name: "body"}}))
+}
+
+func Test__WithRunIf(t *testing.T) {
+	fields := WithRunIf([]configuration.Field{{Name: "body"}})
+	assert.Equal(t, []configuration.Field{{Name: "body"}, RunIfField}, fields)
+	assert.Equal(t, fields, WithRunIf(fields))
+}
+
+func Test__WithSkippedChannel(t *testing.T) {
+	channels := WithSkippedChannel([]OutputChannel{DefaultOutputChannel})
+	assert.Equal(t, []OutputChannel{DefaultOutputChannel, SkippedOutputChannel}, channels)
+	assert.Equal(t, channels, WithSkippedChannel(channels))
+}
+
+func Test__ShouldRun(t *testing.T) {
+	t.Run("condition not set -> runs", func(t *testing.T) {
+		assert.True(t, ShouldRun(map[string]any{"body": "hi"}))
+		assert.True(t, ShouldRun(map[string]any{RunIfFieldName: nil}))
+		assert.True(t, ShouldRun(nil))
+	})
+
+	t.Run("falsy values -> skipped", func(t *testing.T) {
+		for _, value := range []any{"", "  ", "false", "FALSE", " False ", "0", "no", "off", "null", "nil", "<nil>", false, 0} {
+			assert.False(t, ShouldRun(map[string]any{RunIfFieldName: value}), "%#v", value)
+		}
+	})
+
+	t.Run("truthy values -> runs", func(t *testing.T) {
+		for _, value := range []any{"true", "1", "yes", "opened", "0.5", true, 2} {
+			assert.True(t, ShouldRun(map[string]any{RunIfFieldName: value}), "%#v", value)
+		}
+	})
+}
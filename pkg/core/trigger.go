@@ -96,6 +96,16 @@ type EventContext interface {
 	Emit(payloadType string, payload any) error
 }
 
+/*
+ * Events emitted by webhook handlers go on the default output channel.
+ * Triggers that route the webhooks they receive, like the GitHub webhook router,
+ * emit on their own output channels through this interface instead.
+ */
+type ChannelEventContext interface {
+	EventContext
+	EmitOnChannel(channel, payloadType string, payload any) error
+}
+
 type TriggerActionContext struct {
 	Name          string
 	Parameters    map[string]any
@@ -118,6 +128,13 @@ type WebhookRequestContext struct {
 	Webhook       NodeWebhookContext
 	Events        EventContext
 
+	//
+	// The integration of the node, if it uses one.
+	// Allows webhook handlers to call the integration API,
+	// for example, to acknowledge the event that triggered them.
+	//
+	Integration IntegrationContext
+
 	//
 	// Return an execution context for a given execution,
 	// through a referencing key-value pair.
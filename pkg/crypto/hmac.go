@@ -6,12 +6,14 @@ import (
 	"fmt"
 )
 
-func VerifySignature(key []byte, data []byte, signature string) error {
+func Sign(key []byte, data []byte) string {
 	h := hmac.New(sha256.New, key)
 	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
 
-	computed := fmt.Sprintf("%x", h.Sum(nil))
-	if computed != signature {
+func VerifySignature(key []byte, data []byte, signature string) error {
+	if Sign(key, data) != signature {
 		return fmt.Errorf("invalid signature")
 	}
 
@@ -21,4 +21,8 @@ func Test__VerifySignature(t *testing.T) {
 		signature := "invalid signature"
 		require.Error(t, VerifySignature(key, data, signature))
 	})
+
+	t.Run("signature from Sign is valid", func(t *testing.T) {
+		require.NoError(t, VerifySignature(key, data, Sign(key, data)))
+	})
 }
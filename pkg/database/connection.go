@@ -110,10 +110,13 @@ func TruncateTables() error {
 			workflow_nodes,
 			workflow_events,
 			workflow_node_execution_kvs,
+			workflow_node_execution_idempotency_keys,
 			workflow_node_executions,
 			workflow_node_queue_items,
 			workflow_node_requests,
-			webhooks
+			webhook_deliveries,
+			webhooks,
+			concurrency_locks
 		restart identity cascade;
 	`).Error
 }
@@ -91,6 +91,7 @@ func InvokeNodeExecutionAction(
 		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
+		Secrets:        contexts.NewSecretsContext(tx, orgID, encryptor),
 	}
 
 	if node.AppInstallationID != nil {
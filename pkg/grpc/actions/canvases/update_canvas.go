@@ -94,7 +94,12 @@ func UpdateCanvas(ctx context.Context, encryptor crypto.Encryptor, registry *reg
 			}
 
 			if workflowNode.State == models.CanvasNodeStateReady {
-				err = setupNode(ctx, tx, encryptor, registry, workflowNode, webhookBaseURL)
+				var upstream map[string]any
+				if workflowNode.ParentNodeID == nil {
+					upstream = upstreamPayloads(registry, nodes, edges, workflowNode.NodeID)
+				}
+
+				err = setupNode(ctx, tx, encryptor, registry, workflowNode, webhookBaseURL, upstream)
 				if err != nil {
 					workflowNode.State = models.CanvasNodeStateError
 					errorMsg := err.Error()
@@ -307,12 +312,12 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 	return &canvasNode, nil
 }
 
-func setupNode(ctx context.Context, tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.Registry, node *models.CanvasNode, webhookBaseURL string) error {
+func setupNode(ctx context.Context, tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.Registry, node *models.CanvasNode, webhookBaseURL string, upstream map[string]any) error {
 	switch node.Type {
 	case models.NodeTypeTrigger:
 		return setupTrigger(ctx, tx, encryptor, registry, node, webhookBaseURL)
 	case models.NodeTypeComponent:
-		return setupComponent(tx, encryptor, registry, node)
+		return setupComponent(tx, encryptor, registry, node, upstream)
 	case models.NodeTypeWidget:
 		// Widgets are not persisted and don't have any logic to execute and to setup.
 		return nil
@@ -363,7 +368,7 @@ func setupTrigger(ctx context.Context, tx *gorm.DB, encryptor crypto.Encryptor,
 	return tx.Save(node).Error
 }
 
-func setupComponent(tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.Registry, node *models.CanvasNode) error {
+func setupComponent(tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.Registry, node *models.CanvasNode, upstream map[string]any) error {
 	ref := node.Ref.Data()
 	component, err := registry.GetComponent(ref.Component.Name)
 	if err != nil {
@@ -376,6 +381,7 @@ func setupComponent(tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.
 		HTTP:          contexts.NewHTTPContext(registry.GetHTTPClient()),
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
+		Upstream:      upstream,
 	}
 
 	if node.AppInstallationID != nil {
@@ -403,6 +409,71 @@ func setupComponent(tx *gorm.DB, encryptor crypto.Encryptor, registry *registry.
 	return tx.Save(node).Error
 }
 
+/*
+ * Returns the example payloads of every node upstream of the node,
+ * by node name and by node ID, so components can validate
+ * the expressions reading from them when they are set up.
+ * Nodes without an example payload map to an empty one.
+ */
+func upstreamPayloads(registry *registry.Registry, nodes []models.Node, edges []models.Edge, nodeID string) map[string]any {
+	nodesByID := map[string]models.Node{}
+	for _, node := range nodes {
+		nodesByID[node.ID] = node
+	}
+
+	upstream := map[string]any{}
+	visited := map[string]bool{nodeID: true}
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range edges {
+			if edge.TargetID != target || visited[edge.SourceID] {
+				continue
+			}
+
+			visited[edge.SourceID] = true
+			queue = append(queue, edge.SourceID)
+
+			source, ok := nodesByID[edge.SourceID]
+			if !ok {
+				continue
+			}
+
+			payload := examplePayload(registry, source)
+			upstream[source.ID] = payload
+			if source.Name != "" {
+				upstream[source.Name] = payload
+			}
+		}
+	}
+
+	return upstream
+}
+
+func examplePayload(registry *registry.Registry, node models.Node) map[string]any {
+	var payload map[string]any
+	switch {
+	case node.Ref.Trigger != nil:
+		trigger, err := registry.GetTrigger(node.Ref.Trigger.Name)
+		if err == nil {
+			payload = trigger.ExampleData()
+		}
+	case node.Ref.Component != nil:
+		component, err := registry.GetComponent(node.Ref.Component.Name)
+		if err == nil {
+			payload = component.ExampleOutput()
+		}
+	}
+
+	if payload == nil {
+		return map[string]any{}
+	}
+
+	return payload
+}
+
 func deleteNodes(tx *gorm.DB, existingNodes []models.CanvasNode, newNodes []models.Node) error {
 	for _, existingNode := range existingNodes {
 		if !slices.ContainsFunc(newNodes, func(n models.Node) bool { return n.ID == existingNode.NodeID }) {
@@ -2,15 +2,19 @@ package canvases
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/test/support"
 	"google.golang.org/protobuf/types/known/structpb"
 	"gorm.io/datatypes"
@@ -1121,3 +1125,28 @@ func TestUpdateCanvas_WidgetTextLengthValidation(t *testing.T) {
 	database.Conn().Model(&models.CanvasNode{}).Where("workflow_id = ? AND node_id = ?", canvas.ID, "annotation-2").Count(&annotationNodeCount)
 	assert.Equal(t, int64(0), annotationNodeCount, "widget nodes should not be persisted in workflow_nodes table")
 }
+
+func TestUpstreamPayloads(t *testing.T) {
+	r := registry.NewRegistry(crypto.NewNoOpEncryptor())
+	nodes := []models.Node{
+		{ID: "trigger-1", Name: "Start", Type: models.NodeTypeTrigger, Ref: models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}},
+		{ID: "node-1", Name: "First", Type: models.NodeTypeComponent, Ref: models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}},
+		{ID: "node-2", Name: "Second", Type: models.NodeTypeComponent, Ref: models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}},
+		{ID: "node-3", Name: "Other", Type: models.NodeTypeComponent, Ref: models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}},
+	}
+
+	edges := []models.Edge{
+		{SourceID: "trigger-1", TargetID: "node-1", Channel: "default"},
+		{SourceID: "node-1", TargetID: "node-2", Channel: "default"},
+		{SourceID: "trigger-1", TargetID: "node-3", Channel: "default"},
+	}
+
+	upstream := upstreamPayloads(r, nodes, edges, "node-2")
+	assert.ElementsMatch(t, []string{"trigger-1", "Start", "node-1", "First"}, slices.Collect(maps.Keys(upstream)))
+
+	trigger, err := r.GetTrigger("start")
+	require.NoError(t, err)
+	assert.Equal(t, trigger.ExampleData(), upstream["Start"])
+
+	assert.Empty(t, upstreamPayloads(r, nodes, edges, "trigger-1"))
+}
@@ -6,12 +6,35 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
 const discordAPIBase = "https://discord.com/api/v10"
 
+/*
+ * Rate limited requests are retried after the time Discord asks for,
+ * unless waiting would block the execution for longer than MaxRateLimitWait in total.
+ */
+const (
+	MaxRateLimitRetries = 3
+	MaxRetryAfter       = 5 * time.Second
+	MaxRateLimitWait    = 10 * time.Second
+)
+
+/*
+ * Webhook URLs come from secrets, so they are checked before sending anything,
+ * to avoid posting message content to arbitrary hosts.
+ */
+var webhookHosts = []string{"discord.com", "discordapp.com"}
+
+var sleep = time.Sleep
+
 type Client struct {
 	BotToken string
 }
@@ -173,7 +196,7 @@ func (c *Client) CreateMessage(channelID string, req CreateMessageRequest) (*Mes
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	responseBody, err := c.doRequest(http.MethodPost, fmt.Sprintf("/channels/%s/messages", channelID), bytes.NewReader(body))
+	responseBody, err := c.doRequest(http.MethodPost, fmt.Sprintf("/channels/%s/messages", channelID), body)
 	if err != nil {
 		return nil, err
 	}
@@ -186,32 +209,130 @@ func (c *Client) CreateMessage(channelID string, req CreateMessageRequest) (*Mes
 	return &message, nil
 }
 
+// ExecuteWebhook sends a message through a channel webhook, which needs no bot token
+func ExecuteWebhook(webhookURL string, req CreateMessageRequest) (*Message, error) {
+	parsed, err := parseWebhookURL(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Without wait=true, Discord answers with a 204,
+	// and we would not get the message back.
+	//
+	query := parsed.Query()
+	query.Set("wait", "true")
+	parsed.RawQuery = query.Encode()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	responseBody, err := sendRequest(http.MethodPost, parsed.String(), body, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+	if err := json.Unmarshal(responseBody, &message); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &message, nil
+}
+
+func parseWebhookURL(webhookURL string) (*url.URL, error) {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	if parsed.Scheme != "https" || !slices.Contains(webhookHosts, parsed.Hostname()) || parsed.Port() != "" {
+		return nil, fmt.Errorf("webhook URL must be an https URL on %s", strings.Join(webhookHosts, " or "))
+	}
+
+	if !strings.HasPrefix(parsed.Path, "/api/webhooks/") {
+		return nil, fmt.Errorf("webhook URL must be a Discord webhook URL, like https://discord.com/api/webhooks/...")
+	}
+
+	return parsed, nil
+}
+
 // doRequest executes an HTTP request to the Discord API
-func (c *Client) doRequest(method, endpoint string, body io.Reader) ([]byte, error) {
-	url := discordAPIBase + endpoint
+func (c *Client) doRequest(method, endpoint string, body []byte) ([]byte, error) {
+	return sendRequest(method, discordAPIBase+endpoint, body, fmt.Sprintf("Bot %s", c.BotToken))
+}
+
+func sendRequest(method, URL string, body []byte, authorization string) ([]byte, error) {
+	waited := time.Duration(0)
+	for attempt := 0; ; attempt++ {
+		resp, responseBody, err := send(method, URL, body, authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < MaxRateLimitRetries {
+			wait := retryAfter(resp.Header, responseBody)
+			if wait <= MaxRetryAfter && waited+wait <= MaxRateLimitWait {
+				sleep(wait)
+				waited += wait
+				continue
+			}
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, fmt.Errorf("request failed: status %d, body: %s", resp.StatusCode, string(responseBody))
+		}
+
+		return responseBody, nil
+	}
+}
+
+func send(method, URL string, body []byte, authorization string) (*http.Response, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
 
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequest(method, URL, reader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", c.BotToken))
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return resp, responseBody, nil
+}
+
+// retryAfter reads the wait time from the Retry-After header, or from the retry_after field of the body
+func retryAfter(header http.Header, body []byte) time.Duration {
+	if seconds, err := strconv.ParseFloat(header.Get("Retry-After"), 64); err == nil {
+		return time.Duration(seconds * float64(time.Second))
+	}
+
+	var rateLimit struct {
+		RetryAfter float64 `json:"retry_after"`
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("request failed: status %d, body: %s", resp.StatusCode, string(responseBody))
+	if err := json.Unmarshal(body, &rateLimit); err == nil && rateLimit.RetryAfter > 0 {
+		return time.Duration(rateLimit.RetryAfter * float64(time.Second))
 	}
 
-	return responseBody, nil
+	return time.Second
 }
@@ -71,6 +71,7 @@ func (d *Discord) Configuration() []configuration.Field {
 func (d *Discord) Components() []core.Component {
 	return []core.Component{
 		&SendTextMessage{},
+		&PostMessage{},
 	}
 }
 
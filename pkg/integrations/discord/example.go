@@ -10,9 +10,19 @@ import (
 //go:embed example_output_send_text_message.json
 var exampleOutputSendTextMessageBytes []byte
 
+//go:embed example_output_post_message.json
+var exampleOutputPostMessageBytes []byte
+
 var exampleOutputOnce sync.Once
 var exampleOutput map[string]any
 
+var exampleOutputPostMessageOnce sync.Once
+var exampleOutputPostMessage map[string]any
+
 func (c *SendTextMessage) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleOutputOnce, exampleOutputSendTextMessageBytes, &exampleOutput)
 }
+
+func (c *PostMessage) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputPostMessageOnce, exampleOutputPostMessageBytes, &exampleOutputPostMessage)
+}
@@ -0,0 +1,453 @@
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DestinationChannel = "channel"
+	DestinationWebhook = "webhook"
+)
+
+/*
+ * Message limits enforced by Discord.
+ * See https://discord.com/developers/docs/resources/message#embed-object-embed-limits
+ */
+const (
+	MaxContentLength          = 2000
+	MaxEmbeds                 = 10
+	MaxEmbedTitleLength       = 256
+	MaxEmbedDescriptionLength = 4096
+	MaxEmbedFields            = 25
+	MaxEmbedFieldNameLength   = 256
+	MaxEmbedFieldValueLength  = 1024
+	MaxEmbedFooterLength      = 2048
+	MaxEmbedAuthorLength      = 256
+	MaxEmbedsTotalLength      = 6000
+)
+
+type PostMessage struct{}
+
+// SecretKeyRef is stored in YAML as: { secret: "name", key: "keyName" }.
+type SecretKeyRef struct {
+	Secret string `json:"secret" mapstructure:"secret"`
+	Key    string `json:"key" mapstructure:"key"`
+}
+
+type PostMessageConfiguration struct {
+	Destination string        `json:"destination" mapstructure:"destination"`
+	Channel     string        `json:"channel" mapstructure:"channel"`
+	WebhookURL  *SecretKeyRef `json:"webhookUrl" mapstructure:"webhookUrl"`
+	Content     string        `json:"content" mapstructure:"content"`
+	Embeds      any           `json:"embeds" mapstructure:"embeds"`
+}
+
+type PostMessageMetadata struct {
+	Channel *ChannelMetadata `json:"channel,omitempty" mapstructure:"channel"`
+}
+
+func (c *PostMessage) Name() string {
+	return "discord.postMessage"
+}
+
+func (c *PostMessage) Label() string {
+	return "Post Message"
+}
+
+func (c *PostMessage) Description() string {
+	return "Post a message with embeds to a Discord channel, using the bot or a webhook"
+}
+
+func (c *PostMessage) Documentation() string {
+	return `The Post Message component posts a message to a Discord channel, as the integration's bot or through a channel webhook.
+
+## Use Cases
+
+- **Notifications**: Post deployment and release notes to a channel
+- **Rich alerts**: Post alerts with multiple embeds, fields, and links
+- **Channels without the bot**: Post to channels of servers the bot is not in, using a webhook
+
+## Configuration
+
+- **Destination**: Post as the bot to a channel, or through a webhook
+- **Channel**: The Discord channel to post to, when posting as the bot
+- **Webhook URL**: The secret holding the channel webhook URL, when posting through a webhook
+- **Content**: Plain text message content (max 2000 characters)
+- **Embeds**: Optional JSON array of Discord embed objects (max 10)
+
+## Output
+
+Returns the posted message, including its ` + "`id`" + ` and ` + "`channel_id`" + `.
+
+## Notes
+
+- Either content or embeds must be provided
+- Embed limits - title, description, fields, footer, author, and the 6000 characters total - are checked when the node is saved
+- Webhook URLs must be Discord webhook URLs, on discord.com or discordapp.com
+- Rate limited requests are retried after the time Discord asks for, for up to 10 seconds in total`
+}
+
+func (c *PostMessage) Icon() string {
+	return "discord"
+}
+
+func (c *PostMessage) Color() string {
+	return "gray"
+}
+
+func (c *PostMessage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PostMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "destination",
+			Label:    "Destination",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  DestinationChannel,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Channel (bot)", Value: DestinationChannel},
+						{Label: "Webhook", Value: DestinationWebhook},
+					},
+				},
+			},
+		},
+		{
+			Name:  "channel",
+			Label: "Channel",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type: "channel",
+				},
+			},
+			Description: "Discord channel to post the message to",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "destination", Values: []string{DestinationChannel}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "destination", Values: []string{DestinationChannel}},
+			},
+		},
+		{
+			Name:        "webhookUrl",
+			Label:       "Webhook URL",
+			Type:        configuration.FieldTypeSecretKey,
+			Description: "Secret holding the channel webhook URL",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "destination", Values: []string{DestinationWebhook}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "destination", Values: []string{DestinationWebhook}},
+			},
+		},
+		{
+			Name:        "content",
+			Label:       "Content",
+			Type:        configuration.FieldTypeText,
+			Description: "Plain text message content (max 2000 characters)",
+		},
+		{
+			Name:        "embeds",
+			Label:       "Embeds",
+			Type:        configuration.FieldTypeJSON,
+			Description: "JSON array of Discord embed objects",
+			TypeOptions: &configuration.TypeOptions{
+				JSON: &configuration.JSONTypeOptions{
+					Schema: map[string]any{
+						"type":     "array",
+						"maxItems": MaxEmbeds,
+						"items":    map[string]any{"type": "object"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *PostMessage) Setup(ctx core.SetupContext) error {
+	var config PostMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Destination != "" && !slices.Contains([]string{DestinationChannel, DestinationWebhook}, config.Destination) {
+		return fmt.Errorf("invalid destination: %s", config.Destination)
+	}
+
+	if config.Content == "" && isEmptyEmbeds(config.Embeds) {
+		return errors.New("either content or embeds is required")
+	}
+
+	if utf8.RuneCountInString(config.Content) > MaxContentLength {
+		return fmt.Errorf("content exceeds maximum length of %d characters", MaxContentLength)
+	}
+
+	//
+	// Embeds built with expressions can only be checked at execution time.
+	//
+	if text, ok := config.Embeds.(string); !ok || !strings.Contains(text, "{{") {
+		embeds, err := parseEmbeds(config.Embeds)
+		if err != nil {
+			return err
+		}
+
+		if err := validateEmbeds(embeds); err != nil {
+			return err
+		}
+	}
+
+	if config.Destination == DestinationWebhook {
+		if config.WebhookURL == nil || config.WebhookURL.Secret == "" || config.WebhookURL.Key == "" {
+			return errors.New("webhook URL is required")
+		}
+
+		return ctx.Metadata.Set(PostMessageMetadata{})
+	}
+
+	if config.Channel == "" {
+		return errors.New("channel is required")
+	}
+
+	client, err := NewClient(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to create Discord client: %w", err)
+	}
+
+	channelInfo, err := client.GetChannel(config.Channel)
+	if err != nil {
+		return fmt.Errorf("channel validation failed: %w", err)
+	}
+
+	return ctx.Metadata.Set(PostMessageMetadata{
+		Channel: &ChannelMetadata{
+			ID:   channelInfo.ID,
+			Name: channelInfo.Name,
+		},
+	})
+}
+
+func (c *PostMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PostMessage) Execute(ctx core.ExecutionContext) error {
+	var config PostMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	embeds, err := parseEmbeds(config.Embeds)
+	if err != nil {
+		return err
+	}
+
+	if err := validateEmbeds(embeds); err != nil {
+		return err
+	}
+
+	req := CreateMessageRequest{
+		Content: config.Content,
+		Embeds:  embeds,
+	}
+
+	message, err := c.post(ctx, config, req)
+	if err != nil {
+		return fmt.Errorf("failed to post message: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"discord.message.sent",
+		[]any{messagePayload(message)},
+	)
+}
+
+func (c *PostMessage) post(ctx core.ExecutionContext, config PostMessageConfiguration, req CreateMessageRequest) (*Message, error) {
+	if config.Destination != DestinationWebhook {
+		if config.Channel == "" {
+			return nil, errors.New("channel is required")
+		}
+
+		client, err := NewClient(ctx.Integration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Discord client: %w", err)
+		}
+
+		return client.CreateMessage(config.Channel, req)
+	}
+
+	if config.WebhookURL == nil || ctx.Secrets == nil {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	webhookURL, err := ctx.Secrets.GetKey(config.WebhookURL.Secret, config.WebhookURL.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve webhook URL: %w", err)
+	}
+
+	return ExecuteWebhook(string(webhookURL), req)
+}
+
+func isEmptyEmbeds(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	if text, ok := value.(string); ok {
+		text = strings.TrimSpace(text)
+		return text == "" || text == "[]"
+	}
+
+	if list, ok := value.([]any); ok {
+		return len(list) == 0
+	}
+
+	return false
+}
+
+func parseEmbeds(value any) ([]Embed, error) {
+	if isEmptyEmbeds(value) {
+		return nil, nil
+	}
+
+	parsed, err := configuration.ParseJSON(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid embeds: %w", err)
+	}
+
+	data, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid embeds: %w", err)
+	}
+
+	var embeds []Embed
+	if err := json.Unmarshal(data, &embeds); err != nil {
+		return nil, fmt.Errorf("embeds must be a JSON array of embed objects: %w", err)
+	}
+
+	return embeds, nil
+}
+
+func validateEmbeds(embeds []Embed) error {
+	if len(embeds) > MaxEmbeds {
+		return fmt.Errorf("a message can have at most %d embeds, got %d", MaxEmbeds, len(embeds))
+	}
+
+	total := 0
+	for i, embed := range embeds {
+		lengths, err := embedLengths(embed)
+		if err != nil {
+			return fmt.Errorf("embed %d: %w", i+1, err)
+		}
+
+		total += lengths
+	}
+
+	if total > MaxEmbedsTotalLength {
+		return fmt.Errorf("embeds have %d characters in total, but at most %d are allowed", total, MaxEmbedsTotalLength)
+	}
+
+	return nil
+}
+
+/*
+ * Checks the limits of a single embed, and returns
+ * the number of characters it counts towards the total limit.
+ */
+func embedLengths(embed Embed) (int, error) {
+	total := 0
+	check := func(name, value string, limit int) error {
+		length := utf8.RuneCountInString(value)
+		if length > limit {
+			return fmt.Errorf("%s exceeds maximum length of %d characters", name, limit)
+		}
+
+		total += length
+		return nil
+	}
+
+	if err := check("title", embed.Title, MaxEmbedTitleLength); err != nil {
+		return 0, err
+	}
+
+	if err := check("description", embed.Description, MaxEmbedDescriptionLength); err != nil {
+		return 0, err
+	}
+
+	if embed.Footer != nil {
+		if err := check("footer text", embed.Footer.Text, MaxEmbedFooterLength); err != nil {
+			return 0, err
+		}
+	}
+
+	if embed.Author != nil {
+		if err := check("author name", embed.Author.Name, MaxEmbedAuthorLength); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(embed.Fields) > MaxEmbedFields {
+		return 0, fmt.Errorf("at most %d fields are allowed, got %d", MaxEmbedFields, len(embed.Fields))
+	}
+
+	for _, field := range embed.Fields {
+		if err := check("field name", field.Name, MaxEmbedFieldNameLength); err != nil {
+			return 0, err
+		}
+
+		if err := check("field value", field.Value, MaxEmbedFieldValueLength); err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+func messagePayload(message *Message) map[string]any {
+	return map[string]any{
+		"id":         message.ID,
+		"channel_id": message.ChannelID,
+		"content":    message.Content,
+		"timestamp":  message.Timestamp,
+		"author": map[string]any{
+			"id":       message.Author.ID,
+			"username": message.Author.Username,
+			"bot":      message.Author.Bot,
+		},
+	}
+}
+
+func (c *PostMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *PostMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PostMessage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PostMessage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PostMessage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,278 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const testMessageResponse = `{
+	"id": "1234567890",
+	"type": 0,
+	"content": "Deployed",
+	"channel_id": "123456789",
+	"author": {"id": "999888777", "username": "Releases", "bot": true},
+	"timestamp": "2025-01-16T12:00:00.000Z"
+}`
+
+func withSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	waits := []time.Duration{}
+	original := sleep
+	sleep = func(d time.Duration) { waits = append(waits, d) }
+	t.Cleanup(func() {
+		sleep = original
+	})
+
+	return &waits
+}
+
+func Test__PostMessage__Setup(t *testing.T) {
+	component := &PostMessage{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	webhook := map[string]any{"secret": "discord", "key": "webhookUrl"}
+
+	t.Run("no content or embeds -> error", func(t *testing.T) {
+		err := setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "embeds": "[]"})
+		require.ErrorContains(t, err, "either content or embeds is required")
+	})
+
+	t.Run("content too long -> error", func(t *testing.T) {
+		err := setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "content": strings.Repeat("a", MaxContentLength+1)})
+		require.ErrorContains(t, err, "content exceeds maximum length")
+	})
+
+	t.Run("too many embeds -> error", func(t *testing.T) {
+		embeds := "[" + strings.TrimSuffix(strings.Repeat(`{"title": "hi"},`, MaxEmbeds+1), ",") + "]"
+		err := setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "embeds": embeds})
+		require.ErrorContains(t, err, "at most 10 embeds")
+	})
+
+	t.Run("embed title too long -> error", func(t *testing.T) {
+		embeds := fmt.Sprintf(`[{"title": "%s"}]`, strings.Repeat("a", MaxEmbedTitleLength+1))
+		err := setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "embeds": embeds})
+		require.ErrorContains(t, err, "embed 1: title exceeds maximum length of 256 characters")
+	})
+
+	t.Run("embeds over the total limit -> error", func(t *testing.T) {
+		description := strings.Repeat("a", MaxEmbedDescriptionLength)
+		embeds := fmt.Sprintf(`[{"description": "%s"}, {"description": "%s"}]`, description, description)
+		err := setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "embeds": embeds})
+		require.ErrorContains(t, err, "at most 6000 are allowed")
+	})
+
+	t.Run("embeds with expressions are not checked", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"destination": DestinationWebhook, "webhookUrl": webhook, "embeds": "{{ $.data.embeds }}"}))
+	})
+
+	t.Run("webhook destination without webhook URL -> error", func(t *testing.T) {
+		err := setup(map[string]any{"destination": DestinationWebhook, "content": "Deployed"})
+		require.ErrorContains(t, err, "webhook URL is required")
+	})
+
+	t.Run("channel destination without channel -> error", func(t *testing.T) {
+		err := setup(map[string]any{"destination": DestinationChannel, "content": "Deployed"})
+		require.ErrorContains(t, err, "channel is required")
+	})
+
+	t.Run("valid channel destination -> stores metadata", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			assert.Contains(t, req.URL.String(), "/channels/123456789")
+			return jsonResponse(http.StatusOK, `{"id": "123456789", "name": "releases", "type": 0}`), nil
+		})
+
+		metadata := &contexts.MetadataContext{}
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Configuration: map[string]any{"botToken": "test-bot-token"}},
+			Metadata:      metadata,
+			Configuration: map[string]any{"channel": "123456789", "embeds": `[{"title": "Deployed"}]`},
+		})
+
+		require.NoError(t, err)
+		stored, ok := metadata.Metadata.(PostMessageMetadata)
+		require.True(t, ok)
+		require.NotNil(t, stored.Channel)
+		assert.Equal(t, "releases", stored.Channel.Name)
+	})
+}
+
+func Test__PostMessage__Execute(t *testing.T) {
+	component := &PostMessage{}
+
+	t.Run("webhook destination -> posts through the webhook and emits the message", func(t *testing.T) {
+		var payload CreateMessageRequest
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://discord.com/api/webhooks/1/abc?wait=true", req.URL.String())
+			assert.Empty(t, req.Header.Get("Authorization"))
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &payload))
+			return jsonResponse(http.StatusOK, testMessageResponse), nil
+		})
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{},
+			ExecutionState: execState,
+			Secrets: &contexts.SecretsContext{Values: map[string]map[string][]byte{
+				"discord": {"webhookUrl": []byte("https://discord.com/api/webhooks/1/abc")},
+			}},
+			Configuration: map[string]any{
+				"destination": DestinationWebhook,
+				"webhookUrl":  map[string]any{"secret": "discord", "key": "webhookUrl"},
+				"content":     "Deployed",
+				"embeds":      `[{"title": "v1.4.0", "fields": [{"name": "Environment", "value": "production"}]}]`,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Deployed", payload.Content)
+		require.Len(t, payload.Embeds, 1)
+		assert.Equal(t, "v1.4.0", payload.Embeds[0].Title)
+		require.Len(t, payload.Embeds[0].Fields, 1)
+
+		assert.Equal(t, "discord.message.sent", execState.Type)
+		require.Len(t, execState.Payloads, 1)
+		data := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "1234567890", data["id"])
+	})
+
+	t.Run("missing webhook secret -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Secrets:        &contexts.SecretsContext{},
+			Configuration: map[string]any{
+				"destination": DestinationWebhook,
+				"webhookUrl":  map[string]any{"secret": "discord", "key": "webhookUrl"},
+				"content":     "Deployed",
+			},
+		})
+
+		require.ErrorContains(t, err, "failed to resolve webhook URL")
+	})
+
+	t.Run("webhook URL outside of Discord -> error without sending", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("unexpected request to %s", req.URL.String())
+		})
+
+		for _, webhookURL := range []string{
+			"http://discord.com/api/webhooks/1/abc",
+			"https://example.com/api/webhooks/1/abc",
+			"https://discord.com.example.com/api/webhooks/1/abc",
+			"https://discord.com:8443/api/webhooks/1/abc",
+			"https://discord.com/api/v10/users/@me",
+		} {
+			err := component.Execute(core.ExecutionContext{
+				Integration:    &contexts.IntegrationContext{},
+				ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+				Secrets: &contexts.SecretsContext{Values: map[string]map[string][]byte{
+					"discord": {"webhookUrl": []byte(webhookURL)},
+				}},
+				Configuration: map[string]any{
+					"destination": DestinationWebhook,
+					"webhookUrl":  map[string]any{"secret": "discord", "key": "webhookUrl"},
+					"content":     "Deployed",
+				},
+			})
+
+			require.ErrorContains(t, err, "webhook URL must be", webhookURL)
+		}
+	})
+
+	t.Run("rate limited -> retries after the time Discord asks for", func(t *testing.T) {
+		waits := withSleep(t)
+		attempts := 0
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				response := jsonResponse(http.StatusTooManyRequests, `{"message": "You are being rate limited.", "retry_after": 1.5}`)
+				response.Header.Set("Retry-After", "2")
+				return response, nil
+			}
+
+			if attempts == 2 {
+				return jsonResponse(http.StatusTooManyRequests, `{"message": "You are being rate limited.", "retry_after": 0.25}`), nil
+			}
+
+			return jsonResponse(http.StatusOK, testMessageResponse), nil
+		})
+
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{Configuration: map[string]any{"botToken": "test-bot-token"}},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"channel": "123456789", "content": "Deployed"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []time.Duration{2 * time.Second, 250 * time.Millisecond}, *waits)
+	})
+
+	t.Run("still rate limited after all retries -> error", func(t *testing.T) {
+		waits := withSleep(t)
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusTooManyRequests, `{"retry_after": 1}`), nil
+		})
+
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{Configuration: map[string]any{"botToken": "test-bot-token"}},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"channel": "123456789", "content": "Deployed"},
+		})
+
+		require.ErrorContains(t, err, "status 429")
+		assert.Len(t, *waits, MaxRateLimitRetries)
+	})
+
+	t.Run("retries over the total wait limit -> error", func(t *testing.T) {
+		waits := withSleep(t)
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusTooManyRequests, `{"retry_after": 4}`), nil
+		})
+
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{Configuration: map[string]any{"botToken": "test-bot-token"}},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"channel": "123456789", "content": "Deployed"},
+		})
+
+		require.ErrorContains(t, err, "status 429")
+		assert.Equal(t, []time.Duration{4 * time.Second, 4 * time.Second}, *waits)
+	})
+
+	t.Run("retry after too long -> error without waiting", func(t *testing.T) {
+		waits := withSleep(t)
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusTooManyRequests, `{"retry_after": 3600}`), nil
+		})
+
+		err := component.Execute(core.ExecutionContext{
+			Integration:    &contexts.IntegrationContext{Configuration: map[string]any{"botToken": "test-bot-token"}},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"channel": "123456789", "content": "Deployed"},
+		})
+
+		require.ErrorContains(t, err, "status 429")
+		assert.Empty(t, *waits)
+	})
+}
@@ -240,17 +240,7 @@ func (c *SendTextMessage) Execute(ctx core.ExecutionContext) error {
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		"discord.message.sent",
-		[]any{map[string]any{
-			"id":         response.ID,
-			"channel_id": response.ChannelID,
-			"content":    response.Content,
-			"timestamp":  response.Timestamp,
-			"author": map[string]any{
-				"id":       response.Author.ID,
-				"username": response.Author.Username,
-				"bot":      response.Author.Bot,
-			},
-		}},
+		[]any{messagePayload(response)},
 	)
 }
 
@@ -1,4 +1,4 @@
-package smtp
+package email
 
 import (
 	"crypto/rand"
@@ -41,6 +41,7 @@ type SendResult struct {
 	Cc        []string  `json:"cc,omitempty"`
 	Bcc       []string  `json:"bcc,omitempty"`
 	Subject   string    `json:"subject"`
+	MessageID string    `json:"messageId"`
 	SentAt    time.Time `json:"sentAt"`
 	FromEmail string    `json:"fromEmail"`
 }
@@ -195,8 +196,13 @@ func (c *Client) SendEmail(email Email) (*SendResult, error) {
 		return nil, fmt.Errorf("at least one recipient is required")
 	}
 
+	messageID, err := newMessageID(fromEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate message ID: %w", err)
+	}
+
 	// Build message
-	message, err := c.buildMessage(email, fromName, fromEmail)
+	message, err := c.buildMessage(email, fromName, fromEmail, messageID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build message: %w", err)
 	}
@@ -241,6 +247,7 @@ func (c *Client) SendEmail(email Email) (*SendResult, error) {
 		Cc:        email.Cc,
 		Bcc:       email.Bcc,
 		Subject:   email.Subject,
+		MessageID: messageID,
 		SentAt:    time.Now().UTC(),
 		FromEmail: fromEmail,
 	}, nil
@@ -286,7 +293,7 @@ func (c *Client) connect() (smtpClient, error) {
 	return conn, nil
 }
 
-func (c *Client) buildMessage(email Email, fromName, fromEmail string) (string, error) {
+func (c *Client) buildMessage(email Email, fromName, fromEmail, messageID string) (string, error) {
 	boundary, err := randomBoundary()
 	if err != nil {
 		return "", err
@@ -302,6 +309,8 @@ func (c *Client) buildMessage(email Email, fromName, fromEmail string) (string,
 	headers := []string{
 		fmt.Sprintf("From: %s", from),
 		fmt.Sprintf("Subject: %s", email.Subject),
+		fmt.Sprintf("Message-ID: %s", messageID),
+		fmt.Sprintf("Date: %s", time.Now().UTC().Format(time.RFC1123Z)),
 		"MIME-Version: 1.0",
 		fmt.Sprintf("Content-Type: multipart/alternative; boundary=\"%s\"", boundary),
 	}
@@ -349,6 +358,21 @@ func (c *Client) buildMessage(email Email, fromName, fromEmail string) (string,
 	return message, nil
 }
 
+// newMessageID generates a unique Message-ID, using the domain of the sender
+func newMessageID(fromEmail string) (string, error) {
+	id, err := randomBoundary()
+	if err != nil {
+		return "", err
+	}
+
+	domain := "superplane.local"
+	if at := strings.LastIndex(fromEmail, "@"); at >= 0 && at < len(fromEmail)-1 {
+		domain = fromEmail[at+1:]
+	}
+
+	return fmt.Sprintf("<%s@%s>", id, domain), nil
+}
+
 func randomBoundary() (string, error) {
 	b := make([]byte, 12)
 	if _, err := rand.Read(b); err != nil {
@@ -1,4 +1,4 @@
-package smtp
+package email
 
 import (
 	"fmt"
@@ -9,20 +9,28 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+)
+
+const (
+	BodyFormatText     = "text"
+	BodyFormatTemplate = "template"
 )
 
 type SendEmail struct{}
 
 type SendEmailConfiguration struct {
-	To        string `json:"to" mapstructure:"to"`
-	Cc        string `json:"cc" mapstructure:"cc"`
-	Bcc       string `json:"bcc" mapstructure:"bcc"`
-	Subject   string `json:"subject" mapstructure:"subject"`
-	Body      string `json:"body" mapstructure:"body"`
-	IsHTML    bool   `json:"isHTML" mapstructure:"isHTML"`
-	FromName  string `json:"fromName" mapstructure:"fromName"`
-	FromEmail string `json:"fromEmail" mapstructure:"fromEmail"`
-	ReplyTo   string `json:"replyTo" mapstructure:"replyTo"`
+	To           string `json:"to" mapstructure:"to"`
+	Cc           string `json:"cc" mapstructure:"cc"`
+	Bcc          string `json:"bcc" mapstructure:"bcc"`
+	Subject      string `json:"subject" mapstructure:"subject"`
+	BodyFormat   string `json:"bodyFormat" mapstructure:"bodyFormat"`
+	Body         string `json:"body" mapstructure:"body"`
+	BodyTemplate string `json:"bodyTemplate" mapstructure:"bodyTemplate"`
+	IsHTML       bool   `json:"isHTML" mapstructure:"isHTML"`
+	FromName     string `json:"fromName" mapstructure:"fromName"`
+	FromEmail    string `json:"fromEmail" mapstructure:"fromEmail"`
+	ReplyTo      string `json:"replyTo" mapstructure:"replyTo"`
 }
 
 type SendEmailMetadata struct {
@@ -58,7 +66,12 @@ func (c *SendEmail) Documentation() string {
 - **CC**: Carbon copy recipients (optional, comma-separated)
 - **BCC**: Blind carbon copy recipients (optional, comma-separated)
 - **Subject**: Email subject line (supports expressions)
+- **Body Format**: How the body is written:
+  - **Text**: The body is plain text or HTML, with expressions
+  - **Template**: The body is a Go template, rendered against the input event.
+    For example, ` + "`{{ range .data.failures }}- {{ .name }}{{ end }}`" + `. The ` + "`json`" + `, ` + "`upper`" + `, ` + "`lower`" + `, ` + "`truncate`" + `, and ` + "`date`" + ` functions are available
 - **Body**: Email body content (supports expressions and HTML)
+- **Body Template**: Email body template. Template syntax errors are reported when the node is saved
 - **Is HTML**: Toggle to send HTML-formatted emails
 - **From Name**: Sender display name (optional, uses app default if not specified)
 - **From Email**: Sender email address (optional, uses app default if not specified)
@@ -74,7 +87,7 @@ The SMTP server must be configured in the application settings before using this
 
 ## Output
 
-Returns metadata about the sent email including recipients and subject.`
+Returns metadata about the sent email including recipients, subject, and the ` + "`messageId`" + ` of the email.`
 }
 
 func (c *SendEmail) Icon() string {
@@ -119,12 +132,45 @@ func (c *SendEmail) Configuration() []configuration.Field {
 			Required:    true,
 			Description: "Email subject line",
 		},
+		{
+			Name:     "bodyFormat",
+			Label:    "Body Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: false,
+			Default:  BodyFormatText,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Text", Value: BodyFormatText},
+						{Label: "Template", Value: BodyFormatTemplate},
+					},
+				},
+			},
+		},
 		{
 			Name:        "body",
 			Label:       "Body",
 			Type:        configuration.FieldTypeText,
-			Required:    true,
 			Description: "Email body content",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatText}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatText}},
+			},
+		},
+		{
+			Name:               "bodyTemplate",
+			Label:              "Body Template",
+			Type:               configuration.FieldTypeText,
+			DisallowExpression: true,
+			Description:        "Go template rendered against the input event",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatTemplate}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatTemplate}},
+			},
 		},
 		{
 			Name:        "isHTML",
@@ -172,7 +218,15 @@ func (c *SendEmail) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("subject is required")
 	}
 
-	if config.Body == "" {
+	if config.BodyFormat == BodyFormatTemplate {
+		if config.BodyTemplate == "" {
+			return fmt.Errorf("body template is required")
+		}
+
+		if _, err := template.Parse("bodyTemplate", config.BodyTemplate); err != nil {
+			return err
+		}
+	} else if config.Body == "" {
 		return fmt.Errorf("body is required")
 	}
 
@@ -232,7 +286,12 @@ func (c *SendEmail) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("subject is required")
 	}
 
-	if config.Body == "" {
+	body, err := c.buildBody(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	if body == "" {
 		return fmt.Errorf("body is required")
 	}
 
@@ -248,9 +307,9 @@ func (c *SendEmail) Execute(ctx core.ExecutionContext) error {
 	// Set text/html body based on isHTML flag
 	var textBody, htmlBody string
 	if config.IsHTML {
-		htmlBody = config.Body
+		htmlBody = body
 	} else {
-		textBody = config.Body
+		textBody = body
 	}
 
 	email := Email{
@@ -277,6 +336,14 @@ func (c *SendEmail) Execute(ctx core.ExecutionContext) error {
 	)
 }
 
+func (c *SendEmail) buildBody(ctx core.ExecutionContext, config SendEmailConfiguration) (string, error) {
+	if config.BodyFormat != BodyFormatTemplate {
+		return config.Body, nil
+	}
+
+	return template.Render("bodyTemplate", config.BodyTemplate, ctx.Data)
+}
+
 func (c *SendEmail) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 	return 200, nil
 }
@@ -1,4 +1,4 @@
-package smtp
+package email
 
 import (
 	"crypto/tls"
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
 	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
@@ -66,6 +67,21 @@ func Test__SendEmail__Setup(t *testing.T) {
 		require.ErrorContains(t, err, "body is required")
 	})
 
+	t.Run("invalid body template -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"to":           "test@example.com",
+				"subject":      "Test Subject",
+				"bodyFormat":   BodyFormatTemplate,
+				"bodyTemplate": "Failed: {{ .data.name",
+			},
+		})
+
+		require.ErrorIs(t, err, template.ErrInvalidTemplate)
+	})
+
 	t.Run("invalid email in to -> error", func(t *testing.T) {
 		err := component.Setup(core.SetupContext{
 			Integration: &contexts.IntegrationContext{},
@@ -191,6 +207,45 @@ func Test__SendEmail__Execute(t *testing.T) {
 		assert.Contains(t, emailContent, "To: recipient@example.com")
 		assert.Contains(t, emailContent, "Subject: Test Subject")
 		assert.Contains(t, emailContent, "Hello, this is a test message.")
+
+		result, ok := execState.Payloads[0].(map[string]any)["data"].(*SendResult)
+		require.True(t, ok)
+		assert.Regexp(t, `^<[0-9a-f]+@example\.com>$`, result.MessageID)
+		assert.Contains(t, emailContent, "Message-ID: "+result.MessageID)
+	})
+
+	t.Run("body template -> renders it against the input", func(t *testing.T) {
+		sentData := &strings.Builder{}
+		mockClient := &fakeSMTPClient{dataWriter: sentData}
+
+		originalDial := smtpDial
+		smtpDial = func(addr string) (smtpClient, error) {
+			return mockClient, nil
+		}
+		defer func() { smtpDial = originalDial }()
+
+		err := component.Execute(core.ExecutionContext{
+			Integration: &contexts.IntegrationContext{
+				Configuration: map[string]any{
+					"host":      "smtp.example.com",
+					"port":      "587",
+					"fromEmail": "sender@example.com",
+					"useTLS":    "false",
+				},
+			},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Data:           map[string]any{"data": map[string]any{"failures": []any{"api", "worker"}}},
+			Configuration: map[string]any{
+				"to":           "ops@example.com, oncall@example.com",
+				"subject":      "Daily digest",
+				"bodyFormat":   BodyFormatTemplate,
+				"bodyTemplate": "Failed: {{ range .data.failures }}{{ upper . }} {{ end }}",
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ops@example.com", "oncall@example.com"}, mockClient.rcptTo)
+		assert.Contains(t, sentData.String(), "Failed: API WORKER")
 	})
 
 	t.Run("SMTP connection failure -> returns error", func(t *testing.T) {
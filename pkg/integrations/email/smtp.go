@@ -1,4 +1,4 @@
-package smtp
+package email
 
 import (
 	"fmt"
@@ -10,6 +10,11 @@ import (
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
+/*
+ * The email integration sends email through an SMTP server.
+ * It is registered as "smtp", the name it had before it was moved here,
+ * so existing integrations and smtp.sendEmail nodes keep working.
+ */
 func init() {
 	registry.RegisterIntegration("smtp", &SMTP{})
 }
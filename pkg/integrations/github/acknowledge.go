@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	AcknowledgeReaction = "eyes"
+
+	/*
+	 * The reaction is posted while GitHub waits for the webhook response,
+	 * and GitHub gives up on deliveries after 10 seconds.
+	 */
+	AcknowledgeTimeout = 5 * time.Second
+)
+
+var AutoAcknowledgeField = configuration.Field{
+	Name:        "autoAcknowledge",
+	Label:       "Auto Acknowledge",
+	Type:        configuration.FieldTypeBool,
+	Default:     false,
+	Description: "Add an eyes reaction to the comment as soon as it is received",
+}
+
+/*
+ * Reacting to the comment is best effort:
+ * the event was already accepted, so a failure is only logged.
+ */
+func acknowledgeComment(ctx core.WebhookRequestContext, data map[string]any) {
+	logger := log.WithFields(log.Fields{"workflow_id": ctx.WorkflowID, "node_id": ctx.NodeID})
+	if ctx.Integration == nil {
+		logger.Warn("Cannot acknowledge comment - node has no integration")
+		return
+	}
+
+	err := postAcknowledgeReaction(ctx.Integration, integrationTransport(ctx.Integration), data)
+	if err != nil {
+		logger.Warnf("Failed to acknowledge comment: %v", err)
+	}
+}
+
+func postAcknowledgeReaction(integration core.IntegrationContext, transport http.RoundTripper, data map[string]any) error {
+	var event struct {
+		Comment struct {
+			ID int64 `mapstructure:"id"`
+		} `mapstructure:"comment"`
+		Repository struct {
+			Name  string `mapstructure:"name"`
+			Owner struct {
+				Login string `mapstructure:"login"`
+			} `mapstructure:"owner"`
+		} `mapstructure:"repository"`
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{Result: &event, WeaklyTypedInput: true})
+	if err != nil {
+		return err
+	}
+
+	if err := decoder.Decode(data); err != nil {
+		return fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	if event.Comment.ID == 0 || event.Repository.Name == "" || event.Repository.Owner.Login == "" {
+		return errors.New("event has no comment ID or repository")
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	reactionCtx, cancel := context.WithTimeout(context.Background(), AcknowledgeTimeout)
+	defer cancel()
+
+	_, _, err = client.Reactions.CreateIssueCommentReaction(
+		reactionCtx,
+		event.Repository.Owner.Login,
+		event.Repository.Name,
+		event.Comment.ID,
+		AcknowledgeReaction,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to react to comment %d: %w", event.Comment.ID, wrapGitHubError(err))
+	}
+
+	return nil
+}
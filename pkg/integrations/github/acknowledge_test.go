@@ -0,0 +1,51 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__PostAcknowledgeReaction(t *testing.T) {
+	data := map[string]any{
+		"comment":    map[string]any{"id": float64(1001)},
+		"repository": map[string]any{"name": "hello", "owner": map[string]any{"login": "testhq"}},
+	}
+
+	reactionTransport := func(status int) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/issues/comments/1001/reactions":
+				return mockResponse(status, `{"id":1,"content":"eyes"}`), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	integration := testInstallation(t, "testhq")
+
+	t.Run("eyes reaction is posted to the comment", func(t *testing.T) {
+		transport := reactionTransport(http.StatusCreated)
+		require.NoError(t, postAcknowledgeReaction(integration, transport, data))
+
+		last := transport.requests[len(transport.requests)-1]
+		assert.Equal(t, http.MethodPost, last.Method)
+		assert.Equal(t, "/repos/testhq/hello/issues/comments/1001/reactions", last.URL.Path)
+	})
+
+	t.Run("event without a comment -> error", func(t *testing.T) {
+		transport := reactionTransport(http.StatusCreated)
+		err := postAcknowledgeReaction(integration, transport, map[string]any{"repository": data["repository"]})
+		require.ErrorContains(t, err, "event has no comment ID or repository")
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("reaction fails -> error", func(t *testing.T) {
+		err := postAcknowledgeReaction(integration, reactionTransport(http.StatusForbidden), data)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
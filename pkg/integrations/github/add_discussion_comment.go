@@ -0,0 +1,281 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type AddDiscussionComment struct{}
+
+type AddDiscussionCommentConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	DiscussionNumber string `json:"discussionNumber" mapstructure:"discussionNumber"`
+	Body             string `json:"body" mapstructure:"body"`
+	ReplyToID        string `json:"replyToId" mapstructure:"replyToId"`
+}
+
+func (c *AddDiscussionComment) Name() string {
+	return "github.addDiscussionComment"
+}
+
+func (c *AddDiscussionComment) Label() string {
+	return "Add Discussion Comment"
+}
+
+func (c *AddDiscussionComment) Description() string {
+	return "Add a comment to a GitHub discussion"
+}
+
+func (c *AddDiscussionComment) Documentation() string {
+	return `The Add Discussion Comment component adds a comment to a GitHub discussion, or replies to an existing comment.
+
+## Use Cases
+
+- **Announcements**: Post release or incident updates to a discussion
+- **Bots**: Reply to questions asked in discussions
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Discussion Number**: The discussion number (supports expressions)
+- **Body**: The comment body (supports markdown and expressions)
+- **Reply To ID**: Optional node ID of the comment to reply to, for threaded replies
+
+## Output
+
+Emits the ` + "`id`" + ` and ` + "`url`" + ` of the created comment.
+
+## Notes
+
+- Comments cannot be added to locked discussions. In that case, the execution fails with a distinct error`
+}
+
+func (c *AddDiscussionComment) Icon() string {
+	return "github"
+}
+
+func (c *AddDiscussionComment) Color() string {
+	return "gray"
+}
+
+func (c *AddDiscussionComment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *AddDiscussionComment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "discussionNumber",
+			Label:       "Discussion Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.discussion.number}}",
+		},
+		{
+			Name:     "body",
+			Label:    "Body",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		{
+			Name:        "replyToId",
+			Label:       "Reply To ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.comment.node_id}}",
+			Description: "Node ID of the discussion comment to reply to",
+		},
+		EventTypeField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *AddDiscussionComment) Setup(ctx core.SetupContext) error {
+	var config AddDiscussionCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.DiscussionNumber == "" {
+		return errors.New("discussion number is required")
+	}
+
+	if config.Body == "" {
+		return errors.New("body is required")
+	}
+
+	if err := validateEventType(ctx.Configuration); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *AddDiscussionComment) Execute(ctx core.ExecutionContext) error {
+	var config AddDiscussionCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	discussionNumber, err := strconv.Atoi(config.DiscussionNumber)
+	if err != nil {
+		return fmt.Errorf("discussion number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, eventType(ctx.Configuration, "github.discussionComment"), func() (any, error) {
+		discussion, err := c.findDiscussion(client, appMetadata.Owner, config.Repository, discussionNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		var mutation struct {
+			AddDiscussionComment struct {
+				Comment struct {
+					ID  githubv4.ID
+					URL githubv4.URI
+				}
+			} `graphql:"addDiscussionComment(input: $input)"`
+		}
+
+		input := githubv4.AddDiscussionCommentInput{
+			DiscussionID: discussion.ID,
+			Body:         githubv4.String(config.Body),
+		}
+
+		if config.ReplyToID != "" {
+			replyToID := githubv4.ID(config.ReplyToID)
+			input.ReplyToID = &replyToID
+		}
+
+		err = client.Mutate(context.Background(), &mutation, input, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add discussion comment: %w", err)
+		}
+
+		comment := mutation.AddDiscussionComment.Comment
+		output := map[string]any{
+			"id":               comment.ID,
+			"discussionNumber": discussionNumber,
+		}
+
+		if comment.URL.URL != nil {
+			output["url"] = comment.URL.String()
+		}
+
+		if config.ReplyToID != "" {
+			output["replyToId"] = config.ReplyToID
+		}
+
+		return output, nil
+	})
+}
+
+type discussionRef struct {
+	ID     githubv4.ID
+	Locked bool
+}
+
+/*
+ * The mutation needs the node ID of the discussion.
+ * Locked discussions are rejected here, with a clearer error than the one GitHub returns.
+ */
+func (c *AddDiscussionComment) findDiscussion(client *githubv4.Client, owner, repository string, number int) (*discussionRef, error) {
+	var query struct {
+		Repository struct {
+			Discussion *discussionRef `graphql:"discussion(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find discussion %d: %w", number, err)
+	}
+
+	return checkDiscussion(query.Repository.Discussion, number)
+}
+
+func checkDiscussion(discussion *discussionRef, number int) (*discussionRef, error) {
+	if discussion == nil || discussion.ID == nil {
+		return nil, fmt.Errorf("%w: discussion %d", ErrNotFound, number)
+	}
+
+	if discussion.Locked {
+		return nil, fmt.Errorf("%w: discussion %d is locked, so comments cannot be added to it", ErrLocked, number)
+	}
+
+	return discussion, nil
+}
+
+func (c *AddDiscussionComment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *AddDiscussionComment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *AddDiscussionComment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *AddDiscussionComment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *AddDiscussionComment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *AddDiscussionComment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
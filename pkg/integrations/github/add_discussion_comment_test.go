@@ -0,0 +1,66 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AddDiscussionComment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := AddDiscussionComment{}
+
+	t.Run("discussion number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "body": "Hello"},
+		})
+
+		require.ErrorContains(t, err, "discussion number is required")
+	})
+
+	t.Run("body is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "discussionNumber": "12"},
+		})
+
+		require.ErrorContains(t, err, "body is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "discussionNumber": "12", "body": "Hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__AddDiscussionComment__CheckDiscussion(t *testing.T) {
+	t.Run("missing discussion -> not found", func(t *testing.T) {
+		_, err := checkDiscussion(nil, 12)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("locked discussion -> locked", func(t *testing.T) {
+		_, err := checkDiscussion(&discussionRef{ID: githubv4.ID("D_1"), Locked: true}, 12)
+		require.ErrorIs(t, err, ErrLocked)
+		assert.Contains(t, err.Error(), "discussion 12 is locked")
+	})
+
+	t.Run("open discussion -> returned", func(t *testing.T) {
+		discussion, err := checkDiscussion(&discussionRef{ID: githubv4.ID("D_1")}, 12)
+		require.NoError(t, err)
+		assert.Equal(t, githubv4.ID("D_1"), discussion.ID)
+	})
+}
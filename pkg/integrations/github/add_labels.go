@@ -0,0 +1,395 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	LabelCreated  = "created"
+	LabelUpdated  = "updated"
+	LabelExisting = "existing"
+)
+
+var labelColorRegex = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+type AddLabels struct{}
+
+type AddLabelsConfiguration struct {
+	MultiRepositoryConfiguration `mapstructure:",squash"`
+	Labels                       []LabelDefinition `json:"labels" mapstructure:"labels"`
+	StrictLabels                 bool              `json:"strictLabels" mapstructure:"strictLabels"`
+}
+
+type LabelDefinition struct {
+	Name        string `json:"name" mapstructure:"name"`
+	Color       string `json:"color" mapstructure:"color"`
+	Description string `json:"description" mapstructure:"description"`
+}
+
+type LabelResult struct {
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+	Status string `json:"status"`
+	URL    string `json:"url"`
+}
+
+func (c *AddLabels) Name() string {
+	return "github.addLabels"
+}
+
+func (c *AddLabels) Label() string {
+	return "Add Labels"
+}
+
+func (c *AddLabels) Description() string {
+	return "Add labels to one or more GitHub repositories"
+}
+
+func (c *AddLabels) Documentation() string {
+	return `The Add Labels component makes sure labels exist in one or more repositories.
+
+## Use Cases
+
+- **Org-wide labels**: Add the same set of labels to every repository of a team
+- **Repository bootstrap**: Add the standard labels to new repositories
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Repositories**: Select several repositories instead of a single one
+- **Labels**: The labels to add, each with a name, and an optional color and description
+- **Strict Labels**: Only use labels that already exist. Missing labels are not created, and the repository fails with the list of missing labels
+- **Max Repositories**: If more repositories than this are selected, nothing is changed and the execution fails. Defaults to 10
+
+## Output
+
+Emits the ` + "`results`" + ` for each repository, with the labels that were ` + "`created`" + `, or ` + "`updated`" + ` if they already existed.
+With **Strict Labels**, existing labels with no color or description configured are left as they are, and reported as ` + "`existing`" + `.
+Failed repositories have the ` + "`error`" + ` instead.
+` + "`succeeded_count`" + ` and ` + "`failed_count`" + ` summarize the results.
+
+## Notes
+
+- Failing in one repository does not stop the other repositories from being processed. The execution only fails if every repository failed
+- Existing labels keep their color and description, unless a new one is configured
+- With multiple repositories, executions lock the whole owner, unless a concurrency key is configured`
+}
+
+func (c *AddLabels) Icon() string {
+	return "github"
+}
+
+func (c *AddLabels) Color() string {
+	return "gray"
+}
+
+func (c *AddLabels) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *AddLabels) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:  "repository",
+			Label: "Repository",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		RepositoriesField,
+		{
+			Name:     "labels",
+			Label:    "Labels",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Label",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Label:    "Name",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:        "color",
+								Label:       "Color",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "e.g., d73a4a",
+								Description: "Hex color, without the leading #",
+							},
+							{
+								Name:  "description",
+								Label: "Description",
+								Type:  configuration.FieldTypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "strictLabels",
+			Label:       "Strict Labels",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Fail instead of creating labels that do not exist",
+		},
+		MaxRepositoriesField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *AddLabels) Setup(ctx core.SetupContext) error {
+	var config AddLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateLabels(config.Labels); err != nil {
+		return err
+	}
+
+	return ensureReposInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateLabels(labels []LabelDefinition) error {
+	if len(labels) == 0 {
+		return errors.New("at least one label is required")
+	}
+
+	for _, label := range labels {
+		if strings.TrimSpace(label.Name) == "" {
+			return errors.New("label name is required")
+		}
+
+		color := strings.TrimPrefix(label.Color, "#")
+		if color != "" && !isExpression(color) && !labelColorRegex.MatchString(color) {
+			return fmt.Errorf("invalid color for label %s: %s", label.Name, label.Color)
+		}
+	}
+
+	return nil
+}
+
+func (c *AddLabels) Execute(ctx core.ExecutionContext) error {
+	var config AddLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	repositories, err := targetRepositories(config.MultiRepositoryConfiguration)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output := forEachRepository(ctx.Logger, repositories, func(repository string) (any, error) {
+		if config.StrictLabels {
+			return addExistingLabels(client, appMetadata.Owner, repository, config.Labels)
+		}
+
+		return addLabels(client, appMetadata.Owner, repository, config.Labels)
+	})
+
+	if output.SucceededCount == 0 {
+		return fmt.Errorf("failed to add labels to all %d repositories: %s", output.FailedCount, output.Results[0].Error)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.labels",
+		[]any{output},
+	)
+}
+
+func addLabels(client *github.Client, owner, repository string, labels []LabelDefinition) ([]LabelResult, error) {
+	results := make([]LabelResult, 0, len(labels))
+	for _, definition := range labels {
+		result, err := addLabel(client, owner, repository, definition)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+/*
+ * Creates the label, or updates it if it already exists.
+ */
+func addLabel(client *github.Client, owner, repository string, definition LabelDefinition) (*LabelResult, error) {
+	label := newLabel(definition)
+	created, _, err := client.Issues.CreateLabel(context.Background(), owner, repository, label)
+	if err == nil {
+		return labelResult(created, LabelCreated), nil
+	}
+
+	if !isAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create label %s: %w", label.GetName(), wrapGitHubError(err))
+	}
+
+	return updateLabel(client, owner, repository, label)
+}
+
+func updateLabel(client *github.Client, owner, repository string, label *github.Label) (*LabelResult, error) {
+	updated, _, err := client.Issues.EditLabel(context.Background(), owner, repository, label.GetName(), label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update label %s: %w", label.GetName(), wrapGitHubError(err))
+	}
+
+	return labelResult(updated, LabelUpdated), nil
+}
+
+func newLabel(definition LabelDefinition) *github.Label {
+	label := &github.Label{Name: github.Ptr(strings.TrimSpace(definition.Name))}
+	if color := strings.TrimPrefix(definition.Color, "#"); color != "" {
+		label.Color = &color
+	}
+
+	if definition.Description != "" {
+		label.Description = &definition.Description
+	}
+
+	return label
+}
+
+/*
+ * Checks that every label exists before changing any of them,
+ * so a repository with missing labels is left untouched.
+ */
+func addExistingLabels(client *github.Client, owner, repository string, labels []LabelDefinition) ([]LabelResult, error) {
+	existing := make([]*github.Label, 0, len(labels))
+	missing := []string{}
+	for _, definition := range labels {
+		name := strings.TrimSpace(definition.Name)
+		label, response, err := client.Issues.GetLabel(context.Background(), owner, repository, name)
+		if isNotFound(response) {
+			missing = append(missing, name)
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get label %s: %w", name, wrapGitHubError(err))
+		}
+
+		existing = append(existing, label)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("labels do not exist in %s: %s", repository, strings.Join(missing, ", "))
+	}
+
+	results := make([]LabelResult, 0, len(labels))
+	for i, definition := range labels {
+		if definition.Color == "" && definition.Description == "" {
+			results = append(results, *labelResult(existing[i], LabelExisting))
+			continue
+		}
+
+		result, err := updateLabel(client, owner, repository, newLabel(definition))
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+func isAlreadyExists(err error) bool {
+	var responseErr *github.ErrorResponse
+	if !errors.As(err, &responseErr) || responseErr.Response == nil {
+		return false
+	}
+
+	if responseErr.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+
+	for _, e := range responseErr.Errors {
+		if e.Code == "already_exists" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func labelResult(label *github.Label, status string) *LabelResult {
+	return &LabelResult{
+		Name:   label.GetName(),
+		Color:  label.GetColor(),
+		Status: status,
+		URL:    label.GetURL(),
+	}
+}
+
+func (c *AddLabels) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *AddLabels) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *AddLabels) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *AddLabels) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *AddLabels) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *AddLabels) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
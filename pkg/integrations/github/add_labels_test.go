@@ -0,0 +1,171 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AddLabels__Setup(t *testing.T) {
+	component := AddLabels{}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{{Name: "api"}, {Name: "web"}}}}
+	labels := []any{map[string]any{"name": "needs-triage", "color": "#fbca04"}}
+
+	t.Run("labels are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api"},
+		})
+
+		require.ErrorContains(t, err, "at least one label is required")
+	})
+
+	t.Run("invalid color -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "labels": []any{map[string]any{"name": "bug", "color": "red"}}},
+		})
+
+		require.ErrorContains(t, err, "invalid color for label bug")
+	})
+
+	t.Run("repository or repositories are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"labels": labels},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("multiple repositories", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repositories": []any{"api", "web"}, "labels": labels},
+		}))
+	})
+}
+
+func Test__AddLabels__AddLabel(t *testing.T) {
+	definition := LabelDefinition{Name: "needs-triage", Color: "#fbca04", Description: "Needs a look"}
+
+	t.Run("label is created", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"name":"needs-triage","color":"fbca04","description":"Needs a look"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"name":"needs-triage","color":"fbca04"}`), nil
+		}}
+
+		result, err := addLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", definition)
+		require.NoError(t, err)
+		assert.Equal(t, LabelCreated, result.Status)
+		assert.Equal(t, "/repos/testhq/api/labels", transport.requests[0].URL.Path)
+	})
+
+	t.Run("existing label is updated", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodPost {
+				return mockResponse(http.StatusUnprocessableEntity, `{"message":"Validation Failed","errors":[{"resource":"Label","code":"already_exists","field":"name"}]}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"needs-triage","color":"fbca04"}`), nil
+		}}
+
+		result, err := addLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", definition)
+		require.NoError(t, err)
+		assert.Equal(t, LabelUpdated, result.Status)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodPatch, transport.requests[1].Method)
+		assert.Equal(t, "/repos/testhq/api/labels/needs-triage", transport.requests[1].URL.Path)
+	})
+
+	t.Run("other errors fail", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := addLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", definition)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Len(t, transport.requests, 1)
+	})
+}
+
+func Test__AddLabels__StrictLabels(t *testing.T) {
+	existingLabels := func() *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/api/labels/bug", "/repos/testhq/api/labels/needs-triage":
+				return mockResponse(http.StatusOK, `{"name":"`+path.Base(request.URL.Path)+`","color":"fbca04"}`), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+	}
+
+	t.Run("missing labels are listed and nothing is changed", func(t *testing.T) {
+		transport := existingLabels()
+		labels := []LabelDefinition{{Name: "bug", Color: "d73a4a"}, {Name: "p0"}, {Name: "needs-triage"}, {Name: "wontfix"}}
+
+		_, err := addExistingLabels(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", labels)
+		require.ErrorContains(t, err, "labels do not exist in api: p0, wontfix")
+		for _, request := range transport.requests {
+			assert.Equal(t, http.MethodGet, request.Method)
+		}
+	})
+
+	t.Run("existing labels are used without creating any", func(t *testing.T) {
+		transport := existingLabels()
+		labels := []LabelDefinition{{Name: "bug", Color: "d73a4a"}, {Name: "needs-triage"}}
+
+		results, err := addExistingLabels(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", labels)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, LabelUpdated, results[0].Status)
+		assert.Equal(t, LabelExisting, results[1].Status)
+		assert.Equal(t, "needs-triage", results[1].Name)
+
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPatch, transport.requests[2].Method)
+		assert.Equal(t, "/repos/testhq/api/labels/bug", transport.requests[2].URL.Path)
+	})
+}
+
+func Test__AddLabels__PartialFailure(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		switch request.URL.Path {
+		case "/repos/testhq/api/labels":
+			return mockResponse(http.StatusCreated, `{"name":"bug"}`), nil
+		case "/repos/testhq/web/labels":
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		default:
+			return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+		}
+	}}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	output := forEachRepository(log.NewEntry(log.StandardLogger()), []string{"api", "web"}, func(repository string) (any, error) {
+		return addLabels(client, "testhq", repository, []LabelDefinition{{Name: "bug"}})
+	})
+
+	assert.Equal(t, 1, output.SucceededCount)
+	assert.Equal(t, 1, output.FailedCount)
+	assert.Contains(t, output.Results[1].Error, ErrNotFound.Error())
+
+	data, err := json.Marshal(output)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"succeeded_count":1`)
+}
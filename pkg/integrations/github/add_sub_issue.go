@@ -0,0 +1,351 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type AddSubIssue struct{}
+
+type AddSubIssueConfiguration struct {
+	Repository        string `json:"repository" mapstructure:"repository"`
+	ParentIssueNumber string `json:"parentIssueNumber" mapstructure:"parentIssueNumber"`
+	ChildIssueNumber  string `json:"childIssueNumber" mapstructure:"childIssueNumber"`
+	Position          *int   `json:"position" mapstructure:"position"`
+}
+
+type SubIssueSummary struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	URL    string `json:"html_url"`
+}
+
+type AddSubIssueOutput struct {
+	Parent    SubIssueSummary   `json:"parent"`
+	Added     SubIssueSummary   `json:"added"`
+	SubIssues []SubIssueSummary `json:"sub_issues"`
+}
+
+func (c *AddSubIssue) Name() string {
+	return "github.addSubIssue"
+}
+
+func (c *AddSubIssue) Label() string {
+	return "Add Sub-Issue"
+}
+
+func (c *AddSubIssue) Description() string {
+	return "Add an issue as a sub-issue of another GitHub issue"
+}
+
+func (c *AddSubIssue) Documentation() string {
+	return `The Add Sub-Issue component links an issue to a parent issue, as one of its sub-issues.
+
+## Use Cases
+
+- **Epics**: Track the work of an epic as sub-issues of its issue
+- **Incident follow-ups**: Link follow-up issues to the incident issue they came from
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Parent Issue Number**: The number of the parent issue (supports expressions)
+- **Child Issue Number**: The number of the issue to add as a sub-issue (supports expressions)
+- **Position**: Optional position of the sub-issue in the list of the parent, starting at 1. Without it, the sub-issue is added last
+
+## Output
+
+Returns the ` + "`parent`" + ` issue, the ` + "`added`" + ` sub-issue, and all ` + "`sub_issues`" + ` of the parent, in order.
+
+## Notes
+
+- Both issues must exist in the repository. An issue can only have one parent
+- Sub-issues must be available for the repository. If they are not, the execution fails with a feature disabled error`
+}
+
+func (c *AddSubIssue) Icon() string {
+	return "github"
+}
+
+func (c *AddSubIssue) Color() string {
+	return "gray"
+}
+
+func (c *AddSubIssue) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *AddSubIssue) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "parentIssueNumber",
+			Label:       "Parent Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., 42",
+		},
+		{
+			Name:        "childIssueNumber",
+			Label:       "Child Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.number}}",
+		},
+		{
+			Name:        "position",
+			Label:       "Position",
+			Type:        configuration.FieldTypeNumber,
+			Togglable:   true,
+			Description: "Position of the sub-issue in the list of the parent, starting at 1",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *AddSubIssue) Setup(ctx core.SetupContext) error {
+	var config AddSubIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.ParentIssueNumber == "" {
+		return errors.New("parent issue number is required")
+	}
+
+	if config.ChildIssueNumber == "" {
+		return errors.New("child issue number is required")
+	}
+
+	if config.ParentIssueNumber == config.ChildIssueNumber && !isExpression(config.ParentIssueNumber) {
+		return errors.New("an issue cannot be a sub-issue of itself")
+	}
+
+	if config.Position != nil && *config.Position < 1 {
+		return errors.New("position must be at least 1")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *AddSubIssue) Execute(ctx core.ExecutionContext) error {
+	var config AddSubIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	parentNumber, err := strconv.Atoi(config.ParentIssueNumber)
+	if err != nil {
+		return fmt.Errorf("parent issue number is not a number: %v", err)
+	}
+
+	childNumber, err := strconv.Atoi(config.ChildIssueNumber)
+	if err != nil {
+		return fmt.Errorf("child issue number is not a number: %v", err)
+	}
+
+	if parentNumber == childNumber {
+		return errors.New("an issue cannot be a sub-issue of itself")
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := addSubIssue(client, appMetadata.Owner, config.Repository, parentNumber, childNumber, config.Position)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.subIssues",
+		[]any{output},
+	)
+}
+
+func addSubIssue(client *github.Client, owner, repository string, parentNumber, childNumber int, position *int) (*AddSubIssueOutput, error) {
+	parent, _, err := client.Issues.Get(context.Background(), owner, repository, parentNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent issue %d: %w", parentNumber, wrapGitHubError(err))
+	}
+
+	child, _, err := client.Issues.Get(context.Background(), owner, repository, childNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child issue %d: %w", childNumber, wrapGitHubError(err))
+	}
+
+	request := github.SubIssueRequest{SubIssueID: child.GetID()}
+	_, _, err = client.SubIssue.Add(context.Background(), owner, repository, int64(parentNumber), request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add issue %d as a sub-issue of %d: %w", childNumber, parentNumber, subIssueError(err, repository))
+	}
+
+	subIssues, err := listSubIssues(client, owner, repository, parentNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if position != nil {
+		subIssues, err = moveSubIssue(client, owner, repository, parentNumber, child.GetID(), subIssues, *position)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output := &AddSubIssueOutput{
+		Parent:    summarizeSubIssue(parent),
+		Added:     summarizeSubIssue(child),
+		SubIssues: make([]SubIssueSummary, 0, len(subIssues)),
+	}
+
+	for _, subIssue := range subIssues {
+		output.SubIssues = append(output.SubIssues, summarizeSubIssue(subIssue))
+	}
+
+	return output, nil
+}
+
+/*
+ * New sub-issues are added last.
+ * To move one to a position, it is placed before the sub-issue currently there.
+ */
+func moveSubIssue(client *github.Client, owner, repository string, parentNumber int, id int64, subIssues []*github.Issue, position int) ([]*github.Issue, error) {
+	others := []*github.Issue{}
+	var moved *github.Issue
+	for _, subIssue := range subIssues {
+		if subIssue.GetID() == id {
+			moved = subIssue
+			continue
+		}
+
+		others = append(others, subIssue)
+	}
+
+	if moved == nil || position > len(others) {
+		return subIssues, nil
+	}
+
+	request := github.SubIssueRequest{SubIssueID: id, BeforeID: github.Ptr(others[position-1].GetID())}
+	_, _, err := client.SubIssue.Reprioritize(context.Background(), owner, repository, int64(parentNumber), request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move sub-issue to position %d: %w", position, wrapGitHubError(err))
+	}
+
+	reordered := append([]*github.Issue{}, others[:position-1]...)
+	reordered = append(reordered, moved)
+	return append(reordered, others[position-1:]...), nil
+}
+
+func listSubIssues(client *github.Client, owner, repository string, parentNumber int) ([]*github.Issue, error) {
+	opts := &github.IssueListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	subIssues := []*github.Issue{}
+	for {
+		page, response, err := client.SubIssue.ListByIssue(context.Background(), owner, repository, int64(parentNumber), opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sub-issues of %d: %w", parentNumber, wrapGitHubError(err))
+		}
+
+		for _, subIssue := range page {
+			subIssues = append(subIssues, (*github.Issue)(subIssue))
+		}
+
+		if response.NextPage == 0 {
+			return subIssues, nil
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+}
+
+/*
+ * Both issues exist by the time sub-issues are added,
+ * so a 404 means sub-issues are not available for the repository.
+ */
+func subIssueError(err error, repository string) error {
+	err = wrapGitHubError(err)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	return fmt.Errorf("%w: sub-issues are not available for %s: %w", ErrFeatureDisabled, repository, err)
+}
+
+func summarizeSubIssue(issue *github.Issue) SubIssueSummary {
+	return SubIssueSummary{
+		Number: issue.GetNumber(),
+		Title:  issue.GetTitle(),
+		State:  issue.GetState(),
+		URL:    issue.GetHTMLURL(),
+	}
+}
+
+func (c *AddSubIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *AddSubIssue) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *AddSubIssue) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *AddSubIssue) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *AddSubIssue) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *AddSubIssue) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AddSubIssue__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}}
+	component := AddSubIssue{}
+
+	t.Run("parent issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "childIssueNumber": "8"},
+		})
+
+		require.ErrorContains(t, err, "parent issue number is required")
+	})
+
+	t.Run("child issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "parentIssueNumber": "7"},
+		})
+
+		require.ErrorContains(t, err, "child issue number is required")
+	})
+
+	t.Run("same issue -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "parentIssueNumber": "7", "childIssueNumber": "7"},
+		})
+
+		require.ErrorContains(t, err, "cannot be a sub-issue of itself")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "parentIssueNumber": "7", "childIssueNumber": "{{$.data.number}}", "position": 1},
+		}))
+	})
+}
+
+func Test__AddSubIssue__Add(t *testing.T) {
+	subIssuesTransport := func(addStatus int) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case request.URL.Path == "/repos/testhq/hello/issues/7":
+				return mockResponse(http.StatusOK, `{"id":70,"number":7,"title":"Epic"}`), nil
+			case request.URL.Path == "/repos/testhq/hello/issues/8":
+				return mockResponse(http.StatusOK, `{"id":80,"number":8,"title":"Task"}`), nil
+			case request.Method == http.MethodPost:
+				return mockResponse(addStatus, `{"message":"Not Found"}`), nil
+			case request.Method == http.MethodPatch:
+				return mockResponse(http.StatusOK, `{"id":70,"number":7}`), nil
+			default:
+				return mockResponse(http.StatusOK, `[{"id":60,"number":6,"title":"Other"},{"id":80,"number":8,"title":"Task"}]`), nil
+			}
+		}}
+	}
+
+	t.Run("sub-issue is added last", func(t *testing.T) {
+		transport := subIssuesTransport(http.StatusCreated)
+		output, err := addSubIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, 8, nil)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+		assert.Equal(t, "/repos/testhq/hello/issues/7/sub_issues", transport.requests[2].URL.Path)
+
+		assert.Equal(t, 7, output.Parent.Number)
+		assert.Equal(t, 8, output.Added.Number)
+		require.Len(t, output.SubIssues, 2)
+		assert.Equal(t, 8, output.SubIssues[1].Number)
+	})
+
+	t.Run("sub-issue is moved to the position", func(t *testing.T) {
+		transport := subIssuesTransport(http.StatusCreated)
+		transport.handler = wrapHandler(transport.handler, func(request *http.Request) {
+			if request.Method == http.MethodPatch {
+				body, _ := io.ReadAll(request.Body)
+				assert.JSONEq(t, `{"sub_issue_id":80,"before_id":60}`, string(body))
+			}
+		})
+
+		output, err := addSubIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, 8, github.Ptr(1))
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 5)
+		assert.Equal(t, "/repos/testhq/hello/issues/7/sub_issues/priority", transport.requests[4].URL.Path)
+		assert.Equal(t, 8, output.SubIssues[0].Number)
+		assert.Equal(t, 6, output.SubIssues[1].Number)
+	})
+
+	t.Run("position past the end -> not moved", func(t *testing.T) {
+		transport := subIssuesTransport(http.StatusCreated)
+		_, err := addSubIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, 8, github.Ptr(5))
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 4)
+	})
+
+	t.Run("sub-issues not available -> feature disabled", func(t *testing.T) {
+		transport := subIssuesTransport(http.StatusNotFound)
+		_, err := addSubIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, 8, nil)
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		assert.Contains(t, err.Error(), "sub-issues are not available for hello")
+	})
+
+	t.Run("child issue does not exist -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Path == "/repos/testhq/hello/issues/7" {
+				return mockResponse(http.StatusOK, `{"id":70,"number":7}`), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := addSubIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, 8, nil)
+		require.ErrorIs(t, err, ErrNotFound)
+		require.ErrorContains(t, err, "failed to get child issue 8")
+		assert.Len(t, transport.requests, 2)
+	})
+}
+
+func wrapHandler(handler func(*http.Request) (*http.Response, error), inspect func(*http.Request)) func(*http.Request) (*http.Response, error) {
+	return func(request *http.Request) (*http.Response, error) {
+		inspect(request)
+		return handler(request)
+	}
+}
@@ -0,0 +1,392 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type AddToProject struct{}
+
+type AddToProjectConfiguration struct {
+	ProjectURL    string `json:"projectUrl" mapstructure:"projectUrl"`
+	Organization  string `json:"organization" mapstructure:"organization"`
+	ProjectNumber string `json:"projectNumber" mapstructure:"projectNumber"`
+	ItemURL       string `json:"itemUrl" mapstructure:"itemUrl"`
+}
+
+type ProjectRef struct {
+	OwnerType string
+	Owner     string
+	Number    int
+}
+
+type ItemRef struct {
+	Owner      string
+	Repository string
+	Number     int
+}
+
+func (c *AddToProject) Name() string {
+	return "github.addToProject"
+}
+
+func (c *AddToProject) Label() string {
+	return "Add to Project"
+}
+
+func (c *AddToProject) Description() string {
+	return "Add an issue or pull request to a GitHub project"
+}
+
+func (c *AddToProject) Documentation() string {
+	return `The Add to Project component adds an issue or pull request to a GitHub Projects (v2) board.
+
+## Use Cases
+
+- **Work tracking**: Add new issues to the team's project board automatically
+- **Release planning**: Add pull requests to a release project when they are opened
+- **Triage**: Route issues to different projects based on their labels
+
+## Configuration
+
+- **Project URL**: URL of the project, e.g. ` + "`https://github.com/orgs/acme/projects/5`" + `
+- **Organization** and **Project Number**: Alternative to the project URL, for organization projects
+- **Item URL**: URL of the issue or pull request to add, e.g. ` + "`https://github.com/acme/widgets/issues/42`" + `
+
+## Output
+
+Returns the ID of the created project item, together with the project and content node IDs.
+
+## Notes
+
+- Projects v2 is only available through the GitHub GraphQL API
+- The GitHub app must be installed in the project owner account, with read and write access to projects
+- Adding an item that is already in the project returns the existing item`
+}
+
+func (c *AddToProject) Icon() string {
+	return "github"
+}
+
+func (c *AddToProject) Color() string {
+	return "gray"
+}
+
+func (c *AddToProject) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *AddToProject) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "projectUrl",
+			Label:       "Project URL",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., https://github.com/orgs/acme/projects/5",
+			Description: "URL of the project. Leave empty to use organization and project number instead.",
+		},
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Description: "Organization that owns the project. Only used if no project URL is given.",
+		},
+		{
+			Name:        "projectNumber",
+			Label:       "Project Number",
+			Type:        configuration.FieldTypeString,
+			Description: "Number of the project in the organization. Only used if no project URL is given.",
+		},
+		{
+			Name:        "itemUrl",
+			Label:       "Item URL",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.html_url}}",
+			Description: "URL of the issue or pull request to add to the project",
+		},
+	}
+}
+
+func (c *AddToProject) Setup(ctx core.SetupContext) error {
+	var config AddToProjectConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.ProjectURL == "" && (config.Organization == "" || config.ProjectNumber == "") {
+		return fmt.Errorf("project URL, or organization and project number, are required")
+	}
+
+	if config.ItemURL == "" {
+		return fmt.Errorf("item URL is required")
+	}
+
+	return nil
+}
+
+func (c *AddToProject) Execute(ctx core.ExecutionContext) error {
+	var config AddToProjectConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	project, err := resolveProjectRef(config)
+	if err != nil {
+		return err
+	}
+
+	item, err := parseItemURL(config.ItemURL)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	projectID, err := c.findProjectID(client, project)
+	if err != nil {
+		return projectAccessError(err, fmt.Sprintf("project %d of %s", project.Number, project.Owner))
+	}
+
+	contentID, err := c.findContentID(client, item)
+	if err != nil {
+		return projectAccessError(err, config.ItemURL)
+	}
+
+	var mutation struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+
+	input := githubv4.AddProjectV2ItemByIdInput{
+		ProjectID: projectID,
+		ContentID: contentID,
+	}
+
+	err = client.Mutate(context.Background(), &mutation, input, nil)
+	if err != nil {
+		return projectAccessError(err, fmt.Sprintf("project %d of %s", project.Number, project.Owner))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.projectItem",
+		[]any{map[string]any{
+			"itemId":    mutation.AddProjectV2ItemByID.Item.ID,
+			"projectId": projectID,
+			"contentId": contentID,
+			"itemUrl":   config.ItemURL,
+		}},
+	)
+}
+
+func (c *AddToProject) findProjectID(client *githubv4.Client, project *ProjectRef) (githubv4.ID, error) {
+	variables := map[string]any{
+		"login":  githubv4.String(project.Owner),
+		"number": githubv4.Int(project.Number),
+	}
+
+	if project.OwnerType == "users" {
+		var query struct {
+			User struct {
+				ProjectV2 struct {
+					ID githubv4.ID
+				} `graphql:"projectV2(number: $number)"`
+			} `graphql:"user(login: $login)"`
+		}
+
+		err := client.Query(context.Background(), &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return query.User.ProjectV2.ID, nil
+	}
+
+	var query struct {
+		Organization struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			} `graphql:"projectV2(number: $number)"`
+		} `graphql:"organization(login: $login)"`
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.Organization.ProjectV2.ID, nil
+}
+
+func (c *AddToProject) findContentID(client *githubv4.Client, item *ItemRef) (githubv4.ID, error) {
+	var query struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				Issue struct {
+					ID githubv4.ID
+				} `graphql:"... on Issue"`
+				PullRequest struct {
+					ID githubv4.ID
+				} `graphql:"... on PullRequest"`
+			} `graphql:"issueOrPullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(item.Owner),
+		"name":   githubv4.String(item.Repository),
+		"number": githubv4.Int(item.Number),
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Repository.IssueOrPullRequest.Issue.ID != nil {
+		return query.Repository.IssueOrPullRequest.Issue.ID, nil
+	}
+
+	if query.Repository.IssueOrPullRequest.PullRequest.ID != nil {
+		return query.Repository.IssueOrPullRequest.PullRequest.ID, nil
+	}
+
+	return nil, fmt.Errorf("issue or pull request %d not found in %s/%s", item.Number, item.Owner, item.Repository)
+}
+
+func resolveProjectRef(config AddToProjectConfiguration) (*ProjectRef, error) {
+	if config.ProjectURL != "" {
+		return parseProjectURL(config.ProjectURL)
+	}
+
+	number, err := strconv.Atoi(config.ProjectNumber)
+	if err != nil {
+		return nil, fmt.Errorf("project number is not a number: %v", err)
+	}
+
+	return &ProjectRef{OwnerType: "orgs", Owner: config.Organization, Number: number}, nil
+}
+
+/*
+ * Project URLs look like:
+ * - https://github.com/orgs/<org>/projects/<number>
+ * - https://github.com/users/<user>/projects/<number>
+ * and may have a trailing view path, e.g. /views/1.
+ */
+func parseProjectURL(projectURL string) (*ProjectRef, error) {
+	parts, err := githubURLPath(projectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) < 4 || (parts[0] != "orgs" && parts[0] != "users") || parts[2] != "projects" {
+		return nil, fmt.Errorf("invalid project URL %q", projectURL)
+	}
+
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid project number in URL %q", projectURL)
+	}
+
+	return &ProjectRef{OwnerType: parts[0], Owner: parts[1], Number: number}, nil
+}
+
+/*
+ * Item URLs look like:
+ * - https://github.com/<owner>/<repo>/issues/<number>
+ * - https://github.com/<owner>/<repo>/pull/<number>
+ */
+func parseItemURL(itemURL string) (*ItemRef, error) {
+	parts, err := githubURLPath(itemURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) < 4 || (parts[2] != "issues" && parts[2] != "pull") {
+		return nil, fmt.Errorf("invalid issue or pull request URL %q", itemURL)
+	}
+
+	number, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue or pull request number in URL %q", itemURL)
+	}
+
+	return &ItemRef{Owner: parts[0], Repository: parts[1], Number: number}, nil
+}
+
+func githubURLPath(rawURL string) ([]string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %v", rawURL, err)
+	}
+
+	if u.Host != "github.com" {
+		return nil, fmt.Errorf("invalid URL %q: not a github.com URL", rawURL)
+	}
+
+	return strings.Split(strings.Trim(u.Path, "/"), "/"), nil
+}
+
+/*
+ * When the app has no access to a project, GitHub does not say so directly.
+ * Instead, it says it could not resolve the project, or that the resource is not accessible.
+ */
+func projectAccessError(err error, target string) error {
+	message := err.Error()
+	if strings.Contains(message, "Could not resolve") ||
+		strings.Contains(message, "not accessible by integration") ||
+		strings.Contains(message, "does not have permission") {
+		return fmt.Errorf(
+			"%s not found or not accessible: make sure the GitHub app is installed in its owner account, and has access to projects: %v",
+			target,
+			err,
+		)
+	}
+
+	return fmt.Errorf("failed to add item to project: %w", err)
+}
+
+func (c *AddToProject) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *AddToProject) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *AddToProject) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *AddToProject) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *AddToProject) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *AddToProject) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
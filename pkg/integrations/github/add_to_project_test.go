@@ -0,0 +1,104 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AddToProject__Setup(t *testing.T) {
+	component := AddToProject{}
+
+	t.Run("project is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"organization": "acme", "itemUrl": "https://github.com/acme/widgets/issues/1"},
+		})
+
+		require.ErrorContains(t, err, "project URL, or organization and project number, are required")
+	})
+
+	t.Run("item URL is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"projectUrl": "https://github.com/orgs/acme/projects/5"},
+		})
+
+		require.ErrorContains(t, err, "item URL is required")
+	})
+
+	t.Run("organization and project number -> ok", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"organization":  "acme",
+				"projectNumber": "5",
+				"itemUrl":       "{{$.data.issue.html_url}}",
+			},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__AddToProject__ParseProjectURL(t *testing.T) {
+	t.Run("organization project", func(t *testing.T) {
+		project, err := parseProjectURL("https://github.com/orgs/acme/projects/5")
+		require.NoError(t, err)
+		assert.Equal(t, &ProjectRef{OwnerType: "orgs", Owner: "acme", Number: 5}, project)
+	})
+
+	t.Run("user project with view path", func(t *testing.T) {
+		project, err := parseProjectURL("https://github.com/users/octocat/projects/12/views/1")
+		require.NoError(t, err)
+		assert.Equal(t, &ProjectRef{OwnerType: "users", Owner: "octocat", Number: 12}, project)
+	})
+
+	t.Run("not a project URL -> error", func(t *testing.T) {
+		_, err := parseProjectURL("https://github.com/acme/widgets")
+		require.ErrorContains(t, err, "invalid project URL")
+	})
+
+	t.Run("not a github.com URL -> error", func(t *testing.T) {
+		_, err := parseProjectURL("https://example.com/orgs/acme/projects/5")
+		require.ErrorContains(t, err, "not a github.com URL")
+	})
+}
+
+func Test__AddToProject__ParseItemURL(t *testing.T) {
+	t.Run("issue", func(t *testing.T) {
+		item, err := parseItemURL("https://github.com/acme/widgets/issues/42")
+		require.NoError(t, err)
+		assert.Equal(t, &ItemRef{Owner: "acme", Repository: "widgets", Number: 42}, item)
+	})
+
+	t.Run("pull request", func(t *testing.T) {
+		item, err := parseItemURL("https://github.com/acme/widgets/pull/7/files")
+		require.NoError(t, err)
+		assert.Equal(t, &ItemRef{Owner: "acme", Repository: "widgets", Number: 7}, item)
+	})
+
+	t.Run("invalid number -> error", func(t *testing.T) {
+		_, err := parseItemURL("https://github.com/acme/widgets/issues/abc")
+		require.ErrorContains(t, err, "invalid issue or pull request number")
+	})
+}
+
+func Test__AddToProject__ProjectAccessError(t *testing.T) {
+	t.Run("unresolvable project -> access error", func(t *testing.T) {
+		err := projectAccessError(errors.New("Could not resolve to a ProjectV2 with the number 5."), "project 5 of acme")
+		require.ErrorContains(t, err, "project 5 of acme not found or not accessible")
+	})
+
+	t.Run("other errors are wrapped", func(t *testing.T) {
+		err := projectAccessError(errors.New("boom"), "project 5 of acme")
+		require.ErrorContains(t, err, "failed to add item to project: boom")
+	})
+}
@@ -0,0 +1,327 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DeploymentReviewedOutputChannel   = "reviewed"
+	DeploymentNotPendingOutputChannel = "notPending"
+
+	DeploymentReviewApproved = "approved"
+	DeploymentReviewRejected = "rejected"
+)
+
+type ApproveDeployment struct{}
+
+type ApproveDeploymentConfiguration struct {
+	Repository      string `json:"repository" mapstructure:"repository"`
+	RunID           string `json:"runId" mapstructure:"runId"`
+	EnvironmentName string `json:"environmentName" mapstructure:"environmentName"`
+	State           string `json:"state" mapstructure:"state"`
+	Comment         string `json:"comment" mapstructure:"comment"`
+}
+
+type DeploymentReviewOutput struct {
+	RunID               int64                `json:"run_id"`
+	Environment         string               `json:"environment"`
+	EnvironmentID       int64                `json:"environment_id,omitempty"`
+	State               string               `json:"state"`
+	Comment             string               `json:"comment,omitempty"`
+	Deployments         []ReviewedDeployment `json:"deployments,omitempty"`
+	PendingEnvironments []string             `json:"pending_environments,omitempty"`
+}
+
+type ReviewedDeployment struct {
+	ID  int64  `json:"id"`
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+func (c *ApproveDeployment) Name() string {
+	return "github.approveDeployment"
+}
+
+func (c *ApproveDeployment) Label() string {
+	return "Approve Deployment"
+}
+
+func (c *ApproveDeployment) Description() string {
+	return "Approve or reject a workflow run waiting on a protected GitHub environment"
+}
+
+func (c *ApproveDeployment) Documentation() string {
+	return `The Approve Deployment component reviews the pending deployment of a workflow run to a protected environment, approving or rejecting it.
+
+## Use Cases
+
+- **Gated CD**: Approve deployments to production automatically once the checks of the release pass
+- **Change freezes**: Reject deployments started during a freeze window
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Run ID**: The ID of the workflow run waiting for approval (supports expressions)
+- **Environment**: The name of the environment the run is waiting on (supports expressions)
+- **State**: **Approved** to let the deployment proceed, or **Rejected** to stop it
+- **Comment**: An optional comment for the review
+
+## Output Channels
+
+- **Reviewed**: The deployment was approved or rejected
+- **Not Pending**: The run has no deployment waiting on the environment, for example because it was already reviewed, or it finished
+
+## Output
+
+Emits a ` + "`github.deploymentReview`" + ` event with the ` + "`run_id`" + `, the ` + "`environment`" + `, the review ` + "`state`" + ` and ` + "`comment`" + `.
+When reviewed, the ` + "`deployments`" + ` that were approved or rejected are included, with their ` + "`id`" + `, ` + "`ref`" + ` and ` + "`sha`" + `.
+When not pending, the ` + "`pending_environments`" + ` the run is waiting on instead, if any, are included.
+
+## Notes
+
+- The GitHub App must be one of the required reviewers of the environment
+- Environment names are matched ignoring case`
+}
+
+func (c *ApproveDeployment) Icon() string {
+	return "github"
+}
+
+func (c *ApproveDeployment) Color() string {
+	return "gray"
+}
+
+func (c *ApproveDeployment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: DeploymentReviewedOutputChannel, Label: "Reviewed"},
+		{Name: DeploymentNotPendingOutputChannel, Label: "Not Pending"},
+	}
+}
+
+func (c *ApproveDeployment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "runId",
+			Label:       "Run ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.workflow_run.id}}",
+		},
+		{
+			Name:        "environmentName",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+		{
+			Name:     "state",
+			Label:    "State",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  DeploymentReviewApproved,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Approved", Value: DeploymentReviewApproved},
+						{Label: "Rejected", Value: DeploymentReviewRejected},
+					},
+				},
+			},
+		},
+		{
+			Name:  "comment",
+			Label: "Comment",
+			Type:  configuration.FieldTypeText,
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *ApproveDeployment) Setup(ctx core.SetupContext) error {
+	var config ApproveDeploymentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.RunID == "" {
+		return errors.New("run ID is required")
+	}
+
+	if !isExpression(config.RunID) {
+		if _, err := strconv.ParseInt(config.RunID, 10, 64); err != nil {
+			return fmt.Errorf("run ID is not a number: %s", config.RunID)
+		}
+	}
+
+	if strings.TrimSpace(config.EnvironmentName) == "" {
+		return errors.New("environment is required")
+	}
+
+	if config.State != DeploymentReviewApproved && config.State != DeploymentReviewRejected {
+		return fmt.Errorf("invalid state: %s", config.State)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ApproveDeployment) Execute(ctx core.ExecutionContext) error {
+	var config ApproveDeploymentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	runID, err := strconv.ParseInt(config.RunID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("run ID is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, reviewed, err := reviewPendingDeployment(client, appMetadata.Owner, config.Repository, runID, config)
+	if err != nil {
+		return err
+	}
+
+	channel := DeploymentReviewedOutputChannel
+	if !reviewed {
+		ctx.Logger.Infof("Workflow run %d has no deployment to %s waiting for review", runID, output.Environment)
+		channel = DeploymentNotPendingOutputChannel
+	}
+
+	return ctx.ExecutionState.Emit(channel, "github.deploymentReview", []any{output})
+}
+
+/*
+ * The review endpoint takes environment IDs, so the pending deployments
+ * of the run are read first, to find the one waiting on the environment.
+ * A run that is not waiting on it is reported instead of failing,
+ * since it is often already reviewed by someone else.
+ */
+func reviewPendingDeployment(client *github.Client, owner, repository string, runID int64, config ApproveDeploymentConfiguration) (*DeploymentReviewOutput, bool, error) {
+	environment := strings.TrimSpace(config.EnvironmentName)
+	output := &DeploymentReviewOutput{
+		RunID:       runID,
+		Environment: environment,
+		State:       config.State,
+		Comment:     config.Comment,
+	}
+
+	pending, _, err := client.Actions.GetPendingDeployments(context.Background(), owner, repository, runID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get pending deployments of workflow run %d: %w", runID, wrapGitHubError(err))
+	}
+
+	var match *github.PendingDeployment
+	for _, deployment := range pending {
+		name := deployment.GetEnvironment().GetName()
+		if strings.EqualFold(name, environment) {
+			match = deployment
+			continue
+		}
+
+		output.PendingEnvironments = append(output.PendingEnvironments, name)
+	}
+
+	if match == nil {
+		return output, false, nil
+	}
+
+	if match.CurrentUserCanApprove != nil && !match.GetCurrentUserCanApprove() {
+		return nil, false, fmt.Errorf("%w: the GitHub App is not a reviewer of environment %s", ErrPermissionDenied, environment)
+	}
+
+	output.Environment = match.GetEnvironment().GetName()
+	output.EnvironmentID = match.GetEnvironment().GetID()
+	output.PendingEnvironments = nil
+
+	deployments, _, err := client.Actions.PendingDeployments(context.Background(), owner, repository, runID, &github.PendingDeploymentsRequest{
+		EnvironmentIDs: []int64{output.EnvironmentID},
+		State:          config.State,
+		Comment:        config.Comment,
+	})
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to review deployment of workflow run %d: %w", runID, wrapGitHubError(err))
+	}
+
+	output.Deployments = []ReviewedDeployment{}
+	for _, deployment := range deployments {
+		output.Deployments = append(output.Deployments, ReviewedDeployment{
+			ID:  deployment.GetID(),
+			Ref: deployment.GetRef(),
+			SHA: deployment.GetSHA(),
+		})
+	}
+
+	return output, true, nil
+}
+
+func (c *ApproveDeployment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ApproveDeployment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ApproveDeployment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ApproveDeployment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ApproveDeployment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ApproveDeployment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ApproveDeployment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ApproveDeployment{}
+
+	setup := func(runID, environment, state string) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "runId": runID, "environmentName": environment, "state": state},
+		})
+	}
+
+	t.Run("invalid configuration -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup("", "production", "approved"), "run ID is required")
+		require.ErrorContains(t, setup("abc", "production", "approved"), "run ID is not a number: abc")
+		require.ErrorContains(t, setup("42", " ", "approved"), "environment is required")
+		require.ErrorContains(t, setup("42", "production", "pending"), "invalid state: pending")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "runId": "{{$.data.workflow_run.id}}", "environmentName": "production", "state": "rejected"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ApproveDeployment__Review(t *testing.T) {
+	config := ApproveDeploymentConfiguration{Repository: "hello", EnvironmentName: "Production", State: "approved", Comment: "checks passed"}
+
+	transportFor := func(pending string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, pending), nil
+			}
+
+			return mockResponse(http.StatusOK, `[{"id":42,"ref":"main","sha":"abc123"}]`), nil
+		}}
+	}
+
+	t.Run("pending deployment -> reviewed by environment ID", func(t *testing.T) {
+		transport := transportFor(`[{"environment":{"id":7,"name":"staging"}},{"environment":{"id":8,"name":"production"},"current_user_can_approve":true}]`)
+		output, reviewed, err := reviewPendingDeployment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 99, config)
+		require.NoError(t, err)
+		require.True(t, reviewed)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/actions/runs/99/pending_deployments", transport.requests[1].URL.Path)
+
+		body, _ := io.ReadAll(transport.requests[1].Body)
+		assert.JSONEq(t, `{"environment_ids":[8],"state":"approved","comment":"checks passed"}`, string(body))
+		assert.Equal(t, "production", output.Environment)
+		assert.Equal(t, int64(8), output.EnvironmentID)
+		assert.Nil(t, output.PendingEnvironments)
+		assert.Equal(t, []ReviewedDeployment{{ID: 42, Ref: "main", SHA: "abc123"}}, output.Deployments)
+	})
+
+	t.Run("no deployment pending on the environment -> not reviewed", func(t *testing.T) {
+		transport := transportFor(`[{"environment":{"id":7,"name":"staging"}}]`)
+		output, reviewed, err := reviewPendingDeployment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 99, config)
+		require.NoError(t, err)
+		assert.False(t, reviewed)
+		assert.Len(t, transport.requests, 1)
+		assert.Equal(t, []string{"staging"}, output.PendingEnvironments)
+	})
+
+	t.Run("app is not a reviewer -> permission denied", func(t *testing.T) {
+		transport := transportFor(`[{"environment":{"id":8,"name":"production"},"current_user_can_approve":false}]`)
+		_, _, err := reviewPendingDeployment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 99, config)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Len(t, transport.requests, 1)
+	})
+}
@@ -0,0 +1,225 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ArchiveRepository struct{}
+
+type ArchiveRepositoryConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Archived   bool   `json:"archived" mapstructure:"archived"`
+	Confirm    bool   `json:"confirm" mapstructure:"confirm"`
+}
+
+type ArchiveRepositoryOutput struct {
+	Repository string `json:"repository"`
+	Archived   bool   `json:"archived"`
+	Changed    bool   `json:"changed"`
+	URL        string `json:"html_url"`
+}
+
+func (c *ArchiveRepository) Name() string {
+	return "github.archiveRepository"
+}
+
+func (c *ArchiveRepository) Label() string {
+	return "Archive Repository"
+}
+
+func (c *ArchiveRepository) Description() string {
+	return "Archive or unarchive a GitHub repository"
+}
+
+func (c *ArchiveRepository) Documentation() string {
+	return `The Archive Repository component archives or unarchives a repository.
+
+## Use Cases
+
+- **Lifecycle automation**: Archive repositories that have been inactive for a long time
+- **Restoring repositories**: Unarchive a repository to make it writable again
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Archived**: Archive the repository when enabled, unarchive it when disabled
+- **Confirm**: Must be enabled to archive the repository. Archived repositories are read-only, so the component refuses to archive without it
+
+## Output
+
+Returns the ` + "`repository`" + `, its ` + "`archived`" + ` state, and ` + "`changed`" + `, which is false if the repository was already in that state.
+
+## Notes
+
+- Archiving and unarchiving a repository requires admin access to it, and the GitHub integration to be set up with **Repository Administration** enabled
+- Unarchiving a repository that is not archived does nothing`
+}
+
+func (c *ArchiveRepository) Icon() string {
+	return "github"
+}
+
+func (c *ArchiveRepository) Color() string {
+	return "gray"
+}
+
+func (c *ArchiveRepository) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ArchiveRepository) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "archived",
+			Label:       "Archived",
+			Type:        configuration.FieldTypeBool,
+			Default:     true,
+			Description: "Archive the repository when enabled, unarchive it when disabled",
+		},
+		{
+			Name:        "confirm",
+			Label:       "Confirm",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Confirm that the repository should be archived",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *ArchiveRepository) Setup(ctx core.SetupContext) error {
+	var config ArchiveRepositoryConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateArchiveConfirmation(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateArchiveConfirmation(config ArchiveRepositoryConfiguration) error {
+	if config.Archived && !config.Confirm {
+		return errors.New("confirm must be enabled to archive a repository")
+	}
+
+	return nil
+}
+
+func (c *ArchiveRepository) Execute(ctx core.ExecutionContext) error {
+	var config ArchiveRepositoryConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateArchiveConfirmation(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setRepositoryArchived(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.Archived)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.repositoryArchive",
+		[]any{output},
+	)
+}
+
+func setRepositoryArchived(client *github.Client, installationID, owner, repo string, archived bool) (*ArchiveRepositoryOutput, error) {
+	repository, err := getRepository(client, installationID, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ArchiveRepositoryOutput{
+		Repository: repo,
+		Archived:   archived,
+		URL:        repository.GetHTMLURL(),
+	}
+
+	if repository.GetArchived() == archived {
+		return output, nil
+	}
+
+	_, _, err = client.Repositories.Edit(context.Background(), owner, repo, &github.Repository{Archived: github.Ptr(archived)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set archived state of %s to %t: %w", repo, archived, wrapGitHubError(err))
+	}
+
+	output.Changed = true
+	return output, nil
+}
+
+func (c *ArchiveRepository) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ArchiveRepository) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ArchiveRepository) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ArchiveRepository) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ArchiveRepository) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ArchiveRepository) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ArchiveRepository__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ArchiveRepository{}
+
+	t.Run("archiving without confirmation -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "archived": true},
+		})
+
+		require.ErrorContains(t, err, "confirm must be enabled to archive a repository")
+	})
+
+	t.Run("unarchiving does not need confirmation", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "archived": false},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "archived": true, "confirm": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ArchiveRepository__SetArchived(t *testing.T) {
+	repositoryTransport := func(archived bool) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodPatch {
+				return mockResponse(http.StatusOK, `{"name":"hello","archived":true}`), nil
+			}
+
+			if archived {
+				return mockResponse(http.StatusOK, `{"name":"hello","archived":true,"html_url":"https://github.com/testhq/hello"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"hello","archived":false,"html_url":"https://github.com/testhq/hello"}`), nil
+		}}
+	}
+
+	t.Run("repository is archived", func(t *testing.T) {
+		transport := repositoryTransport(false)
+		output, err := setRepositoryArchived(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		body, _ := io.ReadAll(transport.requests[1].Body)
+		assert.JSONEq(t, `{"archived":true}`, string(body))
+		assert.True(t, output.Changed)
+		assert.True(t, output.Archived)
+		assert.Equal(t, "https://github.com/testhq/hello", output.URL)
+	})
+
+	t.Run("unarchiving an active repository -> no-op", func(t *testing.T) {
+		transport := repositoryTransport(false)
+		output, err := setRepositoryArchived(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", false)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.False(t, output.Changed)
+		assert.False(t, output.Archived)
+	})
+
+	t.Run("repository is unarchived", func(t *testing.T) {
+		transport := repositoryTransport(true)
+		output, err := setRepositoryArchived(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		body, _ := io.ReadAll(transport.requests[1].Body)
+		assert.JSONEq(t, `{"archived":false}`, string(body))
+		assert.True(t, output.Changed)
+	})
+}
@@ -0,0 +1,149 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	BatchPagesPerStep   = 10
+	BatchStepInterval   = time.Second
+	BatchNextPageAction = "nextPage"
+	MaxBatchItems       = 10000
+)
+
+/*
+ * BatchCheckpoint records how far a batch went:
+ * the next page to fetch, and how many items were emitted so far.
+ *
+ * Actions receive the node configuration with expressions not resolved,
+ * so the resolved configuration the batch started with is recorded too,
+ * and the next steps fetch with it.
+ */
+type BatchCheckpoint struct {
+	NextPage      int `json:"nextPage" mapstructure:"nextPage"`
+	Count         int `json:"count" mapstructure:"count"`
+	Configuration any `json:"configuration" mapstructure:"configuration"`
+}
+
+type BatchMetadata struct {
+	Batch *BatchCheckpoint `json:"batch,omitempty" mapstructure:"batch"`
+}
+
+type batchContext struct {
+	Configuration any
+	Metadata      core.MetadataContext
+	Requests      core.RequestContext
+	Logger        *log.Entry
+}
+
+/*
+ * Fetches one page of items.
+ * The returned next page is 0 when there are no more pages.
+ */
+type batchFetchFunc func(page int) ([]any, int, error)
+
+/*
+ * Emits the items of one page.
+ * The last page is emitted with last set, and must pass the execution.
+ * The other ones must be emitted without passing it.
+ */
+type batchEmitFunc func(items []any, last bool) error
+
+/*
+ * batchEmit fetches all pages of a list, and emits the items of each page as it is fetched.
+ *
+ * The execution, its metadata, and the emitted events are updated in a single transaction,
+ * so if the worker dies while fetching, everything done in that transaction is lost.
+ * To avoid fetching and emitting everything again on retries, pages are fetched in steps of BatchPagesPerStep.
+ * At the end of each step, a checkpoint is recorded in the execution metadata,
+ * and the next step is scheduled with the BatchNextPageAction action,
+ * which runs in its own transaction, and resumes from the checkpoint.
+ * Items are never kept in the metadata - only the checkpoint is.
+ *
+ * Components using it must expose BatchNextPageAction,
+ * and call batchEmit again from HandleAction(),
+ * with the configuration returned by batchConfiguration().
+ */
+func batchEmit(ctx batchContext, fetch batchFetchFunc, emit batchEmitFunc) error {
+	metadata := BatchMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	checkpoint := BatchCheckpoint{NextPage: 1, Configuration: ctx.Configuration}
+	if metadata.Batch != nil {
+		checkpoint = *metadata.Batch
+		ctx.Logger.Infof("Resuming batch from page %d, with %d items already emitted", checkpoint.NextPage, checkpoint.Count)
+	}
+
+	for range BatchPagesPerStep {
+		items, nextPage, err := fetch(checkpoint.NextPage)
+		if err != nil {
+			return err
+		}
+
+		if err := emit(items, nextPage == 0); err != nil {
+			return err
+		}
+
+		if nextPage == 0 {
+			return nil
+		}
+
+		checkpoint.Count += len(items)
+		checkpoint.NextPage = nextPage
+	}
+
+	if err := ctx.Metadata.Set(BatchMetadata{Batch: &checkpoint}); err != nil {
+		return fmt.Errorf("failed to record batch checkpoint: %w", err)
+	}
+
+	return ctx.Requests.ScheduleActionCall(BatchNextPageAction, map[string]any{}, BatchStepInterval)
+}
+
+/*
+ * batchFetchAll fetches all pages of a list in memory,
+ * for outputs that need all the items at once.
+ * Nothing is emitted until all pages are fetched, so nothing is checkpointed,
+ * and the items are capped at MaxBatchItems.
+ */
+func batchFetchAll(fetch batchFetchFunc) ([]any, error) {
+	items := []any{}
+	for page := 1; page != 0; {
+		pageItems, nextPage, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, pageItems...)
+		if len(items) > MaxBatchItems {
+			return nil, fmt.Errorf("more than %d items found - use filters to narrow down the results", MaxBatchItems)
+		}
+
+		page = nextPage
+	}
+
+	return items, nil
+}
+
+/*
+ * Returns the resolved configuration recorded when the batch started.
+ */
+func batchConfiguration(metadataCtx core.MetadataContext) (any, error) {
+	metadata := BatchMetadata{}
+	if err := mapstructure.Decode(metadataCtx.Get(), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if metadata.Batch == nil || metadata.Batch.Configuration == nil {
+		return nil, errors.New("no batch in progress")
+	}
+
+	return metadata.Batch.Configuration, nil
+}
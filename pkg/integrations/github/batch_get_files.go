@@ -0,0 +1,343 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MaxBatchFilePaths         = 100
+	DefaultBatchFilesMaxBytes = 1024 * 1024
+	MaxBatchFilesMaxBytes     = 10 * 1024 * 1024
+)
+
+type BatchGetFiles struct{}
+
+type BatchGetFilesConfiguration struct {
+	Repository string   `json:"repository" mapstructure:"repository"`
+	Ref        string   `json:"ref" mapstructure:"ref"`
+	Paths      []string `json:"paths" mapstructure:"paths"`
+	MaxBytes   *int     `json:"maxBytes" mapstructure:"maxBytes"`
+}
+
+type BatchFilesOutput struct {
+	Ref        string                     `json:"ref"`
+	Files      map[string]BatchFileOutput `json:"files"`
+	TotalBytes int                        `json:"total_bytes"`
+	Truncated  bool                       `json:"truncated"`
+}
+
+type BatchFileOutput struct {
+	Found   bool   `json:"found"`
+	SHA     string `json:"sha,omitempty"`
+	Size    int    `json:"size,omitempty"`
+	Content string `json:"content,omitempty"`
+	Skipped bool   `json:"skipped,omitempty"`
+}
+
+func (c *BatchGetFiles) Name() string {
+	return "github.batchGetFiles"
+}
+
+func (c *BatchGetFiles) Label() string {
+	return "Batch Get Files"
+}
+
+func (c *BatchGetFiles) Description() string {
+	return "Read multiple files of a GitHub repository"
+}
+
+func (c *BatchGetFiles) Documentation() string {
+	return `The Batch Get Files component reads the content of multiple files of a repository, at a given branch, tag, or commit.
+
+## Use Cases
+
+- **Config validation**: Read all the configuration files of a repository and check them in one step
+- **Audits**: Check that a repository has the expected ` + "`CODEOWNERS`" + `, ` + "`LICENSE`" + ` and ` + "`SECURITY.md`" + ` files
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Branch or tag**: The branch, tag, or commit SHA to read the files at
+- **Paths**: The paths of the files to read, like ` + "`.github/CODEOWNERS`" + `. At most 100
+- **Max Bytes**: Maximum total size of the files read. Defaults to 1 MiB, at most 10 MiB
+
+## Output
+
+Emits a ` + "`github.files`" + ` event with the ` + "`ref`" + `, the ` + "`total_bytes`" + ` read, and ` + "`files`" + `, a map from each path to:
+
+- ` + "`found`" + `: whether the file exists. Missing paths and directories are included with ` + "`found`" + ` set to false, instead of failing the execution
+- ` + "`sha`" + `, ` + "`size`" + ` and ` + "`content`" + ` of the file
+- ` + "`skipped`" + `: true if the file was not read because it would go over **Max Bytes**. ` + "`truncated`" + ` is then true as well
+
+## Notes
+
+- The tree of the repository is read once, so missing files and file sizes are known before reading any file
+- When GitHub truncates the tree of a very large repository, files missing from the tree are read one by one
+- Content is read as text, so binary files are not supported`
+}
+
+func (c *BatchGetFiles) Icon() string {
+	return "github"
+}
+
+func (c *BatchGetFiles) Color() string {
+	return "gray"
+}
+
+func (c *BatchGetFiles) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *BatchGetFiles) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "ref",
+			Label:    "Branch or tag",
+			Type:     configuration.FieldTypeGitRef,
+			Required: true,
+			Default:  "main",
+		},
+		{
+			Name:     "paths",
+			Label:    "Paths",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Path",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "maxBytes",
+			Label:       "Max Bytes",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultBatchFilesMaxBytes,
+			Description: "Maximum total size of the files read, in bytes",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxBatchFilesMaxBytes; return &max }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *BatchGetFiles) Setup(ctx core.SetupContext) error {
+	var config BatchGetFilesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateBatchGetFiles(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateBatchGetFiles(config BatchGetFilesConfiguration) error {
+	if config.Ref == "" {
+		return errors.New("ref is required")
+	}
+
+	if len(config.Paths) == 0 {
+		return errors.New("at least one path is required")
+	}
+
+	if len(config.Paths) > MaxBatchFilePaths {
+		return fmt.Errorf("%d paths configured, but at most %d files can be read", len(config.Paths), MaxBatchFilePaths)
+	}
+
+	for _, path := range config.Paths {
+		if batchFilePath(path) == "" {
+			return errors.New("paths cannot be empty")
+		}
+	}
+
+	if config.MaxBytes != nil && (*config.MaxBytes < 1 || *config.MaxBytes > MaxBatchFilesMaxBytes) {
+		return fmt.Errorf("max bytes must be between 1 and %d", MaxBatchFilesMaxBytes)
+	}
+
+	return nil
+}
+
+func (c *BatchGetFiles) Execute(ctx core.ExecutionContext) error {
+	var config BatchGetFilesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateBatchGetFiles(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	maxBytes := DefaultBatchFilesMaxBytes
+	if config.MaxBytes != nil {
+		maxBytes = *config.MaxBytes
+	}
+
+	output, err := batchGetFiles(client, appMetadata.Owner, config.Repository, config.Ref, config.Paths, maxBytes)
+	if err != nil {
+		return err
+	}
+
+	if output.Truncated {
+		ctx.Logger.Warnf("Files of %s@%s go over %d bytes, some were skipped", config.Repository, config.Ref, maxBytes)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.files", []any{output})
+}
+
+/*
+ * Paths are relative to the root of the repository,
+ * so a leading slash is accepted, and dropped.
+ */
+func batchFilePath(path string) string {
+	return strings.Trim(strings.TrimSpace(path), "/")
+}
+
+/*
+ * The recursive tree gives the SHA and size of every file with a single call,
+ * so missing files are never requested, and the byte limit is checked before reading a file.
+ */
+func batchGetFiles(client *github.Client, owner, repo, ref string, paths []string, maxBytes int) (*BatchFilesOutput, error) {
+	tree, _, err := client.Git.GetTree(context.Background(), owner, repo, treeRef(ref), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree: %w", wrapGitHubError(err))
+	}
+
+	entries := map[string]*github.TreeEntry{}
+	for _, entry := range tree.Entries {
+		entries[entry.GetPath()] = entry
+	}
+
+	output := &BatchFilesOutput{Ref: ref, Files: map[string]BatchFileOutput{}}
+	for _, path := range paths {
+		path = batchFilePath(path)
+		if _, ok := output.Files[path]; ok {
+			continue
+		}
+
+		entry, ok := entries[path]
+		if !ok && tree.GetTruncated() {
+			entry, err = getBatchFileEntry(client, owner, repo, ref, path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if entry == nil || entry.GetType() != "blob" {
+			output.Files[path] = BatchFileOutput{Found: false}
+			continue
+		}
+
+		file := BatchFileOutput{Found: true, SHA: entry.GetSHA(), Size: entry.GetSize()}
+		if output.TotalBytes+file.Size > maxBytes {
+			file.Skipped = true
+			output.Truncated = true
+			output.Files[path] = file
+			continue
+		}
+
+		content, _, err := client.Git.GetBlobRaw(context.Background(), owner, repo, file.SHA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, wrapGitHubError(err))
+		}
+
+		file.Content = string(content)
+		output.TotalBytes += len(content)
+		output.Files[path] = file
+	}
+
+	return output, nil
+}
+
+/*
+ * Used for paths missing from a truncated tree.
+ * The contents API returns nothing for a missing path, and a list for a directory.
+ */
+func getBatchFileEntry(client *github.Client, owner, repo, ref, path string) (*github.TreeEntry, error) {
+	file, _, response, err := client.Repositories.GetContents(context.Background(), owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if isNotFound(response) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", path, wrapGitHubError(err))
+	}
+
+	if file == nil {
+		return nil, nil
+	}
+
+	return &github.TreeEntry{
+		Path: file.Path,
+		Type: github.Ptr("blob"),
+		SHA:  file.SHA,
+		Size: file.Size,
+	}, nil
+}
+
+func (c *BatchGetFiles) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *BatchGetFiles) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *BatchGetFiles) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *BatchGetFiles) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *BatchGetFiles) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *BatchGetFiles) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
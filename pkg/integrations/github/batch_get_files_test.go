@@ -0,0 +1,115 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__BatchGetFiles__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := BatchGetFiles{}
+
+	t.Run("no paths -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "paths": []string{}},
+		})
+
+		require.ErrorContains(t, err, "at least one path is required")
+	})
+
+	t.Run("empty path -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "paths": []string{"README.md", " / "}},
+		})
+
+		require.ErrorContains(t, err, "paths cannot be empty")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "paths": []string{"README.md"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__BatchGetFiles__GetFiles(t *testing.T) {
+	transportFor := func(truncated bool) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case strings.HasPrefix(request.URL.Path, "/repos/testhq/hello/git/trees/"):
+				if truncated {
+					return mockResponse(http.StatusOK, `{"sha":"t1","truncated":true,"tree":[]}`), nil
+				}
+
+				return mockResponse(http.StatusOK, `{"sha":"t1","tree":[
+					{"path":"a.yaml","type":"blob","sha":"b1","size":5},
+					{"path":"b.yaml","type":"blob","sha":"b2","size":10},
+					{"path":"config","type":"tree","sha":"t2"}
+				]}`), nil
+			case request.URL.Path == "/repos/testhq/hello/contents/a.yaml":
+				return mockResponse(http.StatusOK, `{"type":"file","path":"a.yaml","sha":"b1","size":5}`), nil
+			case strings.HasPrefix(request.URL.Path, "/repos/testhq/hello/contents/"):
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			case request.URL.Path == "/repos/testhq/hello/git/blobs/b1":
+				return mockResponse(http.StatusOK, `a: 1`+"\n"), nil
+			}
+
+			return mockResponse(http.StatusOK, `b: 2222222`+"\n"), nil
+		}}
+	}
+
+	t.Run("files are read from the tree, missing paths are not found", func(t *testing.T) {
+		transport := transportFor(false)
+		output, err := batchGetFiles(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "refs/heads/main", []string{"/a.yaml", "b.yaml", "config", "missing.yaml"}, 1024)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+
+		assert.Equal(t, "/repos/testhq/hello/git/trees/main", transport.requests[0].URL.Path)
+		assert.Equal(t, "1", transport.requests[0].URL.Query().Get("recursive"))
+		assert.Equal(t, map[string]BatchFileOutput{
+			"a.yaml":       {Found: true, SHA: "b1", Size: 5, Content: "a: 1\n"},
+			"b.yaml":       {Found: true, SHA: "b2", Size: 10, Content: "b: 2222222\n"},
+			"config":       {Found: false},
+			"missing.yaml": {Found: false},
+		}, output.Files)
+		assert.Equal(t, 16, output.TotalBytes)
+		assert.False(t, output.Truncated)
+	})
+
+	t.Run("files over max bytes -> skipped", func(t *testing.T) {
+		transport := transportFor(false)
+		output, err := batchGetFiles(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main", []string{"a.yaml", "b.yaml"}, 8)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, BatchFileOutput{Found: true, SHA: "b2", Size: 10, Skipped: true}, output.Files["b.yaml"])
+		assert.True(t, output.Truncated)
+	})
+
+	t.Run("truncated tree -> missing paths are read one by one", func(t *testing.T) {
+		transport := transportFor(true)
+		output, err := batchGetFiles(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main", []string{"a.yaml", "missing.yaml"}, 1024)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+
+		assert.Equal(t, "main", transport.requests[1].URL.Query().Get("ref"))
+		assert.Equal(t, BatchFileOutput{Found: true, SHA: "b1", Size: 5, Content: "a: 1\n"}, output.Files["a.yaml"])
+		assert.Equal(t, BatchFileOutput{Found: false}, output.Files["missing.yaml"])
+	})
+}
@@ -0,0 +1,162 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+/*
+ * Fake paginated list, with one item per page,
+ * recording which pages were fetched.
+ */
+type fakePages struct {
+	total   int
+	fetched []int
+	failAt  int
+}
+
+func (f *fakePages) fetch(page int) ([]any, int, error) {
+	if page == f.failAt {
+		return nil, 0, errors.New("worker died")
+	}
+
+	f.fetched = append(f.fetched, page)
+	if page == f.total {
+		return []any{page}, 0, nil
+	}
+
+	return []any{page}, page + 1, nil
+}
+
+/*
+ * Fake execution output, recording the items emitted by each step.
+ * Items emitted by a step that fails are discarded,
+ * like the transaction the step runs in is rolled back.
+ */
+type fakeOutput struct {
+	committed []any
+	step      []any
+	passed    bool
+}
+
+func (o *fakeOutput) emit(items []any, last bool) error {
+	o.step = append(o.step, items...)
+	o.passed = last
+	return nil
+}
+
+func (o *fakeOutput) run(ctx batchContext, fetch batchFetchFunc) error {
+	o.step = nil
+	err := batchEmit(ctx, fetch, o.emit)
+	if err != nil {
+		o.passed = false
+		return err
+	}
+
+	o.committed = append(o.committed, o.step...)
+	return nil
+}
+
+func Test__BatchEmit(t *testing.T) {
+	logger := log.NewEntry(log.New())
+
+	t.Run("single step -> items are emitted without checkpoint", func(t *testing.T) {
+		pages := &fakePages{total: 3}
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		output := &fakeOutput{}
+
+		require.NoError(t, output.run(batchContext{Metadata: metadata, Requests: requests, Logger: logger}, pages.fetch))
+		assert.Equal(t, []any{1, 2, 3}, output.committed)
+		assert.True(t, output.passed)
+		assert.Nil(t, metadata.Metadata)
+		assert.Empty(t, requests.Action)
+	})
+
+	t.Run("multiple steps -> pages are emitted, only the checkpoint is recorded", func(t *testing.T) {
+		pages := &fakePages{total: BatchPagesPerStep + 5}
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		output := &fakeOutput{}
+		ctx := batchContext{Configuration: map[string]any{"labels": []string{"bug"}}, Metadata: metadata, Requests: requests, Logger: logger}
+
+		require.NoError(t, output.run(ctx, pages.fetch))
+		assert.Len(t, output.committed, BatchPagesPerStep)
+		assert.False(t, output.passed)
+		assert.Equal(t, BatchNextPageAction, requests.Action)
+		assert.Equal(t, BatchStepInterval, requests.Duration)
+
+		checkpoint := metadata.Metadata.(BatchMetadata).Batch
+		require.NotNil(t, checkpoint)
+		assert.Equal(t, BatchPagesPerStep+1, checkpoint.NextPage)
+		assert.Equal(t, BatchPagesPerStep, checkpoint.Count)
+
+		//
+		// The next steps use the configuration recorded in the checkpoint.
+		//
+		configuration, err := batchConfiguration(metadata)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"labels": []string{"bug"}}, configuration)
+
+		require.NoError(t, output.run(ctx, pages.fetch))
+		assert.Len(t, output.committed, BatchPagesPerStep+5)
+		assert.True(t, output.passed)
+	})
+
+	t.Run("crash mid-step -> retry resumes from the last checkpoint", func(t *testing.T) {
+		pages := &fakePages{total: BatchPagesPerStep + 5, failAt: BatchPagesPerStep + 3}
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		output := &fakeOutput{}
+		ctx := batchContext{Metadata: metadata, Requests: requests, Logger: logger}
+
+		//
+		// The first step completes, and the second one dies
+		// before recording a new checkpoint.
+		//
+		require.NoError(t, output.run(ctx, pages.fetch))
+		require.ErrorContains(t, output.run(ctx, pages.fetch), "worker died")
+		assert.Equal(t, BatchPagesPerStep+1, metadata.Metadata.(BatchMetadata).Batch.NextPage)
+		assert.False(t, output.passed)
+
+		//
+		// The retry only fetches the pages after the checkpoint,
+		// and every item is emitted exactly once.
+		//
+		pages.failAt = 0
+		pages.fetched = nil
+		require.NoError(t, output.run(ctx, pages.fetch))
+		assert.Equal(t, []int{BatchPagesPerStep + 1, BatchPagesPerStep + 2, BatchPagesPerStep + 3, BatchPagesPerStep + 4, BatchPagesPerStep + 5}, pages.fetched)
+
+		expected := []any{}
+		for page := 1; page <= BatchPagesPerStep+5; page++ {
+			expected = append(expected, page)
+		}
+
+		assert.Equal(t, expected, output.committed)
+		assert.True(t, output.passed)
+	})
+}
+
+func Test__BatchFetchAll(t *testing.T) {
+	t.Run("all pages are fetched", func(t *testing.T) {
+		pages := &fakePages{total: BatchPagesPerStep + 5}
+		items, err := batchFetchAll(pages.fetch)
+		require.NoError(t, err)
+		assert.Len(t, items, BatchPagesPerStep+5)
+	})
+
+	t.Run("too many items -> error", func(t *testing.T) {
+		fetch := func(page int) ([]any, int, error) {
+			return make([]any, 2000), page + 1, nil
+		}
+
+		_, err := batchFetchAll(fetch)
+		require.ErrorContains(t, err, "more than 10000 items found")
+	})
+}
@@ -0,0 +1,259 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type BlockUser struct{}
+
+type BlockUserConfiguration struct {
+	Organization string `json:"organization" mapstructure:"organization"`
+	Username     string `json:"username" mapstructure:"username"`
+	Block        *bool  `json:"block" mapstructure:"block"`
+}
+
+type BlockUserOutput struct {
+	Organization string `json:"organization"`
+	Username     string `json:"username"`
+	Blocked      bool   `json:"blocked"`
+	Changed      bool   `json:"changed"`
+}
+
+func (c *BlockUser) Name() string {
+	return "github.blockUser"
+}
+
+func (c *BlockUser) Label() string {
+	return "Block User"
+}
+
+func (c *BlockUser) Description() string {
+	return "Block or unblock a user in a GitHub organization"
+}
+
+func (c *BlockUser) Documentation() string {
+	return `The Block User component blocks a user from an organization, or unblocks them.
+
+## Use Cases
+
+- **Abuse response**: Block accounts that spam issues or pull requests
+- **Appeals**: Unblock a user once an appeal is accepted
+
+## Configuration
+
+- **Organization**: The organization to block the user from. Defaults to the organization of the integration
+- **Username**: The GitHub username (supports expressions)
+- **Block**: Block the user if enabled, unblock them otherwise
+
+## Output
+
+Returns the ` + "`organization`" + `, the ` + "`username`" + `, whether the user is now ` + "`blocked`" + `, and ` + "`changed`" + `, which is false if the user was already in that state.
+
+## Notes
+
+- The GitHub app needs the Blocking users (write) organization permission. It is checked when the component is saved, unless the organization is an expression
+- Blocked users cannot interact with the repositories of the organization`
+}
+
+func (c *BlockUser) Icon() string {
+	return "github"
+}
+
+func (c *BlockUser) Color() string {
+	return "gray"
+}
+
+func (c *BlockUser) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *BlockUser) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Description: "Defaults to the organization of the integration",
+		},
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.sender.login}}",
+		},
+		{
+			Name:        "block",
+			Label:       "Block",
+			Type:        configuration.FieldTypeBool,
+			Default:     true,
+			Description: "Block the user if enabled, unblock them otherwise",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *BlockUser) Setup(ctx core.SetupContext) error {
+	return c.setup(ctx, integrationTransport(ctx.Integration))
+}
+
+func (c *BlockUser) setup(ctx core.SetupContext, transport http.RoundTripper) error {
+	var config BlockUserConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Username == "" {
+		return errors.New("username is required")
+	}
+
+	//
+	// Expressions are only resolved on execution,
+	// so the permission can only be checked here when none are used.
+	//
+	if isExpression(config.Organization) {
+		return nil
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	organization := blockOrganization(config, appMetadata)
+	_, _, err = client.Organizations.ListBlockedUsers(context.Background(), organization, &github.ListOptions{PerPage: 1})
+	return blockError(err, organization)
+}
+
+func (c *BlockUser) Execute(ctx core.ExecutionContext) error {
+	var config BlockUserConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	block := config.Block == nil || *config.Block
+	output, err := setUserBlocked(client, blockOrganization(config, appMetadata), config.Username, block)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.userBlock",
+		[]any{output},
+	)
+}
+
+func setUserBlocked(client *github.Client, organization, username string, block bool) (*BlockUserOutput, error) {
+	output := &BlockUserOutput{Organization: organization, Username: username, Blocked: block}
+
+	blocked, _, err := client.Organizations.IsBlocked(context.Background(), organization, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if %s is blocked: %w", username, blockError(err, organization))
+	}
+
+	if blocked == block {
+		return output, nil
+	}
+
+	if block {
+		_, err = client.Organizations.BlockUser(context.Background(), organization, username)
+	} else {
+		_, err = client.Organizations.UnblockUser(context.Background(), organization, username)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update block of %s: %w", username, blockError(err, organization))
+	}
+
+	output.Changed = true
+	return output, nil
+}
+
+func blockOrganization(config BlockUserConfiguration, appMetadata Metadata) string {
+	if config.Organization != "" {
+		return config.Organization
+	}
+
+	return appMetadata.Owner
+}
+
+/*
+ * Without the organization permission for blocking users,
+ * GitHub answers with a 403.
+ */
+func blockError(err error, organization string) error {
+	if err == nil {
+		return nil
+	}
+
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf(
+			"the GitHub app cannot block users in %s, grant it the Blocking users (write) organization permission: %w",
+			organization,
+			err,
+		)
+	}
+
+	return err
+}
+
+func (c *BlockUser) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *BlockUser) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *BlockUser) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *BlockUser) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *BlockUser) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *BlockUser) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
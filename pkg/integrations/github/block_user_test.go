@@ -0,0 +1,126 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__BlockUser__Setup(t *testing.T) {
+	component := BlockUser{}
+	config := map[string]any{"username": "spammer"}
+
+	blocksTransport := func(status int, body string) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/orgs/acme/blocks":
+				return mockResponse(status, body), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	t.Run("username is required", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Configuration: map[string]any{},
+		}, blocksTransport(http.StatusOK, `[]`))
+
+		require.ErrorContains(t, err, "username is required")
+	})
+
+	t.Run("expression -> permission is not checked", func(t *testing.T) {
+		transport := blocksTransport(http.StatusOK, `[]`)
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: map[string]any{"organization": "{{$.data.org}}", "username": "spammer"},
+		}, transport))
+
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("permission granted -> ok", func(t *testing.T) {
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: config,
+		}, blocksTransport(http.StatusOK, `[]`)))
+	})
+
+	t.Run("missing permission -> error with guidance", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: config,
+		}, blocksTransport(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`))
+
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Contains(t, err.Error(), "Blocking users (write)")
+	})
+}
+
+func Test__BlockUser__SetBlocked(t *testing.T) {
+	blockTransport := func(blocked bool) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				if blocked {
+					return mockResponse(http.StatusNoContent, ``), nil
+				}
+
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			}
+
+			return mockResponse(http.StatusNoContent, ``), nil
+		}}
+	}
+
+	t.Run("user is blocked", func(t *testing.T) {
+		transport := blockTransport(false)
+		output, err := setUserBlocked(github.NewClient(&http.Client{Transport: transport}), "acme", "spammer", true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodPut, transport.requests[1].Method)
+		assert.Equal(t, "/orgs/acme/blocks/spammer", transport.requests[1].URL.Path)
+		assert.Equal(t, &BlockUserOutput{Organization: "acme", Username: "spammer", Blocked: true, Changed: true}, output)
+	})
+
+	t.Run("already blocked -> no-op", func(t *testing.T) {
+		transport := blockTransport(true)
+		output, err := setUserBlocked(github.NewClient(&http.Client{Transport: transport}), "acme", "spammer", true)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.True(t, output.Blocked)
+		assert.False(t, output.Changed)
+	})
+
+	t.Run("user is unblocked", func(t *testing.T) {
+		transport := blockTransport(true)
+		output, err := setUserBlocked(github.NewClient(&http.Client{Transport: transport}), "acme", "spammer", false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodDelete, transport.requests[1].Method)
+		assert.False(t, output.Blocked)
+		assert.True(t, output.Changed)
+	})
+
+	t.Run("missing permission -> error with guidance", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := setUserBlocked(github.NewClient(&http.Client{Transport: transport}), "acme", "spammer", true)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Contains(t, err.Error(), "grant it the Blocking users (write) organization permission")
+	})
+}
+
+func Test__BlockUser__Organization(t *testing.T) {
+	appMetadata := Metadata{Owner: "acme"}
+	assert.Equal(t, "acme", blockOrganization(BlockUserConfiguration{}, appMetadata))
+	assert.Equal(t, "other", blockOrganization(BlockUserConfiguration{Organization: "other"}, appMetadata))
+}
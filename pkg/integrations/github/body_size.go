@@ -0,0 +1,77 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+/*
+ * GitHub rejects bodies over these lengths
+ * with a generic validation error.
+ */
+const (
+	MaxCommentBodyLength     = 65536
+	MaxPullRequestBodyLength = 65536
+	MaxReleaseBodyLength     = 125000
+)
+
+const (
+	OnOversizeTruncate = "truncate"
+	OnOversizeFail     = "fail"
+)
+
+const TruncationNotice = "\n\n…(truncated)"
+
+var ErrBodyTooLarge = errors.New("body is too large")
+
+var OnOversizeField = configuration.Field{
+	Name:     "onOversize",
+	Label:    "On Oversize",
+	Type:     configuration.FieldTypeSelect,
+	Required: true,
+	Default:  OnOversizeTruncate,
+	TypeOptions: &configuration.TypeOptions{
+		Select: &configuration.SelectTypeOptions{
+			Options: []configuration.FieldOption{
+				{Label: "Truncate", Value: OnOversizeTruncate},
+				{Label: "Fail", Value: OnOversizeFail},
+			},
+		},
+	},
+	Description: "What to do when the body is longer than GitHub allows",
+}
+
+func validateOnOversize(onOversize string) error {
+	if onOversize != "" && !slices.Contains([]string{OnOversizeTruncate, OnOversizeFail}, onOversize) {
+		return fmt.Errorf("invalid on oversize: %s", onOversize)
+	}
+
+	return nil
+}
+
+/*
+ * Returns the body to send to GitHub, and whether it was truncated.
+ * Lengths are counted in characters, not bytes, like GitHub does.
+ */
+func guardBodySize(body string, limit int, onOversize string) (string, bool, error) {
+	length := utf8.RuneCountInString(body)
+	if length <= limit {
+		return body, false, nil
+	}
+
+	if onOversize == OnOversizeFail {
+		return "", false, fmt.Errorf("%w: %d characters, GitHub allows at most %d", ErrBodyTooLarge, length, limit)
+	}
+
+	runes := []rune(body)
+	keep := limit - utf8.RuneCountInString(TruncationNotice)
+	if keep <= 0 {
+		return string(runes[:limit]), true, nil
+	}
+
+	return string(runes[:keep]) + TruncationNotice, true, nil
+}
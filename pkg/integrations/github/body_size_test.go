@@ -0,0 +1,81 @@
+package github
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__GuardBodySize(t *testing.T) {
+	t.Run("body within the limit is not changed", func(t *testing.T) {
+		body, truncated, err := guardBodySize("hello", 5, OnOversizeFail)
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, "hello", body)
+	})
+
+	t.Run("oversized body is truncated with a notice", func(t *testing.T) {
+		body, truncated, err := guardBodySize(strings.Repeat("a", MaxCommentBodyLength+10), MaxCommentBodyLength, OnOversizeTruncate)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, MaxCommentBodyLength, utf8.RuneCountInString(body))
+		assert.True(t, strings.HasSuffix(body, TruncationNotice))
+	})
+
+	t.Run("truncate is the default", func(t *testing.T) {
+		_, truncated, err := guardBodySize(strings.Repeat("a", 40), 30, "")
+		require.NoError(t, err)
+		assert.True(t, truncated)
+	})
+
+	t.Run("limit shorter than the notice -> body is cut without it", func(t *testing.T) {
+		body, truncated, err := guardBodySize("hello world", 5, OnOversizeTruncate)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, "hello", body)
+	})
+
+	t.Run("length is counted in characters", func(t *testing.T) {
+		body, truncated, err := guardBodySize(strings.Repeat("é", 20), 20, OnOversizeFail)
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, strings.Repeat("é", 20), body)
+
+		body, truncated, err = guardBodySize(strings.Repeat("é", 30), 20, OnOversizeTruncate)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.True(t, utf8.ValidString(body))
+		assert.Equal(t, 20, utf8.RuneCountInString(body))
+	})
+
+	t.Run("oversized body with fail -> error", func(t *testing.T) {
+		_, _, err := guardBodySize(strings.Repeat("a", MaxReleaseBodyLength+1), MaxReleaseBodyLength, OnOversizeFail)
+		require.ErrorIs(t, err, ErrBodyTooLarge)
+		assert.Contains(t, err.Error(), "at most 125000")
+	})
+}
+
+func Test__ValidateOnOversize(t *testing.T) {
+	require.NoError(t, validateOnOversize(""))
+	require.NoError(t, validateOnOversize(OnOversizeFail))
+	require.ErrorContains(t, validateOnOversize("drop"), "invalid on oversize")
+}
+
+func Test__ReleaseOutput(t *testing.T) {
+	data, err := json.Marshal(&ReleaseOutput{
+		RepositoryRelease: &github.RepositoryRelease{TagName: github.Ptr("v1.0.0")},
+		Truncated:         true,
+	})
+
+	require.NoError(t, err)
+
+	var output map[string]any
+	require.NoError(t, json.Unmarshal(data, &output))
+	assert.Equal(t, "v1.0.0", output["tag_name"])
+	assert.Equal(t, true, output["truncated"])
+}
@@ -0,0 +1,538 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultBulkCloseMaxIssues = 50
+
+	/*
+	 * The search API returns at most 1000 results for a query.
+	 */
+	MaxBulkCloseIssues = 1000
+)
+
+type BulkCloseIssues struct{}
+
+type BulkCloseIssuesConfiguration struct {
+	Repository         string `json:"repository" mapstructure:"repository"`
+	Query              string `json:"query" mapstructure:"query"`
+	Comment            string `json:"comment" mapstructure:"comment"`
+	MaxIssues          *int   `json:"maxIssues" mapstructure:"maxIssues"`
+	DryRun             bool   `json:"dryRun" mapstructure:"dryRun"`
+	RateLimitThreshold *int   `json:"rateLimitThreshold" mapstructure:"rateLimitThreshold"`
+}
+
+type BulkCloseResult struct {
+	Number int    `json:"number" mapstructure:"number"`
+	Title  string `json:"title" mapstructure:"title"`
+	URL    string `json:"html_url" mapstructure:"html_url"`
+	Closed bool   `json:"closed" mapstructure:"closed"`
+	Error  string `json:"error,omitempty" mapstructure:"error"`
+}
+
+/*
+ * A bulk close keeps what it needs to resume after a rate limit pause in its metadata:
+ * the resolved configuration, the issues to close, and the results so far.
+ * The number of results is the cursor of the next issue to close.
+ */
+type BulkCloseIssuesMetadata struct {
+	Repository         string             `json:"repository" mapstructure:"repository"`
+	Query              string             `json:"query" mapstructure:"query"`
+	TotalCount         int                `json:"total_count" mapstructure:"total_count"`
+	Comment            string             `json:"comment,omitempty" mapstructure:"comment"`
+	RateLimitThreshold *int               `json:"rate_limit_threshold,omitempty" mapstructure:"rate_limit_threshold"`
+	Issues             []BulkIssue        `json:"issues" mapstructure:"issues"`
+	Results            []BulkCloseResult  `json:"results" mapstructure:"results"`
+	Progress           *RateLimitProgress `json:"progress,omitempty" mapstructure:"progress"`
+}
+
+func (c *BulkCloseIssues) Name() string {
+	return "github.bulkCloseIssues"
+}
+
+func (c *BulkCloseIssues) Label() string {
+	return "Bulk Close Issues"
+}
+
+func (c *BulkCloseIssues) Description() string {
+	return "Close every open GitHub issue matching a search query"
+}
+
+func (c *BulkCloseIssues) Documentation() string {
+	return `The Bulk Close Issues component closes every open issue of a repository that matches a search query.
+
+## Use Cases
+
+- **Stale issue cleanup**: Close issues that have not been updated in months
+- **Release cleanup**: Close issues labeled for a version that was released
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Query**: GitHub issue search qualifiers, for example ` + "`label:stale updated:<2025-01-01`" + `.
+  The search is always limited to open issues of the selected repository
+- **Comment**: Optional comment added to each issue before it is closed (supports markdown and expressions)
+- **Max Issues**: If more issues than this match the query, nothing is closed and the execution fails. Defaults to 50
+- **Dry Run**: Only list the issues that would be closed
+- **Rate Limit Threshold**: Before closing each issue, pause until the rate limit resets if fewer than this many API requests are left
+
+## Output
+
+Emits the ` + "`results`" + ` for each issue, with its ` + "`number`" + `, ` + "`title`" + `, whether it was ` + "`closed`" + `, and the ` + "`error`" + ` if closing it failed.
+` + "`closed_count`" + ` and ` + "`failed_count`" + ` summarize the results.
+
+## Notes
+
+- The query is always scoped to the selected repository, so it cannot use repo:, org: or user: qualifiers
+- Failing to close one issue does not stop the others from being closed
+- Rate limited requests are retried. With a rate limit threshold, the execution pauses before running out of requests instead,
+  and resumes from the next issue once the rate limit resets. Its metadata shows the ` + "`progress`" + `, with the time it is ` + "`paused_until`" + `, if any`
+}
+
+func (c *BulkCloseIssues) Icon() string {
+	return "github"
+}
+
+func (c *BulkCloseIssues) Color() string {
+	return "gray"
+}
+
+func (c *BulkCloseIssues) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *BulkCloseIssues) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "query",
+			Label:       "Query",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., label:stale updated:<2025-01-01",
+			Description: "Issue search qualifiers. Only open issues of the repository are matched",
+		},
+		{
+			Name:        "comment",
+			Label:       "Comment",
+			Type:        configuration.FieldTypeText,
+			Description: "Comment added to each issue before it is closed",
+		},
+		{
+			Name:        "maxIssues",
+			Label:       "Max Issues",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultBulkCloseMaxIssues,
+			Description: "Fail without closing anything if more issues than this match",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxBulkCloseIssues; return &max }(),
+				},
+			},
+		},
+		{
+			Name:        "dryRun",
+			Label:       "Dry Run",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only list the issues that would be closed",
+		},
+		RateLimitThresholdField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *BulkCloseIssues) Setup(ctx core.SetupContext) error {
+	var config BulkCloseIssuesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Query) == "" {
+		return errors.New("query is required")
+	}
+
+	if err := validateBulkCloseQuery(config.Query); err != nil {
+		return err
+	}
+
+	if config.MaxIssues != nil && (*config.MaxIssues < 1 || *config.MaxIssues > MaxBulkCloseIssues) {
+		return fmt.Errorf("max issues must be between 1 and %d", MaxBulkCloseIssues)
+	}
+
+	if err := validateRateLimitThreshold(config.RateLimitThreshold); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *BulkCloseIssues) Execute(ctx core.ExecutionContext) error {
+	var config BulkCloseIssuesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	//
+	// The query can use expressions, so it is validated again once resolved.
+	//
+	if err := validateBulkCloseQuery(config.Query); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	maxIssues := bulkCloseMaxIssues(config)
+	query := bulkCloseQuery(config.Query, appMetadata.Owner, config.Repository)
+	issues, total, err := searchIssues(client, query, maxIssues)
+	if err != nil {
+		return err
+	}
+
+	if total > maxIssues && !config.DryRun {
+		return fmt.Errorf("%d issues match the query, but at most %d can be closed - refine the query or raise max issues", total, maxIssues)
+	}
+
+	if config.DryRun {
+		results := []BulkCloseResult{}
+		for _, issue := range bulkIssues(issues) {
+			result := bulkCloseResult(issue)
+			if !issueInRepository(issue.RepositoryURL, appMetadata.Owner, config.Repository) {
+				result.Error = fmt.Sprintf("issue is not in %s/%s", appMetadata.Owner, config.Repository)
+			}
+
+			results = append(results, result)
+		}
+
+		return emitBulkCloseResults(ctx.ExecutionState, query, total, true, results)
+	}
+
+	metadata := BulkCloseIssuesMetadata{
+		Repository:         config.Repository,
+		Query:              query,
+		TotalCount:         total,
+		Comment:            config.Comment,
+		RateLimitThreshold: config.RateLimitThreshold,
+		Issues:             bulkIssues(issues),
+		Results:            []BulkCloseResult{},
+	}
+
+	return c.closeAll(client, ctx.Logger, ctx.Metadata, ctx.ExecutionState, ctx.Requests, appMetadata.Owner, metadata)
+}
+
+/*
+ * Closes the issues that were not closed yet, starting from the cursor.
+ * If the rate limit threshold is reached, the cursor is saved in the metadata,
+ * and the rest of the issues are closed by the resume action, once the rate limit resets.
+ */
+func (c *BulkCloseIssues) closeAll(
+	client *github.Client,
+	logger *log.Entry,
+	metadataCtx core.MetadataContext,
+	state core.ExecutionStateContext,
+	requests core.RequestContext,
+	owner string,
+	metadata BulkCloseIssuesMetadata,
+) error {
+	scheduler := newRateLimitScheduler(client, metadata.RateLimitThreshold, func(progress RateLimitProgress) {
+		if progress.PausedUntil != "" {
+			logger.Infof("%d of %d issues closed, %d requests left - pausing until %s", progress.Completed, progress.Total, progress.Remaining, progress.PausedUntil)
+		}
+
+		metadata.Progress = &progress
+	})
+
+	results, pause, err := closeIssues(client, owner, metadata.Repository, metadata.Issues, metadata.Comment, scheduler, metadata.Results)
+	if err != nil {
+		return err
+	}
+
+	metadata.Results = results
+	if err := metadataCtx.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	if pause > 0 {
+		return requests.ScheduleActionCall(RateLimitResumeAction, map[string]any{}, pause)
+	}
+
+	return emitBulkCloseResults(state, metadata.Query, metadata.TotalCount, false, results)
+}
+
+func emitBulkCloseResults(state core.ExecutionStateContext, query string, total int, dryRun bool, results []BulkCloseResult) error {
+	closed := 0
+	for _, result := range results {
+		if result.Closed {
+			closed++
+		}
+	}
+
+	failed := 0
+	if !dryRun {
+		failed = len(results) - closed
+	}
+
+	return state.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issues.closed",
+		[]any{map[string]any{
+			"query":        query,
+			"total_count":  total,
+			"dry_run":      dryRun,
+			"closed_count": closed,
+			"failed_count": failed,
+			"results":      results,
+		}},
+	)
+}
+
+func bulkCloseMaxIssues(config BulkCloseIssuesConfiguration) int {
+	if config.MaxIssues == nil || *config.MaxIssues < 1 {
+		return DefaultBulkCloseMaxIssues
+	}
+
+	return min(*config.MaxIssues, MaxBulkCloseIssues)
+}
+
+/*
+ * GitHub ORs repeated scope qualifiers, so a repo:, org: or user: qualifier
+ * in the query would also match issues outside of the configured repository.
+ */
+var bulkCloseScopeQualifierRegex = regexp.MustCompile(`(?i)(^|\s)-?(repo|org|user):`)
+
+func validateBulkCloseQuery(query string) error {
+	if bulkCloseScopeQualifierRegex.MatchString(query) {
+		return errors.New("query cannot use repo:, org: or user: qualifiers - the configured repository is always used")
+	}
+
+	return nil
+}
+
+func bulkCloseQuery(query, owner, repository string) string {
+	return fmt.Sprintf("%s repo:%s/%s is:issue is:open", strings.TrimSpace(query), owner, repository)
+}
+
+/*
+ * Returns up to limit issues matching the query,
+ * and the total number of issues matching it.
+ */
+func searchIssues(client *github.Client, query string, limit int) ([]*github.Issue, int, error) {
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: min(limit, 100)}}
+	issues := []*github.Issue{}
+	total := 0
+
+	for {
+		var result *github.IssuesSearchResult
+		var response *github.Response
+		err := withRateLimitRetry("search issues", func() error {
+			var err error
+			result, response, err = client.Search.Issues(context.Background(), query, opts)
+			return err
+		})
+
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to search issues: %w", err)
+		}
+
+		total = result.GetTotal()
+		for _, issue := range result.Issues {
+			if len(issues) >= limit {
+				break
+			}
+
+			issues = append(issues, issue)
+		}
+
+		if len(issues) >= limit || response.NextPage == 0 {
+			return issues, total, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Closes the issues after the ones that already have results,
+ * and returns all the results. If the rate limit threshold is reached,
+ * it stops, and returns how long to pause before closing the rest.
+ */
+func closeIssues(client *github.Client, owner, repository string, issues []BulkIssue, comment string, scheduler *rateLimitScheduler, results []BulkCloseResult) ([]BulkCloseResult, time.Duration, error) {
+	for i := len(results); i < len(issues); i++ {
+		issue := issues[i]
+		result := bulkCloseResult(issue)
+
+		//
+		// Issues are closed by number, so an issue from another repository
+		// would close the issue with the same number in the configured one.
+		//
+		if !issueInRepository(issue.RepositoryURL, owner, repository) {
+			result.Error = fmt.Sprintf("issue is not in %s/%s", owner, repository)
+			results = append(results, result)
+			continue
+		}
+
+		pause, err := scheduler.Pause(i, len(issues))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if pause > 0 {
+			return results, pause, nil
+		}
+
+		err = closeIssue(client, owner, repository, issue.Number, comment)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Closed = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results, 0, nil
+}
+
+func issueInRepository(repositoryURL, owner, repository string) bool {
+	return strings.HasSuffix(
+		strings.ToLower(repositoryURL),
+		strings.ToLower(fmt.Sprintf("/repos/%s/%s", owner, repository)),
+	)
+}
+
+func closeIssue(client *github.Client, owner, repository string, number int, comment string) error {
+	if comment != "" {
+		err := withRateLimitRetry(fmt.Sprintf("comment on issue %d", number), func() error {
+			_, _, err := client.Issues.CreateComment(context.Background(), owner, repository, number, &github.IssueComment{Body: &comment})
+			return err
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to comment: %w", err)
+		}
+	}
+
+	err := withRateLimitRetry(fmt.Sprintf("close issue %d", number), func() error {
+		_, _, err := client.Issues.Edit(context.Background(), owner, repository, number, &github.IssueRequest{State: github.Ptr("closed")})
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to close: %w", err)
+	}
+
+	return nil
+}
+
+func bulkCloseResult(issue BulkIssue) BulkCloseResult {
+	return BulkCloseResult{
+		Number: issue.Number,
+		Title:  issue.Title,
+		URL:    issue.URL,
+	}
+}
+
+func (c *BulkCloseIssues) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *BulkCloseIssues) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *BulkCloseIssues) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           RateLimitResumeAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *BulkCloseIssues) HandleAction(ctx core.ActionContext) error {
+	if ctx.Name != RateLimitResumeAction {
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	//
+	// The action configuration is not resolved,
+	// so everything needed to resume comes from the metadata.
+	//
+	metadata := BulkCloseIssuesMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	return c.closeAll(client, ctx.Logger, ctx.Metadata, ctx.ExecutionState, ctx.Requests, appMetadata.Owner, metadata)
+}
+
+func (c *BulkCloseIssues) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *BulkCloseIssues) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__BulkCloseIssues__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := BulkCloseIssues{}
+
+	t.Run("query is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": " "},
+		})
+
+		require.ErrorContains(t, err, "query is required")
+	})
+
+	t.Run("max issues over the search limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": "label:stale", "maxIssues": 5000},
+		})
+
+		require.ErrorContains(t, err, "max issues must be between 1 and 1000")
+	})
+
+	t.Run("scope qualifiers in the query -> error", func(t *testing.T) {
+		for _, query := range []string{"label:stale repo:other/repo", "org:other label:stale", "label:stale USER:someone"} {
+			err := component.Setup(core.SetupContext{
+				Integration:   &contexts.IntegrationContext{},
+				Metadata:      &contexts.MetadataContext{},
+				Configuration: map[string]any{"repository": "hello", "query": query},
+			})
+
+			require.ErrorContains(t, err, "cannot use repo:, org: or user: qualifiers", query)
+		}
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "query": "label:stale", "dryRun": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__BulkCloseIssues__Query(t *testing.T) {
+	assert.Equal(t, "label:stale repo:testhq/hello is:issue is:open", bulkCloseQuery(" label:stale ", "testhq", "hello"))
+}
+
+func Test__BulkCloseIssues__SearchIssues(t *testing.T) {
+	issuesPage := func(total int, numbers ...int) string {
+		issues := []map[string]any{}
+		for _, number := range numbers {
+			issues = append(issues, map[string]any{"number": number, "title": fmt.Sprintf("Issue %d", number)})
+		}
+
+		data, _ := json.Marshal(map[string]any{"total_count": total, "items": issues})
+		return string(data)
+	}
+
+	t.Run("pages are fetched until the limit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "" {
+				response := mockResponse(http.StatusOK, issuesPage(5, 1, 2))
+				response.Header = http.Header{"Link": []string{`<https://api.github.com/search/issues?page=2>; rel="next"`}}
+				return response, nil
+			}
+
+			return mockResponse(http.StatusOK, issuesPage(5, 3, 4)), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		issues, total, err := searchIssues(client, "label:stale", 3)
+		require.NoError(t, err)
+		assert.Equal(t, 5, total)
+		require.Len(t, issues, 3)
+		assert.Equal(t, 3, issues[2].GetNumber())
+		assert.Equal(t, "3", transport.requests[0].URL.Query().Get("per_page"))
+	})
+
+	t.Run("rate limited search is retried", func(t *testing.T) {
+		originalWait := RateLimitRetryWait
+		RateLimitRetryWait = 0
+		t.Cleanup(func() { RateLimitRetryWait = originalWait })
+
+		attempts := 0
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return mockResponse(http.StatusTooManyRequests, `{"message":"slow down"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, issuesPage(1, 7)), nil
+		}}
+
+		issues, _, err := searchIssues(github.NewClient(&http.Client{Transport: transport}), "label:stale", 10)
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusUnprocessableEntity, `{"message":"Validation Failed"}`), nil
+		}}
+
+		_, _, err := searchIssues(github.NewClient(&http.Client{Transport: transport}), "label:", 10)
+		require.ErrorContains(t, err, "failed to search issues")
+		assert.Len(t, transport.requests, 1)
+	})
+}
+
+func Test__BulkCloseIssues__CloseIssues(t *testing.T) {
+	issues := []*github.Issue{
+		{Number: github.Ptr(1), Title: github.Ptr("First"), RepositoryURL: github.Ptr("https://api.github.com/repos/testhq/hello")},
+		{Number: github.Ptr(2), Title: github.Ptr("Second"), RepositoryURL: github.Ptr("https://api.github.com/repos/testhq/hello")},
+		{Number: github.Ptr(3), Title: github.Ptr("Elsewhere"), RepositoryURL: github.Ptr("https://api.github.com/repos/other/repo")},
+	}
+
+	comments := []int{}
+	closed := []int{}
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		switch {
+		case request.Method == http.MethodPost && request.URL.Path == "/repos/testhq/hello/issues/1/comments":
+			comments = append(comments, 1)
+			return mockResponse(http.StatusCreated, `{"id":1}`), nil
+		case request.Method == http.MethodPost && request.URL.Path == "/repos/testhq/hello/issues/2/comments":
+			comments = append(comments, 2)
+			return mockResponse(http.StatusCreated, `{"id":2}`), nil
+		case request.Method == http.MethodPatch && request.URL.Path == "/repos/testhq/hello/issues/1":
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"state":"closed"}`, string(body))
+			closed = append(closed, 1)
+			return mockResponse(http.StatusOK, `{"number":1,"state":"closed"}`), nil
+		case request.Method == http.MethodPatch && request.URL.Path == "/repos/testhq/hello/issues/2":
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		default:
+			return nil, fmt.Errorf("unexpected request: %s %s", request.Method, request.URL.String())
+		}
+	}}
+
+	results, pause, err := closeIssues(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", bulkIssues(issues), "Closing as stale", nil, []BulkCloseResult{})
+	require.NoError(t, err)
+	assert.Zero(t, pause)
+	require.Len(t, results, 3)
+	assert.Equal(t, []int{1, 2}, comments)
+	assert.Equal(t, []int{1}, closed)
+
+	assert.True(t, results[0].Closed)
+	assert.Empty(t, results[0].Error)
+
+	assert.False(t, results[1].Closed)
+	assert.Contains(t, results[1].Error, "failed to close")
+	assert.Contains(t, results[1].Error, ErrPermissionDenied.Error())
+
+	assert.False(t, results[2].Closed)
+	assert.Equal(t, "issue is not in testhq/hello", results[2].Error)
+}
@@ -0,0 +1,444 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultBulkLockMaxItems = 50
+
+var LockReasons = []string{"off-topic", "too heated", "resolved", "spam"}
+
+type BulkLockConversations struct{}
+
+type BulkLockConversationsConfiguration struct {
+	Repository         string `json:"repository" mapstructure:"repository"`
+	Query              string `json:"query" mapstructure:"query"`
+	LockReason         string `json:"lockReason" mapstructure:"lockReason"`
+	MaxItems           *int   `json:"maxItems" mapstructure:"maxItems"`
+	RateLimitThreshold *int   `json:"rateLimitThreshold" mapstructure:"rateLimitThreshold"`
+}
+
+type BulkLockResult struct {
+	Number        int    `json:"number" mapstructure:"number"`
+	Title         string `json:"title" mapstructure:"title"`
+	URL           string `json:"html_url" mapstructure:"html_url"`
+	PullRequest   bool   `json:"pull_request" mapstructure:"pull_request"`
+	Locked        bool   `json:"locked" mapstructure:"locked"`
+	AlreadyLocked bool   `json:"already_locked,omitempty" mapstructure:"already_locked"`
+	Error         string `json:"error,omitempty" mapstructure:"error"`
+}
+
+/*
+ * A bulk lock keeps what it needs to resume after a rate limit pause in its metadata:
+ * the resolved configuration, the items to lock, and the results so far.
+ * The number of results is the cursor of the next item to lock.
+ */
+type BulkLockConversationsMetadata struct {
+	Repository         string             `json:"repository" mapstructure:"repository"`
+	Query              string             `json:"query" mapstructure:"query"`
+	LockReason         string             `json:"lock_reason" mapstructure:"lock_reason"`
+	RateLimitThreshold *int               `json:"rate_limit_threshold,omitempty" mapstructure:"rate_limit_threshold"`
+	Issues             []BulkIssue        `json:"issues" mapstructure:"issues"`
+	Results            []BulkLockResult   `json:"results" mapstructure:"results"`
+	Progress           *RateLimitProgress `json:"progress,omitempty" mapstructure:"progress"`
+}
+
+func (c *BulkLockConversations) Name() string {
+	return "github.bulkLockConversations"
+}
+
+func (c *BulkLockConversations) Label() string {
+	return "Bulk Lock Conversations"
+}
+
+func (c *BulkLockConversations) Description() string {
+	return "Lock the conversation of every open GitHub issue and pull request matching a search query"
+}
+
+func (c *BulkLockConversations) Documentation() string {
+	return `The Bulk Lock Conversations component locks the conversation of every open issue and pull request of a repository that matches a search query.
+
+## Use Cases
+
+- **Incident follow-up**: Lock the issues opened about an incident once it is resolved
+- **Moderation**: Lock heated or off-topic threads matching a label
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Query**: GitHub issue search qualifiers, for example ` + "`label:incident-2026-01`" + `.
+  The search is always limited to open issues and pull requests of the selected repository. Add ` + "`is:issue`" + ` or ` + "`is:pr`" + ` to only match one of them
+- **Lock Reason**: Optional reason shown on each locked conversation: off-topic, too heated, resolved or spam
+- **Max Items**: If more items than this match the query, nothing is locked and the execution fails. Defaults to 50
+- **Rate Limit Threshold**: Before locking each item, pause until the rate limit resets if fewer than this many API requests are left
+
+## Output
+
+Emits a ` + "`github.conversations.locked`" + ` event with the ` + "`results`" + ` for each item: its ` + "`number`" + `, ` + "`title`" + `, whether it is a ` + "`pull_request`" + `,
+whether it was ` + "`locked`" + ` or ` + "`already_locked`" + `, and the ` + "`error`" + ` if locking it failed.
+` + "`locked_count`" + `, ` + "`skipped_count`" + ` and ` + "`failed_count`" + ` summarize the results.
+
+## Notes
+
+- The query is always scoped to the selected repository, so it cannot use repo:, org: or user: qualifiers
+- Conversations that are already locked are skipped, and keep their lock reason
+- Failing to lock one item does not stop the others from being locked
+- With a rate limit threshold, the execution pauses before running out of requests, and resumes from the next item once the rate limit resets.
+  Its metadata shows the ` + "`progress`" + `, with the time it is ` + "`paused_until`" + `, if any`
+}
+
+func (c *BulkLockConversations) Icon() string {
+	return "github"
+}
+
+func (c *BulkLockConversations) Color() string {
+	return "gray"
+}
+
+func (c *BulkLockConversations) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *BulkLockConversations) Configuration() []configuration.Field {
+	reasons := []configuration.FieldOption{}
+	for _, reason := range LockReasons {
+		reasons = append(reasons, configuration.FieldOption{Label: strings.ToUpper(reason[:1]) + reason[1:], Value: reason})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "query",
+			Label:       "Query",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., label:incident-2026-01",
+			Description: "Issue search qualifiers. Only open issues and pull requests of the repository are matched",
+		},
+		{
+			Name:        "lockReason",
+			Label:       "Lock Reason",
+			Type:        configuration.FieldTypeSelect,
+			Togglable:   true,
+			Description: "Reason shown on each locked conversation",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: reasons,
+				},
+			},
+		},
+		{
+			Name:        "maxItems",
+			Label:       "Max Items",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultBulkLockMaxItems,
+			Description: "Fail without locking anything if more items than this match",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxBulkCloseIssues; return &max }(),
+				},
+			},
+		},
+		RateLimitThresholdField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *BulkLockConversations) Setup(ctx core.SetupContext) error {
+	var config BulkLockConversationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Query) == "" {
+		return errors.New("query is required")
+	}
+
+	if err := validateBulkCloseQuery(config.Query); err != nil {
+		return err
+	}
+
+	if config.LockReason != "" && !slices.Contains(LockReasons, config.LockReason) {
+		return fmt.Errorf("invalid lock reason: %s", config.LockReason)
+	}
+
+	if config.MaxItems != nil && (*config.MaxItems < 1 || *config.MaxItems > MaxBulkCloseIssues) {
+		return fmt.Errorf("max items must be between 1 and %d", MaxBulkCloseIssues)
+	}
+
+	if err := validateRateLimitThreshold(config.RateLimitThreshold); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *BulkLockConversations) Execute(ctx core.ExecutionContext) error {
+	var config BulkLockConversationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	//
+	// The query can use expressions, so it is validated again once resolved.
+	//
+	if err := validateBulkCloseQuery(config.Query); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	maxItems := DefaultBulkLockMaxItems
+	if config.MaxItems != nil && *config.MaxItems > 0 {
+		maxItems = min(*config.MaxItems, MaxBulkCloseIssues)
+	}
+
+	query := bulkLockQuery(config.Query, appMetadata.Owner, config.Repository)
+	issues, total, err := searchIssues(client, query, maxItems)
+	if err != nil {
+		return err
+	}
+
+	if total > maxItems {
+		return fmt.Errorf("%d items match the query, but at most %d can be locked - refine the query or raise max items", total, maxItems)
+	}
+
+	metadata := BulkLockConversationsMetadata{
+		Repository:         config.Repository,
+		Query:              query,
+		LockReason:         config.LockReason,
+		RateLimitThreshold: config.RateLimitThreshold,
+		Issues:             bulkIssues(issues),
+		Results:            []BulkLockResult{},
+	}
+
+	return c.lockAll(client, ctx.Logger, ctx.Metadata, ctx.ExecutionState, ctx.Requests, appMetadata.Owner, metadata)
+}
+
+/*
+ * Locks the conversations that were not locked yet, starting from the cursor.
+ * If the rate limit threshold is reached, the cursor is saved in the metadata,
+ * and the rest of the conversations are locked by the resume action, once the rate limit resets.
+ */
+func (c *BulkLockConversations) lockAll(
+	client *github.Client,
+	logger *log.Entry,
+	metadataCtx core.MetadataContext,
+	state core.ExecutionStateContext,
+	requests core.RequestContext,
+	owner string,
+	metadata BulkLockConversationsMetadata,
+) error {
+	scheduler := newRateLimitScheduler(client, metadata.RateLimitThreshold, func(progress RateLimitProgress) {
+		if progress.PausedUntil != "" {
+			logger.Infof("%d of %d items locked, %d requests left - pausing until %s", progress.Completed, progress.Total, progress.Remaining, progress.PausedUntil)
+		}
+
+		metadata.Progress = &progress
+	})
+
+	results, pause, err := lockConversations(client, owner, metadata.Repository, metadata.Issues, metadata.LockReason, scheduler, metadata.Results)
+	if err != nil {
+		return err
+	}
+
+	metadata.Results = results
+	if err := metadataCtx.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	if pause > 0 {
+		return requests.ScheduleActionCall(RateLimitResumeAction, map[string]any{}, pause)
+	}
+
+	locked, skipped, failed := 0, 0, 0
+	for _, result := range results {
+		switch {
+		case result.Locked:
+			locked++
+		case result.AlreadyLocked:
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	logger.Infof("Locked %d conversations, skipped %d, failed %d", locked, skipped, failed)
+
+	return state.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.conversations.locked",
+		[]any{map[string]any{
+			"query":         metadata.Query,
+			"lock_reason":   metadata.LockReason,
+			"total_count":   len(results),
+			"locked_count":  locked,
+			"skipped_count": skipped,
+			"failed_count":  failed,
+			"results":       results,
+		}},
+	)
+}
+
+func bulkLockQuery(query, owner, repository string) string {
+	return fmt.Sprintf("%s repo:%s/%s is:open", strings.TrimSpace(query), owner, repository)
+}
+
+/*
+ * Locks the conversations after the ones that already have results,
+ * and returns all the results. If the rate limit threshold is reached,
+ * it stops, and returns how long to pause before locking the rest.
+ */
+func lockConversations(client *github.Client, owner, repository string, issues []BulkIssue, reason string, scheduler *rateLimitScheduler, results []BulkLockResult) ([]BulkLockResult, time.Duration, error) {
+	for i := len(results); i < len(issues); i++ {
+		issue := issues[i]
+		result := BulkLockResult{
+			Number:      issue.Number,
+			Title:       issue.Title,
+			URL:         issue.URL,
+			PullRequest: issue.PullRequest,
+		}
+
+		if !issueInRepository(issue.RepositoryURL, owner, repository) {
+			result.Error = fmt.Sprintf("issue is not in %s/%s", owner, repository)
+			results = append(results, result)
+			continue
+		}
+
+		//
+		// Locking again would replace the lock reason set by whoever locked it first.
+		//
+		if issue.Locked {
+			result.AlreadyLocked = true
+			results = append(results, result)
+			continue
+		}
+
+		pause, err := scheduler.Pause(i, len(issues))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if pause > 0 {
+			return results, pause, nil
+		}
+
+		err = withRateLimitRetry(fmt.Sprintf("lock issue %d", result.Number), func() error {
+			_, err := client.Issues.Lock(context.Background(), owner, repository, result.Number, &github.LockIssueOptions{LockReason: reason})
+			return err
+		})
+
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to lock: %v", err)
+		} else {
+			result.Locked = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results, 0, nil
+}
+
+func (c *BulkLockConversations) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *BulkLockConversations) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *BulkLockConversations) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           RateLimitResumeAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *BulkLockConversations) HandleAction(ctx core.ActionContext) error {
+	if ctx.Name != RateLimitResumeAction {
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	//
+	// The action configuration is not resolved,
+	// so everything needed to resume comes from the metadata.
+	//
+	metadata := BulkLockConversationsMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	return c.lockAll(client, ctx.Logger, ctx.Metadata, ctx.ExecutionState, ctx.Requests, appMetadata.Owner, metadata)
+}
+
+func (c *BulkLockConversations) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *BulkLockConversations) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
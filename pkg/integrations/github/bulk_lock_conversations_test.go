@@ -0,0 +1,125 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__BulkLockConversations__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := BulkLockConversations{}
+
+	t.Run("query is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": " "},
+		})
+
+		require.ErrorContains(t, err, "query is required")
+	})
+
+	t.Run("scope qualifiers in the query -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": "label:incident repo:other/repo"},
+		})
+
+		require.ErrorContains(t, err, "cannot use repo:, org: or user: qualifiers")
+	})
+
+	t.Run("invalid lock reason -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": "label:incident", "lockReason": "boring"},
+		})
+
+		require.ErrorContains(t, err, "invalid lock reason: boring")
+	})
+
+	t.Run("max items over the search limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "query": "label:incident", "maxItems": 5000},
+		})
+
+		require.ErrorContains(t, err, "max items must be between 1 and 1000")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "query": "label:incident", "lockReason": "resolved"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__BulkLockConversations__Query(t *testing.T) {
+	assert.Equal(t, "label:incident repo:testhq/hello is:open", bulkLockQuery(" label:incident ", "testhq", "hello"))
+}
+
+func Test__BulkLockConversations__LockConversations(t *testing.T) {
+	repositoryURL := github.Ptr("https://api.github.com/repos/testhq/hello")
+	issues := []*github.Issue{
+		{Number: github.Ptr(1), Title: github.Ptr("Outage"), RepositoryURL: repositoryURL},
+		{Number: github.Ptr(2), Title: github.Ptr("Fix outage"), RepositoryURL: repositoryURL, PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/testhq/hello/pulls/2")}},
+		{Number: github.Ptr(3), Title: github.Ptr("Already locked"), RepositoryURL: repositoryURL, Locked: github.Ptr(true)},
+		{Number: github.Ptr(4), Title: github.Ptr("Forbidden"), RepositoryURL: repositoryURL},
+		{Number: github.Ptr(1), Title: github.Ptr("Outage"), RepositoryURL: repositoryURL},
+		{Number: github.Ptr(5), Title: github.Ptr("Elsewhere"), RepositoryURL: github.Ptr("https://api.github.com/repos/other/repo")},
+	}
+
+	locked := []string{}
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		if request.Method != http.MethodPut {
+			return nil, fmt.Errorf("unexpected request: %s %s", request.Method, request.URL.String())
+		}
+
+		if request.URL.Path == "/repos/testhq/hello/issues/4/lock" {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}
+
+		body, _ := io.ReadAll(request.Body)
+		assert.JSONEq(t, `{"lock_reason":"resolved"}`, string(body))
+		locked = append(locked, request.URL.Path)
+		return mockResponse(http.StatusNoContent, ``), nil
+	}}
+
+	results, pause, err := lockConversations(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", bulkIssues(issues), "resolved", nil, []BulkLockResult{})
+	require.NoError(t, err)
+	assert.Zero(t, pause)
+	require.Len(t, results, 5)
+	assert.Equal(t, []string{"/repos/testhq/hello/issues/1/lock", "/repos/testhq/hello/issues/2/lock"}, locked)
+
+	assert.True(t, results[0].Locked)
+	assert.False(t, results[0].PullRequest)
+
+	assert.True(t, results[1].Locked)
+	assert.True(t, results[1].PullRequest)
+
+	assert.False(t, results[2].Locked)
+	assert.True(t, results[2].AlreadyLocked)
+	assert.Empty(t, results[2].Error)
+
+	assert.False(t, results[3].Locked)
+	assert.Contains(t, results[3].Error, "failed to lock")
+	assert.Contains(t, results[3].Error, ErrPermissionDenied.Error())
+
+	assert.Equal(t, 5, results[4].Number)
+	assert.Equal(t, "issue is not in testhq/hello", results[4].Error)
+}
@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CancelInvitation struct{}
+
+type CancelInvitationConfiguration struct {
+	Scope        string `json:"scope" mapstructure:"scope"`
+	Repository   string `json:"repository" mapstructure:"repository"`
+	InvitationID string `json:"invitationId" mapstructure:"invitationId"`
+	Invitee      string `json:"invitee" mapstructure:"invitee"`
+}
+
+type CancelInvitationOutput struct {
+	Scope          string `json:"scope"`
+	Repository     string `json:"repository,omitempty"`
+	InvitationID   int64  `json:"invitation_id,omitempty"`
+	Invitee        string `json:"invitee,omitempty"`
+	AlreadyRemoved bool   `json:"already_removed"`
+	CancelledAt    string `json:"cancelled_at"`
+}
+
+func (c *CancelInvitation) Name() string {
+	return "github.cancelInvitation"
+}
+
+func (c *CancelInvitation) Label() string {
+	return "Cancel Invitation"
+}
+
+func (c *CancelInvitation) Description() string {
+	return "Cancel a pending invitation to a GitHub repository or organization"
+}
+
+func (c *CancelInvitation) Documentation() string {
+	return `The Cancel Invitation component cancels an invitation to a repository or organization that was not accepted yet.
+
+## Use Cases
+
+- **Invitation cleanup**: Cancel the stale invitations found by List Pending Invitations
+- **Offboarding**: Cancel the invitations of people who left before accepting them
+
+## Configuration
+
+- **Scope**: Cancel an invitation to a repository, or to the organization
+- **Repository**: Select the GitHub repository, for the repository scope
+- **Invitation ID**: The ID of the invitation (supports expressions)
+- **Invitee**: The login or email of the invitee, if the invitation ID is not known. Only one of the two can be set
+
+## Output
+
+Emits a ` + "`github.invitation.cancelled`" + ` event with the ` + "`invitation_id`" + `, the ` + "`invitee`" + ` if it was set, and ` + "`already_removed`" + `,
+which is true if there was no such invitation to cancel.
+
+## Notes
+
+- An invitation that does not exist, or was already accepted or cancelled, is not an error, so cleanup flows can be re-run
+- Finding an invitation by invitee lists all the pending invitations of the repository or organization
+- Repository invitations need the **Administration** write permission, and organization invitations the **Members** organization write permission.
+  The **Administration** write permission is only requested when the GitHub integration is set up with **Repository Administration** enabled`
+}
+
+func (c *CancelInvitation) Icon() string {
+	return "github"
+}
+
+func (c *CancelInvitation) Color() string {
+	return "gray"
+}
+
+func (c *CancelInvitation) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CancelInvitation) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "scope",
+			Label:    "Scope",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  InvitationScopeRepository,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Repository", Value: InvitationScopeRepository},
+						{Label: "Organization", Value: InvitationScopeOrganization},
+					},
+				},
+			},
+		},
+		{
+			Name:  "repository",
+			Label: "Repository",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "scope", Values: []string{InvitationScopeRepository}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "scope", Values: []string{InvitationScopeRepository}},
+			},
+		},
+		{
+			Name:        "invitationId",
+			Label:       "Invitation ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.id}}",
+			Description: "The ID of the invitation to cancel",
+		},
+		{
+			Name:        "invitee",
+			Label:       "Invitee",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., monalisa",
+			Description: "The login or email of the invitee, if the invitation ID is not known",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CancelInvitation) Setup(ctx core.SetupContext) error {
+	var config CancelInvitationConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.InvitationID == "" && config.Invitee == "" {
+		return errors.New("invitation ID or invitee is required")
+	}
+
+	if config.InvitationID != "" && config.Invitee != "" {
+		return errors.New("only one of invitation ID and invitee can be set")
+	}
+
+	if config.InvitationID != "" && !isExpression(config.InvitationID) {
+		if _, err := strconv.ParseInt(config.InvitationID, 10, 64); err != nil {
+			return fmt.Errorf("invitation ID is not a number: %s", config.InvitationID)
+		}
+	}
+
+	switch config.Scope {
+	case InvitationScopeOrganization:
+		return nil
+	case "", InvitationScopeRepository:
+		return ensureRepoInMetadata(
+			ctx.Metadata,
+			ctx.Integration,
+			ctx.Configuration,
+		)
+	}
+
+	return fmt.Errorf("invalid scope: %s", config.Scope)
+}
+
+func (c *CancelInvitation) Execute(ctx core.ExecutionContext) error {
+	var config CancelInvitationConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := cancelInvitation(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	if output.AlreadyRemoved {
+		ctx.Logger.Infof("Invitation not found - nothing to cancel")
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.invitation.cancelled",
+		[]any{output},
+	)
+}
+
+func cancelInvitation(client *github.Client, owner string, config CancelInvitationConfiguration) (*CancelInvitationOutput, error) {
+	scope := config.Scope
+	if scope == "" {
+		scope = InvitationScopeRepository
+	}
+
+	output := &CancelInvitationOutput{Scope: scope, Invitee: config.Invitee}
+	if scope == InvitationScopeRepository {
+		output.Repository = config.Repository
+	}
+
+	if config.InvitationID != "" {
+		invitationID, err := strconv.ParseInt(config.InvitationID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invitation ID is not a number: %v", err)
+		}
+
+		output.InvitationID = invitationID
+	} else {
+		invitationID, err := findInvitationID(client, owner, scope, config.Repository, config.Invitee)
+		if err != nil {
+			return nil, err
+		}
+
+		output.InvitationID = invitationID
+	}
+
+	output.CancelledAt = time.Now().Format(time.RFC3339)
+	if output.InvitationID == 0 {
+		output.AlreadyRemoved = true
+		return output, nil
+	}
+
+	var response *github.Response
+	var err error
+	if scope == InvitationScopeOrganization {
+		response, err = client.Organizations.CancelInvite(context.Background(), owner, output.InvitationID)
+	} else {
+		response, err = client.Repositories.DeleteInvitation(context.Background(), owner, config.Repository, output.InvitationID)
+	}
+
+	if err != nil {
+		if !isNotFound(response) {
+			return nil, fmt.Errorf("failed to cancel invitation %d: %w", output.InvitationID, wrapGitHubError(err))
+		}
+
+		output.AlreadyRemoved = true
+	}
+
+	return output, nil
+}
+
+/*
+ * Returns 0 if the invitee has no pending invitation.
+ * Logins and emails are case-insensitive on GitHub.
+ */
+func findInvitationID(client *github.Client, owner, scope, repository, invitee string) (int64, error) {
+	invitee = strings.TrimSpace(invitee)
+	if invitee == "" {
+		return 0, errors.New("invitation ID or invitee is required")
+	}
+
+	pending, err := listPendingInvitations(client, owner, ListPendingInvitationsConfiguration{Scope: scope, Repository: repository}, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, invitation := range pending.Invitations {
+		if strings.EqualFold(invitation.Login, invitee) || strings.EqualFold(invitation.Email, invitee) {
+			return invitation.ID, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (c *CancelInvitation) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CancelInvitation) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CancelInvitation) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CancelInvitation) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CancelInvitation) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CancelInvitation) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CancelInvitation__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CancelInvitation{}
+
+	t.Run("invitation ID or invitee is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": InvitationScopeOrganization},
+		})
+
+		require.ErrorContains(t, err, "invitation ID or invitee is required")
+	})
+
+	t.Run("invitation ID is not a number -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": InvitationScopeOrganization, "invitationId": "abc"},
+		})
+
+		require.ErrorContains(t, err, "invitation ID is not a number")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "invitee": "monalisa"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CancelInvitation__Cancel(t *testing.T) {
+	t.Run("repository invitation by ID is deleted", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNoContent, ``), nil
+		}}
+
+		config := CancelInvitationConfiguration{Repository: "hello", InvitationID: "7"}
+		output, err := cancelInvitation(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+
+		assert.Equal(t, http.MethodDelete, transport.requests[0].Method)
+		assert.Equal(t, "/repos/testhq/hello/invitations/7", transport.requests[0].URL.Path)
+		assert.Equal(t, int64(7), output.InvitationID)
+		assert.False(t, output.AlreadyRemoved)
+	})
+
+	t.Run("organization invitation by invitee is found and cancelled", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `[{"id":1,"login":"hubot"},{"id":2,"login":"MonaLisa"}]`), nil
+			}
+
+			return mockResponse(http.StatusNoContent, ``), nil
+		}}
+
+		config := CancelInvitationConfiguration{Scope: InvitationScopeOrganization, Invitee: "monalisa"}
+		output, err := cancelInvitation(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, "/orgs/testhq/invitations/2", transport.requests[1].URL.Path)
+		assert.Equal(t, int64(2), output.InvitationID)
+		assert.Equal(t, "monalisa", output.Invitee)
+	})
+
+	t.Run("invitee without invitation -> nothing to cancel", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[{"id":1,"invitee":{"login":"hubot"}}]`), nil
+		}}
+
+		config := CancelInvitationConfiguration{Repository: "hello", Invitee: "monalisa"}
+		output, err := cancelInvitation(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+		assert.True(t, output.AlreadyRemoved)
+	})
+
+	t.Run("missing invitation -> already removed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		config := CancelInvitationConfiguration{Repository: "hello", InvitationID: "7"}
+		output, err := cancelInvitation(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.True(t, output.AlreadyRemoved)
+	})
+}
@@ -0,0 +1,286 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ActionsSecretScopeRepository   = "repository"
+	ActionsSecretScopeEnvironment  = "environment"
+	ActionsSecretScopeOrganization = "organization"
+)
+
+type CheckActionsSecret struct{}
+
+type CheckActionsSecretConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	SecretName  string `json:"secretName" mapstructure:"secretName"`
+	Scope       string `json:"scope" mapstructure:"scope"`
+	Environment string `json:"environment" mapstructure:"environment"`
+}
+
+type ActionsSecretOutput struct {
+	Name        string     `json:"name"`
+	Scope       string     `json:"scope"`
+	Environment string     `json:"environment,omitempty"`
+	Exists      bool       `json:"exists"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Visibility  string     `json:"visibility,omitempty"`
+}
+
+func (c *CheckActionsSecret) Name() string {
+	return "github.checkActionsSecret"
+}
+
+func (c *CheckActionsSecret) Label() string {
+	return "Check Actions Secret"
+}
+
+func (c *CheckActionsSecret) Description() string {
+	return "Check whether a GitHub Actions secret exists, without reading its value"
+}
+
+func (c *CheckActionsSecret) Documentation() string {
+	return `The Check Actions Secret component checks whether a GitHub Actions secret exists, and when it was last updated.
+
+## Use Cases
+
+- **Workflow gates**: Verify that a workflow's secrets exist before running it
+- **Audits**: Find secrets that were not rotated recently
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Secret Name**: The name of the secret (supports expressions)
+- **Scope**: Where the secret is defined: the repository, one of its environments, or the organization
+- **Environment**: The environment name, for environment secrets (supports expressions)
+
+## Output
+
+Emits a ` + "`github.actionsSecret`" + ` event with the secret ` + "`name`" + `, its ` + "`scope`" + `, and ` + "`exists`" + `.
+If the secret exists, ` + "`updated_at`" + ` is set, and organization secrets also have their ` + "`visibility`" + `.
+
+## Notes
+
+- Only the secret metadata is read. GitHub never returns secret values
+- A missing secret is not an error: ` + "`exists`" + ` is false
+- For environment secrets, a missing environment is reported as a missing secret
+- Organization secrets are looked up in the organization that owns the repository, and need the organization secrets read permission`
+}
+
+func (c *CheckActionsSecret) Icon() string {
+	return "github"
+}
+
+func (c *CheckActionsSecret) Color() string {
+	return "gray"
+}
+
+func (c *CheckActionsSecret) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CheckActionsSecret) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "secretName",
+			Label:       "Secret Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., DEPLOY_KEY",
+		},
+		{
+			Name:     "scope",
+			Label:    "Scope",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ActionsSecretScopeRepository,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Repository", Value: ActionsSecretScopeRepository},
+						{Label: "Environment", Value: ActionsSecretScopeEnvironment},
+						{Label: "Organization", Value: ActionsSecretScopeOrganization},
+					},
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., production",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "scope", Values: []string{ActionsSecretScopeEnvironment}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "scope", Values: []string{ActionsSecretScopeEnvironment}},
+			},
+		},
+	}
+}
+
+func (c *CheckActionsSecret) Setup(ctx core.SetupContext) error {
+	var config CheckActionsSecretConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCheckActionsSecret(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateCheckActionsSecret(config CheckActionsSecretConfiguration) error {
+	if strings.TrimSpace(config.SecretName) == "" {
+		return errors.New("secret name is required")
+	}
+
+	scopes := []string{ActionsSecretScopeRepository, ActionsSecretScopeEnvironment, ActionsSecretScopeOrganization}
+	if config.Scope != "" && !slices.Contains(scopes, config.Scope) {
+		return fmt.Errorf("invalid scope: %s", config.Scope)
+	}
+
+	if config.Scope == ActionsSecretScopeEnvironment && strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required for environment secrets")
+	}
+
+	return nil
+}
+
+func (c *CheckActionsSecret) Execute(ctx core.ExecutionContext) error {
+	var config CheckActionsSecretConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCheckActionsSecret(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := checkActionsSecret(client, appMetadata.InstallationID, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Checked %s secret %s of %s: exists=%t", output.Scope, output.Name, config.Repository, output.Exists)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.actionsSecret",
+		[]any{output},
+	)
+}
+
+/*
+ * Reads the secret metadata from the endpoint of its scope.
+ * GitHub answers with a 404 for missing secrets, which is reported as exists: false.
+ */
+func checkActionsSecret(client *github.Client, installationID, owner string, config CheckActionsSecretConfiguration) (*ActionsSecretOutput, error) {
+	scope := config.Scope
+	if scope == "" {
+		scope = ActionsSecretScopeRepository
+	}
+
+	output := &ActionsSecretOutput{Name: config.SecretName, Scope: scope}
+
+	var secret *github.Secret
+	var response *github.Response
+	var err error
+
+	switch scope {
+	case ActionsSecretScopeEnvironment:
+		output.Environment = config.Environment
+
+		//
+		// The environment secrets endpoint takes the repository ID, not its name.
+		//
+		repository, repoErr := getRepository(client, installationID, owner, config.Repository)
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		secret, response, err = client.Actions.GetEnvSecret(context.Background(), int(repository.GetID()), config.Environment, config.SecretName)
+	case ActionsSecretScopeOrganization:
+		secret, response, err = client.Actions.GetOrgSecret(context.Background(), owner, config.SecretName)
+	default:
+		secret, response, err = client.Actions.GetRepoSecret(context.Background(), owner, config.Repository, config.SecretName)
+	}
+
+	if isNotFound(response) {
+		return output, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", config.SecretName, wrapGitHubError(err))
+	}
+
+	updatedAt := secret.UpdatedAt.Time
+	output.Exists = true
+	output.UpdatedAt = &updatedAt
+	output.Visibility = secret.Visibility
+	return output, nil
+}
+
+func (c *CheckActionsSecret) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CheckActionsSecret) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CheckActionsSecret) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CheckActionsSecret) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CheckActionsSecret) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CheckActionsSecret) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
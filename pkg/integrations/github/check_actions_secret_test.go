@@ -0,0 +1,140 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CheckActionsSecret__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CheckActionsSecret{}
+
+	t.Run("secret name is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "secret name is required")
+	})
+
+	t.Run("invalid scope -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "secretName": "DEPLOY_KEY", "scope": "enterprise"},
+		})
+
+		require.ErrorContains(t, err, "invalid scope: enterprise")
+	})
+
+	t.Run("environment is required for environment secrets", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "secretName": "DEPLOY_KEY", "scope": "environment"},
+		})
+
+		require.ErrorContains(t, err, "environment is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "secretName": "DEPLOY_KEY"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CheckActionsSecret__Check(t *testing.T) {
+	secretTransport := func(status int, body string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.Contains(request.URL.Path, "/secrets/") {
+				return mockResponse(status, body), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":123456,"name":"hello"}`), nil
+		}}
+	}
+
+	t.Run("repository secret exists", func(t *testing.T) {
+		transport := secretTransport(http.StatusOK, `{"name":"DEPLOY_KEY","created_at":"2026-01-01T10:00:00Z","updated_at":"2026-01-10T10:00:00Z"}`)
+		output, err := checkActionsSecret(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", CheckActionsSecretConfiguration{
+			Repository: "hello",
+			SecretName: "DEPLOY_KEY",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, "/repos/testhq/hello/actions/secrets/DEPLOY_KEY", transport.requests[0].URL.Path)
+		assert.True(t, output.Exists)
+		assert.Equal(t, ActionsSecretScopeRepository, output.Scope)
+		require.NotNil(t, output.UpdatedAt)
+		assert.Equal(t, 10, output.UpdatedAt.Day())
+	})
+
+	t.Run("missing secret -> exists is false", func(t *testing.T) {
+		transport := secretTransport(http.StatusNotFound, `{"message":"Not Found"}`)
+		output, err := checkActionsSecret(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", CheckActionsSecretConfiguration{
+			Repository: "hello",
+			SecretName: "DEPLOY_KEY",
+		})
+
+		require.NoError(t, err)
+		assert.False(t, output.Exists)
+		assert.Nil(t, output.UpdatedAt)
+	})
+
+	t.Run("environment secrets are looked up by repository ID", func(t *testing.T) {
+		transport := secretTransport(http.StatusOK, `{"name":"DEPLOY_KEY","updated_at":"2026-01-10T10:00:00Z"}`)
+		output, err := checkActionsSecret(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", CheckActionsSecretConfiguration{
+			Repository:  "hello",
+			SecretName:  "DEPLOY_KEY",
+			Scope:       ActionsSecretScopeEnvironment,
+			Environment: "production",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repositories/123456/environments/production/secrets/DEPLOY_KEY", transport.requests[1].URL.Path)
+		assert.True(t, output.Exists)
+		assert.Equal(t, "production", output.Environment)
+	})
+
+	t.Run("organization secrets are looked up in the owner organization", func(t *testing.T) {
+		transport := secretTransport(http.StatusOK, `{"name":"NPM_TOKEN","updated_at":"2026-01-10T10:00:00Z","visibility":"selected"}`)
+		output, err := checkActionsSecret(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", CheckActionsSecretConfiguration{
+			Repository: "hello",
+			SecretName: "NPM_TOKEN",
+			Scope:      ActionsSecretScopeOrganization,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "/orgs/testhq/actions/secrets/NPM_TOKEN", transport.requests[0].URL.Path)
+		assert.True(t, output.Exists)
+		assert.Equal(t, "selected", output.Visibility)
+	})
+
+	t.Run("permission errors are not reported as missing secrets", func(t *testing.T) {
+		transport := secretTransport(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`)
+		_, err := checkActionsSecret(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", CheckActionsSecretConfiguration{
+			Repository: "hello",
+			SecretName: "NPM_TOKEN",
+			Scope:      ActionsSecretScopeOrganization,
+		})
+
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,86 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	EmitModeChunked  = "chunked"
+	DefaultChunkSize = 10
+	MaxChunkSize     = 100
+)
+
+/*
+ * ChunkSizeField is used by list components supporting the chunked emit mode.
+ * It is only shown when that mode is selected.
+ */
+var ChunkSizeField = configuration.Field{
+	Name:        "chunkSize",
+	Label:       "Chunk Size",
+	Type:        configuration.FieldTypeNumber,
+	Default:     DefaultChunkSize,
+	Description: "Number of items in each emitted event",
+	TypeOptions: &configuration.TypeOptions{
+		Number: &configuration.NumberTypeOptions{
+			Min: func() *int { min := 1; return &min }(),
+			Max: func() *int { max := MaxChunkSize; return &max }(),
+		},
+	},
+	VisibilityConditions: []configuration.VisibilityCondition{
+		{Field: "emitMode", Values: []string{EmitModeChunked}},
+	},
+}
+
+func validateChunkSize(chunkSize *int) error {
+	if chunkSize == nil {
+		return nil
+	}
+
+	if *chunkSize < 1 || *chunkSize > MaxChunkSize {
+		return fmt.Errorf("chunk size must be between 1 and %d", MaxChunkSize)
+	}
+
+	return nil
+}
+
+func chunkSize(size *int) int {
+	if size == nil {
+		return DefaultChunkSize
+	}
+
+	return *size
+}
+
+/*
+ * chunkEmit emits items in events of up to chunkSize items each.
+ * Emitting one event per item floods the queue on large lists,
+ * while a single event for all of them leaves no room for parallelism downstream.
+ *
+ * Each event includes the items in its chunk, the chunk position, and the total number of chunks.
+ * If there are no items, no events are emitted.
+ */
+func chunkEmit(state core.ExecutionStateContext, channel, eventType string, items []any, chunkSize int) error {
+	return state.Emit(channel, eventType, chunkPayloads(items, chunkSize))
+}
+
+func chunkPayloads(items []any, chunkSize int) []any {
+	if chunkSize < 1 {
+		chunkSize = DefaultChunkSize
+	}
+
+	total := (len(items) + chunkSize - 1) / chunkSize
+	payloads := make([]any, 0, total)
+	for start := 0; start < len(items); start += chunkSize {
+		end := min(start+chunkSize, len(items))
+		payloads = append(payloads, map[string]any{
+			"items":  items[start:end],
+			"chunk":  start/chunkSize + 1,
+			"chunks": total,
+		})
+	}
+
+	return payloads
+}
@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+
+	DefaultCircuitBreakerThreshold = 5
+	DefaultCircuitBreakerCooldown  = 30 * time.Second
+)
+
+/*
+ * CircuitBreaker stops calling GitHub during outages,
+ * so executions fail fast instead of piling up waiting on timeouts.
+ *
+ * After the threshold of consecutive failures - 5xx responses or transport errors, like timeouts -
+ * the circuit opens, and requests fail with ErrCircuitOpen for the cooldown.
+ * After the cooldown, the circuit is half-open: a single probe request goes through.
+ * If it succeeds, the circuit closes again. If it fails, it opens for another cooldown.
+ *
+ * The breaker is shared by all GitHub clients, since an outage affects all of them.
+ */
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		state:     CircuitClosed,
+	}
+}
+
+var circuitBreaker = NewCircuitBreaker(DefaultCircuitBreakerThreshold, DefaultCircuitBreakerCooldown)
+
+func SetCircuitBreaker(breaker *CircuitBreaker) {
+	circuitBreaker = breaker
+}
+
+func (b *CircuitBreaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+/*
+ * Allow returns an error wrapping ErrCircuitOpen if the request should not be made.
+ * Every allowed request must be followed by a call to Record.
+ */
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		remaining := b.cooldown - b.now().Sub(b.openedAt)
+		if remaining > 0 {
+			return fmt.Errorf("%w - GitHub requests are paused for %s after repeated failures", ErrCircuitOpen, remaining.Round(time.Second))
+		}
+
+		b.transition(CircuitHalfOpen)
+		b.probing = true
+		return nil
+
+	case CircuitHalfOpen:
+		if b.probing {
+			return fmt.Errorf("%w - waiting for a probe request to GitHub to complete", ErrCircuitOpen)
+		}
+
+		b.probing = true
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+func (b *CircuitBreaker) Record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.failures = 0
+		b.probing = false
+		if b.state != CircuitClosed {
+			b.transition(CircuitClosed)
+		}
+
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.threshold {
+		b.probing = false
+		b.openedAt = b.now()
+		if b.state != CircuitOpen {
+			b.transition(CircuitOpen)
+		}
+	}
+}
+
+/*
+ * Lets another request probe GitHub, if the cancelled one was the probe.
+ */
+func (b *CircuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *CircuitBreaker) transition(state string) {
+	logger := log.WithFields(log.Fields{
+		"circuit_breaker": "github",
+		"from":            b.state,
+		"to":              state,
+		"failures":        b.failures,
+	})
+
+	b.state = state
+	if state == CircuitOpen {
+		logger.Warnf("GitHub circuit breaker opened - failing requests for %s", b.cooldown)
+		return
+	}
+
+	logger.Infof("GitHub circuit breaker is %s", state)
+}
+
+/*
+ * 5xx responses and transport errors count as failures.
+ * Other responses - including 4xx - mean GitHub is up.
+ * Requests cancelled by the caller say nothing about GitHub, so they are not counted at all.
+ */
+type circuitBreakerTransport struct {
+	base    http.RoundTripper
+	breaker *CircuitBreaker
+}
+
+func newCircuitBreakerTransport(base http.RoundTripper, breaker *CircuitBreaker) *circuitBreakerTransport {
+	return &circuitBreakerTransport{base: base, breaker: breaker}
+}
+
+func (t *circuitBreakerTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(request)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			t.breaker.release()
+			return nil, err
+		}
+
+		t.breaker.Record(false)
+		return nil, err
+	}
+
+	t.breaker.Record(response.StatusCode < http.StatusInternalServerError)
+	return response, nil
+}
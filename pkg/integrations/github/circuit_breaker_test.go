@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__CircuitBreaker(t *testing.T) {
+	newBreaker := func() (*CircuitBreaker, *time.Time) {
+		now := time.Now()
+		breaker := NewCircuitBreaker(3, time.Minute)
+		breaker.now = func() time.Time { return now }
+		return breaker, &now
+	}
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		breaker, _ := newBreaker()
+		for range 2 {
+			require.NoError(t, breaker.Allow())
+			breaker.Record(false)
+		}
+
+		assert.Equal(t, CircuitClosed, breaker.State())
+
+		require.NoError(t, breaker.Allow())
+		breaker.Record(false)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		err := breaker.Allow()
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Contains(t, err.Error(), "paused for 1m0s")
+	})
+
+	t.Run("success resets the failure count", func(t *testing.T) {
+		breaker, _ := newBreaker()
+		breaker.Record(false)
+		breaker.Record(false)
+		breaker.Record(true)
+		breaker.Record(false)
+		breaker.Record(false)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("half-open after the cooldown, with a single probe", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for range 3 {
+			breaker.Record(false)
+		}
+
+		*now = now.Add(time.Minute)
+		require.NoError(t, breaker.Allow())
+		assert.Equal(t, CircuitHalfOpen, breaker.State())
+		require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+		breaker.Record(true)
+		assert.Equal(t, CircuitClosed, breaker.State())
+		require.NoError(t, breaker.Allow())
+	})
+
+	t.Run("failed probe opens the circuit again", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for range 3 {
+			breaker.Record(false)
+		}
+
+		*now = now.Add(time.Minute)
+		require.NoError(t, breaker.Allow())
+		breaker.Record(false)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		*now = now.Add(30 * time.Second)
+		require.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+	})
+}
+
+func Test__CircuitBreakerTransport(t *testing.T) {
+	request := func(t *testing.T) *http.Request {
+		request, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/testhq/hello", nil)
+		require.NoError(t, err)
+		return request
+	}
+
+	t.Run("5xx responses and errors trip the breaker, 4xx do not", func(t *testing.T) {
+		breaker := NewCircuitBreaker(2, time.Minute)
+		responses := []int{http.StatusNotFound, http.StatusBadGateway}
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if len(responses) == 0 {
+				return nil, errors.New("i/o timeout")
+			}
+
+			status := responses[0]
+			responses = responses[1:]
+			return mockResponse(status, `{}`), nil
+		}}
+
+		transport := newCircuitBreakerTransport(base, breaker)
+		for range 2 {
+			_, err := transport.RoundTrip(request(t))
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, CircuitClosed, breaker.State())
+
+		_, err := transport.RoundTrip(request(t))
+		require.Error(t, err)
+		assert.Equal(t, CircuitOpen, breaker.State())
+
+		_, err = transport.RoundTrip(request(t))
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Len(t, base.requests, 3, "open circuit fails fast without calling GitHub")
+	})
+
+	t.Run("cancelled requests are not counted", func(t *testing.T) {
+		breaker := NewCircuitBreaker(1, time.Minute)
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return nil, context.Canceled
+		}}
+
+		_, err := newCircuitBreakerTransport(base, breaker).RoundTrip(request(t))
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	t.Run("open circuit error reaches the component", func(t *testing.T) {
+		breaker := NewCircuitBreaker(1, time.Minute)
+		breaker.Record(false)
+
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{}`), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: newCircuitBreakerTransport(base, breaker)})
+		_, _, err := client.Repositories.Get(context.Background(), "testhq", "hello")
+		require.ErrorIs(t, wrapGitHubError(err), ErrCircuitOpen)
+		assert.Empty(t, base.requests)
+	})
+}
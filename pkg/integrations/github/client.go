@@ -1,16 +1,94 @@
 package github
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	"github.com/google/go-github/v74/github"
+	"github.com/shurcooL/githubv4"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
 func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string) (*github.Client, error) {
+	return newClient(ctx, integrationTransport(ctx), ghAppID, installationID)
+}
+
+/*
+ * NewExecutionClient creates a client whose requests
+ * identify the execution and node making them.
+ */
+func NewExecutionClient(ctx core.ExecutionContext, ghAppID int64, installationID string) (*github.Client, error) {
+	return newClient(ctx.Integration, executionTransport(ctx), ghAppID, installationID)
+}
+
+func newClient(ctx core.IntegrationContext, transport http.RoundTripper, ghAppID int64, installationID string) (*github.Client, error) {
+	itr, err := newInstallationTransport(ctx, transport, ghAppID, installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return github.NewClient(&http.Client{Transport: itr}), nil
+}
+
+/*
+ * Some operations - issue transfers, projects v2, discussions -
+ * are only available through the GraphQL API.
+ * The GraphQL client uses the same app installation authentication as the REST one.
+ */
+func NewGraphQLClient(ctx core.IntegrationContext, ghAppID int64, installationID string) (*githubv4.Client, error) {
+	return newGraphQLClient(ctx, integrationTransport(ctx), ghAppID, installationID)
+}
+
+func NewExecutionGraphQLClient(ctx core.ExecutionContext, ghAppID int64, installationID string) (*githubv4.Client, error) {
+	return newGraphQLClient(ctx.Integration, executionTransport(ctx), ghAppID, installationID)
+}
+
+func integrationTransport(ctx core.IntegrationContext) http.RoundTripper {
+	trace := requestTrace{IntegrationID: ctx.ID().String()}
+	return newTracingTransport(
+		newCircuitBreakerTransport(http.DefaultTransport, circuitBreaker),
+		trace,
+		log.WithField("integration_id", trace.IntegrationID),
+	)
+}
+
+func executionTransport(ctx core.ExecutionContext) http.RoundTripper {
+	trace := requestTrace{
+		IntegrationID: ctx.Integration.ID().String(),
+		ExecutionID:   ctx.ID.String(),
+		NodeID:        ctx.NodeID,
+		Parent:        ctx.Context,
+	}
+
+	logger := ctx.Logger
+	if logger == nil {
+		logger = log.NewEntry(log.StandardLogger())
+	}
+
+	return newTracingTransport(newCircuitBreakerTransport(http.DefaultTransport, circuitBreaker), trace, logger)
+}
+
+func newGraphQLClient(ctx core.IntegrationContext, transport http.RoundTripper, ghAppID int64, installationID string) (*githubv4.Client, error) {
+	itr, err := newInstallationTransport(ctx, transport, ghAppID, installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return githubv4.NewClient(&http.Client{Transport: itr}), nil
+}
+
+/*
+ * The installation transport mints - and refreshes - installation
+ * access tokens using the GitHub app private key, and adds them to every request.
+ */
+func newInstallationTransport(ctx core.IntegrationContext, transport http.RoundTripper, ghAppID int64, installationID string) (*revocableTransport, error) {
 	ID, err := strconv.Atoi(installationID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse installation ID: %v", err)
@@ -21,10 +99,9 @@ func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string
 		return nil, fmt.Errorf("failed to find PEM: %v", err)
 	}
 
-	itr, err := ghinstallation.New(
-		http.DefaultTransport,
+	atr, err := ghinstallation.NewAppsTransport(
+		transport,
 		ghAppID,
-		int64(ID),
 		[]byte(pem),
 	)
 
@@ -32,7 +109,59 @@ func NewClient(ctx core.IntegrationContext, ghAppID int64, installationID string
 		return nil, fmt.Errorf("failed to create apps transport: %v", err)
 	}
 
-	return github.NewClient(&http.Client{Transport: itr}), nil
+	return &revocableTransport{
+		apps:           atr,
+		installationID: int64(ID),
+		current:        ghinstallation.NewFromAppsTransport(atr, int64(ID)),
+	}, nil
+}
+
+/*
+ * ghinstallation keeps using its token until it expires,
+ * so after a token is revoked, the transport is replaced
+ * by a new one, which mints a new token on the next request.
+ */
+type revocableTransport struct {
+	mu             sync.Mutex
+	apps           *ghinstallation.AppsTransport
+	installationID int64
+	current        *ghinstallation.Transport
+}
+
+func (t *revocableTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.transport().RoundTrip(request)
+}
+
+func (t *revocableTransport) transport() *ghinstallation.Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.current
+}
+
+func (t *revocableTransport) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.current = ghinstallation.NewFromAppsTransport(t.apps, t.installationID)
+}
+
+/*
+ * Revokes the installation token of the client, and returns when it would have expired.
+ * The client can still be used afterwards, with a new token.
+ */
+func revokeInstallationToken(client *github.Client) (time.Time, error) {
+	transport, ok := client.Client().Transport.(*revocableTransport)
+	if !ok {
+		return time.Time{}, errors.New("client does not use an installation token")
+	}
+
+	_, err := client.Apps.RevokeInstallationToken(context.Background())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to revoke installation token: %w", wrapGitHubError(err))
+	}
+
+	expiresAt, _, _ := transport.transport().Expiry()
+	transport.reset()
+	return expiresAt, nil
 }
 
 func findSecret(ctx core.IntegrationContext, secretName string) (string, error) {
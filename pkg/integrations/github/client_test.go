@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+type mockTransport struct {
+	requests []*http.Request
+	handler  func(*http.Request) (*http.Response, error)
+}
+
+func (m *mockTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	m.requests = append(m.requests, request)
+	return m.handler(request)
+}
+
+func mockResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func testIntegrationWithPEM(t *testing.T) *contexts.IntegrationContext {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	return &contexts.IntegrationContext{
+		Secrets: map[string]core.IntegrationSecret{
+			GitHubAppPEM: {Name: GitHubAppPEM, Value: keyPEM},
+		},
+	}
+}
+
+const testInstallationTokenPath = "/app/installations/123/access_tokens"
+
+/*
+ * Integration for the GitHub App installation 123,
+ * whose tokens are minted by installationTransport().
+ */
+func testInstallation(t *testing.T, owner string, repositories ...Repository) *contexts.IntegrationContext {
+	integration := testIntegrationWithPEM(t)
+	integration.Metadata = Metadata{
+		Owner:          owner,
+		InstallationID: "123",
+		GitHubApp:      GitHubAppMetadata{ID: 1},
+		Repositories:   repositories,
+	}
+
+	return integration
+}
+
+func mockInstallationToken() *http.Response {
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	return mockResponse(http.StatusCreated, fmt.Sprintf(`{"token":"ghs_test","expires_at":"%s"}`, expiresAt))
+}
+
+/*
+ * Mints installation tokens for testInstallation(),
+ * and hands every other request to the handler.
+ */
+func installationTransport(handler func(*http.Request) (*http.Response, error)) *mockTransport {
+	return &mockTransport{
+		handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Path == testInstallationTokenPath {
+				return mockInstallationToken(), nil
+			}
+
+			return handler(request)
+		},
+	}
+}
+
+func Test__NewGraphQLClient(t *testing.T) {
+	t.Run("invalid installation ID -> error", func(t *testing.T) {
+		_, err := NewGraphQLClient(&contexts.IntegrationContext{}, 1, "not-a-number")
+		require.ErrorContains(t, err, "failed to parse installation ID")
+	})
+
+	t.Run("missing PEM -> error", func(t *testing.T) {
+		_, err := NewGraphQLClient(&contexts.IntegrationContext{}, 1, "123")
+		require.ErrorContains(t, err, "failed to find PEM")
+	})
+
+	t.Run("mints installation token and runs viewer query", func(t *testing.T) {
+		transport := installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/graphql":
+				return mockResponse(http.StatusOK, `{"data":{"viewer":{"login":"superplane-app[bot]"}}}`), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+
+		client, err := newGraphQLClient(testIntegrationWithPEM(t), transport, 1, "123")
+		require.NoError(t, err)
+
+		var query struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+
+		require.NoError(t, client.Query(context.Background(), &query, nil))
+		assert.Equal(t, "superplane-app[bot]", string(query.Viewer.Login))
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+		assert.True(t, strings.HasPrefix(transport.requests[0].Header.Get("Authorization"), "Bearer "))
+		assert.Equal(t, "https://api.github.com/graphql", transport.requests[1].URL.String())
+		assert.Equal(t, "token ghs_test", transport.requests[1].Header.Get("Authorization"))
+	})
+}
+
+func Test__RevokeInstallationToken(t *testing.T) {
+	t.Run("client without installation token -> error", func(t *testing.T) {
+		_, err := revokeInstallationToken(github.NewClient(nil))
+		require.ErrorContains(t, err, "client does not use an installation token")
+	})
+
+	t.Run("token is revoked, and a new one is minted if the client is used again", func(t *testing.T) {
+		transport := installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/installation/token":
+				return mockResponse(http.StatusNoContent, ``), nil
+			case "/repos/testhq/hello":
+				return mockResponse(http.StatusOK, `{"name":"hello"}`), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+
+		client, err := newClient(testIntegrationWithPEM(t), transport, 1, "123")
+		require.NoError(t, err)
+
+		expiresAt, err := revokeInstallationToken(client)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+		_, _, err = client.Repositories.Get(context.Background(), "testhq", "hello")
+		require.NoError(t, err)
+
+		paths := []string{}
+		for _, request := range transport.requests {
+			paths = append(paths, request.Method+" "+request.URL.Path)
+		}
+
+		assert.Equal(t, []string{
+			"POST " + testInstallationTokenPath,
+			"DELETE /installation/token",
+			"POST " + testInstallationTokenPath,
+			"GET /repos/testhq/hello",
+		}, paths)
+	})
+}
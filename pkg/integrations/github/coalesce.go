@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"golang.org/x/sync/singleflight"
+)
+
+/*
+ * In fan-out flows, many nodes read the same GitHub resource at the same time.
+ * Coalescing makes concurrent identical reads share a single API call.
+ *
+ * Reads are keyed on the installation, the method and its arguments,
+ * so results are never shared across installations.
+ * Reads without an installation ID are not coalesced.
+ * Only calls in flight are shared - nothing is cached after the call returns.
+ *
+ * The shared call is made with the client of the caller that started it,
+ * so it is aborted if the execution of that caller is cancelled.
+ * The other callers are not cancelled, so they make the call themselves in that case.
+ *
+ * The result is shared by all callers, so it must be treated as read-only.
+ */
+var readGroup singleflight.Group
+
+func coalesceRead[T any](installationID, method string, args []any, read func() (T, error)) (T, error) {
+	if installationID == "" {
+		return read()
+	}
+
+	started := false
+	value, err, _ := readGroup.Do(coalesceKey(installationID, method, args), func() (any, error) {
+		started = true
+		return read()
+	})
+
+	if err != nil && !started && isCancellation(err) {
+		return read()
+	}
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return value.(T), nil
+}
+
+func isCancellation(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func coalesceKey(installationID, method string, args []any) string {
+	parts := []string{installationID, method}
+	for _, arg := range args {
+		parts = append(parts, fmt.Sprintf("%v", arg))
+	}
+
+	return strings.Join(parts, "\x00")
+}
+
+func getRepository(client *github.Client, installationID, owner, repo string) (*github.Repository, error) {
+	return coalesceRead(installationID, "GetRepository", []any{owner, repo}, func() (*github.Repository, error) {
+		repository, _, err := client.Repositories.Get(context.Background(), owner, repo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository %s: %w", repo, wrapGitHubError(err))
+		}
+
+		return repository, nil
+	})
+}
+
+func getBranchRef(client *github.Client, installationID, owner, repo, branch string) (*github.Reference, error) {
+	return coalesceRead(installationID, "GetBranchRef", []any{owner, repo, branch}, func() (*github.Reference, error) {
+		ref, _, err := client.Git.GetRef(context.Background(), owner, repo, "heads/"+branch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branch %s: %w", branch, wrapGitHubError(err))
+		}
+
+		return ref, nil
+	})
+}
+
+func getPullRequest(client *github.Client, installationID, owner, repo string, number int) (*github.PullRequest, error) {
+	return coalesceRead(installationID, "GetPullRequest", []any{owner, repo, number}, func() (*github.PullRequest, error) {
+		pullRequest, _, err := client.PullRequests.Get(context.Background(), owner, repo, number)
+		return pullRequest, wrapGitHubError(err)
+	})
+}
+
+func getLatestRelease(client *github.Client, installationID, owner, repo string) (*github.RepositoryRelease, error) {
+	return coalesceRead(installationID, "GetLatestRelease", []any{owner, repo}, func() (*github.RepositoryRelease, error) {
+		release, _, err := client.Repositories.GetLatestRelease(context.Background(), owner, repo)
+		return release, wrapGitHubError(err)
+	})
+}
+
+func getReleaseByTag(client *github.Client, installationID, owner, repo, tag string) (*github.RepositoryRelease, error) {
+	return coalesceRead(installationID, "GetReleaseByTag", []any{owner, repo, tag}, func() (*github.RepositoryRelease, error) {
+		release, _, err := client.Repositories.GetReleaseByTag(context.Background(), owner, repo, tag)
+		return release, wrapGitHubError(err)
+	})
+}
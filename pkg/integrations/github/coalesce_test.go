@@ -0,0 +1,214 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockingTransport struct {
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+	status  int
+	body    string
+}
+
+func newBlockingTransport(status int, body string) *blockingTransport {
+	return &blockingTransport{started: make(chan struct{}, 10), release: make(chan struct{}), status: status, body: body}
+}
+
+func (b *blockingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	b.calls.Add(1)
+	b.started <- struct{}{}
+	<-b.release
+	return mockResponse(b.status, b.body), nil
+}
+
+func concurrentReads[T any](n int, read func() (T, error)) ([]T, []error) {
+	var wg sync.WaitGroup
+	repositories := make([]T, n)
+	errs := make([]error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			repositories[i], errs[i] = read()
+		}()
+	}
+
+	wg.Wait()
+	return repositories, errs
+}
+
+func Test__CoalesceRead(t *testing.T) {
+	t.Run("concurrent identical reads share one API call", func(t *testing.T) {
+		transport := newBlockingTransport(http.StatusOK, `{"name":"hello","default_branch":"main"}`)
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		go func() {
+			<-transport.started
+			time.Sleep(100 * time.Millisecond)
+			close(transport.release)
+		}()
+
+		repositories, errs := concurrentReads(10, func() (*github.Repository, error) {
+			return getRepository(client, "1001", "testhq", "hello")
+		})
+
+		assert.Equal(t, int32(1), transport.calls.Load())
+		for i := range repositories {
+			require.NoError(t, errs[i])
+			assert.Equal(t, "main", repositories[i].GetDefaultBranch())
+		}
+	})
+
+	t.Run("concurrent pull request reads share one API call", func(t *testing.T) {
+		transport := newBlockingTransport(http.StatusOK, `{"number":42,"head":{"sha":"abc123"}}`)
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		go func() {
+			<-transport.started
+			time.Sleep(100 * time.Millisecond)
+			close(transport.release)
+		}()
+
+		pullRequests, errs := concurrentReads(10, func() (*github.PullRequest, error) {
+			return getPullRequest(client, "1001", "testhq", "hello", 42)
+		})
+
+		assert.Equal(t, int32(1), transport.calls.Load())
+		for i := range pullRequests {
+			require.NoError(t, errs[i])
+			assert.Equal(t, "abc123", pullRequests[i].GetHead().GetSHA())
+		}
+	})
+
+	t.Run("errors are shared too", func(t *testing.T) {
+		transport := newBlockingTransport(http.StatusNotFound, `{"message":"Not Found"}`)
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		go func() {
+			<-transport.started
+			time.Sleep(100 * time.Millisecond)
+			close(transport.release)
+		}()
+
+		_, errs := concurrentReads(5, func() (*github.Repository, error) {
+			return getRepository(client, "1001", "testhq", "missing")
+		})
+
+		assert.Equal(t, int32(1), transport.calls.Load())
+		for _, err := range errs {
+			require.ErrorIs(t, err, ErrNotFound)
+		}
+	})
+
+	t.Run("reads are not shared across installations", func(t *testing.T) {
+		first := newBlockingTransport(http.StatusOK, `{"name":"hello"}`)
+		second := newBlockingTransport(http.StatusOK, `{"name":"hello"}`)
+
+		var wg sync.WaitGroup
+		for installationID, transport := range map[string]*blockingTransport{"1001": first, "2002": second} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := getRepository(github.NewClient(&http.Client{Transport: transport}), installationID, "testhq", "hello")
+				assert.NoError(t, err)
+			}()
+		}
+
+		<-first.started
+		<-second.started
+		close(first.release)
+		close(second.release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), first.calls.Load())
+		assert.Equal(t, int32(1), second.calls.Load())
+	})
+
+	t.Run("cancelled shared read -> other callers read it themselves", func(t *testing.T) {
+		parent, cancel := context.WithCancel(context.Background())
+		started := make(chan struct{})
+		cancelled := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			close(started)
+			<-request.Context().Done()
+			return nil, context.Cause(request.Context())
+		}}
+
+		other := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"name":"hello"}`), nil
+		}}
+
+		var wg sync.WaitGroup
+		var cancelledErr error
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			transport := newTracingTransport(cancelled, requestTrace{Parent: parent}, log.NewEntry(log.StandardLogger()))
+			_, cancelledErr = getRepository(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello")
+		}()
+
+		<-started
+		var repository *github.Repository
+		var err error
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			repository, err = getRepository(github.NewClient(&http.Client{Transport: other}), "1001", "testhq", "hello")
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+		wg.Wait()
+
+		require.ErrorIs(t, cancelledErr, context.Canceled)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", repository.GetName())
+		assert.Len(t, other.requests, 1)
+	})
+
+	t.Run("reads without an installation ID are not shared", func(t *testing.T) {
+		transport := newBlockingTransport(http.StatusOK, `{"name":"hello"}`)
+		client := github.NewClient(&http.Client{Transport: transport})
+
+		go func() {
+			<-transport.started
+			<-transport.started
+			close(transport.release)
+		}()
+
+		_, errs := concurrentReads(2, func() (*github.Repository, error) {
+			return getRepository(client, "", "testhq", "hello")
+		})
+
+		assert.Equal(t, int32(2), transport.calls.Load())
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("sequential reads are not cached", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"name":"hello"}`), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		for range 2 {
+			_, err := getRepository(client, "1001", "testhq", "hello")
+			require.NoError(t, err)
+		}
+
+		assert.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello", transport.requests[0].URL.Path)
+	})
+}
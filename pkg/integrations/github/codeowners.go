@@ -0,0 +1,204 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+/*
+ * GitHub uses the first CODEOWNERS file it finds, in this order.
+ */
+var CodeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+var codeOwnerRegex = regexp.MustCompile(`^(@[A-Za-z0-9][A-Za-z0-9-]*(/[A-Za-z0-9._-]+)?|[^@\s]+@[^@\s]+\.[^@\s]+)$`)
+
+type CodeOwnersRule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+	regex   *regexp.Regexp
+}
+
+/*
+ * Lines GitHub would skip are not rules, and are returned as errors instead,
+ * so a single typo does not make the whole file unusable.
+ */
+func parseCodeOwners(content string) ([]CodeOwnersRule, []string) {
+	rules := []CodeOwnersRule{}
+	invalid := []string{}
+	for i, line := range strings.Split(content, "\n") {
+		fields := codeOwnersFields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		owners := fields[1:]
+		if err := validateCodeOwnersRule(pattern, owners); err != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: %v", i+1, err))
+			continue
+		}
+
+		regex, err := codeOwnersPatternRegex(pattern)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: invalid pattern %q", i+1, pattern))
+			continue
+		}
+
+		rules = append(rules, CodeOwnersRule{Line: i + 1, Pattern: pattern, Owners: owners, regex: regex})
+	}
+
+	return rules, invalid
+}
+
+/*
+ * Comments start with an unescaped #, anywhere on the line.
+ * Escaped spaces and # are part of the pattern.
+ */
+func codeOwnersFields(line string) []string {
+	fields := []string{}
+	var current strings.Builder
+	escaped := false
+	for _, r := range strings.TrimSpace(line) {
+		switch {
+		case escaped:
+			current.WriteRune('\\')
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '#':
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+			}
+
+			return fields
+		case r == ' ' || r == '\t':
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+
+	return fields
+}
+
+func validateCodeOwnersRule(pattern string, owners []string) error {
+	if strings.HasPrefix(pattern, "!") {
+		return fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+
+	if strings.Contains(pattern, "[") {
+		return fmt.Errorf("character ranges in pattern %q are not supported", pattern)
+	}
+
+	for _, owner := range owners {
+		if !codeOwnerRegex.MatchString(owner) {
+			return fmt.Errorf("invalid owner %q", owner)
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Patterns follow the gitignore rules:
+ *
+ * - A pattern without a slash, or with only a trailing one, matches at any depth.
+ *   Any other pattern is relative to the root of the repository.
+ * - A pattern matches the path itself and everything below it,
+ *   but a trailing slash only matches what is below it,
+ *   and a trailing /* only matches the direct children.
+ * - * and ? never match a slash, ** matches any number of directories.
+ */
+func codeOwnersPatternRegex(pattern string) (*regexp.Regexp, error) {
+	trimmed := strings.TrimSuffix(pattern, "/")
+	directoryOnly := trimmed != pattern
+
+	var re strings.Builder
+	if strings.Contains(trimmed, "/") {
+		re.WriteString(`\A`)
+	} else {
+		re.WriteString(`(?:\A|/)`)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(trimmed, "/"), "/")
+	last := len(segments) - 1
+	for i, segment := range segments {
+		if segment == "**" {
+			switch {
+			case i == 0 && i == last:
+				re.WriteString(`.+`)
+			case i == 0:
+				re.WriteString(`(?:.+/)?`)
+			case i == last:
+				re.WriteString(`/.*`)
+			default:
+				re.WriteString(`(?:/.+)?`)
+			}
+
+			continue
+		}
+
+		if i > 0 && !(i == 1 && segments[0] == "**") {
+			re.WriteString("/")
+		}
+
+		re.WriteString(codeOwnersSegmentRegex(segment))
+	}
+
+	switch {
+	case directoryOnly:
+		re.WriteString(`/`)
+	case segments[last] == "*" || segments[last] == "**":
+		re.WriteString(`\z`)
+	default:
+		re.WriteString(`(?:\z|/)`)
+	}
+
+	return regexp.Compile(re.String())
+}
+
+func codeOwnersSegmentRegex(segment string) string {
+	var re strings.Builder
+	escaped := false
+	for _, r := range segment {
+		switch {
+		case escaped:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			re.WriteString(`[^/]*`)
+		case r == '?':
+			re.WriteString(`[^/]`)
+		default:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return re.String()
+}
+
+/*
+ * The last matching rule wins, even if it has no owners.
+ */
+func matchCodeOwners(rules []CodeOwnersRule, path string) *CodeOwnersRule {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].regex.MatchString(path) {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__CodeOwners__Parse(t *testing.T) {
+	content := `# Global owners
+*       @acme/core
+
+*.js    @js-owner # JavaScript
+/build/logs/ @doctocat
+docs/* docs@example.com
+apps/  @octocat
+\#notes.md @acme/writers
+/scripts/ invalid-owner
+!vendor @acme/core
+/vendor/
+`
+
+	rules, invalid := parseCodeOwners(content)
+	require.Len(t, rules, 7)
+	assert.Equal(t, []string{"@acme/core"}, rules[0].Owners)
+	assert.Equal(t, 2, rules[0].Line)
+	assert.Equal(t, "*.js", rules[1].Pattern)
+	assert.Equal(t, []string{"@js-owner"}, rules[1].Owners)
+	assert.Equal(t, `\#notes.md`, rules[5].Pattern)
+	assert.Empty(t, rules[6].Owners)
+
+	require.Len(t, invalid, 2)
+	assert.Contains(t, invalid[0], `line 9: invalid owner "invalid-owner"`)
+	assert.Contains(t, invalid[1], "line 10: negated pattern")
+}
+
+func Test__CodeOwners__Match(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"*", "README.md", true},
+		{"*", "src/app/main.go", true},
+		{"*.js", "app.js", true},
+		{"*.js", "src/web/app.js", true},
+		{"*.js", "app.jsx", false},
+		{"/build/logs/", "build/logs/today.log", true},
+		{"/build/logs/", "build/logs", false},
+		{"/build/logs/", "src/build/logs/today.log", false},
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		{"docs/*", "src/docs/index.md", false},
+		{"apps/", "apps/web/index.ts", true},
+		{"apps/", "services/apps/main.go", true},
+		{"/docs/", "docs/index.md", true},
+		{"/docs/", "src/docs/index.md", false},
+		{"docs", "docs/guides/index.md", true},
+		{"docs", "src/docs/index.md", true},
+		{"/scripts", "scripts/deploy.sh", true},
+		{"**/logs", "logs/today.log", true},
+		{"**/logs", "deep/nested/logs/today.log", true},
+		{"/build/**", "build/a/b.txt", true},
+		{"/build/**", "build", false},
+		{"src/**/test.go", "src/test.go", true},
+		{"src/**/test.go", "src/a/b/test.go", true},
+		{"src/**/test.go", "lib/src/test.go", false},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{`\#notes.md`, "#notes.md", true},
+		{"a.b", "axb", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			regex, err := codeOwnersPatternRegex(tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, regex.MatchString(tc.path))
+		})
+	}
+}
+
+func Test__CodeOwners__LastMatchWins(t *testing.T) {
+	rules, invalid := parseCodeOwners(`*             @acme/core
+/apps/        @acme/apps
+/apps/github  @acme/github
+/apps/legacy/
+`)
+
+	require.Empty(t, invalid)
+
+	rule := matchCodeOwners(rules, "README.md")
+	require.NotNil(t, rule)
+	assert.Equal(t, []string{"@acme/core"}, rule.Owners)
+
+	rule = matchCodeOwners(rules, "/apps/web/index.ts")
+	require.NotNil(t, rule)
+	assert.Equal(t, []string{"@acme/apps"}, rule.Owners)
+
+	rule = matchCodeOwners(rules, "apps/github/main.go")
+	require.NotNil(t, rule)
+	assert.Equal(t, "/apps/github", rule.Pattern)
+
+	rule = matchCodeOwners(rules, "apps/legacy/main.go")
+	require.NotNil(t, rule)
+	assert.Empty(t, rule.Owners)
+
+	assert.Nil(t, matchCodeOwners(nil, "README.md"))
+}
@@ -79,6 +79,14 @@ func getRepositoryFromConfiguration(c any) string {
 	return repository
 }
 
+/*
+ * Expressions are only resolved on execution,
+ * so Setup can only validate static values.
+ */
+func isExpression(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
 func verifySignature(ctx core.WebhookRequestContext) (int, error) {
 	signature := ctx.Headers.Get("X-Hub-Signature-256")
 	if signature == "" {
@@ -103,16 +111,11 @@ func verifySignature(ctx core.WebhookRequestContext) (int, error) {
 	return http.StatusOK, nil
 }
 
-func fetchReleaseByStrategy(client *github.Client, owner, repo, strategy, tagName string) (*github.RepositoryRelease, error) {
+func fetchReleaseByStrategy(client *github.Client, installationID, owner, repo, strategy, tagName string) (*github.RepositoryRelease, error) {
 	switch strategy {
 	case "specific":
 		// Fetch by specific tag name
-		release, _, err := client.Repositories.GetReleaseByTag(
-			context.Background(),
-			owner,
-			repo,
-			tagName,
-		)
+		release, err := getReleaseByTag(client, installationID, owner, repo, tagName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find release with tag %s: %w", tagName, err)
 		}
@@ -120,11 +123,7 @@ func fetchReleaseByStrategy(client *github.Client, owner, repo, strategy, tagNam
 
 	case "latest":
 		// Fetch latest published release
-		release, _, err := client.Repositories.GetLatestRelease(
-			context.Background(),
-			owner,
-			repo,
-		)
+		release, err := getLatestRelease(client, installationID, owner, repo)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch latest release: %w", err)
 		}
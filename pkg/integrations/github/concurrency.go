@@ -0,0 +1,183 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+/*
+ * ConcurrencyLock serializes executions of GitHub components
+ * that share the same concurrency key, to avoid races like
+ * two workflows creating the same branch at the same time.
+ *
+ * The default implementation keeps the locks in the database,
+ * so executions running in different instances are serialized too.
+ */
+type ConcurrencyLock interface {
+
+	//
+	// Acquires the lock for the key, if it is not held by another holder.
+	// The returned function must be called to release it.
+	//
+	TryAcquire(key, holder string) (func(), bool, error)
+}
+
+/*
+ * How long a lock is held if its holder never releases it,
+ * for example, because the instance running it crashed.
+ */
+const ConcurrencyLockLease = 15 * time.Minute
+
+/*
+ * How long an execution waits before trying to acquire a held lock again.
+ * Executions do not block while waiting - they are retried later.
+ */
+const ConcurrencyLockRetryInterval = 10 * time.Second
+
+var concurrencyLock ConcurrencyLock = NewDatabaseLock(ConcurrencyLockLease)
+
+func SetConcurrencyLock(lock ConcurrencyLock) {
+	concurrencyLock = lock
+}
+
+var ConcurrencyKeyField = configuration.Field{
+	Name:        "concurrencyKey",
+	Label:       "Concurrency Key",
+	Type:        configuration.FieldTypeString,
+	Togglable:   true,
+	Description: "Executions sharing the same key in the same GitHub account run one at a time. Defaults to the repository full name.",
+}
+
+/*
+ * Acquires the concurrency lock for the execution.
+ * If no concurrency key is configured, the repository full name is used,
+ * or the owner, for components targeting multiple repositories.
+ * Configured keys are scoped to the owner, so the same key
+ * used in different GitHub accounts does not serialize them.
+ *
+ * If the lock is held by another execution, a retry of this one is scheduled,
+ * and false is returned - the component must return without doing anything else.
+ */
+func acquireConcurrencyLock(ctx core.ExecutionContext, owner string) (func(), bool, error) {
+	key := concurrencyKey(ctx.Configuration, owner)
+	release, acquired, err := concurrencyLock.TryAcquire(key, ctx.ID.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire concurrency lock for %s: %w", key, err)
+	}
+
+	if acquired {
+		return release, true, nil
+	}
+
+	ctx.Logger.Infof("Concurrency lock for %s is held - retrying in %s", key, ConcurrencyLockRetryInterval)
+	telemetry.RecordConcurrencyLockContention(context.Background())
+
+	err = ctx.Requests.ScheduleActionCall(core.RetryExecutionAction, map[string]any{}, ConcurrencyLockRetryInterval)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to schedule retry for concurrency lock %s: %w", key, err)
+	}
+
+	return nil, false, nil
+}
+
+func concurrencyKey(c any, owner string) string {
+	configMap, ok := c.(map[string]any)
+	if ok {
+		key, ok := configMap["concurrencyKey"].(string)
+		if ok && key != "" {
+			return fmt.Sprintf("%s:%s", owner, key)
+		}
+	}
+
+	//
+	// Components targeting multiple repositories lock the whole owner.
+	//
+	repository := getRepositoryFromConfiguration(c)
+	if repository == "" || hasRepositories(configMap) {
+		return owner
+	}
+
+	return fmt.Sprintf("%s/%s", owner, repository)
+}
+
+func hasRepositories(configMap map[string]any) bool {
+	switch repositories := configMap["repositories"].(type) {
+	case []any:
+		return len(repositories) > 0
+	case []string:
+		return len(repositories) > 0
+	default:
+		return false
+	}
+}
+
+/*
+ * DatabaseLock keeps one row per held key.
+ * Rows are written with their own connection, before anything else
+ * the execution does, so no transaction is kept open while waiting.
+ */
+type DatabaseLock struct {
+	lease time.Duration
+}
+
+func NewDatabaseLock(lease time.Duration) *DatabaseLock {
+	return &DatabaseLock{lease: lease}
+}
+
+func (l *DatabaseLock) TryAcquire(key, holder string) (func(), bool, error) {
+	acquired, err := models.AcquireConcurrencyLock(key, holder, l.lease)
+	if err != nil || !acquired {
+		return nil, false, err
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			err := models.ReleaseConcurrencyLock(key, holder)
+			if err != nil {
+				log.Errorf("failed to release concurrency lock for %s: %v", key, err)
+			}
+		})
+	}, true, nil
+}
+
+/*
+ * InProcessLock holds the locks in memory.
+ * It only serializes executions within a single process.
+ */
+type InProcessLock struct {
+	mu      sync.Mutex
+	holders map[string]string
+}
+
+func NewInProcessLock() *InProcessLock {
+	return &InProcessLock{holders: map[string]string{}}
+}
+
+func (l *InProcessLock) TryAcquire(key, holder string) (func(), bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, held := l.holders[key]
+	if held && current != holder {
+		return nil, false, nil
+	}
+
+	l.holders[key] = holder
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if l.holders[key] == holder {
+			delete(l.holders, key)
+		}
+	}, true, nil
+}
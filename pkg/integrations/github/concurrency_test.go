@@ -0,0 +1,97 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ConcurrencyKey(t *testing.T) {
+	t.Run("defaults to repository full name", func(t *testing.T) {
+		key := concurrencyKey(map[string]any{"repository": "hello"}, "testhq")
+		assert.Equal(t, "testhq/hello", key)
+	})
+
+	t.Run("uses configured key, scoped to the owner", func(t *testing.T) {
+		key := concurrencyKey(map[string]any{"repository": "hello", "concurrencyKey": "deploys"}, "testhq")
+		assert.Equal(t, "testhq:deploys", key)
+		assert.NotEqual(t, key, concurrencyKey(map[string]any{"repository": "hello", "concurrencyKey": "deploys"}, "otherhq"))
+	})
+}
+
+func Test__InProcessLock(t *testing.T) {
+	lock := NewInProcessLock()
+	release, acquired, err := lock.TryAcquire("testhq/hello", "execution-1")
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	_, acquired, err = lock.TryAcquire("testhq/hello", "execution-2")
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	//
+	// The holder can acquire it again, and other keys are not affected.
+	//
+	_, acquired, err = lock.TryAcquire("testhq/hello", "execution-1")
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	releaseOther, acquired, err := lock.TryAcquire("testhq/world", "execution-2")
+	require.NoError(t, err)
+	require.True(t, acquired)
+	releaseOther()
+
+	release()
+	release, acquired, err = lock.TryAcquire("testhq/hello", "execution-2")
+	require.NoError(t, err)
+	require.True(t, acquired)
+	release()
+	assert.Empty(t, lock.holders)
+}
+
+func Test__AcquireConcurrencyLock(t *testing.T) {
+	lock := NewInProcessLock()
+	SetConcurrencyLock(lock)
+	defer SetConcurrencyLock(NewDatabaseLock(ConcurrencyLockLease))
+
+	newContext := func() (core.ExecutionContext, *contexts.RequestContext) {
+		requests := &contexts.RequestContext{}
+		return core.ExecutionContext{
+			ID:            uuid.New(),
+			Configuration: map[string]any{"repository": "hello"},
+			Logger:        logrus.NewEntry(logrus.New()),
+			Requests:      requests,
+		}, requests
+	}
+
+	ctx, requests := newContext()
+	release, acquired, err := acquireConcurrencyLock(ctx, "testhq")
+	require.NoError(t, err)
+	require.True(t, acquired)
+	assert.Empty(t, requests.Action)
+
+	t.Run("lock is held -> retry is scheduled", func(t *testing.T) {
+		otherCtx, otherRequests := newContext()
+		_, acquired, err := acquireConcurrencyLock(otherCtx, "testhq")
+		require.NoError(t, err)
+		require.False(t, acquired)
+		assert.Equal(t, core.RetryExecutionAction, otherRequests.Action)
+		assert.Equal(t, ConcurrencyLockRetryInterval, otherRequests.Duration)
+	})
+
+	t.Run("lock is released -> other execution acquires it", func(t *testing.T) {
+		release()
+
+		otherCtx, otherRequests := newContext()
+		releaseOther, acquired, err := acquireConcurrencyLock(otherCtx, "testhq")
+		require.NoError(t, err)
+		require.True(t, acquired)
+		assert.Empty(t, otherRequests.Action)
+		releaseOther()
+	})
+}
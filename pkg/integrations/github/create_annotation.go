@@ -0,0 +1,425 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * GitHub accepts up to 50 annotations per check run update,
+ * so more annotations are sent across several updates.
+ */
+const MaxAnnotationsPerRequest = 50
+
+const (
+	AnnotationLevelNotice  = "notice"
+	AnnotationLevelWarning = "warning"
+	AnnotationLevelFailure = "failure"
+)
+
+type CreateAnnotation struct{}
+
+type CreateAnnotationConfiguration struct {
+	Repository  string       `json:"repository" mapstructure:"repository"`
+	CheckRunID  string       `json:"checkRunId" mapstructure:"checkRunId"`
+	Title       string       `json:"title" mapstructure:"title"`
+	Summary     string       `json:"summary" mapstructure:"summary"`
+	Annotations []Annotation `json:"annotations" mapstructure:"annotations"`
+}
+
+type Annotation struct {
+	Path      string `json:"path" mapstructure:"path"`
+	StartLine int    `json:"start_line" mapstructure:"start_line"`
+	EndLine   int    `json:"end_line" mapstructure:"end_line"`
+	Level     string `json:"level" mapstructure:"level"`
+	Message   string `json:"message" mapstructure:"message"`
+}
+
+type CreateAnnotationOutput struct {
+	CheckRunID      int64  `json:"check_run_id"`
+	HTMLURL         string `json:"html_url"`
+	AnnotationCount int    `json:"annotation_count"`
+	Requests        int    `json:"requests"`
+}
+
+func (c *CreateAnnotation) Name() string {
+	return "github.createAnnotation"
+}
+
+func (c *CreateAnnotation) Label() string {
+	return "Create Annotations"
+}
+
+func (c *CreateAnnotation) Description() string {
+	return "Add file annotations to a GitHub check run"
+}
+
+func (c *CreateAnnotation) Documentation() string {
+	return `The Create Annotations component adds file annotations to a check run, like the findings of a linter or a security scanner.
+
+## Use Cases
+
+- **CI reporting**: Show the findings of a CI tool next to the lines they are about, in the pull request diff
+- **Code review**: Flag files that need attention before merging
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Check Run ID**: The ID of the check run to annotate (supports expressions)
+- **Title**: The title of the check run output. Defaults to the current title, or the check run name
+- **Summary**: The summary of the check run output. Defaults to the current summary
+- **Annotations**: The annotations, each with its file ` + "`path`" + `, ` + "`start_line`" + `, ` + "`end_line`" + `, ` + "`level`" + ` and ` + "`message`" + `. The level is one of ` + "`notice`" + `, ` + "`warning`" + ` or ` + "`failure`" + `
+
+## Output
+
+Emits a ` + "`github.checkRunAnnotations`" + ` event with the ` + "`check_run_id`" + `, its ` + "`html_url`" + `, the total ` + "`annotation_count`" + `, and the number of update ` + "`requests`" + ` made.
+
+## Notes
+
+- GitHub accepts 50 annotations per request, so annotations are sent in batches of 50
+- Annotations are added to the ones the check run already has
+- If a batch fails, the annotations of the previous batches are kept, and the error says how many were added
+- The end line defaults to the start line`
+}
+
+func (c *CreateAnnotation) Icon() string {
+	return "github"
+}
+
+func (c *CreateAnnotation) Color() string {
+	return "gray"
+}
+
+func (c *CreateAnnotation) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateAnnotation) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "checkRunId",
+			Label:       "Check Run ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., 4242",
+		},
+		{
+			Name:        "title",
+			Label:       "Title",
+			Type:        configuration.FieldTypeString,
+			Description: "Defaults to the current title of the check run output",
+		},
+		{
+			Name:        "summary",
+			Label:       "Summary",
+			Type:        configuration.FieldTypeText,
+			Description: "Defaults to the current summary of the check run output",
+		},
+		{
+			Name:     "annotations",
+			Label:    "Annotations",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Annotation",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "path",
+								Label:    "Path",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:     "start_line",
+								Label:    "Start Line",
+								Type:     configuration.FieldTypeNumber,
+								Required: true,
+							},
+							{
+								Name:  "end_line",
+								Label: "End Line",
+								Type:  configuration.FieldTypeNumber,
+							},
+							{
+								Name:     "level",
+								Label:    "Level",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  AnnotationLevelWarning,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "Notice", Value: AnnotationLevelNotice},
+											{Label: "Warning", Value: AnnotationLevelWarning},
+											{Label: "Failure", Value: AnnotationLevelFailure},
+										},
+									},
+								},
+							},
+							{
+								Name:     "message",
+								Label:    "Message",
+								Type:     configuration.FieldTypeText,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+/*
+ * Annotations often come from an expression over the output of a CI tool,
+ * where lines may be strings, so they are decoded weakly.
+ */
+func decodeCreateAnnotationConfiguration(input any) (CreateAnnotationConfiguration, error) {
+	var config CreateAnnotationConfiguration
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{Result: &config, WeaklyTypedInput: true})
+	if err != nil {
+		return config, err
+	}
+
+	if err := decoder.Decode(input); err != nil {
+		return config, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return config, nil
+}
+
+func (c *CreateAnnotation) Setup(ctx core.SetupContext) error {
+	//
+	// Paths, lines and messages may be expressions, only resolved on execution,
+	// so only the number of annotations and their levels are validated here.
+	//
+	var config struct {
+		CheckRunID  string `mapstructure:"checkRunId"`
+		Annotations []struct {
+			Level string `mapstructure:"level"`
+		} `mapstructure:"annotations"`
+	}
+
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.CheckRunID == "" {
+		return errors.New("check run ID is required")
+	}
+
+	if len(config.Annotations) == 0 {
+		return errors.New("at least one annotation is required")
+	}
+
+	for i, annotation := range config.Annotations {
+		if err := validateAnnotationLevel(i, annotation.Level); err != nil {
+			return err
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateAnnotationLevel(i int, level string) error {
+	levels := []string{AnnotationLevelNotice, AnnotationLevelWarning, AnnotationLevelFailure}
+	if !slices.Contains(levels, level) {
+		return fmt.Errorf("annotation %d: invalid level %q, must be one of %s", i+1, level, strings.Join(levels, ", "))
+	}
+
+	return nil
+}
+
+func validateAnnotations(annotations []Annotation) error {
+	if len(annotations) == 0 {
+		return errors.New("at least one annotation is required")
+	}
+
+	for i, annotation := range annotations {
+		if strings.TrimSpace(annotation.Path) == "" {
+			return fmt.Errorf("annotation %d: path is required", i+1)
+		}
+
+		if annotation.StartLine < 1 {
+			return fmt.Errorf("annotation %d: start line must be greater than 0", i+1)
+		}
+
+		if annotation.EndLine != 0 && annotation.EndLine < annotation.StartLine {
+			return fmt.Errorf("annotation %d: end line must not be before the start line", i+1)
+		}
+
+		if err := validateAnnotationLevel(i, annotation.Level); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(annotation.Message) == "" {
+			return fmt.Errorf("annotation %d: message is required", i+1)
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateAnnotation) Execute(ctx core.ExecutionContext) error {
+	config, err := decodeCreateAnnotationConfiguration(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	checkRunID, err := strconv.ParseInt(config.CheckRunID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("check run ID is not a number: %s", config.CheckRunID)
+	}
+
+	if err := validateAnnotations(config.Annotations); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := createAnnotations(client, appMetadata.Owner, config.Repository, checkRunID, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Added %d annotations to check run %d in %d requests", output.AnnotationCount, checkRunID, output.Requests)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.checkRunAnnotations",
+		[]any{output},
+	)
+}
+
+/*
+ * Sends the annotations in batches of MaxAnnotationsPerRequest.
+ * Every update must include the name, title and summary of the check run,
+ * so the current ones are kept unless the title or summary are configured.
+ */
+func createAnnotations(client *github.Client, owner, repo string, checkRunID int64, config CreateAnnotationConfiguration) (*CreateAnnotationOutput, error) {
+	checkRun, _, err := client.Checks.GetCheckRun(context.Background(), owner, repo, checkRunID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get check run %d: %w", checkRunID, wrapGitHubError(err))
+	}
+
+	title := cmp.Or(config.Title, checkRun.GetOutput().GetTitle(), checkRun.GetName())
+	summary := cmp.Or(config.Summary, checkRun.GetOutput().GetSummary(), title)
+
+	output := &CreateAnnotationOutput{CheckRunID: checkRunID, HTMLURL: checkRun.GetHTMLURL()}
+	for batch := range slices.Chunk(config.Annotations, MaxAnnotationsPerRequest) {
+		_, _, err := client.Checks.UpdateCheckRun(context.Background(), owner, repo, checkRunID, github.UpdateCheckRunOptions{
+			Name: checkRun.GetName(),
+			Output: &github.CheckRunOutput{
+				Title:       github.Ptr(title),
+				Summary:     github.Ptr(summary),
+				Annotations: checkRunAnnotations(batch),
+			},
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to add annotations to check run %d, %d of %d were added: %w",
+				checkRunID, output.AnnotationCount, len(config.Annotations), wrapGitHubError(err),
+			)
+		}
+
+		output.AnnotationCount += len(batch)
+		output.Requests++
+	}
+
+	return output, nil
+}
+
+func checkRunAnnotations(annotations []Annotation) []*github.CheckRunAnnotation {
+	result := make([]*github.CheckRunAnnotation, 0, len(annotations))
+	for _, annotation := range annotations {
+		endLine := annotation.EndLine
+		if endLine == 0 {
+			endLine = annotation.StartLine
+		}
+
+		result = append(result, &github.CheckRunAnnotation{
+			Path:            github.Ptr(annotation.Path),
+			StartLine:       github.Ptr(annotation.StartLine),
+			EndLine:         github.Ptr(endLine),
+			AnnotationLevel: github.Ptr(annotation.Level),
+			Message:         github.Ptr(annotation.Message),
+		})
+	}
+
+	return result
+}
+
+func (c *CreateAnnotation) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateAnnotation) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateAnnotation) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateAnnotation) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateAnnotation) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateAnnotation) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
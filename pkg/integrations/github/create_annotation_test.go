@@ -0,0 +1,188 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateAnnotation__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateAnnotation{}
+	annotation := map[string]any{"path": "main.go", "start_line": 10, "level": "warning", "message": "Unused variable"}
+
+	t.Run("check run ID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "annotations": []any{annotation}},
+		})
+
+		require.ErrorContains(t, err, "check run ID is required")
+	})
+
+	t.Run("annotations are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "checkRunId": "4242"},
+		})
+
+		require.ErrorContains(t, err, "at least one annotation is required")
+	})
+
+	t.Run("invalid level -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"checkRunId":  "4242",
+				"annotations": []any{annotation, map[string]any{"path": "main.go", "start_line": 1, "level": "error", "message": "Boom"}},
+			},
+		})
+
+		require.ErrorContains(t, err, `annotation 2: invalid level "error"`)
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "checkRunId": "4242", "annotations": []any{annotation}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateAnnotation__Validate(t *testing.T) {
+	t.Run("lines may be strings", func(t *testing.T) {
+		config, err := decodeCreateAnnotationConfiguration(map[string]any{
+			"annotations": []any{map[string]any{"path": "main.go", "start_line": "10", "end_line": "12", "level": "notice", "message": "Hi"}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, config.Annotations[0].StartLine)
+		assert.Equal(t, 12, config.Annotations[0].EndLine)
+		require.NoError(t, validateAnnotations(config.Annotations))
+	})
+
+	t.Run("invalid annotations -> error", func(t *testing.T) {
+		require.ErrorContains(t, validateAnnotations([]Annotation{{StartLine: 1, Level: "notice", Message: "Hi"}}), "annotation 1: path is required")
+		require.ErrorContains(t, validateAnnotations([]Annotation{{Path: "a.go", Level: "notice", Message: "Hi"}}), "start line must be greater than 0")
+		require.ErrorContains(t, validateAnnotations([]Annotation{{Path: "a.go", StartLine: 5, EndLine: 2, Level: "notice", Message: "Hi"}}), "end line must not be before the start line")
+		require.ErrorContains(t, validateAnnotations([]Annotation{{Path: "a.go", StartLine: 1, Level: "info", Message: "Hi"}}), `invalid level "info"`)
+		require.ErrorContains(t, validateAnnotations([]Annotation{{Path: "a.go", StartLine: 1, Level: "notice"}}), "message is required")
+	})
+}
+
+func Test__CreateAnnotation__Create(t *testing.T) {
+	annotations := func(count int) []Annotation {
+		result := []Annotation{}
+		for i := range count {
+			result = append(result, Annotation{Path: "main.go", StartLine: i + 1, Level: AnnotationLevelWarning, Message: fmt.Sprintf("Finding %d", i+1)})
+		}
+
+		return result
+	}
+
+	checkRunTransport := func(failOnUpdate int) *mockTransport {
+		updates := 0
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"id":4242,"name":"lint","html_url":"https://github.com/testhq/hello/runs/4242","output":{"title":"Lint","summary":"3 findings"}}`), nil
+			}
+
+			updates++
+			if updates == failOnUpdate {
+				return mockResponse(http.StatusUnprocessableEntity, `{"message":"Validation Failed"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":4242,"name":"lint"}`), nil
+		}}
+	}
+
+	updateBody := func(t *testing.T, request *http.Request) github.UpdateCheckRunOptions {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+
+		var options github.UpdateCheckRunOptions
+		require.NoError(t, json.Unmarshal(body, &options))
+		return options
+	}
+
+	t.Run("annotations are sent in batches of 50", func(t *testing.T) {
+		transport := checkRunTransport(0)
+		output, err := createAnnotations(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 4242, CreateAnnotationConfiguration{
+			Annotations: annotations(120),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+		assert.Equal(t, 120, output.AnnotationCount)
+		assert.Equal(t, 3, output.Requests)
+		assert.Equal(t, "https://github.com/testhq/hello/runs/4242", output.HTMLURL)
+
+		sizes := []int{}
+		for _, request := range transport.requests[1:] {
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.Equal(t, "/repos/testhq/hello/check-runs/4242", request.URL.Path)
+
+			options := updateBody(t, request)
+			assert.Equal(t, "lint", options.Name)
+			assert.Equal(t, "Lint", options.Output.GetTitle())
+			assert.Equal(t, "3 findings", options.Output.GetSummary())
+			sizes = append(sizes, len(options.Output.Annotations))
+		}
+
+		assert.Equal(t, []int{50, 50, 20}, sizes)
+	})
+
+	t.Run("end line defaults to the start line", func(t *testing.T) {
+		transport := checkRunTransport(0)
+		_, err := createAnnotations(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 4242, CreateAnnotationConfiguration{
+			Title:       "Security scan",
+			Annotations: annotations(1),
+		})
+
+		require.NoError(t, err)
+		options := updateBody(t, transport.requests[1])
+		assert.Equal(t, "Security scan", options.Output.GetTitle())
+		annotation := options.Output.Annotations[0]
+		assert.Equal(t, 1, annotation.GetEndLine())
+		assert.Equal(t, "warning", annotation.GetAnnotationLevel())
+		assert.Equal(t, "Finding 1", annotation.GetMessage())
+	})
+
+	t.Run("failed batch -> error with the annotations added so far", func(t *testing.T) {
+		transport := checkRunTransport(2)
+		_, err := createAnnotations(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 4242, CreateAnnotationConfiguration{
+			Annotations: annotations(120),
+		})
+
+		require.ErrorContains(t, err, "50 of 120 were added")
+		assert.Len(t, transport.requests, 3)
+	})
+
+	t.Run("check run not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := createAnnotations(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 4242, CreateAnnotationConfiguration{
+			Annotations: annotations(1),
+		})
+
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,268 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+var commitSHARegex = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+type CreateCommitComment struct{}
+
+type CreateCommitCommentConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	SHA        string `json:"sha" mapstructure:"sha"`
+	Body       string `json:"body" mapstructure:"body"`
+	Path       string `json:"path" mapstructure:"path"`
+	Position   *int   `json:"position" mapstructure:"position"`
+	OnOversize string `json:"onOversize" mapstructure:"onOversize"`
+}
+
+func (c *CreateCommitComment) Name() string {
+	return "github.createCommitComment"
+}
+
+func (c *CreateCommitComment) Label() string {
+	return "Create Commit Comment"
+}
+
+func (c *CreateCommitComment) Description() string {
+	return "Add a comment to a GitHub commit"
+}
+
+func (c *CreateCommitComment) Documentation() string {
+	return `The Create Commit Comment component adds a comment to a commit, or to a line of a file changed by the commit.
+
+## Use Cases
+
+- **Build annotations**: Comment build or deploy results directly on the commit
+- **Line-level feedback**: Point out a problem on a specific line changed by the commit
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **SHA**: The commit SHA (supports expressions)
+- **Body**: The comment text (supports markdown and expressions)
+- **Path**: The path of a file changed by the commit, for a line-level comment (optional)
+- **Position**: The line in the diff of the file to comment on. Required when a path is set
+- **On Oversize**: Truncate the body, or fail, when it is longer than GitHub allows
+
+## Output
+
+Returns the created comment, including its ` + "`html_url`" + `.
+
+## Notes
+
+- The position is the line index in the diff of the file, not the line number in the file. The first line after the ` + "`@@`" + ` hunk header is position 1`
+}
+
+func (c *CreateCommitComment) Icon() string {
+	return "github"
+}
+
+func (c *CreateCommitComment) Color() string {
+	return "gray"
+}
+
+func (c *CreateCommitComment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateCommitComment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "sha",
+			Label:       "SHA",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.head_commit.id}}",
+		},
+		{
+			Name:     "body",
+			Label:    "Body",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		{
+			Name:        "path",
+			Label:       "Path",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., src/main.go",
+			Description: "File to comment on, for a line-level comment",
+		},
+		{
+			Name:        "position",
+			Label:       "Position",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Line in the diff of the file to comment on",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		OnOversizeField,
+		EventTypeField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateCommitComment) Setup(ctx core.SetupContext) error {
+	var config CreateCommitCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCommitComment(config); err != nil {
+		return err
+	}
+
+	if err := validateOnOversize(config.OnOversize); err != nil {
+		return err
+	}
+
+	if err := validateEventType(ctx.Configuration); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateCommitComment(config CreateCommitCommentConfiguration) error {
+	if config.SHA == "" {
+		return errors.New("sha is required")
+	}
+
+	if !isExpression(config.SHA) && !commitSHARegex.MatchString(config.SHA) {
+		return fmt.Errorf("invalid sha %s: must be 7 to 40 hexadecimal characters", config.SHA)
+	}
+
+	if config.Body == "" {
+		return errors.New("body is required")
+	}
+
+	if config.Path != "" && config.Position == nil {
+		return errors.New("position is required when a path is set")
+	}
+
+	if config.Position != nil && config.Path == "" {
+		return errors.New("path is required when a position is set")
+	}
+
+	if config.Position != nil && *config.Position < 1 {
+		return errors.New("position must be at least 1")
+	}
+
+	return nil
+}
+
+func (c *CreateCommitComment) Execute(ctx core.ExecutionContext) error {
+	var config CreateCommitCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	//
+	// The SHA can be an expression, so it is validated again once resolved.
+	//
+	if err := validateCommitComment(config); err != nil {
+		return err
+	}
+
+	body, truncated, err := guardBodySize(config.Body, MaxCommentBodyLength, config.OnOversize)
+	if err != nil {
+		return err
+	}
+
+	if truncated {
+		ctx.Logger.Warnf("Commit comment body is longer than %d characters, truncating it", MaxCommentBodyLength)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, eventType(ctx.Configuration, "github.commitComment"), func() (any, error) {
+		return createCommitComment(client, appMetadata.Owner, config.Repository, config.SHA, body, config.Path, config.Position)
+	})
+}
+
+func createCommitComment(client *github.Client, owner, repo, sha, body, path string, position *int) (*github.RepositoryComment, error) {
+	comment := &github.RepositoryComment{Body: github.Ptr(body)}
+	if path != "" {
+		comment.Path = github.Ptr(path)
+		comment.Position = position
+	}
+
+	created, _, err := client.Repositories.CreateComment(context.Background(), owner, repo, sha, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to comment on commit %s: %w", sha, wrapGitHubError(err))
+	}
+
+	return created, nil
+}
+
+func (c *CreateCommitComment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateCommitComment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateCommitComment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateCommitComment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateCommitComment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateCommitComment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateCommitComment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateCommitComment{}
+
+	setup := func(config map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: config,
+		})
+	}
+
+	t.Run("sha is required", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "body": "Deployed"}), "sha is required")
+	})
+
+	t.Run("invalid sha -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "sha": "not-a-sha", "body": "Deployed"})
+		require.ErrorContains(t, err, "invalid sha not-a-sha")
+	})
+
+	t.Run("body is required", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "sha": "6dcb09b"}), "body is required")
+	})
+
+	t.Run("path without position -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "sha": "6dcb09b", "body": "Typo", "path": "README.md"})
+		require.ErrorContains(t, err, "position is required when a path is set")
+	})
+
+	t.Run("position without path -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "sha": "6dcb09b", "body": "Typo", "position": 3})
+		require.ErrorContains(t, err, "path is required when a position is set")
+	})
+
+	t.Run("expression sha -> ok", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"repository": "hello", "sha": "{{$.data.head_commit.id}}", "body": "Deployed"}))
+	})
+
+	t.Run("line-level comment -> ok", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{
+			"repository": "hello",
+			"sha":        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"body":       "Typo",
+			"path":       "README.md",
+			"position":   3,
+		}))
+	})
+}
+
+func Test__CreateCommitComment__Create(t *testing.T) {
+	t.Run("commit comment", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"body":"Deployed to production"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"id":1,"body":"Deployed to production","html_url":"https://github.com/testhq/hello/commit/6dcb09b#commitcomment-1"}`), nil
+		}}
+
+		comment, err := createCommitComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "6dcb09b", "Deployed to production", "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+		assert.Equal(t, "/repos/testhq/hello/commits/6dcb09b/comments", transport.requests[0].URL.Path)
+		assert.Equal(t, "https://github.com/testhq/hello/commit/6dcb09b#commitcomment-1", comment.GetHTMLURL())
+	})
+
+	t.Run("line-level comment", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"body":"Typo","path":"README.md","position":3}`, string(body))
+			return mockResponse(http.StatusCreated, `{"id":2,"body":"Typo","path":"README.md","position":3}`), nil
+		}}
+
+		comment, err := createCommitComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "6dcb09b", "Typo", "README.md", github.Ptr(3))
+		require.NoError(t, err)
+		assert.Equal(t, "README.md", comment.GetPath())
+	})
+
+	t.Run("commit not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusUnprocessableEntity, `{"message":"No commit found for SHA: 6dcb09b"}`), nil
+		}}
+
+		_, err := createCommitComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "6dcb09b", "Deployed", "", nil)
+		require.ErrorContains(t, err, "failed to comment on commit 6dcb09b")
+	})
+}
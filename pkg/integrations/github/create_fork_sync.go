@@ -0,0 +1,247 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ForkSyncedOutputChannel   = "synced"
+	ForkConflictOutputChannel = "conflict"
+
+	ForkSyncFastForwarded = "fast_forwarded"
+	ForkSyncMerged        = "merged"
+	ForkSyncUpToDate      = "up_to_date"
+	ForkSyncConflict      = "conflict"
+)
+
+type CreateForkSync struct{}
+
+type CreateForkSyncConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Branch     string `json:"branch" mapstructure:"branch"`
+}
+
+type ForkSyncOutput struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	Status     string `json:"status"`
+	BaseBranch string `json:"base_branch,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+func (c *CreateForkSync) Name() string {
+	return "github.createForkSync"
+}
+
+func (c *CreateForkSync) Label() string {
+	return "Sync Fork"
+}
+
+func (c *CreateForkSync) Description() string {
+	return "Sync a branch of a forked GitHub repository with its upstream repository"
+}
+
+func (c *CreateForkSync) Documentation() string {
+	return `The Sync Fork component updates a branch of a forked repository with the changes from its upstream repository.
+
+## Use Cases
+
+- **Fork maintenance**: Keep forks from drifting away from upstream on a schedule
+- **Conflict handling**: Open an issue or notify the team when a fork can no longer be synced automatically
+
+## Configuration
+
+- **Repository**: Select the forked GitHub repository
+- **Branch**: The branch of the fork to sync (supports expressions)
+
+## Output Channels
+
+- **Synced**: The branch was synced, or was already up to date
+- **Conflict**: The branch has changes that conflict with upstream, and must be synced manually
+
+## Output
+
+Returns the ` + "`branch`" + ` and the ` + "`status`" + ` of the sync:
+
+- ` + "`fast_forwarded`" + `: The branch had no changes of its own, and was fast-forwarded to upstream
+- ` + "`merged`" + `: The upstream changes were merged into the branch
+- ` + "`up_to_date`" + `: The branch was not behind upstream, so nothing changed
+- ` + "`conflict`" + `: The branch could not be synced because of conflicts
+
+## Notes
+
+- The repository must be a fork, otherwise the component fails`
+}
+
+func (c *CreateForkSync) Icon() string {
+	return "github"
+}
+
+func (c *CreateForkSync) Color() string {
+	return "gray"
+}
+
+func (c *CreateForkSync) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ForkSyncedOutputChannel, Label: "Synced"},
+		{Name: ForkConflictOutputChannel, Label: "Conflict"},
+	}
+}
+
+func (c *CreateForkSync) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "branch",
+			Label:       "Branch",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., main",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateForkSync) Setup(ctx core.SetupContext) error {
+	var config CreateForkSyncConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Branch == "" {
+		return errors.New("branch is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreateForkSync) Execute(ctx core.ExecutionContext) error {
+	var config CreateForkSyncConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := syncFork(client, appMetadata.Owner, config.Repository, config.Branch)
+	if err != nil {
+		return err
+	}
+
+	channel := ForkSyncedOutputChannel
+	if output.Status == ForkSyncConflict {
+		ctx.Logger.Infof("Branch %s of %s conflicts with upstream: %s", config.Branch, config.Repository, output.Message)
+		channel = ForkConflictOutputChannel
+	}
+
+	return ctx.ExecutionState.Emit(channel, "github.forkSync", []any{output})
+}
+
+/*
+ * GitHub answers with a 409 when the branch conflicts with upstream.
+ * That is reported as a conflict status instead of an error,
+ * so the workflow can route it to a manual resolution.
+ */
+func syncFork(client *github.Client, owner, repo, branch string) (*ForkSyncOutput, error) {
+	output := &ForkSyncOutput{Repository: repo, Branch: branch}
+	result, _, err := client.Repositories.MergeUpstream(
+		context.Background(),
+		owner,
+		repo,
+		&github.RepoMergeUpstreamRequest{Branch: github.Ptr(branch)},
+	)
+
+	if err != nil {
+		var responseErr *github.ErrorResponse
+		if errors.As(err, &responseErr) && responseErr.Response != nil && responseErr.Response.StatusCode == http.StatusConflict {
+			output.Status = ForkSyncConflict
+			output.Message = responseErr.Message
+			return output, nil
+		}
+
+		return nil, fmt.Errorf("failed to sync branch %s of %s: %w", branch, repo, wrapGitHubError(err))
+	}
+
+	output.BaseBranch = result.GetBaseBranch()
+	output.Message = result.GetMessage()
+	output.Status = forkSyncStatus(result.GetMergeType())
+	return output, nil
+}
+
+func forkSyncStatus(mergeType string) string {
+	switch mergeType {
+	case "fast-forward":
+		return ForkSyncFastForwarded
+	case "merge":
+		return ForkSyncMerged
+	default:
+		return ForkSyncUpToDate
+	}
+}
+
+func (c *CreateForkSync) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateForkSync) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateForkSync) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateForkSync) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateForkSync) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateForkSync) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
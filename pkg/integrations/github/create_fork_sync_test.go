@@ -0,0 +1,86 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateForkSync__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateForkSync{}
+
+	t.Run("branch is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "branch is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "branch": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateForkSync__Sync(t *testing.T) {
+	t.Run("fast-forwarded", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"branch":"main"}`, string(body))
+			return mockResponse(http.StatusOK, `{"message":"Successfully fetched and fast-forwarded from upstream upstream:main.","merge_type":"fast-forward","base_branch":"upstream:main"}`), nil
+		}}
+
+		output, err := syncFork(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main")
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+		assert.Equal(t, "/repos/testhq/hello/merge-upstream", transport.requests[0].URL.Path)
+		assert.Equal(t, ForkSyncFastForwarded, output.Status)
+		assert.Equal(t, "upstream:main", output.BaseBranch)
+	})
+
+	t.Run("already up to date", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"message":"This branch is not behind the upstream upstream:main.","merge_type":"none","base_branch":"upstream:main"}`), nil
+		}}
+
+		output, err := syncFork(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main")
+		require.NoError(t, err)
+		assert.Equal(t, ForkSyncUpToDate, output.Status)
+	})
+
+	t.Run("conflict -> conflict status, not an error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusConflict, `{"message":"There are merge conflicts"}`), nil
+		}}
+
+		output, err := syncFork(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main")
+		require.NoError(t, err)
+		assert.Equal(t, ForkSyncConflict, output.Status)
+		assert.Equal(t, "There are merge conflicts", output.Message)
+	})
+
+	t.Run("branch not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := syncFork(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "missing")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -127,6 +127,7 @@ func (c *CreateIssue) Configuration() []configuration.Field {
 				},
 			},
 		},
+		ConcurrencyKeyField,
 	}
 }
 
@@ -149,11 +150,22 @@ func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode application metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
 
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
 	//
 	// Prepare the request based on the configuration
 	//
@@ -174,22 +186,20 @@ func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 	}
 
 	// Create the issue
-	issue, _, err := client.Issues.Create(
-		context.Background(),
-		appMetadata.Owner,
-		config.Repository,
-		issueRequest,
-	)
-
-	if err != nil {
-		return fmt.Errorf("failed to create issue: %w", err)
-	}
-
-	return ctx.ExecutionState.Emit(
-		core.DefaultOutputChannel.Name,
-		"github.issue",
-		[]any{issue},
-	)
+	return withIdempotency(ctx, "github.issue", func() (any, error) {
+		issue, _, err := client.Issues.Create(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			issueRequest,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create issue: %w", err)
+		}
+
+		return issue, nil
+	})
 }
 
 func (c *CreateIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
@@ -0,0 +1,545 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+)
+
+const (
+	BodyFormatMarkdown = "markdown"
+	BodyFormatTemplate = "template"
+
+	IssueCommentActionRetry = "retry"
+	IssueCommentActionSkip  = "skip"
+)
+
+type CreateIssueComment struct{}
+
+type CreateIssueCommentConfiguration struct {
+	Repository    string `json:"repository" mapstructure:"repository"`
+	IssueNumber   string `json:"issueNumber" mapstructure:"issueNumber"`
+	BodyFormat    string `json:"bodyFormat" mapstructure:"bodyFormat"`
+	Body          string `json:"body" mapstructure:"body"`
+	BodyTemplate  string `json:"bodyTemplate" mapstructure:"bodyTemplate"`
+	RenderPreview bool   `json:"renderPreview" mapstructure:"renderPreview"`
+	OnOversize    string `json:"onOversize" mapstructure:"onOversize"`
+
+	ContinueOnError bool `json:"continueOnError" mapstructure:"continueOnError"`
+}
+
+/*
+ * The comment, as returned by GitHub,
+ * with the rendered HTML preview of its body, if requested.
+ */
+type IssueCommentOutput struct {
+	*github.IssueComment
+	RenderedHTML string `json:"rendered_html,omitempty" mapstructure:"rendered_html,omitempty"`
+	Truncated    bool   `json:"truncated" mapstructure:"truncated"`
+	Skipped      bool   `json:"skipped,omitempty" mapstructure:"skipped,omitempty"`
+	SkippedBy    string `json:"skipped_by,omitempty" mapstructure:"skipped_by,omitempty"`
+}
+
+/*
+ * Actions only receive the node configuration, with expressions not resolved,
+ * so the resolved request is recorded in the execution metadata
+ * before the comment is created, for the retry action to repeat it.
+ */
+type IssueCommentMetadata struct {
+	Idempotency *IdempotencyRecord   `json:"idempotency,omitempty" mapstructure:"idempotency"`
+	Request     *IssueCommentRequest `json:"request,omitempty" mapstructure:"request"`
+}
+
+type IssueCommentRequest struct {
+	Key           string `json:"key" mapstructure:"key"`
+	Repository    string `json:"repository" mapstructure:"repository"`
+	IssueNumber   int    `json:"issueNumber" mapstructure:"issueNumber"`
+	Body          string `json:"body" mapstructure:"body"`
+	Truncated     bool   `json:"truncated" mapstructure:"truncated"`
+	RenderPreview bool   `json:"renderPreview" mapstructure:"renderPreview"`
+	EventType     string `json:"eventType" mapstructure:"eventType"`
+}
+
+func (c *CreateIssueComment) Name() string {
+	return "github.createIssueComment"
+}
+
+func (c *CreateIssueComment) Label() string {
+	return "Create Issue Comment"
+}
+
+func (c *CreateIssueComment) Description() string {
+	return "Add a comment to a GitHub issue or pull request"
+}
+
+func (c *CreateIssueComment) Documentation() string {
+	return `The Create Issue Comment component adds a comment to a GitHub issue or pull request.
+
+## Use Cases
+
+- **Status updates**: Report deployment or build results on the related issue or pull request
+- **Notifications**: Mention people on an issue when something happens
+- **Bots**: Reply to commands posted as comments
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue or pull request number (supports expressions)
+- **Body Format**: How the comment body is written:
+  - **Markdown**: The body is markdown, with expressions. The GitHub functions below are available in expressions too,
+    e.g. ` + "`{{ shortSha($[\"On Push\"].data.after) }}`" + `
+  - **Template**: The body is a Go template, rendered against the input event.
+    For example, ` + "`{{ .data.pull_request.title | upper }}`" + `. The ` + "`json`" + `, ` + "`upper`" + `, ` + "`lower`" + `, ` + "`truncate`" + `, and ` + "`date`" + ` functions are available,
+    and these GitHub functions:
+    - ` + "`shortSha`" + `: The first 7 characters of a commit SHA, e.g. ` + "`{{ shortSha .data.after }}`" + `
+    - ` + "`prUrl`" + `: The URL of a pull request, e.g. ` + "`{{ prUrl .data.repository.full_name .data.number }}`" + `
+    - ` + "`mentionUser`" + `: The @mention of a login, e.g. ` + "`{{ mentionUser .data.sender.login }}`" + `
+    - ` + "`relativeTime`" + `: How long ago a time is, e.g. ` + "`{{ relativeTime .data.created_at }}`" + ` renders ` + "`3 hours ago`" + `
+- **Body**: The comment body (supports markdown and expressions)
+- **Body Template**: The comment body template. Template syntax errors are reported when the node is saved
+- **Render Preview**: Also render the body as HTML, using the repository context for references like ` + "`#123`" + ` and ` + "`@user`" + `
+- **On Oversize**: GitHub rejects comments over 65536 characters. Truncate the body with a notice, or fail before calling GitHub
+- **Continue On Error**: If creating the comment fails, emit an error event on the **Error** channel instead of failing the execution
+- **Run If**: Only comment when this is truthy, for example ` + "`{{ $.data.action == \"opened\" }}`" + `.
+  If it is falsy - empty, ` + "`false`" + `, ` + "`0`" + `, ` + "`no`" + `, ` + "`off`" + `, or ` + "`null`" + ` - no comment is posted, and a skipped event is emitted on the **Skipped** channel
+
+## Output
+
+Returns the created comment. If **Render Preview** is enabled, the rendered HTML is included in ` + "`rendered_html`" + `,
+so downstream Slack or email nodes can show a faithful preview.
+` + "`truncated`" + ` is true if the body was cut to fit the GitHub limit.
+
+With **Continue On Error**, failures are emitted on the **Error** channel, with the error ` + "`message`" + `, its ` + "`category`" + `, like ` + "`not_found`" + ` or ` + "`rate_limited`" + `, and ` + "`retryable`" + `.
+
+## Actions
+
+- **Retry**: Create the comment again after a failed or stuck execution, with the same body
+- **Skip**: Complete the execution without posting the comment. The output is emitted on the **Skipped** channel, with ` + "`skipped`" + ` set to true
+
+## Notes
+
+- Rendering the preview uses an extra GitHub API call, which counts against the rate limit, so it is opt-in
+- Neither action is available once the comment was created
+- Expressions in the issue number and body are checked on setup, and references other than ` + "`$`" + `, ` + "`root()`" + ` and ` + "`previous()`" + ` are rejected`
+}
+
+func (c *CreateIssueComment) Icon() string {
+	return "github"
+}
+
+func (c *CreateIssueComment) Color() string {
+	return "gray"
+}
+
+func (c *CreateIssueComment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel, core.ErrorOutputChannel}
+}
+
+func (c *CreateIssueComment) EventTypes() []string {
+	return []string{"github.issueComment", core.ErrorPayloadType}
+}
+
+func (c *CreateIssueComment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:     "bodyFormat",
+			Label:    "Body Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  BodyFormatMarkdown,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Markdown", Value: BodyFormatMarkdown},
+						{Label: "Template", Value: BodyFormatTemplate},
+					},
+				},
+			},
+		},
+		{
+			Name:  "body",
+			Label: "Body",
+			Type:  configuration.FieldTypeText,
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatMarkdown}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatMarkdown}},
+			},
+		},
+		{
+			Name:               "bodyTemplate",
+			Label:              "Body Template",
+			Type:               configuration.FieldTypeText,
+			DisallowExpression: true,
+			Placeholder:        "e.g., Deployed {{ .data.head_sha | truncate 7 }}",
+			Description:        "Go template rendered against the input event",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatTemplate}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "bodyFormat", Values: []string{BodyFormatTemplate}},
+			},
+		},
+		{
+			Name:        "renderPreview",
+			Label:       "Render Preview",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Include the rendered HTML of the comment body in the output. Uses an extra API call.",
+		},
+		OnOversizeField,
+		{
+			Name:        "continueOnError",
+			Label:       "Continue On Error",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Emit an error event on the error channel instead of failing",
+		},
+		EventTypeField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateIssueComment) Inputs() []core.InputField {
+	return []core.InputField{
+		{
+			Name:        "issueNumber",
+			Description: "The number of the issue or pull request to comment on",
+			Required:    true,
+		},
+		{
+			Name:        "body",
+			Description: "The comment body, usually built from the event data",
+		},
+	}
+}
+
+func (c *CreateIssueComment) Setup(ctx core.SetupContext) error {
+	var config CreateIssueCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if config.BodyFormat == BodyFormatTemplate {
+		if config.BodyTemplate == "" {
+			return errors.New("body template is required")
+		}
+
+		if _, err := template.Parse("bodyTemplate", config.BodyTemplate, template.GitHubFuncs); err != nil {
+			return err
+		}
+	} else if config.Body == "" {
+		return errors.New("body is required")
+	}
+
+	if err := validateOnOversize(config.OnOversize); err != nil {
+		return err
+	}
+
+	if err := validateInputReferences(c.Inputs(), ctx.Configuration, ctx.Upstream); err != nil {
+		return err
+	}
+
+	if err := validateEventType(ctx.Configuration); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreateIssueComment) Execute(ctx core.ExecutionContext) error {
+	var config CreateIssueCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	body, err := c.buildBody(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	body, truncated, err := guardBodySize(body, MaxCommentBodyLength, config.OnOversize)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	key, err := idempotencyKey(ctx.NodeID, ctx.Configuration)
+	if err != nil {
+		return fmt.Errorf("failed to build idempotency key: %w", err)
+	}
+
+	request := IssueCommentRequest{
+		Key:           key,
+		Repository:    config.Repository,
+		IssueNumber:   issueNumber,
+		Body:          body,
+		Truncated:     truncated,
+		RenderPreview: config.RenderPreview,
+		EventType:     eventType(ctx.Configuration, "github.issueComment"),
+	}
+
+	err = withIdempotency(ctx, request.EventType, func() (any, error) {
+		err := ctx.Metadata.Set(IssueCommentMetadata{Request: &request})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record comment request: %w", err)
+		}
+
+		return createIssueComment(ctx.Logger, client, appMetadata.Owner, request)
+	})
+
+	if err != nil && config.ContinueOnError && !ctx.ExecutionState.IsFinished() {
+		ctx.Logger.Warnf("Failed to create comment, continuing on error: %v", err)
+		return ctx.ExecutionState.EmitError(core.ErrorOutputChannel.Name, err)
+	}
+
+	return err
+}
+
+func createIssueComment(logger *log.Entry, client *github.Client, owner string, request IssueCommentRequest) (*IssueCommentOutput, error) {
+	logger = logger.WithFields(log.Fields{
+		"repository":   request.Repository,
+		"issue_number": request.IssueNumber,
+	})
+
+	logger.Info("Creating issue comment")
+	comment, _, err := client.Issues.CreateComment(
+		context.Background(),
+		owner,
+		request.Repository,
+		request.IssueNumber,
+		&github.IssueComment{Body: &request.Body},
+	)
+
+	if err != nil {
+		logger.Errorf("Failed to create issue comment: %v", err)
+		return nil, fmt.Errorf("failed to create comment: %w", wrapGitHubError(err))
+	}
+
+	logger.WithField("comment_id", comment.GetID()).Info("Issue comment created")
+
+	output := &IssueCommentOutput{IssueComment: comment, Truncated: request.Truncated}
+	if !request.RenderPreview {
+		return output, nil
+	}
+
+	//
+	// The comment was already created at this point,
+	// so failing to render the preview should not fail the execution.
+	//
+	html, _, err := client.Markdown.Render(
+		context.Background(),
+		request.Body,
+		&github.MarkdownOptions{
+			Mode:    "gfm",
+			Context: fmt.Sprintf("%s/%s", owner, request.Repository),
+		},
+	)
+
+	if err != nil {
+		logger.Warnf("Comment %d created, but failed to render preview: %v", comment.GetID(), err)
+		return output, nil
+	}
+
+	output.RenderedHTML = html
+	return output, nil
+}
+
+func (c *CreateIssueComment) buildBody(ctx core.ExecutionContext, config CreateIssueCommentConfiguration) (string, error) {
+	if config.BodyFormat != BodyFormatTemplate {
+		return config.Body, nil
+	}
+
+	return template.Render("bodyTemplate", config.BodyTemplate, ctx.Data, template.GitHubFuncs)
+}
+
+func (c *CreateIssueComment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateIssueComment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateIssueComment) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           IssueCommentActionRetry,
+			Description:    "Retry",
+			UserAccessible: true,
+		},
+		{
+			Name:           IssueCommentActionSkip,
+			Description:    "Skip",
+			UserAccessible: true,
+		},
+	}
+}
+
+func (c *CreateIssueComment) HandleAction(ctx core.ActionContext) error {
+	return c.handleAction(ctx, integrationTransport(ctx.Integration))
+}
+
+func (c *CreateIssueComment) handleAction(ctx core.ActionContext, transport http.RoundTripper) error {
+	metadata := IssueCommentMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	//
+	// The execution state context does not tell passed and failed executions apart,
+	// so the recorded result is what tells us the comment was already created.
+	//
+	if metadata.Idempotency != nil && metadata.Idempotency.Result != nil {
+		return errors.New("comment was already created")
+	}
+
+	switch ctx.Name {
+	case IssueCommentActionRetry:
+		return c.handleRetry(ctx, transport, metadata)
+	case IssueCommentActionSkip:
+		return c.handleSkip(ctx, metadata)
+	default:
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+}
+
+func (c *CreateIssueComment) handleRetry(ctx core.ActionContext, transport http.RoundTripper, metadata IssueCommentMetadata) error {
+	if metadata.Request == nil {
+		return errors.New("execution failed before the comment request was built - nothing to retry")
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := createIssueComment(ctx.Logger, client, appMetadata.Owner, *metadata.Request)
+	if err != nil {
+		return err
+	}
+
+	err = c.complete(ctx, metadata, output)
+	if err != nil {
+		return err
+	}
+
+	eventType := metadata.Request.EventType
+	if eventType == "" {
+		eventType = "github.issueComment"
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, eventType, []any{output})
+}
+
+func (c *CreateIssueComment) handleSkip(ctx core.ActionContext, metadata IssueCommentMetadata) error {
+	output := &IssueCommentOutput{Skipped: true}
+	if user := ctx.Auth.AuthenticatedUser(); user != nil {
+		output.SkippedBy = user.Email
+	}
+
+	err := c.complete(ctx, metadata, output)
+	if err != nil {
+		return err
+	}
+
+	//
+	// No comment was posted, so the event goes to the skipped channel,
+	// just like when the run if condition is falsy.
+	//
+	return ctx.ExecutionState.Emit(core.SkippedOutputChannel.Name, core.SkippedPayloadType, []any{output})
+}
+
+/*
+ * The result is recorded just like withIdempotency does,
+ * so the execution is not repeated, and further actions are rejected.
+ */
+func (c *CreateIssueComment) complete(ctx core.ActionContext, metadata IssueCommentMetadata, output *IssueCommentOutput) error {
+	record := &IdempotencyRecord{Result: output}
+	if metadata.Request != nil {
+		record.Key = metadata.Request.Key
+	}
+
+	err := ctx.Metadata.Set(IssueCommentMetadata{Idempotency: record, Request: metadata.Request})
+	if err != nil {
+		return fmt.Errorf("failed to record comment result: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CreateIssueComment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateIssueComment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,383 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+	"github.com/superplanehq/superplane/pkg/registry"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateIssueComment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateIssueComment{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "body": "hi"},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("body is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42"},
+		})
+
+		require.ErrorContains(t, err, "body is required")
+	})
+
+	t.Run("body template is required in template format", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "bodyFormat": BodyFormatTemplate, "body": "hi"},
+		})
+
+		require.ErrorContains(t, err, "body template is required")
+	})
+
+	t.Run("invalid body template -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":   "hello",
+				"issueNumber":  "42",
+				"bodyFormat":   BodyFormatTemplate,
+				"bodyTemplate": "Deployed {{ .data.sha",
+			},
+		})
+
+		require.ErrorIs(t, err, template.ErrInvalidTemplate)
+	})
+
+	t.Run("unknown expression reference -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": "{{ eventt.number }}",
+				"body":        "Deployed :rocket:",
+			},
+		})
+
+		require.ErrorContains(t, err, `issueNumber: unknown reference "eventt"`)
+	})
+
+	t.Run("invalid expression -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": "42",
+				"body":        "Deployed {{ $.data.sha + }}",
+			},
+		})
+
+		require.ErrorContains(t, err, "body: invalid expression")
+	})
+
+	t.Run("known expression references are accepted", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": `{{ $["On Pull Request"].data.number }}`,
+				"body":        "{{ root().data.sha }} by {{ upper(previous().data.author) }}, {{ let n = len($.data.files); n }} files",
+			},
+		}))
+	})
+
+	t.Run("GitHub functions are accepted in expressions", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": "42",
+				"body":        `{{ shortSha(root().data.sha) }} by {{ mentionUser(previous().data.author) }}, {{ prUrl("testhq/hello", 42) }}`,
+			},
+		}))
+	})
+
+	upstream := map[string]any{
+		"On Pull Request": (&OnPullRequest{}).ExampleData(),
+		"on-pull-request": (&OnPullRequest{}).ExampleData(),
+	}
+
+	t.Run("field not in the upstream payload -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Upstream:    upstream,
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": `{{ $["On Pull Request"].dataa.number }}`,
+				"body":        "Deployed :rocket:",
+			},
+		})
+
+		require.ErrorContains(t, err, `issueNumber: unknown field "dataa" for node "On Pull Request"`)
+	})
+
+	t.Run("node not upstream -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Upstream:    upstream,
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": "{{ $.dataa.number }}",
+				"body":        "Deployed :rocket:",
+			},
+		})
+
+		require.ErrorContains(t, err, `issueNumber: unknown node "dataa"`)
+	})
+
+	t.Run("fields of upstream payloads are accepted", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &contexts.MetadataContext{},
+			Upstream:    upstream,
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"issueNumber": `{{ $["On Pull Request"].data.number }}`,
+				"body":        `{{ $["on-pull-request"].data.pull_request.title }} at {{ $["On Pull Request"].timestamp }}`,
+			},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":    "hello",
+				"issueNumber":   "42",
+				"body":          "Deployed :rocket:",
+				"renderPreview": true,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateIssueComment__Output(t *testing.T) {
+	comment := &github.IssueComment{ID: github.Ptr(int64(1)), Body: github.Ptr("**hi**")}
+
+	t.Run("rendered HTML is included next to the comment fields", func(t *testing.T) {
+		data, err := json.Marshal(&IssueCommentOutput{IssueComment: comment, RenderedHTML: "<p><strong>hi</strong></p>"})
+		require.NoError(t, err)
+
+		var output map[string]any
+		require.NoError(t, json.Unmarshal(data, &output))
+		assert.Equal(t, "**hi**", output["body"])
+		assert.Equal(t, "<p><strong>hi</strong></p>", output["rendered_html"])
+	})
+
+	t.Run("rendered HTML is omitted when not requested", func(t *testing.T) {
+		data, err := json.Marshal(&IssueCommentOutput{IssueComment: comment})
+		require.NoError(t, err)
+
+		var output map[string]any
+		require.NoError(t, json.Unmarshal(data, &output))
+		assert.NotContains(t, output, "rendered_html")
+	})
+}
+
+func Test__CreateIssueComment__BuildBody(t *testing.T) {
+	component := CreateIssueComment{}
+	ctx := core.ExecutionContext{
+		Data: map[string]any{"data": map[string]any{"sha": "a1b2c3d4e5f6", "environment": "production"}},
+	}
+
+	t.Run("markdown body is used as is", func(t *testing.T) {
+		body, err := component.buildBody(ctx, CreateIssueCommentConfiguration{Body: "Deployed"})
+		require.NoError(t, err)
+		assert.Equal(t, "Deployed", body)
+	})
+
+	t.Run("template is rendered against the input", func(t *testing.T) {
+		body, err := component.buildBody(ctx, CreateIssueCommentConfiguration{
+			BodyFormat:   BodyFormatTemplate,
+			BodyTemplate: "Deployed {{ .data.sha }} to {{ upper .data.environment }}",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Deployed a1b2c3d4e5f6 to PRODUCTION", body)
+	})
+
+	t.Run("GitHub functions are available", func(t *testing.T) {
+		body, err := component.buildBody(ctx, CreateIssueCommentConfiguration{
+			BodyFormat:   BodyFormatTemplate,
+			BodyTemplate: "Deployed {{ shortSha .data.sha }} for {{ mentionUser \"octocat\" }}",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Deployed a1b2c3d for @octocat", body)
+	})
+
+	t.Run("missing data -> render error", func(t *testing.T) {
+		_, err := component.buildBody(ctx, CreateIssueCommentConfiguration{
+			BodyFormat:   BodyFormatTemplate,
+			BodyTemplate: "Deployed {{ .data.version }}",
+		})
+
+		require.ErrorIs(t, err, template.ErrRenderFailed)
+	})
+}
+
+func Test__CreateIssueComment__Inputs(t *testing.T) {
+	inputs := core.ComponentInputs(&CreateIssueComment{})
+	require.Len(t, inputs, 2)
+	assert.Equal(t, "issueNumber", inputs[0].Name)
+	assert.True(t, inputs[0].Required)
+	assert.Equal(t, "body", inputs[1].Name)
+}
+
+func Test__CreateIssueComment__Actions(t *testing.T) {
+	component := CreateIssueComment{}
+	request := &IssueCommentRequest{Key: "abc", Repository: "hello", IssueNumber: 42, Body: "Deployed :rocket:"}
+
+	actionCtx := func(name string, metadata any) (core.ActionContext, *contexts.MetadataContext, *contexts.ExecutionStateContext) {
+		integration := testInstallation(t, "testhq")
+		metadataCtx := &contexts.MetadataContext{Metadata: metadata}
+		stateCtx := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		return core.ActionContext{
+			Name:           name,
+			Logger:         log.NewEntry(log.StandardLogger()),
+			Integration:    integration,
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Auth:           &contexts.AuthContext{User: &core.User{Email: "ops@example.com"}},
+		}, metadataCtx, stateCtx
+	}
+
+	commentTransport := func(status int, body string) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/issues/42/comments":
+				return mockResponse(status, body), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	t.Run("retry and skip are user accessible", func(t *testing.T) {
+		actions := component.Actions()
+		require.Len(t, actions, 2)
+		for _, action := range actions {
+			assert.True(t, action.UserAccessible)
+		}
+
+		assert.Equal(t, IssueCommentActionRetry, actions[0].Name)
+		assert.Equal(t, IssueCommentActionSkip, actions[1].Name)
+	})
+
+	t.Run("comment already created -> error", func(t *testing.T) {
+		ctx, _, stateCtx := actionCtx(IssueCommentActionRetry, IssueCommentMetadata{
+			Idempotency: &IdempotencyRecord{Key: "abc", Result: map[string]any{"id": 1}},
+		})
+
+		err := component.handleAction(ctx, commentTransport(http.StatusCreated, `{"id":2}`))
+		require.ErrorContains(t, err, "comment was already created")
+		assert.False(t, stateCtx.Finished)
+	})
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		ctx, _, _ := actionCtx("delete", nil)
+		require.ErrorContains(t, component.handleAction(ctx, commentTransport(http.StatusCreated, `{}`)), "unknown action: delete")
+	})
+
+	t.Run("retry without a recorded request -> error", func(t *testing.T) {
+		ctx, _, _ := actionCtx(IssueCommentActionRetry, nil)
+		err := component.handleAction(ctx, commentTransport(http.StatusCreated, `{}`))
+		require.ErrorContains(t, err, "nothing to retry")
+	})
+
+	t.Run("retry creates the recorded comment", func(t *testing.T) {
+		ctx, metadataCtx, stateCtx := actionCtx(IssueCommentActionRetry, IssueCommentMetadata{Request: request})
+		transport := commentTransport(http.StatusCreated, `{"id":2,"body":"Deployed :rocket:"}`)
+
+		require.NoError(t, component.handleAction(ctx, transport))
+
+		last := transport.requests[len(transport.requests)-1]
+		body, _ := io.ReadAll(last.Body)
+		assert.JSONEq(t, `{"body":"Deployed :rocket:"}`, string(body))
+
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, "github.issueComment", stateCtx.Type)
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(*IssueCommentOutput)
+		assert.Equal(t, int64(2), output.GetID())
+
+		metadata := metadataCtx.Get().(IssueCommentMetadata)
+		assert.Equal(t, "abc", metadata.Idempotency.Key)
+		assert.Equal(t, output, metadata.Idempotency.Result)
+	})
+
+	t.Run("retry emits the event type resolved on execution", func(t *testing.T) {
+		customRequest := *request
+		customRequest.EventType = "acme.deployComment"
+		ctx, _, stateCtx := actionCtx(IssueCommentActionRetry, IssueCommentMetadata{Request: &customRequest})
+		ctx.Configuration = map[string]any{"eventType": "{{ $.data.type }}"}
+
+		require.NoError(t, component.handleAction(ctx, commentTransport(http.StatusCreated, `{"id":2}`)))
+		assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, "acme.deployComment", stateCtx.Type)
+	})
+
+	t.Run("failed retry -> error, nothing recorded", func(t *testing.T) {
+		ctx, metadataCtx, stateCtx := actionCtx(IssueCommentActionRetry, IssueCommentMetadata{Request: request})
+		err := component.handleAction(ctx, commentTransport(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`))
+
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.False(t, stateCtx.Finished)
+		assert.Nil(t, metadataCtx.Get().(IssueCommentMetadata).Idempotency)
+	})
+
+	t.Run("skip completes without posting", func(t *testing.T) {
+		ctx, metadataCtx, stateCtx := actionCtx(IssueCommentActionSkip, IssueCommentMetadata{Request: request})
+		transport := commentTransport(http.StatusCreated, `{}`)
+
+		require.NoError(t, component.handleAction(ctx, transport))
+		assert.Empty(t, transport.requests)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, core.SkippedOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, core.SkippedPayloadType, stateCtx.Type)
+
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(*IssueCommentOutput)
+		assert.True(t, output.Skipped)
+		assert.Equal(t, "ops@example.com", output.SkippedBy)
+		assert.Nil(t, output.IssueComment)
+		assert.NotNil(t, metadataCtx.Get().(IssueCommentMetadata).Idempotency)
+	})
+}
+
+func Test__CreateIssueComment__RunIf(t *testing.T) {
+	component := registry.NewPanicableComponent(&CreateIssueComment{})
+
+	assert.True(t, core.SupportsRunIf(component.Configuration()))
+	assert.Contains(t, component.OutputChannels(nil), core.SkippedOutputChannel)
+}
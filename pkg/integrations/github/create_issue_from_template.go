@@ -0,0 +1,392 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const IssueTemplateDirectory = ".github/ISSUE_TEMPLATE"
+
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+type CreateIssueFromTemplate struct{}
+
+type CreateIssueFromTemplateConfiguration struct {
+	Repository   string         `json:"repository" mapstructure:"repository"`
+	TemplateName string         `json:"templateName" mapstructure:"templateName"`
+	Title        string         `json:"title" mapstructure:"title"`
+	Fields       map[string]any `json:"fields" mapstructure:"fields"`
+}
+
+/*
+ * A markdown issue template:
+ * the YAML front matter, and the body after it.
+ */
+type IssueTemplate struct {
+	Path      string
+	Title     string
+	Labels    []string
+	Assignees []string
+	Body      string
+}
+
+func (c *CreateIssueFromTemplate) Name() string {
+	return "github.createIssueFromTemplate"
+}
+
+func (c *CreateIssueFromTemplate) Label() string {
+	return "Create Issue from Template"
+}
+
+func (c *CreateIssueFromTemplate) Description() string {
+	return "Create a GitHub issue from one of the repository's issue templates"
+}
+
+func (c *CreateIssueFromTemplate) Documentation() string {
+	return `The Create Issue from Template component creates an issue from a markdown issue template of the repository, in ` + "`.github/ISSUE_TEMPLATE`" + `.
+
+## Use Cases
+
+- **Incident reports**: Open incidents with the team's incident template, filled with alert details
+- **Release checklists**: Create the release checklist issue for every new version
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Template Name**: The template file name, like ` + "`bug_report`" + ` or ` + "`bug_report.md`" + `
+- **Title**: The issue title (optional, supports expressions). Defaults to the title of the template
+- **Fields**: Values for the placeholders of the template
+
+## Templates
+
+The labels and assignees in the front matter of the template are applied to the issue.
+Placeholders like ` + "`{{ version }}`" + ` in the title and body of the template are replaced with the value of the field with the same name.
+Placeholders without a field are left as they are.
+
+## Output
+
+Returns the created issue object.
+
+## Notes
+
+- Only markdown templates are supported, not issue forms (` + "`.yml`" + ` templates)
+- The template is checked when the component is saved, unless the repository or template name are expressions`
+}
+
+func (c *CreateIssueFromTemplate) Icon() string {
+	return "github"
+}
+
+func (c *CreateIssueFromTemplate) Color() string {
+	return "gray"
+}
+
+func (c *CreateIssueFromTemplate) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateIssueFromTemplate) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "templateName",
+			Label:       "Template Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., bug_report",
+			Description: "File name of the template in .github/ISSUE_TEMPLATE",
+		},
+		{
+			Name:        "title",
+			Label:       "Title",
+			Type:        configuration.FieldTypeString,
+			Description: "Defaults to the title of the template",
+		},
+		{
+			Name:        "fields",
+			Label:       "Fields",
+			Type:        configuration.FieldTypeObject,
+			Description: "Values for the {{ placeholders }} of the template",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateIssueFromTemplate) Setup(ctx core.SetupContext) error {
+	return c.setup(ctx, integrationTransport(ctx.Integration))
+}
+
+func (c *CreateIssueFromTemplate) setup(ctx core.SetupContext, transport http.RoundTripper) error {
+	var config CreateIssueFromTemplateConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.TemplateName == "" {
+		return errors.New("template name is required")
+	}
+
+	err := ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	//
+	// Expressions are only resolved on execution,
+	// so the template can only be checked here when none are used.
+	//
+	if isExpression(config.Repository) || isExpression(config.TemplateName) {
+		return nil
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	_, err = getIssueTemplate(client, appMetadata.Owner, config.Repository, config.TemplateName)
+	return err
+}
+
+func (c *CreateIssueFromTemplate) Execute(ctx core.ExecutionContext) error {
+	var config CreateIssueFromTemplateConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	template, err := getIssueTemplate(client, appMetadata.Owner, config.Repository, config.TemplateName)
+	if err != nil {
+		return err
+	}
+
+	issueRequest, err := buildTemplateIssueRequest(template, config.Title, config.Fields)
+	if err != nil {
+		return err
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.issue", func() (any, error) {
+		issue, _, err := client.Issues.Create(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			issueRequest,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create issue: %w", wrapGitHubError(err))
+		}
+
+		return issue, nil
+	})
+}
+
+func issueTemplatePath(name string) string {
+	if path.Ext(name) == "" {
+		name += ".md"
+	}
+
+	return path.Join(IssueTemplateDirectory, name)
+}
+
+func getIssueTemplate(client *github.Client, owner, repo, name string) (*IssueTemplate, error) {
+	templatePath := issueTemplatePath(name)
+	if path.Ext(templatePath) != ".md" {
+		return nil, fmt.Errorf("issue template %s is not a markdown template", name)
+	}
+
+	file, _, _, err := client.Repositories.GetContents(context.Background(), owner, repo, templatePath, nil)
+	if err != nil {
+		err = wrapGitHubError(err)
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("issue template %s does not exist in %s: %w", templatePath, repo, err)
+		}
+
+		return nil, fmt.Errorf("failed to get issue template %s: %w", templatePath, err)
+	}
+
+	if file == nil {
+		return nil, fmt.Errorf("issue template %s is not a file", templatePath)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode issue template %s: %w", templatePath, err)
+	}
+
+	template, err := parseIssueTemplate(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue template %s: %w", templatePath, err)
+	}
+
+	template.Path = templatePath
+	return template, nil
+}
+
+/*
+ * The front matter is optional.
+ * Labels and assignees can be a list, or a comma-separated string.
+ */
+func parseIssueTemplate(content string) (*IssueTemplate, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return &IssueTemplate{Body: content}, nil
+	}
+
+	frontMatter, body, found := strings.Cut(strings.TrimPrefix(content, "---\n"), "\n---")
+	if !found {
+		return nil, errors.New("front matter is not closed")
+	}
+
+	var metadata struct {
+		Title     string `json:"title"`
+		Labels    any    `json:"labels"`
+		Assignees any    `json:"assignees"`
+	}
+
+	if err := yaml.Unmarshal([]byte(frontMatter), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse front matter: %w", err)
+	}
+
+	return &IssueTemplate{
+		Title:     metadata.Title,
+		Labels:    templateList(metadata.Labels),
+		Assignees: templateList(metadata.Assignees),
+		Body:      strings.TrimPrefix(strings.TrimPrefix(body, "\n"), "\n"),
+	}, nil
+}
+
+func templateList(value any) []string {
+	items := []string{}
+	switch v := value.(type) {
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+
+	case []any:
+		for _, item := range v {
+			if s := strings.TrimSpace(fmt.Sprintf("%v", item)); s != "" {
+				items = append(items, s)
+			}
+		}
+	}
+
+	return items
+}
+
+func renderIssueTemplate(content string, fields map[string]any) string {
+	return templatePlaceholderRegex.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := templatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+		value, ok := fields[name]
+		if !ok {
+			return placeholder
+		}
+
+		return fmt.Sprintf("%v", value)
+	})
+}
+
+func buildTemplateIssueRequest(template *IssueTemplate, title string, fields map[string]any) (*github.IssueRequest, error) {
+	if title == "" {
+		title = strings.TrimSpace(renderIssueTemplate(template.Title, fields))
+	}
+
+	if title == "" {
+		return nil, fmt.Errorf("title is required, since issue template %s has no title", template.Path)
+	}
+
+	issueRequest := &github.IssueRequest{
+		Title: github.Ptr(title),
+		Body:  github.Ptr(renderIssueTemplate(template.Body, fields)),
+	}
+
+	if len(template.Labels) > 0 {
+		issueRequest.Labels = &template.Labels
+	}
+
+	if len(template.Assignees) > 0 {
+		issueRequest.Assignees = &template.Assignees
+	}
+
+	return issueRequest, nil
+}
+
+func (c *CreateIssueFromTemplate) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateIssueFromTemplate) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateIssueFromTemplate) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateIssueFromTemplate) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateIssueFromTemplate) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateIssueFromTemplate) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
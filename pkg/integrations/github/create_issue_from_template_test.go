@@ -0,0 +1,163 @@
+package github
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const bugReportTemplate = `---
+name: Bug report
+about: Report a problem
+title: "[Bug] {{ summary }}"
+labels: bug, needs-triage
+assignees:
+  - octocat
+---
+
+## Version
+
+{{ version }}
+
+## Notes
+
+{{notes}}
+`
+
+func Test__CreateIssueFromTemplate__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateIssueFromTemplate{}
+
+	templateTransport := func(status int, body string) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/contents/.github/ISSUE_TEMPLATE/bug_report.md":
+				return mockResponse(status, body), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	t.Run("template name is required", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		}, templateTransport(http.StatusOK, `{}`))
+
+		require.ErrorContains(t, err, "template name is required")
+	})
+
+	t.Run("expression -> template is not checked", func(t *testing.T) {
+		transport := templateTransport(http.StatusOK, `{}`)
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "templateName": "{{$.data.template}}"},
+		}, transport))
+
+		assert.Empty(t, transport.requests)
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+
+	t.Run("template exists -> ok", func(t *testing.T) {
+		content := base64.StdEncoding.EncodeToString([]byte(bugReportTemplate))
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "templateName": "bug_report"},
+		}, templateTransport(http.StatusOK, `{"type":"file","encoding":"base64","content":"`+content+`"}`)))
+	})
+
+	t.Run("template does not exist -> error", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "templateName": "bug_report"},
+		}, templateTransport(http.StatusNotFound, `{"message":"Not Found"}`))
+
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func Test__CreateIssueFromTemplate__GetTemplate(t *testing.T) {
+	t.Run("template is read and parsed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			content := base64.StdEncoding.EncodeToString([]byte(bugReportTemplate))
+			return mockResponse(http.StatusOK, `{"type":"file","encoding":"base64","content":"`+content+`"}`), nil
+		}}
+
+		template, err := getIssueTemplate(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug_report")
+		require.NoError(t, err)
+		assert.Equal(t, "/repos/testhq/hello/contents/.github/ISSUE_TEMPLATE/bug_report.md", transport.requests[0].URL.Path)
+		assert.Equal(t, ".github/ISSUE_TEMPLATE/bug_report.md", template.Path)
+		assert.Equal(t, "[Bug] {{ summary }}", template.Title)
+		assert.Equal(t, []string{"bug", "needs-triage"}, template.Labels)
+		assert.Equal(t, []string{"octocat"}, template.Assignees)
+		assert.Equal(t, "## Version\n\n{{ version }}\n\n## Notes\n\n{{notes}}\n", template.Body)
+	})
+
+	t.Run("template does not exist -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := getIssueTemplate(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "missing.md")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.ErrorContains(t, err, "issue template .github/ISSUE_TEMPLATE/missing.md does not exist in hello")
+	})
+
+	t.Run("issue forms are not supported", func(t *testing.T) {
+		_, err := getIssueTemplate(github.NewClient(nil), "testhq", "hello", "bug_report.yml")
+		require.ErrorContains(t, err, "is not a markdown template")
+	})
+}
+
+func Test__CreateIssueFromTemplate__Parse(t *testing.T) {
+	t.Run("template without front matter", func(t *testing.T) {
+		template, err := parseIssueTemplate("Just a body")
+		require.NoError(t, err)
+		assert.Equal(t, "Just a body", template.Body)
+		assert.Empty(t, template.Labels)
+	})
+
+	t.Run("front matter not closed -> error", func(t *testing.T) {
+		_, err := parseIssueTemplate("---\ntitle: oops\n")
+		require.ErrorContains(t, err, "front matter is not closed")
+	})
+}
+
+func Test__CreateIssueFromTemplate__BuildRequest(t *testing.T) {
+	template, err := parseIssueTemplate(bugReportTemplate)
+	require.NoError(t, err)
+	template.Path = ".github/ISSUE_TEMPLATE/bug_report.md"
+
+	t.Run("fields are substituted", func(t *testing.T) {
+		request, err := buildTemplateIssueRequest(template, "", map[string]any{"summary": "Login fails", "version": 1.2})
+		require.NoError(t, err)
+		assert.Equal(t, "[Bug] Login fails", request.GetTitle())
+		assert.Equal(t, "## Version\n\n1.2\n\n## Notes\n\n{{notes}}\n", request.GetBody())
+		assert.Equal(t, []string{"bug", "needs-triage"}, request.GetLabels())
+		assert.Equal(t, []string{"octocat"}, request.GetAssignees())
+	})
+
+	t.Run("configured title replaces the template title", func(t *testing.T) {
+		request, err := buildTemplateIssueRequest(template, "Custom title", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Custom title", request.GetTitle())
+	})
+
+	t.Run("no title -> error", func(t *testing.T) {
+		_, err := buildTemplateIssueRequest(&IssueTemplate{Path: "x.md", Body: "body"}, "", nil)
+		require.ErrorContains(t, err, "title is required")
+	})
+}
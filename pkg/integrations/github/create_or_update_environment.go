@@ -0,0 +1,522 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ReviewerTypeUser          = "User"
+	ReviewerTypeTeam          = "Team"
+	BranchPolicyAll           = "all"
+	BranchPolicyProtected     = "protected"
+	BranchPolicyCustom        = "custom"
+	BranchPolicyPatternBranch = "branch"
+	BranchPolicyPatternTag    = "tag"
+	MaxWaitTimerMinutes       = 43200
+	MaxEnvironmentReviewers   = 6
+)
+
+type CreateOrUpdateEnvironment struct{}
+
+type CreateOrUpdateEnvironmentConfiguration struct {
+	Repository             string                `json:"repository" mapstructure:"repository"`
+	Environment            string                `json:"environment" mapstructure:"environment"`
+	WaitTimer              *int                  `json:"waitTimer" mapstructure:"waitTimer"`
+	Reviewers              []EnvironmentReviewer `json:"reviewers" mapstructure:"reviewers"`
+	DeploymentBranchPolicy string                `json:"deploymentBranchPolicy" mapstructure:"deploymentBranchPolicy"`
+	BranchPolicies         []BranchPolicyPattern `json:"branchPolicies" mapstructure:"branchPolicies"`
+}
+
+type EnvironmentReviewer struct {
+	Type string `json:"type" mapstructure:"type"`
+	Name string `json:"name" mapstructure:"name"`
+	ID   int64  `json:"id,omitempty" mapstructure:"-"`
+}
+
+type BranchPolicyPattern struct {
+	Name string `json:"name" mapstructure:"name"`
+	Type string `json:"type" mapstructure:"type"`
+}
+
+type EnvironmentOutput struct {
+	ID                     int64                 `json:"id"`
+	Name                   string                `json:"name"`
+	URL                    string                `json:"html_url"`
+	WaitTimer              int                   `json:"wait_timer"`
+	Reviewers              []EnvironmentReviewer `json:"reviewers"`
+	DeploymentBranchPolicy string                `json:"deployment_branch_policy"`
+	BranchPolicies         []BranchPolicyPattern `json:"branch_policies"`
+	CreatedBranchPolicies  int                   `json:"created_branch_policies"`
+}
+
+func (c *CreateOrUpdateEnvironment) Name() string {
+	return "github.createOrUpdateEnvironment"
+}
+
+func (c *CreateOrUpdateEnvironment) Label() string {
+	return "Create or Update Environment"
+}
+
+func (c *CreateOrUpdateEnvironment) Description() string {
+	return "Create or update a GitHub deployment environment with its protection rules"
+}
+
+func (c *CreateOrUpdateEnvironment) Documentation() string {
+	return `The Create or Update Environment component creates a deployment environment in a repository, or updates it, with its protection rules.
+
+## Use Cases
+
+- **Environments as code**: Provision the environments of new repositories from a template
+- **Access changes**: Change who must approve deployments to production
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: The environment name (supports expressions)
+- **Wait Timer**: Minutes to wait before deployments to the environment proceed, from 0 to 43200 (30 days)
+- **Reviewers**: Up to 6 users or teams, by login or team slug, who must approve deployments
+- **Deployment Branch Policy**: Allow deployments from **All** branches, **Protected** branches only, or branches and tags matching **Custom** patterns
+- **Branch Policies**: The name patterns, like ` + "`release/*`" + `, for the custom policy
+
+## Output
+
+Emits a ` + "`github.environment`" + ` event with the environment ` + "`id`" + `, ` + "`name`" + `, ` + "`html_url`" + `, ` + "`wait_timer`" + `, the resolved ` + "`reviewers`" + ` with their ` + "`id`" + `, the ` + "`deployment_branch_policy`" + `, the custom ` + "`branch_policies`" + `, and how many of them were created.
+
+## Notes
+
+- The configuration replaces the protection rules of an existing environment. Reviewers that are not configured are removed
+- Custom branch policies are created after the environment. Existing patterns are kept, and patterns that are not configured are not deleted
+- Reviewers are resolved before the environment is changed, and the execution fails if one does not exist
+- Reviewers, wait timers and custom policies need GitHub Enterprise, or a public repository
+- Requires the GitHub integration to be set up with **Repository Administration** enabled`
+}
+
+func (c *CreateOrUpdateEnvironment) Icon() string {
+	return "github"
+}
+
+func (c *CreateOrUpdateEnvironment) Color() string {
+	return "gray"
+}
+
+func (c *CreateOrUpdateEnvironment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateOrUpdateEnvironment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+		{
+			Name:        "waitTimer",
+			Label:       "Wait Timer (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     0,
+			Description: "Minutes to wait before deployments proceed",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 0; return &min }(),
+					Max: func() *int { max := MaxWaitTimerMinutes; return &max }(),
+				},
+			},
+		},
+		{
+			Name:  "reviewers",
+			Label: "Reviewers",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Reviewer",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "type",
+								Label:    "Type",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  ReviewerTypeUser,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "User", Value: ReviewerTypeUser},
+											{Label: "Team", Value: ReviewerTypeTeam},
+										},
+									},
+								},
+							},
+							{
+								Name:        "name",
+								Label:       "Name",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Description: "User login or team slug",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:     "deploymentBranchPolicy",
+			Label:    "Deployment Branch Policy",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  BranchPolicyAll,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "All branches", Value: BranchPolicyAll},
+						{Label: "Protected branches", Value: BranchPolicyProtected},
+						{Label: "Custom", Value: BranchPolicyCustom},
+					},
+				},
+			},
+		},
+		{
+			Name:  "branchPolicies",
+			Label: "Branch Policies",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Pattern",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Pattern",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Placeholder: "e.g., release/*",
+							},
+							{
+								Name:     "type",
+								Label:    "Type",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  BranchPolicyPatternBranch,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "Branch", Value: BranchPolicyPatternBranch},
+											{Label: "Tag", Value: BranchPolicyPatternTag},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "deploymentBranchPolicy", Values: []string{BranchPolicyCustom}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "deploymentBranchPolicy", Values: []string{BranchPolicyCustom}},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateOrUpdateEnvironment) Setup(ctx core.SetupContext) error {
+	var config CreateOrUpdateEnvironmentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateEnvironmentConfiguration(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateEnvironmentConfiguration(config CreateOrUpdateEnvironmentConfiguration) error {
+	if strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required")
+	}
+
+	if config.WaitTimer != nil && (*config.WaitTimer < 0 || *config.WaitTimer > MaxWaitTimerMinutes) {
+		return fmt.Errorf("wait timer must be between 0 and %d minutes", MaxWaitTimerMinutes)
+	}
+
+	if len(config.Reviewers) > MaxEnvironmentReviewers {
+		return fmt.Errorf("%d reviewers configured, but an environment has at most %d", len(config.Reviewers), MaxEnvironmentReviewers)
+	}
+
+	for _, reviewer := range config.Reviewers {
+		if reviewer.Type != ReviewerTypeUser && reviewer.Type != ReviewerTypeTeam {
+			return fmt.Errorf("invalid reviewer type: %s", reviewer.Type)
+		}
+
+		if strings.TrimSpace(reviewer.Name) == "" {
+			return errors.New("reviewer name is required")
+		}
+	}
+
+	switch config.DeploymentBranchPolicy {
+	case "", BranchPolicyAll, BranchPolicyProtected:
+		return nil
+	case BranchPolicyCustom:
+	default:
+		return fmt.Errorf("invalid deployment branch policy: %s", config.DeploymentBranchPolicy)
+	}
+
+	if len(config.BranchPolicies) == 0 {
+		return errors.New("at least one branch policy is required for the custom deployment branch policy")
+	}
+
+	for _, policy := range config.BranchPolicies {
+		if strings.TrimSpace(policy.Name) == "" {
+			return errors.New("branch policy pattern is required")
+		}
+
+		if policy.Type != "" && policy.Type != BranchPolicyPatternBranch && policy.Type != BranchPolicyPatternTag {
+			return fmt.Errorf("invalid branch policy type: %s", policy.Type)
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateOrUpdateEnvironment) Execute(ctx core.ExecutionContext) error {
+	var config CreateOrUpdateEnvironmentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateEnvironmentConfiguration(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := createOrUpdateEnvironment(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Environment %s saved, with %d reviewers", output.Name, len(output.Reviewers))
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.environment",
+		[]any{output},
+	)
+}
+
+func createOrUpdateEnvironment(client *github.Client, owner string, config CreateOrUpdateEnvironmentConfiguration) (*EnvironmentOutput, error) {
+	reviewers, err := resolveEnvironmentReviewers(client, owner, config.Reviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	waitTimer := 0
+	if config.WaitTimer != nil {
+		waitTimer = *config.WaitTimer
+	}
+
+	request := &github.CreateUpdateEnvironment{
+		WaitTimer: github.Ptr(waitTimer),
+		Reviewers: []*github.EnvReviewers{},
+	}
+
+	for _, reviewer := range reviewers {
+		request.Reviewers = append(request.Reviewers, &github.EnvReviewers{Type: github.Ptr(reviewer.Type), ID: github.Ptr(reviewer.ID)})
+	}
+
+	policy := config.DeploymentBranchPolicy
+	switch policy {
+	case BranchPolicyProtected:
+		request.DeploymentBranchPolicy = &github.BranchPolicy{ProtectedBranches: github.Ptr(true), CustomBranchPolicies: github.Ptr(false)}
+	case BranchPolicyCustom:
+		request.DeploymentBranchPolicy = &github.BranchPolicy{ProtectedBranches: github.Ptr(false), CustomBranchPolicies: github.Ptr(true)}
+	default:
+		policy = BranchPolicyAll
+	}
+
+	environment, _, err := client.Repositories.CreateUpdateEnvironment(context.Background(), owner, config.Repository, config.Environment, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save environment %s: %w", config.Environment, wrapGitHubError(err))
+	}
+
+	output := &EnvironmentOutput{
+		ID:                     environment.GetID(),
+		Name:                   environment.GetName(),
+		URL:                    environment.GetHTMLURL(),
+		WaitTimer:              waitTimer,
+		Reviewers:              reviewers,
+		DeploymentBranchPolicy: policy,
+		BranchPolicies:         []BranchPolicyPattern{},
+	}
+
+	if policy != BranchPolicyCustom {
+		return output, nil
+	}
+
+	output.BranchPolicies, output.CreatedBranchPolicies, err = createBranchPolicies(client, owner, config.Repository, config.Environment, config.BranchPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+/*
+ * The environment API only accepts reviewer IDs,
+ * so the configured logins and team slugs are looked up first.
+ */
+func resolveEnvironmentReviewers(client *github.Client, owner string, reviewers []EnvironmentReviewer) ([]EnvironmentReviewer, error) {
+	resolved := []EnvironmentReviewer{}
+	for _, reviewer := range reviewers {
+		name := strings.TrimSpace(reviewer.Name)
+		if reviewer.Type == ReviewerTypeTeam {
+			team, response, err := client.Teams.GetTeamBySlug(context.Background(), owner, name)
+			if isNotFound(response) {
+				return nil, fmt.Errorf("%w: reviewer team %s does not exist in %s", ErrNotFound, name, owner)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to get reviewer team %s: %w", name, wrapGitHubError(err))
+			}
+
+			resolved = append(resolved, EnvironmentReviewer{Type: ReviewerTypeTeam, Name: team.GetSlug(), ID: team.GetID()})
+			continue
+		}
+
+		user, response, err := client.Users.Get(context.Background(), name)
+		if isNotFound(response) {
+			return nil, fmt.Errorf("%w: reviewer user %s does not exist", ErrNotFound, name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer user %s: %w", name, wrapGitHubError(err))
+		}
+
+		resolved = append(resolved, EnvironmentReviewer{Type: ReviewerTypeUser, Name: user.GetLogin(), ID: user.GetID()})
+	}
+
+	return resolved, nil
+}
+
+/*
+ * Custom branch policies are separate resources of the environment,
+ * created one by one once the environment uses custom policies.
+ * Patterns the environment already has are not created again.
+ */
+func createBranchPolicies(client *github.Client, owner, repository, environment string, policies []BranchPolicyPattern) ([]BranchPolicyPattern, int, error) {
+	existing, _, err := client.Repositories.ListDeploymentBranchPolicies(context.Background(), owner, repository, environment)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list branch policies of %s: %w", environment, wrapEnvironmentError(err, environment))
+	}
+
+	patterns := []BranchPolicyPattern{}
+	for _, policy := range existing.BranchPolicies {
+		patterns = append(patterns, BranchPolicyPattern{Name: policy.GetName(), Type: policy.GetType()})
+	}
+
+	created := 0
+	for _, policy := range policies {
+		pattern := BranchPolicyPattern{Name: strings.TrimSpace(policy.Name), Type: policy.Type}
+		if pattern.Type == "" {
+			pattern.Type = BranchPolicyPatternBranch
+		}
+
+		if slices.Contains(patterns, pattern) {
+			continue
+		}
+
+		_, _, err := client.Repositories.CreateDeploymentBranchPolicy(
+			context.Background(),
+			owner,
+			repository,
+			environment,
+			&github.DeploymentBranchPolicyRequest{Name: github.Ptr(pattern.Name), Type: github.Ptr(pattern.Type)},
+		)
+
+		if err != nil {
+			return nil, created, fmt.Errorf("failed to create branch policy %s: %w", pattern.Name, wrapGitHubError(err))
+		}
+
+		patterns = append(patterns, pattern)
+		created++
+	}
+
+	return patterns, created, nil
+}
+
+func (c *CreateOrUpdateEnvironment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateOrUpdateEnvironment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateOrUpdateEnvironment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateOrUpdateEnvironment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateOrUpdateEnvironment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateOrUpdateEnvironment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateOrUpdateEnvironment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateOrUpdateEnvironment{}
+
+	setup := func(configuration map[string]any) error {
+		configuration["repository"] = "hello"
+		configuration["environment"] = "production"
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("wait timer out of range -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"waitTimer": -1}), "wait timer must be between 0 and 43200 minutes")
+		require.ErrorContains(t, setup(map[string]any{"waitTimer": 43201}), "wait timer must be between 0 and 43200 minutes")
+	})
+
+	t.Run("invalid reviewers -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"reviewers": []map[string]any{{"type": "Bot", "name": "x"}}}), "invalid reviewer type: Bot")
+		require.ErrorContains(t, setup(map[string]any{"reviewers": []map[string]any{{"type": "User", "name": " "}}}), "reviewer name is required")
+
+		reviewers := []map[string]any{}
+		for range 7 {
+			reviewers = append(reviewers, map[string]any{"type": "User", "name": "octocat"})
+		}
+
+		require.ErrorContains(t, setup(map[string]any{"reviewers": reviewers}), "7 reviewers configured, but an environment has at most 6")
+	})
+
+	t.Run("custom policy without patterns -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"deploymentBranchPolicy": "custom"}), "at least one branch policy is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":             "hello",
+				"environment":            "production",
+				"waitTimer":              30,
+				"deploymentBranchPolicy": "custom",
+				"branchPolicies":         []map[string]any{{"name": "release/*", "type": "branch"}},
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateOrUpdateEnvironment__Save(t *testing.T) {
+	transportFor := func() *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			path := request.URL.Path
+			switch {
+			case path == "/users/octocat":
+				return mockResponse(http.StatusOK, `{"login":"octocat","id":1}`), nil
+			case path == "/orgs/testhq/teams/release-managers":
+				return mockResponse(http.StatusOK, `{"slug":"release-managers","id":1234}`), nil
+			case strings.HasPrefix(path, "/users/") || strings.HasPrefix(path, "/orgs/"):
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			case strings.HasSuffix(path, "/environments/production"):
+				return mockResponse(http.StatusOK, `{"id":161088068,"name":"production","html_url":"https://github.com/testhq/hello/deployments"}`), nil
+			case strings.HasSuffix(path, "/deployment-branch-policies") && request.Method == http.MethodGet:
+				return mockResponse(http.StatusOK, `{"total_count":1,"branch_policies":[{"id":1,"name":"main","type":"branch"}]}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":2,"name":"release/*","type":"branch"}`), nil
+		}}
+	}
+
+	t.Run("reviewers are resolved and sent by ID", func(t *testing.T) {
+		transport := transportFor()
+		config := CreateOrUpdateEnvironmentConfiguration{
+			Repository:  "hello",
+			Environment: "production",
+			WaitTimer:   github.Ptr(30),
+			Reviewers:   []EnvironmentReviewer{{Type: "User", Name: "octocat"}, {Type: "Team", Name: "release-managers"}},
+		}
+
+		output, err := createOrUpdateEnvironment(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPut, transport.requests[2].Method)
+
+		body, _ := io.ReadAll(transport.requests[2].Body)
+		assert.JSONEq(t, `{"can_admins_bypass":true,"wait_timer":30,"reviewers":[{"type":"User","id":1},{"type":"Team","id":1234}],"deployment_branch_policy":null}`, string(body))
+		assert.Equal(t, int64(161088068), output.ID)
+		assert.Equal(t, BranchPolicyAll, output.DeploymentBranchPolicy)
+		assert.Equal(t, []EnvironmentReviewer{{Type: "User", Name: "octocat", ID: 1}, {Type: "Team", Name: "release-managers", ID: 1234}}, output.Reviewers)
+	})
+
+	t.Run("unknown reviewer -> not found, environment untouched", func(t *testing.T) {
+		transport := transportFor()
+		config := CreateOrUpdateEnvironmentConfiguration{
+			Repository:  "hello",
+			Environment: "production",
+			Reviewers:   []EnvironmentReviewer{{Type: "Team", Name: "nobody"}},
+		}
+
+		_, err := createOrUpdateEnvironment(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "reviewer team nobody does not exist in testhq")
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("custom policy -> only missing patterns created", func(t *testing.T) {
+		transport := transportFor()
+		config := CreateOrUpdateEnvironmentConfiguration{
+			Repository:             "hello",
+			Environment:            "production",
+			DeploymentBranchPolicy: BranchPolicyCustom,
+			BranchPolicies:         []BranchPolicyPattern{{Name: "main", Type: "branch"}, {Name: "release/*"}},
+		}
+
+		output, err := createOrUpdateEnvironment(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+
+		body, _ := io.ReadAll(transport.requests[0].Body)
+		assert.JSONEq(t, `{"can_admins_bypass":true,"wait_timer":0,"reviewers":[],"deployment_branch_policy":{"protected_branches":false,"custom_branch_policies":true}}`, string(body))
+
+		assert.Equal(t, http.MethodPost, transport.requests[2].Method)
+		body, _ = io.ReadAll(transport.requests[2].Body)
+		assert.JSONEq(t, `{"name":"release/*","type":"branch"}`, string(body))
+		assert.Equal(t, []BranchPolicyPattern{{Name: "main", Type: "branch"}, {Name: "release/*", Type: "branch"}}, output.BranchPolicies)
+		assert.Equal(t, 1, output.CreatedBranchPolicies)
+	})
+}
@@ -0,0 +1,313 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreateOrUpdateLabel struct{}
+
+type CreateOrUpdateLabelConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	Name        string `json:"name" mapstructure:"name"`
+	NewName     string `json:"newName" mapstructure:"newName"`
+	Color       string `json:"color" mapstructure:"color"`
+	Description string `json:"description" mapstructure:"description"`
+}
+
+type CreateOrUpdateLabelOutput struct {
+	Name         string `json:"name"`
+	Color        string `json:"color"`
+	Description  string `json:"description"`
+	URL          string `json:"url"`
+	Status       string `json:"status"`
+	PreviousName string `json:"previous_name,omitempty"`
+}
+
+func (c *CreateOrUpdateLabel) Name() string {
+	return "github.createOrUpdateLabel"
+}
+
+func (c *CreateOrUpdateLabel) Label() string {
+	return "Create or Update Label"
+}
+
+func (c *CreateOrUpdateLabel) Description() string {
+	return "Create a label in a GitHub repository, or update it if it already exists"
+}
+
+func (c *CreateOrUpdateLabel) Documentation() string {
+	return `The Create or Update Label component makes sure a label exists in a repository, with the configured color and description.
+
+## Use Cases
+
+- **Label palettes**: Enforce the same label colors and descriptions across repositories
+- **Label renames**: Rename a label, keeping it on the issues and pull requests that already have it
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Name**: The name of the label
+- **New Name**: Optional new name, to rename an existing label
+- **Color**: Hex color, like ` + "`d73a4a`" + `. A leading ` + "`#`" + ` is removed
+- **Description**: Optional label description
+
+## Output
+
+Emits the resulting label, with its ` + "`name`" + `, ` + "`color`" + `, ` + "`description`" + `, and ` + "`url`" + `.
+` + "`status`" + ` is ` + "`created`" + ` if the label did not exist, or ` + "`updated`" + ` otherwise.
+If the label was renamed, ` + "`previous_name`" + ` has its old name.
+
+## Notes
+
+- If the label does not exist, it is created with the new name, if one is configured
+- Colors and descriptions that are not configured are kept as they are on existing labels`
+}
+
+func (c *CreateOrUpdateLabel) Icon() string {
+	return "github"
+}
+
+func (c *CreateOrUpdateLabel) Color() string {
+	return "gray"
+}
+
+func (c *CreateOrUpdateLabel) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateOrUpdateLabel) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "name",
+			Label:       "Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., priority/high",
+		},
+		{
+			Name:        "newName",
+			Label:       "New Name",
+			Type:        configuration.FieldTypeString,
+			Togglable:   true,
+			Description: "Rename the label",
+		},
+		{
+			Name:        "color",
+			Label:       "Color",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., d73a4a",
+			Description: "Hex color, without the leading #",
+		},
+		{
+			Name:  "description",
+			Label: "Description",
+			Type:  configuration.FieldTypeString,
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateOrUpdateLabel) Setup(ctx core.SetupContext) error {
+	var config CreateOrUpdateLabelConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	color := normalizeLabelColor(config.Color)
+	if color != "" && !isExpression(color) && !labelColorRegex.MatchString(color) {
+		return fmt.Errorf("invalid color %s: must be 6 hex digits, like d73a4a", config.Color)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+/*
+ * GitHub only accepts colors as 6 hex digits, without the leading #.
+ */
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(color), "#"))
+}
+
+func (c *CreateOrUpdateLabel) Execute(ctx core.ExecutionContext) error {
+	var config CreateOrUpdateLabelConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	color := normalizeLabelColor(config.Color)
+	if color != "" && !labelColorRegex.MatchString(color) {
+		return fmt.Errorf("invalid color %s: must be 6 hex digits, like d73a4a", config.Color)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	config.Color = color
+	output, err := createOrUpdateLabel(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.label",
+		[]any{output},
+	)
+}
+
+func createOrUpdateLabel(client *github.Client, owner string, config CreateOrUpdateLabelConfiguration) (*CreateOrUpdateLabelOutput, error) {
+	name := strings.TrimSpace(config.Name)
+	newName := strings.TrimSpace(config.NewName)
+
+	label := &github.Label{}
+	if config.Color != "" {
+		label.Color = &config.Color
+	}
+
+	if config.Description != "" {
+		label.Description = &config.Description
+	}
+
+	_, _, err := client.Issues.GetLabel(context.Background(), owner, config.Repository, url.PathEscape(name))
+	if err != nil {
+		err = wrapGitHubError(err)
+		if !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("failed to get label %s: %w", name, err)
+		}
+
+		label.Name = github.Ptr(name)
+		if newName != "" {
+			label.Name = github.Ptr(newName)
+		}
+
+		created, _, err := client.Issues.CreateLabel(context.Background(), owner, config.Repository, label)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create label %s: %w", label.GetName(), wrapGitHubError(err))
+		}
+
+		return labelOutput(created, LabelCreated, ""), nil
+	}
+
+	update := labelUpdate{Color: label.Color, Description: label.Description}
+	previousName := ""
+	if newName != "" && newName != name {
+		update.NewName = &newName
+		previousName = name
+	}
+
+	updated, err := editLabel(client, owner, config.Repository, name, update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update label %s: %w", name, wrapGitHubError(err))
+	}
+
+	return labelOutput(updated, LabelUpdated, previousName), nil
+}
+
+/*
+ * Issues.EditLabel sends the new name as "name", but GitHub only renames labels with "new_name".
+ * Label names can also have slashes, like priority/high, so names are escaped in the path.
+ */
+type labelUpdate struct {
+	NewName     *string `json:"new_name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func editLabel(client *github.Client, owner, repository, name string, update labelUpdate) (*github.Label, error) {
+	u := fmt.Sprintf("repos/%v/%v/labels/%v", owner, repository, url.PathEscape(name))
+	request, err := client.NewRequest(http.MethodPatch, u, update)
+	if err != nil {
+		return nil, err
+	}
+
+	label := &github.Label{}
+	if _, err := client.Do(context.Background(), request, label); err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+func labelOutput(label *github.Label, status, previousName string) *CreateOrUpdateLabelOutput {
+	return &CreateOrUpdateLabelOutput{
+		Name:         label.GetName(),
+		Color:        label.GetColor(),
+		Description:  label.GetDescription(),
+		URL:          label.GetURL(),
+		Status:       status,
+		PreviousName: previousName,
+	}
+}
+
+func (c *CreateOrUpdateLabel) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateOrUpdateLabel) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateOrUpdateLabel) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateOrUpdateLabel) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateOrUpdateLabel) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateOrUpdateLabel) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
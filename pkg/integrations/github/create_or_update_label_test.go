@@ -0,0 +1,133 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateOrUpdateLabel__Setup(t *testing.T) {
+	component := CreateOrUpdateLabel{}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{{Name: "api"}}}}
+
+	t.Run("name is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api"},
+		})
+
+		require.ErrorContains(t, err, "name is required")
+	})
+
+	t.Run("invalid color -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "name": "bug", "color": "#fff"},
+		})
+
+		require.ErrorContains(t, err, "invalid color #fff")
+	})
+
+	t.Run("color with leading # -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "name": "bug", "color": "#D73A4A"},
+		}))
+	})
+
+	t.Run("color expression -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "name": "bug", "color": "{{$.data.color}}"},
+		}))
+	})
+}
+
+func Test__CreateOrUpdateLabel__CreateOrUpdate(t *testing.T) {
+	config := CreateOrUpdateLabelConfiguration{Repository: "api", Name: "bug", Color: normalizeLabelColor("#D73A4A")}
+
+	t.Run("missing label is created", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"name":"bug","color":"d73a4a"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"name":"bug","color":"d73a4a"}`), nil
+		}}
+
+		output, err := createOrUpdateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Equal(t, LabelCreated, output.Status)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/api/labels", transport.requests[1].URL.Path)
+	})
+
+	t.Run("existing label is updated", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"name":"bug","color":"d73a4a"}`), nil
+		}}
+
+		output, err := createOrUpdateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Equal(t, LabelUpdated, output.Status)
+		assert.Empty(t, output.PreviousName)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodPatch, transport.requests[1].Method)
+		assert.Equal(t, "/repos/testhq/api/labels/bug", transport.requests[1].URL.Path)
+	})
+
+	t.Run("existing label is renamed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodPatch {
+				body, _ := io.ReadAll(request.Body)
+				assert.JSONEq(t, `{"new_name":"type/bug","color":"d73a4a"}`, string(body))
+				return mockResponse(http.StatusOK, `{"name":"type/bug","color":"d73a4a"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"bug","color":"d73a4a"}`), nil
+		}}
+
+		renamed := config
+		renamed.NewName = "type/bug"
+		output, err := createOrUpdateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", renamed)
+		require.NoError(t, err)
+		assert.Equal(t, "type/bug", output.Name)
+		assert.Equal(t, "bug", output.PreviousName)
+	})
+
+	t.Run("label name with slash is escaped", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"name":"priority/high","color":"d73a4a"}`), nil
+		}}
+
+		scoped := config
+		scoped.Name = "priority/high"
+		_, err := createOrUpdateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", scoped)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/api/labels/priority%2Fhigh", transport.requests[0].URL.EscapedPath())
+		assert.Equal(t, "/repos/testhq/api/labels/priority%2Fhigh", transport.requests[1].URL.EscapedPath())
+	})
+
+	t.Run("other errors fail", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := createOrUpdateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Len(t, transport.requests, 1)
+	})
+}
@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreatePullRequestFromIssue struct{}
+
+type CreatePullRequestFromIssueConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	IssueNumber string `json:"issueNumber" mapstructure:"issueNumber"`
+	Head        string `json:"head" mapstructure:"head"`
+	Base        string `json:"base" mapstructure:"base"`
+}
+
+type PullRequestFromIssueOutput struct {
+	Number      int    `json:"number"`
+	IssueNumber int    `json:"issue_number"`
+	Title       string `json:"title"`
+	Head        string `json:"head"`
+	Base        string `json:"base"`
+	URL         string `json:"html_url"`
+}
+
+func (c *CreatePullRequestFromIssue) Name() string {
+	return "github.createPullRequestFromIssue"
+}
+
+func (c *CreatePullRequestFromIssue) Label() string {
+	return "Create Pull Request From Issue"
+}
+
+func (c *CreatePullRequestFromIssue) Description() string {
+	return "Turn an existing GitHub issue into a pull request, keeping its discussion"
+}
+
+func (c *CreatePullRequestFromIssue) Documentation() string {
+	return `The Create Pull Request From Issue component converts an issue into a pull request from a head branch into a base branch.
+
+## Use Cases
+
+- **Issue-driven development**: Open the pull request for an issue once a branch with the fix is pushed
+- **Bots**: Turn a feature request into a pull request after generating the change
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue to convert (supports expressions)
+- **Head**: The branch with the changes (supports expressions). Use ` + "`username:branch`" + ` for branches in forks
+- **Base**: The branch to merge the changes into, e.g. ` + "`main`" + `
+
+## Output
+
+Returns the pull request ` + "`number`" + `, which is the same as the ` + "`issue_number`" + `, its ` + "`title`" + `, ` + "`head`" + ` and ` + "`base`" + `, and ` + "`html_url`" + `.
+
+## Notes
+
+- The pull request keeps the title, body, comments, labels and assignees of the issue
+- Issues that are already pull requests are rejected
+- GitHub rejects the conversion if there is already an open pull request from the head into the base, or if they have no differences`
+}
+
+func (c *CreatePullRequestFromIssue) Icon() string {
+	return "github"
+}
+
+func (c *CreatePullRequestFromIssue) Color() string {
+	return "gray"
+}
+
+func (c *CreatePullRequestFromIssue) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreatePullRequestFromIssue) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:        "head",
+			Label:       "Head",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., fix/issue-42",
+			Description: "The branch with the changes",
+		},
+		{
+			Name:        "base",
+			Label:       "Base",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Default:     "main",
+			Description: "The branch to merge the changes into",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreatePullRequestFromIssue) Setup(ctx core.SetupContext) error {
+	var config CreatePullRequestFromIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if !isExpression(config.IssueNumber) {
+		if _, err := strconv.Atoi(config.IssueNumber); err != nil {
+			return fmt.Errorf("issue number is not a number: %s", config.IssueNumber)
+		}
+	}
+
+	if config.Head == "" {
+		return errors.New("head is required")
+	}
+
+	if config.Base == "" {
+		return errors.New("base is required")
+	}
+
+	if config.Head == config.Base {
+		return errors.New("head and base must be different branches")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreatePullRequestFromIssue) Execute(ctx core.ExecutionContext) error {
+	var config CreatePullRequestFromIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	//
+	// Expressions are only resolved now, so the branches are checked again.
+	//
+	if config.Head == config.Base {
+		return fmt.Errorf("head and base must be different branches, both are %s", config.Head)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.pullRequest", func() (any, error) {
+		return createPullRequestFromIssue(client, appMetadata.Owner, config.Repository, issueNumber, config.Head, config.Base)
+	})
+}
+
+func createPullRequestFromIssue(client *github.Client, owner, repo string, issueNumber int, head, base string) (*PullRequestFromIssueOutput, error) {
+	issue, _, err := client.Issues.Get(context.Background(), owner, repo, issueNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue #%d: %w", issueNumber, wrapGitHubError(err))
+	}
+
+	if issue.IsPullRequest() {
+		return nil, fmt.Errorf("issue #%d is already a pull request", issueNumber)
+	}
+
+	pullRequest, _, err := client.PullRequests.Create(context.Background(), owner, repo, &github.NewPullRequest{
+		Issue: &issueNumber,
+		Head:  &head,
+		Base:  &base,
+	})
+
+	if err != nil {
+		return nil, pullRequestFromIssueError(issueNumber, err)
+	}
+
+	return &PullRequestFromIssueOutput{
+		Number:      pullRequest.GetNumber(),
+		IssueNumber: issueNumber,
+		Title:       pullRequest.GetTitle(),
+		Head:        pullRequest.GetHead().GetRef(),
+		Base:        pullRequest.GetBase().GetRef(),
+		URL:         pullRequest.GetHTMLURL(),
+	}, nil
+}
+
+/*
+ * GitHub answers with a 422, and the reason in the error details,
+ * when the branches are invalid, or a pull request between them already exists.
+ */
+func pullRequestFromIssueError(issueNumber int, err error) error {
+	var responseErr *github.ErrorResponse
+	if errors.As(err, &responseErr) && responseErr.Response != nil && responseErr.Response.StatusCode == http.StatusUnprocessableEntity {
+		reasons := []string{}
+		for _, detail := range responseErr.Errors {
+			if detail.Message != "" {
+				reasons = append(reasons, detail.Message)
+			}
+		}
+
+		if len(reasons) > 0 {
+			return fmt.Errorf("issue #%d cannot be converted to a pull request: %s", issueNumber, strings.Join(reasons, "; "))
+		}
+	}
+
+	return fmt.Errorf("failed to create pull request from issue #%d: %w", issueNumber, wrapGitHubError(err))
+}
+
+func (c *CreatePullRequestFromIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreatePullRequestFromIssue) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreatePullRequestFromIssue) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreatePullRequestFromIssue) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreatePullRequestFromIssue) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreatePullRequestFromIssue) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
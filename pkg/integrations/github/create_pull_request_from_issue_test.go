@@ -0,0 +1,120 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreatePullRequestFromIssue__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreatePullRequestFromIssue{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "head": "fix", "base": "main"},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("issue number must be a number", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "abc", "head": "fix", "base": "main"},
+		})
+
+		require.ErrorContains(t, err, "issue number is not a number")
+	})
+
+	t.Run("head and base must be different", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "head": "main", "base": "main"},
+		})
+
+		require.ErrorContains(t, err, "head and base must be different branches")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "{{ $.data.issue.number }}", "head": "fix/issue-42", "base": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreatePullRequestFromIssue__Create(t *testing.T) {
+	t.Run("issue is converted to a pull request", func(t *testing.T) {
+		var request map[string]any
+		transport := &mockTransport{handler: func(r *http.Request) (*http.Response, error) {
+			if r.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"number":42,"title":"Fix login"}`), nil
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &request))
+			return mockResponse(http.StatusCreated, `{
+				"number":42,
+				"title":"Fix login",
+				"html_url":"https://github.com/testhq/hello/pull/42",
+				"head":{"ref":"fix/issue-42"},
+				"base":{"ref":"main"}
+			}`), nil
+		}}
+
+		output, err := createPullRequestFromIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, "fix/issue-42", "main")
+		require.NoError(t, err)
+		assert.Equal(t, "/repos/testhq/hello/pulls", transport.requests[1].URL.Path)
+		assert.Equal(t, map[string]any{"issue": float64(42), "head": "fix/issue-42", "base": "main"}, request)
+		assert.Equal(t, &PullRequestFromIssueOutput{
+			Number:      42,
+			IssueNumber: 42,
+			Title:       "Fix login",
+			Head:        "fix/issue-42",
+			Base:        "main",
+			URL:         "https://github.com/testhq/hello/pull/42",
+		}, output)
+	})
+
+	t.Run("issue already a pull request -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(r *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"number":42,"pull_request":{"url":"https://api.github.com/repos/testhq/hello/pulls/42"}}`), nil
+		}}
+
+		_, err := createPullRequestFromIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, "fix", "main")
+		require.ErrorContains(t, err, "issue #42 is already a pull request")
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("rejected conversion -> reasons", func(t *testing.T) {
+		transport := &mockTransport{handler: func(r *http.Request) (*http.Response, error) {
+			if r.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"number":42}`), nil
+			}
+
+			return mockResponse(http.StatusUnprocessableEntity, `{
+				"message":"Validation Failed",
+				"errors":[{"resource":"PullRequest","code":"custom","message":"A pull request already exists for testhq:fix."}]
+			}`), nil
+		}}
+
+		_, err := createPullRequestFromIssue(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, "fix", "main")
+		require.ErrorContains(t, err, "issue #42 cannot be converted to a pull request: A pull request already exists for testhq:fix.")
+	})
+}
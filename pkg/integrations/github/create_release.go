@@ -24,6 +24,18 @@ type CreateReleaseConfiguration struct {
 	Prerelease           bool   `mapstructure:"prerelease"`
 	GenerateReleaseNotes bool   `mapstructure:"generateReleaseNotes"`
 	Body                 string `mapstructure:"body"`
+	OnOversize           string `mapstructure:"onOversize"`
+}
+
+/*
+ * The release, as returned by GitHub,
+ * whether its body was truncated to fit the GitHub limit,
+ * and the assets uploaded to it, if any.
+ */
+type ReleaseOutput struct {
+	*github.RepositoryRelease
+	Truncated    bool                 `json:"truncated" mapstructure:"truncated"`
+	AssetChanges []ReleaseAssetChange `json:"asset_changes,omitempty" mapstructure:"asset_changes"`
 }
 
 // Semantic version regex pattern: captures optional prefix, major, minor, patch
@@ -61,10 +73,12 @@ func (c *CreateRelease) Documentation() string {
 - **Draft**: Create as draft release (not published)
 - **Prerelease**: Mark as pre-release
 - **Generate Release Notes**: Automatically generate release notes from commits
+- **On Oversize**: GitHub rejects release notes over 125000 characters. Truncate the notes with a notice, or fail before creating the release
 
 ## Output
 
-Returns the created release object with all release information including tag, assets, and metadata.`
+Returns the created release object with all release information including tag, assets, and metadata.
+` + "`truncated`" + ` is true if the release notes were cut to fit the GitHub limit.`
 }
 
 func (c *CreateRelease) Icon() string {
@@ -182,6 +196,8 @@ func (c *CreateRelease) Configuration() []configuration.Field {
 			Placeholder: "## Important Notes\n\nPlease review the breaking changes...",
 			Description: "Optional text to append after auto-generated release notes. If auto-generation is off, this becomes the entire release description.",
 		},
+		OnOversizeField,
+		ConcurrencyKeyField,
 	}
 }
 
@@ -209,17 +225,56 @@ func (c *CreateRelease) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
 
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	releaseRequest, truncated, err := c.buildReleaseRequest(ctx, client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Create the release, and emit output with release data
+	//
+	return withIdempotency(ctx, "github.release", func() (any, error) {
+		release, _, err := client.Repositories.CreateRelease(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			releaseRequest,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create release: %w", err)
+		}
+
+		return &ReleaseOutput{RepositoryRelease: release, Truncated: truncated}, nil
+	})
+}
+
+/*
+ * Builds the release to create from the configuration,
+ * and returns whether its body was truncated to fit the GitHub limit.
+ */
+func (c *CreateRelease) buildReleaseRequest(ctx core.ExecutionContext, client *github.Client, owner string, config CreateReleaseConfiguration) (*github.RepositoryRelease, bool, error) {
 	//
 	// Determine the tag name based on version strategy
 	//
-	tagName, err := c.determineTagName(ctx, client, appMetadata.Owner, config)
+	tagName, err := c.determineTagName(ctx, client, owner, config)
 	if err != nil {
-		return fmt.Errorf("failed to determine tag name: %w", err)
+		return nil, false, fmt.Errorf("failed to determine tag name: %w", err)
 	}
 
 	//
@@ -227,9 +282,9 @@ func (c *CreateRelease) Execute(ctx core.ExecutionContext) error {
 	//
 	var body string
 	if config.GenerateReleaseNotes {
-		generatedNotes, err := c.generateReleaseNotes(client, appMetadata.Owner, config.Repository, tagName)
+		generatedNotes, err := c.generateReleaseNotes(client, owner, config.Repository, tagName)
 		if err != nil {
-			return fmt.Errorf("failed to generate release notes: %w", err)
+			return nil, false, fmt.Errorf("failed to generate release notes: %w", err)
 		}
 		body = generatedNotes
 
@@ -241,6 +296,11 @@ func (c *CreateRelease) Execute(ctx core.ExecutionContext) error {
 		body = config.Body
 	}
 
+	body, truncated, err := guardBodySize(body, MaxReleaseBodyLength, config.OnOversize)
+	if err != nil {
+		return nil, false, err
+	}
+
 	//
 	// Prepare the release request
 	//
@@ -258,27 +318,7 @@ func (c *CreateRelease) Execute(ctx core.ExecutionContext) error {
 		releaseRequest.Body = &body
 	}
 
-	//
-	// Create the release
-	//
-	release, _, err := client.Repositories.CreateRelease(
-		context.Background(),
-		appMetadata.Owner,
-		config.Repository,
-		releaseRequest,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to create release: %w", err)
-	}
-
-	//
-	// Emit output with release data
-	//
-	return ctx.ExecutionState.Emit(
-		core.DefaultOutputChannel.Name,
-		"github.release",
-		[]any{release},
-	)
+	return releaseRequest, truncated, nil
 }
 
 func (c *CreateRelease) determineTagName(ctx core.ExecutionContext, client *github.Client, owner string, config CreateReleaseConfiguration) (string, error) {
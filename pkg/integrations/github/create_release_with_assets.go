@@ -0,0 +1,445 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ReleaseCreatedOutputChannel      = "created"
+	ReleaseAssetsFailedOutputChannel = "failed"
+)
+
+type CreateReleaseWithAssets struct{}
+
+type CreateReleaseWithAssetsConfiguration struct {
+	CreateReleaseConfiguration `mapstructure:",squash"`
+	Assets                     []ReleaseAssetFile `mapstructure:"assets"`
+	RollbackOnFailure          bool               `mapstructure:"rollbackOnFailure"`
+}
+
+/*
+ * A release asset, uploaded either from text content,
+ * or from a file of the repository.
+ */
+type ReleaseAssetFile struct {
+	Name        string `mapstructure:"name"`
+	Path        string `mapstructure:"path"`
+	Content     string `mapstructure:"content"`
+	ContentType string `mapstructure:"contentType"`
+}
+
+type ReleaseAssetUpload struct {
+	Name        string `json:"name"`
+	ID          int64  `json:"id,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	DownloadURL string `json:"browser_download_url,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type ReleaseWithAssetsOutput struct {
+	*github.RepositoryRelease
+	Truncated  bool                 `json:"truncated"`
+	Uploads    []ReleaseAssetUpload `json:"uploads"`
+	RolledBack bool                 `json:"rolled_back"`
+}
+
+func (c *CreateReleaseWithAssets) Name() string {
+	return "github.createReleaseWithAssets"
+}
+
+func (c *CreateReleaseWithAssets) Label() string {
+	return "Create Release With Assets"
+}
+
+func (c *CreateReleaseWithAssets) Description() string {
+	return "Create a GitHub release and upload its assets"
+}
+
+func (c *CreateReleaseWithAssets) Documentation() string {
+	return `The Create Release With Assets component creates a release in a GitHub repository and uploads its assets, in a single step.
+
+## Use Cases
+
+- **Release automation**: Publish a release together with its checksums, SBOM or manifests
+- **Safe releases**: Only publish a release once all of its assets were uploaded
+
+## Configuration
+
+The release is configured just like in Create Release, with the addition of:
+
+- **Assets**: The assets to upload. Each asset has a **Name**, and either the **Content** to upload, or the **Path** of a file of the repository.
+  **Content Type** defaults to text/plain
+- **Roll Back On Failure**: Delete the release if an asset fails to upload
+
+## Output Channels
+
+- **Created**: The release was created, and all of its assets were uploaded
+- **Failed**: Some assets failed to upload
+
+## Output
+
+Returns the release, as returned by GitHub, with ` + "`uploads`" + `: the ` + "`name`" + `, ` + "`id`" + `, ` + "`size`" + ` and ` + "`browser_download_url`" + ` of each asset,
+or the ` + "`error`" + ` if it failed to upload. ` + "`rolled_back`" + ` is true if the release was deleted because of a failed upload.
+
+## Notes
+
+- The release is created as a draft, and only published once all of its assets were uploaded, so a published release never misses assets
+- A release that failed to upload its assets stays a draft, unless it is rolled back. Drafts have no tag yet, so rolling back leaves nothing behind
+- Files are read at the release tag if it already exists, or at the default branch otherwise, which is where GitHub creates the tag.
+  If a file cannot be read, the execution fails before creating the release
+- Files are uploaded as they are, but at most 10 MiB of files can be read`
+}
+
+func (c *CreateReleaseWithAssets) Icon() string {
+	return "github"
+}
+
+func (c *CreateReleaseWithAssets) Color() string {
+	return "gray"
+}
+
+func (c *CreateReleaseWithAssets) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ReleaseCreatedOutputChannel, Label: "Created"},
+		{Name: ReleaseAssetsFailedOutputChannel, Label: "Failed"},
+	}
+}
+
+func (c *CreateReleaseWithAssets) Configuration() []configuration.Field {
+	//
+	// The release fields are the ones of Create Release,
+	// which has the concurrency key as its last field.
+	//
+	releaseFields := (&CreateRelease{}).Configuration()
+	fields := append([]configuration.Field{}, releaseFields[:len(releaseFields)-1]...)
+
+	return append(fields,
+		configuration.Field{
+			Name:     "assets",
+			Label:    "Assets",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Asset",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Name",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Placeholder: "e.g., checksums.txt",
+							},
+							{
+								Name:        "path",
+								Label:       "Path",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "e.g., dist/manifest.json",
+								Description: "Upload this file of the repository",
+							},
+							{
+								Name:        "content",
+								Label:       "Content",
+								Type:        configuration.FieldTypeText,
+								Description: "Upload this content, if no path is set",
+							},
+							{
+								Name:        "contentType",
+								Label:       "Content Type",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "e.g., application/json",
+								Description: "Defaults to text/plain",
+							},
+						},
+					},
+				},
+			},
+		},
+		configuration.Field{
+			Name:        "rollbackOnFailure",
+			Label:       "Roll Back On Failure",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Delete the release if an asset fails to upload",
+		},
+		ConcurrencyKeyField,
+	)
+}
+
+func (c *CreateReleaseWithAssets) Setup(ctx core.SetupContext) error {
+	var config CreateReleaseWithAssetsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateReleaseAssetFiles(config.Assets); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateReleaseAssetFiles(assets []ReleaseAssetFile) error {
+	if len(assets) == 0 {
+		return errors.New("at least one asset is required")
+	}
+
+	names := []string{}
+	for _, asset := range assets {
+		name := strings.TrimSpace(asset.Name)
+		if name == "" {
+			return errors.New("asset name is required")
+		}
+
+		if containsLabelName(names, name) {
+			return fmt.Errorf("asset %s is configured more than once", name)
+		}
+
+		if asset.Path != "" && asset.Content != "" {
+			return fmt.Errorf("asset %s can only have a path or content, not both", name)
+		}
+
+		if asset.Path == "" && asset.Content == "" {
+			return fmt.Errorf("asset %s needs a path or content", name)
+		}
+
+		names = append(names, name)
+	}
+
+	return nil
+}
+
+func (c *CreateReleaseWithAssets) Execute(ctx core.ExecutionContext) error {
+	var config CreateReleaseWithAssetsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateReleaseAssetFiles(config.Assets); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	creator := &CreateRelease{}
+	releaseRequest, truncated, err := creator.buildReleaseRequest(ctx, client, appMetadata.Owner, config.CreateReleaseConfiguration)
+	if err != nil {
+		return err
+	}
+
+	sources, err := readReleaseAssetFiles(client, creator, appMetadata.Owner, config.Repository, releaseRequest.GetTagName(), config.Assets)
+	if err != nil {
+		return err
+	}
+
+	output, err := createReleaseWithAssets(client, appMetadata.Owner, config.Repository, releaseRequest, sources, config.RollbackOnFailure)
+	if err != nil {
+		return err
+	}
+
+	output.Truncated = truncated
+	channel := ReleaseCreatedOutputChannel
+	for _, upload := range output.Uploads {
+		if upload.Error != "" {
+			ctx.Logger.Warnf("Failed to upload asset %s: %s", upload.Name, upload.Error)
+			channel = ReleaseAssetsFailedOutputChannel
+		}
+	}
+
+	return ctx.ExecutionState.Emit(channel, "github.release", []any{output})
+}
+
+/*
+ * Files are read before the release is created,
+ * so a missing file does not leave a release behind.
+ */
+func readReleaseAssetFiles(client *github.Client, creator *CreateRelease, owner, repository, tagName string, assets []ReleaseAssetFile) ([]ReleaseAssetSource, error) {
+	paths := []string{}
+	for _, asset := range assets {
+		if asset.Path != "" {
+			paths = append(paths, asset.Path)
+		}
+	}
+
+	var files *BatchFilesOutput
+	if len(paths) > 0 {
+		ref, err := releaseAssetsRef(client, creator, owner, repository, tagName)
+		if err != nil {
+			return nil, err
+		}
+
+		files, err = batchGetFiles(client, owner, repository, ref, paths, MaxBatchFilesMaxBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sources := []ReleaseAssetSource{}
+	for _, asset := range assets {
+		source := ReleaseAssetSource{Name: strings.TrimSpace(asset.Name), Content: asset.Content, ContentType: asset.ContentType}
+		if asset.Path != "" {
+			file := files.Files[batchFilePath(asset.Path)]
+			if !file.Found {
+				return nil, fmt.Errorf("%w: file %s of asset %s does not exist at %s", ErrNotFound, asset.Path, source.Name, files.Ref)
+			}
+
+			if file.Skipped {
+				return nil, fmt.Errorf("file %s of asset %s cannot be read, the files of the assets go over %d bytes", asset.Path, source.Name, MaxBatchFilesMaxBytes)
+			}
+
+			source.Content = file.Content
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+/*
+ * When the release tag does not exist yet,
+ * GitHub creates it from the default branch once the release is published.
+ */
+func releaseAssetsRef(client *github.Client, creator *CreateRelease, owner, repository, tagName string) (string, error) {
+	exists, err := creator.tagExists(client, owner, repository, tagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to check tag %s: %w", tagName, wrapGitHubError(err))
+	}
+
+	if exists {
+		return tagName, nil
+	}
+
+	repo, _, err := client.Repositories.Get(context.Background(), owner, repository)
+	if err != nil {
+		return "", fmt.Errorf("failed to get repository: %w", wrapGitHubError(err))
+	}
+
+	return repo.GetDefaultBranch(), nil
+}
+
+func createReleaseWithAssets(client *github.Client, owner, repository string, request *github.RepositoryRelease, sources []ReleaseAssetSource, rollback bool) (*ReleaseWithAssetsOutput, error) {
+	publish := !request.GetDraft()
+	request.Draft = github.Ptr(true)
+
+	release, _, err := client.Repositories.CreateRelease(context.Background(), owner, repository, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release: %w", wrapGitHubError(err))
+	}
+
+	output := &ReleaseWithAssetsOutput{RepositoryRelease: release, Uploads: []ReleaseAssetUpload{}}
+	failed := false
+	for _, source := range sources {
+		upload := ReleaseAssetUpload{Name: source.Name}
+		asset, err := uploadReleaseAsset(client, owner, repository, release.GetID(), source.Name, source)
+		if err != nil {
+			upload.Error = err.Error()
+			failed = true
+		} else {
+			upload.ID = asset.GetID()
+			upload.Size = asset.GetSize()
+		}
+
+		output.Uploads = append(output.Uploads, upload)
+	}
+
+	if failed {
+		if !rollback {
+			return output, nil
+		}
+
+		_, err := client.Repositories.DeleteRelease(context.Background(), owner, repository, release.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to roll back draft release %d: %w", release.GetID(), wrapGitHubError(err))
+		}
+
+		output.RolledBack = true
+		return output, nil
+	}
+
+	if publish {
+		release, _, err = client.Repositories.EditRelease(context.Background(), owner, repository, release.GetID(), &github.RepositoryRelease{Draft: github.Ptr(false)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to publish draft release %d: %w", output.GetID(), wrapGitHubError(err))
+		}
+	} else {
+		release, _, err = client.Repositories.GetRelease(context.Background(), owner, repository, release.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get release %d: %w", output.GetID(), wrapGitHubError(err))
+		}
+	}
+
+	//
+	// Download URLs of draft assets change once the release is published,
+	// so they are taken from the final release.
+	//
+	output.RepositoryRelease = release
+	urls := map[int64]string{}
+	for _, asset := range release.Assets {
+		urls[asset.GetID()] = asset.GetBrowserDownloadURL()
+	}
+
+	for i := range output.Uploads {
+		output.Uploads[i].DownloadURL = urls[output.Uploads[i].ID]
+	}
+
+	return output, nil
+}
+
+func (c *CreateReleaseWithAssets) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateReleaseWithAssets) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateReleaseWithAssets) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateReleaseWithAssets) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateReleaseWithAssets) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateReleaseWithAssets) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,222 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateReleaseWithAssets__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateReleaseWithAssets{}
+
+	setup := func(assets []any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "versionStrategy": "manual", "tagName": "v1.0.0", "assets": assets},
+		})
+	}
+
+	t.Run("assets are required", func(t *testing.T) {
+		require.ErrorContains(t, setup([]any{}), "at least one asset is required")
+	})
+
+	t.Run("asset needs a path or content", func(t *testing.T) {
+		require.ErrorContains(t, setup([]any{map[string]any{"name": "sbom.json"}}), "asset sbom.json needs a path or content")
+	})
+
+	t.Run("asset cannot have both a path and content", func(t *testing.T) {
+		err := setup([]any{map[string]any{"name": "sbom.json", "path": "sbom.json", "content": "{}"}})
+		require.ErrorContains(t, err, "can only have a path or content")
+	})
+
+	t.Run("duplicate asset names -> error", func(t *testing.T) {
+		err := setup([]any{
+			map[string]any{"name": "checksums.txt", "content": "a"},
+			map[string]any{"name": "Checksums.txt", "content": "b"},
+		})
+
+		require.ErrorContains(t, err, "asset Checksums.txt is configured more than once")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":      "hello",
+				"versionStrategy": "manual",
+				"tagName":         "v1.0.0",
+				"assets":          []any{map[string]any{"name": "manifest.json", "path": "dist/manifest.json"}},
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateReleaseWithAssets__Configuration(t *testing.T) {
+	fields := (&CreateReleaseWithAssets{}).Configuration()
+	names := []string{}
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+
+	assert.Contains(t, names, "tagName")
+	assert.Equal(t, []string{"assets", "rollbackOnFailure", ConcurrencyKeyField.Name}, names[len(names)-3:])
+}
+
+func Test__CreateReleaseWithAssets__ReadAssetFiles(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		switch {
+		case request.URL.Path == "/repos/testhq/hello/git/ref/tags/v1.0.0":
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		case request.URL.Path == "/repos/testhq/hello":
+			return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"main"}`), nil
+		case request.URL.Path == "/repos/testhq/hello/git/trees/main":
+			return mockResponse(http.StatusOK, `{"sha":"t1","tree":[{"path":"dist/manifest.json","type":"blob","sha":"b1","size":2}]}`), nil
+		case request.URL.Path == "/repos/testhq/hello/git/blobs/b1":
+			return mockResponse(http.StatusOK, `{}`), nil
+		}
+
+		return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+	}}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+
+	t.Run("files are read at the default branch when the tag does not exist", func(t *testing.T) {
+		sources, err := readReleaseAssetFiles(client, &CreateRelease{}, "testhq", "hello", "v1.0.0", []ReleaseAssetFile{
+			{Name: " manifest.json ", Path: "/dist/manifest.json", ContentType: "application/json"},
+			{Name: "checksums.txt", Content: "abc"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []ReleaseAssetSource{
+			{Name: "manifest.json", Content: "{}", ContentType: "application/json"},
+			{Name: "checksums.txt", Content: "abc"},
+		}, sources)
+	})
+
+	t.Run("missing file -> error", func(t *testing.T) {
+		_, err := readReleaseAssetFiles(client, &CreateRelease{}, "testhq", "hello", "v1.0.0", []ReleaseAssetFile{
+			{Name: "sbom.json", Path: "sbom.json"},
+		})
+
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Contains(t, err.Error(), "file sbom.json of asset sbom.json does not exist at main")
+	})
+}
+
+func Test__CreateReleaseWithAssets__Create(t *testing.T) {
+	sources := []ReleaseAssetSource{
+		{Name: "checksums.txt", Content: "abcde"},
+		{Name: "sbom.json", Content: "{}", ContentType: "application/json"},
+	}
+
+	transportFor := func(failedAsset string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case request.Method == http.MethodPost && request.URL.Path == "/repos/testhq/hello/releases":
+				return mockResponse(http.StatusCreated, `{"id":42,"tag_name":"v1.0.0","draft":true}`), nil
+			case request.Method == http.MethodPost && request.URL.Path == "/repos/testhq/hello/releases/42/assets":
+				name := request.URL.Query().Get("name")
+				if name == failedAsset {
+					return mockResponse(http.StatusUnprocessableEntity, `{"message":"Validation Failed"}`), nil
+				}
+
+				id := "1"
+				if name == "sbom.json" {
+					id = "2"
+				}
+
+				return mockResponse(http.StatusCreated, `{"id":`+id+`,"name":"`+name+`","size":5}`), nil
+			case request.Method == http.MethodPatch && request.URL.Path == "/repos/testhq/hello/releases/42":
+				return mockResponse(http.StatusOK, `{"id":42,"tag_name":"v1.0.0","draft":false,"assets":[
+					{"id":1,"name":"checksums.txt","browser_download_url":"https://github.com/testhq/hello/releases/download/v1.0.0/checksums.txt"},
+					{"id":2,"name":"sbom.json","browser_download_url":"https://github.com/testhq/hello/releases/download/v1.0.0/sbom.json"}
+				]}`), nil
+			case request.Method == http.MethodGet && request.URL.Path == "/repos/testhq/hello/releases/42":
+				return mockResponse(http.StatusOK, `{"id":42,"tag_name":"v1.0.0","draft":true,"assets":[
+					{"id":1,"name":"checksums.txt","browser_download_url":"https://github.com/testhq/hello/releases/download/untagged-1/checksums.txt"},
+					{"id":2,"name":"sbom.json","browser_download_url":"https://github.com/testhq/hello/releases/download/untagged-1/sbom.json"}
+				]}`), nil
+			case request.Method == http.MethodDelete && request.URL.Path == "/repos/testhq/hello/releases/42":
+				return mockResponse(http.StatusNoContent, ``), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+	}
+
+	t.Run("release is created as a draft, and published once its assets are uploaded", func(t *testing.T) {
+		transport := transportFor("")
+		request := &github.RepositoryRelease{TagName: github.Ptr("v1.0.0"), Draft: github.Ptr(false)}
+		output, err := createReleaseWithAssets(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request, sources, true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+
+		body, _ := io.ReadAll(transport.requests[0].Body)
+		assert.Contains(t, string(body), `"draft":true`)
+		body, _ = io.ReadAll(transport.requests[3].Body)
+		assert.JSONEq(t, `{"draft":false}`, string(body))
+
+		assert.False(t, output.GetDraft())
+		assert.False(t, output.RolledBack)
+		assert.Equal(t, []ReleaseAssetUpload{
+			{Name: "checksums.txt", ID: 1, Size: 5, DownloadURL: "https://github.com/testhq/hello/releases/download/v1.0.0/checksums.txt"},
+			{Name: "sbom.json", ID: 2, Size: 5, DownloadURL: "https://github.com/testhq/hello/releases/download/v1.0.0/sbom.json"},
+		}, output.Uploads)
+	})
+
+	t.Run("draft release is kept as a draft", func(t *testing.T) {
+		transport := transportFor("")
+		request := &github.RepositoryRelease{TagName: github.Ptr("v1.0.0"), Draft: github.Ptr(true)}
+		output, err := createReleaseWithAssets(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request, sources, false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+		assert.Equal(t, http.MethodGet, transport.requests[3].Method)
+
+		assert.True(t, output.GetDraft())
+		assert.Contains(t, output.Uploads[1].DownloadURL, "untagged-1")
+	})
+
+	t.Run("failed upload without rollback -> draft is kept", func(t *testing.T) {
+		transport := transportFor("sbom.json")
+		request := &github.RepositoryRelease{TagName: github.Ptr("v1.0.0")}
+		output, err := createReleaseWithAssets(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request, sources, false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+
+		assert.True(t, output.GetDraft())
+		assert.False(t, output.RolledBack)
+		assert.Equal(t, int64(1), output.Uploads[0].ID)
+		assert.Empty(t, output.Uploads[0].Error)
+		assert.Contains(t, output.Uploads[1].Error, "failed to upload release asset sbom.json")
+	})
+
+	t.Run("failed upload with rollback -> draft is deleted", func(t *testing.T) {
+		transport := transportFor("checksums.txt")
+		request := &github.RepositoryRelease{TagName: github.Ptr("v1.0.0")}
+		output, err := createReleaseWithAssets(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request, sources, true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+
+		assert.Equal(t, http.MethodDelete, transport.requests[3].Method)
+		assert.True(t, output.RolledBack)
+		assert.NotEmpty(t, output.Uploads[0].Error)
+		assert.Equal(t, int64(2), output.Uploads[1].ID)
+
+		data, err := json.Marshal(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"rolled_back":true`)
+	})
+}
@@ -0,0 +1,271 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * GitHub rejects repository_dispatch events with an event type
+ * longer than 100 characters, or a client payload with more than 10 top-level properties.
+ * GitHub does not document a payload size limit, so we use a conservative one.
+ */
+const (
+	MaxDispatchEventTypeLength      = 100
+	MaxDispatchClientPayloadProps   = 10
+	MaxDispatchClientPayloadSizeKiB = 64
+)
+
+type CreateRepositoryDispatch struct{}
+
+type CreateRepositoryDispatchConfiguration struct {
+	Repository    string `json:"repository" mapstructure:"repository"`
+	EventType     string `json:"eventType" mapstructure:"eventType"`
+	ClientPayload any    `json:"clientPayload" mapstructure:"clientPayload"`
+}
+
+func (c *CreateRepositoryDispatch) Name() string {
+	return "github.createRepositoryDispatch"
+}
+
+func (c *CreateRepositoryDispatch) Label() string {
+	return "Create Repository Dispatch"
+}
+
+func (c *CreateRepositoryDispatch) Description() string {
+	return "Send a repository_dispatch event to a GitHub repository"
+}
+
+func (c *CreateRepositoryDispatch) Documentation() string {
+	return `The Create Repository Dispatch component sends a ` + "`repository_dispatch`" + ` event to a GitHub repository.
+
+## Use Cases
+
+- **Fan-out triggers**: Start every workflow listening to an event type, without knowing their names
+- **Cross-repository automation**: Trigger workflows in other repositories with custom data
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Event Type**: The event type, matched by the ` + "`types`" + ` filter of the ` + "`repository_dispatch`" + ` workflow trigger. At most 100 characters
+- **Client Payload**: Optional JSON object sent as ` + "`client_payload`" + `. At most 10 top-level properties
+
+## Output
+
+GitHub does not return anything for dispatched events, so the component emits a confirmation with the ` + "`repository`" + `, ` + "`event_type`" + `, ` + "`client_payload`" + `, and ` + "`dispatched_at`" + `.
+
+## Notes
+
+- Use **Run Workflow** to run a specific workflow and wait for it to complete`
+}
+
+func (c *CreateRepositoryDispatch) Icon() string {
+	return "github"
+}
+
+func (c *CreateRepositoryDispatch) Color() string {
+	return "gray"
+}
+
+func (c *CreateRepositoryDispatch) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateRepositoryDispatch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "eventType",
+			Label:       "Event Type",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., deploy",
+		},
+		{
+			Name:        "clientPayload",
+			Label:       "Client Payload",
+			Type:        configuration.FieldTypeJSON,
+			Description: "JSON object with at most 10 top-level properties",
+			TypeOptions: &configuration.TypeOptions{
+				JSON: &configuration.JSONTypeOptions{
+					Schema: map[string]any{
+						"type":          "object",
+						"maxProperties": MaxDispatchClientPayloadProps,
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateRepositoryDispatch) Setup(ctx core.SetupContext) error {
+	var config CreateRepositoryDispatchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.EventType == "" {
+		return errors.New("event type is required")
+	}
+
+	if !isExpression(config.EventType) && len(config.EventType) > MaxDispatchEventTypeLength {
+		return fmt.Errorf("event type must be at most %d characters", MaxDispatchEventTypeLength)
+	}
+
+	//
+	// Payloads with expressions are only checked at execution time.
+	//
+	if text, ok := config.ClientPayload.(string); !ok || !isExpression(text) {
+		if _, err := parseClientPayload(config.ClientPayload); err != nil {
+			return err
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreateRepositoryDispatch) Execute(ctx core.ExecutionContext) error {
+	var config CreateRepositoryDispatchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.EventType) > MaxDispatchEventTypeLength {
+		return fmt.Errorf("event type must be at most %d characters", MaxDispatchEventTypeLength)
+	}
+
+	payload, err := parseClientPayload(config.ClientPayload)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.repositoryDispatch", func() (any, error) {
+		opts := github.DispatchRequestOptions{EventType: config.EventType}
+		if payload != nil {
+			raw, err := json.Marshal(payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal client payload: %w", err)
+			}
+
+			message := json.RawMessage(raw)
+			opts.ClientPayload = &message
+		}
+
+		_, _, err := client.Repositories.Dispatch(context.Background(), appMetadata.Owner, config.Repository, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create repository dispatch: %w", wrapGitHubError(err))
+		}
+
+		return map[string]any{
+			"repository":     config.Repository,
+			"event_type":     config.EventType,
+			"client_payload": payload,
+			"dispatched_at":  time.Now().UTC().Format(time.RFC3339),
+		}, nil
+	})
+}
+
+/*
+ * Parses the client payload and checks it against the GitHub limits.
+ * An empty payload returns nil, so no client_payload is sent.
+ */
+func parseClientPayload(value any) (map[string]any, error) {
+	if value == nil || value == "" {
+		return nil, nil
+	}
+
+	parsed, err := configuration.ParseJSON(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client payload: %w", err)
+	}
+
+	payload, ok := parsed.(map[string]any)
+	if !ok {
+		return nil, errors.New("client payload must be a JSON object")
+	}
+
+	if len(payload) > MaxDispatchClientPayloadProps {
+		return nil, fmt.Errorf("client payload has %d top-level properties, but at most %d are allowed", len(payload), MaxDispatchClientPayloadProps)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client payload: %w", err)
+	}
+
+	if len(data) > MaxDispatchClientPayloadSizeKiB*1024 {
+		return nil, fmt.Errorf("client payload is %d bytes, but at most %d KiB are allowed", len(data), MaxDispatchClientPayloadSizeKiB)
+	}
+
+	return payload, nil
+}
+
+func (c *CreateRepositoryDispatch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateRepositoryDispatch) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateRepositoryDispatch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateRepositoryDispatch) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateRepositoryDispatch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateRepositoryDispatch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateRepositoryDispatch__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateRepositoryDispatch{}
+
+	t.Run("event type is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "event type is required")
+	})
+
+	t.Run("event type too long -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "eventType": strings.Repeat("a", 101)},
+		})
+
+		require.ErrorContains(t, err, "at most 100 characters")
+	})
+
+	t.Run("too many payload properties -> error", func(t *testing.T) {
+		payload := map[string]any{}
+		for i := range 11 {
+			payload[fmt.Sprintf("key%d", i)] = i
+		}
+
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "eventType": "deploy", "clientPayload": payload},
+		})
+
+		require.ErrorContains(t, err, "11 top-level properties")
+	})
+
+	t.Run("payload with expressions is checked at execution time", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":    "hello",
+				"eventType":     "deploy",
+				"clientPayload": `{"ref": {{ $.data.ref }}}`,
+			},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":    "hello",
+				"eventType":     "deploy",
+				"clientPayload": `{"environment": "production"}`,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ParseClientPayload(t *testing.T) {
+	t.Run("empty payload -> nil", func(t *testing.T) {
+		payload, err := parseClientPayload("")
+		require.NoError(t, err)
+		assert.Nil(t, payload)
+	})
+
+	t.Run("JSON string is parsed", func(t *testing.T) {
+		payload, err := parseClientPayload(`{"ref": "main"}`)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"ref": "main"}, payload)
+	})
+
+	t.Run("non-object payload -> error", func(t *testing.T) {
+		_, err := parseClientPayload(`["main"]`)
+		require.ErrorContains(t, err, "must be a JSON object")
+	})
+
+	t.Run("invalid JSON -> error", func(t *testing.T) {
+		_, err := parseClientPayload(`{"ref": }`)
+		require.ErrorContains(t, err, "invalid client payload")
+	})
+
+	t.Run("payload too large -> error", func(t *testing.T) {
+		_, err := parseClientPayload(map[string]any{"data": strings.Repeat("a", 65*1024)})
+		require.ErrorContains(t, err, "at most 64 KiB")
+	})
+}
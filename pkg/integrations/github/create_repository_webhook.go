@@ -0,0 +1,426 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	WebhookContentTypeJSON = "json"
+	WebhookContentTypeForm = "form"
+	WebhookActionCreated   = "created"
+	WebhookActionUpdated   = "updated"
+)
+
+/*
+ * The events a repository webhook can subscribe to.
+ * "*" subscribes to all of them, including the ones GitHub adds later.
+ */
+var RepositoryWebhookEvents = []string{
+	"*",
+	"branch_protection_configuration",
+	"branch_protection_rule",
+	"check_run",
+	"check_suite",
+	"code_scanning_alert",
+	"commit_comment",
+	"create",
+	"custom_property_values",
+	"delete",
+	"dependabot_alert",
+	"deploy_key",
+	"deployment",
+	"deployment_protection_rule",
+	"deployment_review",
+	"deployment_status",
+	"discussion",
+	"discussion_comment",
+	"fork",
+	"gollum",
+	"issue_comment",
+	"issues",
+	"label",
+	"member",
+	"merge_group",
+	"meta",
+	"milestone",
+	"package",
+	"page_build",
+	"project",
+	"project_card",
+	"project_column",
+	"public",
+	"pull_request",
+	"pull_request_review",
+	"pull_request_review_comment",
+	"pull_request_review_thread",
+	"push",
+	"registry_package",
+	"release",
+	"repository",
+	"repository_advisory",
+	"repository_dispatch",
+	"repository_import",
+	"repository_ruleset",
+	"repository_vulnerability_alert",
+	"secret_scanning_alert",
+	"secret_scanning_alert_location",
+	"security_and_analysis",
+	"star",
+	"status",
+	"team_add",
+	"watch",
+	"workflow_dispatch",
+	"workflow_job",
+	"workflow_run",
+}
+
+type CreateRepositoryWebhook struct{}
+
+// SecretKeyRef is stored in YAML as: { secret: "name", key: "keyName" }.
+type SecretKeyRef struct {
+	Secret string `json:"secret" mapstructure:"secret"`
+	Key    string `json:"key" mapstructure:"key"`
+}
+
+type CreateRepositoryWebhookConfiguration struct {
+	Repository  string        `json:"repository" mapstructure:"repository"`
+	URL         string        `json:"url" mapstructure:"url"`
+	Events      []string      `json:"events" mapstructure:"events"`
+	ContentType string        `json:"contentType" mapstructure:"contentType"`
+	Secret      *SecretKeyRef `json:"secret" mapstructure:"secret"`
+}
+
+type RepositoryWebhookOutput struct {
+	ID          int64    `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	ContentType string   `json:"content_type"`
+	Action      string   `json:"action"`
+}
+
+func (c *CreateRepositoryWebhook) Name() string {
+	return "github.createRepositoryWebhook"
+}
+
+func (c *CreateRepositoryWebhook) Label() string {
+	return "Create Repository Webhook"
+}
+
+func (c *CreateRepositoryWebhook) Description() string {
+	return "Create a webhook on a GitHub repository, or update the one with the same URL"
+}
+
+func (c *CreateRepositoryWebhook) Documentation() string {
+	return `The Create Repository Webhook component creates a webhook on a repository. If the repository already has a webhook with the same URL, that webhook is updated instead, so running it again does not create duplicates.
+
+## Use Cases
+
+- **Repository provisioning**: Connect new repositories to CI, chat or deployment services
+- **Webhook rotation**: Change the events or the secret of an existing webhook
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **URL**: The URL the webhook delivers to (supports expressions)
+- **Events**: The events to deliver, like ` + "`push`" + ` or ` + "`pull_request`" + `. Use ` + "`*`" + ` for all events
+- **Content Type**: Deliver payloads as ` + "`json`" + ` or ` + "`form`" + ` encoded. Defaults to ` + "`json`" + `
+- **Secret**: Optional secret used to sign the deliveries
+
+## Output
+
+Emits a ` + "`github.repositoryWebhook`" + ` event with the webhook ` + "`id`" + `, its ` + "`url`" + `, ` + "`events`" + ` and ` + "`content_type`" + `, and the ` + "`action`" + ` taken, ` + "`created`" + ` or ` + "`updated`" + `.
+
+## Notes
+
+- Events are checked against the events GitHub delivers to repository webhooks, and unknown events fail the setup
+- Updating a webhook replaces its configuration, so a webhook updated without a secret no longer signs its deliveries
+- The webhook is active, and verifies the TLS certificate of the URL`
+}
+
+func (c *CreateRepositoryWebhook) Icon() string {
+	return "github"
+}
+
+func (c *CreateRepositoryWebhook) Color() string {
+	return "gray"
+}
+
+func (c *CreateRepositoryWebhook) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateRepositoryWebhook) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "url",
+			Label:       "URL",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., https://ci.example.com/hooks/github",
+		},
+		{
+			Name:     "events",
+			Label:    "Events",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			Default:  []string{"push"},
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Event",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:     "contentType",
+			Label:    "Content Type",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  WebhookContentTypeJSON,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "JSON", Value: WebhookContentTypeJSON},
+						{Label: "Form", Value: WebhookContentTypeForm},
+					},
+				},
+			},
+		},
+		{
+			Name:        "secret",
+			Label:       "Secret",
+			Type:        configuration.FieldTypeSecretKey,
+			Description: "Secret used to sign the deliveries",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateRepositoryWebhook) Setup(ctx core.SetupContext) error {
+	var config CreateRepositoryWebhookConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateRepositoryWebhook(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateRepositoryWebhook(config CreateRepositoryWebhookConfiguration) error {
+	if strings.TrimSpace(config.URL) == "" {
+		return errors.New("url is required")
+	}
+
+	if !isExpression(config.URL) {
+		parsed, err := url.Parse(config.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid url: %s", config.URL)
+		}
+	}
+
+	if len(config.Events) == 0 {
+		return errors.New("at least one event is required")
+	}
+
+	for _, event := range config.Events {
+		if isExpression(event) {
+			continue
+		}
+
+		if !slices.Contains(RepositoryWebhookEvents, event) {
+			return fmt.Errorf("unknown event: %s", event)
+		}
+	}
+
+	if config.ContentType != "" && config.ContentType != WebhookContentTypeJSON && config.ContentType != WebhookContentTypeForm {
+		return fmt.Errorf("invalid content type: %s", config.ContentType)
+	}
+
+	if config.Secret != nil && (config.Secret.Secret == "") != (config.Secret.Key == "") {
+		return errors.New("secret requires both a secret and a key")
+	}
+
+	return nil
+}
+
+func (c *CreateRepositoryWebhook) Execute(ctx core.ExecutionContext) error {
+	var config CreateRepositoryWebhookConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateRepositoryWebhook(config); err != nil {
+		return err
+	}
+
+	hookConfig := &github.HookConfig{
+		URL:         github.Ptr(config.URL),
+		ContentType: github.Ptr(cmp.Or(config.ContentType, WebhookContentTypeJSON)),
+		InsecureSSL: github.Ptr("0"),
+	}
+
+	if config.Secret != nil && config.Secret.Secret != "" {
+		if ctx.Secrets == nil {
+			return errors.New("secrets are not available")
+		}
+
+		secret, err := ctx.Secrets.GetKey(config.Secret.Secret, config.Secret.Key)
+		if err != nil {
+			return fmt.Errorf("failed to resolve webhook secret: %w", err)
+		}
+
+		hookConfig.Secret = github.Ptr(string(secret))
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := upsertRepositoryWebhook(client, appMetadata.Owner, config.Repository, &github.Hook{
+		Active: github.Ptr(true),
+		Events: config.Events,
+		Config: hookConfig,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Webhook %d %s on %s", output.ID, output.Action, config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.repositoryWebhook",
+		[]any{output},
+	)
+}
+
+/*
+ * GitHub accepts any number of webhooks with the same URL,
+ * so the existing ones are checked first, to update instead of duplicating.
+ */
+func upsertRepositoryWebhook(client *github.Client, owner, repo string, hook *github.Hook) (*RepositoryWebhookOutput, error) {
+	existing, err := findRepositoryWebhook(client, owner, repo, hook.Config.GetURL())
+	if err != nil {
+		return nil, err
+	}
+
+	action := WebhookActionCreated
+	var result *github.Hook
+	if existing == nil {
+		result, _, err = client.Repositories.CreateHook(context.Background(), owner, repo, hook)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create webhook: %w", wrapGitHubError(err))
+		}
+	} else {
+		action = WebhookActionUpdated
+		result, _, err = client.Repositories.EditHook(context.Background(), owner, repo, existing.GetID(), hook)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update webhook %d: %w", existing.GetID(), wrapGitHubError(err))
+		}
+	}
+
+	return &RepositoryWebhookOutput{
+		ID:          result.GetID(),
+		URL:         result.GetConfig().GetURL(),
+		Events:      result.Events,
+		ContentType: result.GetConfig().GetContentType(),
+		Action:      action,
+	}, nil
+}
+
+func findRepositoryWebhook(client *github.Client, owner, repo, hookURL string) (*github.Hook, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		hooks, response, err := client.Repositories.ListHooks(context.Background(), owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhooks: %w", wrapGitHubError(err))
+		}
+
+		for _, hook := range hooks {
+			if hook.GetConfig().GetURL() == hookURL {
+				return hook, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return nil, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (c *CreateRepositoryWebhook) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateRepositoryWebhook) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateRepositoryWebhook) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateRepositoryWebhook) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateRepositoryWebhook) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateRepositoryWebhook) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
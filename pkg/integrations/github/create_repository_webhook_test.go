@@ -0,0 +1,130 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateRepositoryWebhook__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateRepositoryWebhook{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("url is required", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "events": []string{"push"}})
+		require.ErrorContains(t, err, "url is required")
+	})
+
+	t.Run("invalid url -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "url": "ftp://example.com", "events": []string{"push"}})
+		require.ErrorContains(t, err, "invalid url")
+	})
+
+	t.Run("unknown event -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "url": "https://example.com/hook", "events": []string{"push", "pushes"}})
+		require.ErrorContains(t, err, "unknown event: pushes")
+	})
+
+	t.Run("invalid content type -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "url": "https://example.com/hook", "events": []string{"push"}, "contentType": "xml"})
+		require.ErrorContains(t, err, "invalid content type")
+	})
+
+	t.Run("expressions are checked at execution time", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{
+			"repository": "hello",
+			"url":        "{{$.data.url}}",
+			"events":     []string{"{{$.data.event}}", "*"},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "url": "https://example.com/hook", "events": []string{"push"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateRepositoryWebhook__Upsert(t *testing.T) {
+	hook := &github.Hook{
+		Active: github.Ptr(true),
+		Events: []string{"push", "pull_request"},
+		Config: &github.HookConfig{
+			URL:         github.Ptr("https://example.com/hook"),
+			ContentType: github.Ptr("json"),
+		},
+	}
+
+	t.Run("webhook is created", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `[{"id":1,"config":{"url":"https://other.example.com/hook"}}]`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"name":"web","active":true,"events":["push","pull_request"],"config":{"url":"https://example.com/hook","content_type":"json"}}`, string(body))
+			return mockResponse(http.StatusCreated, `{"id":7,"events":["push","pull_request"],"config":{"url":"https://example.com/hook","content_type":"json"}}`), nil
+		}}
+
+		output, err := upsertRepositoryWebhook(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", hook)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), output.ID)
+		assert.Equal(t, WebhookActionCreated, output.Action)
+		assert.Equal(t, []string{"push", "pull_request"}, output.Events)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodPost, transport.requests[1].Method)
+		assert.Equal(t, "/repos/testhq/hello/hooks", transport.requests[1].URL.Path)
+	})
+
+	t.Run("webhook with the same url is updated", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet && request.URL.Query().Get("page") == "" {
+				response := mockResponse(http.StatusOK, `[{"id":1,"config":{"url":"https://other.example.com/hook"}}]`)
+				response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/hooks?page=2>; rel="next"`)
+				return response, nil
+			}
+
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `[{"id":5,"config":{"url":"https://example.com/hook"}}]`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":5,"events":["push","pull_request"],"config":{"url":"https://example.com/hook","content_type":"json"}}`), nil
+		}}
+
+		output, err := upsertRepositoryWebhook(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", hook)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), output.ID)
+		assert.Equal(t, WebhookActionUpdated, output.Action)
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPatch, transport.requests[2].Method)
+		assert.Equal(t, "/repos/testhq/hello/hooks/5", transport.requests[2].URL.Path)
+	})
+
+	t.Run("missing permission fails", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := upsertRepositoryWebhook(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", hook)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
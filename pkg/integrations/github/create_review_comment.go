@@ -0,0 +1,444 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DiffSideLeft  = "LEFT"
+	DiffSideRight = "RIGHT"
+)
+
+type CreateReviewComment struct{}
+
+type CreateReviewCommentConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	CommitID   string `json:"commitId" mapstructure:"commitId"`
+	Path       string `json:"path" mapstructure:"path"`
+	Line       string `json:"line" mapstructure:"line"`
+	Side       string `json:"side" mapstructure:"side"`
+	StartLine  string `json:"startLine" mapstructure:"startLine"`
+	StartSide  string `json:"startSide" mapstructure:"startSide"`
+	Body       string `json:"body" mapstructure:"body"`
+}
+
+type ReviewCommentRequest struct {
+	PullNumber int
+	CommitID   string
+	Path       string
+	Line       int
+	Side       string
+	StartLine  int
+	StartSide  string
+	Body       string
+}
+
+func (c *CreateReviewComment) Name() string {
+	return "github.createReviewComment"
+}
+
+func (c *CreateReviewComment) Label() string {
+	return "Create Review Comment"
+}
+
+func (c *CreateReviewComment) Description() string {
+	return "Comment on lines of a GitHub pull request diff"
+}
+
+func (c *CreateReviewComment) Documentation() string {
+	return `The Create Review Comment component comments on one or more lines of a file in the diff of a pull request.
+
+## Use Cases
+
+- **Linters**: Report lint findings on the lines they apply to
+- **Automated review**: Suggest changes to specific lines of a pull request
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Commit ID**: The SHA of the commit to comment on. Defaults to the head commit of the pull request
+- **Path**: The path of a file changed by the pull request
+- **Line**: The line of the file to comment on. For a multi-line comment, the last line
+- **Side**: ` + "`RIGHT`" + ` for the new version of the file (additions and unchanged lines), ` + "`LEFT`" + ` for the old version (deletions). Defaults to ` + "`RIGHT`" + `
+- **Start Line** and **Start Side**: The first line of a multi-line comment (optional)
+- **Body**: The comment text (supports markdown and expressions)
+
+## Output
+
+Returns the created review comment, including its ` + "`html_url`" + `.
+
+## Notes
+
+- The lines must be part of the diff of the pull request, otherwise GitHub rejects the comment
+- The path is checked against the files changed by the pull request when the component is saved, unless the repository, pull request number, or path are expressions`
+}
+
+func (c *CreateReviewComment) Icon() string {
+	return "github"
+}
+
+func (c *CreateReviewComment) Color() string {
+	return "gray"
+}
+
+func (c *CreateReviewComment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateReviewComment) Configuration() []configuration.Field {
+	sideOptions := &configuration.TypeOptions{
+		Select: &configuration.SelectTypeOptions{
+			Options: []configuration.FieldOption{
+				{Label: "Right (new version)", Value: DiffSideRight},
+				{Label: "Left (old version)", Value: DiffSideLeft},
+			},
+		},
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "commitId",
+			Label:       "Commit ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.pull_request.head.sha}}",
+			Description: "Defaults to the head commit of the pull request",
+		},
+		{
+			Name:        "path",
+			Label:       "Path",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., src/main.go",
+		},
+		{
+			Name:        "line",
+			Label:       "Line",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., 42",
+			Description: "Line to comment on, or the last line of a multi-line comment",
+		},
+		{
+			Name:        "side",
+			Label:       "Side",
+			Type:        configuration.FieldTypeSelect,
+			Default:     DiffSideRight,
+			TypeOptions: sideOptions,
+		},
+		{
+			Name:        "startLine",
+			Label:       "Start Line",
+			Type:        configuration.FieldTypeString,
+			Description: "First line of a multi-line comment",
+		},
+		{
+			Name:        "startSide",
+			Label:       "Start Side",
+			Type:        configuration.FieldTypeSelect,
+			TypeOptions: sideOptions,
+			Description: "Side of the first line of a multi-line comment. Defaults to the side",
+		},
+		{
+			Name:     "body",
+			Label:    "Body",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		EventTypeField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateReviewComment) Setup(ctx core.SetupContext) error {
+	return c.setup(ctx, integrationTransport(ctx.Integration))
+}
+
+func (c *CreateReviewComment) setup(ctx core.SetupContext, transport http.RoundTripper) error {
+	var config CreateReviewCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if config.Body == "" {
+		return errors.New("body is required")
+	}
+
+	if config.Line == "" {
+		return errors.New("line is required")
+	}
+
+	//
+	// Lines can be expressions, so they are only validated once resolved.
+	//
+	if !isExpression(config.Line) && !isExpression(config.StartLine) {
+		if _, err := parseReviewCommentLines(config); err != nil {
+			return err
+		}
+	}
+
+	if err := validateEventType(ctx.Configuration); err != nil {
+		return err
+	}
+
+	err := ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	//
+	// Expressions are only resolved on execution,
+	// so the path can only be checked here when none are used.
+	//
+	if isExpression(config.Repository) || isExpression(config.PullNumber) || isExpression(config.Path) {
+		return nil
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	return ensurePathInPullRequest(client, appMetadata.Owner, config.Repository, pullNumber, config.Path)
+}
+
+func (c *CreateReviewComment) Execute(ctx core.ExecutionContext) error {
+	var config CreateReviewCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	request, err := buildReviewCommentRequest(config)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, eventType(ctx.Configuration, "github.reviewComment"), func() (any, error) {
+		return createReviewComment(client, appMetadata.Owner, config.Repository, request)
+	})
+}
+
+func buildReviewCommentRequest(config CreateReviewCommentConfiguration) (*ReviewCommentRequest, error) {
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return nil, fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	request, err := parseReviewCommentLines(config)
+	if err != nil {
+		return nil, err
+	}
+
+	request.PullNumber = pullNumber
+	return request, nil
+}
+
+func parseReviewCommentLines(config CreateReviewCommentConfiguration) (*ReviewCommentRequest, error) {
+	line, err := strconv.Atoi(config.Line)
+	if err != nil {
+		return nil, fmt.Errorf("line is not a number: %v", err)
+	}
+
+	if line < 1 {
+		return nil, errors.New("line must be at least 1")
+	}
+
+	request := &ReviewCommentRequest{
+		CommitID: config.CommitID,
+		Path:     config.Path,
+		Line:     line,
+		Side:     DiffSideRight,
+		Body:     config.Body,
+	}
+
+	if config.Side != "" {
+		request.Side = config.Side
+	}
+
+	if !slices.Contains([]string{DiffSideLeft, DiffSideRight}, request.Side) {
+		return nil, fmt.Errorf("invalid side: %s", request.Side)
+	}
+
+	if config.StartLine == "" {
+		if config.StartSide != "" {
+			return nil, errors.New("start line is required when a start side is set")
+		}
+
+		return request, nil
+	}
+
+	request.StartLine, err = strconv.Atoi(config.StartLine)
+	if err != nil {
+		return nil, fmt.Errorf("start line is not a number: %v", err)
+	}
+
+	if request.StartLine < 1 {
+		return nil, errors.New("start line must be at least 1")
+	}
+
+	request.StartSide = request.Side
+	if config.StartSide != "" {
+		request.StartSide = config.StartSide
+	}
+
+	if !slices.Contains([]string{DiffSideLeft, DiffSideRight}, request.StartSide) {
+		return nil, fmt.Errorf("invalid start side: %s", request.StartSide)
+	}
+
+	if request.StartSide == request.Side && request.StartLine >= request.Line {
+		return nil, errors.New("start line must be before the line")
+	}
+
+	return request, nil
+}
+
+func ensurePathInPullRequest(client *github.Client, owner, repo string, pullNumber int, path string) error {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		files, response, err := client.PullRequests.ListFiles(context.Background(), owner, repo, pullNumber, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list files of pull request %d: %w", pullNumber, wrapGitHubError(err))
+		}
+
+		if slices.ContainsFunc(files, func(file *github.CommitFile) bool { return file.GetFilename() == path }) {
+			return nil
+		}
+
+		if response.NextPage == 0 {
+			return fmt.Errorf("%s is not changed by pull request %d", path, pullNumber)
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func createReviewComment(client *github.Client, owner, repo string, request *ReviewCommentRequest) (*github.PullRequestComment, error) {
+	commitID := request.CommitID
+	if commitID == "" {
+		pullRequest, _, err := client.PullRequests.Get(context.Background(), owner, repo, request.PullNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request %d: %w", request.PullNumber, wrapGitHubError(err))
+		}
+
+		commitID = pullRequest.GetHead().GetSHA()
+	}
+
+	comment := &github.PullRequestComment{
+		CommitID: github.Ptr(commitID),
+		Path:     github.Ptr(request.Path),
+		Line:     github.Ptr(request.Line),
+		Side:     github.Ptr(request.Side),
+		Body:     github.Ptr(request.Body),
+	}
+
+	if request.StartLine > 0 {
+		comment.StartLine = github.Ptr(request.StartLine)
+		comment.StartSide = github.Ptr(request.StartSide)
+	}
+
+	created, _, err := client.PullRequests.CreateComment(context.Background(), owner, repo, request.PullNumber, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to comment on %s in pull request %d: %w", request.Path, request.PullNumber, wrapGitHubError(err))
+	}
+
+	return created, nil
+}
+
+func (c *CreateReviewComment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateReviewComment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateReviewComment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateReviewComment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateReviewComment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateReviewComment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
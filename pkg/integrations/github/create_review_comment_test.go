@@ -0,0 +1,167 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateReviewComment__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateReviewComment{}
+	config := func(overrides map[string]any) map[string]any {
+		config := map[string]any{"repository": "hello", "pullNumber": "42", "path": "src/main.go", "line": "10", "body": "Unused variable"}
+		for key, value := range overrides {
+			config[key] = value
+		}
+
+		return config
+	}
+
+	filesTransport := func() *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/pulls/42/files":
+				if request.URL.Query().Get("page") == "" {
+					response := mockResponse(http.StatusOK, `[{"filename":"README.md"}]`)
+					response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/pulls/42/files?page=2>; rel="next"`)
+					return response, nil
+				}
+
+				return mockResponse(http.StatusOK, `[{"filename":"src/main.go"}]`), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	setup := func(t *testing.T, config map[string]any, transport *mockTransport) error {
+		return component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: config,
+		}, transport)
+	}
+
+	t.Run("path is required", func(t *testing.T) {
+		err := setup(t, config(map[string]any{"path": ""}), filesTransport())
+		require.ErrorContains(t, err, "path is required")
+	})
+
+	t.Run("invalid side -> error", func(t *testing.T) {
+		err := setup(t, config(map[string]any{"side": "MIDDLE"}), filesTransport())
+		require.ErrorContains(t, err, "invalid side: MIDDLE")
+	})
+
+	t.Run("start line after line -> error", func(t *testing.T) {
+		err := setup(t, config(map[string]any{"startLine": "12"}), filesTransport())
+		require.ErrorContains(t, err, "start line must be before the line")
+	})
+
+	t.Run("path changed by the pull request -> ok", func(t *testing.T) {
+		transport := filesTransport()
+		require.NoError(t, setup(t, config(map[string]any{"startLine": "8"}), transport))
+		assert.Len(t, transport.requests, 3)
+	})
+
+	t.Run("path not changed by the pull request -> error", func(t *testing.T) {
+		err := setup(t, config(map[string]any{"path": "docs/index.md"}), filesTransport())
+		require.ErrorContains(t, err, "docs/index.md is not changed by pull request 42")
+	})
+
+	t.Run("expressions -> path is not checked", func(t *testing.T) {
+		transport := filesTransport()
+		require.NoError(t, setup(t, config(map[string]any{"pullNumber": "{{$.data.number}}", "line": "{{$.data.line}}"}), transport))
+		assert.Empty(t, transport.requests)
+	})
+}
+
+func Test__CreateReviewComment__Create(t *testing.T) {
+	t.Run("multi-line comment on the head commit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"number":42,"head":{"sha":"abc123"}}`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"commit_id":"abc123","path":"src/main.go","line":10,"side":"RIGHT","start_line":8,"start_side":"RIGHT","body":"Unused variable"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"id":1,"html_url":"https://github.com/testhq/hello/pull/42#discussion_r1"}`), nil
+		}}
+
+		request, err := buildReviewCommentRequest(CreateReviewCommentConfiguration{
+			PullNumber: "42",
+			Path:       "src/main.go",
+			Line:       "10",
+			StartLine:  "8",
+			Body:       "Unused variable",
+		})
+
+		require.NoError(t, err)
+		comment, err := createReviewComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/pulls/42/comments", transport.requests[1].URL.Path)
+		assert.Equal(t, "https://github.com/testhq/hello/pull/42#discussion_r1", comment.GetHTMLURL())
+	})
+
+	t.Run("single-line comment on the given commit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"commit_id":"def456","path":"src/main.go","line":3,"side":"LEFT","body":"Why remove this?"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"id":2}`), nil
+		}}
+
+		request, err := buildReviewCommentRequest(CreateReviewCommentConfiguration{
+			PullNumber: "42",
+			CommitID:   "def456",
+			Path:       "src/main.go",
+			Line:       "3",
+			Side:       DiffSideLeft,
+			Body:       "Why remove this?",
+		})
+
+		require.NoError(t, err)
+		_, err = createReviewComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", request)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("line outside the diff -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusUnprocessableEntity, `{"message":"Validation Failed","errors":[{"message":"line could not be resolved"}]}`), nil
+		}}
+
+		_, err := createReviewComment(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", &ReviewCommentRequest{PullNumber: 42, CommitID: "abc123", Path: "src/main.go", Line: 500, Side: DiffSideRight})
+		require.ErrorContains(t, err, "failed to comment on src/main.go in pull request 42")
+	})
+}
+
+func Test__CreateReviewComment__ParseLines(t *testing.T) {
+	t.Run("start side defaults to the side", func(t *testing.T) {
+		request, err := parseReviewCommentLines(CreateReviewCommentConfiguration{Line: "5", Side: DiffSideLeft, StartLine: "2"})
+		require.NoError(t, err)
+		assert.Equal(t, DiffSideLeft, request.StartSide)
+	})
+
+	t.Run("start line on the other side can be after the line", func(t *testing.T) {
+		_, err := parseReviewCommentLines(CreateReviewCommentConfiguration{Line: "5", StartLine: "9", StartSide: DiffSideLeft})
+		require.NoError(t, err)
+	})
+
+	t.Run("start side without start line -> error", func(t *testing.T) {
+		_, err := parseReviewCommentLines(CreateReviewCommentConfiguration{Line: "5", StartSide: DiffSideLeft})
+		require.ErrorContains(t, err, "start line is required when a start side is set")
+	})
+
+	t.Run("line is not a number -> error", func(t *testing.T) {
+		_, err := parseReviewCommentLines(CreateReviewCommentConfiguration{Line: "ten"})
+		require.ErrorContains(t, err, "line is not a number")
+	})
+}
@@ -0,0 +1,366 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreateSignedCommit struct{}
+
+type CreateSignedCommitConfiguration struct {
+	Repository      string             `json:"repository" mapstructure:"repository"`
+	Branch          string             `json:"branch" mapstructure:"branch"`
+	ExpectedHeadOID string             `json:"expectedHeadOid" mapstructure:"expectedHeadOid"`
+	Message         string             `json:"message" mapstructure:"message"`
+	Additions       []CommitFileChange `json:"additions" mapstructure:"additions"`
+	Deletions       []CommitFileChange `json:"deletions" mapstructure:"deletions"`
+}
+
+type CommitFileChange struct {
+	Path    string `json:"path" mapstructure:"path"`
+	Content string `json:"content,omitempty" mapstructure:"content"`
+}
+
+type SignedCommitOutput struct {
+	OID       string `json:"oid"`
+	URL       string `json:"url"`
+	Branch    string `json:"branch"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+func (c *CreateSignedCommit) Name() string {
+	return "github.createSignedCommit"
+}
+
+func (c *CreateSignedCommit) Label() string {
+	return "Create Signed Commit"
+}
+
+func (c *CreateSignedCommit) Description() string {
+	return "Create a commit signed by GitHub on a branch, adding and deleting files"
+}
+
+func (c *CreateSignedCommit) Documentation() string {
+	return `The Create Signed Commit component creates a commit on a branch with the GraphQL ` + "`createCommitOnBranch`" + ` mutation. GitHub signs the commits it creates this way, so they are shown as verified.
+
+## Use Cases
+
+- **Signed commit policies**: Commit generated files, like changelogs or version bumps, to branches that require signed commits
+- **Configuration updates**: Change several files in a single commit
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Branch**: The branch to commit to (supports expressions)
+- **Expected Head OID**: The commit SHA the branch must point to. The commit is only created on top of it (supports expressions)
+- **Message**: The commit message. The first line is the headline, and the rest is the body
+- **Additions**: Files to create or replace, each with its path and full content
+- **Deletions**: Paths of files to delete
+
+## Output
+
+Emits a ` + "`github.signedCommit`" + ` event with the ` + "`oid`" + ` and ` + "`url`" + ` of the new commit, the ` + "`branch`" + `, and the number of ` + "`additions`" + ` and ` + "`deletions`" + `.
+
+## Notes
+
+- If the branch moved since **Expected Head OID** was read, GitHub rejects the commit. The execution fails with a retryable ` + "`stale_head`" + ` error, so read the head again and retry
+- File contents are committed as they are, with no line ending conversion
+- Deleting a path that does not exist on the branch fails the commit`
+}
+
+func (c *CreateSignedCommit) Icon() string {
+	return "github"
+}
+
+func (c *CreateSignedCommit) Color() string {
+	return "gray"
+}
+
+func (c *CreateSignedCommit) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateSignedCommit) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "branch",
+			Label:       "Branch",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., main",
+		},
+		{
+			Name:        "expectedHeadOid",
+			Label:       "Expected Head OID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.sha}}",
+			Description: "The commit SHA the branch must point to",
+		},
+		{
+			Name:     "message",
+			Label:    "Message",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		{
+			Name:  "additions",
+			Label: "Additions",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "File",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "path",
+								Label:    "Path",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:  "content",
+								Label: "Content",
+								Type:  configuration.FieldTypeText,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "deletions",
+			Label: "Deletions",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "File",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "path",
+								Label:    "Path",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateSignedCommit) Setup(ctx core.SetupContext) error {
+	var config CreateSignedCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCreateSignedCommit(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateCreateSignedCommit(config CreateSignedCommitConfiguration) error {
+	if strings.TrimSpace(config.Branch) == "" {
+		return errors.New("branch is required")
+	}
+
+	if strings.TrimSpace(config.ExpectedHeadOID) == "" {
+		return errors.New("expected head OID is required")
+	}
+
+	if strings.TrimSpace(config.Message) == "" {
+		return errors.New("message is required")
+	}
+
+	if len(config.Additions) == 0 && len(config.Deletions) == 0 {
+		return errors.New("at least one addition or deletion is required")
+	}
+
+	paths := map[string]bool{}
+	for _, change := range append(append([]CommitFileChange{}, config.Additions...), config.Deletions...) {
+		if strings.TrimSpace(change.Path) == "" {
+			return errors.New("file path is required")
+		}
+
+		//
+		// GitHub rejects the commit if a path is changed twice.
+		//
+		if paths[change.Path] {
+			return fmt.Errorf("path %s is changed more than once", change.Path)
+		}
+
+		paths[change.Path] = true
+	}
+
+	return nil
+}
+
+func (c *CreateSignedCommit) Execute(ctx core.ExecutionContext) error {
+	var config CreateSignedCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCreateSignedCommit(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := createSignedCommit(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Created commit %s on %s", output.OID, output.Branch)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.signedCommit",
+		[]any{output},
+	)
+}
+
+func createSignedCommit(client *githubv4.Client, owner string, config CreateSignedCommitConfiguration) (*SignedCommitOutput, error) {
+	var mutation struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				OID string `graphql:"oid"`
+				URL string
+			}
+		} `graphql:"createCommitOnBranch(input: $input)"`
+	}
+
+	additions := []githubv4.FileAddition{}
+	for _, addition := range config.Additions {
+		additions = append(additions, githubv4.FileAddition{
+			Path:     githubv4.String(addition.Path),
+			Contents: githubv4.Base64String(base64.StdEncoding.EncodeToString([]byte(addition.Content))),
+		})
+	}
+
+	deletions := []githubv4.FileDeletion{}
+	for _, deletion := range config.Deletions {
+		deletions = append(deletions, githubv4.FileDeletion{Path: githubv4.String(deletion.Path)})
+	}
+
+	headline, body, _ := strings.Cut(strings.TrimSpace(config.Message), "\n")
+	message := githubv4.CommitMessage{Headline: githubv4.String(strings.TrimSpace(headline))}
+	if body = strings.TrimSpace(body); body != "" {
+		message.Body = githubv4.NewString(githubv4.String(body))
+	}
+
+	input := githubv4.CreateCommitOnBranchInput{
+		Branch: githubv4.CommittableBranch{
+			RepositoryNameWithOwner: githubv4.NewString(githubv4.String(owner + "/" + config.Repository)),
+			BranchName:              githubv4.NewString(githubv4.String(config.Branch)),
+		},
+		Message:         message,
+		ExpectedHeadOid: githubv4.GitObjectID(config.ExpectedHeadOID),
+		FileChanges: &githubv4.FileChanges{
+			Additions: &additions,
+			Deletions: &deletions,
+		},
+	}
+
+	if err := client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+		return nil, fmt.Errorf("failed to create commit on %s: %w", config.Branch, wrapCommitError(err, config))
+	}
+
+	return &SignedCommitOutput{
+		OID:       mutation.CreateCommitOnBranch.Commit.OID,
+		URL:       mutation.CreateCommitOnBranch.Commit.URL,
+		Branch:    config.Branch,
+		Additions: len(additions),
+		Deletions: len(deletions),
+	}, nil
+}
+
+/*
+ * GitHub rejects the mutation when the branch does not point to the expected head,
+ * with a GraphQL error saying the branch was expected to point to it.
+ */
+func wrapCommitError(err error, config CreateSignedCommitConfiguration) error {
+	if strings.Contains(strings.ToLower(err.Error()), "expected branch to point to") {
+		return fmt.Errorf("%w: %s no longer points to %s: %w", ErrStaleHead, config.Branch, config.ExpectedHeadOID, err)
+	}
+
+	return err
+}
+
+func (c *CreateSignedCommit) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateSignedCommit) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateSignedCommit) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateSignedCommit) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateSignedCommit) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateSignedCommit) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateSignedCommit__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateSignedCommit{}
+
+	configuration := func(overrides map[string]any) map[string]any {
+		config := map[string]any{
+			"repository":      "hello",
+			"branch":          "main",
+			"expectedHeadOid": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"message":         "Bump version",
+			"additions":       []any{map[string]any{"path": "VERSION", "content": "1.2.0\n"}},
+		}
+
+		for key, value := range overrides {
+			config[key] = value
+		}
+
+		return config
+	}
+
+	t.Run("expected head OID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration(map[string]any{"expectedHeadOid": ""}),
+		})
+
+		require.ErrorContains(t, err, "expected head OID is required")
+	})
+
+	t.Run("file changes are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration(map[string]any{"additions": []any{}}),
+		})
+
+		require.ErrorContains(t, err, "at least one addition or deletion is required")
+	})
+
+	t.Run("path changed twice -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration(map[string]any{"deletions": []any{map[string]any{"path": "VERSION"}}}),
+		})
+
+		require.ErrorContains(t, err, "path VERSION is changed more than once")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: configuration(nil),
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateSignedCommit__Create(t *testing.T) {
+	config := CreateSignedCommitConfiguration{
+		Repository:      "hello",
+		Branch:          "main",
+		ExpectedHeadOID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Message:         "Bump version\n\nReleased by the release workflow",
+		Additions:       []CommitFileChange{{Path: "VERSION", Content: "1.2.0\n"}},
+		Deletions:       []CommitFileChange{{Path: "VERSION.old"}},
+	}
+
+	t.Run("commit is created on the branch", func(t *testing.T) {
+		var input map[string]any
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			var body struct {
+				Variables struct {
+					Input map[string]any `json:"input"`
+				} `json:"variables"`
+			}
+
+			data, _ := io.ReadAll(request.Body)
+			require.NoError(t, json.Unmarshal(data, &body))
+			input = body.Variables.Input
+			return mockResponse(http.StatusOK, `{"data":{"createCommitOnBranch":{"commit":{"oid":"7638417db6d59f3c431d3e1f261cc637155684cd","url":"https://github.com/testhq/hello/commit/7638417"}}}}`), nil
+		}}
+
+		output, err := createSignedCommit(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Equal(t, &SignedCommitOutput{
+			OID:       "7638417db6d59f3c431d3e1f261cc637155684cd",
+			URL:       "https://github.com/testhq/hello/commit/7638417",
+			Branch:    "main",
+			Additions: 1,
+			Deletions: 1,
+		}, output)
+
+		assert.Equal(t, map[string]any{"repositoryNameWithOwner": "testhq/hello", "branchName": "main"}, input["branch"])
+		assert.Equal(t, map[string]any{"headline": "Bump version", "body": "Released by the release workflow"}, input["message"])
+		assert.Equal(t, "6dcb09b5b57875f334f61aebed695e2e4193db5e", input["expectedHeadOid"])
+		assert.Equal(t, map[string]any{
+			"additions": []any{map[string]any{"path": "VERSION", "contents": base64.StdEncoding.EncodeToString([]byte("1.2.0\n"))}},
+			"deletions": []any{map[string]any{"path": "VERSION.old"}},
+		}, input["fileChanges"])
+	})
+
+	t.Run("stale head -> retryable error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"data":{"createCommitOnBranch":null},"errors":[{"type":"STALE_DATA","message":"Expected branch to point to \"6dcb09b5b57875f334f61aebed695e2e4193db5e\" but it did not. Pull and try again."}]}`), nil
+		}}
+
+		_, err := createSignedCommit(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrStaleHead)
+		assert.ErrorContains(t, err, "main no longer points to 6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	})
+}
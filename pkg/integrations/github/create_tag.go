@@ -0,0 +1,296 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreateTag struct{}
+
+type CreateTagConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	Tag         string `json:"tag" mapstructure:"tag"`
+	Message     string `json:"message" mapstructure:"message"`
+	Object      string `json:"object" mapstructure:"object"`
+	Type        string `json:"type" mapstructure:"type"`
+	TaggerName  string `json:"taggerName" mapstructure:"taggerName"`
+	TaggerEmail string `json:"taggerEmail" mapstructure:"taggerEmail"`
+}
+
+func (c *CreateTag) Name() string {
+	return "github.createTag"
+}
+
+func (c *CreateTag) Label() string {
+	return "Create Tag"
+}
+
+func (c *CreateTag) Description() string {
+	return "Create a Git tag in a GitHub repository"
+}
+
+func (c *CreateTag) Documentation() string {
+	return `The Create Tag component creates a Git tag in a GitHub repository.
+
+## Use Cases
+
+- **Release flows**: Tag a commit before creating a release
+- **Versioning**: Mark deployed commits with a version tag
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Tag**: Name of the tag, e.g. ` + "`v1.2.3`" + `
+- **Message**: Tag message. If set, an annotated tag is created. If empty, a lightweight tag is created
+- **Object**: SHA of the Git object to tag
+- **Type**: Type of the tagged object: commit, tree, or blob
+- **Tagger Name** and **Tagger Email**: Optional tagger information for annotated tags
+
+## Output
+
+Returns the tag name, the tag SHA, and the ref that was created.
+For annotated tags, the tag SHA is the SHA of the tag object.
+For lightweight tags, it is the SHA of the tagged object.`
+}
+
+func (c *CreateTag) Icon() string {
+	return "github"
+}
+
+func (c *CreateTag) Color() string {
+	return "gray"
+}
+
+func (c *CreateTag) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateTag) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "tag",
+			Label:       "Tag",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., v1.2.3",
+		},
+		{
+			Name:        "message",
+			Label:       "Message",
+			Type:        configuration.FieldTypeText,
+			Description: "If set, an annotated tag is created. Otherwise, a lightweight tag is created.",
+		},
+		{
+			Name:        "object",
+			Label:       "Object SHA",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.head_commit.id}}",
+		},
+		{
+			Name:     "type",
+			Label:    "Object Type",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "commit",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Commit", Value: "commit"},
+						{Label: "Tree", Value: "tree"},
+						{Label: "Blob", Value: "blob"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "taggerName",
+			Label: "Tagger Name",
+			Type:  configuration.FieldTypeString,
+		},
+		{
+			Name:  "taggerEmail",
+			Label: "Tagger Email",
+			Type:  configuration.FieldTypeString,
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateTag) Setup(ctx core.SetupContext) error {
+	var config CreateTagConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Tag == "" {
+		return errors.New("tag is required")
+	}
+
+	if config.Object == "" {
+		return errors.New("object is required")
+	}
+
+	if !isExpression(config.Object) && !shaRegex.MatchString(config.Object) {
+		return fmt.Errorf("invalid object SHA format: expected 40-character hexadecimal string, got %q", config.Object)
+	}
+
+	if config.Type != "" && !slices.Contains([]string{"commit", "tree", "blob"}, config.Type) {
+		return fmt.Errorf("invalid object type: %s", config.Type)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreateTag) Execute(ctx core.ExecutionContext) error {
+	var config CreateTagConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if !shaRegex.MatchString(config.Object) {
+		return fmt.Errorf("invalid object SHA format: expected 40-character hexadecimal string, got %q", config.Object)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.tag", func() (any, error) {
+		//
+		// Lightweight tags are just refs pointing to the object.
+		// Annotated tags need a tag object first, and the ref points to it.
+		//
+		sha := config.Object
+		annotated := config.Message != ""
+		if annotated {
+			tag, _, err := client.Git.CreateTag(
+				context.Background(),
+				appMetadata.Owner,
+				config.Repository,
+				c.buildTag(config),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to create tag object: %w", err)
+			}
+
+			sha = tag.GetSHA()
+		}
+
+		ref, _, err := client.Git.CreateRef(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			&github.Reference{
+				Ref:    github.Ptr("refs/tags/" + config.Tag),
+				Object: &github.GitObject{SHA: &sha},
+			},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tag ref: %w", err)
+		}
+
+		return map[string]any{
+			"tag":        config.Tag,
+			"sha":        sha,
+			"ref":        ref.GetRef(),
+			"object":     config.Object,
+			"annotated":  annotated,
+			"repository": config.Repository,
+		}, nil
+	})
+}
+
+func (c *CreateTag) buildTag(config CreateTagConfiguration) *github.Tag {
+	objectType := config.Type
+	if objectType == "" {
+		objectType = "commit"
+	}
+
+	tag := &github.Tag{
+		Tag:     &config.Tag,
+		Message: &config.Message,
+		Object: &github.GitObject{
+			SHA:  &config.Object,
+			Type: &objectType,
+		},
+	}
+
+	if config.TaggerName != "" && config.TaggerEmail != "" {
+		tag.Tagger = &github.CommitAuthor{
+			Name:  &config.TaggerName,
+			Email: &config.TaggerEmail,
+			Date:  &github.Timestamp{Time: time.Now()},
+		}
+	}
+
+	return tag
+}
+
+func (c *CreateTag) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateTag) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateTag) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateTag) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateTag) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateTag) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateTag__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateTag{}
+	sha := "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+
+	t.Run("tag is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "object": sha},
+		})
+
+		require.ErrorContains(t, err, "tag is required")
+	})
+
+	t.Run("invalid SHA -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "tag": "v1.0.0", "object": "main"},
+		})
+
+		require.ErrorContains(t, err, "invalid object SHA format")
+	})
+
+	t.Run("invalid object type -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "tag": "v1.0.0", "object": sha, "type": "branch"},
+		})
+
+		require.ErrorContains(t, err, "invalid object type")
+	})
+
+	t.Run("expression object -> metadata is set", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "tag": "v1.0.0", "object": "{{$.data.head_commit.id}}"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateTag__BuildTag(t *testing.T) {
+	component := CreateTag{}
+
+	t.Run("defaults to commit type without tagger", func(t *testing.T) {
+		tag := component.buildTag(CreateTagConfiguration{
+			Tag:     "v1.0.0",
+			Message: "Release v1.0.0",
+			Object:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		})
+
+		assert.Equal(t, "v1.0.0", tag.GetTag())
+		assert.Equal(t, "Release v1.0.0", tag.GetMessage())
+		assert.Equal(t, "commit", tag.GetObject().GetType())
+		assert.Nil(t, tag.Tagger)
+	})
+
+	t.Run("includes tagger when name and email are set", func(t *testing.T) {
+		tag := component.buildTag(CreateTagConfiguration{
+			Tag:         "v1.0.0",
+			Message:     "Release v1.0.0",
+			Object:      "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			Type:        "tree",
+			TaggerName:  "Release Bot",
+			TaggerEmail: "bot@example.com",
+		})
+
+		assert.Equal(t, "tree", tag.GetObject().GetType())
+		require.NotNil(t, tag.Tagger)
+		assert.Equal(t, "Release Bot", tag.Tagger.GetName())
+		assert.Equal(t, "bot@example.com", tag.Tagger.GetEmail())
+	})
+}
@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreateTeamDiscussion struct{}
+
+type CreateTeamDiscussionConfiguration struct {
+	Organization string `json:"organization" mapstructure:"organization"`
+	TeamSlug     string `json:"teamSlug" mapstructure:"teamSlug"`
+	Title        string `json:"title" mapstructure:"title"`
+	Body         string `json:"body" mapstructure:"body"`
+	Private      bool   `json:"private" mapstructure:"private"`
+}
+
+func (c *CreateTeamDiscussion) Name() string {
+	return "github.createTeamDiscussion"
+}
+
+func (c *CreateTeamDiscussion) Label() string {
+	return "Create Team Discussion"
+}
+
+func (c *CreateTeamDiscussion) Description() string {
+	return "Create a discussion on a GitHub team page"
+}
+
+func (c *CreateTeamDiscussion) Documentation() string {
+	return `The Create Team Discussion component creates a discussion on the page of a GitHub organization team, notifying its members.
+
+## Use Cases
+
+- **Team notifications**: Notify a team about incidents, releases, or required reviews
+- **Announcements**: Post organization-wide updates to the teams they concern
+
+## Configuration
+
+- **Organization**: The GitHub organization. Defaults to the organization the GitHub app is installed in
+- **Team Slug**: The slug of the team, for example ` + "`platform-team`" + `
+- **Title**: The discussion title (supports expressions)
+- **Body**: The discussion body (supports markdown and expressions)
+- **Private**: Only allow team members and organization owners to see the discussion
+
+## Output
+
+Emits the ` + "`number`" + ` and ` + "`html_url`" + ` of the created discussion.
+
+## Notes
+
+- The GitHub app needs the **Members** (read) and **Team discussions** (write) organization permissions
+- When the organization and team slug are not expressions, the team is checked to exist when the canvas is saved`
+}
+
+func (c *CreateTeamDiscussion) Icon() string {
+	return "github"
+}
+
+func (c *CreateTeamDiscussion) Color() string {
+	return "gray"
+}
+
+func (c *CreateTeamDiscussion) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateTeamDiscussion) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., acme",
+			Description: "Defaults to the organization the GitHub app is installed in",
+		},
+		{
+			Name:        "teamSlug",
+			Label:       "Team Slug",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., platform-team",
+		},
+		{
+			Name:     "title",
+			Label:    "Title",
+			Type:     configuration.FieldTypeString,
+			Required: true,
+		},
+		{
+			Name:     "body",
+			Label:    "Body",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		{
+			Name:        "private",
+			Label:       "Private",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only allow team members and organization owners to see the discussion",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *CreateTeamDiscussion) Setup(ctx core.SetupContext) error {
+	return c.setup(ctx, integrationTransport(ctx.Integration))
+}
+
+func (c *CreateTeamDiscussion) setup(ctx core.SetupContext, transport http.RoundTripper) error {
+	var config CreateTeamDiscussionConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.TeamSlug == "" {
+		return errors.New("team slug is required")
+	}
+
+	if config.Title == "" {
+		return errors.New("title is required")
+	}
+
+	if config.Body == "" {
+		return errors.New("body is required")
+	}
+
+	//
+	// Expressions are only resolved on execution,
+	// so the team can only be checked here when none are used.
+	//
+	if strings.Contains(config.Organization, "{{") || strings.Contains(config.TeamSlug, "{{") {
+		return nil
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	organization := teamOrganization(config, appMetadata)
+	_, _, err = client.Teams.GetTeamBySlug(context.Background(), organization, config.TeamSlug)
+	return teamError(err, organization, config.TeamSlug)
+}
+
+func (c *CreateTeamDiscussion) Execute(ctx core.ExecutionContext) error {
+	var config CreateTeamDiscussionConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	organization := teamOrganization(config, appMetadata)
+	return withIdempotency(ctx, "github.teamDiscussion", func() (any, error) {
+		discussion, _, err := client.Teams.CreateDiscussionBySlug(
+			context.Background(),
+			organization,
+			config.TeamSlug,
+			github.TeamDiscussion{
+				Title:   github.Ptr(config.Title),
+				Body:    github.Ptr(config.Body),
+				Private: github.Ptr(config.Private),
+			},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create team discussion: %w", teamError(err, organization, config.TeamSlug))
+		}
+
+		return map[string]any{
+			"organization": organization,
+			"team":         config.TeamSlug,
+			"number":       discussion.GetNumber(),
+			"title":        discussion.GetTitle(),
+			"private":      discussion.GetPrivate(),
+			"html_url":     discussion.GetHTMLURL(),
+		}, nil
+	})
+}
+
+func teamOrganization(config CreateTeamDiscussionConfiguration, appMetadata Metadata) string {
+	if config.Organization != "" {
+		return config.Organization
+	}
+
+	return appMetadata.Owner
+}
+
+/*
+ * Without the organization permissions for teams,
+ * GitHub answers with a 403, or with a 404, as if the team did not exist.
+ */
+func teamError(err error, organization, teamSlug string) error {
+	if err == nil {
+		return nil
+	}
+
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf(
+			"the GitHub app cannot access teams in %s, grant it the Members (read) and Team discussions (write) organization permissions: %w",
+			organization,
+			err,
+		)
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		return fmt.Errorf(
+			"team %s not found in %s, or the GitHub app lacks the Members (read) organization permission: %w",
+			teamSlug,
+			organization,
+			err,
+		)
+	}
+
+	return err
+}
+
+func (c *CreateTeamDiscussion) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateTeamDiscussion) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateTeamDiscussion) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateTeamDiscussion) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateTeamDiscussion) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateTeamDiscussion) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
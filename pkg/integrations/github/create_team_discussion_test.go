@@ -0,0 +1,80 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateTeamDiscussion__Setup(t *testing.T) {
+	component := CreateTeamDiscussion{}
+	config := map[string]any{"teamSlug": "platform-team", "title": "Release", "body": "Release 1.4.0 is out"}
+
+	teamTransport := func(status int, body string) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/orgs/acme/teams/platform-team":
+				return mockResponse(status, body), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	t.Run("team slug is required", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Configuration: map[string]any{"title": "Release", "body": "Release 1.4.0 is out"},
+		}, teamTransport(http.StatusOK, `{}`))
+
+		require.ErrorContains(t, err, "team slug is required")
+	})
+
+	t.Run("expression -> team is not checked", func(t *testing.T) {
+		transport := teamTransport(http.StatusOK, `{}`)
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: map[string]any{"teamSlug": "{{$.data.team}}", "title": "Release", "body": "Release 1.4.0 is out"},
+		}, transport))
+
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("team exists -> ok", func(t *testing.T) {
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: config,
+		}, teamTransport(http.StatusOK, `{"id":1,"slug":"platform-team"}`)))
+	})
+
+	t.Run("team does not exist -> error", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: config,
+		}, teamTransport(http.StatusNotFound, `{"message":"Not Found"}`))
+
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Contains(t, err.Error(), "team platform-team not found in acme")
+	})
+
+	t.Run("missing team permissions -> error with guidance", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "acme"),
+			Configuration: config,
+		}, teamTransport(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`))
+
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Contains(t, err.Error(), "Members (read)")
+	})
+}
+
+func Test__CreateTeamDiscussion__TeamOrganization(t *testing.T) {
+	appMetadata := Metadata{Owner: "acme"}
+	assert.Equal(t, "acme", teamOrganization(CreateTeamDiscussionConfiguration{}, appMetadata))
+	assert.Equal(t, "other", teamOrganization(CreateTeamDiscussionConfiguration{Organization: "other"}, appMetadata))
+}
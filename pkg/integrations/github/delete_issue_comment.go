@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type DeleteIssueComment struct{}
+
+type DeleteIssueCommentConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	CommentID  string `json:"commentId" mapstructure:"commentId"`
+}
+
+func (c *DeleteIssueComment) Name() string {
+	return "github.deleteIssueComment"
+}
+
+func (c *DeleteIssueComment) Label() string {
+	return "Delete Issue Comment"
+}
+
+func (c *DeleteIssueComment) Description() string {
+	return "Delete a comment on a GitHub issue or pull request"
+}
+
+func (c *DeleteIssueComment) Documentation() string {
+	return `The Delete Issue Comment component deletes a comment from a GitHub issue or pull request.
+
+## Use Cases
+
+- **Moderation**: Remove spam or abusive comments
+- **Cleanup**: Remove bot comments that are no longer relevant
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Comment ID**: The numeric ID of the comment to delete (supports expressions)
+
+## Output
+
+Returns a confirmation with the ID of the deleted comment, so audit logs can record it.
+
+## Notes
+
+- If the comment does not exist anymore, the component succeeds without doing anything, and ` + "`already_deleted`" + ` is set in the output`
+}
+
+func (c *DeleteIssueComment) Icon() string {
+	return "github"
+}
+
+func (c *DeleteIssueComment) Color() string {
+	return "gray"
+}
+
+func (c *DeleteIssueComment) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *DeleteIssueComment) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "commentId",
+			Label:       "Comment ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.comment.id}}",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *DeleteIssueComment) Setup(ctx core.SetupContext) error {
+	var config DeleteIssueCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.CommentID == "" {
+		return errors.New("comment ID is required")
+	}
+
+	if !isExpression(config.CommentID) {
+		if _, err := strconv.ParseInt(config.CommentID, 10, 64); err != nil {
+			return fmt.Errorf("comment ID is not a number: %s", config.CommentID)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *DeleteIssueComment) Execute(ctx core.ExecutionContext) error {
+	var config DeleteIssueCommentConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	commentID, err := strconv.ParseInt(config.CommentID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("comment ID is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	alreadyDeleted := false
+	response, err := client.Issues.DeleteComment(
+		context.Background(),
+		appMetadata.Owner,
+		config.Repository,
+		commentID,
+	)
+
+	if err != nil {
+		if !isNotFound(response) {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		ctx.Logger.Infof("Comment %d not found - nothing to delete", commentID)
+		alreadyDeleted = true
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issueComment.deleted",
+		[]any{map[string]any{
+			"comment_id":      commentID,
+			"repository":      config.Repository,
+			"already_deleted": alreadyDeleted,
+			"deleted_at":      time.Now().Format(time.RFC3339),
+		}},
+	)
+}
+
+func isNotFound(response *github.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+func (c *DeleteIssueComment) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *DeleteIssueComment) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *DeleteIssueComment) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *DeleteIssueComment) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *DeleteIssueComment) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *DeleteIssueComment) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
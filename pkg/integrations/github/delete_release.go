@@ -137,6 +137,7 @@ func (c *DeleteRelease) Configuration() []configuration.Field {
 			Default:     true,
 			Description: "When enabled, also deletes the associated Git tag from the repository",
 		},
+		ConcurrencyKeyField,
 	}
 }
 
@@ -164,15 +165,26 @@ func (c *DeleteRelease) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
 
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
 	//
 	// Fetch the release based on the selected strategy
 	//
-	release, err := fetchReleaseByStrategy(client, appMetadata.Owner, config.Repository, config.ReleaseStrategy, config.TagName)
+	release, err := fetchReleaseByStrategy(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.ReleaseStrategy, config.TagName)
 	if err != nil {
 		return err
 	}
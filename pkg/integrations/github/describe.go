@@ -0,0 +1,87 @@
+package github
+
+import (
+	"slices"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * ComponentDescriptor describes the inputs and outputs of a component,
+ * so tooling can generate forms and validate wiring without running it.
+ */
+type ComponentDescriptor struct {
+	Name           string                `json:"name"`
+	Label          string                `json:"label"`
+	Description    string                `json:"description"`
+	Configuration  []configuration.Field `json:"configuration"`
+	OutputChannels []ChannelDescriptor   `json:"outputChannels"`
+	EventTypes     []string              `json:"eventTypes"`
+}
+
+type ChannelDescriptor struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+}
+
+/*
+ * Components that emit more than one event type,
+ * like the list components, with one type per emit mode,
+ * declare all the types they can emit.
+ */
+type eventTypeDeclarer interface {
+	EventTypes() []string
+}
+
+/*
+ * Describe returns the descriptors of all GitHub components.
+ */
+func Describe() []ComponentDescriptor {
+	components := (&GitHub{}).Components()
+	descriptors := make([]ComponentDescriptor, 0, len(components))
+	for _, component := range components {
+		descriptors = append(descriptors, describeComponent(component))
+	}
+
+	return descriptors
+}
+
+/*
+ * GitHub components have the same output channels for any configuration,
+ * so they are described for the default one.
+ * Event types are the ones declared by the component,
+ * or the type of its example output, when it only emits one,
+ * plus the skipped event for components that support run if conditions.
+ */
+func describeComponent(component core.Component) ComponentDescriptor {
+	descriptor := ComponentDescriptor{
+		Name:           component.Name(),
+		Label:          component.Label(),
+		Description:    component.Description(),
+		Configuration:  core.WithRunIf(component.Configuration()),
+		OutputChannels: []ChannelDescriptor{},
+		EventTypes:     []string{},
+	}
+
+	for _, channel := range core.WithSkippedChannel(component.OutputChannels(nil)) {
+		descriptor.OutputChannels = append(descriptor.OutputChannels, ChannelDescriptor{
+			Name:        channel.Name,
+			Label:       channel.Label,
+			Description: channel.Description,
+		})
+	}
+
+	if declarer, ok := component.(eventTypeDeclarer); ok {
+		descriptor.EventTypes = append(descriptor.EventTypes, declarer.EventTypes()...)
+	} else if eventType, ok := component.ExampleOutput()["type"].(string); ok && eventType != "" {
+		descriptor.EventTypes = append(descriptor.EventTypes, eventType)
+	}
+
+	if core.SupportsRunIf(descriptor.Configuration) && !slices.Contains(descriptor.EventTypes, core.SkippedPayloadType) {
+		descriptor.EventTypes = append(descriptor.EventTypes, core.SkippedPayloadType)
+	}
+
+	return descriptor
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func Test__Describe(t *testing.T) {
+	descriptors := Describe()
+	require.Len(t, descriptors, len((&GitHub{}).Components()))
+
+	t.Run("create issue comment descriptor matches the component", func(t *testing.T) {
+		component := &CreateIssueComment{}
+		index := slices.IndexFunc(descriptors, func(d ComponentDescriptor) bool { return d.Name == component.Name() })
+		require.NotEqual(t, -1, index)
+
+		descriptor := descriptors[index]
+		assert.Equal(t, component.Label(), descriptor.Label)
+		assert.Equal(t, component.Description(), descriptor.Description)
+		assert.Equal(t, core.WithRunIf(component.Configuration()), descriptor.Configuration)
+		assert.Equal(t, []ChannelDescriptor{
+			{Name: core.DefaultOutputChannel.Name, Label: core.DefaultOutputChannel.Label},
+			{Name: core.ErrorOutputChannel.Name, Label: core.ErrorOutputChannel.Label, Description: core.ErrorOutputChannel.Description},
+			{Name: core.SkippedOutputChannel.Name, Label: core.SkippedOutputChannel.Label, Description: core.SkippedOutputChannel.Description},
+		}, descriptor.OutputChannels)
+		assert.Equal(t, []string{component.ExampleOutput()["type"].(string), core.ErrorPayloadType, core.SkippedPayloadType}, descriptor.EventTypes)
+	})
+
+	t.Run("declared event types are listed", func(t *testing.T) {
+		for name, expected := range map[string][]string{
+			"github.listIssues":      {"github.issues", "github.issue"},
+			"github.waitForCheckRun": {CheckRunPayloadType, CheckRunTimeoutPayloadType},
+		} {
+			index := slices.IndexFunc(descriptors, func(d ComponentDescriptor) bool { return d.Name == name })
+			require.NotEqual(t, -1, index, name)
+			for _, eventType := range expected {
+				assert.Contains(t, descriptors[index].EventTypes, eventType, name)
+			}
+		}
+	})
+
+	t.Run("event types include the example output type", func(t *testing.T) {
+		for i, component := range (&GitHub{}).Components() {
+			if eventType, ok := component.ExampleOutput()["type"].(string); ok && eventType != "" {
+				assert.Contains(t, descriptors[i].EventTypes, eventType, component.Name())
+			}
+		}
+	})
+
+	t.Run("output channels do not depend on the configuration", func(t *testing.T) {
+		for _, component := range (&GitHub{}).Components() {
+			expected := component.OutputChannels(nil)
+			for _, field := range component.Configuration() {
+				if field.TypeOptions == nil || field.TypeOptions.Select == nil {
+					continue
+				}
+
+				for _, option := range field.TypeOptions.Select.Options {
+					channels := component.OutputChannels(map[string]any{field.Name: option.Value})
+					assert.Equal(t, expected, channels, "%s with %s=%s", component.Name(), field.Name, option.Value)
+				}
+			}
+		}
+	})
+
+	t.Run("every component has an output channel and an event type", func(t *testing.T) {
+		for _, descriptor := range descriptors {
+			assert.NotEmpty(t, descriptor.OutputChannels, descriptor.Name)
+			assert.NotEmpty(t, descriptor.EventTypes, descriptor.Name)
+		}
+	})
+
+	t.Run("descriptors are serializable", func(t *testing.T) {
+		data, err := json.Marshal(descriptors)
+		require.NoError(t, err)
+
+		var decoded []map[string]any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Len(t, decoded, len(descriptors))
+		assert.Equal(t, descriptors[0].Name, decoded[0]["name"])
+		assert.Contains(t, decoded[0], "configuration")
+		assert.Contains(t, decoded[0], "outputChannels")
+		assert.Contains(t, decoded[0], "eventTypes")
+	})
+}
@@ -0,0 +1,497 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	ConflictingPullRequestsPayloadType = "github.pullRequests.conflicting"
+	ConflictsPollAction                = "poll"
+	DefaultConflictsTimeoutMinutes     = 10
+	DefaultConflictsMaxPullRequests    = 100
+	MaxConflictsPullRequests           = 500
+
+	//
+	// How many pull requests are fetched at the same time,
+	// so a large repository does not use up the secondary rate limit.
+	//
+	ConflictsConcurrency = 5
+)
+
+type DetectConflictingPullRequests struct{}
+
+type DetectConflictingPullRequestsConfiguration struct {
+	Repository      string `json:"repository" mapstructure:"repository"`
+	Base            string `json:"base" mapstructure:"base"`
+	MaxPullRequests *int   `json:"maxPullRequests" mapstructure:"maxPullRequests"`
+	Timeout         *int   `json:"timeout" mapstructure:"timeout"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so Execute records the resolved values it needs here,
+ * along with the results of the pull requests already checked.
+ */
+type DetectConflictsMetadata struct {
+	Repository   string                   `json:"repository" mapstructure:"repository"`
+	Base         string                   `json:"base" mapstructure:"base"`
+	StartedAt    string                   `json:"startedAt" mapstructure:"startedAt"`
+	Deadline     string                   `json:"deadline" mapstructure:"deadline"`
+	PollAttempts int                      `json:"pollAttempts" mapstructure:"pollAttempts"`
+	Checked      int                      `json:"checked" mapstructure:"checked"`
+	Pending      []int                    `json:"pending" mapstructure:"pending"`
+	Conflicting  []ConflictingPullRequest `json:"conflicting" mapstructure:"conflicting"`
+	Failed       []ConflictCheckFailure   `json:"failed" mapstructure:"failed"`
+	Cancelled    bool                     `json:"cancelled,omitempty" mapstructure:"cancelled"`
+}
+
+type ConflictingPullRequest struct {
+	Number         int    `json:"number" mapstructure:"number"`
+	Title          string `json:"title" mapstructure:"title"`
+	Author         string `json:"author" mapstructure:"author"`
+	HeadRef        string `json:"head_ref" mapstructure:"head_ref"`
+	BaseRef        string `json:"base_ref" mapstructure:"base_ref"`
+	HeadSHA        string `json:"head_sha" mapstructure:"head_sha"`
+	MergeableState string `json:"mergeable_state" mapstructure:"mergeable_state"`
+	URL            string `json:"html_url" mapstructure:"html_url"`
+}
+
+type ConflictCheckFailure struct {
+	Number int    `json:"number" mapstructure:"number"`
+	Error  string `json:"error" mapstructure:"error"`
+}
+
+func (c *DetectConflictingPullRequests) Name() string {
+	return "github.detectConflictingPullRequests"
+}
+
+func (c *DetectConflictingPullRequests) Label() string {
+	return "Detect Conflicting Pull Requests"
+}
+
+func (c *DetectConflictingPullRequests) Description() string {
+	return "Find the open GitHub pull requests that conflict with their base branch"
+}
+
+func (c *DetectConflictingPullRequests) Documentation() string {
+	return `The Detect Conflicting Pull Requests component checks every open pull request of a repository,
+and emits the ones that conflict with their base branch.
+
+## Use Cases
+
+- **Rebase reminders**: After a large merge, notify the authors of the pull requests that now need a rebase
+- **Hygiene reports**: List the conflicting pull requests of a repository on a schedule
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Base Branch**: Only check pull requests into this branch. If empty, pull requests into any branch are checked
+- **Max Pull Requests**: Maximum number of open pull requests to check, most recently updated first. Defaults to 100, at most 500
+- **Timeout**: Minutes to wait for GitHub to compute the mergeability of the pull requests. Defaults to 10
+
+## Output
+
+Emits a ` + "`github.pullRequests.conflicting`" + ` event with:
+
+- ` + "`conflicting`" + `: the ` + "`number`" + `, ` + "`title`" + `, ` + "`author`" + `, ` + "`head_ref`" + `, ` + "`base_ref`" + ` and ` + "`html_url`" + ` of each conflicting pull request
+- ` + "`conflicting_count`" + ` and ` + "`checked_count`" + `, the number of pull requests checked
+- ` + "`unknown`" + `: the pull requests whose mergeability was still not computed at the timeout
+- ` + "`failed`" + `: the pull requests that could not be fetched, with the error
+
+## Notes
+
+- GitHub computes the mergeability of a pull request in the background, after it is requested. Pull requests are fetched again,
+  every 5 seconds and slowing down up to once a minute, until the mergeability of all of them is known, or the timeout is reached
+- Pull requests are fetched 5 at a time
+- Pull requests closed while checking are left out
+- If no pull request conflicts, the event is still emitted, with an empty list`
+}
+
+func (c *DetectConflictingPullRequests) Icon() string {
+	return "github"
+}
+
+func (c *DetectConflictingPullRequests) Color() string {
+	return "gray"
+}
+
+func (c *DetectConflictingPullRequests) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *DetectConflictingPullRequests) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "base",
+			Label:       "Base Branch",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Leave empty to check pull requests into any branch",
+		},
+		{
+			Name:        "maxPullRequests",
+			Label:       "Max Pull Requests",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultConflictsMaxPullRequests,
+			Description: "Maximum number of open pull requests to check",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxConflictsPullRequests; return &max }(),
+				},
+			},
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultConflictsTimeoutMinutes,
+			Description: "Minutes to wait for the mergeability to be computed",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *DetectConflictingPullRequests) Setup(ctx core.SetupContext) error {
+	var config DetectConflictingPullRequestsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.MaxPullRequests != nil && (*config.MaxPullRequests < 1 || *config.MaxPullRequests > MaxConflictsPullRequests) {
+		return fmt.Errorf("max pull requests must be between 1 and %d", MaxConflictsPullRequests)
+	}
+
+	if config.Timeout != nil && *config.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *DetectConflictingPullRequests) Execute(ctx core.ExecutionContext) error {
+	var config DetectConflictingPullRequestsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	maxPullRequests := DefaultConflictsMaxPullRequests
+	if config.MaxPullRequests != nil && *config.MaxPullRequests > 0 {
+		maxPullRequests = min(*config.MaxPullRequests, MaxConflictsPullRequests)
+	}
+
+	timeout := DefaultConflictsTimeoutMinutes * time.Minute
+	if config.Timeout != nil && *config.Timeout > 0 {
+		timeout = time.Duration(*config.Timeout) * time.Minute
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	base := strings.TrimPrefix(strings.TrimSpace(config.Base), "refs/heads/")
+	numbers, err := listOpenPullRequestNumbers(client, appMetadata.Owner, config.Repository, base, maxPullRequests)
+	if err != nil {
+		return err
+	}
+
+	startedAt := time.Now()
+	deadline := startedAt.Add(timeout)
+	metadata := DetectConflictsMetadata{
+		Repository:  config.Repository,
+		Base:        base,
+		StartedAt:   startedAt.Format(time.RFC3339),
+		Deadline:    deadline.Format(time.RFC3339),
+		Pending:     numbers,
+		Conflicting: []ConflictingPullRequest{},
+		Failed:      []ConflictCheckFailure{},
+	}
+
+	ctx.Logger.Infof("Checking %d open pull requests for conflicts", len(numbers))
+
+	//
+	// The first fetch makes GitHub start computing the mergeability
+	// of the pull requests it does not know it for yet.
+	//
+	checkPullRequestConflicts(client, appMetadata.Owner, &metadata, deadline, time.Now)
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	if len(metadata.Pending) == 0 {
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, ConflictingPullRequestsPayloadType, []any{conflictsOutput(metadata, false)})
+	}
+
+	return ctx.Requests.ScheduleActionCall(ConflictsPollAction, map[string]any{}, MergeablePollBackoff.Interval(1))
+}
+
+func (c *DetectConflictingPullRequests) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           ConflictsPollAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *DetectConflictingPullRequests) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case ConflictsPollAction:
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *DetectConflictingPullRequests) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := DetectConflictsMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	//
+	// A poll scheduled before the execution was cancelled
+	// must not schedule the next one.
+	//
+	if metadata.Cancelled {
+		return nil
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	metadata.PollAttempts++
+	timedOut := checkPullRequestConflicts(client, appMetadata.Owner, &metadata, deadline, time.Now)
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	if len(metadata.Pending) > 0 && !timedOut {
+		return ctx.Requests.ScheduleActionCall(ConflictsPollAction, map[string]any{}, MergeablePollBackoff.Interval(metadata.PollAttempts+1))
+	}
+
+	if timedOut {
+		ctx.Logger.Warnf("Mergeability of %d pull requests still unknown at the timeout", len(metadata.Pending))
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, ConflictingPullRequestsPayloadType, []any{conflictsOutput(metadata, timedOut)})
+}
+
+/*
+ * Pull requests are listed most recently updated first,
+ * so the ones most likely to be worked on are checked when there are more than the limit.
+ */
+func listOpenPullRequestNumbers(client *github.Client, owner, repo, base string, limit int) ([]int, error) {
+	opts := &github.PullRequestListOptions{
+		State:       "open",
+		Base:        base,
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	numbers := []int{}
+	for {
+		pullRequests, response, err := client.PullRequests.List(context.Background(), owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list open pull requests: %w", wrapGitHubError(err))
+		}
+
+		for _, pullRequest := range pullRequests {
+			numbers = append(numbers, pullRequest.GetNumber())
+			if len(numbers) >= limit {
+				return numbers, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return numbers, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Fetches the pending pull requests, and moves the ones whose mergeability is known
+ * out of the pending list. Returns true if the deadline was reached.
+ */
+func checkPullRequestConflicts(client *github.Client, owner string, metadata *DetectConflictsMetadata, deadline time.Time, now func() time.Time) bool {
+	var mu sync.Mutex
+	pending := []int{}
+	timedOut := false
+
+	var group errgroup.Group
+	group.SetLimit(ConflictsConcurrency)
+	for _, number := range metadata.Pending {
+		group.Go(func() error {
+			pr, _, err := client.PullRequests.Get(context.Background(), owner, metadata.Repository, number)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				metadata.Checked++
+				metadata.Failed = append(metadata.Failed, ConflictCheckFailure{
+					Number: number,
+					Error:  fmt.Sprintf("failed to get pull request: %v", wrapGitHubError(err)),
+				})
+
+				return nil
+			}
+
+			if pr.GetState() != "open" {
+				return nil
+			}
+
+			switch mergeableOutputChannel(pr, deadline, now()) {
+			case ConflictingOutputChannel:
+				metadata.Checked++
+				metadata.Conflicting = append(metadata.Conflicting, summarizeConflictingPullRequest(pr))
+			case MergeableOutputChannel:
+				metadata.Checked++
+			case MergeableTimeoutOutputChannel:
+				timedOut = true
+				pending = append(pending, number)
+			default:
+				pending = append(pending, number)
+			}
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	//
+	// Pull requests are fetched concurrently,
+	// so the results are sorted to keep them stable between polls.
+	//
+	slices.Sort(pending)
+	slices.SortFunc(metadata.Conflicting, func(a, b ConflictingPullRequest) int { return a.Number - b.Number })
+	slices.SortFunc(metadata.Failed, func(a, b ConflictCheckFailure) int { return a.Number - b.Number })
+
+	metadata.Pending = pending
+	return timedOut || (len(pending) > 0 && !now().Before(deadline))
+}
+
+func summarizeConflictingPullRequest(pr *github.PullRequest) ConflictingPullRequest {
+	return ConflictingPullRequest{
+		Number:         pr.GetNumber(),
+		Title:          pr.GetTitle(),
+		Author:         pr.GetUser().GetLogin(),
+		HeadRef:        pr.GetHead().GetRef(),
+		BaseRef:        pr.GetBase().GetRef(),
+		HeadSHA:        pr.GetHead().GetSHA(),
+		MergeableState: pr.GetMergeableState(),
+		URL:            pr.GetHTMLURL(),
+	}
+}
+
+func conflictsOutput(metadata DetectConflictsMetadata, timedOut bool) map[string]any {
+	unknown := metadata.Pending
+	if unknown == nil {
+		unknown = []int{}
+	}
+
+	return map[string]any{
+		"repository":        metadata.Repository,
+		"base":              metadata.Base,
+		"conflicting":       metadata.Conflicting,
+		"conflicting_count": len(metadata.Conflicting),
+		"checked_count":     metadata.Checked,
+		"unknown":           unknown,
+		"failed":            metadata.Failed,
+		"timed_out":         timedOut,
+		"poll_attempts":     metadata.PollAttempts,
+	}
+}
+
+func (c *DetectConflictingPullRequests) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *DetectConflictingPullRequests) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+/*
+ * Marks the check as cancelled, so pending polls stop rescheduling.
+ */
+func (c *DetectConflictingPullRequests) Cancel(ctx core.ExecutionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := DetectConflictsMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	metadata.Cancelled = true
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Stopped checking %d pull requests for conflicts", len(metadata.Pending))
+	return nil
+}
+
+func (c *DetectConflictingPullRequests) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+/*
+ * Pull requests are fetched concurrently, so requests are counted under a lock.
+ */
+type pullRequestsTransport struct {
+	mu       sync.Mutex
+	requests map[string]int
+	handler  func(path string, attempt int) *http.Response
+}
+
+func (p *pullRequestsTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.requests == nil {
+		p.requests = map[string]int{}
+	}
+
+	p.requests[request.URL.Path]++
+	return p.handler(request.URL.Path, p.requests[request.URL.Path]), nil
+}
+
+func Test__DetectConflictingPullRequests__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := DetectConflictingPullRequests{}
+
+	t.Run("invalid max pull requests -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "maxPullRequests": 501},
+		})
+
+		require.ErrorContains(t, err, "max pull requests must be between 1 and 500")
+	})
+
+	t.Run("invalid timeout -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "timeout": 0},
+		})
+
+		require.ErrorContains(t, err, "timeout must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__DetectConflictingPullRequests__ListOpen(t *testing.T) {
+	transport := &mockTransport{
+		handler: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("page") == "2" {
+				return mockResponse(http.StatusOK, `[{"number": 3}, {"number": 4}]`), nil
+			}
+
+			response := mockResponse(http.StatusOK, `[{"number": 1}, {"number": 2}]`)
+			response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/pulls?page=2>; rel="next"`)
+			return response, nil
+		},
+	}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	numbers, err := listOpenPullRequestNumbers(client, "testhq", "hello", "main", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+
+	require.Len(t, transport.requests, 2)
+	query := transport.requests[0].URL.Query()
+	assert.Equal(t, "open", query.Get("state"))
+	assert.Equal(t, "main", query.Get("base"))
+	assert.Equal(t, "updated", query.Get("sort"))
+}
+
+func Test__DetectConflictingPullRequests__Check(t *testing.T) {
+	pullRequest := func(number int, state string, mergeable string, mergeableState string) *http.Response {
+		return mockResponse(http.StatusOK, fmt.Sprintf(
+			`{"number": %d, "state": "%s", "title": "PR %d", "user": {"login": "octocat"}, "head": {"ref": "feature-%d"}, "base": {"ref": "main"}, "mergeable": %s, "mergeable_state": "%s"}`,
+			number, state, number, number, mergeable, mergeableState,
+		))
+	}
+
+	handler := func(path string, attempt int) *http.Response {
+		switch {
+		case strings.HasSuffix(path, "/pulls/1"):
+			return pullRequest(1, "open", "false", "dirty")
+		case strings.HasSuffix(path, "/pulls/2"):
+			return pullRequest(2, "open", "true", "clean")
+		case strings.HasSuffix(path, "/pulls/3") && attempt == 1:
+			return pullRequest(3, "open", "null", "unknown")
+		case strings.HasSuffix(path, "/pulls/3"):
+			return pullRequest(3, "open", "false", "dirty")
+		case strings.HasSuffix(path, "/pulls/4"):
+			return pullRequest(4, "closed", "null", "unknown")
+		case strings.HasSuffix(path, "/pulls/5"):
+			return mockResponse(http.StatusInternalServerError, `{"message": "Server Error"}`)
+		}
+
+		return mockResponse(http.StatusNotFound, `{"message": "Not Found"}`)
+	}
+
+	deadline := time.Now().Add(time.Minute)
+
+	t.Run("unknown mergeability stays pending until computed", func(t *testing.T) {
+		transport := &pullRequestsTransport{handler: handler}
+		client := github.NewClient(&http.Client{Transport: transport})
+		metadata := &DetectConflictsMetadata{Repository: "hello", Pending: []int{5, 4, 3, 2, 1}}
+
+		timedOut := checkPullRequestConflicts(client, "testhq", metadata, deadline, time.Now)
+		assert.False(t, timedOut)
+		assert.Equal(t, []int{3}, metadata.Pending)
+		require.Len(t, metadata.Conflicting, 1)
+		assert.Equal(t, 1, metadata.Conflicting[0].Number)
+		assert.Equal(t, "octocat", metadata.Conflicting[0].Author)
+		assert.Equal(t, "feature-1", metadata.Conflicting[0].HeadRef)
+		require.Len(t, metadata.Failed, 1)
+		assert.Equal(t, 5, metadata.Failed[0].Number)
+		assert.Equal(t, 3, metadata.Checked)
+
+		timedOut = checkPullRequestConflicts(client, "testhq", metadata, deadline, time.Now)
+		assert.False(t, timedOut)
+		assert.Empty(t, metadata.Pending)
+		require.Len(t, metadata.Conflicting, 2)
+		assert.Equal(t, 3, metadata.Conflicting[1].Number)
+		assert.Equal(t, 4, metadata.Checked)
+		assert.Equal(t, 2, transport.requests["/repos/testhq/hello/pulls/3"])
+		assert.Equal(t, 1, transport.requests["/repos/testhq/hello/pulls/1"])
+	})
+
+	t.Run("deadline reached -> timed out with unknown pull requests", func(t *testing.T) {
+		transport := &pullRequestsTransport{handler: handler}
+		client := github.NewClient(&http.Client{Transport: transport})
+		metadata := &DetectConflictsMetadata{Repository: "hello", Pending: []int{3}}
+
+		timedOut := checkPullRequestConflicts(client, "testhq", metadata, time.Now().Add(-time.Second), time.Now)
+		assert.True(t, timedOut)
+		assert.Equal(t, []int{3}, metadata.Pending)
+
+		output := conflictsOutput(*metadata, timedOut)
+		assert.Equal(t, []int{3}, output["unknown"])
+		assert.Equal(t, true, output["timed_out"])
+	})
+}
+
+func Test__DetectConflictingPullRequests__Cancel(t *testing.T) {
+	component := DetectConflictingPullRequests{}
+	metadataCtx := &contexts.MetadataContext{Metadata: DetectConflictsMetadata{Repository: "hello", Pending: []int{3}}}
+	requestCtx := &contexts.RequestContext{}
+
+	require.NoError(t, component.Cancel(core.ExecutionContext{
+		Metadata:       metadataCtx,
+		ExecutionState: &contexts.ExecutionStateContext{},
+		Logger:         log.NewEntry(log.StandardLogger()),
+	}))
+
+	assert.True(t, metadataCtx.Get().(DetectConflictsMetadata).Cancelled)
+
+	require.NoError(t, component.HandleAction(core.ActionContext{
+		Name:           ConflictsPollAction,
+		Metadata:       metadataCtx,
+		Requests:       requestCtx,
+		ExecutionState: &contexts.ExecutionStateContext{},
+		Logger:         log.NewEntry(log.StandardLogger()),
+	}))
+
+	assert.Empty(t, requestCtx.Action)
+}
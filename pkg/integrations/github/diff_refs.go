@@ -0,0 +1,340 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * The compare API returns at most 250 commits and 300 files.
+ * Past those limits, the file change set may be incomplete.
+ */
+const (
+	CompareCommitsLimit = 250
+	CompareFilesLimit   = 300
+)
+
+type DiffRefs struct{}
+
+type DiffRefsConfiguration struct {
+	Repository  string   `json:"repository" mapstructure:"repository"`
+	BaseRef     string   `json:"baseRef" mapstructure:"baseRef"`
+	HeadRef     string   `json:"headRef" mapstructure:"headRef"`
+	PathsFilter []string `json:"pathsFilter" mapstructure:"pathsFilter"`
+}
+
+type RenamedFile struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type DiffRefsOutput struct {
+	BaseRef      string        `json:"base_ref"`
+	HeadRef      string        `json:"head_ref"`
+	Status       string        `json:"status"`
+	AheadBy      int           `json:"ahead_by"`
+	BehindBy     int           `json:"behind_by"`
+	TotalCommits int           `json:"total_commits"`
+	Added        []string      `json:"added"`
+	Modified     []string      `json:"modified"`
+	Removed      []string      `json:"removed"`
+	Renamed      []RenamedFile `json:"renamed"`
+	FilesChanged int           `json:"files_changed"`
+	Changed      bool          `json:"changed"`
+	Incomplete   bool          `json:"incomplete"`
+	URL          string        `json:"html_url"`
+}
+
+func (c *DiffRefs) Name() string {
+	return "github.diffRefs"
+}
+
+func (c *DiffRefs) Label() string {
+	return "Diff Refs"
+}
+
+func (c *DiffRefs) Description() string {
+	return "Get the files changed between two refs of a GitHub repository"
+}
+
+func (c *DiffRefs) Documentation() string {
+	return `The Diff Refs component lists the files that changed between two branches, tags, or commits.
+
+## Use Cases
+
+- **Path gating**: Only run a deployment when files under ` + "`services/api/**`" + ` changed
+- **Release notes**: Report which parts of a repository changed between two tags
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Base Ref**: The branch, tag, or commit SHA to compare from (supports expressions)
+- **Head Ref**: The branch, tag, or commit SHA to compare to (supports expressions)
+- **Paths Filter**: Optional globs, like ` + "`*.md`" + ` or ` + "`services/api/**`" + `. Only matching files are included.
+  A trailing ` + "`/**`" + ` matches everything under a directory
+
+## Output
+
+Returns the ` + "`added`" + `, ` + "`modified`" + `, and ` + "`removed`" + ` paths, and the ` + "`renamed`" + ` files, with their old and new paths.
+` + "`changed`" + ` is true if any file matched, so a following node can be gated on it.
+` + "`status`" + `, ` + "`ahead_by`" + `, ` + "`behind_by`" + `, and ` + "`total_commits`" + ` describe how the refs relate.
+
+## Notes
+
+- GitHub compares at most 250 commits and 300 files. Past those limits ` + "`incomplete`" + ` is true, and files may be missing
+- Like ` + "`git diff base...head`" + `, changes are relative to the merge base of the two refs`
+}
+
+func (c *DiffRefs) Icon() string {
+	return "github"
+}
+
+func (c *DiffRefs) Color() string {
+	return "gray"
+}
+
+func (c *DiffRefs) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *DiffRefs) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "baseRef",
+			Label:       "Base Ref",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., main",
+		},
+		{
+			Name:        "headRef",
+			Label:       "Head Ref",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.after}}",
+		},
+		{
+			Name:        "pathsFilter",
+			Label:       "Paths Filter",
+			Type:        configuration.FieldTypeList,
+			Description: "Only include files matching one of these globs",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Path",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *DiffRefs) Setup(ctx core.SetupContext) error {
+	var config DiffRefsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.BaseRef == "" {
+		return errors.New("base ref is required")
+	}
+
+	if config.HeadRef == "" {
+		return errors.New("head ref is required")
+	}
+
+	for _, pattern := range config.PathsFilter {
+		if _, err := path.Match(strings.TrimSuffix(pattern, "/**"), ""); err != nil {
+			return fmt.Errorf("invalid paths filter %q: %w", pattern, err)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *DiffRefs) Execute(ctx core.ExecutionContext) error {
+	var config DiffRefsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	comparison, _, err := client.Repositories.CompareCommits(
+		context.Background(),
+		appMetadata.Owner,
+		config.Repository,
+		config.BaseRef,
+		config.HeadRef,
+		&github.ListOptions{PerPage: 100},
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to compare %s...%s: %w", config.BaseRef, config.HeadRef, wrapGitHubError(err))
+	}
+
+	output, err := diffComparison(comparison, config.PathsFilter)
+	if err != nil {
+		return err
+	}
+
+	output.BaseRef = config.BaseRef
+	output.HeadRef = config.HeadRef
+	if output.Incomplete {
+		ctx.Logger.Warnf("Comparison of %s...%s has %d commits and %d files - file changes may be incomplete", config.BaseRef, config.HeadRef, output.TotalCommits, len(comparison.Files))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.refDiff",
+		[]any{output},
+	)
+}
+
+/*
+ * Aggregates the files of a comparison by change type.
+ * Copied files count as added, and files with only mode changes as modified.
+ */
+func diffComparison(comparison *github.CommitsComparison, pathsFilter []string) (*DiffRefsOutput, error) {
+	output := &DiffRefsOutput{
+		Status:       comparison.GetStatus(),
+		AheadBy:      comparison.GetAheadBy(),
+		BehindBy:     comparison.GetBehindBy(),
+		TotalCommits: comparison.GetTotalCommits(),
+		Added:        []string{},
+		Modified:     []string{},
+		Removed:      []string{},
+		Renamed:      []RenamedFile{},
+		URL:          comparison.GetHTMLURL(),
+		Incomplete:   comparison.GetTotalCommits() > CompareCommitsLimit || len(comparison.Files) >= CompareFilesLimit,
+	}
+
+	for _, file := range comparison.Files {
+		match, err := matchesPaths(pathsFilter, file.GetFilename(), file.GetPreviousFilename())
+		if err != nil {
+			return nil, err
+		}
+
+		if !match {
+			continue
+		}
+
+		switch file.GetStatus() {
+		case "added", "copied":
+			output.Added = append(output.Added, file.GetFilename())
+		case "removed":
+			output.Removed = append(output.Removed, file.GetFilename())
+		case "renamed":
+			output.Renamed = append(output.Renamed, RenamedFile{From: file.GetPreviousFilename(), To: file.GetFilename()})
+		default:
+			output.Modified = append(output.Modified, file.GetFilename())
+		}
+
+		output.FilesChanged++
+	}
+
+	output.Changed = output.FilesChanged > 0
+	return output, nil
+}
+
+/*
+ * Renamed files match if either their old or new path matches.
+ */
+func matchesPaths(patterns []string, paths ...string) (bool, error) {
+	if len(patterns) == 0 {
+		return true, nil
+	}
+
+	for _, pattern := range patterns {
+		for _, p := range paths {
+			if p == "" {
+				continue
+			}
+
+			match, err := matchPath(pattern, p)
+			if err != nil {
+				return false, fmt.Errorf("invalid paths filter %q: %w", pattern, err)
+			}
+
+			if match {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func matchPath(pattern, p string) (bool, error) {
+	dir, ok := strings.CutSuffix(pattern, "/**")
+	if !ok {
+		return path.Match(pattern, p)
+	}
+
+	for current := path.Dir(p); current != "."; current = path.Dir(current) {
+		match, err := path.Match(dir, current)
+		if err != nil || match {
+			return match, err
+		}
+	}
+
+	return false, nil
+}
+
+func (c *DiffRefs) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *DiffRefs) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *DiffRefs) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *DiffRefs) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *DiffRefs) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *DiffRefs) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
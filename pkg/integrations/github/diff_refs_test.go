@@ -0,0 +1,104 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__DiffRefs__Setup(t *testing.T) {
+	component := DiffRefs{}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{{Name: "api"}}}}
+
+	t.Run("base ref is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "headRef": "feature"},
+		})
+
+		require.ErrorContains(t, err, "base ref is required")
+	})
+
+	t.Run("head ref is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "baseRef": "main"},
+		})
+
+		require.ErrorContains(t, err, "head ref is required")
+	})
+
+	t.Run("invalid paths filter -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "baseRef": "main", "headRef": "feature", "pathsFilter": []any{"docs/["}},
+		})
+
+		require.ErrorContains(t, err, "invalid paths filter")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "baseRef": "main", "headRef": "feature", "pathsFilter": []any{"services/*/**"}},
+		}))
+	})
+}
+
+func Test__DiffRefs__DiffComparison(t *testing.T) {
+	comparison := &github.CommitsComparison{
+		Status:       github.Ptr("ahead"),
+		AheadBy:      github.Ptr(3),
+		TotalCommits: github.Ptr(3),
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("services/api/main.go"), Status: github.Ptr("modified")},
+			{Filename: github.Ptr("services/api/handlers/new.go"), Status: github.Ptr("added")},
+			{Filename: github.Ptr("services/web/old.ts"), Status: github.Ptr("removed")},
+			{Filename: github.Ptr("docs/api.md"), PreviousFilename: github.Ptr("services/api/README.md"), Status: github.Ptr("renamed")},
+			{Filename: github.Ptr("README.md"), Status: github.Ptr("changed")},
+		},
+	}
+
+	t.Run("no filter -> all files", func(t *testing.T) {
+		output, err := diffComparison(comparison, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"services/api/handlers/new.go"}, output.Added)
+		assert.Equal(t, []string{"services/api/main.go", "README.md"}, output.Modified)
+		assert.Equal(t, []string{"services/web/old.ts"}, output.Removed)
+		assert.Equal(t, []RenamedFile{{From: "services/api/README.md", To: "docs/api.md"}}, output.Renamed)
+		assert.Equal(t, 5, output.FilesChanged)
+		assert.True(t, output.Changed)
+		assert.False(t, output.Incomplete)
+	})
+
+	t.Run("directory filter -> files under it, including renamed from it", func(t *testing.T) {
+		output, err := diffComparison(comparison, []string{"services/api/**"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"services/api/handlers/new.go"}, output.Added)
+		assert.Equal(t, []string{"services/api/main.go"}, output.Modified)
+		assert.Empty(t, output.Removed)
+		assert.Len(t, output.Renamed, 1)
+		assert.Equal(t, 3, output.FilesChanged)
+	})
+
+	t.Run("no matches -> not changed", func(t *testing.T) {
+		output, err := diffComparison(comparison, []string{"*.yaml"})
+		require.NoError(t, err)
+		assert.False(t, output.Changed)
+		assert.Empty(t, output.Modified)
+	})
+
+	t.Run("over the commit limit -> incomplete", func(t *testing.T) {
+		output, err := diffComparison(&github.CommitsComparison{TotalCommits: github.Ptr(251)}, nil)
+		require.NoError(t, err)
+		assert.True(t, output.Incomplete)
+	})
+}
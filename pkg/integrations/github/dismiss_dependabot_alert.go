@@ -0,0 +1,220 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+var dependabotDismissReasons = []string{"fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"}
+
+type DismissDependabotAlert struct{}
+
+type DismissDependabotAlertConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	AlertNumber string `json:"alertNumber" mapstructure:"alertNumber"`
+	Reason      string `json:"reason" mapstructure:"reason"`
+	Comment     string `json:"comment" mapstructure:"comment"`
+}
+
+func (c *DismissDependabotAlert) Name() string {
+	return "github.dismissDependabotAlert"
+}
+
+func (c *DismissDependabotAlert) Label() string {
+	return "Dismiss Dependabot Alert"
+}
+
+func (c *DismissDependabotAlert) Description() string {
+	return "Dismiss a Dependabot alert in a GitHub repository"
+}
+
+func (c *DismissDependabotAlert) Documentation() string {
+	return `The Dismiss Dependabot Alert component dismisses a Dependabot alert in a GitHub repository.
+
+## Use Cases
+
+- **Triage**: Dismiss alerts for dependencies that are only used in development
+- **Risk acceptance**: Record why a vulnerability is tolerated after an approval step
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Alert Number**: The number of the alert (supports expressions)
+- **Reason**: Why the alert is dismissed: fix started, inaccurate, no bandwidth, not used, or tolerable risk
+- **Comment**: Optional comment explaining the dismissal
+
+## Output
+
+Returns the dismissed alert.`
+}
+
+func (c *DismissDependabotAlert) Icon() string {
+	return "github"
+}
+
+func (c *DismissDependabotAlert) Color() string {
+	return "gray"
+}
+
+func (c *DismissDependabotAlert) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *DismissDependabotAlert) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "alertNumber",
+			Label:       "Alert Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.number}}",
+		},
+		{
+			Name:     "reason",
+			Label:    "Reason",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Fix started", Value: "fix_started"},
+						{Label: "Inaccurate", Value: "inaccurate"},
+						{Label: "No bandwidth", Value: "no_bandwidth"},
+						{Label: "Not used", Value: "not_used"},
+						{Label: "Tolerable risk", Value: "tolerable_risk"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "comment",
+			Label: "Comment",
+			Type:  configuration.FieldTypeText,
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *DismissDependabotAlert) Setup(ctx core.SetupContext) error {
+	var config DismissDependabotAlertConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.AlertNumber == "" {
+		return errors.New("alert number is required")
+	}
+
+	if !slices.Contains(dependabotDismissReasons, config.Reason) {
+		return fmt.Errorf("invalid dismissal reason %q: must be one of %v", config.Reason, dependabotDismissReasons)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *DismissDependabotAlert) Execute(ctx core.ExecutionContext) error {
+	var config DismissDependabotAlertConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	alertNumber, err := strconv.Atoi(config.AlertNumber)
+	if err != nil {
+		return fmt.Errorf("alert number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.dependabotAlert", func() (any, error) {
+		state := &github.DependabotAlertState{
+			State:           "dismissed",
+			DismissedReason: &config.Reason,
+		}
+
+		if config.Comment != "" {
+			state.DismissedComment = &config.Comment
+		}
+
+		alert, _, err := client.Dependabot.UpdateAlert(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			alertNumber,
+			state,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to dismiss Dependabot alert: %w", wrapFeatureError(err, "Dependabot alerts"))
+		}
+
+		return buildDependabotAlertOutput(alert), nil
+	})
+}
+
+func (c *DismissDependabotAlert) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *DismissDependabotAlert) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *DismissDependabotAlert) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *DismissDependabotAlert) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *DismissDependabotAlert) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *DismissDependabotAlert) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
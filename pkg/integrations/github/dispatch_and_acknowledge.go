@@ -0,0 +1,255 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultDispatchAcknowledgement = "Workflow started"
+
+type DispatchAndAcknowledge struct{}
+
+type DispatchAndAcknowledgeConfiguration struct {
+	Repository   string  `json:"repository" mapstructure:"repository"`
+	WorkflowFile string  `json:"workflowFile" mapstructure:"workflowFile"`
+	Ref          string  `json:"ref" mapstructure:"ref"`
+	Inputs       []Input `json:"inputs" mapstructure:"inputs"`
+	IssueNumber  string  `json:"issueNumber" mapstructure:"issueNumber"`
+	Message      string  `json:"message" mapstructure:"message"`
+}
+
+type DispatchAcknowledgement struct {
+	IssueNumber int    `json:"issue_number"`
+	RunID       int64  `json:"run_id"`
+	RunNumber   int    `json:"run_number"`
+	RunURL      string `json:"run_url"`
+	CommentID   int64  `json:"comment_id"`
+	CommentURL  string `json:"comment_url"`
+}
+
+func (c *DispatchAndAcknowledge) Name() string {
+	return "github.dispatchAndAcknowledge"
+}
+
+func (c *DispatchAndAcknowledge) Label() string {
+	return "Dispatch And Acknowledge"
+}
+
+func (c *DispatchAndAcknowledge) Description() string {
+	return "Run a GitHub Actions workflow and reply with a link to its run"
+}
+
+func (c *DispatchAndAcknowledge) Documentation() string {
+	return `The Dispatch And Acknowledge component dispatches a GitHub Actions workflow, finds the run it started,
+and comments on an issue or pull request with a link to the run.
+
+## Use Cases
+
+- **ChatOps**: Reply to a ` + "`/deploy`" + ` comment with a link to the deployment run
+- **Manual triggers**: Let people follow the workflow they started from an issue
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the workflow
+- **Workflow File**: Path to the workflow file (e.g., ` + "`.github/workflows/deploy.yml`" + `)
+- **Branch or Tag**: Git reference to run the workflow on (default: main)
+- **Inputs**: Optional workflow inputs as key-value pairs (supports expressions)
+- **Issue Number**: The issue or pull request to comment on, usually from the comment event (supports expressions)
+- **Message**: The text of the comment, followed by the link to the run. Defaults to "` + DefaultDispatchAcknowledgement + `"
+
+## Output
+
+Emits the ` + "`run_id`" + `, ` + "`run_number`" + ` and ` + "`run_url`" + ` of the workflow run, and the ` + "`comment_id`" + ` and ` + "`comment_url`" + ` of the comment.
+
+## Notes
+
+- The run is found just like in Run Workflow. Set the ` + "`run-name`" + ` of the workflow with the ` + "`superplane_execution_id`" + ` input,
+  if it can be dispatched more than once at the same time
+- The component does not wait for the run to finish. Use an On Workflow Run trigger to react to its completion
+- If the run is not found, or the comment cannot be created, the execution fails, and the error says whether the workflow was dispatched`
+}
+
+func (c *DispatchAndAcknowledge) Icon() string {
+	return "workflow"
+}
+
+func (c *DispatchAndAcknowledge) Color() string {
+	return "gray"
+}
+
+func (c *DispatchAndAcknowledge) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *DispatchAndAcknowledge) Configuration() []configuration.Field {
+	//
+	// The workflow fields are the ones of Run Workflow, without the timeout,
+	// since the run is not waited for.
+	//
+	fields := []configuration.Field{}
+	for _, field := range (&RunWorkflow{}).Configuration() {
+		if field.Name != "timeout" {
+			fields = append(fields, field)
+		}
+	}
+
+	return append(fields,
+		configuration.Field{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+			Description: "The issue or pull request to comment on",
+		},
+		configuration.Field{
+			Name:        "message",
+			Label:       "Message",
+			Type:        configuration.FieldTypeText,
+			Placeholder: DefaultDispatchAcknowledgement,
+			Description: "Text of the comment, followed by the link to the run",
+		},
+		ConcurrencyKeyField,
+	)
+}
+
+func (c *DispatchAndAcknowledge) Setup(ctx core.SetupContext) error {
+	var config DispatchAndAcknowledgeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.WorkflowFile) == "" {
+		return errors.New("workflow file is required")
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if !isExpression(config.IssueNumber) {
+		if _, err := strconv.Atoi(config.IssueNumber); err != nil {
+			return fmt.Errorf("issue number is not a number: %s", config.IssueNumber)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *DispatchAndAcknowledge) Execute(ctx core.ExecutionContext) error {
+	var config DispatchAndAcknowledgeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	spec := RunWorkflowSpec{
+		Repository:   config.Repository,
+		WorkflowFile: config.WorkflowFile,
+		Ref:          config.Ref,
+		Inputs:       config.Inputs,
+	}
+
+	return withIdempotency(ctx, "github.workflowDispatch.acknowledged", func() (any, error) {
+		run, dispatchedAt, err := (&RunWorkflow{}).dispatchWorkflow(ctx, client, appMetadata.Owner, spec)
+		if err != nil && !dispatchedAt.IsZero() {
+			return nil, fmt.Errorf("workflow was dispatched, but no comment was created: %w", err)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Logger.Infof("Started workflow run %d", run.GetID())
+
+		return acknowledgeDispatch(client, appMetadata.Owner, config.Repository, issueNumber, config.Message, run)
+	})
+}
+
+func acknowledgeDispatch(client *github.Client, owner, repository string, issueNumber int, message string, run *github.WorkflowRun) (*DispatchAcknowledgement, error) {
+	body := dispatchAcknowledgementBody(message, run)
+	comment, _, err := client.Issues.CreateComment(context.Background(), owner, repository, issueNumber, &github.IssueComment{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("workflow run %d was started, but commenting on issue %d failed: %w", run.GetID(), issueNumber, wrapGitHubError(err))
+	}
+
+	return &DispatchAcknowledgement{
+		IssueNumber: issueNumber,
+		RunID:       run.GetID(),
+		RunNumber:   run.GetRunNumber(),
+		RunURL:      run.GetHTMLURL(),
+		CommentID:   comment.GetID(),
+		CommentURL:  comment.GetHTMLURL(),
+	}, nil
+}
+
+func dispatchAcknowledgementBody(message string, run *github.WorkflowRun) string {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		message = DefaultDispatchAcknowledgement
+	}
+
+	return fmt.Sprintf("%s\n\n[Workflow run #%d](%s)", message, run.GetRunNumber(), run.GetHTMLURL())
+}
+
+func (c *DispatchAndAcknowledge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *DispatchAndAcknowledge) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *DispatchAndAcknowledge) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *DispatchAndAcknowledge) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *DispatchAndAcknowledge) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *DispatchAndAcknowledge) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
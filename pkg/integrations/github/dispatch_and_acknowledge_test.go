@@ -0,0 +1,109 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__DispatchAndAcknowledge__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := DispatchAndAcknowledge{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "workflowFile": "deploy.yml", "ref": "main"},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("issue number is not a number -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "workflowFile": "deploy.yml", "ref": "main", "issueNumber": "abc"},
+		})
+
+		require.ErrorContains(t, err, "issue number is not a number")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":   "hello",
+				"workflowFile": ".github/workflows/deploy.yml",
+				"ref":          "main",
+				"issueNumber":  "{{$.data.issue.number}}",
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__DispatchAndAcknowledge__Configuration(t *testing.T) {
+	names := []string{}
+	for _, field := range (&DispatchAndAcknowledge{}).Configuration() {
+		names = append(names, field.Name)
+	}
+
+	assert.Equal(t, []string{"repository", "workflowFile", "ref", "inputs", "issueNumber", "message", ConcurrencyKeyField.Name}, names)
+}
+
+func Test__DispatchAndAcknowledge__Acknowledge(t *testing.T) {
+	run := &github.WorkflowRun{
+		ID:        github.Ptr(int64(99)),
+		RunNumber: github.Ptr(17),
+		HTMLURL:   github.Ptr("https://github.com/testhq/hello/actions/runs/99"),
+	}
+
+	t.Run("comment links to the run", func(t *testing.T) {
+		var body string
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(request.Body)
+			body = string(data)
+			return mockResponse(http.StatusCreated, `{"id":5,"html_url":"https://github.com/testhq/hello/issues/3#issuecomment-5"}`), nil
+		}}
+
+		output, err := acknowledgeDispatch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 3, "Deploying to production", run)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, "/repos/testhq/hello/issues/3/comments", transport.requests[0].URL.Path)
+		assert.JSONEq(t, `{"body":"Deploying to production\n\n[Workflow run #17](https://github.com/testhq/hello/actions/runs/99)"}`, body)
+
+		assert.Equal(t, &DispatchAcknowledgement{
+			IssueNumber: 3,
+			RunID:       99,
+			RunNumber:   17,
+			RunURL:      "https://github.com/testhq/hello/actions/runs/99",
+			CommentID:   5,
+			CommentURL:  "https://github.com/testhq/hello/issues/3#issuecomment-5",
+		}, output)
+	})
+
+	t.Run("comment fails -> error names the started run", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := acknowledgeDispatch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 3, "", run)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.Contains(t, err.Error(), "workflow run 99 was started, but commenting on issue 3 failed")
+	})
+
+	t.Run("default message", func(t *testing.T) {
+		assert.Equal(t, "Workflow started\n\n[Workflow run #17](https://github.com/testhq/hello/actions/runs/99)", dispatchAcknowledgementBody(" ", run))
+	})
+}
@@ -0,0 +1,322 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	BodyModeReplace       = "replace"
+	BodyModeAppend        = "append"
+	BodyModePrepend       = "prepend"
+	BodyModeUpsertSection = "upsertSection"
+
+	DefaultSectionKey = "superplane"
+)
+
+type EditPullRequestBody struct{}
+
+type EditPullRequestBodyConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	Content    string `json:"content" mapstructure:"content"`
+	Mode       string `json:"mode" mapstructure:"mode"`
+	SectionKey string `json:"sectionKey" mapstructure:"sectionKey"`
+	OnOversize string `json:"onOversize" mapstructure:"onOversize"`
+}
+
+type PullRequestBodyOutput struct {
+	*github.PullRequest
+	Truncated bool `json:"truncated" mapstructure:"truncated"`
+}
+
+func (c *EditPullRequestBody) Name() string {
+	return "github.editPullRequestBody"
+}
+
+func (c *EditPullRequestBody) Label() string {
+	return "Edit Pull Request Body"
+}
+
+func (c *EditPullRequestBody) Description() string {
+	return "Replace, extend, or maintain a section of a GitHub pull request description"
+}
+
+func (c *EditPullRequestBody) Documentation() string {
+	return `The Edit Pull Request Body component updates the description of a GitHub pull request.
+
+## Use Cases
+
+- **Deploy annotations**: Add preview environment links to the pull request description
+- **Status reports**: Keep an up-to-date test or deploy summary in the description
+- **Templates**: Prepend or append checklists to new pull requests
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Content**: The content to write (supports markdown and expressions)
+- **Mode**: How the content is written:
+  - **Replace**: Replaces the whole description
+  - **Append**: Adds the content at the end of the description
+  - **Prepend**: Adds the content at the start of the description
+  - **Upsert Section**: Keeps the content in a managed section, delimited by hidden markers.
+    The first run adds the section at the end of the description, and later runs replace it, so content is not duplicated
+- **Section Key**: Identifies the managed section, so multiple nodes can each maintain their own section. Defaults to ` + "`superplane`" + `
+- **On Oversize**: GitHub rejects descriptions over 65536 characters. Truncate the description with a notice, or fail before updating it
+
+## Output
+
+Returns the updated pull request, including its new body. ` + "`truncated`" + ` is true if the body was cut to fit the GitHub limit.`
+}
+
+func (c *EditPullRequestBody) Icon() string {
+	return "github"
+}
+
+func (c *EditPullRequestBody) Color() string {
+	return "gray"
+}
+
+func (c *EditPullRequestBody) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *EditPullRequestBody) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:     "content",
+			Label:    "Content",
+			Type:     configuration.FieldTypeText,
+			Required: true,
+		},
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  BodyModeUpsertSection,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Replace", Value: BodyModeReplace},
+						{Label: "Append", Value: BodyModeAppend},
+						{Label: "Prepend", Value: BodyModePrepend},
+						{Label: "Upsert Section", Value: BodyModeUpsertSection},
+					},
+				},
+			},
+		},
+		{
+			Name:        "sectionKey",
+			Label:       "Section Key",
+			Type:        configuration.FieldTypeString,
+			Placeholder: DefaultSectionKey,
+			Description: "Identifies the managed section of the description",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{BodyModeUpsertSection}},
+			},
+		},
+		OnOversizeField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *EditPullRequestBody) Setup(ctx core.SetupContext) error {
+	var config EditPullRequestBodyConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.Content == "" {
+		return errors.New("content is required")
+	}
+
+	if config.Mode != "" && !slices.Contains([]string{BodyModeReplace, BodyModeAppend, BodyModePrepend, BodyModeUpsertSection}, config.Mode) {
+		return fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	if err := validateOnOversize(config.OnOversize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *EditPullRequestBody) Execute(ctx core.ExecutionContext) error {
+	var config EditPullRequestBodyConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.pullRequest", func() (any, error) {
+		pr, _, err := client.PullRequests.Get(context.Background(), appMetadata.Owner, config.Repository, pullNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", err)
+		}
+
+		body, truncated, err := guardBodySize(
+			updateBody(pr.GetBody(), config.Content, config.Mode, config.SectionKey),
+			MaxPullRequestBodyLength,
+			config.OnOversize,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		updated, _, err := client.PullRequests.Edit(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			pullNumber,
+			&github.PullRequest{Body: &body},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update pull request: %w", err)
+		}
+
+		return &PullRequestBodyOutput{PullRequest: updated, Truncated: truncated}, nil
+	})
+}
+
+/*
+ * Managed sections are delimited by HTML comments,
+ * which GitHub does not render.
+ */
+func sectionMarkers(key string) (string, string) {
+	if key == "" {
+		key = DefaultSectionKey
+	}
+
+	return fmt.Sprintf("<!-- %s:start -->", key), fmt.Sprintf("<!-- %s:end -->", key)
+}
+
+func updateBody(current, content, mode, sectionKey string) string {
+	switch mode {
+	case BodyModeReplace:
+		return content
+	case BodyModeAppend:
+		if current == "" {
+			return content
+		}
+
+		return current + "\n\n" + content
+	case BodyModePrepend:
+		if current == "" {
+			return content
+		}
+
+		return content + "\n\n" + current
+	default:
+		return upsertSection(current, content, sectionKey)
+	}
+}
+
+func upsertSection(current, content, sectionKey string) string {
+	start, end := sectionMarkers(sectionKey)
+	section := start + "\n" + content + "\n" + end
+
+	startIndex := strings.Index(current, start)
+	if startIndex >= 0 {
+		endIndex := strings.Index(current[startIndex:], end)
+		if endIndex >= 0 {
+			endIndex += startIndex + len(end)
+			return current[:startIndex] + section + current[endIndex:]
+		}
+	}
+
+	if current == "" {
+		return section
+	}
+
+	return current + "\n\n" + section
+}
+
+func (c *EditPullRequestBody) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *EditPullRequestBody) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *EditPullRequestBody) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *EditPullRequestBody) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *EditPullRequestBody) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *EditPullRequestBody) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
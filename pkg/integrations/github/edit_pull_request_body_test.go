@@ -0,0 +1,84 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__EditPullRequestBody__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := EditPullRequestBody{}
+
+	t.Run("content is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		})
+
+		require.ErrorContains(t, err, "content is required")
+	})
+
+	t.Run("invalid mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "content": "hi", "mode": "merge"},
+		})
+
+		require.ErrorContains(t, err, "invalid mode")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository": "hello",
+				"pullNumber": "42",
+				"content":    "hi",
+				"mode":       BodyModeUpsertSection,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__EditPullRequestBody__UpdateBody(t *testing.T) {
+	t.Run("replace", func(t *testing.T) {
+		assert.Equal(t, "new", updateBody("old", "new", BodyModeReplace, ""))
+	})
+
+	t.Run("append and prepend", func(t *testing.T) {
+		assert.Equal(t, "old\n\nnew", updateBody("old", "new", BodyModeAppend, ""))
+		assert.Equal(t, "new\n\nold", updateBody("old", "new", BodyModePrepend, ""))
+		assert.Equal(t, "new", updateBody("", "new", BodyModeAppend, ""))
+	})
+
+	t.Run("upsert section adds the section once and then replaces it", func(t *testing.T) {
+		body := updateBody("Description", "Preview: v1", BodyModeUpsertSection, "")
+		assert.Equal(t, "Description\n\n<!-- superplane:start -->\nPreview: v1\n<!-- superplane:end -->", body)
+
+		body = updateBody(body+"\n\nFooter", "Preview: v2", BodyModeUpsertSection, "")
+		assert.Equal(t, "Description\n\n<!-- superplane:start -->\nPreview: v2\n<!-- superplane:end -->\n\nFooter", body)
+	})
+
+	t.Run("sections with different keys are kept apart", func(t *testing.T) {
+		body := updateBody("", "Preview: v1", BodyModeUpsertSection, "preview")
+		body = updateBody(body, "Tests: passed", BodyModeUpsertSection, "tests")
+		body = updateBody(body, "Preview: v2", BodyModeUpsertSection, "preview")
+
+		assert.Equal(t, "<!-- preview:start -->\nPreview: v2\n<!-- preview:end -->\n\n<!-- tests:start -->\nTests: passed\n<!-- tests:end -->", body)
+	})
+
+	t.Run("section without end marker is appended again", func(t *testing.T) {
+		body := updateBody("<!-- superplane:start -->\nbroken", "fixed", BodyModeUpsertSection, "")
+		assert.Equal(t, "<!-- superplane:start -->\nbroken\n\n<!-- superplane:start -->\nfixed\n<!-- superplane:end -->", body)
+	})
+}
@@ -0,0 +1,283 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+var autoMergeMethods = []string{"merge", "squash", "rebase"}
+
+type EnableAutoMerge struct{}
+
+type EnableAutoMergeConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	PullNumber  string `json:"pullNumber" mapstructure:"pullNumber"`
+	MergeMethod string `json:"mergeMethod" mapstructure:"mergeMethod"`
+}
+
+func (c *EnableAutoMerge) Name() string {
+	return "github.enableAutoMerge"
+}
+
+func (c *EnableAutoMerge) Label() string {
+	return "Enable Auto-Merge"
+}
+
+func (c *EnableAutoMerge) Description() string {
+	return "Enable auto-merge on a GitHub pull request"
+}
+
+func (c *EnableAutoMerge) Documentation() string {
+	return `The Enable Auto-Merge component enables auto-merge on a GitHub pull request, so GitHub merges it once all requirements are met.
+
+## Use Cases
+
+- **Dependency updates**: Merge approved dependency updates once checks pass
+- **Release flows**: Merge release pull requests without polling for their checks
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Merge Method**: The merge method to use: merge, squash, or rebase
+
+## Output
+
+Emits the pull request ` + "`number`" + `, the ` + "`merge_method`" + `, and when auto-merge was enabled, in ` + "`enabled_at`" + `.
+
+## Notes
+
+- Auto-merge must be allowed in the repository settings, under **General** > **Pull Requests** > **Allow auto-merge**
+- Auto-merge cannot be enabled on pull requests that can already be merged`
+}
+
+func (c *EnableAutoMerge) Icon() string {
+	return "github"
+}
+
+func (c *EnableAutoMerge) Color() string {
+	return "gray"
+}
+
+func (c *EnableAutoMerge) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *EnableAutoMerge) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:     "mergeMethod",
+			Label:    "Merge Method",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "merge",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Merge", Value: "merge"},
+						{Label: "Squash", Value: "squash"},
+						{Label: "Rebase", Value: "rebase"},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *EnableAutoMerge) Setup(ctx core.SetupContext) error {
+	var config EnableAutoMergeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if !slices.Contains(autoMergeMethods, config.MergeMethod) {
+		return fmt.Errorf("invalid merge method %q: must be one of %v", config.MergeMethod, autoMergeMethods)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *EnableAutoMerge) Execute(ctx core.ExecutionContext) error {
+	var config EnableAutoMergeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	if !slices.Contains(autoMergeMethods, config.MergeMethod) {
+		return fmt.Errorf("invalid merge method %q: must be one of %v", config.MergeMethod, autoMergeMethods)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.autoMerge", func() (any, error) {
+		pullRequestID, err := c.findPullRequest(client, appMetadata.Owner, config.Repository, pullNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		var mutation struct {
+			EnablePullRequestAutoMerge struct {
+				PullRequest struct {
+					AutoMergeRequest *struct {
+						EnabledAt githubv4.DateTime
+					}
+				}
+			} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+		}
+
+		mergeMethod := githubv4.PullRequestMergeMethod(strings.ToUpper(config.MergeMethod))
+		input := githubv4.EnablePullRequestAutoMergeInput{
+			PullRequestID: pullRequestID,
+			MergeMethod:   &mergeMethod,
+		}
+
+		err = client.Mutate(context.Background(), &mutation, input, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable auto-merge on pull request %d: %w", pullNumber, autoMergeError(err, config.Repository))
+		}
+
+		output := map[string]any{
+			"number":       pullNumber,
+			"merge_method": config.MergeMethod,
+		}
+
+		if request := mutation.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest; request != nil {
+			output["enabled_at"] = request.EnabledAt.Time
+		}
+
+		return output, nil
+	})
+}
+
+/*
+ * The mutation needs the node ID of the pull request.
+ */
+func (c *EnableAutoMerge) findPullRequest(client *githubv4.Client, owner, repository string, number int) (githubv4.ID, error) {
+	var query struct {
+		Repository struct {
+			PullRequest *struct {
+				ID githubv4.ID
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request %d: %w", number, err)
+	}
+
+	if query.Repository.PullRequest == nil || query.Repository.PullRequest.ID == nil {
+		return nil, fmt.Errorf("%w: pull request %d", ErrNotFound, number)
+	}
+
+	return query.Repository.PullRequest.ID, nil
+}
+
+/*
+ * GraphQL errors carry no status code, so the message is used
+ * to tell when auto-merge is not allowed on the repository.
+ */
+func autoMergeError(err error, repository string) error {
+	if !strings.Contains(strings.ToLower(err.Error()), "auto merge is not allowed") {
+		return err
+	}
+
+	return fmt.Errorf(
+		"%w: auto-merge is not allowed on %s, enable it in the repository settings, under General > Pull Requests > Allow auto-merge: %w",
+		ErrFeatureDisabled,
+		repository,
+		err,
+	)
+}
+
+func (c *EnableAutoMerge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *EnableAutoMerge) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *EnableAutoMerge) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *EnableAutoMerge) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *EnableAutoMerge) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *EnableAutoMerge) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__EnableAutoMerge__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := EnableAutoMerge{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "mergeMethod": "squash"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("invalid merge method -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "mergeMethod": "fast-forward"},
+		})
+
+		require.ErrorContains(t, err, "invalid merge method")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "mergeMethod": "squash"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__EnableAutoMerge__AutoMergeError(t *testing.T) {
+	t.Run("auto-merge not allowed -> feature disabled, with guidance", func(t *testing.T) {
+		err := autoMergeError(errors.New("Pull request Auto merge is not allowed for this repository"), "hello")
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		assert.Contains(t, err.Error(), "Allow auto-merge")
+	})
+
+	t.Run("other errors are returned as they are", func(t *testing.T) {
+		original := errors.New("Pull request is in clean status")
+		assert.Equal(t, original, autoMergeError(original, "hello"))
+	})
+}
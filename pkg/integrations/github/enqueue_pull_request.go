@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type EnqueuePullRequest struct{}
+
+type EnqueuePullRequestConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+}
+
+type MergeQueueEntry struct {
+	Number               int        `json:"number"`
+	BaseRef              string     `json:"base_ref"`
+	State                string     `json:"state"`
+	Position             *int       `json:"position,omitempty"`
+	EnqueuedAt           *time.Time `json:"enqueued_at,omitempty"`
+	EstimatedTimeToMerge *int       `json:"estimated_time_to_merge,omitempty"`
+}
+
+func (c *EnqueuePullRequest) Name() string {
+	return "github.enqueuePullRequest"
+}
+
+func (c *EnqueuePullRequest) Label() string {
+	return "Enqueue Pull Request"
+}
+
+func (c *EnqueuePullRequest) Description() string {
+	return "Add a GitHub pull request to the merge queue of its base branch"
+}
+
+func (c *EnqueuePullRequest) Documentation() string {
+	return `The Enqueue Pull Request component adds a pull request to the merge queue of its base branch.
+
+## Use Cases
+
+- **Queue-enabled repositories**: Merge pull requests through the merge queue, instead of merging them directly
+- **Release flows**: Queue approved release pull requests once their checks pass
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+
+## Output
+
+Emits the pull request ` + "`number`" + `, its ` + "`base_ref`" + `, and the merge queue entry:
+
+- ` + "`state`" + `: ` + "`QUEUED`" + `, ` + "`AWAITING_CHECKS`" + `, ` + "`MERGEABLE`" + `, ` + "`UNMERGEABLE`" + `, or ` + "`LOCKED`" + `
+- ` + "`position`" + `: The position of the pull request in the queue, if GitHub reports it
+- ` + "`estimated_time_to_merge`" + `: Estimated seconds until the pull request is merged, if GitHub reports it
+
+## Notes
+
+- The base branch must have a merge queue, which is enabled with the **Require merge queue** branch protection rule.
+  If it has none, the execution fails without changing the pull request`
+}
+
+func (c *EnqueuePullRequest) Icon() string {
+	return "github"
+}
+
+func (c *EnqueuePullRequest) Color() string {
+	return "gray"
+}
+
+func (c *EnqueuePullRequest) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *EnqueuePullRequest) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *EnqueuePullRequest) Setup(ctx core.SetupContext) error {
+	var config EnqueuePullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *EnqueuePullRequest) Execute(ctx core.ExecutionContext) error {
+	var config EnqueuePullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.mergeQueueEntry", func() (any, error) {
+		return enqueuePullRequest(client, appMetadata.Owner, config.Repository, pullNumber)
+	})
+}
+
+func enqueuePullRequest(client *githubv4.Client, owner, repository string, number int) (*MergeQueueEntry, error) {
+	var query struct {
+		Repository struct {
+			PullRequest *struct {
+				ID          githubv4.ID
+				BaseRefName string
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to find pull request %d: %w", number, err)
+	}
+
+	pullRequest := query.Repository.PullRequest
+	if pullRequest == nil || pullRequest.ID == nil {
+		return nil, fmt.Errorf("%w: pull request %d", ErrNotFound, number)
+	}
+
+	if err := ensureMergeQueue(client, owner, repository, pullRequest.BaseRefName); err != nil {
+		return nil, err
+	}
+
+	var mutation struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *struct {
+				Position             *int
+				State                string
+				EnqueuedAt           *githubv4.DateTime
+				EstimatedTimeToMerge *int
+			}
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+
+	input := githubv4.EnqueuePullRequestInput{PullRequestID: pullRequest.ID}
+	if err := client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+		return nil, fmt.Errorf("failed to enqueue pull request %d: %w", number, err)
+	}
+
+	output := &MergeQueueEntry{Number: number, BaseRef: pullRequest.BaseRefName}
+	if entry := mutation.EnqueuePullRequest.MergeQueueEntry; entry != nil {
+		output.State = entry.State
+		output.Position = entry.Position
+		output.EstimatedTimeToMerge = entry.EstimatedTimeToMerge
+		if entry.EnqueuedAt != nil {
+			output.EnqueuedAt = &entry.EnqueuedAt.Time
+		}
+	}
+
+	return output, nil
+}
+
+/*
+ * Without a merge queue, GitHub only fails the mutation with a generic error,
+ * so the queue is checked first to fail with an actionable one.
+ */
+func ensureMergeQueue(client *githubv4.Client, owner, repository, branch string) error {
+	var query struct {
+		Repository struct {
+			MergeQueue *struct {
+				ID githubv4.ID
+			} `graphql:"mergeQueue(branch: $branch)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"branch": githubv4.String(branch),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return fmt.Errorf("failed to find merge queue of %s: %w", branch, err)
+	}
+
+	if query.Repository.MergeQueue != nil && query.Repository.MergeQueue.ID != nil {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: %s has no merge queue on %s, enable it with the Require merge queue branch protection rule, or merge the pull request directly",
+		ErrFeatureDisabled,
+		repository,
+		branch,
+	)
+}
+
+func (c *EnqueuePullRequest) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *EnqueuePullRequest) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *EnqueuePullRequest) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *EnqueuePullRequest) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *EnqueuePullRequest) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *EnqueuePullRequest) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
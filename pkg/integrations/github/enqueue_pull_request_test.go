@@ -0,0 +1,87 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__EnqueuePullRequest__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := EnqueuePullRequest{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__EnqueuePullRequest__Enqueue(t *testing.T) {
+	graphQLTransport := func(pullRequest, mergeQueue string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			switch {
+			case strings.Contains(string(body), "enqueuePullRequest("):
+				return mockResponse(http.StatusOK, `{"data":{"enqueuePullRequest":{"mergeQueueEntry":{"position":2,"state":"QUEUED","enqueuedAt":"2026-01-16T17:56:16Z","estimatedTimeToMerge":600}}}}`), nil
+			case strings.Contains(string(body), "mergeQueue("):
+				return mockResponse(http.StatusOK, `{"data":{"repository":{"mergeQueue":`+mergeQueue+`}}}`), nil
+			default:
+				return mockResponse(http.StatusOK, `{"data":{"repository":{"pullRequest":`+pullRequest+`}}}`), nil
+			}
+		}}
+	}
+
+	t.Run("pull request is enqueued", func(t *testing.T) {
+		transport := graphQLTransport(`{"id":"PR_1","baseRefName":"main"}`, `{"id":"MQ_1"}`)
+		entry, err := enqueuePullRequest(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+
+		assert.Equal(t, 42, entry.Number)
+		assert.Equal(t, "main", entry.BaseRef)
+		assert.Equal(t, "QUEUED", entry.State)
+		require.NotNil(t, entry.Position)
+		assert.Equal(t, 2, *entry.Position)
+		require.NotNil(t, entry.EstimatedTimeToMerge)
+		assert.Equal(t, 600, *entry.EstimatedTimeToMerge)
+		require.NotNil(t, entry.EnqueuedAt)
+	})
+
+	t.Run("no merge queue -> feature disabled, without enqueuing", func(t *testing.T) {
+		transport := graphQLTransport(`{"id":"PR_1","baseRefName":"main"}`, `null`)
+		_, err := enqueuePullRequest(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		assert.Contains(t, err.Error(), "Require merge queue")
+		assert.Len(t, transport.requests, 2)
+	})
+
+	t.Run("pull request not found -> error", func(t *testing.T) {
+		transport := graphQLTransport(`null`, `null`)
+		_, err := enqueuePullRequest(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Len(t, transport.requests, 1)
+	})
+}
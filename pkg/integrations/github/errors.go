@@ -0,0 +1,172 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/superplanehq/superplane/pkg/retry"
+)
+
+/*
+ * Error categories for GitHub API failures.
+ * Errors returned by the components wrap one of these,
+ * so callers can use errors.Is() to decide whether to retry or fail fast.
+ * They also implement core.CategorizedError and core.RetryableError,
+ * so error events describe them.
+ */
+var (
+	ErrNotFound            = newCategoryError("not found", false)
+	ErrRateLimited         = newCategoryError("rate limited", true)
+	ErrNotMergeable        = newCategoryError("not mergeable", false)
+	ErrPermissionDenied    = newCategoryError("permission denied", false)
+	ErrFeatureDisabled     = newCategoryError("feature disabled", false)
+	ErrLocked              = newCategoryError("locked", false)
+	ErrInstallationRevoked = newCategoryError("installation revoked", false)
+	ErrCircuitOpen         = newCategoryError("circuit open", true)
+	ErrEnvironmentNotFound = newCategoryError("environment not found", false)
+	ErrStaleHead           = newCategoryError("stale head", true)
+)
+
+type categoryError struct {
+	message   string
+	retryable bool
+}
+
+func newCategoryError(message string, retryable bool) error {
+	return &categoryError{message: message, retryable: retryable}
+}
+
+func (e *categoryError) Error() string {
+	return e.message
+}
+
+func (e *categoryError) Category() string {
+	return strings.ReplaceAll(e.message, " ", "_")
+}
+
+func (e *categoryError) Retryable() bool {
+	return e.retryable
+}
+
+/*
+ * Maps errors returned by the go-github client to the error categories above.
+ * Errors that do not fit any category are returned as they are.
+ */
+func wrapGitHubError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+
+	var abuseRateLimitErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseRateLimitErr) {
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+
+	var responseErr *github.ErrorResponse
+	if !errors.As(err, &responseErr) || responseErr.Response == nil {
+		return err
+	}
+
+	switch responseErr.Response.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %w", ErrPermissionDenied, err)
+
+	//
+	// The merge endpoints use 405 when the pull request is not mergeable.
+	//
+	case http.StatusMethodNotAllowed:
+		return fmt.Errorf("%w: %w", ErrNotMergeable, err)
+	}
+
+	return err
+}
+
+/*
+ * Security features like secret scanning can be disabled per repository.
+ * GitHub reports that with a 403 or 404, and a message saying the feature is disabled.
+ */
+func wrapFeatureError(err error, feature string) error {
+	var responseErr *github.ErrorResponse
+	if !errors.As(err, &responseErr) || responseErr.Response == nil {
+		return wrapGitHubError(err)
+	}
+
+	statusCode := responseErr.Response.StatusCode
+	if statusCode != http.StatusNotFound && statusCode != http.StatusForbidden {
+		return wrapGitHubError(err)
+	}
+
+	if strings.Contains(strings.ToLower(responseErr.Message), "disabled") {
+		return fmt.Errorf("%w: %s is not enabled for this repository: %w", ErrFeatureDisabled, feature, err)
+	}
+
+	return wrapGitHubError(err)
+}
+
+/*
+ * The environment endpoints return 404 when the environment does not exist.
+ * The repository is checked when the node is set up,
+ * so a 404 there means the environment is missing.
+ */
+func wrapEnvironmentError(err error, environment string) error {
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: environment %s does not exist: %w", ErrEnvironmentNotFound, environment, err)
+	}
+
+	return err
+}
+
+/*
+ * How many times, and after how long, rate limited calls are retried.
+ * The wait doubles after each attempt.
+ */
+var (
+	RateLimitRetries   = 3
+	RateLimitRetryWait = 2 * time.Second
+)
+
+/*
+ * Retries the call while GitHub rate limits it.
+ * Other errors are returned right away, wrapped with wrapGitHubError.
+ */
+func withRateLimitRetry(task string, call func() error) error {
+	var permanentErr error
+	err := retry.WithExponentialBackoff(func() error {
+		err := wrapGitHubError(call())
+		if err != nil && !errors.Is(err, ErrRateLimited) {
+			permanentErr = err
+			return nil
+		}
+
+		return err
+	}, retry.Options{
+		Task:        task,
+		MaxAttempts: RateLimitRetries,
+		Wait:        RateLimitRetryWait,
+		Verbose:     true,
+	})
+
+	if permanentErr != nil {
+		return permanentErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRateLimited, err)
+	}
+
+	return nil
+}
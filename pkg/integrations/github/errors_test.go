@@ -0,0 +1,97 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+func Test__WrapGitHubError(t *testing.T) {
+	responseError := func(statusCode int) error {
+		return fmt.Errorf("request failed: %w", &github.ErrorResponse{
+			Response: &http.Response{StatusCode: statusCode, Request: &http.Request{}},
+			Message:  http.StatusText(statusCode),
+		})
+	}
+
+	t.Run("nil -> nil", func(t *testing.T) {
+		assert.NoError(t, wrapGitHubError(nil))
+	})
+
+	t.Run("status codes are mapped to error categories", func(t *testing.T) {
+		assert.ErrorIs(t, wrapGitHubError(responseError(http.StatusNotFound)), ErrNotFound)
+		assert.ErrorIs(t, wrapGitHubError(responseError(http.StatusTooManyRequests)), ErrRateLimited)
+		assert.ErrorIs(t, wrapGitHubError(responseError(http.StatusUnauthorized)), ErrPermissionDenied)
+		assert.ErrorIs(t, wrapGitHubError(responseError(http.StatusForbidden)), ErrPermissionDenied)
+		assert.ErrorIs(t, wrapGitHubError(responseError(http.StatusMethodNotAllowed)), ErrNotMergeable)
+	})
+
+	t.Run("rate limit errors -> rate limited", func(t *testing.T) {
+		err := wrapGitHubError(&github.RateLimitError{Response: &http.Response{StatusCode: http.StatusForbidden, Request: &http.Request{}}})
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.NotErrorIs(t, err, ErrPermissionDenied)
+
+		err = wrapGitHubError(&github.AbuseRateLimitError{Response: &http.Response{StatusCode: http.StatusForbidden, Request: &http.Request{}}})
+		assert.ErrorIs(t, err, ErrRateLimited)
+	})
+
+	t.Run("original error is kept", func(t *testing.T) {
+		err := wrapGitHubError(responseError(http.StatusNotFound))
+
+		var responseErr *github.ErrorResponse
+		require.True(t, errors.As(err, &responseErr))
+		assert.Equal(t, http.StatusNotFound, responseErr.Response.StatusCode)
+	})
+
+	t.Run("other errors are returned as they are", func(t *testing.T) {
+		original := errors.New("connection reset")
+		assert.Equal(t, original, wrapGitHubError(original))
+
+		err := wrapGitHubError(responseError(http.StatusUnprocessableEntity))
+		assert.NotErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("error categories are exposed to error events", func(t *testing.T) {
+		event := core.NewErrorEvent(fmt.Errorf("failed to create comment: %w", wrapGitHubError(responseError(http.StatusTooManyRequests))))
+		assert.Equal(t, "rate_limited", event.Category)
+		assert.True(t, event.Retryable)
+
+		event = core.NewErrorEvent(fmt.Errorf("failed to create comment: %w", wrapGitHubError(responseError(http.StatusNotFound))))
+		assert.Equal(t, "not_found", event.Category)
+		assert.False(t, event.Retryable)
+	})
+}
+
+func Test__WrapFeatureError(t *testing.T) {
+	responseError := func(statusCode int, message string) error {
+		return &github.ErrorResponse{
+			Response: &http.Response{StatusCode: statusCode, Request: &http.Request{}},
+			Message:  message,
+		}
+	}
+
+	t.Run("disabled feature -> feature disabled", func(t *testing.T) {
+		err := wrapFeatureError(responseError(http.StatusNotFound, "Secret scanning is disabled on this repository."), "secret scanning")
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		assert.Contains(t, err.Error(), "secret scanning is not enabled for this repository")
+	})
+
+	t.Run("disabled Dependabot alerts -> feature disabled", func(t *testing.T) {
+		err := wrapFeatureError(responseError(http.StatusForbidden, "Dependabot alerts are disabled for this repository."), "Dependabot alerts")
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		require.NotErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("other errors keep their category", func(t *testing.T) {
+		err := wrapFeatureError(responseError(http.StatusNotFound, "Not Found"), "secret scanning")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.NotErrorIs(t, err, ErrFeatureDisabled)
+	})
+}
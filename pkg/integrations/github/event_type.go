@@ -0,0 +1,61 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+const MaxEventTypeLength = 100
+
+var eventTypeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*(\.[A-Za-z][A-Za-z0-9_-]*)*$`)
+
+/*
+ * Downstream nodes route on the type of the emitted events.
+ * Components with this field let users replace their default type,
+ * like github.issueComment, with a custom one.
+ */
+var EventTypeField = configuration.Field{
+	Name:        "eventType",
+	Label:       "Event Type",
+	Type:        configuration.FieldTypeString,
+	Togglable:   true,
+	Placeholder: "e.g., acme.deployComment",
+	Description: "Type of the emitted events, for routing. Defaults to the type of the component",
+}
+
+/*
+ * Actions receive the configuration with expressions not resolved,
+ * so the event type must be a plain string, which validateEventType enforces.
+ */
+func eventType(c any, defaultType string) string {
+	configMap, ok := c.(map[string]any)
+	if !ok {
+		return defaultType
+	}
+
+	customType, ok := configMap["eventType"].(string)
+	if !ok || customType == "" {
+		return defaultType
+	}
+
+	return customType
+}
+
+func validateEventType(c any) error {
+	customType := eventType(c, "")
+	if customType == "" {
+		return nil
+	}
+
+	if len(customType) > MaxEventTypeLength {
+		return fmt.Errorf("event type must be at most %d characters", MaxEventTypeLength)
+	}
+
+	if !eventTypeRegex.MatchString(customType) {
+		return fmt.Errorf("invalid event type %s: use letters, digits, - and _, in dot-separated segments, like acme.deployComment", customType)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package github
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__EventType(t *testing.T) {
+	t.Run("no custom type -> default is used", func(t *testing.T) {
+		assert.Equal(t, "github.issueComment", eventType(map[string]any{}, "github.issueComment"))
+		assert.Equal(t, "github.issueComment", eventType(map[string]any{"eventType": ""}, "github.issueComment"))
+		assert.Equal(t, "github.issueComment", eventType(nil, "github.issueComment"))
+	})
+
+	t.Run("custom type is used", func(t *testing.T) {
+		assert.Equal(t, "acme.deployComment", eventType(map[string]any{"eventType": "acme.deployComment"}, "github.issueComment"))
+	})
+}
+
+func Test__ValidateEventType(t *testing.T) {
+	require.NoError(t, validateEventType(map[string]any{}))
+	require.NoError(t, validateEventType(map[string]any{"eventType": "acme.deploy-comment_v2"}))
+	require.NoError(t, validateEventType(map[string]any{"eventType": "deploy"}))
+
+	for _, invalid := range []string{"acme..deploy", ".acme", "acme.", "1acme", "acme deploy", "acme.{{$.x}}"} {
+		err := validateEventType(map[string]any{"eventType": invalid})
+		require.ErrorContains(t, err, "invalid event type", invalid)
+	}
+
+	err := validateEventType(map[string]any{"eventType": strings.Repeat("a", MaxEventTypeLength+1)})
+	require.ErrorContains(t, err, "at most")
+}
@@ -34,6 +34,306 @@ var exampleOutputDeleteReleaseBytes []byte
 //go:embed example_output_run_workflow.json
 var exampleOutputRunWorkflowBytes []byte
 
+//go:embed example_output_list_issues.json
+var exampleOutputListIssuesBytes []byte
+
+//go:embed example_output_add_to_project.json
+var exampleOutputAddToProjectBytes []byte
+
+//go:embed example_output_delete_issue_comment.json
+var exampleOutputDeleteIssueCommentBytes []byte
+
+//go:embed example_output_create_issue_comment.json
+var exampleOutputCreateIssueCommentBytes []byte
+
+//go:embed example_output_get_pull_request.json
+var exampleOutputGetPullRequestBytes []byte
+
+//go:embed example_output_create_tag.json
+var exampleOutputCreateTagBytes []byte
+
+//go:embed example_output_edit_pull_request_body.json
+var exampleOutputEditPullRequestBodyBytes []byte
+
+//go:embed example_output_list_deployments.json
+var exampleOutputListDeploymentsBytes []byte
+
+//go:embed example_output_list_workflow_runs.json
+var exampleOutputListWorkflowRunsBytes []byte
+
+//go:embed example_output_rerun_workflow.json
+var exampleOutputRerunWorkflowBytes []byte
+
+//go:embed example_output_get_latest_release.json
+var exampleOutputGetLatestReleaseBytes []byte
+
+//go:embed example_output_list_secret_scanning_alerts.json
+var exampleOutputListSecretScanningAlertsBytes []byte
+
+//go:embed example_output_update_secret_scanning_alert.json
+var exampleOutputUpdateSecretScanningAlertBytes []byte
+
+//go:embed example_output_list_dependabot_alerts.json
+var exampleOutputListDependabotAlertsBytes []byte
+
+//go:embed example_output_dismiss_dependabot_alert.json
+var exampleOutputDismissDependabotAlertBytes []byte
+
+//go:embed example_output_create_repository_dispatch.json
+var exampleOutputCreateRepositoryDispatchBytes []byte
+
+//go:embed example_output_get_tree.json
+var exampleOutputGetTreeBytes []byte
+
+//go:embed example_output_invite_collaborator.json
+var exampleOutputInviteCollaboratorBytes []byte
+
+//go:embed example_output_list_pull_requests_for_commit.json
+var exampleOutputListPullRequestsForCommitBytes []byte
+
+//go:embed example_output_add_discussion_comment.json
+var exampleOutputAddDiscussionCommentBytes []byte
+
+//go:embed example_output_list_accessible_repositories.json
+var exampleOutputListAccessibleRepositoriesBytes []byte
+
+//go:embed example_output_list_commits.json
+var exampleOutputListCommitsBytes []byte
+
+//go:embed example_output_enable_auto_merge.json
+var exampleOutputEnableAutoMergeBytes []byte
+
+//go:embed example_output_set_pull_request_draft.json
+var exampleOutputSetPullRequestDraftBytes []byte
+
+//go:embed example_output_list_check_runs.json
+var exampleOutputListCheckRunsBytes []byte
+
+//go:embed example_output_wait_for_check_run.json
+var exampleOutputWaitForCheckRunBytes []byte
+
+//go:embed example_output_create_team_discussion.json
+var exampleOutputCreateTeamDiscussionBytes []byte
+
+//go:embed example_output_get_user.json
+var exampleOutputGetUserBytes []byte
+
+//go:embed example_output_bulk_close_issues.json
+var exampleOutputBulkCloseIssuesBytes []byte
+
+//go:embed example_output_get_pull_request_reviews.json
+var exampleOutputGetPullRequestReviewsBytes []byte
+
+//go:embed example_output_add_labels.json
+var exampleOutputAddLabelsBytes []byte
+
+//go:embed example_output_list_repository_labels.json
+var exampleOutputListRepositoryLabelsBytes []byte
+
+//go:embed example_output_create_or_update_label.json
+var exampleOutputCreateOrUpdateLabelBytes []byte
+
+//go:embed example_output_react_to_issue.json
+var exampleOutputReactToIssueBytes []byte
+
+//go:embed example_output_diff_refs.json
+var exampleOutputDiffRefsBytes []byte
+
+//go:embed example_output_get_commit.json
+var exampleOutputGetCommitBytes []byte
+
+//go:embed example_output_enqueue_pull_request.json
+var exampleOutputEnqueuePullRequestBytes []byte
+
+//go:embed example_output_remove_assignees.json
+var exampleOutputRemoveAssigneesBytes []byte
+
+//go:embed example_output_pin_issue.json
+var exampleOutputPinIssueBytes []byte
+
+//go:embed example_output_add_sub_issue.json
+var exampleOutputAddSubIssueBytes []byte
+
+//go:embed example_output_list_checks_for_pr.json
+var exampleOutputListChecksForPRBytes []byte
+
+//go:embed example_output_create_fork_sync.json
+var exampleOutputCreateForkSyncBytes []byte
+
+//go:embed example_output_set_default_branch.json
+var exampleOutputSetDefaultBranchBytes []byte
+
+//go:embed example_output_create_issue_from_template.json
+var exampleOutputCreateIssueFromTemplateBytes []byte
+
+//go:embed example_output_block_user.json
+var exampleOutputBlockUserBytes []byte
+
+//go:embed example_output_create_commit_comment.json
+var exampleOutputCreateCommitCommentBytes []byte
+
+//go:embed example_output_create_review_comment.json
+var exampleOutputCreateReviewCommentBytes []byte
+
+//go:embed example_output_archive_repository.json
+var exampleOutputArchiveRepositoryBytes []byte
+
+//go:embed example_output_list_stale_branches.json
+var exampleOutputListStaleBranchesBytes []byte
+
+//go:embed example_output_get_workflow_usage.json
+var exampleOutputGetWorkflowUsageBytes []byte
+
+//go:embed example_output_list_tags.json
+var exampleOutputListTagsBytes []byte
+
+//go:embed example_output_replay_webhook.json
+var exampleOutputReplayWebhookBytes []byte
+
+//go:embed example_output_get_default_branch_sha.json
+var exampleOutputGetDefaultBranchSHABytes []byte
+
+//go:embed example_output_list_environment_variables.json
+var exampleOutputListEnvironmentVariablesBytes []byte
+
+//go:embed example_output_list_environment_secrets.json
+var exampleOutputListEnvironmentSecretsBytes []byte
+
+//go:embed example_output_set_environment_variable.json
+var exampleOutputSetEnvironmentVariableBytes []byte
+
+//go:embed example_output_get_latest_deployment_status.json
+var exampleOutputGetLatestDeploymentStatusBytes []byte
+
+//go:embed example_output_rerequest_check_suite.json
+var exampleOutputRerequestCheckSuiteBytes []byte
+
+//go:embed example_output_list_forks.json
+var exampleOutputListForksBytes []byte
+
+//go:embed example_output_get_organization.json
+var exampleOutputGetOrganizationBytes []byte
+
+//go:embed example_output_create_pull_request_from_issue.json
+var exampleOutputCreatePullRequestFromIssueBytes []byte
+
+//go:embed example_output_merge_branch.json
+var exampleOutputMergeBranchBytes []byte
+
+//go:embed example_output_check_actions_secret.json
+var exampleOutputCheckActionsSecretBytes []byte
+
+//go:embed example_output_create_annotation.json
+var exampleOutputCreateAnnotationBytes []byte
+
+//go:embed example_output_list_notifications.json
+var exampleOutputListNotificationsBytes []byte
+
+//go:embed example_output_mark_notification_read.json
+var exampleOutputMarkNotificationReadBytes []byte
+
+//go:embed example_output_get_pull_request_diff.json
+var exampleOutputGetPullRequestDiffBytes []byte
+
+//go:embed example_output_create_signed_commit.json
+var exampleOutputCreateSignedCommitBytes []byte
+
+//go:embed example_output_list_self_hosted_runners.json
+var exampleOutputListSelfHostedRunnersBytes []byte
+
+//go:embed example_output_search_code.json
+var exampleOutputSearchCodeBytes []byte
+
+//go:embed example_output_wait_for_mergeable.json
+var exampleOutputWaitForMergeableBytes []byte
+
+//go:embed example_output_create_repository_webhook.json
+var exampleOutputCreateRepositoryWebhookBytes []byte
+
+//go:embed example_output_list_audit_log.json
+var exampleOutputListAuditLogBytes []byte
+
+//go:embed example_output_migrate_label.json
+var exampleOutputMigrateLabelBytes []byte
+
+//go:embed example_output_list_pull_request_commits.json
+var exampleOutputListPullRequestCommitsBytes []byte
+
+//go:embed example_output_set_labels.json
+var exampleOutputSetLabelsBytes []byte
+
+//go:embed example_output_set_repository_topics.json
+var exampleOutputSetRepositoryTopicsBytes []byte
+
+//go:embed example_output_create_or_update_environment.json
+var exampleOutputCreateOrUpdateEnvironmentBytes []byte
+
+//go:embed example_output_approve_deployment.json
+var exampleOutputApproveDeploymentBytes []byte
+
+//go:embed example_output_process_stale_issues.json
+var exampleOutputProcessStaleIssuesBytes []byte
+
+//go:embed example_output_list_org_members.json
+var exampleOutputListOrgMembersBytes []byte
+
+//go:embed example_output_revoke_installation_token.json
+var exampleOutputRevokeInstallationTokenBytes []byte
+
+//go:embed example_output_generate_changelog.json
+var exampleOutputGenerateChangelogBytes []byte
+
+//go:embed example_output_generate_release_notes.json
+var exampleOutputGenerateReleaseNotesBytes []byte
+
+//go:embed example_output_batch_get_files.json
+var exampleOutputBatchGetFilesBytes []byte
+
+//go:embed example_output_wait_for_required_checks.json
+var exampleOutputWaitForRequiredChecksBytes []byte
+
+//go:embed example_output_list_pending_invitations.json
+var exampleOutputListPendingInvitationsBytes []byte
+
+//go:embed example_output_cancel_invitation.json
+var exampleOutputCancelInvitationBytes []byte
+
+//go:embed example_output_request_copilot_review.json
+var exampleOutputRequestCopilotReviewBytes []byte
+
+//go:embed example_output_bulk_lock_conversations.json
+var exampleOutputBulkLockConversationsBytes []byte
+
+//go:embed example_output_get_latest_successful_run.json
+var exampleOutputGetLatestSuccessfulRunBytes []byte
+
+//go:embed example_output_create_release_with_assets.json
+var exampleOutputCreateReleaseWithAssetsBytes []byte
+
+//go:embed example_output_get_pull_request_by_branch.json
+var exampleOutputGetPullRequestByBranchBytes []byte
+
+//go:embed example_output_dispatch_and_acknowledge.json
+var exampleOutputDispatchAndAcknowledgeBytes []byte
+
+//go:embed example_output_list_org_repositories.json
+var exampleOutputListOrgRepositoriesBytes []byte
+
+//go:embed example_output_set_commit_statuses.json
+var exampleOutputSetCommitStatusesBytes []byte
+
+//go:embed example_output_find_code_owners.json
+var exampleOutputFindCodeOwnersBytes []byte
+
+//go:embed example_output_summarize_pull_request.json
+var exampleOutputSummarizePullRequestBytes []byte
+
+//go:embed example_output_detect_conflicting_pull_requests.json
+var exampleOutputDetectConflictingPullRequestsBytes []byte
+
+//go:embed example_output_post_deployment_summary.json
+var exampleOutputPostDeploymentSummaryBytes []byte
+
 //go:embed example_data_on_issue_comment.json
 var exampleDataOnIssueCommentBytes []byte
 
@@ -61,6 +361,9 @@ var exampleDataOnBranchCreatedBytes []byte
 //go:embed example_data_on_workflow_run.json
 var exampleDataOnWorkflowRunBytes []byte
 
+//go:embed example_data_webhook_router.json
+var exampleDataWebhookRouterBytes []byte
+
 var exampleOutputCreateIssueOnce sync.Once
 var exampleOutputCreateIssue map[string]any
 
@@ -88,6 +391,306 @@ var exampleOutputDeleteRelease map[string]any
 var exampleOutputRunWorkflowOnce sync.Once
 var exampleOutputRunWorkflow map[string]any
 
+var exampleOutputListIssuesOnce sync.Once
+var exampleOutputListIssues map[string]any
+
+var exampleOutputAddToProjectOnce sync.Once
+var exampleOutputAddToProject map[string]any
+
+var exampleOutputDeleteIssueCommentOnce sync.Once
+var exampleOutputDeleteIssueComment map[string]any
+
+var exampleOutputCreateIssueCommentOnce sync.Once
+var exampleOutputCreateIssueComment map[string]any
+
+var exampleOutputGetPullRequestOnce sync.Once
+var exampleOutputGetPullRequest map[string]any
+
+var exampleOutputCreateTagOnce sync.Once
+var exampleOutputCreateTag map[string]any
+
+var exampleOutputEditPullRequestBodyOnce sync.Once
+var exampleOutputEditPullRequestBody map[string]any
+
+var exampleOutputListDeploymentsOnce sync.Once
+var exampleOutputListDeployments map[string]any
+
+var exampleOutputListWorkflowRunsOnce sync.Once
+var exampleOutputListWorkflowRuns map[string]any
+
+var exampleOutputRerunWorkflowOnce sync.Once
+var exampleOutputRerunWorkflow map[string]any
+
+var exampleOutputGetLatestReleaseOnce sync.Once
+var exampleOutputGetLatestRelease map[string]any
+
+var exampleOutputListSecretScanningAlertsOnce sync.Once
+var exampleOutputListSecretScanningAlerts map[string]any
+
+var exampleOutputUpdateSecretScanningAlertOnce sync.Once
+var exampleOutputUpdateSecretScanningAlert map[string]any
+
+var exampleOutputListDependabotAlertsOnce sync.Once
+var exampleOutputListDependabotAlerts map[string]any
+
+var exampleOutputDismissDependabotAlertOnce sync.Once
+var exampleOutputDismissDependabotAlert map[string]any
+
+var exampleOutputCreateRepositoryDispatchOnce sync.Once
+var exampleOutputCreateRepositoryDispatch map[string]any
+
+var exampleOutputGetTreeOnce sync.Once
+var exampleOutputGetTree map[string]any
+
+var exampleOutputInviteCollaboratorOnce sync.Once
+var exampleOutputInviteCollaborator map[string]any
+
+var exampleOutputListPullRequestsForCommitOnce sync.Once
+var exampleOutputListPullRequestsForCommit map[string]any
+
+var exampleOutputAddDiscussionCommentOnce sync.Once
+var exampleOutputAddDiscussionComment map[string]any
+
+var exampleOutputListAccessibleRepositoriesOnce sync.Once
+var exampleOutputListAccessibleRepositories map[string]any
+
+var exampleOutputListCommitsOnce sync.Once
+var exampleOutputListCommits map[string]any
+
+var exampleOutputEnableAutoMergeOnce sync.Once
+var exampleOutputEnableAutoMerge map[string]any
+
+var exampleOutputSetPullRequestDraftOnce sync.Once
+var exampleOutputSetPullRequestDraft map[string]any
+
+var exampleOutputListCheckRunsOnce sync.Once
+var exampleOutputListCheckRuns map[string]any
+
+var exampleOutputWaitForCheckRunOnce sync.Once
+var exampleOutputWaitForCheckRun map[string]any
+
+var exampleOutputCreateTeamDiscussionOnce sync.Once
+var exampleOutputCreateTeamDiscussion map[string]any
+
+var exampleOutputGetUserOnce sync.Once
+var exampleOutputGetUser map[string]any
+
+var exampleOutputBulkCloseIssuesOnce sync.Once
+var exampleOutputBulkCloseIssues map[string]any
+
+var exampleOutputGetPullRequestReviewsOnce sync.Once
+var exampleOutputGetPullRequestReviews map[string]any
+
+var exampleOutputAddLabelsOnce sync.Once
+var exampleOutputAddLabels map[string]any
+
+var exampleOutputListRepositoryLabelsOnce sync.Once
+var exampleOutputListRepositoryLabels map[string]any
+
+var exampleOutputCreateOrUpdateLabelOnce sync.Once
+var exampleOutputCreateOrUpdateLabel map[string]any
+
+var exampleOutputReactToIssueOnce sync.Once
+var exampleOutputReactToIssue map[string]any
+
+var exampleOutputDiffRefsOnce sync.Once
+var exampleOutputDiffRefs map[string]any
+
+var exampleOutputGetCommitOnce sync.Once
+var exampleOutputGetCommit map[string]any
+
+var exampleOutputEnqueuePullRequestOnce sync.Once
+var exampleOutputEnqueuePullRequest map[string]any
+
+var exampleOutputRemoveAssigneesOnce sync.Once
+var exampleOutputRemoveAssignees map[string]any
+
+var exampleOutputPinIssueOnce sync.Once
+var exampleOutputPinIssue map[string]any
+
+var exampleOutputAddSubIssueOnce sync.Once
+var exampleOutputAddSubIssue map[string]any
+
+var exampleOutputListChecksForPROnce sync.Once
+var exampleOutputListChecksForPR map[string]any
+
+var exampleOutputCreateForkSyncOnce sync.Once
+var exampleOutputCreateForkSync map[string]any
+
+var exampleOutputSetDefaultBranchOnce sync.Once
+var exampleOutputSetDefaultBranch map[string]any
+
+var exampleOutputCreateIssueFromTemplateOnce sync.Once
+var exampleOutputCreateIssueFromTemplate map[string]any
+
+var exampleOutputBlockUserOnce sync.Once
+var exampleOutputBlockUser map[string]any
+
+var exampleOutputCreateCommitCommentOnce sync.Once
+var exampleOutputCreateCommitComment map[string]any
+
+var exampleOutputCreateReviewCommentOnce sync.Once
+var exampleOutputCreateReviewComment map[string]any
+
+var exampleOutputArchiveRepositoryOnce sync.Once
+var exampleOutputArchiveRepository map[string]any
+
+var exampleOutputListStaleBranchesOnce sync.Once
+var exampleOutputListStaleBranches map[string]any
+
+var exampleOutputGetWorkflowUsageOnce sync.Once
+var exampleOutputGetWorkflowUsage map[string]any
+
+var exampleOutputListTagsOnce sync.Once
+var exampleOutputListTags map[string]any
+
+var exampleOutputReplayWebhookOnce sync.Once
+var exampleOutputReplayWebhook map[string]any
+
+var exampleOutputGetDefaultBranchSHAOnce sync.Once
+var exampleOutputGetDefaultBranchSHA map[string]any
+
+var exampleOutputListEnvironmentVariablesOnce sync.Once
+var exampleOutputListEnvironmentVariables map[string]any
+
+var exampleOutputListEnvironmentSecretsOnce sync.Once
+var exampleOutputListEnvironmentSecrets map[string]any
+
+var exampleOutputSetEnvironmentVariableOnce sync.Once
+var exampleOutputSetEnvironmentVariable map[string]any
+
+var exampleOutputGetLatestDeploymentStatusOnce sync.Once
+var exampleOutputGetLatestDeploymentStatus map[string]any
+
+var exampleOutputRerequestCheckSuiteOnce sync.Once
+var exampleOutputRerequestCheckSuite map[string]any
+
+var exampleOutputListForksOnce sync.Once
+var exampleOutputListForks map[string]any
+
+var exampleOutputGetOrganizationOnce sync.Once
+var exampleOutputGetOrganization map[string]any
+
+var exampleOutputCreatePullRequestFromIssueOnce sync.Once
+var exampleOutputCreatePullRequestFromIssue map[string]any
+
+var exampleOutputMergeBranchOnce sync.Once
+var exampleOutputMergeBranch map[string]any
+
+var exampleOutputCheckActionsSecretOnce sync.Once
+var exampleOutputCheckActionsSecret map[string]any
+
+var exampleOutputCreateAnnotationOnce sync.Once
+var exampleOutputCreateAnnotation map[string]any
+
+var exampleOutputListNotificationsOnce sync.Once
+var exampleOutputListNotifications map[string]any
+
+var exampleOutputMarkNotificationReadOnce sync.Once
+var exampleOutputMarkNotificationRead map[string]any
+
+var exampleOutputGetPullRequestDiffOnce sync.Once
+var exampleOutputGetPullRequestDiff map[string]any
+
+var exampleOutputCreateSignedCommitOnce sync.Once
+var exampleOutputCreateSignedCommit map[string]any
+
+var exampleOutputListSelfHostedRunnersOnce sync.Once
+var exampleOutputListSelfHostedRunners map[string]any
+
+var exampleOutputSearchCodeOnce sync.Once
+var exampleOutputSearchCode map[string]any
+
+var exampleOutputWaitForMergeableOnce sync.Once
+var exampleOutputWaitForMergeable map[string]any
+
+var exampleOutputCreateRepositoryWebhookOnce sync.Once
+var exampleOutputCreateRepositoryWebhook map[string]any
+
+var exampleOutputListAuditLogOnce sync.Once
+var exampleOutputListAuditLog map[string]any
+
+var exampleOutputMigrateLabelOnce sync.Once
+var exampleOutputMigrateLabel map[string]any
+
+var exampleOutputListPullRequestCommitsOnce sync.Once
+var exampleOutputListPullRequestCommits map[string]any
+
+var exampleOutputSetLabelsOnce sync.Once
+var exampleOutputSetLabels map[string]any
+
+var exampleOutputSetRepositoryTopicsOnce sync.Once
+var exampleOutputSetRepositoryTopics map[string]any
+
+var exampleOutputCreateOrUpdateEnvironmentOnce sync.Once
+var exampleOutputCreateOrUpdateEnvironment map[string]any
+
+var exampleOutputApproveDeploymentOnce sync.Once
+var exampleOutputApproveDeployment map[string]any
+
+var exampleOutputProcessStaleIssuesOnce sync.Once
+var exampleOutputProcessStaleIssues map[string]any
+
+var exampleOutputListOrgMembersOnce sync.Once
+var exampleOutputListOrgMembers map[string]any
+
+var exampleOutputRevokeInstallationTokenOnce sync.Once
+var exampleOutputRevokeInstallationToken map[string]any
+
+var exampleOutputGenerateChangelogOnce sync.Once
+var exampleOutputGenerateChangelog map[string]any
+
+var exampleOutputGenerateReleaseNotesOnce sync.Once
+var exampleOutputGenerateReleaseNotes map[string]any
+
+var exampleOutputBatchGetFilesOnce sync.Once
+var exampleOutputBatchGetFiles map[string]any
+
+var exampleOutputWaitForRequiredChecksOnce sync.Once
+var exampleOutputWaitForRequiredChecks map[string]any
+
+var exampleOutputListPendingInvitationsOnce sync.Once
+var exampleOutputListPendingInvitations map[string]any
+
+var exampleOutputCancelInvitationOnce sync.Once
+var exampleOutputCancelInvitation map[string]any
+
+var exampleOutputRequestCopilotReviewOnce sync.Once
+var exampleOutputRequestCopilotReview map[string]any
+
+var exampleOutputBulkLockConversationsOnce sync.Once
+var exampleOutputBulkLockConversations map[string]any
+
+var exampleOutputGetLatestSuccessfulRunOnce sync.Once
+var exampleOutputGetLatestSuccessfulRun map[string]any
+
+var exampleOutputCreateReleaseWithAssetsOnce sync.Once
+var exampleOutputCreateReleaseWithAssets map[string]any
+
+var exampleOutputGetPullRequestByBranchOnce sync.Once
+var exampleOutputGetPullRequestByBranch map[string]any
+
+var exampleOutputDispatchAndAcknowledgeOnce sync.Once
+var exampleOutputDispatchAndAcknowledge map[string]any
+
+var exampleOutputListOrgRepositoriesOnce sync.Once
+var exampleOutputListOrgRepositories map[string]any
+
+var exampleOutputSetCommitStatusesOnce sync.Once
+var exampleOutputSetCommitStatuses map[string]any
+
+var exampleOutputFindCodeOwnersOnce sync.Once
+var exampleOutputFindCodeOwners map[string]any
+
+var exampleOutputSummarizePullRequestOnce sync.Once
+var exampleOutputSummarizePullRequest map[string]any
+
+var exampleOutputDetectConflictingPullRequestsOnce sync.Once
+var exampleOutputDetectConflictingPullRequests map[string]any
+
+var exampleOutputPostDeploymentSummaryOnce sync.Once
+var exampleOutputPostDeploymentSummary map[string]any
+
 var exampleDataOnIssueCommentOnce sync.Once
 var exampleDataOnIssueComment map[string]any
 
@@ -115,6 +718,9 @@ var exampleDataOnBranchCreated map[string]any
 var exampleDataOnWorkflowRunOnce sync.Once
 var exampleDataOnWorkflowRun map[string]any
 
+var exampleDataWebhookRouterOnce sync.Once
+var exampleDataWebhookRouter map[string]any
+
 func (c *CreateIssue) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateIssueOnce, exampleOutputCreateIssueBytes, &exampleOutputCreateIssue)
 }
@@ -194,3 +800,407 @@ func (t *OnBranchCreated) ExampleData() map[string]any {
 func (t *OnWorkflowRun) ExampleData() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleDataOnWorkflowRunOnce, exampleDataOnWorkflowRunBytes, &exampleDataOnWorkflowRun)
 }
+
+func (t *WebhookRouter) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataWebhookRouterOnce, exampleDataWebhookRouterBytes, &exampleDataWebhookRouter)
+}
+
+func (c *ListIssues) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListIssuesOnce, exampleOutputListIssuesBytes, &exampleOutputListIssues)
+}
+
+func (c *AddToProject) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputAddToProjectOnce, exampleOutputAddToProjectBytes, &exampleOutputAddToProject)
+}
+
+func (c *DeleteIssueComment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputDeleteIssueCommentOnce, exampleOutputDeleteIssueCommentBytes, &exampleOutputDeleteIssueComment)
+}
+
+func (c *CreateIssueComment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateIssueCommentOnce, exampleOutputCreateIssueCommentBytes, &exampleOutputCreateIssueComment)
+}
+
+func (c *GetPullRequest) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetPullRequestOnce, exampleOutputGetPullRequestBytes, &exampleOutputGetPullRequest)
+}
+
+func (c *CreateTag) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateTagOnce, exampleOutputCreateTagBytes, &exampleOutputCreateTag)
+}
+
+func (c *EditPullRequestBody) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputEditPullRequestBodyOnce, exampleOutputEditPullRequestBodyBytes, &exampleOutputEditPullRequestBody)
+}
+
+func (c *ListDeployments) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListDeploymentsOnce, exampleOutputListDeploymentsBytes, &exampleOutputListDeployments)
+}
+
+func (c *ListWorkflowRuns) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListWorkflowRunsOnce, exampleOutputListWorkflowRunsBytes, &exampleOutputListWorkflowRuns)
+}
+
+func (c *RerunWorkflow) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputRerunWorkflowOnce, exampleOutputRerunWorkflowBytes, &exampleOutputRerunWorkflow)
+}
+
+func (c *GetLatestRelease) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetLatestReleaseOnce, exampleOutputGetLatestReleaseBytes, &exampleOutputGetLatestRelease)
+}
+
+func (c *ListSecretScanningAlerts) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListSecretScanningAlertsOnce, exampleOutputListSecretScanningAlertsBytes, &exampleOutputListSecretScanningAlerts)
+}
+
+func (c *UpdateSecretScanningAlert) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputUpdateSecretScanningAlertOnce, exampleOutputUpdateSecretScanningAlertBytes, &exampleOutputUpdateSecretScanningAlert)
+}
+
+func (c *ListDependabotAlerts) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListDependabotAlertsOnce, exampleOutputListDependabotAlertsBytes, &exampleOutputListDependabotAlerts)
+}
+
+func (c *DismissDependabotAlert) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputDismissDependabotAlertOnce, exampleOutputDismissDependabotAlertBytes, &exampleOutputDismissDependabotAlert)
+}
+
+func (c *CreateRepositoryDispatch) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateRepositoryDispatchOnce, exampleOutputCreateRepositoryDispatchBytes, &exampleOutputCreateRepositoryDispatch)
+}
+
+func (c *GetTree) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetTreeOnce, exampleOutputGetTreeBytes, &exampleOutputGetTree)
+}
+
+func (c *InviteCollaborator) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputInviteCollaboratorOnce, exampleOutputInviteCollaboratorBytes, &exampleOutputInviteCollaborator)
+}
+
+func (c *ListPullRequestsForCommit) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListPullRequestsForCommitOnce, exampleOutputListPullRequestsForCommitBytes, &exampleOutputListPullRequestsForCommit)
+}
+
+func (c *AddDiscussionComment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputAddDiscussionCommentOnce, exampleOutputAddDiscussionCommentBytes, &exampleOutputAddDiscussionComment)
+}
+
+func (c *ListAccessibleRepositories) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListAccessibleRepositoriesOnce, exampleOutputListAccessibleRepositoriesBytes, &exampleOutputListAccessibleRepositories)
+}
+
+func (c *ListCommits) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListCommitsOnce, exampleOutputListCommitsBytes, &exampleOutputListCommits)
+}
+
+func (c *EnableAutoMerge) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputEnableAutoMergeOnce, exampleOutputEnableAutoMergeBytes, &exampleOutputEnableAutoMerge)
+}
+
+func (c *SetPullRequestDraft) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetPullRequestDraftOnce, exampleOutputSetPullRequestDraftBytes, &exampleOutputSetPullRequestDraft)
+}
+
+func (c *ListCheckRuns) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListCheckRunsOnce, exampleOutputListCheckRunsBytes, &exampleOutputListCheckRuns)
+}
+
+func (c *WaitForCheckRun) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputWaitForCheckRunOnce, exampleOutputWaitForCheckRunBytes, &exampleOutputWaitForCheckRun)
+}
+
+func (c *CreateTeamDiscussion) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateTeamDiscussionOnce, exampleOutputCreateTeamDiscussionBytes, &exampleOutputCreateTeamDiscussion)
+}
+
+func (c *GetUser) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetUserOnce, exampleOutputGetUserBytes, &exampleOutputGetUser)
+}
+
+func (c *BulkCloseIssues) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputBulkCloseIssuesOnce, exampleOutputBulkCloseIssuesBytes, &exampleOutputBulkCloseIssues)
+}
+
+func (c *GetPullRequestReviews) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetPullRequestReviewsOnce, exampleOutputGetPullRequestReviewsBytes, &exampleOutputGetPullRequestReviews)
+}
+
+func (c *AddLabels) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputAddLabelsOnce, exampleOutputAddLabelsBytes, &exampleOutputAddLabels)
+}
+
+func (c *ListRepositoryLabels) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListRepositoryLabelsOnce, exampleOutputListRepositoryLabelsBytes, &exampleOutputListRepositoryLabels)
+}
+
+func (c *CreateOrUpdateLabel) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateOrUpdateLabelOnce, exampleOutputCreateOrUpdateLabelBytes, &exampleOutputCreateOrUpdateLabel)
+}
+
+func (c *ReactToIssue) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputReactToIssueOnce, exampleOutputReactToIssueBytes, &exampleOutputReactToIssue)
+}
+
+func (c *DiffRefs) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputDiffRefsOnce, exampleOutputDiffRefsBytes, &exampleOutputDiffRefs)
+}
+
+func (c *GetCommit) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetCommitOnce, exampleOutputGetCommitBytes, &exampleOutputGetCommit)
+}
+
+func (c *EnqueuePullRequest) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputEnqueuePullRequestOnce, exampleOutputEnqueuePullRequestBytes, &exampleOutputEnqueuePullRequest)
+}
+
+func (c *RemoveAssignees) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputRemoveAssigneesOnce, exampleOutputRemoveAssigneesBytes, &exampleOutputRemoveAssignees)
+}
+
+func (c *PinIssue) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputPinIssueOnce, exampleOutputPinIssueBytes, &exampleOutputPinIssue)
+}
+
+func (c *AddSubIssue) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputAddSubIssueOnce, exampleOutputAddSubIssueBytes, &exampleOutputAddSubIssue)
+}
+
+func (c *ListChecksForPR) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListChecksForPROnce, exampleOutputListChecksForPRBytes, &exampleOutputListChecksForPR)
+}
+
+func (c *CreateForkSync) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateForkSyncOnce, exampleOutputCreateForkSyncBytes, &exampleOutputCreateForkSync)
+}
+
+func (c *SetDefaultBranch) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetDefaultBranchOnce, exampleOutputSetDefaultBranchBytes, &exampleOutputSetDefaultBranch)
+}
+
+func (c *CreateIssueFromTemplate) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateIssueFromTemplateOnce, exampleOutputCreateIssueFromTemplateBytes, &exampleOutputCreateIssueFromTemplate)
+}
+
+func (c *BlockUser) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputBlockUserOnce, exampleOutputBlockUserBytes, &exampleOutputBlockUser)
+}
+
+func (c *CreateCommitComment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateCommitCommentOnce, exampleOutputCreateCommitCommentBytes, &exampleOutputCreateCommitComment)
+}
+
+func (c *CreateReviewComment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateReviewCommentOnce, exampleOutputCreateReviewCommentBytes, &exampleOutputCreateReviewComment)
+}
+
+func (c *ArchiveRepository) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputArchiveRepositoryOnce, exampleOutputArchiveRepositoryBytes, &exampleOutputArchiveRepository)
+}
+
+func (c *ListStaleBranches) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListStaleBranchesOnce, exampleOutputListStaleBranchesBytes, &exampleOutputListStaleBranches)
+}
+
+func (c *GetWorkflowUsage) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetWorkflowUsageOnce, exampleOutputGetWorkflowUsageBytes, &exampleOutputGetWorkflowUsage)
+}
+
+func (c *ListTags) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListTagsOnce, exampleOutputListTagsBytes, &exampleOutputListTags)
+}
+
+func (c *ReplayWebhook) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputReplayWebhookOnce, exampleOutputReplayWebhookBytes, &exampleOutputReplayWebhook)
+}
+
+func (c *GetDefaultBranchSHA) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetDefaultBranchSHAOnce, exampleOutputGetDefaultBranchSHABytes, &exampleOutputGetDefaultBranchSHA)
+}
+
+func (c *ListEnvironmentVariables) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListEnvironmentVariablesOnce, exampleOutputListEnvironmentVariablesBytes, &exampleOutputListEnvironmentVariables)
+}
+
+func (c *ListEnvironmentSecrets) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListEnvironmentSecretsOnce, exampleOutputListEnvironmentSecretsBytes, &exampleOutputListEnvironmentSecrets)
+}
+
+func (c *SetEnvironmentVariable) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetEnvironmentVariableOnce, exampleOutputSetEnvironmentVariableBytes, &exampleOutputSetEnvironmentVariable)
+}
+
+func (c *GetLatestDeploymentStatus) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetLatestDeploymentStatusOnce, exampleOutputGetLatestDeploymentStatusBytes, &exampleOutputGetLatestDeploymentStatus)
+}
+
+func (c *RerequestCheckSuite) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputRerequestCheckSuiteOnce, exampleOutputRerequestCheckSuiteBytes, &exampleOutputRerequestCheckSuite)
+}
+
+func (c *ListForks) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListForksOnce, exampleOutputListForksBytes, &exampleOutputListForks)
+}
+
+func (c *GetOrganization) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetOrganizationOnce, exampleOutputGetOrganizationBytes, &exampleOutputGetOrganization)
+}
+
+func (c *CreatePullRequestFromIssue) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreatePullRequestFromIssueOnce, exampleOutputCreatePullRequestFromIssueBytes, &exampleOutputCreatePullRequestFromIssue)
+}
+
+func (c *MergeBranch) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputMergeBranchOnce, exampleOutputMergeBranchBytes, &exampleOutputMergeBranch)
+}
+
+func (c *CheckActionsSecret) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCheckActionsSecretOnce, exampleOutputCheckActionsSecretBytes, &exampleOutputCheckActionsSecret)
+}
+
+func (c *CreateAnnotation) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateAnnotationOnce, exampleOutputCreateAnnotationBytes, &exampleOutputCreateAnnotation)
+}
+
+func (c *ListNotifications) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListNotificationsOnce, exampleOutputListNotificationsBytes, &exampleOutputListNotifications)
+}
+
+func (c *MarkNotificationRead) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputMarkNotificationReadOnce, exampleOutputMarkNotificationReadBytes, &exampleOutputMarkNotificationRead)
+}
+
+func (c *GetPullRequestDiff) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetPullRequestDiffOnce, exampleOutputGetPullRequestDiffBytes, &exampleOutputGetPullRequestDiff)
+}
+
+func (c *CreateSignedCommit) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateSignedCommitOnce, exampleOutputCreateSignedCommitBytes, &exampleOutputCreateSignedCommit)
+}
+
+func (c *ListSelfHostedRunners) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListSelfHostedRunnersOnce, exampleOutputListSelfHostedRunnersBytes, &exampleOutputListSelfHostedRunners)
+}
+
+func (c *SearchCode) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSearchCodeOnce, exampleOutputSearchCodeBytes, &exampleOutputSearchCode)
+}
+
+func (c *WaitForMergeable) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputWaitForMergeableOnce, exampleOutputWaitForMergeableBytes, &exampleOutputWaitForMergeable)
+}
+
+func (c *CreateRepositoryWebhook) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateRepositoryWebhookOnce, exampleOutputCreateRepositoryWebhookBytes, &exampleOutputCreateRepositoryWebhook)
+}
+
+func (c *ListAuditLog) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListAuditLogOnce, exampleOutputListAuditLogBytes, &exampleOutputListAuditLog)
+}
+
+func (c *MigrateLabel) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputMigrateLabelOnce, exampleOutputMigrateLabelBytes, &exampleOutputMigrateLabel)
+}
+
+func (c *ListPullRequestCommits) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListPullRequestCommitsOnce, exampleOutputListPullRequestCommitsBytes, &exampleOutputListPullRequestCommits)
+}
+
+func (c *SetLabels) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetLabelsOnce, exampleOutputSetLabelsBytes, &exampleOutputSetLabels)
+}
+
+func (c *SetRepositoryTopics) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetRepositoryTopicsOnce, exampleOutputSetRepositoryTopicsBytes, &exampleOutputSetRepositoryTopics)
+}
+
+func (c *CreateOrUpdateEnvironment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateOrUpdateEnvironmentOnce, exampleOutputCreateOrUpdateEnvironmentBytes, &exampleOutputCreateOrUpdateEnvironment)
+}
+
+func (c *ApproveDeployment) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputApproveDeploymentOnce, exampleOutputApproveDeploymentBytes, &exampleOutputApproveDeployment)
+}
+
+func (c *ProcessStaleIssues) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputProcessStaleIssuesOnce, exampleOutputProcessStaleIssuesBytes, &exampleOutputProcessStaleIssues)
+}
+
+func (c *ListOrgMembers) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListOrgMembersOnce, exampleOutputListOrgMembersBytes, &exampleOutputListOrgMembers)
+}
+
+func (c *RevokeInstallationToken) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputRevokeInstallationTokenOnce, exampleOutputRevokeInstallationTokenBytes, &exampleOutputRevokeInstallationToken)
+}
+
+func (c *GenerateChangelog) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGenerateChangelogOnce, exampleOutputGenerateChangelogBytes, &exampleOutputGenerateChangelog)
+}
+
+func (c *GenerateReleaseNotes) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGenerateReleaseNotesOnce, exampleOutputGenerateReleaseNotesBytes, &exampleOutputGenerateReleaseNotes)
+}
+
+func (c *BatchGetFiles) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputBatchGetFilesOnce, exampleOutputBatchGetFilesBytes, &exampleOutputBatchGetFiles)
+}
+
+func (c *WaitForRequiredChecks) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputWaitForRequiredChecksOnce, exampleOutputWaitForRequiredChecksBytes, &exampleOutputWaitForRequiredChecks)
+}
+
+func (c *ListPendingInvitations) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListPendingInvitationsOnce, exampleOutputListPendingInvitationsBytes, &exampleOutputListPendingInvitations)
+}
+
+func (c *CancelInvitation) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCancelInvitationOnce, exampleOutputCancelInvitationBytes, &exampleOutputCancelInvitation)
+}
+
+func (c *RequestCopilotReview) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputRequestCopilotReviewOnce, exampleOutputRequestCopilotReviewBytes, &exampleOutputRequestCopilotReview)
+}
+
+func (c *BulkLockConversations) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputBulkLockConversationsOnce, exampleOutputBulkLockConversationsBytes, &exampleOutputBulkLockConversations)
+}
+
+func (c *GetLatestSuccessfulRun) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetLatestSuccessfulRunOnce, exampleOutputGetLatestSuccessfulRunBytes, &exampleOutputGetLatestSuccessfulRun)
+}
+
+func (c *CreateReleaseWithAssets) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateReleaseWithAssetsOnce, exampleOutputCreateReleaseWithAssetsBytes, &exampleOutputCreateReleaseWithAssets)
+}
+
+func (c *GetPullRequestByBranch) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputGetPullRequestByBranchOnce, exampleOutputGetPullRequestByBranchBytes, &exampleOutputGetPullRequestByBranch)
+}
+
+func (c *DispatchAndAcknowledge) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputDispatchAndAcknowledgeOnce, exampleOutputDispatchAndAcknowledgeBytes, &exampleOutputDispatchAndAcknowledge)
+}
+
+func (c *ListOrgRepositories) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputListOrgRepositoriesOnce, exampleOutputListOrgRepositoriesBytes, &exampleOutputListOrgRepositories)
+}
+
+func (c *SetCommitStatuses) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSetCommitStatusesOnce, exampleOutputSetCommitStatusesBytes, &exampleOutputSetCommitStatuses)
+}
+
+func (c *FindCodeOwners) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputFindCodeOwnersOnce, exampleOutputFindCodeOwnersBytes, &exampleOutputFindCodeOwners)
+}
+
+func (c *SummarizePullRequest) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputSummarizePullRequestOnce, exampleOutputSummarizePullRequestBytes, &exampleOutputSummarizePullRequest)
+}
+
+func (c *DetectConflictingPullRequests) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputDetectConflictingPullRequestsOnce, exampleOutputDetectConflictingPullRequestsBytes, &exampleOutputDetectConflictingPullRequests)
+}
+
+func (c *PostDeploymentSummary) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputPostDeploymentSummaryOnce, exampleOutputPostDeploymentSummaryBytes, &exampleOutputPostDeploymentSummary)
+}
@@ -0,0 +1,281 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const MaxCodeOwnersPaths = 1000
+
+type FindCodeOwners struct{}
+
+type FindCodeOwnersConfiguration struct {
+	Repository string   `json:"repository" mapstructure:"repository"`
+	Ref        string   `json:"ref" mapstructure:"ref"`
+	Paths      []string `json:"paths" mapstructure:"paths"`
+}
+
+type CodeOwnersOutput struct {
+	Found  bool              `json:"found"`
+	File   string            `json:"file,omitempty"`
+	Owners []string          `json:"owners"`
+	Paths  []CodeOwnersMatch `json:"paths"`
+	Errors []string          `json:"errors,omitempty"`
+}
+
+type CodeOwnersMatch struct {
+	Path    string   `json:"path"`
+	Owners  []string `json:"owners"`
+	Pattern string   `json:"pattern,omitempty"`
+	Line    int      `json:"line,omitempty"`
+}
+
+func (c *FindCodeOwners) Name() string {
+	return "github.findCodeOwners"
+}
+
+func (c *FindCodeOwners) Label() string {
+	return "Find Code Owners"
+}
+
+func (c *FindCodeOwners) Description() string {
+	return "Find the code owners of files from the CODEOWNERS file of a GitHub repository"
+}
+
+func (c *FindCodeOwners) Documentation() string {
+	return `The Find Code Owners component reads the CODEOWNERS file of a repository, and finds the owners of each of a list of paths.
+
+## Use Cases
+
+- **Review routing**: Request reviews from the owners of the files changed by a pull request
+- **Notifications**: Notify the teams owning the parts of the code affected by an incident
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Branch or Tag**: The ref to read the CODEOWNERS file at. Defaults to the default branch of the repository
+- **Paths**: The paths of the files to find the owners of (supports expressions)
+
+## Output
+
+Emits a ` + "`github.codeOwners`" + ` event with:
+
+- ` + "`found`" + `: whether the repository has a CODEOWNERS file, and ` + "`file`" + `, its path
+- ` + "`paths`" + `: for each path, its ` + "`owners`" + `, and the ` + "`pattern`" + ` and ` + "`line`" + ` of the rule that matched
+- ` + "`owners`" + `: the owners of all paths, without duplicates
+- ` + "`errors`" + `: the lines of the file that were skipped, and why
+
+## Notes
+
+- Like on GitHub, the file is read from ` + "`.github/CODEOWNERS`" + `, ` + "`CODEOWNERS`" + ` or ` + "`docs/CODEOWNERS`" + `, and the first one found is used
+- Like on GitHub, the last rule matching a path wins, and a rule without owners leaves the path without owners
+- Lines with invalid owners, negated patterns or character ranges are skipped, since GitHub skips them too
+- If the repository has no CODEOWNERS file, ` + "`found`" + ` is false, every path has no owners, and the execution still succeeds
+- Owners are not checked for write access to the repository`
+}
+
+func (c *FindCodeOwners) Icon() string {
+	return "github"
+}
+
+func (c *FindCodeOwners) Color() string {
+	return "gray"
+}
+
+func (c *FindCodeOwners) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *FindCodeOwners) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "ref",
+			Label:       "Branch or Tag",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Leave empty to use the default branch",
+		},
+		{
+			Name:     "paths",
+			Label:    "Paths",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Path",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *FindCodeOwners) Setup(ctx core.SetupContext) error {
+	var config FindCodeOwnersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.Paths) == 0 {
+		return errors.New("at least one path is required")
+	}
+
+	if len(config.Paths) > MaxCodeOwnersPaths {
+		return fmt.Errorf("%d paths configured, but at most %d are supported", len(config.Paths), MaxCodeOwnersPaths)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *FindCodeOwners) Execute(ctx core.ExecutionContext) error {
+	var config FindCodeOwnersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := findCodeOwners(client, appMetadata.Owner, config.Repository, config.Ref, config.Paths)
+	if err != nil {
+		return err
+	}
+
+	if !output.Found {
+		ctx.Logger.Infof("No CODEOWNERS file found in %s", config.Repository)
+	}
+
+	for _, message := range output.Errors {
+		ctx.Logger.Warnf("Skipped %s %s", output.File, message)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.codeOwners",
+		[]any{output},
+	)
+}
+
+func findCodeOwners(client *github.Client, owner, repo, ref string, paths []string) (*CodeOwnersOutput, error) {
+	file, content, err := getCodeOwnersFile(client, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &CodeOwnersOutput{
+		Found:  file != "",
+		File:   file,
+		Owners: []string{},
+		Paths:  []CodeOwnersMatch{},
+	}
+
+	rules, invalid := parseCodeOwners(content)
+	if len(invalid) > 0 {
+		output.Errors = invalid
+	}
+
+	for _, path := range paths {
+		match := CodeOwnersMatch{Path: path, Owners: []string{}}
+		if rule := matchCodeOwners(rules, path); rule != nil {
+			match.Owners = rule.Owners
+			match.Pattern = rule.Pattern
+			match.Line = rule.Line
+		}
+
+		for _, codeOwner := range match.Owners {
+			if !slices.Contains(output.Owners, codeOwner) {
+				output.Owners = append(output.Owners, codeOwner)
+			}
+		}
+
+		output.Paths = append(output.Paths, match)
+	}
+
+	return output, nil
+}
+
+/*
+ * An empty file name means the repository has no CODEOWNERS file.
+ */
+func getCodeOwnersFile(client *github.Client, owner, repo, ref string) (string, string, error) {
+	for _, location := range CodeOwnersLocations {
+		file, _, response, err := client.Repositories.GetContents(context.Background(), owner, repo, location, &github.RepositoryContentGetOptions{Ref: ref})
+		if isNotFound(response) {
+			continue
+		}
+
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get %s: %w", location, wrapGitHubError(err))
+		}
+
+		if file == nil {
+			continue
+		}
+
+		content, err := file.GetContent()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode %s: %w", location, err)
+		}
+
+		return location, content, nil
+	}
+
+	return "", "", nil
+}
+
+func (c *FindCodeOwners) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *FindCodeOwners) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *FindCodeOwners) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *FindCodeOwners) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *FindCodeOwners) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *FindCodeOwners) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,117 @@
+package github
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__FindCodeOwners__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := FindCodeOwners{}
+
+	t.Run("paths are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "at least one path is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "paths": []any{"README.md"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__FindCodeOwners__Find(t *testing.T) {
+	codeOwners := "*  @testhq/core\n/api/  @testhq/api @alice\n/docs/\nbad-line owner\n"
+	contentResponse := func(path, content string) *http.Response {
+		return mockResponse(http.StatusOK, `{"type": "file", "path": "`+path+`", "encoding": "base64", "content": "`+base64.StdEncoding.EncodeToString([]byte(content))+`"}`)
+	}
+
+	t.Run("first file found is used", func(t *testing.T) {
+		transport := &mockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "/contents/CODEOWNERS") {
+					return contentResponse("CODEOWNERS", codeOwners), nil
+				}
+
+				return mockResponse(http.StatusNotFound, `{"message": "Not Found"}`), nil
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		output, err := findCodeOwners(client, "testhq", "hello", "release", []string{"api/server.go", "README.md", "docs/index.md"})
+		require.NoError(t, err)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/contents/.github/CODEOWNERS", transport.requests[0].URL.Path)
+		assert.Equal(t, "release", transport.requests[1].URL.Query().Get("ref"))
+
+		assert.True(t, output.Found)
+		assert.Equal(t, "CODEOWNERS", output.File)
+		require.Len(t, output.Paths, 3)
+		assert.Equal(t, []string{"@testhq/api", "@alice"}, output.Paths[0].Owners)
+		assert.Equal(t, 2, output.Paths[0].Line)
+		assert.Equal(t, []string{"@testhq/core"}, output.Paths[1].Owners)
+		assert.Equal(t, []string{}, output.Paths[2].Owners)
+		assert.Equal(t, "/docs/", output.Paths[2].Pattern)
+		assert.Equal(t, []string{"@testhq/api", "@alice", "@testhq/core"}, output.Owners)
+		require.Len(t, output.Errors, 1)
+		assert.Contains(t, output.Errors[0], "line 4")
+	})
+
+	t.Run("no CODEOWNERS file -> empty result", func(t *testing.T) {
+		transport := &mockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusNotFound, `{"message": "Not Found"}`), nil
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		output, err := findCodeOwners(client, "testhq", "hello", "", []string{"README.md"})
+		require.NoError(t, err)
+
+		assert.Len(t, transport.requests, 3)
+		assert.False(t, output.Found)
+		assert.Empty(t, output.File)
+		assert.Empty(t, output.Owners)
+		require.Len(t, output.Paths, 1)
+		assert.Equal(t, []string{}, output.Paths[0].Owners)
+	})
+
+	t.Run("other errors fail", func(t *testing.T) {
+		transport := &mockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusForbidden, `{"message": "Resource not accessible by integration"}`), nil
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		_, err := findCodeOwners(client, "testhq", "hello", "", []string{"README.md"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "failed to get .github/CODEOWNERS")
+	})
+}
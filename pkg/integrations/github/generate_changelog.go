@@ -0,0 +1,520 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+)
+
+const ChangelogOtherSection = "Other"
+
+/*
+ * Sections used when none are configured.
+ */
+var DefaultChangelogSections = []ChangelogSectionDefinition{
+	{Title: "Features", Labels: "feature, enhancement"},
+	{Title: "Fixes", Labels: "fix, bug"},
+	{Title: "Chores", Labels: "chore, dependencies"},
+}
+
+type GenerateChangelog struct{}
+
+type GenerateChangelogConfiguration struct {
+	Repository string                       `json:"repository" mapstructure:"repository"`
+	FromTag    string                       `json:"fromTag" mapstructure:"fromTag"`
+	ToTag      string                       `json:"toTag" mapstructure:"toTag"`
+	Sections   []ChangelogSectionDefinition `json:"sections" mapstructure:"sections"`
+	Template   string                       `json:"template" mapstructure:"template"`
+}
+
+type ChangelogSectionDefinition struct {
+	Title  string `json:"title" mapstructure:"title"`
+	Labels string `json:"labels" mapstructure:"labels"`
+}
+
+type ChangelogOutput struct {
+	Repository        string             `json:"repository"`
+	From              string             `json:"from"`
+	To                string             `json:"to"`
+	CompareURL        string             `json:"compare_url"`
+	Sections          []ChangelogSection `json:"sections"`
+	Commits           []CommitSummary    `json:"commits_without_pull_request"`
+	CommitsCount      int                `json:"commits_count"`
+	PullRequestsCount int                `json:"pull_requests_count"`
+	Markdown          string             `json:"markdown"`
+}
+
+type ChangelogSection struct {
+	Title        string                 `json:"title"`
+	PullRequests []ChangelogPullRequest `json:"pull_requests"`
+}
+
+type ChangelogPullRequest struct {
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	Author string   `json:"author"`
+	URL    string   `json:"html_url"`
+	Labels []string `json:"labels"`
+}
+
+func (c *GenerateChangelog) Name() string {
+	return "github.generateChangelog"
+}
+
+func (c *GenerateChangelog) Label() string {
+	return "Generate Changelog"
+}
+
+func (c *GenerateChangelog) Description() string {
+	return "Generate the changelog between two GitHub releases, grouped by pull request labels"
+}
+
+func (c *GenerateChangelog) Documentation() string {
+	return `The Generate Changelog component compares two tags, finds the pull requests that were merged between them, and groups them into sections by their labels, as Markdown.
+
+## Use Cases
+
+- **Release notes**: Generate the notes of a release, and pass them to Create Release
+- **Release announcements**: Post what changed since the last release to a chat channel
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **From Tag**: The tag to compare from. Defaults to the release before **To Tag**
+- **To Tag**: The tag to compare to. Defaults to the latest release
+- **Sections**: The sections of the changelog, each with a title and the comma-separated labels of its pull requests.
+  Defaults to **Features** (` + "`feature`" + `, ` + "`enhancement`" + `), **Fixes** (` + "`fix`" + `, ` + "`bug`" + `) and **Chores** (` + "`chore`" + `, ` + "`dependencies`" + `)
+- **Template**: A Go template for the Markdown, rendered against the output, like ` + "`{{ range .sections }}## {{ .title }}{{ end }}`" + `
+
+## Output
+
+Emits a ` + "`github.changelog`" + ` event with:
+- ` + "`from`" + ` and ` + "`to`" + `: The compared tags, and the ` + "`compare_url`" + `
+- ` + "`sections`" + `: Each section ` + "`title`" + ` and its ` + "`pull_requests`" + `, with their ` + "`number`" + `, ` + "`title`" + `, ` + "`author`" + `, ` + "`html_url`" + ` and ` + "`labels`" + `
+- ` + "`commits_without_pull_request`" + `: The commits that were pushed directly
+- ` + "`markdown`" + `: The rendered changelog
+
+## Notes
+
+- A pull request goes in the first section with one of its labels, and pull requests without any go in an **Other** section. Empty sections are left out of the default Markdown
+- Labels are matched ignoring case
+- Finding the pull request of each commit takes one request per commit`
+}
+
+func (c *GenerateChangelog) Icon() string {
+	return "github"
+}
+
+func (c *GenerateChangelog) Color() string {
+	return "gray"
+}
+
+func (c *GenerateChangelog) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GenerateChangelog) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "fromTag",
+			Label:       "From Tag",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., v1.2.0",
+			Description: "Defaults to the release before the to tag",
+		},
+		{
+			Name:        "toTag",
+			Label:       "To Tag",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., v1.3.0",
+			Description: "Defaults to the latest release",
+		},
+		{
+			Name:      "sections",
+			Label:     "Sections",
+			Type:      configuration.FieldTypeList,
+			Togglable: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Section",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "title",
+								Label:    "Title",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:        "labels",
+								Label:       "Labels",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Placeholder: "e.g., feature, enhancement",
+								Description: "Comma-separated labels of the pull requests in the section",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:               "template",
+			Label:              "Template",
+			Type:               configuration.FieldTypeText,
+			Togglable:          true,
+			DisallowExpression: true,
+			Placeholder:        "e.g., {{ range .sections }}## {{ .title }}{{ end }}",
+			Description:        "Go template for the Markdown, rendered against the output",
+		},
+	}
+}
+
+func (c *GenerateChangelog) Setup(ctx core.SetupContext) error {
+	var config GenerateChangelogConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	for _, section := range config.Sections {
+		if strings.TrimSpace(section.Title) == "" {
+			return errors.New("section title is required")
+		}
+
+		if len(changelogLabels(section.Labels)) == 0 {
+			return fmt.Errorf("section %s has no labels", section.Title)
+		}
+	}
+
+	if config.Template != "" {
+		if _, err := template.Parse("template", config.Template, template.GitHubFuncs); err != nil {
+			return err
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GenerateChangelog) Execute(ctx core.ExecutionContext) error {
+	var config GenerateChangelogConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := generateChangelog(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Generated changelog from %s to %s, with %d pull requests", output.From, output.To, output.PullRequestsCount)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.changelog",
+		[]any{output},
+	)
+}
+
+func generateChangelog(client *github.Client, owner string, config GenerateChangelogConfiguration) (*ChangelogOutput, error) {
+	from, to, err := changelogTags(client, owner, config.Repository, strings.TrimSpace(config.FromTag), strings.TrimSpace(config.ToTag))
+	if err != nil {
+		return nil, err
+	}
+
+	commits, compareURL, err := compareAllCommits(client, owner, config.Repository, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := config.Sections
+	if len(definitions) == 0 {
+		definitions = DefaultChangelogSections
+	}
+
+	output := &ChangelogOutput{
+		Repository:   config.Repository,
+		From:         from,
+		To:           to,
+		CompareURL:   compareURL,
+		Sections:     []ChangelogSection{},
+		Commits:      []CommitSummary{},
+		CommitsCount: len(commits),
+	}
+
+	for _, definition := range definitions {
+		output.Sections = append(output.Sections, ChangelogSection{Title: definition.Title, PullRequests: []ChangelogPullRequest{}})
+	}
+
+	output.Sections = append(output.Sections, ChangelogSection{Title: ChangelogOtherSection, PullRequests: []ChangelogPullRequest{}})
+
+	seen := map[int]bool{}
+	for _, commit := range commits {
+		pull, err := commitPullRequest(client, owner, config.Repository, commit.GetSHA())
+		if err != nil {
+			return nil, err
+		}
+
+		if pull == nil {
+			output.Commits = append(output.Commits, summarizeCommit(commit))
+			continue
+		}
+
+		if seen[pull.GetNumber()] {
+			continue
+		}
+
+		seen[pull.GetNumber()] = true
+		entry := ChangelogPullRequest{
+			Number: pull.GetNumber(),
+			Title:  pull.GetTitle(),
+			Author: pull.GetUser().GetLogin(),
+			URL:    pull.GetHTMLURL(),
+			Labels: []string{},
+		}
+
+		for _, label := range pull.Labels {
+			entry.Labels = append(entry.Labels, label.GetName())
+		}
+
+		index := changelogSectionIndex(definitions, entry.Labels)
+		output.Sections[index].PullRequests = append(output.Sections[index].PullRequests, entry)
+		output.PullRequestsCount++
+	}
+
+	output.Markdown, err = renderChangelog(output, config.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+/*
+ * Without tags, the two latest releases are compared.
+ * Releases are listed newest first, and drafts have no tag yet, so they are skipped.
+ */
+func changelogTags(client *github.Client, owner, repository, from, to string) (string, string, error) {
+	if from != "" && to != "" {
+		return from, to, nil
+	}
+
+	tags := []string{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		releases, response, err := client.Repositories.ListReleases(context.Background(), owner, repository, opts)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to list releases: %w", wrapGitHubError(err))
+		}
+
+		for _, release := range releases {
+			if !release.GetDraft() {
+				tags = append(tags, release.GetTagName())
+			}
+		}
+
+		index := 0
+		if to != "" {
+			index = slices.Index(tags, to)
+		}
+
+		if index >= 0 && index+1 < len(tags) {
+			if to == "" {
+				to = tags[0]
+			}
+
+			if from == "" {
+				from = tags[index+1]
+			}
+
+			return from, to, nil
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	if to == "" {
+		return "", "", fmt.Errorf("%w: %s has fewer than two releases to compare", ErrNotFound, repository)
+	}
+
+	return "", "", fmt.Errorf("%w: no release before %s", ErrNotFound, to)
+}
+
+func compareAllCommits(client *github.Client, owner, repository, from, to string) ([]*github.RepositoryCommit, string, error) {
+	commits := []*github.RepositoryCommit{}
+	compareURL := ""
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		comparison, response, err := client.Repositories.CompareCommits(context.Background(), owner, repository, from, to, opts)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to compare %s with %s: %w", from, to, wrapGitHubError(err))
+		}
+
+		compareURL = comparison.GetHTMLURL()
+		commits = append(commits, comparison.Commits...)
+		if response.NextPage == 0 {
+			return commits, compareURL, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Returns the merged pull request the commit came from,
+ * or nil if it was pushed directly.
+ */
+func commitPullRequest(client *github.Client, owner, repository, sha string) (*github.PullRequest, error) {
+	var pulls []*github.PullRequest
+	err := withRateLimitRetry(fmt.Sprintf("list pull requests of commit %s", sha), func() error {
+		var err error
+		pulls, _, err = client.PullRequests.ListPullRequestsWithCommit(context.Background(), owner, repository, sha, &github.ListOptions{PerPage: 100})
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests of commit %s: %w", sha, err)
+	}
+
+	for _, pull := range pulls {
+		if pull.MergedAt != nil {
+			return pull, nil
+		}
+	}
+
+	return nil, nil
+}
+
+/*
+ * Returns the index of the first section with one of the labels,
+ * or of the Other section, which comes after the configured ones.
+ */
+func changelogSectionIndex(definitions []ChangelogSectionDefinition, labels []string) int {
+	for i, definition := range definitions {
+		for _, label := range changelogLabels(definition.Labels) {
+			if containsLabelName(labels, label) {
+				return i
+			}
+		}
+	}
+
+	return len(definitions)
+}
+
+func changelogLabels(labels string) []string {
+	names := []string{}
+	for _, label := range strings.Split(labels, ",") {
+		names = appendLabelName(names, label)
+	}
+
+	return names
+}
+
+func renderChangelog(output *ChangelogOutput, text string) (string, error) {
+	if text != "" {
+		//
+		// The template uses the JSON field names, like the rest of the event data.
+		//
+		var data map[string]any
+		encoded, err := json.Marshal(output)
+		if err != nil {
+			return "", err
+		}
+
+		if err := json.Unmarshal(encoded, &data); err != nil {
+			return "", err
+		}
+
+		return template.Render("template", text, data, template.GitHubFuncs)
+	}
+
+	var markdown strings.Builder
+	fmt.Fprintf(&markdown, "## What's changed in %s\n", output.To)
+	for _, section := range output.Sections {
+		if len(section.PullRequests) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&markdown, "\n### %s\n\n", section.Title)
+		for _, pull := range section.PullRequests {
+			fmt.Fprintf(&markdown, "- %s (#%d) @%s\n", pull.Title, pull.Number, pull.Author)
+		}
+	}
+
+	if len(output.Commits) > 0 {
+		markdown.WriteString("\n### Commits\n\n")
+		for _, commit := range output.Commits {
+			fmt.Fprintf(&markdown, "- %s (%s)\n", commit.Message, commit.SHA[:min(len(commit.SHA), 7)])
+		}
+	}
+
+	if output.CompareURL != "" {
+		fmt.Fprintf(&markdown, "\n**Full changelog**: %s\n", output.CompareURL)
+	}
+
+	return markdown.String(), nil
+}
+
+func (c *GenerateChangelog) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GenerateChangelog) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GenerateChangelog) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GenerateChangelog) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GenerateChangelog) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GenerateChangelog) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
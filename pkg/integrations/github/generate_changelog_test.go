@@ -0,0 +1,129 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GenerateChangelog__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GenerateChangelog{}
+
+	setup := func(configuration map[string]any) error {
+		configuration["repository"] = "hello"
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("section without labels -> error", func(t *testing.T) {
+		err := setup(map[string]any{"sections": []map[string]any{{"title": "Docs", "labels": " , "}}})
+		require.ErrorContains(t, err, "section Docs has no labels")
+	})
+
+	t.Run("invalid template -> error", func(t *testing.T) {
+		require.ErrorIs(t, setup(map[string]any{"template": "{{ range .sections }"}), template.ErrInvalidTemplate)
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "toTag": "v1.3.0"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GenerateChangelog__Generate(t *testing.T) {
+	pulls := map[string]string{
+		"aaa": `[{"number":1,"title":"Add SSO","user":{"login":"octocat"},"merged_at":"2026-01-10T00:00:00Z","labels":[{"name":"Enhancement"}]}]`,
+		"bbb": `[{"number":2,"title":"Fix upload","user":{"login":"hubot"},"merged_at":"2026-01-11T00:00:00Z","labels":[{"name":"bug"},{"name":"feature"}]}]`,
+		"ccc": `[{"number":1,"title":"Add SSO","user":{"login":"octocat"},"merged_at":"2026-01-10T00:00:00Z","labels":[{"name":"enhancement"}]}]`,
+		"ddd": `[{"number":3,"title":"Unmerged","merged_at":null}]`,
+		"eee": `[{"number":4,"title":"Update docs","user":{"login":"octocat"},"merged_at":"2026-01-12T00:00:00Z","labels":[]}]`,
+	}
+
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		path := request.URL.Path
+		switch {
+		case strings.HasSuffix(path, "/releases"):
+			return mockResponse(http.StatusOK, `[{"tag_name":"v1.4.0","draft":true},{"tag_name":"v1.3.0"},{"tag_name":"v1.2.0"},{"tag_name":"v1.1.0"}]`), nil
+		case strings.Contains(path, "/compare/"):
+			return mockResponse(http.StatusOK, `{"html_url":"https://github.com/testhq/hello/compare/v1.2.0...v1.3.0","commits":[
+				{"sha":"aaa"},{"sha":"bbb"},{"sha":"ccc"},{"sha":"ddd","commit":{"message":"Bump version\n\nDetails","author":{"name":"Octo Cat"}}},{"sha":"eee"}
+			]}`), nil
+		case strings.HasSuffix(path, "/pulls"):
+			sha := strings.Split(path, "/")[5]
+			return mockResponse(http.StatusOK, pulls[sha]), nil
+		}
+
+		return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+	}}
+
+	t.Run("latest releases compared, pull requests grouped by label", func(t *testing.T) {
+		transport.requests = nil
+		output, err := generateChangelog(github.NewClient(&http.Client{Transport: transport}), "testhq", GenerateChangelogConfiguration{Repository: "hello"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "v1.2.0", output.From)
+		assert.Equal(t, "v1.3.0", output.To)
+		assert.Equal(t, "/repos/testhq/hello/compare/v1.2.0...v1.3.0", transport.requests[1].URL.Path)
+		assert.Equal(t, 5, output.CommitsCount)
+		assert.Equal(t, 3, output.PullRequestsCount)
+
+		titles := []string{}
+		for _, section := range output.Sections {
+			numbers := []string{}
+			for _, pull := range section.PullRequests {
+				numbers = append(numbers, pull.Title)
+			}
+
+			titles = append(titles, section.Title+": "+strings.Join(numbers, ", "))
+		}
+
+		assert.Equal(t, []string{"Features: Add SSO, Fix upload", "Fixes: ", "Chores: ", "Other: Update docs"}, titles)
+		require.Len(t, output.Commits, 1)
+		assert.Equal(t, "Bump version", output.Commits[0].Message)
+
+		assert.Equal(t, "## What's changed in v1.3.0\n\n"+
+			"### Features\n\n- Add SSO (#1) @octocat\n- Fix upload (#2) @hubot\n\n"+
+			"### Other\n\n- Update docs (#4) @octocat\n\n"+
+			"### Commits\n\n- Bump version (ddd)\n\n"+
+			"**Full changelog**: https://github.com/testhq/hello/compare/v1.2.0...v1.3.0\n", output.Markdown)
+	})
+
+	t.Run("to tag given -> compared with the release before it, with custom sections and template", func(t *testing.T) {
+		transport.requests = nil
+		config := GenerateChangelogConfiguration{
+			Repository: "hello",
+			ToTag:      "v1.2.0",
+			Sections:   []ChangelogSectionDefinition{{Title: "Bugs", Labels: "bug"}},
+			Template:   `{{ range .sections }}{{ .title }}={{ len .pull_requests }};{{ end }}`,
+		}
+
+		output, err := generateChangelog(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.1.0", output.From)
+		assert.Equal(t, "/repos/testhq/hello/compare/v1.1.0...v1.2.0", transport.requests[1].URL.Path)
+		assert.Equal(t, "Bugs=1;Other=2;", output.Markdown)
+	})
+
+	t.Run("no release before the to tag -> not found", func(t *testing.T) {
+		_, err := generateChangelog(github.NewClient(&http.Client{Transport: transport}), "testhq", GenerateChangelogConfiguration{Repository: "hello", ToTag: "v1.1.0"})
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "no release before v1.1.0")
+	})
+}
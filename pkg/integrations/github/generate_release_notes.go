@@ -0,0 +1,231 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GenerateReleaseNotes struct{}
+
+type GenerateReleaseNotesConfiguration struct {
+	Repository      string `json:"repository" mapstructure:"repository"`
+	TagName         string `json:"tagName" mapstructure:"tagName"`
+	PreviousTagName string `json:"previousTagName" mapstructure:"previousTagName"`
+	TargetCommitish string `json:"targetCommitish" mapstructure:"targetCommitish"`
+}
+
+type ReleaseNotesOutput struct {
+	TagName         string `json:"tag_name"`
+	PreviousTagName string `json:"previous_tag_name,omitempty"`
+	FirstRelease    bool   `json:"first_release"`
+	Name            string `json:"name"`
+	Body            string `json:"body"`
+}
+
+func (c *GenerateReleaseNotes) Name() string {
+	return "github.generateReleaseNotes"
+}
+
+func (c *GenerateReleaseNotes) Label() string {
+	return "Generate Release Notes"
+}
+
+func (c *GenerateReleaseNotes) Description() string {
+	return "Generate the release notes of a GitHub release, without creating it"
+}
+
+func (c *GenerateReleaseNotes) Documentation() string {
+	return `The Generate Release Notes component generates release notes with GitHub, the same way as when creating a release, without creating the release.
+
+## Use Cases
+
+- **Release previews**: Review or post the notes of a release before creating it with Create Release
+- **Approvals**: Send the notes of the next release for approval
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Tag Name**: The tag of the release. It does not have to exist yet
+- **Previous Tag Name**: The tag to generate the notes from. Defaults to the latest release
+- **Target Commitish**: The branch or commit the tag is created from, if it does not exist yet. Defaults to the default branch
+
+## Output
+
+Emits a ` + "`github.releaseNotes`" + ` event with the ` + "`tag_name`" + `, the generated ` + "`name`" + ` and ` + "`body`" + ` Markdown, and the ` + "`previous_tag_name`" + ` the notes start from.
+
+## Notes
+
+- If the repository has no release yet and no previous tag is given, the notes cover the whole history, and ` + "`first_release`" + ` is true
+- The notes follow the ` + "`.github/release.yml`" + ` configuration of the repository, if there is one`
+}
+
+func (c *GenerateReleaseNotes) Icon() string {
+	return "github"
+}
+
+func (c *GenerateReleaseNotes) Color() string {
+	return "gray"
+}
+
+func (c *GenerateReleaseNotes) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GenerateReleaseNotes) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "tagName",
+			Label:       "Tag Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., v1.3.0",
+		},
+		{
+			Name:        "previousTagName",
+			Label:       "Previous Tag Name",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., v1.2.0",
+			Description: "Defaults to the latest release",
+		},
+		{
+			Name:        "targetCommitish",
+			Label:       "Target Commitish",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Branch or commit the tag is created from, if it does not exist yet",
+		},
+	}
+}
+
+func (c *GenerateReleaseNotes) Setup(ctx core.SetupContext) error {
+	var config GenerateReleaseNotesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.TagName) == "" {
+		return errors.New("tag name is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GenerateReleaseNotes) Execute(ctx core.ExecutionContext) error {
+	var config GenerateReleaseNotesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := generateReleaseNotes(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.releaseNotes",
+		[]any{output},
+	)
+}
+
+/*
+ * GitHub generates the notes from the latest release when no previous tag is given,
+ * so it is looked up to report where the notes start, and whether there is one at all.
+ */
+func generateReleaseNotes(client *github.Client, owner string, config GenerateReleaseNotesConfiguration) (*ReleaseNotesOutput, error) {
+	output := &ReleaseNotesOutput{
+		TagName:         strings.TrimSpace(config.TagName),
+		PreviousTagName: strings.TrimSpace(config.PreviousTagName),
+	}
+
+	opts := &github.GenerateNotesOptions{TagName: output.TagName}
+	if output.PreviousTagName != "" {
+		opts.PreviousTagName = github.Ptr(output.PreviousTagName)
+	} else {
+		latest, response, err := client.Repositories.GetLatestRelease(context.Background(), owner, config.Repository)
+		switch {
+		case isNotFound(response):
+			output.FirstRelease = true
+		case err != nil:
+			return nil, fmt.Errorf("failed to get latest release: %w", wrapGitHubError(err))
+		case latest.GetTagName() != output.TagName:
+			output.PreviousTagName = latest.GetTagName()
+		}
+	}
+
+	if config.TargetCommitish != "" {
+		opts.TargetCommitish = github.Ptr(config.TargetCommitish)
+	}
+
+	notes, response, err := client.Repositories.GenerateReleaseNotes(context.Background(), owner, config.Repository, opts)
+	if err != nil {
+		if config.PreviousTagName != "" && response != nil && (response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusUnprocessableEntity) {
+			return nil, fmt.Errorf("%w: previous tag %s does not exist in %s", ErrNotFound, output.PreviousTagName, config.Repository)
+		}
+
+		return nil, fmt.Errorf("failed to generate release notes: %w", wrapGitHubError(err))
+	}
+
+	output.Name = notes.Name
+	output.Body = notes.Body
+	return output, nil
+}
+
+func (c *GenerateReleaseNotes) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GenerateReleaseNotes) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GenerateReleaseNotes) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GenerateReleaseNotes) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GenerateReleaseNotes) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GenerateReleaseNotes) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GenerateReleaseNotes__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GenerateReleaseNotes{}
+
+	t.Run("tag name is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "tagName": " "},
+		})
+
+		require.ErrorContains(t, err, "tag name is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "tagName": "v1.3.0"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GenerateReleaseNotes__Generate(t *testing.T) {
+	transportFor := func(latestStatus int, notesStatus int) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(request.URL.Path, "/releases/latest") {
+				if latestStatus != http.StatusOK {
+					return mockResponse(latestStatus, `{"message":"Not Found"}`), nil
+				}
+
+				return mockResponse(http.StatusOK, `{"tag_name":"v1.2.0"}`), nil
+			}
+
+			if notesStatus != http.StatusOK {
+				return mockResponse(notesStatus, `{"message":"Validation Failed"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"v1.3.0","body":"## What's Changed"}`), nil
+		}}
+	}
+
+	t.Run("no previous tag -> notes from the latest release", func(t *testing.T) {
+		transport := transportFor(http.StatusOK, http.StatusOK)
+		config := GenerateReleaseNotesConfiguration{Repository: "hello", TagName: "v1.3.0", TargetCommitish: "main"}
+		output, err := generateReleaseNotes(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, "/repos/testhq/hello/releases/generate-notes", transport.requests[1].URL.Path)
+		body, _ := io.ReadAll(transport.requests[1].Body)
+		assert.JSONEq(t, `{"tag_name":"v1.3.0","target_commitish":"main"}`, string(body))
+		assert.Equal(t, &ReleaseNotesOutput{TagName: "v1.3.0", PreviousTagName: "v1.2.0", Name: "v1.3.0", Body: "## What's Changed"}, output)
+	})
+
+	t.Run("no release yet -> first release", func(t *testing.T) {
+		transport := transportFor(http.StatusNotFound, http.StatusOK)
+		output, err := generateReleaseNotes(github.NewClient(&http.Client{Transport: transport}), "testhq", GenerateReleaseNotesConfiguration{Repository: "hello", TagName: "v1.0.0"})
+		require.NoError(t, err)
+		assert.True(t, output.FirstRelease)
+		assert.Empty(t, output.PreviousTagName)
+	})
+
+	t.Run("previous tag given -> sent, latest release not read", func(t *testing.T) {
+		transport := transportFor(http.StatusOK, http.StatusOK)
+		config := GenerateReleaseNotesConfiguration{Repository: "hello", TagName: "v1.3.0", PreviousTagName: "v1.1.0"}
+		output, err := generateReleaseNotes(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+
+		body, _ := io.ReadAll(transport.requests[0].Body)
+		assert.JSONEq(t, `{"tag_name":"v1.3.0","previous_tag_name":"v1.1.0"}`, string(body))
+		assert.Equal(t, "v1.1.0", output.PreviousTagName)
+	})
+
+	t.Run("unknown previous tag -> not found", func(t *testing.T) {
+		transport := transportFor(http.StatusOK, http.StatusUnprocessableEntity)
+		config := GenerateReleaseNotesConfiguration{Repository: "hello", TagName: "v1.3.0", PreviousTagName: "v0.0.1"}
+		_, err := generateReleaseNotes(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "previous tag v0.0.1 does not exist in hello")
+	})
+}
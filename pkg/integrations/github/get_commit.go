@@ -0,0 +1,282 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetCommit struct{}
+
+type GetCommitConfiguration struct {
+	Repository          string `json:"repository" mapstructure:"repository"`
+	Ref                 string `json:"ref" mapstructure:"ref"`
+	IncludePullRequests bool   `json:"includePullRequests" mapstructure:"includePullRequests"`
+}
+
+type CommitStats struct {
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+	Total     int `json:"total"`
+}
+
+type CommitFileSummary struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+}
+
+type CommitDetails struct {
+	SHA          string               `json:"sha"`
+	Message      string               `json:"message"`
+	Author       string               `json:"author"`
+	AuthorEmail  string               `json:"author_email"`
+	Committer    string               `json:"committer"`
+	Date         *time.Time           `json:"date"`
+	Parents      []string             `json:"parents"`
+	Stats        CommitStats          `json:"stats"`
+	Files        []CommitFileSummary  `json:"files"`
+	PullRequests []PullRequestSummary `json:"pull_requests,omitempty"`
+	URL          string               `json:"html_url"`
+}
+
+func (c *GetCommit) Name() string {
+	return "github.getCommit"
+}
+
+func (c *GetCommit) Label() string {
+	return "Get Commit"
+}
+
+func (c *GetCommit) Description() string {
+	return "Get a GitHub commit, with its stats and changed files"
+}
+
+func (c *GetCommit) Documentation() string {
+	return `The Get Commit component gets a commit, with its stats and changed files.
+
+## Use Cases
+
+- **Deploy notifications**: Include the commit message, author, and size of the change in notifications
+- **Change review**: Inspect which files a commit changed before acting on it
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Ref**: The commit SHA, or a branch or tag name to get its latest commit (supports expressions)
+- **Include Pull Requests**: Also get the pull requests associated with the commit
+
+## Output
+
+Returns the full commit ` + "`message`" + `, the ` + "`author`" + `, ` + "`committer`" + `, and ` + "`date`" + `,
+the ` + "`stats`" + ` with the ` + "`additions`" + ` and ` + "`deletions`" + `, and the changed ` + "`files`" + `.
+If **Include Pull Requests** is enabled, the associated pull requests are in ` + "`pull_requests`" + `.
+
+## Notes
+
+- Large commits have their files split across pages, which are all fetched. GitHub returns at most 3000 files per commit
+- Including pull requests uses extra GitHub API calls, which count against the rate limit`
+}
+
+func (c *GetCommit) Icon() string {
+	return "github"
+}
+
+func (c *GetCommit) Color() string {
+	return "gray"
+}
+
+func (c *GetCommit) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetCommit) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "ref",
+			Label:       "Ref",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.head_commit.id}}",
+			Description: "Commit SHA, branch, or tag",
+		},
+		{
+			Name:        "includePullRequests",
+			Label:       "Include Pull Requests",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Also get the pull requests associated with the commit",
+		},
+	}
+}
+
+func (c *GetCommit) Setup(ctx core.SetupContext) error {
+	var config GetCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Ref == "" {
+		return errors.New("ref is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetCommit) Execute(ctx core.ExecutionContext) error {
+	var config GetCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	details, err := getCommit(client, appMetadata.Owner, config.Repository, config.Ref)
+	if err != nil {
+		return err
+	}
+
+	if config.IncludePullRequests {
+		details.PullRequests, err = listPullRequestsForCommit(client, appMetadata.Owner, config.Repository, details.SHA)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.commit",
+		[]any{details},
+	)
+}
+
+/*
+ * GitHub splits the files of large commits across pages.
+ * Every page has the full commit, so the details come from the first one,
+ * and only the files are collected from the others.
+ */
+func getCommit(client *github.Client, owner, repository, ref string) (*CommitDetails, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var details *CommitDetails
+	for {
+		commit, response, err := client.Repositories.GetCommit(context.Background(), owner, repository, ref, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit %s: %w", ref, wrapGitHubError(err))
+		}
+
+		if details == nil {
+			details = commitDetails(commit)
+		}
+
+		for _, file := range commit.Files {
+			details.Files = append(details.Files, CommitFileSummary{
+				Filename:         file.GetFilename(),
+				PreviousFilename: file.GetPreviousFilename(),
+				Status:           file.GetStatus(),
+				Additions:        file.GetAdditions(),
+				Deletions:        file.GetDeletions(),
+				Changes:          file.GetChanges(),
+			})
+		}
+
+		if response.NextPage == 0 {
+			return details, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func commitDetails(commit *github.RepositoryCommit) *CommitDetails {
+	details := &CommitDetails{
+		SHA:         commit.GetSHA(),
+		Message:     commit.GetCommit().GetMessage(),
+		Author:      commit.GetCommit().GetAuthor().GetName(),
+		AuthorEmail: commit.GetCommit().GetAuthor().GetEmail(),
+		Committer:   commit.GetCommit().GetCommitter().GetName(),
+		Parents:     []string{},
+		Files:       []CommitFileSummary{},
+		URL:         commit.GetHTMLURL(),
+		Stats: CommitStats{
+			Additions: commit.GetStats().GetAdditions(),
+			Deletions: commit.GetStats().GetDeletions(),
+			Total:     commit.GetStats().GetTotal(),
+		},
+	}
+
+	if commit.GetAuthor().GetLogin() != "" {
+		details.Author = commit.GetAuthor().GetLogin()
+	}
+
+	if commit.GetCommitter().GetLogin() != "" {
+		details.Committer = commit.GetCommitter().GetLogin()
+	}
+
+	if date := commit.GetCommit().GetAuthor().Date; date != nil {
+		details.Date = &date.Time
+	}
+
+	for _, parent := range commit.Parents {
+		details.Parents = append(details.Parents, parent.GetSHA())
+	}
+
+	return details
+}
+
+func (c *GetCommit) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetCommit) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetCommit) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetCommit) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetCommit) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetCommit) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,102 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetCommit__Setup(t *testing.T) {
+	component := GetCommit{}
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+
+	t.Run("ref is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "ref is required")
+	})
+
+	t.Run("repository is not accessible -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "world", "ref": "main"},
+		})
+
+		require.ErrorContains(t, err, "repository world is not accessible to app installation")
+	})
+
+	t.Run("metadata is set", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "{{$.data.head_commit.id}}"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetCommit__GetCommit(t *testing.T) {
+	commit := `{
+		"sha": "abc123",
+		"html_url": "https://github.com/testhq/hello/commit/abc123",
+		"commit": {
+			"message": "Fix login\n\nThe session was not refreshed.",
+			"author": {"name": "Octo Cat", "email": "octocat@example.com", "date": "2026-01-16T17:56:16Z"},
+			"committer": {"name": "GitHub"}
+		},
+		"author": {"login": "octocat"},
+		"parents": [{"sha": "def456"}],
+		"stats": {"additions": 12, "deletions": 3, "total": 15},
+		"files": %s
+	}`
+
+	t.Run("files are collected from every page", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "" {
+				response := mockResponse(http.StatusOK, fmt.Sprintf(commit, `[{"filename":"auth/login.go","status":"modified","additions":10,"deletions":3,"changes":13}]`))
+				response.Header = http.Header{"Link": []string{`<https://api.github.com/repos/testhq/hello/commits/main?page=2>; rel="next"`}}
+				return response, nil
+			}
+
+			return mockResponse(http.StatusOK, fmt.Sprintf(commit, `[{"filename":"auth/session.go","previous_filename":"auth/token.go","status":"renamed","additions":2}]`)), nil
+		}}
+
+		details, err := getCommit(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "main")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/commits/main", transport.requests[0].URL.Path)
+
+		assert.Equal(t, "abc123", details.SHA)
+		assert.Equal(t, "Fix login\n\nThe session was not refreshed.", details.Message)
+		assert.Equal(t, "octocat", details.Author)
+		assert.Equal(t, "octocat@example.com", details.AuthorEmail)
+		assert.Equal(t, "GitHub", details.Committer)
+		assert.Equal(t, []string{"def456"}, details.Parents)
+		assert.Equal(t, CommitStats{Additions: 12, Deletions: 3, Total: 15}, details.Stats)
+		require.Len(t, details.Files, 2)
+		assert.Equal(t, "auth/login.go", details.Files[0].Filename)
+		assert.Equal(t, "auth/token.go", details.Files[1].PreviousFilename)
+	})
+
+	t.Run("not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusUnprocessableEntity, `{"message":"No commit found for SHA: nope"}`), nil
+		}}
+
+		_, err := getCommit(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "nope")
+		require.ErrorContains(t, err, "failed to get commit nope")
+	})
+}
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetDefaultBranchSHA struct{}
+
+type GetDefaultBranchSHAConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+}
+
+type DefaultBranchSHAOutput struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	SHA        string `json:"sha"`
+}
+
+func (c *GetDefaultBranchSHA) Name() string {
+	return "github.getDefaultBranchSHA"
+}
+
+func (c *GetDefaultBranchSHA) Label() string {
+	return "Get Default Branch SHA"
+}
+
+func (c *GetDefaultBranchSHA) Description() string {
+	return "Get the default branch of a GitHub repository and its head commit SHA"
+}
+
+func (c *GetDefaultBranchSHA) Documentation() string {
+	return `The Get Default Branch SHA component gets the default branch of a repository, and the SHA of its head commit.
+
+## Use Cases
+
+- **Branching**: Create branches from the head of the default branch, without hardcoding its name
+- **Deployments**: Deploy the latest commit of the default branch
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+
+## Output
+
+Returns the default ` + "`branch`" + ` name, and the ` + "`sha`" + ` of its head commit.
+
+## Notes
+
+- Nodes reading the same repository at the same time share the GitHub API calls
+- With **Cache TTL**, the SHA read in the last seconds is reused. Pushes received by an On Push trigger drop it, so it does not go stale after a merge`
+}
+
+func (c *GetDefaultBranchSHA) Icon() string {
+	return "github"
+}
+
+func (c *GetDefaultBranchSHA) Color() string {
+	return "gray"
+}
+
+func (c *GetDefaultBranchSHA) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetDefaultBranchSHA) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		CacheTTLField,
+		BypassCacheField,
+	}
+}
+
+func (c *GetDefaultBranchSHA) Setup(ctx core.SetupContext) error {
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetDefaultBranchSHA) Execute(ctx core.ExecutionContext) error {
+	var config GetDefaultBranchSHAConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := cachedRead(appMetadata.InstallationID, readCacheOptions(ctx.Configuration), "GetDefaultBranchSHA", []any{appMetadata.Owner, config.Repository}, func() (*DefaultBranchSHAOutput, error) {
+		return getDefaultBranchSHA(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository)
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.defaultBranchSHA",
+		[]any{output},
+	)
+}
+
+func getDefaultBranchSHA(client *github.Client, installationID, owner, repo string) (*DefaultBranchSHAOutput, error) {
+	repository, err := getRepository(client, installationID, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := repository.GetDefaultBranch()
+	if branch == "" {
+		return nil, fmt.Errorf("repository %s has no default branch", repo)
+	}
+
+	ref, err := getBranchRef(client, installationID, owner, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DefaultBranchSHAOutput{
+		Repository: repo,
+		Branch:     branch,
+		SHA:        ref.GetObject().GetSHA(),
+	}, nil
+}
+
+func (c *GetDefaultBranchSHA) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetDefaultBranchSHA) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetDefaultBranchSHA) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetDefaultBranchSHA) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetDefaultBranchSHA) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetDefaultBranchSHA) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetDefaultBranchSHA__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetDefaultBranchSHA{}
+
+	t.Run("repository is not accessible -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "world"},
+		})
+
+		require.ErrorContains(t, err, "repository world is not accessible to app installation")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetDefaultBranchSHA__Get(t *testing.T) {
+	t.Run("default branch and its head SHA are returned", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(request.URL.Path, "/git/ref/heads/trunk") {
+				return mockResponse(http.StatusOK, `{"ref":"refs/heads/trunk","object":{"sha":"6dcb09b5b57875f334f61aebed695e2e4193db5e","type":"commit"}}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"trunk"}`), nil
+		}}
+
+		output, err := getDefaultBranchSHA(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, &DefaultBranchSHAOutput{
+			Repository: "hello",
+			Branch:     "trunk",
+			SHA:        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		}, output)
+	})
+
+	t.Run("branch not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.Contains(request.URL.Path, "/git/ref/") {
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"trunk"}`), nil
+		}}
+
+		_, err := getDefaultBranchSHA(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello")
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to get branch trunk")
+	})
+}
@@ -0,0 +1,462 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DeploymentStatusPayloadType           = "github.deploymentStatus"
+	DeploymentStatusTimeoutPayloadType    = "github.deploymentStatus.timeout"
+	DeploymentStatusSuccessOutputChannel  = "success"
+	DeploymentStatusFailureOutputChannel  = "failure"
+	DeploymentStatusPendingOutputChannel  = "pending"
+	DeploymentStatusTimeoutOutputChannel  = "timeout"
+	DeploymentStatusPollAction            = "poll"
+	DefaultDeploymentStatusTimeoutMinutes = 60
+)
+
+var DeploymentStatusPollBackoff = core.Backoff{
+	Initial: 10 * time.Second,
+	Max:     2 * time.Minute,
+}
+
+/*
+ * A deployment is done once its latest status is in one of these states.
+ * Inactive deployments were replaced by a newer deployment to the same environment.
+ */
+var terminalDeploymentStates = []string{"success", "failure", "error", "inactive"}
+
+type GetLatestDeploymentStatus struct{}
+
+type GetLatestDeploymentStatusConfiguration struct {
+	Repository      string `json:"repository" mapstructure:"repository"`
+	Environment     string `json:"environment" mapstructure:"environment"`
+	DeploymentID    string `json:"deploymentId" mapstructure:"deploymentId"`
+	WaitForTerminal bool   `json:"waitForTerminal" mapstructure:"waitForTerminal"`
+	Timeout         *int   `json:"timeout" mapstructure:"timeout"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so Execute records the resolved values it needs here.
+ * The deployment is resolved on the first poll, and kept for the next ones,
+ * so a newer deployment to the same environment does not replace it.
+ */
+type GetLatestDeploymentStatusMetadata struct {
+	Repository   string                   `json:"repository" mapstructure:"repository"`
+	Environment  string                   `json:"environment" mapstructure:"environment"`
+	DeploymentID int64                    `json:"deploymentId" mapstructure:"deploymentId"`
+	StartedAt    string                   `json:"startedAt" mapstructure:"startedAt"`
+	Deadline     string                   `json:"deadline" mapstructure:"deadline"`
+	PollAttempts int                      `json:"pollAttempts" mapstructure:"pollAttempts"`
+	Status       *DeploymentStatusSummary `json:"status,omitempty" mapstructure:"status"`
+}
+
+type DeploymentStatusSummary struct {
+	DeploymentID   int64  `json:"deployment_id"`
+	Environment    string `json:"environment"`
+	State          string `json:"state"`
+	Description    string `json:"description"`
+	EnvironmentURL string `json:"environment_url"`
+	LogURL         string `json:"log_url"`
+	CreatedAt      string `json:"created_at,omitempty"`
+}
+
+func (c *GetLatestDeploymentStatus) Name() string {
+	return "github.getLatestDeploymentStatus"
+}
+
+func (c *GetLatestDeploymentStatus) Label() string {
+	return "Get Latest Deployment Status"
+}
+
+func (c *GetLatestDeploymentStatus) Description() string {
+	return "Get the latest status of a GitHub deployment, optionally waiting until it finishes"
+}
+
+func (c *GetLatestDeploymentStatus) Documentation() string {
+	return `The Get Latest Deployment Status component gets the latest status of a deployment, and routes on its state.
+
+## Use Cases
+
+- **Deployment gating**: Continue only after the latest deployment to an environment succeeded
+- **Release verification**: Wait for a deployment to finish before running smoke tests
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: Use the latest deployment to this environment (supports expressions)
+- **Deployment ID**: Use this deployment instead (supports expressions)
+- **Wait For Terminal State**: Poll the deployment until it succeeds or fails
+- **Timeout (minutes)**: When waiting, how long to wait before giving up. Defaults to 60
+
+## Output Channels
+
+- **Success**: The latest status is ` + "`success`" + `
+- **Failure**: The latest status is ` + "`failure`" + `, ` + "`error`" + ` or ` + "`inactive`" + `
+- **Pending**: The deployment is still in progress. Only used when not waiting
+- **Timeout**: The deployment did not finish before the timeout
+
+## Output
+
+Returns the ` + "`deployment_id`" + `, the ` + "`environment`" + `, the latest ` + "`state`" + ` and ` + "`description`" + `, the ` + "`environment_url`" + ` and the ` + "`log_url`" + `.
+
+## Notes
+
+- Either an environment or a deployment ID is required. The deployment ID is used when both are set
+- A deployment without statuses is ` + "`pending`" + `
+- When waiting, the deployment is resolved once, so newer deployments to the environment do not replace it`
+}
+
+func (c *GetLatestDeploymentStatus) Icon() string {
+	return "github"
+}
+
+func (c *GetLatestDeploymentStatus) Color() string {
+	return "gray"
+}
+
+func (c *GetLatestDeploymentStatus) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: DeploymentStatusSuccessOutputChannel, Label: "Success"},
+		{Name: DeploymentStatusFailureOutputChannel, Label: "Failure"},
+		{Name: DeploymentStatusPendingOutputChannel, Label: "Pending"},
+		{Name: DeploymentStatusTimeoutOutputChannel, Label: "Timeout"},
+	}
+}
+
+func (c *GetLatestDeploymentStatus) EventTypes() []string {
+	return []string{DeploymentStatusPayloadType, DeploymentStatusTimeoutPayloadType}
+}
+
+func (c *GetLatestDeploymentStatus) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., production",
+			Description: "Use the latest deployment to this environment",
+		},
+		{
+			Name:        "deploymentId",
+			Label:       "Deployment ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.deployment.id}}",
+			Description: "Use this deployment instead",
+		},
+		{
+			Name:        "waitForTerminal",
+			Label:       "Wait For Terminal State",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Poll the deployment until it succeeds or fails",
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultDeploymentStatusTimeoutMinutes,
+			Description: "Minutes to wait for the deployment to finish",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "waitForTerminal", Values: []string{"true"}},
+			},
+		},
+	}
+}
+
+func (c *GetLatestDeploymentStatus) Setup(ctx core.SetupContext) error {
+	var config GetLatestDeploymentStatusConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Environment == "" && config.DeploymentID == "" {
+		return errors.New("environment or deployment ID is required")
+	}
+
+	if config.DeploymentID != "" && !isExpression(config.DeploymentID) {
+		if _, err := strconv.ParseInt(config.DeploymentID, 10, 64); err != nil {
+			return fmt.Errorf("deployment ID is not a number: %s", config.DeploymentID)
+		}
+	}
+
+	if config.Timeout != nil && *config.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetLatestDeploymentStatus) Execute(ctx core.ExecutionContext) error {
+	var config GetLatestDeploymentStatusConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	deploymentID, err := parseDeploymentID(config.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	if config.WaitForTerminal {
+		startedAt := time.Now()
+		err := ctx.Metadata.Set(GetLatestDeploymentStatusMetadata{
+			Repository:   config.Repository,
+			Environment:  config.Environment,
+			DeploymentID: deploymentID,
+			StartedAt:    startedAt.Format(time.RFC3339),
+			Deadline:     startedAt.Add(deploymentStatusTimeout(config)).Format(time.RFC3339),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		ctx.Logger.Infof("Waiting for deployment to %s to finish", config.Environment)
+		return ctx.Requests.ScheduleActionCall(DeploymentStatusPollAction, map[string]any{}, DeploymentStatusPollBackoff.Interval(1))
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	deploymentID, err = resolveDeploymentID(client, appMetadata.Owner, config.Repository, config.Environment, deploymentID)
+	if err != nil {
+		return err
+	}
+
+	status, err := fetchLatestDeploymentStatus(client, appMetadata.Owner, config.Repository, deploymentID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(deploymentStatusOutputChannel(status), DeploymentStatusPayloadType, []any{status})
+}
+
+func (c *GetLatestDeploymentStatus) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           DeploymentStatusPollAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *GetLatestDeploymentStatus) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case DeploymentStatusPollAction:
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *GetLatestDeploymentStatus) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := GetLatestDeploymentStatusMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	if err != nil {
+		return fmt.Errorf("invalid start time %q: %w", metadata.StartedAt, err)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	metadata.DeploymentID, err = resolveDeploymentID(client, appMetadata.Owner, metadata.Repository, metadata.Environment, metadata.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	status, err := fetchLatestDeploymentStatus(client, appMetadata.Owner, metadata.Repository, metadata.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	metadata.PollAttempts++
+	metadata.Status = status
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	if slices.Contains(terminalDeploymentStates, status.State) {
+		return ctx.ExecutionState.Emit(deploymentStatusOutputChannel(status), DeploymentStatusPayloadType, []any{status})
+	}
+
+	if !time.Now().Before(deadline) {
+		return ctx.ExecutionState.Emit(DeploymentStatusTimeoutOutputChannel, DeploymentStatusTimeoutPayloadType, []any{map[string]any{
+			"status":        status,
+			"timeout":       deadline.Sub(startedAt).String(),
+			"poll_attempts": metadata.PollAttempts,
+		}})
+	}
+
+	return ctx.Requests.ScheduleActionCall(DeploymentStatusPollAction, map[string]any{}, DeploymentStatusPollBackoff.Interval(metadata.PollAttempts+1))
+}
+
+func parseDeploymentID(deploymentID string) (int64, error) {
+	if deploymentID == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(deploymentID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("deployment ID is not a number: %s", deploymentID)
+	}
+
+	return id, nil
+}
+
+func deploymentStatusTimeout(config GetLatestDeploymentStatusConfiguration) time.Duration {
+	if config.Timeout == nil || *config.Timeout < 1 {
+		return DefaultDeploymentStatusTimeoutMinutes * time.Minute
+	}
+
+	return time.Duration(*config.Timeout) * time.Minute
+}
+
+/*
+ * Returns the deployment ID if one is given,
+ * or the ID of the latest deployment to the environment.
+ */
+func resolveDeploymentID(client *github.Client, owner, repo, environment string, deploymentID int64) (int64, error) {
+	if deploymentID != 0 {
+		return deploymentID, nil
+	}
+
+	deployments, _, err := client.Repositories.ListDeployments(context.Background(), owner, repo, &github.DeploymentsListOptions{
+		Environment: environment,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to list deployments: %w", wrapGitHubError(err))
+	}
+
+	if len(deployments) == 0 {
+		return 0, fmt.Errorf("no deployments found for environment %s: %w", environment, ErrNotFound)
+	}
+
+	return deployments[0].GetID(), nil
+}
+
+func fetchLatestDeploymentStatus(client *github.Client, owner, repo string, deploymentID int64) (*DeploymentStatusSummary, error) {
+	deployment, _, err := client.Repositories.GetDeployment(context.Background(), owner, repo, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %d: %w", deploymentID, wrapGitHubError(err))
+	}
+
+	//
+	// Statuses are returned from the most recent.
+	//
+	statuses, _, err := client.Repositories.ListDeploymentStatuses(context.Background(), owner, repo, deploymentID, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statuses of deployment %d: %w", deploymentID, wrapGitHubError(err))
+	}
+
+	summary := &DeploymentStatusSummary{
+		DeploymentID: deploymentID,
+		Environment:  deployment.GetEnvironment(),
+		State:        "pending",
+	}
+
+	if len(statuses) == 0 {
+		return summary, nil
+	}
+
+	status := statuses[0]
+	summary.State = status.GetState()
+	summary.Description = status.GetDescription()
+	summary.EnvironmentURL = status.GetEnvironmentURL()
+	summary.LogURL = status.GetLogURL()
+	if status.CreatedAt != nil {
+		summary.CreatedAt = status.CreatedAt.Format(time.RFC3339)
+	}
+
+	return summary, nil
+}
+
+func deploymentStatusOutputChannel(status *DeploymentStatusSummary) string {
+	if status.State == "success" {
+		return DeploymentStatusSuccessOutputChannel
+	}
+
+	if slices.Contains(terminalDeploymentStates, status.State) {
+		return DeploymentStatusFailureOutputChannel
+	}
+
+	return DeploymentStatusPendingOutputChannel
+}
+
+func (c *GetLatestDeploymentStatus) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetLatestDeploymentStatus) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetLatestDeploymentStatus) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetLatestDeploymentStatus) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
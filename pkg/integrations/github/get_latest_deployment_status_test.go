@@ -0,0 +1,151 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetLatestDeploymentStatus__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetLatestDeploymentStatus{}
+
+	t.Run("environment or deployment ID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "environment or deployment ID is required")
+	})
+
+	t.Run("invalid deployment ID -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "deploymentId": "latest"},
+		})
+
+		require.ErrorContains(t, err, "deployment ID is not a number: latest")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "deploymentId": "{{$.data.deployment.id}}"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetLatestDeploymentStatus__ExecuteWaiting(t *testing.T) {
+	component := GetLatestDeploymentStatus{}
+	metadataCtx := &contexts.MetadataContext{}
+	requestCtx := &contexts.RequestContext{}
+
+	require.NoError(t, component.Execute(core.ExecutionContext{
+		Configuration: map[string]any{"repository": "hello", "environment": "production", "waitForTerminal": true, "timeout": 15},
+		Metadata:      metadataCtx,
+		Requests:      requestCtx,
+		Logger:        log.NewEntry(log.StandardLogger()),
+	}))
+
+	assert.Equal(t, DeploymentStatusPollAction, requestCtx.Action)
+	assert.Equal(t, DeploymentStatusPollBackoff.Initial, requestCtx.Duration)
+
+	metadata := metadataCtx.Get().(GetLatestDeploymentStatusMetadata)
+	assert.Equal(t, "hello", metadata.Repository)
+	assert.Equal(t, "production", metadata.Environment)
+	assert.Zero(t, metadata.DeploymentID)
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	require.NoError(t, err)
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, deadline.Sub(startedAt))
+}
+
+func Test__GetLatestDeploymentStatus__Status(t *testing.T) {
+	deploymentsTransport := func(statuses string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/deployments"):
+				return mockResponse(http.StatusOK, `[{"id":42,"environment":"production"}]`), nil
+			case strings.HasSuffix(request.URL.Path, "/statuses"):
+				return mockResponse(http.StatusOK, statuses), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":42,"environment":"production"}`), nil
+		}}
+	}
+
+	t.Run("latest deployment to the environment is resolved", func(t *testing.T) {
+		transport := deploymentsTransport(`[]`)
+		id, err := resolveDeploymentID(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "production", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), id)
+		assert.Equal(t, "production", transport.requests[0].URL.Query().Get("environment"))
+		assert.Equal(t, "1", transport.requests[0].URL.Query().Get("per_page"))
+	})
+
+	t.Run("given deployment ID -> no request", func(t *testing.T) {
+		transport := deploymentsTransport(`[]`)
+		id, err := resolveDeploymentID(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "production", 7)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), id)
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("no deployments -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[]`), nil
+		}}
+
+		_, err := resolveDeploymentID(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "staging", 0)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "no deployments found for environment staging")
+	})
+
+	t.Run("latest status is returned", func(t *testing.T) {
+		transport := deploymentsTransport(`[{"state":"success","description":"Deployed","environment_url":"https://hello.example.com","log_url":"https://ci.example.com/42","created_at":"2026-01-16T10:00:00Z"}]`)
+		status, err := fetchLatestDeploymentStatus(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.NoError(t, err)
+		assert.Equal(t, &DeploymentStatusSummary{
+			DeploymentID:   42,
+			Environment:    "production",
+			State:          "success",
+			Description:    "Deployed",
+			EnvironmentURL: "https://hello.example.com",
+			LogURL:         "https://ci.example.com/42",
+			CreatedAt:      "2026-01-16T10:00:00Z",
+		}, status)
+	})
+
+	t.Run("no statuses -> pending", func(t *testing.T) {
+		transport := deploymentsTransport(`[]`)
+		status, err := fetchLatestDeploymentStatus(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", status.State)
+	})
+}
+
+func Test__GetLatestDeploymentStatus__OutputChannel(t *testing.T) {
+	assert.Equal(t, DeploymentStatusSuccessOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "success"}))
+	assert.Equal(t, DeploymentStatusFailureOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "failure"}))
+	assert.Equal(t, DeploymentStatusFailureOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "error"}))
+	assert.Equal(t, DeploymentStatusFailureOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "inactive"}))
+	assert.Equal(t, DeploymentStatusPendingOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "in_progress"}))
+	assert.Equal(t, DeploymentStatusPendingOutputChannel, deploymentStatusOutputChannel(&DeploymentStatusSummary{State: "queued"}))
+}
@@ -0,0 +1,204 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetLatestRelease struct{}
+
+type GetLatestReleaseConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+}
+
+type LatestReleaseOutput struct {
+	Found       bool                 `json:"found"`
+	ID          int64                `json:"id,omitempty"`
+	TagName     string               `json:"tag_name,omitempty"`
+	Name        string               `json:"name,omitempty"`
+	Body        string               `json:"body,omitempty"`
+	URL         string               `json:"html_url,omitempty"`
+	PublishedAt string               `json:"published_at,omitempty"`
+	Assets      []LatestReleaseAsset `json:"assets"`
+}
+
+type LatestReleaseAsset struct {
+	Name          string `json:"name"`
+	ContentType   string `json:"content_type"`
+	Size          int    `json:"size"`
+	DownloadCount int    `json:"download_count"`
+	DownloadURL   string `json:"browser_download_url"`
+}
+
+func (c *GetLatestRelease) Name() string {
+	return "github.getLatestRelease"
+}
+
+func (c *GetLatestRelease) Label() string {
+	return "Get Latest Release"
+}
+
+func (c *GetLatestRelease) Description() string {
+	return "Get the latest published release of a GitHub repository"
+}
+
+func (c *GetLatestRelease) Documentation() string {
+	return `The Get Latest Release component gets the latest published release of a GitHub repository.
+
+## Use Cases
+
+- **Version lookup**: Find the version currently released, to deploy it or to compute the next one
+- **Asset downloads**: Get the download URLs of the latest release assets
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+
+## Output
+
+Emits the ` + "`tag_name`" + `, ` + "`name`" + `, ` + "`body`" + `, and ` + "`assets`" + ` of the latest release, with ` + "`found`" + ` set to ` + "`true`" + `.
+
+If the repository has no published releases, the event only has ` + "`found`" + ` set to ` + "`false`" + `, so downstream nodes can branch on it.
+
+## Notes
+
+- Drafts and prereleases are never returned. Use **Get Release** to find those
+- With **Cache TTL**, the latest release read in the last seconds is reused, until a release event reaches an On Release trigger. Repositories without releases are not cached`
+}
+
+func (c *GetLatestRelease) Icon() string {
+	return "github"
+}
+
+func (c *GetLatestRelease) Color() string {
+	return "gray"
+}
+
+func (c *GetLatestRelease) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetLatestRelease) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		CacheTTLField,
+		BypassCacheField,
+	}
+}
+
+func (c *GetLatestRelease) Setup(ctx core.SetupContext) error {
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetLatestRelease) Execute(ctx core.ExecutionContext) error {
+	var config GetLatestReleaseConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	release, err := cachedRead(appMetadata.InstallationID, readCacheOptions(ctx.Configuration), "GetLatestRelease", []any{appMetadata.Owner, config.Repository}, func() (*github.RepositoryRelease, error) {
+		return getLatestRelease(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository)
+	})
+
+	//
+	// GitHub returns 404 when the repository has no published releases.
+	//
+	if errors.Is(err, ErrNotFound) {
+		ctx.Logger.Infof("No published releases found in %s", config.Repository)
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.release", []any{buildLatestReleaseOutput(nil)})
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get latest release: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.release", []any{buildLatestReleaseOutput(release)})
+}
+
+func buildLatestReleaseOutput(release *github.RepositoryRelease) LatestReleaseOutput {
+	if release == nil {
+		return LatestReleaseOutput{Found: false, Assets: []LatestReleaseAsset{}}
+	}
+
+	output := LatestReleaseOutput{
+		Found:   true,
+		ID:      release.GetID(),
+		TagName: release.GetTagName(),
+		Name:    release.GetName(),
+		Body:    release.GetBody(),
+		URL:     release.GetHTMLURL(),
+		Assets:  make([]LatestReleaseAsset, 0, len(release.Assets)),
+	}
+
+	if release.PublishedAt != nil {
+		output.PublishedAt = release.PublishedAt.Format(time.RFC3339)
+	}
+
+	for _, asset := range release.Assets {
+		output.Assets = append(output.Assets, LatestReleaseAsset{
+			Name:          asset.GetName(),
+			ContentType:   asset.GetContentType(),
+			Size:          asset.GetSize(),
+			DownloadCount: asset.GetDownloadCount(),
+			DownloadURL:   asset.GetBrowserDownloadURL(),
+		})
+	}
+
+	return output
+}
+
+func (c *GetLatestRelease) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetLatestRelease) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetLatestRelease) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetLatestRelease) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetLatestRelease) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetLatestRelease) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
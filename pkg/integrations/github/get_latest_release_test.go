@@ -0,0 +1,69 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetLatestRelease__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetLatestRelease{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetLatestRelease__BuildOutput(t *testing.T) {
+	t.Run("no release -> not found", func(t *testing.T) {
+		output := buildLatestReleaseOutput(nil)
+		assert.False(t, output.Found)
+		assert.Empty(t, output.TagName)
+		assert.NotNil(t, output.Assets)
+	})
+
+	t.Run("release fields and assets are included", func(t *testing.T) {
+		publishedAt := time.Now().UTC().Truncate(time.Second)
+		output := buildLatestReleaseOutput(&github.RepositoryRelease{
+			TagName:     github.Ptr("v1.4.0"),
+			Name:        github.Ptr("Release 1.4.0"),
+			Body:        github.Ptr("Changelog"),
+			PublishedAt: &github.Timestamp{Time: publishedAt},
+			Assets: []*github.ReleaseAsset{
+				{Name: github.Ptr("app.tar.gz"), BrowserDownloadURL: github.Ptr("https://example.com/app.tar.gz")},
+			},
+		})
+
+		assert.True(t, output.Found)
+		assert.Equal(t, "v1.4.0", output.TagName)
+		assert.Equal(t, "Release 1.4.0", output.Name)
+		assert.Equal(t, "Changelog", output.Body)
+		assert.Equal(t, publishedAt.Format(time.RFC3339), output.PublishedAt)
+		require.Len(t, output.Assets, 1)
+		assert.Equal(t, "app.tar.gz", output.Assets[0].Name)
+		assert.Equal(t, "https://example.com/app.tar.gz", output.Assets[0].DownloadURL)
+	})
+}
@@ -0,0 +1,207 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetLatestSuccessfulRun struct{}
+
+type GetLatestSuccessfulRunConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	WorkflowFileName string `json:"workflowFileName" mapstructure:"workflowFileName"`
+	Branch           string `json:"branch" mapstructure:"branch"`
+}
+
+/*
+ * The fields of the run are only set when one was found.
+ */
+type LatestSuccessfulRunOutput struct {
+	Found bool `json:"found"`
+	*WorkflowRunSummary
+}
+
+func (c *GetLatestSuccessfulRun) Name() string {
+	return "github.getLatestSuccessfulRun"
+}
+
+func (c *GetLatestSuccessfulRun) Label() string {
+	return "Get Latest Successful Run"
+}
+
+func (c *GetLatestSuccessfulRun) Description() string {
+	return "Get the most recent successful run of a GitHub Actions workflow"
+}
+
+func (c *GetLatestSuccessfulRun) Documentation() string {
+	return `The Get Latest Successful Run component finds the most recent run of a GitHub Actions workflow that succeeded.
+
+## Use Cases
+
+- **Deployments**: Deploy the commit of the last green build
+- **Rollbacks**: Find the last known good commit of a branch
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Workflow File**: The workflow file name (e.g. ` + "`ci.yml`" + `)
+- **Branch**: Only consider runs for this branch. If empty, runs of all branches are considered
+
+## Output
+
+Emits a ` + "`github.latestSuccessfulRun`" + ` event with ` + "`found`" + `. If a successful run was found, the event also includes its
+` + "`id`" + `, ` + "`run_number`" + `, ` + "`head_branch`" + `, ` + "`head_sha`" + `, ` + "`html_url`" + ` and ` + "`created_at`" + `.
+
+## Notes
+
+- Runs are ordered by creation, so the latest successful run is the most recently started one, not the most recently finished one
+- If the workflow has no successful run, ` + "`found`" + ` is false and the execution still succeeds`
+}
+
+func (c *GetLatestSuccessfulRun) Icon() string {
+	return "github"
+}
+
+func (c *GetLatestSuccessfulRun) Color() string {
+	return "gray"
+}
+
+func (c *GetLatestSuccessfulRun) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetLatestSuccessfulRun) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "workflowFileName",
+			Label:       "Workflow File",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., ci.yml",
+		},
+		{
+			Name:        "branch",
+			Label:       "Branch",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Leave empty to consider runs of all branches",
+		},
+	}
+}
+
+func (c *GetLatestSuccessfulRun) Setup(ctx core.SetupContext) error {
+	var config GetLatestSuccessfulRunConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.WorkflowFileName) == "" {
+		return errors.New("workflow file is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetLatestSuccessfulRun) Execute(ctx core.ExecutionContext) error {
+	var config GetLatestSuccessfulRunConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := getLatestSuccessfulRun(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	if !output.Found {
+		ctx.Logger.Infof("No successful run found for %s", config.WorkflowFileName)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.latestSuccessfulRun",
+		[]any{output},
+	)
+}
+
+/*
+ * Runs are listed from newest to oldest,
+ * so the first run filtered by the API is the latest successful one.
+ */
+func getLatestSuccessfulRun(client *github.Client, owner string, config GetLatestSuccessfulRunConfiguration) (*LatestSuccessfulRunOutput, error) {
+	runsConfig := ListWorkflowRunsConfiguration{
+		Repository:       config.Repository,
+		WorkflowFileName: strings.TrimSpace(config.WorkflowFileName),
+		Branch:           config.Branch,
+		Conclusion:       "success",
+	}
+
+	runs, _, err := listWorkflowRunsPage(client, owner, runsConfig, buildWorkflowRunsOptions(runsConfig, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", wrapGitHubError(err))
+	}
+
+	summaries := summarizeWorkflowRuns(runs.WorkflowRuns, runsConfig, 1)
+	if len(summaries) == 0 {
+		return &LatestSuccessfulRunOutput{Found: false}, nil
+	}
+
+	return &LatestSuccessfulRunOutput{Found: true, WorkflowRunSummary: &summaries[0]}, nil
+}
+
+func (c *GetLatestSuccessfulRun) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetLatestSuccessfulRun) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetLatestSuccessfulRun) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetLatestSuccessfulRun) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetLatestSuccessfulRun) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetLatestSuccessfulRun) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetLatestSuccessfulRun__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetLatestSuccessfulRun{}
+
+	t.Run("workflow file is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "workflowFileName": " "},
+		})
+
+		require.ErrorContains(t, err, "workflow file is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "workflowFileName": "ci.yml", "branch": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetLatestSuccessfulRun__GetLatestSuccessfulRun(t *testing.T) {
+	config := GetLatestSuccessfulRunConfiguration{Repository: "hello", WorkflowFileName: ".github/workflows/ci.yml", Branch: "main"}
+
+	t.Run("latest successful run is found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"total_count":12,"workflow_runs":[
+				{"id":9,"run_number":41,"head_branch":"main","head_sha":"abc123","status":"completed","conclusion":"success","html_url":"https://github.com/testhq/hello/actions/runs/9"}
+			]}`), nil
+		}}
+
+		output, err := getLatestSuccessfulRun(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+
+		request := transport.requests[0]
+		assert.Equal(t, "/repos/testhq/hello/actions/workflows/ci.yml/runs", request.URL.Path)
+		assert.Equal(t, "success", request.URL.Query().Get("status"))
+		assert.Equal(t, "main", request.URL.Query().Get("branch"))
+		assert.Equal(t, "1", request.URL.Query().Get("per_page"))
+
+		assert.True(t, output.Found)
+		assert.Equal(t, int64(9), output.ID)
+		assert.Equal(t, "abc123", output.HeadSHA)
+		assert.Equal(t, "https://github.com/testhq/hello/actions/runs/9", output.URL)
+	})
+
+	t.Run("no successful run -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"total_count":0,"workflow_runs":[]}`), nil
+		}}
+
+		output, err := getLatestSuccessfulRun(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.False(t, output.Found)
+
+		data, err := json.Marshal(output)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"found":false}`, string(data))
+	})
+
+	t.Run("workflow not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := getLatestSuccessfulRun(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetOrganization struct{}
+
+type GetOrganizationConfiguration struct {
+	Organization string `json:"organization" mapstructure:"organization"`
+}
+
+/*
+ * The plan, member count, default repository permission and 2FA requirement
+ * are only returned to apps that can read the organization administration settings.
+ * They are nil otherwise.
+ */
+type OrganizationOutput struct {
+	Login                       string `json:"login"`
+	Name                        string `json:"name,omitempty"`
+	URL                         string `json:"html_url"`
+	Plan                        string `json:"plan,omitempty"`
+	MemberCount                 *int   `json:"member_count"`
+	DefaultRepositoryPermission string `json:"default_repository_permission,omitempty"`
+	TwoFactorRequired           *bool  `json:"two_factor_required"`
+}
+
+func (c *GetOrganization) Name() string {
+	return "github.getOrganization"
+}
+
+func (c *GetOrganization) Label() string {
+	return "Get Organization"
+}
+
+func (c *GetOrganization) Description() string {
+	return "Get the plan and security settings of a GitHub organization"
+}
+
+func (c *GetOrganization) Documentation() string {
+	return `The Get Organization component retrieves a GitHub organization, with its plan and security settings.
+
+## Use Cases
+
+- **Compliance reporting**: Check that 2FA is required, and that members cannot write to all repositories by default
+- **Seat tracking**: Report how many seats of the plan are used
+
+## Configuration
+
+- **Organization**: The organization to get (supports expressions). Defaults to the organization of the integration
+
+## Output
+
+Returns the organization ` + "`login`" + `, ` + "`name`" + `, and ` + "`html_url`" + `, its ` + "`plan`" + `, ` + "`member_count`" + `, ` + "`default_repository_permission`" + `, and whether ` + "`two_factor_required`" + ` is enabled.
+
+## Notes
+
+- The plan and settings are only visible to GitHub apps with the Administration (read) organization permission. Without it, they are empty, and ` + "`member_count`" + ` and ` + "`two_factor_required`" + ` are null
+- The member count is the number of seats of the plan in use`
+}
+
+func (c *GetOrganization) Icon() string {
+	return "github"
+}
+
+func (c *GetOrganization) Color() string {
+	return "gray"
+}
+
+func (c *GetOrganization) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetOrganization) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., my-org",
+			Description: "Defaults to the organization of the integration",
+		},
+	}
+}
+
+func (c *GetOrganization) Setup(ctx core.SetupContext) error {
+	var config GetOrganizationConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (c *GetOrganization) Execute(ctx core.ExecutionContext) error {
+	var config GetOrganizationConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	organization := config.Organization
+	if organization == "" {
+		organization = appMetadata.Owner
+	}
+
+	output, err := getOrganization(client, organization)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.organization",
+		[]any{output},
+	)
+}
+
+func getOrganization(client *github.Client, organization string) (*OrganizationOutput, error) {
+	org, _, err := client.Organizations.Get(context.Background(), organization)
+	if err != nil {
+		return nil, organizationError(err, organization)
+	}
+
+	output := &OrganizationOutput{
+		Login:                       org.GetLogin(),
+		Name:                        org.GetName(),
+		URL:                         org.GetHTMLURL(),
+		DefaultRepositoryPermission: org.GetDefaultRepoPermission(),
+		TwoFactorRequired:           org.TwoFactorRequirementEnabled,
+	}
+
+	if org.Plan != nil {
+		output.Plan = org.Plan.GetName()
+		output.MemberCount = org.Plan.FilledSeats
+	}
+
+	return output, nil
+}
+
+/*
+ * GitHub answers with a 403 when the app cannot read the organization,
+ * for example, when it is not installed on it.
+ */
+func organizationError(err error, organization string) error {
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf(
+			"the GitHub app cannot read the %s organization, install it on the organization, or grant it the Administration (read) organization permission: %w",
+			organization,
+			err,
+		)
+	}
+
+	return fmt.Errorf("failed to get organization %s: %w", organization, err)
+}
+
+func (c *GetOrganization) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetOrganization) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetOrganization) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetOrganization) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetOrganization) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetOrganization) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__GetOrganization__Get(t *testing.T) {
+	t.Run("plan and settings are returned", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{
+				"login":"testhq",
+				"name":"Test HQ",
+				"html_url":"https://github.com/testhq",
+				"plan":{"name":"team","seats":50,"filled_seats":42},
+				"default_repository_permission":"read",
+				"two_factor_requirement_enabled":true
+			}`), nil
+		}}
+
+		output, err := getOrganization(github.NewClient(&http.Client{Transport: transport}), "testhq")
+		require.NoError(t, err)
+		assert.Equal(t, "/orgs/testhq", transport.requests[0].URL.Path)
+		assert.Equal(t, &OrganizationOutput{
+			Login:                       "testhq",
+			Name:                        "Test HQ",
+			URL:                         "https://github.com/testhq",
+			Plan:                        "team",
+			MemberCount:                 github.Ptr(42),
+			DefaultRepositoryPermission: "read",
+			TwoFactorRequired:           github.Ptr(true),
+		}, output)
+	})
+
+	t.Run("settings not visible -> empty", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"login":"testhq","html_url":"https://github.com/testhq"}`), nil
+		}}
+
+		output, err := getOrganization(github.NewClient(&http.Client{Transport: transport}), "testhq")
+		require.NoError(t, err)
+		assert.Empty(t, output.Plan)
+		assert.Nil(t, output.MemberCount)
+		assert.Nil(t, output.TwoFactorRequired)
+	})
+
+	t.Run("no access -> permission error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := getOrganization(github.NewClient(&http.Client{Transport: transport}), "otherhq")
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "the GitHub app cannot read the otherhq organization")
+	})
+
+	t.Run("missing organization -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := getOrganization(github.NewClient(&http.Client{Transport: transport}), "nohq")
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to get organization nohq")
+	})
+}
@@ -0,0 +1,234 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/retry"
+)
+
+type GetPullRequest struct{}
+
+type GetPullRequestConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	PullNumber       string `json:"pullNumber" mapstructure:"pullNumber"`
+	WaitForMergeable bool   `json:"waitForMergeable" mapstructure:"waitForMergeable"`
+}
+
+func (c *GetPullRequest) Name() string {
+	return "github.getPullRequest"
+}
+
+func (c *GetPullRequest) Label() string {
+	return "Get Pull Request"
+}
+
+func (c *GetPullRequest) Description() string {
+	return "Get a GitHub pull request by number"
+}
+
+func (c *GetPullRequest) Documentation() string {
+	return `The Get Pull Request component retrieves a pull request from a GitHub repository by its number.
+
+## Use Cases
+
+- **Merge automation**: Check if a pull request is mergeable before merging it
+- **Deployment flows**: Get the head and base refs of a pull request to deploy
+- **Review routing**: Inspect labels and requested reviewers
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Wait for Mergeable**: GitHub computes the mergeable state asynchronously, so it can be ` + "`null`" + ` right after a push.
+  When enabled, the pull request is fetched again, with backoff, until the mergeable state is known
+
+## Output
+
+Returns the complete pull request object, including:
+- Mergeable state and merge commit SHA
+- Head and base refs
+- Labels and requested reviewers
+- Draft flag
+
+## Notes
+
+- With **Cache TTL**, a pull request read in the last seconds is reused. A ` + "`pull_request`" + ` event received by an On Pull Request trigger drops it
+- A cached pull request may not have its mergeable state yet. **Wait for Mergeable** still fetches it again until it is known`
+}
+
+func (c *GetPullRequest) Icon() string {
+	return "github"
+}
+
+func (c *GetPullRequest) Color() string {
+	return "gray"
+}
+
+func (c *GetPullRequest) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetPullRequest) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "waitForMergeable",
+			Label:       "Wait for Mergeable",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Fetch the pull request again until GitHub finishes computing its mergeable state",
+		},
+		CacheTTLField,
+		BypassCacheField,
+	}
+}
+
+func (c *GetPullRequest) Setup(ctx core.SetupContext) error {
+	var config GetPullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetPullRequest) Execute(ctx core.ExecutionContext) error {
+	var config GetPullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	cacheArgs := []any{appMetadata.Owner, config.Repository, pullNumber}
+	pr, err := cachedRead(appMetadata.InstallationID, readCacheOptions(ctx.Configuration), "GetPullRequest", cacheArgs, func() (*github.PullRequest, error) {
+		return getPullRequest(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, pullNumber)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	if config.WaitForMergeable && pr.Mergeable == nil {
+		pr, err = c.waitForMergeable(ctx, client, appMetadata.Owner, config.Repository, pullNumber)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequest",
+		[]any{pr},
+	)
+}
+
+/*
+ * GitHub starts computing the mergeable state in the background
+ * when the pull request is requested, so we just need to fetch it again.
+ * If it's still not known after all attempts, we return what we have.
+ */
+func (c *GetPullRequest) waitForMergeable(ctx core.ExecutionContext, client *github.Client, owner, repo string, number int) (*github.PullRequest, error) {
+	var pr *github.PullRequest
+	err := retry.WithExponentialBackoff(func() error {
+		var getErr error
+		pr, _, getErr = client.PullRequests.Get(context.Background(), owner, repo, number)
+		if getErr != nil {
+			return getErr
+		}
+
+		if pr.Mergeable == nil {
+			return fmt.Errorf("mergeable state for pull request %d is not computed yet", number)
+		}
+
+		return nil
+	}, retry.Options{
+		Task:        "wait for mergeable state",
+		MaxAttempts: 4,
+		Wait:        time.Second,
+		Verbose:     true,
+	})
+
+	if err == nil {
+		return pr, nil
+	}
+
+	if pr == nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	ctx.Logger.Warnf("Mergeable state for pull request %d still unknown: %v", number, err)
+	return pr, nil
+}
+
+func (c *GetPullRequest) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetPullRequest) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetPullRequest) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetPullRequest) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetPullRequest) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetPullRequest) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
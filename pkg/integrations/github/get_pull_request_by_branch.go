@@ -0,0 +1,223 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetPullRequestByBranch struct{}
+
+type GetPullRequestByBranchConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Head       string `json:"head" mapstructure:"head"`
+	Base       string `json:"base" mapstructure:"base"`
+}
+
+/*
+ * The fields of the pull request are only set when one was found.
+ */
+type PullRequestByBranchOutput struct {
+	Found      bool `json:"found"`
+	MatchCount int  `json:"match_count"`
+	*github.PullRequest
+}
+
+func (c *GetPullRequestByBranch) Name() string {
+	return "github.getPullRequestByBranch"
+}
+
+func (c *GetPullRequestByBranch) Label() string {
+	return "Get Pull Request By Branch"
+}
+
+func (c *GetPullRequestByBranch) Description() string {
+	return "Get the open GitHub pull request of a branch"
+}
+
+func (c *GetPullRequestByBranch) Documentation() string {
+	return `The Get Pull Request By Branch component finds the open pull request of a branch, when only the branch name is known.
+
+## Use Cases
+
+- **Push flows**: Comment on the pull request of a branch that was just pushed
+- **Preview environments**: Link a deployment of a branch to its pull request
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Head Branch**: The branch the changes are on (supports expressions). For a branch of a fork, use ` + "`owner:branch`" + `
+- **Base Branch**: Only match pull requests into this branch. If empty, pull requests into any branch match
+
+## Output
+
+Emits a ` + "`github.pullRequest`" + ` event with ` + "`found`" + `, and ` + "`match_count`" + `, the number of open pull requests that matched.
+If one was found, the event also includes the fields of the pull request, like ` + "`number`" + `, ` + "`html_url`" + `, ` + "`head`" + ` and ` + "`base`" + `.
+
+## Notes
+
+- Only open pull requests are matched. If none match, ` + "`found`" + ` is false and the execution still succeeds
+- Without a base branch, a branch can have several open pull requests. The most recently updated one is emitted
+- The pull request does not include its mergeable state. Use Get Pull Request to read it`
+}
+
+func (c *GetPullRequestByBranch) Icon() string {
+	return "github"
+}
+
+func (c *GetPullRequestByBranch) Color() string {
+	return "gray"
+}
+
+func (c *GetPullRequestByBranch) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetPullRequestByBranch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "head",
+			Label:       "Head Branch",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.ref}}",
+			Description: "The branch the changes are on. Use owner:branch for a branch of a fork",
+		},
+		{
+			Name:        "base",
+			Label:       "Base Branch",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Leave empty to match pull requests into any branch",
+		},
+	}
+}
+
+func (c *GetPullRequestByBranch) Setup(ctx core.SetupContext) error {
+	var config GetPullRequestByBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Head) == "" {
+		return errors.New("head branch is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetPullRequestByBranch) Execute(ctx core.ExecutionContext) error {
+	var config GetPullRequestByBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := getPullRequestByBranch(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	if !output.Found {
+		ctx.Logger.Infof("No open pull request found for %s", config.Head)
+	} else if output.MatchCount > 1 {
+		ctx.Logger.Infof("%d open pull requests found for %s - using the most recently updated one", output.MatchCount, config.Head)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequest",
+		[]any{output},
+	)
+}
+
+func getPullRequestByBranch(client *github.Client, owner string, config GetPullRequestByBranchConfiguration) (*PullRequestByBranchOutput, error) {
+	opts := &github.PullRequestListOptions{
+		State:       "open",
+		Head:        pullRequestHead(owner, config.Head),
+		Base:        strings.TrimPrefix(strings.TrimSpace(config.Base), "refs/heads/"),
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	pullRequests, _, err := client.PullRequests.List(context.Background(), owner, config.Repository, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests for %s: %w", opts.Head, wrapGitHubError(err))
+	}
+
+	if len(pullRequests) == 0 {
+		return &PullRequestByBranchOutput{Found: false}, nil
+	}
+
+	return &PullRequestByBranchOutput{Found: true, MatchCount: len(pullRequests), PullRequest: pullRequests[0]}, nil
+}
+
+/*
+ * The API only filters by head as owner:branch,
+ * and ignores the filter for a branch name alone.
+ */
+func pullRequestHead(owner, head string) string {
+	head = strings.TrimPrefix(strings.TrimSpace(head), "refs/heads/")
+	if strings.Contains(head, ":") {
+		return head
+	}
+
+	return owner + ":" + head
+}
+
+func (c *GetPullRequestByBranch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetPullRequestByBranch) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetPullRequestByBranch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetPullRequestByBranch) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestByBranch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestByBranch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetPullRequestByBranch__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetPullRequestByBranch{}
+
+	t.Run("head branch is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "head": " "},
+		})
+
+		require.ErrorContains(t, err, "head branch is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "head": "feature", "base": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetPullRequestByBranch__Head(t *testing.T) {
+	assert.Equal(t, "testhq:feature", pullRequestHead("testhq", " feature "))
+	assert.Equal(t, "testhq:feature/login", pullRequestHead("testhq", "refs/heads/feature/login"))
+	assert.Equal(t, "someone:feature", pullRequestHead("testhq", "someone:feature"))
+}
+
+func Test__GetPullRequestByBranch__GetPullRequest(t *testing.T) {
+	t.Run("most recently updated pull request is emitted", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[
+				{"number":12,"state":"open","html_url":"https://github.com/testhq/hello/pull/12","head":{"ref":"feature"},"base":{"ref":"release"}},
+				{"number":7,"state":"open","html_url":"https://github.com/testhq/hello/pull/7","head":{"ref":"feature"},"base":{"ref":"main"}}
+			]`), nil
+		}}
+
+		config := GetPullRequestByBranchConfiguration{Repository: "hello", Head: "feature"}
+		output, err := getPullRequestByBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+
+		query := transport.requests[0].URL.Query()
+		assert.Equal(t, "/repos/testhq/hello/pulls", transport.requests[0].URL.Path)
+		assert.Equal(t, "open", query.Get("state"))
+		assert.Equal(t, "testhq:feature", query.Get("head"))
+		assert.Empty(t, query.Get("base"))
+		assert.Equal(t, "updated", query.Get("sort"))
+		assert.Equal(t, "desc", query.Get("direction"))
+
+		assert.True(t, output.Found)
+		assert.Equal(t, 2, output.MatchCount)
+		assert.Equal(t, 12, output.GetNumber())
+		assert.Equal(t, "release", output.GetBase().GetRef())
+	})
+
+	t.Run("base branch is used as a filter", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[{"number":7,"state":"open"}]`), nil
+		}}
+
+		config := GetPullRequestByBranchConfiguration{Repository: "hello", Head: "feature", Base: "refs/heads/main"}
+		output, err := getPullRequestByBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Equal(t, "main", transport.requests[0].URL.Query().Get("base"))
+		assert.Equal(t, 7, output.GetNumber())
+	})
+
+	t.Run("no open pull request -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[]`), nil
+		}}
+
+		config := GetPullRequestByBranchConfiguration{Repository: "hello", Head: "feature"}
+		output, err := getPullRequestByBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.False(t, output.Found)
+
+		data, err := json.Marshal(output)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"found":false,"match_count":0}`, string(data))
+	})
+}
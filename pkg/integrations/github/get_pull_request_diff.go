@@ -0,0 +1,240 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultDiffMaxBytes = 1024 * 1024
+
+type GetPullRequestDiff struct{}
+
+type GetPullRequestDiffConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	MaxBytes   *int   `json:"maxBytes" mapstructure:"maxBytes"`
+}
+
+type PullRequestDiffOutput struct {
+	PullNumber int    `json:"pull_number"`
+	Diff       string `json:"diff"`
+	Size       int    `json:"size"`
+	Truncated  bool   `json:"truncated"`
+}
+
+func (c *GetPullRequestDiff) Name() string {
+	return "github.getPullRequestDiff"
+}
+
+func (c *GetPullRequestDiff) Label() string {
+	return "Get Pull Request Diff"
+}
+
+func (c *GetPullRequestDiff) Description() string {
+	return "Get the raw unified diff of a GitHub pull request"
+}
+
+func (c *GetPullRequestDiff) Documentation() string {
+	return `The Get Pull Request Diff component retrieves the changes of a pull request as a single raw unified diff, as produced by ` + "`git diff`" + `.
+
+## Use Cases
+
+- **Code review bots**: Send the whole diff of a pull request to a reviewer or a model
+- **Patch archiving**: Store the exact changes of a pull request
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Max Bytes**: Maximum size of the emitted diff. Longer diffs are truncated. Defaults to 1 MiB
+
+## Output
+
+Emits a ` + "`github.pullRequestDiff`" + ` event with the ` + "`pull_number`" + `, the raw ` + "`diff`" + `, its full ` + "`size`" + ` in bytes, and ` + "`truncated`" + `, which is true if the diff was cut at **Max Bytes**.
+
+## Notes
+
+- Unlike the per-file patches returned with the files of a pull request, the diff is one text, with the file headers included
+- Diffs are truncated at a character boundary, so the emitted diff can be a few bytes shorter than **Max Bytes**
+- GitHub refuses to generate diffs for very large pull requests, and the execution fails`
+}
+
+func (c *GetPullRequestDiff) Icon() string {
+	return "github"
+}
+
+func (c *GetPullRequestDiff) Color() string {
+	return "gray"
+}
+
+func (c *GetPullRequestDiff) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetPullRequestDiff) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "maxBytes",
+			Label:       "Max Bytes",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultDiffMaxBytes,
+			Description: "Maximum size of the emitted diff, in bytes",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *GetPullRequestDiff) Setup(ctx core.SetupContext) error {
+	var config GetPullRequestDiffConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.MaxBytes != nil && *config.MaxBytes < 1 {
+		return errors.New("max bytes must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetPullRequestDiff) Execute(ctx core.ExecutionContext) error {
+	var config GetPullRequestDiffConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	maxBytes := DefaultDiffMaxBytes
+	if config.MaxBytes != nil {
+		maxBytes = *config.MaxBytes
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := getPullRequestDiff(client, appMetadata.Owner, config.Repository, pullNumber, maxBytes)
+	if err != nil {
+		return err
+	}
+
+	if output.Truncated {
+		ctx.Logger.Warnf("Diff of pull request %d has %d bytes, truncated to %d", pullNumber, output.Size, len(output.Diff))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequestDiff",
+		[]any{output},
+	)
+}
+
+/*
+ * Requests the pull request with the diff media type,
+ * for which GitHub answers with the raw diff instead of the pull request JSON.
+ */
+func getPullRequestDiff(client *github.Client, owner, repo string, number, maxBytes int) (*PullRequestDiffOutput, error) {
+	diff, _, err := client.PullRequests.GetRaw(context.Background(), owner, repo, number, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get diff of pull request %d: %w", number, wrapGitHubError(err))
+	}
+
+	output := &PullRequestDiffOutput{
+		PullNumber: number,
+		Diff:       diff,
+		Size:       len(diff),
+	}
+
+	if len(diff) > maxBytes {
+		output.Diff = truncateBytes(diff, maxBytes)
+		output.Truncated = true
+	}
+
+	return output, nil
+}
+
+/*
+ * Cuts the text at the last character boundary within the limit,
+ * so a multi-byte character is never split.
+ */
+func truncateBytes(text string, limit int) string {
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+
+	return text[:limit]
+}
+
+func (c *GetPullRequestDiff) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetPullRequestDiff) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetPullRequestDiff) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetPullRequestDiff) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestDiff) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestDiff) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const testPullRequestDiff = "diff --git a/README.md b/README.md\n--- a/README.md\n+++ b/README.md\n@@ -1 +1 @@\n-Hello world\n+Héllo world\n"
+
+func Test__GetPullRequestDiff__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetPullRequestDiff{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("max bytes must be positive", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "maxBytes": 0},
+		})
+
+		require.ErrorContains(t, err, "max bytes must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetPullRequestDiff__Get(t *testing.T) {
+	diffTransport := func() *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, testPullRequestDiff), nil
+		}}
+	}
+
+	t.Run("diff is requested with the diff media type", func(t *testing.T) {
+		transport := diffTransport()
+		output, err := getPullRequestDiff(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, DefaultDiffMaxBytes)
+		require.NoError(t, err)
+
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, "/repos/testhq/hello/pulls/42", transport.requests[0].URL.Path)
+		assert.Equal(t, "application/vnd.github.v3.diff", transport.requests[0].Header.Get("Accept"))
+		assert.Equal(t, &PullRequestDiffOutput{
+			PullNumber: 42,
+			Diff:       testPullRequestDiff,
+			Size:       len(testPullRequestDiff),
+		}, output)
+	})
+
+	t.Run("large diff is truncated at a character boundary", func(t *testing.T) {
+		limit := strings.Index(testPullRequestDiff, "é") + 1
+		output, err := getPullRequestDiff(github.NewClient(&http.Client{Transport: diffTransport()}), "testhq", "hello", 42, limit)
+		require.NoError(t, err)
+
+		assert.True(t, output.Truncated)
+		assert.Equal(t, len(testPullRequestDiff), output.Size)
+		assert.Equal(t, testPullRequestDiff[:limit-1], output.Diff)
+	})
+
+	t.Run("pull request not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := getPullRequestDiff(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, DefaultDiffMaxBytes)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to get diff of pull request 42")
+	})
+}
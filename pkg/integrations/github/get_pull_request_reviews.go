@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultRequiredApprovals = 1
+
+	ReviewStateApproved         = "APPROVED"
+	ReviewStateChangesRequested = "CHANGES_REQUESTED"
+	ReviewStateCommented        = "COMMENTED"
+	ReviewStateDismissed        = "DISMISSED"
+	ReviewStatePending          = "PENDING"
+)
+
+type GetPullRequestReviews struct{}
+
+type GetPullRequestReviewsConfiguration struct {
+	Repository        string `json:"repository" mapstructure:"repository"`
+	PullNumber        string `json:"pullNumber" mapstructure:"pullNumber"`
+	RequiredApprovals *int   `json:"requiredApprovals" mapstructure:"requiredApprovals"`
+}
+
+type PullRequestReview struct {
+	ID          int64  `json:"id"`
+	Author      string `json:"author"`
+	State       string `json:"state"`
+	SubmittedAt string `json:"submitted_at,omitempty"`
+	URL         string `json:"html_url"`
+}
+
+type PullRequestReviewsOutput struct {
+	PullNumber        int                 `json:"pull_number"`
+	Reviews           []PullRequestReview `json:"reviews"`
+	Approvals         int                 `json:"approvals"`
+	ChangesRequested  int                 `json:"changes_requested"`
+	RequiredApprovals int                 `json:"required_approvals"`
+	Approved          bool                `json:"approved"`
+}
+
+func (c *GetPullRequestReviews) Name() string {
+	return "github.getPullRequestReviews"
+}
+
+func (c *GetPullRequestReviews) Label() string {
+	return "Get Pull Request Reviews"
+}
+
+func (c *GetPullRequestReviews) Description() string {
+	return "Get the reviews of a GitHub pull request, and whether it has the required approvals"
+}
+
+func (c *GetPullRequestReviews) Documentation() string {
+	return `The Get Pull Request Reviews component lists the reviews of a pull request, and checks if it has enough approvals.
+
+## Use Cases
+
+- **Merge gating**: Only merge a pull request once it has the required approvals
+- **Review reminders**: Notify reviewers when changes were requested
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Required Approvals**: How many approvals the pull request needs. Defaults to 1
+
+## Output
+
+Returns the ` + "`reviews`" + `, with the ` + "`author`" + `, ` + "`state`" + `, and ` + "`submitted_at`" + ` time of each review.
+Like GitHub, only the latest review of each author counts, so there is at most one review per author:
+
+- A later ` + "`COMMENTED`" + ` review does not replace an approval or a change request from the same author
+- A ` + "`DISMISSED`" + ` review no longer counts as an approval or a change request
+
+` + "`approvals`" + ` and ` + "`changes_requested`" + ` count the authors in each state.
+` + "`approved`" + ` is true if there are at least ` + "`required_approvals`" + ` approvals, and no changes requested.
+
+## Notes
+
+- Pending reviews, which were not submitted yet, are ignored
+- Branch protection rules, like code owner reviews, are not evaluated`
+}
+
+func (c *GetPullRequestReviews) Icon() string {
+	return "github"
+}
+
+func (c *GetPullRequestReviews) Color() string {
+	return "gray"
+}
+
+func (c *GetPullRequestReviews) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetPullRequestReviews) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "requiredApprovals",
+			Label:       "Required Approvals",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultRequiredApprovals,
+			Description: "Number of approvals needed for the pull request to be approved",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *GetPullRequestReviews) Setup(ctx core.SetupContext) error {
+	var config GetPullRequestReviewsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.RequiredApprovals != nil && *config.RequiredApprovals < 1 {
+		return errors.New("required approvals must be at least 1")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetPullRequestReviews) Execute(ctx core.ExecutionContext) error {
+	var config GetPullRequestReviewsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	reviews, err := listPullRequestReviews(client, appMetadata.Owner, config.Repository, pullNumber)
+	if err != nil {
+		return err
+	}
+
+	requiredApprovals := DefaultRequiredApprovals
+	if config.RequiredApprovals != nil && *config.RequiredApprovals > 0 {
+		requiredApprovals = *config.RequiredApprovals
+	}
+
+	output := summarizeReviews(latestReviewPerAuthor(reviews), requiredApprovals)
+	output.PullNumber = pullNumber
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequestReviews",
+		[]any{output},
+	)
+}
+
+func listPullRequestReviews(client *github.Client, owner, repository string, pullNumber int) ([]*github.PullRequestReview, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	reviews := []*github.PullRequestReview{}
+	for {
+		page, response, err := client.PullRequests.ListReviews(context.Background(), owner, repository, pullNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list reviews of pull request %d: %w", pullNumber, wrapGitHubError(err))
+		}
+
+		reviews = append(reviews, page...)
+		if response.NextPage == 0 {
+			return reviews, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * GitHub only counts the latest review of each author.
+ * Reviews are listed in chronological order, so later reviews replace earlier ones,
+ * except for comments, which do not change an approval or a change request.
+ */
+func latestReviewPerAuthor(reviews []*github.PullRequestReview) []*github.PullRequestReview {
+	latest := []*github.PullRequestReview{}
+	byAuthor := map[string]int{}
+
+	for _, review := range reviews {
+		if review.GetState() == ReviewStatePending {
+			continue
+		}
+
+		author := review.GetUser().GetLogin()
+		index, ok := byAuthor[author]
+		if !ok {
+			byAuthor[author] = len(latest)
+			latest = append(latest, review)
+			continue
+		}
+
+		if review.GetState() == ReviewStateCommented && latest[index].GetState() != ReviewStateCommented {
+			continue
+		}
+
+		latest[index] = review
+	}
+
+	return latest
+}
+
+func summarizeReviews(reviews []*github.PullRequestReview, requiredApprovals int) *PullRequestReviewsOutput {
+	output := &PullRequestReviewsOutput{
+		Reviews:           make([]PullRequestReview, 0, len(reviews)),
+		RequiredApprovals: requiredApprovals,
+	}
+
+	for _, review := range reviews {
+		summary := PullRequestReview{
+			ID:     review.GetID(),
+			Author: review.GetUser().GetLogin(),
+			State:  review.GetState(),
+			URL:    review.GetHTMLURL(),
+		}
+
+		if review.SubmittedAt != nil {
+			summary.SubmittedAt = review.GetSubmittedAt().UTC().Format(time.RFC3339)
+		}
+
+		output.Reviews = append(output.Reviews, summary)
+	}
+
+	output.Approvals = countReviews(output.Reviews, ReviewStateApproved)
+	output.ChangesRequested = countReviews(output.Reviews, ReviewStateChangesRequested)
+	output.Approved = output.Approvals >= requiredApprovals && output.ChangesRequested == 0
+	return output
+}
+
+func countReviews(reviews []PullRequestReview, state string) int {
+	count := 0
+	for _, review := range reviews {
+		if review.State == state {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (c *GetPullRequestReviews) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetPullRequestReviews) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetPullRequestReviews) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetPullRequestReviews) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestReviews) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetPullRequestReviews) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetPullRequestReviews__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetPullRequestReviews{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("required approvals below 1 -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "requiredApprovals": 0},
+		})
+
+		require.ErrorContains(t, err, "required approvals must be at least 1")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "requiredApprovals": 2},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetPullRequestReviews__ListReviews(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		if request.URL.Query().Get("page") == "" {
+			response := mockResponse(http.StatusOK, `[{"id":1,"state":"APPROVED","user":{"login":"octocat"}}]`)
+			response.Header = http.Header{"Link": []string{`<https://api.github.com/repos/testhq/hello/pulls/42/reviews?page=2>; rel="next"`}}
+			return response, nil
+		}
+
+		return mockResponse(http.StatusOK, `[{"id":2,"state":"COMMENTED","user":{"login":"hubot"}}]`), nil
+	}}
+
+	reviews, err := listPullRequestReviews(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, "/repos/testhq/hello/pulls/42/reviews", transport.requests[0].URL.Path)
+	assert.Equal(t, "100", transport.requests[0].URL.Query().Get("per_page"))
+
+	t.Run("not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := listPullRequestReviews(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func Test__GetPullRequestReviews__Summary(t *testing.T) {
+	now := time.Now()
+	review := func(id int64, author, state string, submittedAt time.Time) *github.PullRequestReview {
+		return &github.PullRequestReview{
+			ID:          github.Ptr(id),
+			User:        &github.User{Login: github.Ptr(author)},
+			State:       github.Ptr(state),
+			SubmittedAt: &github.Timestamp{Time: submittedAt},
+		}
+	}
+
+	reviews := []*github.PullRequestReview{
+		review(1, "octocat", ReviewStateChangesRequested, now.Add(-3*time.Hour)),
+		review(2, "hubot", ReviewStateApproved, now.Add(-2*time.Hour)),
+		review(3, "octocat", ReviewStateApproved, now.Add(-time.Hour)),
+		review(4, "hubot", ReviewStateCommented, now.Add(-30*time.Minute)),
+		review(5, "monalisa", ReviewStateCommented, now.Add(-20*time.Minute)),
+		review(6, "ghost", ReviewStatePending, now),
+	}
+
+	t.Run("latest review per author, comments do not replace approvals", func(t *testing.T) {
+		latest := latestReviewPerAuthor(reviews)
+		require.Len(t, latest, 3)
+		assert.Equal(t, int64(3), latest[0].GetID())
+		assert.Equal(t, int64(2), latest[1].GetID())
+		assert.Equal(t, int64(5), latest[2].GetID())
+	})
+
+	t.Run("required approvals met", func(t *testing.T) {
+		output := summarizeReviews(latestReviewPerAuthor(reviews), 2)
+		assert.Equal(t, 2, output.Approvals)
+		assert.Equal(t, 0, output.ChangesRequested)
+		assert.True(t, output.Approved)
+		assert.Equal(t, now.Add(-time.Hour).UTC().Format(time.RFC3339), output.Reviews[0].SubmittedAt)
+	})
+
+	t.Run("not enough approvals", func(t *testing.T) {
+		output := summarizeReviews(latestReviewPerAuthor(reviews), 3)
+		assert.False(t, output.Approved)
+		assert.Equal(t, 3, output.RequiredApprovals)
+	})
+
+	t.Run("changes requested -> not approved", func(t *testing.T) {
+		output := summarizeReviews(latestReviewPerAuthor(append(reviews, review(7, "hubot", ReviewStateChangesRequested, now))), 1)
+		assert.Equal(t, 1, output.Approvals)
+		assert.Equal(t, 1, output.ChangesRequested)
+		assert.False(t, output.Approved)
+	})
+
+	t.Run("dismissed approval is not counted", func(t *testing.T) {
+		output := summarizeReviews(latestReviewPerAuthor([]*github.PullRequestReview{
+			review(1, "octocat", ReviewStateDismissed, now),
+		}), 1)
+
+		assert.Equal(t, 0, output.Approvals)
+		assert.False(t, output.Approved)
+	})
+}
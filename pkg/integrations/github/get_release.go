@@ -193,7 +193,7 @@ func (c *GetRelease) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
@@ -223,14 +223,14 @@ func (c *GetRelease) Execute(ctx core.ExecutionContext) error {
 		release = r
 	} else if config.ReleaseStrategy == "specific" {
 		// Fetch by tag (validation done above)
-		r, err := fetchReleaseByStrategy(client, appMetadata.Owner, config.Repository, config.ReleaseStrategy, *config.TagName)
+		r, err := fetchReleaseByStrategy(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.ReleaseStrategy, *config.TagName)
 		if err != nil {
 			return err
 		}
 		release = r
 	} else {
 		// Use the common helper for other strategies (latest, latestDraft, latestPrerelease)
-		r, err := fetchReleaseByStrategy(client, appMetadata.Owner, config.Repository, config.ReleaseStrategy, "")
+		r, err := fetchReleaseByStrategy(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.ReleaseStrategy, "")
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,240 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const TreeTruncatedWarning = "GitHub truncated the tree because it is too large, so the list of entries is incomplete"
+
+type GetTree struct{}
+
+type GetTreeConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Ref        string `json:"ref" mapstructure:"ref"`
+	Recursive  bool   `json:"recursive" mapstructure:"recursive"`
+	PathPrefix string `json:"pathPrefix" mapstructure:"pathPrefix"`
+}
+
+type TreeOutput struct {
+	SHA       string            `json:"sha"`
+	Truncated bool              `json:"truncated"`
+	Warning   string            `json:"warning,omitempty"`
+	Entries   []TreeEntryOutput `json:"entries"`
+}
+
+type TreeEntryOutput struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int    `json:"size,omitempty"`
+	SHA  string `json:"sha"`
+}
+
+func (c *GetTree) Name() string {
+	return "github.getTree"
+}
+
+func (c *GetTree) Label() string {
+	return "Get Tree"
+}
+
+func (c *GetTree) Description() string {
+	return "List the files of a GitHub repository"
+}
+
+func (c *GetTree) Documentation() string {
+	return `The Get Tree component lists the files and directories of a GitHub repository at a given branch, tag, or commit.
+
+## Use Cases
+
+- **Codemods**: Find the files a change needs to be applied to
+- **Audits**: Check that every service directory has the expected files
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Branch or tag**: The branch, tag, or commit SHA to list the files of
+- **Recursive**: List the entries of all subdirectories, not only the top-level ones
+- **Path Prefix**: Only include entries whose path starts with this prefix, for example ` + "`services/`" + `
+
+## Output
+
+Emits the tree ` + "`sha`" + ` and its ` + "`entries`" + `. Each entry includes its ` + "`path`" + `, ` + "`type`" + ` (` + "`blob`" + ` for files, ` + "`tree`" + ` for directories), ` + "`size`" + `, and ` + "`sha`" + `.
+
+## Notes
+
+- GitHub truncates trees with more than 100,000 entries. When that happens, ` + "`truncated`" + ` is ` + "`true`" + ` and ` + "`warning`" + ` explains that the list is incomplete
+- The path prefix is applied after the tree is fetched, so it does not help with truncated trees`
+}
+
+func (c *GetTree) Icon() string {
+	return "github"
+}
+
+func (c *GetTree) Color() string {
+	return "gray"
+}
+
+func (c *GetTree) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetTree) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "ref",
+			Label:    "Branch or tag",
+			Type:     configuration.FieldTypeGitRef,
+			Required: true,
+			Default:  "main",
+		},
+		{
+			Name:    "recursive",
+			Label:   "Recursive",
+			Type:    configuration.FieldTypeBool,
+			Default: true,
+		},
+		{
+			Name:        "pathPrefix",
+			Label:       "Path Prefix",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., services/",
+		},
+	}
+}
+
+func (c *GetTree) Setup(ctx core.SetupContext) error {
+	var config GetTreeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Ref == "" {
+		return errors.New("ref is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetTree) Execute(ctx core.ExecutionContext) error {
+	var config GetTreeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	tree, _, err := client.Git.GetTree(
+		context.Background(),
+		appMetadata.Owner,
+		config.Repository,
+		treeRef(config.Ref),
+		config.Recursive,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to get tree: %w", wrapGitHubError(err))
+	}
+
+	output := buildTreeOutput(tree, config.PathPrefix)
+	if output.Truncated {
+		ctx.Logger.Warnf("Tree for %s@%s was truncated by GitHub", config.Repository, config.Ref)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.tree", []any{output})
+}
+
+/*
+ * The trees endpoint accepts a SHA, or a branch or tag name,
+ * so full references like refs/heads/main are shortened.
+ */
+func treeRef(ref string) string {
+	if branch, ok := strings.CutPrefix(ref, "refs/heads/"); ok {
+		return branch
+	}
+
+	return strings.TrimPrefix(ref, "refs/tags/")
+}
+
+func buildTreeOutput(tree *github.Tree, pathPrefix string) TreeOutput {
+	output := TreeOutput{
+		SHA:       tree.GetSHA(),
+		Truncated: tree.GetTruncated(),
+		Entries:   []TreeEntryOutput{},
+	}
+
+	if output.Truncated {
+		output.Warning = TreeTruncatedWarning
+	}
+
+	for _, entry := range tree.Entries {
+		if !strings.HasPrefix(entry.GetPath(), pathPrefix) {
+			continue
+		}
+
+		output.Entries = append(output.Entries, TreeEntryOutput{
+			Path: entry.GetPath(),
+			Type: entry.GetType(),
+			Size: entry.GetSize(),
+			SHA:  entry.GetSHA(),
+		})
+	}
+
+	return output
+}
+
+func (c *GetTree) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetTree) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetTree) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetTree) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetTree) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetTree) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetTree__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetTree{}
+
+	t.Run("ref is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "ref is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "recursive": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetTree__TreeRef(t *testing.T) {
+	assert.Equal(t, "main", treeRef("refs/heads/main"))
+	assert.Equal(t, "v1.0.0", treeRef("refs/tags/v1.0.0"))
+	assert.Equal(t, "main", treeRef("main"))
+}
+
+func Test__GetTree__BuildOutput(t *testing.T) {
+	tree := &github.Tree{
+		SHA: github.Ptr("9fb037999f264ba9a7fc6274d15fa3ae2ab98312"),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), Size: github.Ptr(100), SHA: github.Ptr("a")},
+			{Path: github.Ptr("services"), Type: github.Ptr("tree"), SHA: github.Ptr("b")},
+			{Path: github.Ptr("services/api/main.go"), Type: github.Ptr("blob"), Size: github.Ptr(2048), SHA: github.Ptr("c")},
+		},
+	}
+
+	t.Run("no prefix -> all entries", func(t *testing.T) {
+		output := buildTreeOutput(tree, "")
+		assert.Len(t, output.Entries, 3)
+		assert.False(t, output.Truncated)
+		assert.Empty(t, output.Warning)
+	})
+
+	t.Run("prefix -> only matching entries", func(t *testing.T) {
+		output := buildTreeOutput(tree, "services/")
+		require.Len(t, output.Entries, 1)
+		assert.Equal(t, TreeEntryOutput{Path: "services/api/main.go", Type: "blob", Size: 2048, SHA: "c"}, output.Entries[0])
+	})
+
+	t.Run("truncated tree -> warning", func(t *testing.T) {
+		output := buildTreeOutput(&github.Tree{SHA: github.Ptr("d"), Truncated: github.Ptr(true)}, "")
+		assert.True(t, output.Truncated)
+		assert.Equal(t, TreeTruncatedWarning, output.Warning)
+		assert.NotNil(t, output.Entries)
+	})
+}
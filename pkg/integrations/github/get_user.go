@@ -0,0 +1,199 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type GetUser struct{}
+
+type GetUserConfiguration struct {
+	Username     string `json:"username" mapstructure:"username"`
+	Organization string `json:"organization" mapstructure:"organization"`
+}
+
+type UserOutput struct {
+	Found    bool   `json:"found"`
+	Login    string `json:"login"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Company  string `json:"company,omitempty"`
+	Type     string `json:"type,omitempty"`
+	URL      string `json:"html_url,omitempty"`
+	IsMember *bool  `json:"is_member,omitempty"`
+}
+
+func (c *GetUser) Name() string {
+	return "github.getUser"
+}
+
+func (c *GetUser) Label() string {
+	return "Get User"
+}
+
+func (c *GetUser) Description() string {
+	return "Get a GitHub user, and optionally check their organization membership"
+}
+
+func (c *GetUser) Documentation() string {
+	return `The Get User component retrieves a GitHub user by username.
+
+## Use Cases
+
+- **Routing**: Send work to different paths depending on who triggered it
+- **Access checks**: Only continue if the user is a member of an organization
+- **Bot detection**: Skip events from bot accounts using the user ` + "`type`" + `
+
+## Configuration
+
+- **Username**: The GitHub username (supports expressions)
+- **Organization**: Optional organization to check the user's membership in
+
+## Output
+
+Returns the user's ` + "`login`" + `, ` + "`name`" + `, ` + "`email`" + ` (only if public), ` + "`company`" + `, and ` + "`type`" + ` (` + "`User`" + ` or ` + "`Bot`" + `).
+
+- ` + "`found`" + ` is false if the user does not exist, instead of failing the execution
+- If an organization is configured, ` + "`is_member`" + ` says whether the user is a member of it
+
+## Notes
+
+- Checking private organization membership requires the GitHub app to have the ` + "`members: read`" + ` permission`
+}
+
+func (c *GetUser) Icon() string {
+	return "github"
+}
+
+func (c *GetUser) Color() string {
+	return "gray"
+}
+
+func (c *GetUser) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetUser) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.sender.login}}",
+		},
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., my-org",
+			Description: "Also check whether the user is a member of this organization",
+		},
+	}
+}
+
+func (c *GetUser) Setup(ctx core.SetupContext) error {
+	var config GetUserConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Username == "" {
+		return errors.New("username is required")
+	}
+
+	return nil
+}
+
+func (c *GetUser) Execute(ctx core.ExecutionContext) error {
+	var config GetUserConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := getUser(client, config)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.user",
+		[]any{output},
+	)
+}
+
+func getUser(client *github.Client, config GetUserConfiguration) (*UserOutput, error) {
+	user, _, err := client.Users.Get(context.Background(), config.Username)
+	if err != nil {
+		err = wrapGitHubError(err)
+		if errors.Is(err, ErrNotFound) {
+			return &UserOutput{Found: false, Login: config.Username}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get user %s: %w", config.Username, err)
+	}
+
+	output := &UserOutput{
+		Found:   true,
+		Login:   user.GetLogin(),
+		Name:    user.GetName(),
+		Email:   user.GetEmail(),
+		Company: user.GetCompany(),
+		Type:    user.GetType(),
+		URL:     user.GetHTMLURL(),
+	}
+
+	if config.Organization == "" {
+		return output, nil
+	}
+
+	isMember, _, err := client.Organizations.IsMember(context.Background(), config.Organization, user.GetLogin())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership of %s in %s: %w", user.GetLogin(), config.Organization, wrapGitHubError(err))
+	}
+
+	output.IsMember = &isMember
+	return output, nil
+}
+
+func (c *GetUser) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetUser) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetUser) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetUser) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetUser) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetUser) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
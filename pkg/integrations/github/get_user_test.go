@@ -0,0 +1,108 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetUser__Setup(t *testing.T) {
+	component := GetUser{}
+
+	t.Run("username is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"organization": "testhq"},
+		})
+
+		require.ErrorContains(t, err, "username is required")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"username": "octocat"},
+		}))
+	})
+}
+
+func Test__GetUser__GetUser(t *testing.T) {
+	clientFor := func(handler func(request *http.Request) (*http.Response, error)) (*github.Client, *mockTransport) {
+		transport := &mockTransport{handler: handler}
+		return github.NewClient(&http.Client{Transport: transport}), transport
+	}
+
+	userHandler := func(memberStatus int) func(request *http.Request) (*http.Response, error) {
+		return func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/users/octocat":
+				return mockResponse(http.StatusOK, `{"login":"octocat","name":"The Octocat","company":"@github","type":"User"}`), nil
+			case "/orgs/testhq/members/octocat":
+				return mockResponse(memberStatus, ""), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		}
+	}
+
+	t.Run("user is returned without membership check", func(t *testing.T) {
+		client, transport := clientFor(userHandler(http.StatusNoContent))
+
+		output, err := getUser(client, GetUserConfiguration{Username: "octocat"})
+		require.NoError(t, err)
+		assert.True(t, output.Found)
+		assert.Equal(t, "The Octocat", output.Name)
+		assert.Equal(t, "@github", output.Company)
+		assert.Equal(t, "User", output.Type)
+		assert.Empty(t, output.Email)
+		assert.Nil(t, output.IsMember)
+		require.Len(t, transport.requests, 1)
+	})
+
+	t.Run("member of the organization", func(t *testing.T) {
+		client, _ := clientFor(userHandler(http.StatusNoContent))
+
+		output, err := getUser(client, GetUserConfiguration{Username: "octocat", Organization: "testhq"})
+		require.NoError(t, err)
+		require.NotNil(t, output.IsMember)
+		assert.True(t, *output.IsMember)
+	})
+
+	t.Run("not a member of the organization", func(t *testing.T) {
+		client, _ := clientFor(userHandler(http.StatusNotFound))
+
+		output, err := getUser(client, GetUserConfiguration{Username: "octocat", Organization: "testhq"})
+		require.NoError(t, err)
+		require.NotNil(t, output.IsMember)
+		assert.False(t, *output.IsMember)
+	})
+
+	t.Run("user not found -> found is false", func(t *testing.T) {
+		client, transport := clientFor(func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		})
+
+		output, err := getUser(client, GetUserConfiguration{Username: "ghost", Organization: "testhq"})
+		require.NoError(t, err)
+		assert.False(t, output.Found)
+		assert.Equal(t, "ghost", output.Login)
+		require.Len(t, transport.requests, 1)
+	})
+
+	t.Run("other errors fail the execution", func(t *testing.T) {
+		client, _ := clientFor(func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Forbidden"}`), nil
+		})
+
+		_, err := getUser(client, GetUserConfiguration{Username: "octocat"})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+}
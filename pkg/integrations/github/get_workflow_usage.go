@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const NoBillableUsageNote = "GitHub only reports billable minutes for private repositories"
+
+type GetWorkflowUsage struct{}
+
+type GetWorkflowUsageConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	WorkflowFileName string `json:"workflowFileName" mapstructure:"workflowFileName"`
+	RunID            string `json:"runId" mapstructure:"runId"`
+}
+
+type RunnerUsage struct {
+	TotalMS int64 `json:"total_ms"`
+	Minutes int64 `json:"minutes"`
+}
+
+type WorkflowUsageOutput struct {
+	Repository       string                 `json:"repository"`
+	WorkflowFileName string                 `json:"workflow_file_name,omitempty"`
+	RunID            int64                  `json:"run_id,omitempty"`
+	Billable         map[string]RunnerUsage `json:"billable"`
+	TotalMinutes     int64                  `json:"total_minutes"`
+	RunDurationMS    int64                  `json:"run_duration_ms,omitempty"`
+	Note             string                 `json:"note,omitempty"`
+}
+
+func (c *GetWorkflowUsage) Name() string {
+	return "github.getWorkflowUsage"
+}
+
+func (c *GetWorkflowUsage) Label() string {
+	return "Get Workflow Usage"
+}
+
+func (c *GetWorkflowUsage) Description() string {
+	return "Get the billable GitHub Actions minutes of a workflow or a workflow run"
+}
+
+func (c *GetWorkflowUsage) Documentation() string {
+	return `The Get Workflow Usage component gets the billable GitHub Actions minutes used by a workflow in the current billing cycle, or by a single workflow run.
+
+## Use Cases
+
+- **Cost reporting**: Track the Actions minutes used by each workflow
+- **Budget alerts**: Notify when a workflow uses more minutes than expected
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Workflow File Name**: The workflow file name, e.g. ` + "`ci.yml`" + `
+- **Run ID**: Optional workflow run ID (supports expressions). When set, the usage of that run is returned instead
+
+## Output
+
+Returns the billable usage per runner OS in ` + "`billable`" + `, keyed by ` + "`ubuntu`" + `, ` + "`macos`" + ` or ` + "`windows`" + `, with ` + "`total_ms`" + ` and ` + "`minutes`" + `, and the sum of all of them in ` + "`total_minutes`" + `.
+For workflow runs, ` + "`run_duration_ms`" + ` is the duration of the run.
+
+## Notes
+
+- GitHub only reports billable minutes for private repositories. For public repositories, ` + "`billable`" + ` is empty, and ` + "`note`" + ` explains why
+- Minutes are rounded up to the next minute`
+}
+
+func (c *GetWorkflowUsage) Icon() string {
+	return "github"
+}
+
+func (c *GetWorkflowUsage) Color() string {
+	return "gray"
+}
+
+func (c *GetWorkflowUsage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetWorkflowUsage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "workflowFileName",
+			Label:       "Workflow File Name",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., ci.yml",
+		},
+		{
+			Name:        "runId",
+			Label:       "Run ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.workflow_run.id}}",
+			Description: "Get the usage of this workflow run instead",
+		},
+	}
+}
+
+func (c *GetWorkflowUsage) Setup(ctx core.SetupContext) error {
+	var config GetWorkflowUsageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.WorkflowFileName == "" && config.RunID == "" {
+		return errors.New("workflow file name or run ID is required")
+	}
+
+	if config.RunID != "" && !isExpression(config.RunID) {
+		if _, err := strconv.ParseInt(config.RunID, 10, 64); err != nil {
+			return fmt.Errorf("run ID is not a number: %s", config.RunID)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *GetWorkflowUsage) Execute(ctx core.ExecutionContext) error {
+	var config GetWorkflowUsageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := getWorkflowUsage(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.workflowUsage",
+		[]any{output},
+	)
+}
+
+func getWorkflowUsage(client *github.Client, owner string, config GetWorkflowUsageConfiguration) (*WorkflowUsageOutput, error) {
+	output := &WorkflowUsageOutput{Repository: config.Repository, Billable: map[string]RunnerUsage{}}
+
+	if config.RunID != "" {
+		runID, err := strconv.ParseInt(config.RunID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("run ID is not a number: %v", err)
+		}
+
+		usage, _, err := client.Actions.GetWorkflowRunUsageByID(context.Background(), owner, config.Repository, runID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get usage of workflow run %d: %w", runID, wrapGitHubError(err))
+		}
+
+		output.RunID = runID
+		output.RunDurationMS = usage.GetRunDurationMS()
+		if usage.Billable != nil {
+			for os, bill := range *usage.Billable {
+				addRunnerUsage(output, os, bill.GetTotalMS())
+			}
+		}
+	} else {
+		usage, _, err := client.Actions.GetWorkflowUsageByFileName(context.Background(), owner, config.Repository, config.WorkflowFileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get usage of workflow %s: %w", config.WorkflowFileName, wrapGitHubError(err))
+		}
+
+		output.WorkflowFileName = config.WorkflowFileName
+		if usage.Billable != nil {
+			for os, bill := range *usage.Billable {
+				addRunnerUsage(output, os, bill.GetTotalMS())
+			}
+		}
+	}
+
+	if len(output.Billable) == 0 {
+		output.Note = NoBillableUsageNote
+	}
+
+	return output, nil
+}
+
+func addRunnerUsage(output *WorkflowUsageOutput, os string, totalMS int64) {
+	minutes := (totalMS + 59999) / 60000
+	output.Billable[strings.ToLower(os)] = RunnerUsage{TotalMS: totalMS, Minutes: minutes}
+	output.TotalMinutes += minutes
+}
+
+func (c *GetWorkflowUsage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetWorkflowUsage) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *GetWorkflowUsage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetWorkflowUsage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetWorkflowUsage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetWorkflowUsage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GetWorkflowUsage__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := GetWorkflowUsage{}
+
+	t.Run("workflow file name or run ID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "workflow file name or run ID is required")
+	})
+
+	t.Run("run ID is not a number -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "runId": "abc"},
+		})
+
+		require.ErrorContains(t, err, "run ID is not a number")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "workflowFileName": "ci.yml"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__GetWorkflowUsage__Get(t *testing.T) {
+	usageTransport := func(body string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, body), nil
+		}}
+	}
+
+	t.Run("workflow usage is returned per runner OS", func(t *testing.T) {
+		transport := usageTransport(`{"billable":{"UBUNTU":{"total_ms":180000},"MACOS":{"total_ms":61000}}}`)
+		output, err := getWorkflowUsage(github.NewClient(&http.Client{Transport: transport}), "testhq", GetWorkflowUsageConfiguration{
+			Repository:       "hello",
+			WorkflowFileName: "ci.yml",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "/repos/testhq/hello/actions/workflows/ci.yml/timing", transport.requests[0].URL.Path)
+		assert.Equal(t, RunnerUsage{TotalMS: 180000, Minutes: 3}, output.Billable["ubuntu"])
+		assert.Equal(t, RunnerUsage{TotalMS: 61000, Minutes: 2}, output.Billable["macos"])
+		assert.Equal(t, int64(5), output.TotalMinutes)
+		assert.Empty(t, output.Note)
+	})
+
+	t.Run("run usage is returned when a run ID is given", func(t *testing.T) {
+		transport := usageTransport(`{"billable":{"WINDOWS":{"total_ms":120000,"jobs":1}},"run_duration_ms":130000}`)
+		output, err := getWorkflowUsage(github.NewClient(&http.Client{Transport: transport}), "testhq", GetWorkflowUsageConfiguration{
+			Repository: "hello",
+			RunID:      "42",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "/repos/testhq/hello/actions/runs/42/timing", transport.requests[0].URL.Path)
+		assert.Equal(t, int64(42), output.RunID)
+		assert.Equal(t, int64(130000), output.RunDurationMS)
+		assert.Equal(t, int64(2), output.TotalMinutes)
+	})
+
+	t.Run("public repository -> no billable usage, with a note", func(t *testing.T) {
+		transport := usageTransport(`{"billable":{}}`)
+		output, err := getWorkflowUsage(github.NewClient(&http.Client{Transport: transport}), "testhq", GetWorkflowUsageConfiguration{
+			Repository:       "hello",
+			WorkflowFileName: "ci.yml",
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Billable)
+		assert.Equal(t, NoBillableUsageNote, output.Note)
+	})
+}
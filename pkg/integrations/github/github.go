@@ -43,7 +43,8 @@ type GitHub struct {
 }
 
 type Configuration struct {
-	Organization string `json:"organization"`
+	Organization             string `json:"organization"`
+	RepositoryAdministration bool   `json:"repositoryAdministration" mapstructure:"repositoryAdministration"`
 }
 
 type Metadata struct {
@@ -88,21 +89,133 @@ func (g *GitHub) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeString,
 			Description: "Organization to install the app into. If not specified, the app will be installed into the user's account.",
 		},
+		{
+			Name:        "repositoryAdministration",
+			Label:       "Repository Administration",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Request write access to repository administration, needed by components that change repository settings, collaborators and environments. Only applies when the app is created.",
+		},
 	}
 }
 
 func (g *GitHub) Components() []core.Component {
-	return []core.Component{
+	components := []core.Component{
 		&GetIssue{},
+		&ListIssues{},
+		&ListDeployments{},
+		&ListWorkflowRuns{},
+		&ListCommits{},
+		&GetCommit{},
+		&DiffRefs{},
+		&ListCheckRuns{},
+		&ListChecksForPR{},
+		&WaitForCheckRun{},
+		&ListPullRequestsForCommit{},
+		&ListAccessibleRepositories{},
+		&RerunWorkflow{},
+		&GetPullRequest{},
+		&GetPullRequestReviews{},
+		&EditPullRequestBody{},
+		&EnableAutoMerge{},
+		&EnqueuePullRequest{},
+		&SetPullRequestDraft{},
 		&CreateIssue{},
+		&CreateIssueFromTemplate{},
 		&UpdateIssue{},
+		&RemoveAssignees{},
+		&PinIssue{},
+		&AddSubIssue{},
+		&AddLabels{},
+		&ListRepositoryLabels{},
+		&CreateOrUpdateLabel{},
+		&BulkCloseIssues{},
+		&AddToProject{},
+		&CreateIssueComment{},
+		&ReactToIssue{},
+		&DeleteIssueComment{},
+		&CreateCommitComment{},
+		&CreateReviewComment{},
 		&RunWorkflow{},
+		&CreateRepositoryDispatch{},
+		&CreateForkSync{},
+		&SetDefaultBranch{},
+		&ArchiveRepository{},
+		&ListStaleBranches{},
+		&GetWorkflowUsage{},
+		&ListTags{},
+		&GetDefaultBranchSHA{},
+		&ListEnvironmentVariables{},
+		&ListEnvironmentSecrets{},
+		&SetEnvironmentVariable{},
+		&GetLatestDeploymentStatus{},
+		&RerequestCheckSuite{},
+		&ListForks{},
+		&GetOrganization{},
+		&CreatePullRequestFromIssue{},
+		&MergeBranch{},
+		&CheckActionsSecret{},
+		&CreateAnnotation{},
+		&ListNotifications{},
+		&MarkNotificationRead{},
+		&GetPullRequestDiff{},
+		&CreateSignedCommit{},
+		&ListSelfHostedRunners{},
+		&SearchCode{},
+		&WaitForMergeable{},
+		&CreateRepositoryWebhook{},
+		&ListAuditLog{},
+		&MigrateLabel{},
+		&ListPullRequestCommits{},
+		&SetLabels{},
+		&SetRepositoryTopics{},
+		&CreateOrUpdateEnvironment{},
+		&ApproveDeployment{},
+		&ProcessStaleIssues{},
+		&ListOrgMembers{},
+		&RevokeInstallationToken{},
+		&GenerateChangelog{},
+		&GenerateReleaseNotes{},
+		&BatchGetFiles{},
+		&WaitForRequiredChecks{},
+		&ListPendingInvitations{},
+		&CancelInvitation{},
+		&RequestCopilotReview{},
+		&BulkLockConversations{},
+		&GetLatestSuccessfulRun{},
+		&CreateReleaseWithAssets{},
+		&GetPullRequestByBranch{},
+		&DispatchAndAcknowledge{},
+		&ListOrgRepositories{},
+		&SetCommitStatuses{},
+		&FindCodeOwners{},
+		&SummarizePullRequest{},
+		&DetectConflictingPullRequests{},
+		&PostDeploymentSummary{},
 		&PublishCommitStatus{},
+		&CreateTag{},
 		&CreateRelease{},
 		&GetRelease{},
+		&GetLatestRelease{},
+		&GetTree{},
+		&GetUser{},
+		&InviteCollaborator{},
+		&BlockUser{},
+		&AddDiscussionComment{},
+		&CreateTeamDiscussion{},
 		&UpdateRelease{},
 		&DeleteRelease{},
+		&ListSecretScanningAlerts{},
+		&UpdateSecretScanningAlert{},
+		&ListDependabotAlerts{},
+		&DismissDependabotAlert{},
+	}
+
+	if webhookReplayEnabled() {
+		components = append(components, &ReplayWebhook{})
 	}
+
+	return components
 }
 
 func (g *GitHub) Triggers() []core.Trigger {
@@ -116,6 +229,7 @@ func (g *GitHub) Triggers() []core.Trigger {
 		&OnTagCreated{},
 		&OnBranchCreated{},
 		&OnWorkflowRun{},
+		&WebhookRouter{},
 	}
 }
 
@@ -153,7 +267,7 @@ func (g *GitHub) Sync(ctx core.SyncContext) error {
 		URL:         g.browserActionURL(config.Organization),
 		Method:      "POST",
 		FormFields: map[string]string{
-			"manifest": g.appManifest(ctx),
+			"manifest": g.appManifest(ctx, config),
 			"state":    state,
 		},
 	})
@@ -615,21 +729,14 @@ func (g *GitHub) browserActionURL(organization string) string {
 	return "https://github.com/settings/apps/new"
 }
 
-func (g *GitHub) appManifest(ctx core.SyncContext) string {
+func (g *GitHub) appManifest(ctx core.SyncContext, config Configuration) string {
 	manifest := map[string]any{
-		"name":   `SuperPlane GH integration`,
-		"public": false,
-		"url":    "https://superplane.com",
-		"default_permissions": map[string]string{
-			"issues":           "write",
-			"actions":          "write",
-			"contents":         "write",
-			"pull_requests":    "write",
-			"repository_hooks": "write",
-			"statuses":         "write",
-		},
-		"setup_url":    fmt.Sprintf(`%s/api/v1/integrations/%s/setup`, ctx.BaseURL, ctx.Integration.ID().String()),
-		"redirect_url": fmt.Sprintf(`%s/api/v1/integrations/%s/redirect`, ctx.BaseURL, ctx.Integration.ID().String()),
+		"name":                `SuperPlane GH integration`,
+		"public":              false,
+		"url":                 "https://superplane.com",
+		"default_permissions": manifestPermissions(config),
+		"setup_url":           fmt.Sprintf(`%s/api/v1/integrations/%s/setup`, ctx.BaseURL, ctx.Integration.ID().String()),
+		"redirect_url":        fmt.Sprintf(`%s/api/v1/integrations/%s/redirect`, ctx.BaseURL, ctx.Integration.ID().String()),
 		"hook_attributes": map[string]any{
 			"url": fmt.Sprintf(`%s/api/v1/integrations/%s/webhook`, ctx.WebhooksBaseURL, ctx.Integration.ID().String()),
 		},
@@ -686,9 +793,21 @@ func (g *GitHub) createAppFromManifest(httpCtx core.HTTPContext, code string) (*
 }
 
 func (g *GitHub) Actions() []core.Action {
-	return []core.Action{}
+	return []core.Action{
+		{
+			Name:           "checkHealth",
+			Description:    "Check that the GitHub app installation can still access GitHub",
+			UserAccessible: true,
+		},
+	}
 }
 
 func (g *GitHub) HandleAction(ctx core.IntegrationActionContext) error {
-	return nil
+	switch ctx.Name {
+	case "checkHealth":
+		return g.checkHealth(ctx)
+
+	default:
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
 }
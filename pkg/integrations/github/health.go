@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/google/go-github/v74/github"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * HealthCheck verifies that the GitHub app installation is still usable,
+ * by minting an installation token and listing a single repository.
+ * Installations that were deleted, suspended, or whose app credentials
+ * are no longer accepted return an error wrapping ErrInstallationRevoked.
+ */
+func (g *GitHub) HealthCheck(integration core.IntegrationContext) error {
+	return healthCheck(integration, http.DefaultTransport)
+}
+
+func healthCheck(integration core.IntegrationContext, transport http.RoundTripper) error {
+	metadata := Metadata{}
+	if err := mapstructure.Decode(integration.GetMetadata(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if metadata.InstallationID == "" {
+		return errors.New("GitHub app is not installed yet")
+	}
+
+	client, err := newClient(integration, transport, metadata.GitHubApp.ID, metadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	_, _, err = client.Apps.ListRepos(context.Background(), &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return installationError(err, metadata.InstallationID)
+	}
+
+	return nil
+}
+
+/*
+ * Errors minting the installation token come from the installation transport,
+ * before go-github sees any response, so they are not *github.ErrorResponse.
+ */
+func installationError(err error, installationID string) error {
+	var tokenErr *ghinstallation.HTTPError
+	if !errors.As(err, &tokenErr) || tokenErr.Response == nil {
+		return fmt.Errorf("health check failed: %w", wrapGitHubError(err))
+	}
+
+	switch tokenErr.Response.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: installation %s no longer exists: %w", ErrInstallationRevoked, installationID, err)
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: installation %s is suspended: %w", ErrInstallationRevoked, installationID, err)
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%w: GitHub app credentials were rejected: %w", ErrInstallationRevoked, err)
+	}
+
+	return fmt.Errorf("health check failed: %w", err)
+}
+
+func (g *GitHub) checkHealth(ctx core.IntegrationActionContext) error {
+	err := g.HealthCheck(ctx.Integration)
+	if err != nil {
+		ctx.Logger.Warnf("GitHub health check failed: %v", err)
+		ctx.Integration.Error(err.Error())
+		return nil
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__HealthCheck(t *testing.T) {
+	tokenTransport := func(token *http.Response) *mockTransport {
+		return &mockTransport{
+			handler: func(request *http.Request) (*http.Response, error) {
+				switch request.URL.Path {
+				case testInstallationTokenPath:
+					return token, nil
+				case "/installation/repositories":
+					return mockResponse(http.StatusOK, `{"total_count":1,"repositories":[{"name":"hello"}]}`), nil
+				default:
+					return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+				}
+			},
+		}
+	}
+
+	t.Run("app not installed -> error", func(t *testing.T) {
+		err := healthCheck(&contexts.IntegrationContext{Metadata: Metadata{}}, tokenTransport(mockResponse(http.StatusCreated, "{}")))
+		require.ErrorContains(t, err, "not installed")
+	})
+
+	t.Run("valid installation -> no error", func(t *testing.T) {
+		transport := tokenTransport(mockInstallationToken())
+
+		require.NoError(t, healthCheck(testInstallation(t, ""), transport))
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "token ghs_test", transport.requests[1].Header.Get("Authorization"))
+	})
+
+	t.Run("deleted installation -> installation revoked", func(t *testing.T) {
+		transport := tokenTransport(mockResponse(http.StatusNotFound, `{"message":"Not Found"}`))
+
+		err := healthCheck(testInstallation(t, ""), transport)
+		require.ErrorIs(t, err, ErrInstallationRevoked)
+		assert.Contains(t, err.Error(), "installation 123 no longer exists")
+		require.Len(t, transport.requests, 1)
+	})
+
+	t.Run("suspended installation -> installation revoked", func(t *testing.T) {
+		err := healthCheck(testInstallation(t, ""), tokenTransport(mockResponse(http.StatusForbidden, `{"message":"This installation has been suspended"}`)))
+		require.ErrorIs(t, err, ErrInstallationRevoked)
+		assert.Contains(t, err.Error(), "suspended")
+	})
+
+	t.Run("rejected app credentials -> installation revoked", func(t *testing.T) {
+		err := healthCheck(testInstallation(t, ""), tokenTransport(mockResponse(http.StatusUnauthorized, `{"message":"Bad credentials"}`)))
+		require.ErrorIs(t, err, ErrInstallationRevoked)
+	})
+
+	t.Run("server error -> not revoked", func(t *testing.T) {
+		err := healthCheck(testInstallation(t, ""), tokenTransport(mockResponse(http.StatusBadGateway, `{}`)))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrInstallationRevoked)
+	})
+}
+
+func Test__GitHub__CheckHealthAction(t *testing.T) {
+	t.Run("unknown action -> error", func(t *testing.T) {
+		err := (&GitHub{}).HandleAction(core.IntegrationActionContext{Name: "unknown"})
+		require.ErrorContains(t, err, "unknown action")
+	})
+
+	t.Run("failed health check -> integration error state", func(t *testing.T) {
+		integration := &contexts.IntegrationContext{Metadata: Metadata{}}
+		err := (&GitHub{}).HandleAction(core.IntegrationActionContext{
+			Name:        "checkHealth",
+			Integration: integration,
+			Logger:      log.NewEntry(log.New()),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "error", integration.State)
+		assert.Contains(t, integration.StateDescription, "not installed")
+	})
+}
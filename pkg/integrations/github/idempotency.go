@@ -0,0 +1,137 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * The result of the last side effect is also recorded in the execution metadata,
+ * so actions on a finished execution can tell what it did.
+ */
+type IdempotencyMetadata struct {
+	Idempotency *IdempotencyRecord `json:"idempotency,omitempty" mapstructure:"idempotency"`
+}
+
+type IdempotencyRecord struct {
+	Key    string `json:"key" mapstructure:"key"`
+	Result any    `json:"result,omitempty" mapstructure:"result"`
+}
+
+/*
+ * withIdempotency wraps a mutating GitHub API call so that retries
+ * of the same execution do not repeat the side effect.
+ *
+ * Executions run inside a database transaction, which is rolled back
+ * if the process dies before it commits. So the key and the result are not
+ * recorded with the rest of the execution, but through ctx.Idempotency,
+ * which commits them right away:
+ *
+ * - Before calling the API, a deterministic key derived from the node ID
+ *   and the resolved configuration is recorded.
+ * - After a successful call, the result is recorded under that key.
+ * - If an attempt of the same execution finds a result recorded for the key,
+ *   that result is emitted again, and the API is not called.
+ *
+ * NOTE: GitHub has no native idempotency support for endpoints like
+ * issue comments. If the API call succeeds but the process dies before
+ * the result is recorded, the next attempt only finds the key, not the result,
+ * so it has no way of knowing whether the first call went through.
+ * The call is repeated, and a duplicate is possible. That attempt logs a warning.
+ */
+func withIdempotency(ctx core.ExecutionContext, payloadType string, call func() (any, error)) error {
+	key, err := idempotencyKey(ctx.NodeID, ctx.Configuration)
+	if err != nil {
+		return fmt.Errorf("failed to build idempotency key: %w", err)
+	}
+
+	claim, err := ctx.Idempotency.Claim(key)
+	if err != nil {
+		return fmt.Errorf("failed to record idempotency key: %w", err)
+	}
+
+	if claim.Result != nil {
+		ctx.Logger.Infof("Found result for idempotency key %s - re-emitting it", key)
+		return emitIdempotentResult(ctx, payloadType, key, claim.Result)
+	}
+
+	if claim.Existing {
+		ctx.Logger.Warnf("An earlier attempt recorded idempotency key %s, but no result - calling GitHub again, which may cause a duplicate", key)
+	}
+
+	result, err := call()
+	if err != nil {
+		return err
+	}
+
+	//
+	// The side effect already happened, so failing the execution now
+	// would only hide its result. A retry would repeat it either way.
+	//
+	if err := ctx.Idempotency.Complete(key, result); err != nil {
+		ctx.Logger.Warnf("Failed to record result for idempotency key %s: %v", key, err)
+	}
+
+	return emitIdempotentResult(ctx, payloadType, key, result)
+}
+
+func emitIdempotentResult(ctx core.ExecutionContext, payloadType, key string, result any) error {
+	err := setIdempotencyRecord(ctx.Metadata, IdempotencyRecord{Key: key, Result: result})
+	if err != nil {
+		return fmt.Errorf("failed to record idempotency result: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, payloadType, []any{result})
+}
+
+/*
+ * Metadata is stored as JSON, so the current metadata and the record
+ * are merged as JSON objects, whatever type the component used to set them.
+ */
+func setIdempotencyRecord(metadata core.MetadataContext, record IdempotencyRecord) error {
+	merged := map[string]any{}
+	if current := metadata.Get(); current != nil {
+		if err := jsonRoundTrip(current, &merged); err != nil {
+			return fmt.Errorf("failed to merge with current metadata: %w", err)
+		}
+	}
+
+	var recordMap map[string]any
+	if err := jsonRoundTrip(record, &recordMap); err != nil {
+		return err
+	}
+
+	merged["idempotency"] = recordMap
+	return metadata.Set(merged)
+}
+
+func jsonRoundTrip(value any, target any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, target)
+}
+
+/*
+ * The configuration received by Execute() already has all expressions resolved,
+ * and json.Marshal sorts map keys, so the same node and inputs always produce the same key.
+ */
+func idempotencyKey(nodeID string, configuration any) (string, error) {
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(nodeID))
+	hash.Write([]byte{0})
+	hash.Write(data)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
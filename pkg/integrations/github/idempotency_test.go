@@ -0,0 +1,174 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__IdempotencyKey(t *testing.T) {
+	t.Run("same node and configuration produce the same key", func(t *testing.T) {
+		a, err := idempotencyKey("node-1", map[string]any{"repository": "hello", "title": "Bug"})
+		require.NoError(t, err)
+		b, err := idempotencyKey("node-1", map[string]any{"title": "Bug", "repository": "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("different node produces a different key", func(t *testing.T) {
+		a, err := idempotencyKey("node-1", map[string]any{"repository": "hello"})
+		require.NoError(t, err)
+		b, err := idempotencyKey("node-2", map[string]any{"repository": "hello"})
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("different configuration produces a different key", func(t *testing.T) {
+		a, err := idempotencyKey("node-1", map[string]any{"title": "Bug"})
+		require.NoError(t, err)
+		b, err := idempotencyKey("node-1", map[string]any{"title": "Feature"})
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+}
+
+func Test__WithIdempotency(t *testing.T) {
+	configuration := map[string]any{"repository": "hello", "title": "Bug"}
+
+	newContext := func(idempotency *contexts.IdempotencyContext) (core.ExecutionContext, *contexts.ExecutionStateContext, *contexts.MetadataContext) {
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		metadata := &contexts.MetadataContext{}
+		return core.ExecutionContext{
+			NodeID:         "node-1",
+			Configuration:  configuration,
+			Logger:         logrus.NewEntry(logrus.New()),
+			ExecutionState: state,
+			Metadata:       metadata,
+			Idempotency:    idempotency,
+		}, state, metadata
+	}
+
+	t.Run("calls API, records key and result, and emits", func(t *testing.T) {
+		idempotency := &contexts.IdempotencyContext{}
+		ctx, state, metadata := newContext(idempotency)
+		calls := 0
+		err := withIdempotency(ctx, "github.issue", func() (any, error) {
+			calls++
+			assert.Len(t, idempotency.Records, 1, "key must be recorded before the API is called")
+			return map[string]any{"number": 42}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.True(t, state.Passed)
+		assert.Equal(t, "github.issue", state.Type)
+		require.Len(t, state.Payloads, 1)
+
+		key, err := idempotencyKey("node-1", configuration)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"number": 42}, idempotency.Records[key])
+
+		recorded := IdempotencyMetadata{}
+		require.NoError(t, mapstructure.Decode(metadata.Get(), &recorded))
+		require.NotNil(t, recorded.Idempotency)
+		assert.Equal(t, key, recorded.Idempotency.Key)
+		assert.Equal(t, map[string]any{"number": float64(42)}, recorded.Idempotency.Result)
+	})
+
+	t.Run("metadata recorded by the component is kept", func(t *testing.T) {
+		ctx, _, metadata := newContext(&contexts.IdempotencyContext{})
+		require.NoError(t, metadata.Set(IssueCommentMetadata{Request: &IssueCommentRequest{Repository: "hello", IssueNumber: 42}}))
+
+		err := withIdempotency(ctx, "github.issueComment", func() (any, error) {
+			return map[string]any{"id": 1}, nil
+		})
+
+		require.NoError(t, err)
+
+		recorded := IssueCommentMetadata{}
+		require.NoError(t, mapstructure.Decode(metadata.Get(), &recorded))
+		require.NotNil(t, recorded.Request)
+		assert.Equal(t, "hello", recorded.Request.Repository)
+		assert.Equal(t, 42, recorded.Request.IssueNumber)
+		require.NotNil(t, recorded.Idempotency)
+		assert.NotEmpty(t, recorded.Idempotency.Key)
+	})
+
+	t.Run("attempt after a rolled back one re-emits its result without calling the API", func(t *testing.T) {
+		idempotency := &contexts.IdempotencyContext{}
+		ctx, _, _ := newContext(idempotency)
+		require.NoError(t, withIdempotency(ctx, "github.issue", func() (any, error) {
+			return map[string]any{"number": 42}, nil
+		}))
+
+		//
+		// The records are kept, but the execution state and metadata
+		// of the first attempt are gone, as if its transaction was rolled back.
+		//
+		ctx, state, metadata := newContext(idempotency)
+		err := withIdempotency(ctx, "github.issue", func() (any, error) {
+			t.Fatal("API should not be called")
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+		assert.True(t, state.Passed)
+		require.Len(t, state.Payloads, 1)
+		assert.Equal(t, map[string]any{"number": 42}, state.Payloads[0].(map[string]any)["data"])
+		assert.NotNil(t, metadata.Get())
+	})
+
+	t.Run("key recorded without a result -> API is called again", func(t *testing.T) {
+		key, err := idempotencyKey("node-1", configuration)
+		require.NoError(t, err)
+
+		idempotency := &contexts.IdempotencyContext{Records: map[string]any{key: nil}}
+		ctx, state, _ := newContext(idempotency)
+
+		calls := 0
+		err = withIdempotency(ctx, "github.issue", func() (any, error) {
+			calls++
+			return map[string]any{"number": 43}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, map[string]any{"number": 43}, state.Payloads[0].(map[string]any)["data"])
+		assert.Equal(t, map[string]any{"number": 43}, idempotency.Records[key])
+	})
+
+	t.Run("result recorded for a different key is ignored", func(t *testing.T) {
+		ctx, state, _ := newContext(&contexts.IdempotencyContext{Records: map[string]any{"other": map[string]any{"number": 1}}})
+
+		calls := 0
+		err := withIdempotency(ctx, "github.issue", func() (any, error) {
+			calls++
+			return map[string]any{"number": 42}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, map[string]any{"number": 42}, state.Payloads[0].(map[string]any)["data"])
+	})
+
+	t.Run("API error is returned and nothing is emitted", func(t *testing.T) {
+		idempotency := &contexts.IdempotencyContext{}
+		ctx, state, metadata := newContext(idempotency)
+		err := withIdempotency(ctx, "github.issue", func() (any, error) {
+			return nil, errors.New("boom")
+		})
+
+		require.ErrorContains(t, err, "boom")
+		assert.False(t, state.Finished)
+		assert.Nil(t, metadata.Get())
+		for _, result := range idempotency.Records {
+			assert.Nil(t, result)
+		}
+	})
+}
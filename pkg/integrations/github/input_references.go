@@ -0,0 +1,157 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
+)
+
+var inputExpressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+/*
+ * The names expressions can reference when they are resolved:
+ * the message chain, the blueprint configuration, the root() and previous() functions,
+ * and the GitHub functions from template.GitHubFuncs.
+ * Anything else resolves to nothing, so a typo like {{ eventt.number }} becomes an empty value.
+ */
+var inputExpressionReferences = map[string]bool{
+	"$":        true,
+	"config":   true,
+	"root":     true,
+	"previous": true,
+}
+
+/*
+ * Parses the expressions of the fields declared as inputs,
+ * and returns an error naming the first reference that expressions can't resolve.
+ *
+ * When the upstream payloads are known, references to the message chain are checked too:
+ * $["Node name"] must be a node upstream of this one,
+ * and the field read from its payload must be in the payload of the node.
+ * Fields deeper than that are not checked, since example payloads do not list every field.
+ */
+func validateInputReferences(inputs []core.InputField, configuration any, upstream map[string]any) error {
+	values, ok := configuration.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	for _, input := range inputs {
+		value, ok := values[input.Name].(string)
+		if !ok {
+			continue
+		}
+
+		for _, match := range inputExpressionRegex.FindAllStringSubmatch(value, -1) {
+			err := validateExpressionReferences(match[1], upstream)
+			if err != nil {
+				return fmt.Errorf("%s: %w in expression %q", input.Name, err, match[0])
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateExpressionReferences(expression string, upstream map[string]any) error {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+
+	collector := &expressionReferenceCollector{declared: map[string]bool{}}
+	ast.Walk(&tree.Node, collector)
+
+	for _, reference := range collector.references {
+		if !inputExpressionReferences[reference] && template.GitHubFuncs[reference] == nil && !collector.declared[reference] {
+			return fmt.Errorf("unknown reference %q", reference)
+		}
+	}
+
+	if upstream == nil {
+		return nil
+	}
+
+	for _, path := range collector.chainPaths {
+		payload, ok := upstream[path[0]]
+		if !ok {
+			return fmt.Errorf("unknown node %q", path[0])
+		}
+
+		fields, _ := payload.(map[string]any)
+		if len(path) < 2 || len(fields) == 0 {
+			continue
+		}
+
+		if _, ok := fields[path[1]]; !ok {
+			return fmt.Errorf("unknown field %q for node %q", path[1], path[0])
+		}
+	}
+
+	return nil
+}
+
+/*
+ * Collects the identifiers an expression references.
+ * Property names are not identifiers in the parsed tree, so only root names are collected,
+ * along with the names declared with let, which are valid references too.
+ * For the properties read from the message chain, like $["Node name"].data,
+ * the path of constant property names is collected too.
+ */
+type expressionReferenceCollector struct {
+	references []string
+	declared   map[string]bool
+	chainPaths [][]string
+}
+
+func (c *expressionReferenceCollector) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IdentifierNode:
+		c.references = append(c.references, n.Value)
+	case *ast.VariableDeclaratorNode:
+		c.declared[n.Name] = true
+	case *ast.MemberNode:
+		path, ok := messageChainPath(n)
+		if ok {
+			c.chainPaths = append(c.chainPaths, path)
+		}
+	}
+}
+
+/*
+ * Returns the property names of a member chain starting at $.
+ * Chains with properties that are not constant, like $[name], are not returned.
+ */
+func messageChainPath(member *ast.MemberNode) ([]string, bool) {
+	var property string
+	switch p := member.Property.(type) {
+	case *ast.StringNode:
+		property = p.Value
+	case *ast.IdentifierNode:
+		property = p.Value
+	default:
+		return nil, false
+	}
+
+	switch parent := member.Node.(type) {
+	case *ast.IdentifierNode:
+		if parent.Value != "$" {
+			return nil, false
+		}
+
+		return []string{property}, true
+	case *ast.MemberNode:
+		path, ok := messageChainPath(parent)
+		if !ok {
+			return nil, false
+		}
+
+		return append(path, property), true
+	}
+
+	return nil, false
+}
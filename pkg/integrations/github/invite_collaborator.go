@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	CollaboratorStatusInvited   = "invited"
+	CollaboratorStatusUpdated   = "updated"
+	CollaboratorStatusUnchanged = "unchanged"
+)
+
+var collaboratorPermissions = []string{"pull", "triage", "push", "maintain", "admin"}
+
+type InviteCollaborator struct{}
+
+type InviteCollaboratorConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Username   string `json:"username" mapstructure:"username"`
+	Permission string `json:"permission" mapstructure:"permission"`
+}
+
+type CollaboratorOutput struct {
+	Username      string `json:"username"`
+	Permission    string `json:"permission"`
+	Status        string `json:"status"`
+	InvitationID  int64  `json:"invitation_id,omitempty"`
+	InvitationURL string `json:"invitation_url,omitempty"`
+}
+
+func (c *InviteCollaborator) Name() string {
+	return "github.inviteCollaborator"
+}
+
+func (c *InviteCollaborator) Label() string {
+	return "Invite Collaborator"
+}
+
+func (c *InviteCollaborator) Description() string {
+	return "Invite a user to collaborate on a GitHub repository"
+}
+
+func (c *InviteCollaborator) Documentation() string {
+	return `The Invite Collaborator component invites a user to collaborate on a GitHub repository, or updates their permission if they already are a collaborator.
+
+## Use Cases
+
+- **Onboarding**: Give new team members access to the repositories they work on
+- **Access changes**: Promote or demote collaborators after an approval step
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Username**: The GitHub username of the user (supports expressions)
+- **Permission**: The permission to grant: pull, triage, push, maintain, or admin
+
+## Output
+
+Emits the ` + "`username`" + `, ` + "`permission`" + `, and a ` + "`status`" + `:
+
+- **invited**: The user was not a collaborator, and an invitation was sent. The ` + "`invitation_id`" + ` is included
+- **updated**: The user was a collaborator with a different permission, and the permission was updated
+- **unchanged**: The user already was a collaborator with the same permission, so nothing was done
+
+## Notes
+
+- Requires the GitHub integration to be set up with **Repository Administration** enabled`
+}
+
+func (c *InviteCollaborator) Icon() string {
+	return "github"
+}
+
+func (c *InviteCollaborator) Color() string {
+	return "gray"
+}
+
+func (c *InviteCollaborator) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *InviteCollaborator) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "username",
+			Label:       "Username",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., octocat",
+		},
+		{
+			Name:     "permission",
+			Label:    "Permission",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "push",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Pull", Value: "pull"},
+						{Label: "Triage", Value: "triage"},
+						{Label: "Push", Value: "push"},
+						{Label: "Maintain", Value: "maintain"},
+						{Label: "Admin", Value: "admin"},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *InviteCollaborator) Setup(ctx core.SetupContext) error {
+	var config InviteCollaboratorConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Username == "" {
+		return errors.New("username is required")
+	}
+
+	if !slices.Contains(collaboratorPermissions, config.Permission) {
+		return fmt.Errorf("invalid permission %q: must be one of %v", config.Permission, collaboratorPermissions)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *InviteCollaborator) Execute(ctx core.ExecutionContext) error {
+	var config InviteCollaboratorConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if !slices.Contains(collaboratorPermissions, config.Permission) {
+		return fmt.Errorf("invalid permission %q: must be one of %v", config.Permission, collaboratorPermissions)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.collaborator", func() (any, error) {
+		output := CollaboratorOutput{Username: config.Username, Permission: config.Permission}
+		isCollaborator, _, err := client.Repositories.IsCollaborator(context.Background(), appMetadata.Owner, config.Repository, config.Username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check collaborator: %w", wrapGitHubError(err))
+		}
+
+		if isCollaborator {
+			level, _, err := client.Repositories.GetPermissionLevel(context.Background(), appMetadata.Owner, config.Repository, config.Username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get permission level: %w", wrapGitHubError(err))
+			}
+
+			if level.GetRoleName() == collaboratorRoleName(config.Permission) {
+				ctx.Logger.Infof("%s already has %s permission on %s", config.Username, config.Permission, config.Repository)
+				output.Status = CollaboratorStatusUnchanged
+				return output, nil
+			}
+		}
+
+		invitation, response, err := client.Repositories.AddCollaborator(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			config.Username,
+			&github.RepositoryAddCollaboratorOptions{Permission: config.Permission},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to add collaborator: %w", wrapGitHubError(err))
+		}
+
+		//
+		// GitHub only creates an invitation for users who are not collaborators yet.
+		// For existing collaborators, the permission is updated, and 204 is returned.
+		//
+		if response.StatusCode == http.StatusNoContent {
+			output.Status = CollaboratorStatusUpdated
+			return output, nil
+		}
+
+		output.Status = CollaboratorStatusInvited
+		output.InvitationID = invitation.GetID()
+		output.InvitationURL = invitation.GetHTMLURL()
+		return output, nil
+	})
+}
+
+/*
+ * The permission level endpoint uses role names,
+ * which differ from the permission names for pull and push.
+ */
+func collaboratorRoleName(permission string) string {
+	switch permission {
+	case "pull":
+		return "read"
+	case "push":
+		return "write"
+	}
+
+	return permission
+}
+
+func (c *InviteCollaborator) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *InviteCollaborator) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *InviteCollaborator) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *InviteCollaborator) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *InviteCollaborator) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *InviteCollaborator) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__InviteCollaborator__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := InviteCollaborator{}
+
+	t.Run("username is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "permission": "push"},
+		})
+
+		require.ErrorContains(t, err, "username is required")
+	})
+
+	t.Run("invalid permission -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "username": "octocat", "permission": "write"},
+		})
+
+		require.ErrorContains(t, err, "invalid permission")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "username": "octocat", "permission": "maintain"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__InviteCollaborator__RoleName(t *testing.T) {
+	assert.Equal(t, "read", collaboratorRoleName("pull"))
+	assert.Equal(t, "write", collaboratorRoleName("push"))
+	assert.Equal(t, "triage", collaboratorRoleName("triage"))
+	assert.Equal(t, "maintain", collaboratorRoleName("maintain"))
+	assert.Equal(t, "admin", collaboratorRoleName("admin"))
+}
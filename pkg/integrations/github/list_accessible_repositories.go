@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListAccessibleRepositories struct{}
+
+type ListAccessibleRepositoriesConfiguration struct {
+	NameFilter string `json:"nameFilter" mapstructure:"nameFilter"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type AccessibleRepository struct {
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+	Private       bool   `json:"private"`
+	Archived      bool   `json:"archived"`
+	URL           string `json:"html_url"`
+}
+
+func (c *ListAccessibleRepositories) Name() string {
+	return "github.listAccessibleRepositories"
+}
+
+func (c *ListAccessibleRepositories) Label() string {
+	return "List Accessible Repositories"
+}
+
+func (c *ListAccessibleRepositories) Description() string {
+	return "List the GitHub repositories the GitHub app can access"
+}
+
+func (c *ListAccessibleRepositories) Documentation() string {
+	return `The List Accessible Repositories component lists every repository the GitHub app installation can access.
+
+## Use Cases
+
+- **Fan-out**: Run the same steps on every repository, for example to open dependency update pull requests
+- **Inventory**: Report on the repositories managed by SuperPlane
+
+## Configuration
+
+- **Name Filter**: Optional glob matched against the repository name, for example ` + "`service-*`" + `
+- **Emit Mode**: Emit all repositories in a single event, one event per repository, or one event per chunk of repositories
+- **Chunk Size**: Number of repositories in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each repository includes its ` + "`name`" + `, ` + "`full_name`" + `, and ` + "`default_branch`" + `.
+
+- **Batch** mode emits a single event with the list of repositories in ` + "`repositories`" + `
+- **Per item** mode emits one ` + "`github.repository`" + ` event for each repository. If no repositories match, no events are emitted
+- **Chunked** mode emits one ` + "`github.repositories`" + ` event for each chunk, with its repositories in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + ``
+}
+
+func (c *ListAccessibleRepositories) Icon() string {
+	return "github"
+}
+
+func (c *ListAccessibleRepositories) Color() string {
+	return "gray"
+}
+
+func (c *ListAccessibleRepositories) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListAccessibleRepositories) EventTypes() []string {
+	return []string{"github.repositories", "github.repository"}
+}
+
+func (c *ListAccessibleRepositories) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "nameFilter",
+			Label:       "Name Filter",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., service-*",
+			Description: "Only include repositories whose name matches this glob",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListAccessibleRepositories) Setup(ctx core.SetupContext) error {
+	var config ListAccessibleRepositoriesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	if _, err := path.Match(config.NameFilter, ""); err != nil {
+		return fmt.Errorf("invalid name filter %q: %w", config.NameFilter, err)
+	}
+
+	return nil
+}
+
+func (c *ListAccessibleRepositories) Execute(ctx core.ExecutionContext) error {
+	var config ListAccessibleRepositoriesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	opts := &github.ListOptions{PerPage: 100}
+	repositories := []AccessibleRepository{}
+	for {
+		page, response, err := client.Apps.ListRepos(context.Background(), opts)
+		if err != nil {
+			return fmt.Errorf("failed to list repositories: %w", wrapGitHubError(err))
+		}
+
+		matching, err := filterAccessibleRepositories(page.Repositories, config.NameFilter)
+		if err != nil {
+			return err
+		}
+
+		repositories = append(repositories, matching...)
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	payloads := make([]any, 0, len(repositories))
+	for _, repository := range repositories {
+		payloads = append(payloads, repository)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.repository", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.repositories", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.repositories",
+		[]any{map[string]any{"repositories": repositories}},
+	)
+}
+
+func filterAccessibleRepositories(repositories []*github.Repository, nameFilter string) ([]AccessibleRepository, error) {
+	result := []AccessibleRepository{}
+	for _, repository := range repositories {
+		if nameFilter != "" {
+			match, err := path.Match(nameFilter, repository.GetName())
+			if err != nil {
+				return nil, fmt.Errorf("invalid name filter %q: %w", nameFilter, err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		result = append(result, AccessibleRepository{
+			Name:          repository.GetName(),
+			FullName:      repository.GetFullName(),
+			DefaultBranch: repository.GetDefaultBranch(),
+			Private:       repository.GetPrivate(),
+			Archived:      repository.GetArchived(),
+			URL:           repository.GetHTMLURL(),
+		})
+	}
+
+	return result, nil
+}
+
+func (c *ListAccessibleRepositories) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListAccessibleRepositories) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListAccessibleRepositories) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListAccessibleRepositories) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListAccessibleRepositories) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListAccessibleRepositories) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListAccessibleRepositories__Setup(t *testing.T) {
+	component := ListAccessibleRepositories{}
+
+	t.Run("no configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{},
+		}))
+	})
+
+	t.Run("invalid name filter -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"nameFilter": "service-["},
+		})
+
+		require.ErrorContains(t, err, "invalid name filter")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+}
+
+func Test__ListAccessibleRepositories__Filter(t *testing.T) {
+	repositories := []*github.Repository{
+		{Name: github.Ptr("service-api"), FullName: github.Ptr("acme/service-api"), DefaultBranch: github.Ptr("main")},
+		{Name: github.Ptr("service-web"), FullName: github.Ptr("acme/service-web"), DefaultBranch: github.Ptr("trunk")},
+		{Name: github.Ptr("docs"), FullName: github.Ptr("acme/docs"), DefaultBranch: github.Ptr("main")},
+	}
+
+	t.Run("no filter -> all repositories", func(t *testing.T) {
+		result, err := filterAccessibleRepositories(repositories, "")
+		require.NoError(t, err)
+		assert.Len(t, result, 3)
+	})
+
+	t.Run("glob filter -> matching repositories", func(t *testing.T) {
+		result, err := filterAccessibleRepositories(repositories, "service-*")
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "acme/service-api", result[0].FullName)
+		assert.Equal(t, "trunk", result[1].DefaultBranch)
+	})
+
+	t.Run("no matches -> empty list", func(t *testing.T) {
+		result, err := filterAccessibleRepositories(repositories, "infra-*")
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
@@ -0,0 +1,392 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	AuditLogScopeOrganization = "organization"
+	AuditLogScopeEnterprise   = "enterprise"
+	DefaultAuditLogLimit      = 100
+	MaxAuditLogLimit          = 10000
+)
+
+type ListAuditLog struct{}
+
+type ListAuditLogConfiguration struct {
+	Scope      string `json:"scope" mapstructure:"scope"`
+	Enterprise string `json:"enterprise" mapstructure:"enterprise"`
+	Phrase     string `json:"phrase" mapstructure:"phrase"`
+	Since      string `json:"since" mapstructure:"since"`
+	Until      string `json:"until" mapstructure:"until"`
+	Limit      *int   `json:"limit" mapstructure:"limit"`
+}
+
+type AuditLogEntry struct {
+	DocumentID string         `json:"document_id"`
+	Action     string         `json:"action"`
+	Actor      string         `json:"actor,omitempty"`
+	User       string         `json:"user,omitempty"`
+	Org        string         `json:"org,omitempty"`
+	Business   string         `json:"business,omitempty"`
+	CreatedAt  string         `json:"created_at,omitempty"`
+	Country    string         `json:"actor_country_code,omitempty"`
+	Fields     map[string]any `json:"fields,omitempty"`
+}
+
+func (c *ListAuditLog) Name() string {
+	return "github.listAuditLog"
+}
+
+func (c *ListAuditLog) Label() string {
+	return "List Audit Log"
+}
+
+func (c *ListAuditLog) Description() string {
+	return "List audit log events of a GitHub organization or enterprise"
+}
+
+func (c *ListAuditLog) Documentation() string {
+	return `The List Audit Log component lists the audit log events of the organization, or of an enterprise, and emits one event for each entry.
+
+## Use Cases
+
+- **Security monitoring**: Alert on sensitive actions, like repositories made public or owners added
+- **Compliance exports**: Copy audit events to long-term storage
+
+## Configuration
+
+- **Scope**: List the audit log of the organization, or of an enterprise
+- **Enterprise**: The enterprise slug, for the enterprise scope
+- **Phrase**: Audit log search phrase, like ` + "`action:repo.create actor:octocat`" + `
+- **Since**: Only list events at or after this time, in RFC 3339 format (supports expressions)
+- **Until**: Only list events at or before this time, in RFC 3339 format (supports expressions)
+- **Limit**: Maximum number of events to list. Defaults to 100
+
+## Output
+
+Emits one ` + "`github.auditLogEntry`" + ` event for each entry, newest first, with its ` + "`document_id`" + `, ` + "`action`" + `, ` + "`actor`" + `, ` + "`user`" + `, ` + "`org`" + `, ` + "`business`" + `, ` + "`created_at`" + ` and ` + "`actor_country_code`" + `.
+The fields specific to the action, like ` + "`repo`" + `, are in ` + "`fields`" + `. If no entries are found, no events are emitted.
+
+## Notes
+
+- The audit log is only available on GitHub Enterprise Cloud
+- The organization audit log needs the **Administration** organization read permission.
+  The enterprise audit log needs a token with the ` + "`read:audit_log`" + ` scope, which GitHub app installations do not have.
+  Without them, the execution fails with an error naming the missing access
+- **Since** and **Until** are added to the phrase as a ` + "`created`" + ` qualifier`
+}
+
+func (c *ListAuditLog) Icon() string {
+	return "github"
+}
+
+func (c *ListAuditLog) Color() string {
+	return "gray"
+}
+
+func (c *ListAuditLog) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListAuditLog) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "scope",
+			Label:    "Scope",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  AuditLogScopeOrganization,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Organization", Value: AuditLogScopeOrganization},
+						{Label: "Enterprise", Value: AuditLogScopeEnterprise},
+					},
+				},
+			},
+		},
+		{
+			Name:        "enterprise",
+			Label:       "Enterprise",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., acme",
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "scope", Values: []string{AuditLogScopeEnterprise}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "scope", Values: []string{AuditLogScopeEnterprise}},
+			},
+		},
+		{
+			Name:        "phrase",
+			Label:       "Phrase",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., action:repo.create",
+			Description: "Audit log search phrase",
+		},
+		{
+			Name:        "since",
+			Label:       "Since",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., 2026-01-01T00:00:00Z",
+			Description: "Only list events at or after this time",
+		},
+		{
+			Name:        "until",
+			Label:       "Until",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., 2026-01-31T23:59:59Z",
+			Description: "Only list events at or before this time",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultAuditLogLimit,
+			Description: "Maximum number of events to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxAuditLogLimit; return &max }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *ListAuditLog) Setup(ctx core.SetupContext) error {
+	var config ListAuditLogConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return validateListAuditLog(config)
+}
+
+func validateListAuditLog(config ListAuditLogConfiguration) error {
+	switch config.Scope {
+	case "", AuditLogScopeOrganization:
+	case AuditLogScopeEnterprise:
+		if strings.TrimSpace(config.Enterprise) == "" {
+			return errors.New("enterprise is required")
+		}
+	default:
+		return fmt.Errorf("invalid scope: %s", config.Scope)
+	}
+
+	if config.Limit != nil && (*config.Limit < 1 || *config.Limit > MaxAuditLogLimit) {
+		return fmt.Errorf("limit must be between 1 and %d", MaxAuditLogLimit)
+	}
+
+	//
+	// Times built with expressions can only be checked at execution time.
+	//
+	if isExpression(config.Since) || isExpression(config.Until) {
+		return nil
+	}
+
+	_, err := auditLogPhrase(config)
+	return err
+}
+
+func (c *ListAuditLog) Execute(ctx core.ExecutionContext) error {
+	var config ListAuditLogConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateListAuditLog(config); err != nil {
+		return err
+	}
+
+	phrase, err := auditLogPhrase(config)
+	if err != nil {
+		return err
+	}
+
+	limit := DefaultAuditLogLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	entries, err := listAuditLog(client, appMetadata.Owner, config, phrase, limit)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d audit log entries", len(entries))
+
+	payloads := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		payloads = append(payloads, entry)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.auditLogEntry", payloads)
+}
+
+/*
+ * The audit log endpoints have no time parameters,
+ * so the time range is added to the search phrase.
+ */
+func auditLogPhrase(config ListAuditLogConfiguration) (string, error) {
+	var since, until time.Time
+	var err error
+	if config.Since != "" {
+		since, err = time.Parse(time.RFC3339, config.Since)
+		if err != nil {
+			return "", fmt.Errorf("invalid since time %q: %w", config.Since, err)
+		}
+	}
+
+	if config.Until != "" {
+		until, err = time.Parse(time.RFC3339, config.Until)
+		if err != nil {
+			return "", fmt.Errorf("invalid until time %q: %w", config.Until, err)
+		}
+	}
+
+	created := ""
+	switch {
+	case !since.IsZero() && !until.IsZero():
+		if until.Before(since) {
+			return "", errors.New("until must not be before since")
+		}
+
+		created = fmt.Sprintf("created:%s..%s", since.UTC().Format(time.RFC3339), until.UTC().Format(time.RFC3339))
+	case !since.IsZero():
+		created = fmt.Sprintf("created:>=%s", since.UTC().Format(time.RFC3339))
+	case !until.IsZero():
+		created = fmt.Sprintf("created:<=%s", until.UTC().Format(time.RFC3339))
+	}
+
+	return strings.TrimSpace(strings.TrimSpace(config.Phrase) + " " + created), nil
+}
+
+func listAuditLog(client *github.Client, owner string, config ListAuditLogConfiguration, phrase string, limit int) ([]AuditLogEntry, error) {
+	opts := &github.GetAuditLogOptions{
+		Include:           github.Ptr("all"),
+		Order:             github.Ptr("desc"),
+		ListCursorOptions: github.ListCursorOptions{PerPage: min(limit, 100)},
+	}
+
+	if phrase != "" {
+		opts.Phrase = github.Ptr(phrase)
+	}
+
+	entries := []AuditLogEntry{}
+
+	//
+	// The audit log endpoints use cursor based pagination.
+	//
+	for {
+		var page []*github.AuditEntry
+		var response *github.Response
+		var err error
+		if config.Scope == AuditLogScopeEnterprise {
+			page, response, err = client.Enterprise.GetAuditLog(context.Background(), config.Enterprise, opts)
+		} else {
+			page, response, err = client.Organizations.GetAuditLog(context.Background(), owner, opts)
+		}
+
+		if err != nil {
+			return nil, auditLogError(err, config.Scope)
+		}
+
+		for _, entry := range page {
+			entries = append(entries, buildAuditLogEntry(entry))
+			if len(entries) == limit {
+				return entries, nil
+			}
+		}
+
+		if response.After == "" {
+			return entries, nil
+		}
+
+		opts.ListCursorOptions.After = response.After
+	}
+}
+
+func buildAuditLogEntry(entry *github.AuditEntry) AuditLogEntry {
+	output := AuditLogEntry{
+		DocumentID: entry.GetDocumentID(),
+		Action:     entry.GetAction(),
+		Actor:      entry.GetActor(),
+		User:       entry.GetUser(),
+		Org:        entry.GetOrg(),
+		Business:   entry.GetBusiness(),
+		Country:    entry.GetActorLocation().GetCountryCode(),
+		Fields:     entry.AdditionalFields,
+	}
+
+	switch {
+	case entry.Timestamp != nil:
+		output.CreatedAt = entry.Timestamp.UTC().Format(time.RFC3339)
+	case entry.CreatedAt != nil:
+		output.CreatedAt = entry.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return output
+}
+
+/*
+ * GitHub answers with a 403 when the token cannot read the audit log,
+ * which for the enterprise scope is always the case with app installations.
+ */
+func auditLogError(err error, scope string) error {
+	err = wrapGitHubError(err)
+	if !errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf("failed to list audit log: %w", err)
+	}
+
+	if scope == AuditLogScopeEnterprise {
+		return fmt.Errorf("failed to list enterprise audit log, it needs a token with the read:audit_log scope: %w", err)
+	}
+
+	return fmt.Errorf("failed to list organization audit log, the GitHub app needs the organization Administration read permission: %w", err)
+}
+
+func (c *ListAuditLog) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListAuditLog) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListAuditLog) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListAuditLog) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListAuditLog) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListAuditLog) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
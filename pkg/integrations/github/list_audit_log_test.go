@@ -0,0 +1,120 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListAuditLog__Setup(t *testing.T) {
+	component := ListAuditLog{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("enterprise is required for the enterprise scope", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"scope": "enterprise"}), "enterprise is required")
+	})
+
+	t.Run("invalid since -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"since": "yesterday"}), "invalid since time")
+	})
+
+	t.Run("until before since -> error", func(t *testing.T) {
+		err := setup(map[string]any{"since": "2026-01-02T00:00:00Z", "until": "2026-01-01T00:00:00Z"})
+		require.ErrorContains(t, err, "until must not be before since")
+	})
+
+	t.Run("times with expressions are checked at execution time", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"since": "{{$.data.since}}"}))
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"scope": "organization", "phrase": "action:repo.create", "limit": 10}))
+	})
+}
+
+func Test__ListAuditLog__Phrase(t *testing.T) {
+	phrase, err := auditLogPhrase(ListAuditLogConfiguration{Phrase: "action:repo.create"})
+	require.NoError(t, err)
+	assert.Equal(t, "action:repo.create", phrase)
+
+	phrase, err = auditLogPhrase(ListAuditLogConfiguration{Since: "2026-01-01T02:00:00+02:00"})
+	require.NoError(t, err)
+	assert.Equal(t, "created:>=2026-01-01T00:00:00Z", phrase)
+
+	phrase, err = auditLogPhrase(ListAuditLogConfiguration{
+		Phrase: "actor:octocat",
+		Since:  "2026-01-01T00:00:00Z",
+		Until:  "2026-01-31T00:00:00Z",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "actor:octocat created:2026-01-01T00:00:00Z..2026-01-31T00:00:00Z", phrase)
+}
+
+func Test__ListAuditLog__List(t *testing.T) {
+	t.Run("organization audit log is paginated up to the limit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("after") == "" {
+				response := mockResponse(http.StatusOK, `[
+					{"_document_id":"a","action":"repo.create","actor":"octocat","@timestamp":1767225600000,"repo":"testhq/hello"},
+					{"_document_id":"b","action":"repo.destroy","actor":"octocat","@timestamp":1767225500000}
+				]`)
+
+				response.Header.Set("Link", `<https://api.github.com/orgs/testhq/audit-log?after=cursor1>; rel="next"`)
+				return response, nil
+			}
+
+			return mockResponse(http.StatusOK, `[{"_document_id":"c","action":"org.add_member"},{"_document_id":"d","action":"org.add_member"}]`), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		entries, err := listAuditLog(client, "testhq", ListAuditLogConfiguration{}, "action:repo", 3)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "repo.create", entries[0].Action)
+		assert.Equal(t, "octocat", entries[0].Actor)
+		assert.Equal(t, "2026-01-01T00:00:00Z", entries[0].CreatedAt)
+		assert.Equal(t, "testhq/hello", entries[0].Fields["repo"])
+		assert.Equal(t, "c", entries[2].DocumentID)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/orgs/testhq/audit-log", transport.requests[0].URL.Path)
+		assert.Equal(t, "action:repo", transport.requests[0].URL.Query().Get("phrase"))
+		assert.Equal(t, "cursor1", transport.requests[1].URL.Query().Get("after"))
+	})
+
+	t.Run("enterprise audit log", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[]`), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		entries, err := listAuditLog(client, "testhq", ListAuditLogConfiguration{Scope: "enterprise", Enterprise: "acme"}, "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		assert.Equal(t, "/enterprises/acme/audit-log", transport.requests[0].URL.Path)
+	})
+
+	t.Run("missing audit log access names it", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Must have admin rights to Repository."}`), nil
+		}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		_, err := listAuditLog(client, "testhq", ListAuditLogConfiguration{Scope: "enterprise", Enterprise: "acme"}, "", 10)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "read:audit_log")
+	})
+}
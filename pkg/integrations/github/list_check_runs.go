@@ -0,0 +1,311 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * Conclusions that do not block a merge.
+ */
+var successfulCheckConclusions = []string{"success", "neutral", "skipped"}
+
+type ListCheckRuns struct{}
+
+type ListCheckRunsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Ref        string `json:"ref" mapstructure:"ref"`
+	CheckName  string `json:"checkName" mapstructure:"checkName"`
+	Status     string `json:"status" mapstructure:"status"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type CheckRunSummary struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	DetailsURL string `json:"details_url"`
+}
+
+func (c *ListCheckRuns) Name() string {
+	return "github.listCheckRuns"
+}
+
+func (c *ListCheckRuns) Label() string {
+	return "List Check Runs"
+}
+
+func (c *ListCheckRuns) Description() string {
+	return "List the GitHub check runs for a commit, branch, or tag"
+}
+
+func (c *ListCheckRuns) Documentation() string {
+	return `The List Check Runs component lists the check runs for a commit, branch, or tag.
+
+## Use Cases
+
+- **Merge gates**: Only merge a pull request when all of its checks are successful
+- **Notifications**: Report which checks failed for a commit
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Ref**: Commit SHA, branch, or tag (supports expressions)
+- **Check Name**: Only include check runs with this name
+- **Status**: Only include check runs with this status: queued, in progress, or completed
+- **Emit Mode**: Emit all check runs in a single event, one event per check run, or one event per chunk of check runs
+- **Chunk Size**: Number of check runs in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each check run includes its ` + "`name`" + `, ` + "`status`" + `, ` + "`conclusion`" + `, and ` + "`details_url`" + `.
+
+- **Batch** mode emits a single event with the list of check runs in ` + "`check_runs`" + `, and ` + "`all_successful`" + `, which is true when there is at least one check run, and all of them completed with a success, neutral, or skipped conclusion
+- **Per item** mode emits one ` + "`github.checkRun`" + ` event for each check run. If there are no check runs, no events are emitted
+- **Chunked** mode emits one ` + "`github.checkRuns`" + ` event for each chunk, with its check runs in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + ``
+}
+
+func (c *ListCheckRuns) Icon() string {
+	return "github"
+}
+
+func (c *ListCheckRuns) Color() string {
+	return "gray"
+}
+
+func (c *ListCheckRuns) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListCheckRuns) EventTypes() []string {
+	return []string{"github.checkRuns", "github.checkRun"}
+}
+
+func (c *ListCheckRuns) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "ref",
+			Label:       "Ref",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.head.sha}}",
+		},
+		{
+			Name:        "checkName",
+			Label:       "Check Name",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., build",
+			Description: "Only include check runs with this name",
+		},
+		{
+			Name:  "status",
+			Label: "Status",
+			Type:  configuration.FieldTypeSelect,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Queued", Value: "queued"},
+						{Label: "In progress", Value: "in_progress"},
+						{Label: "Completed", Value: "completed"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListCheckRuns) Setup(ctx core.SetupContext) error {
+	var config ListCheckRunsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Ref == "" {
+		return errors.New("ref is required")
+	}
+
+	if config.Status != "" && !slices.Contains([]string{"queued", "in_progress", "completed"}, config.Status) {
+		return fmt.Errorf("invalid status: %s", config.Status)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListCheckRuns) Execute(ctx core.ExecutionContext) error {
+	var config ListCheckRunsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	opts := buildCheckRunsOptions(config)
+	summaries := []CheckRunSummary{}
+	for {
+		result, response, err := client.Checks.ListCheckRunsForRef(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			config.Ref,
+			opts,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list check runs for %s: %w", config.Ref, wrapGitHubError(err))
+		}
+
+		for _, checkRun := range result.CheckRuns {
+			summaries = append(summaries, CheckRunSummary{
+				ID:         checkRun.GetID(),
+				Name:       checkRun.GetName(),
+				Status:     checkRun.GetStatus(),
+				Conclusion: checkRun.GetConclusion(),
+				DetailsURL: checkRun.GetDetailsURL(),
+			})
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	payloads := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		payloads = append(payloads, summary)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.checkRun", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.checkRuns", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.checkRuns",
+		[]any{map[string]any{
+			"check_runs":     summaries,
+			"all_successful": allCheckRunsSuccessful(summaries),
+		}},
+	)
+}
+
+func buildCheckRunsOptions(config ListCheckRunsConfiguration) *github.ListCheckRunsOptions {
+	opts := &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	if config.CheckName != "" {
+		opts.CheckName = github.Ptr(config.CheckName)
+	}
+
+	if config.Status != "" {
+		opts.Status = github.Ptr(config.Status)
+	}
+
+	return opts
+}
+
+/*
+ * No check runs is not considered successful,
+ * since the checks may not have been created yet.
+ */
+func allCheckRunsSuccessful(checkRuns []CheckRunSummary) bool {
+	if len(checkRuns) == 0 {
+		return false
+	}
+
+	for _, checkRun := range checkRuns {
+		if checkRun.Status != "completed" || !slices.Contains(successfulCheckConclusions, checkRun.Conclusion) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *ListCheckRuns) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListCheckRuns) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListCheckRuns) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListCheckRuns) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListCheckRuns) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListCheckRuns) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
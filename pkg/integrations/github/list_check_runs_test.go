@@ -0,0 +1,84 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListCheckRuns__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListCheckRuns{}
+
+	t.Run("ref is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "ref is required")
+	})
+
+	t.Run("invalid status -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "status": "done"},
+		})
+
+		require.ErrorContains(t, err, "invalid status")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "checkName": "build", "status": "completed"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListCheckRuns__BuildOptions(t *testing.T) {
+	opts := buildCheckRunsOptions(ListCheckRunsConfiguration{CheckName: "build", Status: "completed"})
+	assert.Equal(t, "build", opts.GetCheckName())
+	assert.Equal(t, "completed", opts.GetStatus())
+	assert.Equal(t, 100, opts.ListOptions.PerPage)
+
+	opts = buildCheckRunsOptions(ListCheckRunsConfiguration{})
+	assert.Nil(t, opts.CheckName)
+	assert.Nil(t, opts.Status)
+}
+
+func Test__ListCheckRuns__AllSuccessful(t *testing.T) {
+	t.Run("no check runs -> false", func(t *testing.T) {
+		assert.False(t, allCheckRunsSuccessful([]CheckRunSummary{}))
+	})
+
+	t.Run("successful, neutral and skipped -> true", func(t *testing.T) {
+		assert.True(t, allCheckRunsSuccessful([]CheckRunSummary{
+			{Name: "build", Status: "completed", Conclusion: "success"},
+			{Name: "lint", Status: "completed", Conclusion: "neutral"},
+			{Name: "deploy", Status: "completed", Conclusion: "skipped"},
+		}))
+	})
+
+	t.Run("pending check -> false", func(t *testing.T) {
+		assert.False(t, allCheckRunsSuccessful([]CheckRunSummary{
+			{Name: "build", Status: "completed", Conclusion: "success"},
+			{Name: "test", Status: "in_progress"},
+		}))
+	})
+
+	t.Run("failed check -> false", func(t *testing.T) {
+		assert.False(t, allCheckRunsSuccessful([]CheckRunSummary{
+			{Name: "build", Status: "completed", Conclusion: "failure"},
+		}))
+	})
+}
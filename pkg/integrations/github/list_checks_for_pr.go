@@ -0,0 +1,414 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ChecksStateSuccess = "success"
+	ChecksStateFailure = "failure"
+	ChecksStatePending = "pending"
+
+	CheckTypeStatus   = "status"
+	CheckTypeCheckRun = "check_run"
+)
+
+type ListChecksForPR struct{}
+
+type ListChecksForPRConfiguration struct {
+	Repository   string `json:"repository" mapstructure:"repository"`
+	PullNumber   string `json:"pullNumber" mapstructure:"pullNumber"`
+	RequiredOnly bool   `json:"requiredOnly" mapstructure:"requiredOnly"`
+}
+
+type PullRequestCheck struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	State string `json:"state"`
+	URL   string `json:"url,omitempty"`
+}
+
+type PullRequestChecksOutput struct {
+	PullNumber   int                `json:"pull_number"`
+	HeadSHA      string             `json:"head_sha"`
+	State        string             `json:"state"`
+	Checks       []PullRequestCheck `json:"checks"`
+	Failing      []string           `json:"failing"`
+	Pending      []string           `json:"pending"`
+	RequiredOnly bool               `json:"required_only"`
+}
+
+func (c *ListChecksForPR) Name() string {
+	return "github.listChecksForPR"
+}
+
+func (c *ListChecksForPR) Label() string {
+	return "List Checks for Pull Request"
+}
+
+func (c *ListChecksForPR) Description() string {
+	return "Get the combined state of the commit statuses and check runs of a GitHub pull request"
+}
+
+func (c *ListChecksForPR) Documentation() string {
+	return `The List Checks for Pull Request component answers whether a pull request is green, combining the commit statuses and the check runs of its head commit.
+
+## Use Cases
+
+- **Merge gates**: Only merge a pull request when all of its checks pass
+- **Notifications**: Report which checks are failing or still running on a pull request
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Required Only**: Only consider the status checks required by the branch protection of the base branch
+
+## Output
+
+Returns the ` + "`head_sha`" + ` of the pull request, and the ` + "`checks`" + `, with the ` + "`name`" + `, ` + "`type`" + ` (` + "`status`" + ` or ` + "`check_run`" + `), and ` + "`state`" + ` of each one.
+The ` + "`state`" + ` of a check is one of:
+
+- ` + "`success`" + `: The status is successful, or the check run completed with a success, neutral, or skipped conclusion
+- ` + "`failure`" + `: The status failed or errored, or the check run completed with any other conclusion
+- ` + "`pending`" + `: The status or check run did not complete yet
+
+` + "`failing`" + ` and ` + "`pending`" + ` list the names of the checks in each state.
+The overall ` + "`state`" + ` is ` + "`failure`" + ` if any check is failing, ` + "`pending`" + ` if any check is pending, and ` + "`success`" + ` otherwise.
+
+## Notes
+
+- With no checks at all, the state is ` + "`pending`" + `, since the checks may not have been created yet
+- With **Required Only**, a required check that was not reported yet is pending. If the base branch does not require any status checks, the state is ` + "`success`" + `
+- Only the latest check run with each name is considered, so re-runs replace earlier runs`
+}
+
+func (c *ListChecksForPR) Icon() string {
+	return "github"
+}
+
+func (c *ListChecksForPR) Color() string {
+	return "gray"
+}
+
+func (c *ListChecksForPR) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListChecksForPR) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "requiredOnly",
+			Label:       "Required Only",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only consider the status checks required by the branch protection",
+		},
+	}
+}
+
+func (c *ListChecksForPR) Setup(ctx core.SetupContext) error {
+	var config ListChecksForPRConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListChecksForPR) Execute(ctx core.ExecutionContext) error {
+	var config ListChecksForPRConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := listChecksForPullRequest(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, pullNumber, config.RequiredOnly)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequestChecks",
+		[]any{output},
+	)
+}
+
+func listChecksForPullRequest(client *github.Client, installationID, owner, repo string, pullNumber int, requiredOnly bool) (*PullRequestChecksOutput, error) {
+	pullRequest, err := getPullRequest(client, installationID, owner, repo, pullNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request %d: %w", pullNumber, err)
+	}
+
+	headSHA := pullRequest.GetHead().GetSHA()
+	statuses, err := listCommitStatuses(client, owner, repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns, err := listCheckRunsForSHA(client, owner, repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := append(statuses, checkRuns...)
+	if requiredOnly {
+		required, err := listRequiredStatusChecks(client, owner, repo, pullRequest.GetBase().GetRef())
+		if err != nil {
+			return nil, err
+		}
+
+		checks = filterRequiredChecks(checks, required)
+	}
+
+	output := summarizeChecks(checks, requiredOnly)
+	output.PullNumber = pullNumber
+	output.HeadSHA = headSHA
+	output.RequiredOnly = requiredOnly
+	return output, nil
+}
+
+func listCommitStatuses(client *github.Client, owner, repo, sha string) ([]PullRequestCheck, error) {
+	checks := []PullRequestCheck{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		combined, response, err := client.Repositories.GetCombinedStatus(context.Background(), owner, repo, sha, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get combined status for %s: %w", sha, wrapGitHubError(err))
+		}
+
+		for _, status := range combined.Statuses {
+			checks = append(checks, PullRequestCheck{
+				Name:  status.GetContext(),
+				Type:  CheckTypeStatus,
+				State: commitStatusCheckState(status.GetState()),
+				URL:   status.GetTargetURL(),
+			})
+		}
+
+		if response.NextPage == 0 {
+			return checks, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func listCheckRunsForSHA(client *github.Client, owner, repo, sha string) ([]PullRequestCheck, error) {
+	checks := []PullRequestCheck{}
+	opts := &github.ListCheckRunsOptions{
+		Filter:      github.Ptr("latest"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		result, response, err := client.Checks.ListCheckRunsForRef(context.Background(), owner, repo, sha, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list check runs for %s: %w", sha, wrapGitHubError(err))
+		}
+
+		for _, checkRun := range result.CheckRuns {
+			checks = append(checks, PullRequestCheck{
+				Name:  checkRun.GetName(),
+				Type:  CheckTypeCheckRun,
+				State: checkRunCheckState(checkRun.GetStatus(), checkRun.GetConclusion()),
+				URL:   checkRun.GetDetailsURL(),
+			})
+		}
+
+		if response.NextPage == 0 {
+			return checks, nil
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+}
+
+/*
+ * GitHub returns a 404 if the branch is not protected,
+ * or if its protection does not require status checks.
+ */
+func listRequiredStatusChecks(client *github.Client, owner, repo, branch string) ([]string, error) {
+	requiredChecks, _, err := client.Repositories.GetRequiredStatusChecks(context.Background(), owner, repo, branch)
+	if err != nil {
+		if errors.Is(err, github.ErrBranchNotProtected) {
+			return []string{}, nil
+		}
+
+		err = wrapGitHubError(err)
+		if errors.Is(err, ErrNotFound) {
+			return []string{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get required status checks for %s: %w", branch, err)
+	}
+
+	required := []string{}
+	if requiredChecks.Checks != nil {
+		for _, check := range *requiredChecks.Checks {
+			required = append(required, check.Context)
+		}
+	}
+
+	if requiredChecks.Contexts != nil {
+		for _, name := range *requiredChecks.Contexts {
+			if !slices.Contains(required, name) {
+				required = append(required, name)
+			}
+		}
+	}
+
+	return required, nil
+}
+
+/*
+ * Keeps only the required checks.
+ * A required check that was not reported yet is pending.
+ */
+func filterRequiredChecks(checks []PullRequestCheck, required []string) []PullRequestCheck {
+	filtered := []PullRequestCheck{}
+	for _, name := range required {
+		reported := false
+		for _, check := range checks {
+			if check.Name == name {
+				filtered = append(filtered, check)
+				reported = true
+			}
+		}
+
+		if !reported {
+			filtered = append(filtered, PullRequestCheck{Name: name, State: ChecksStatePending})
+		}
+	}
+
+	return filtered
+}
+
+func summarizeChecks(checks []PullRequestCheck, requiredOnly bool) *PullRequestChecksOutput {
+	output := &PullRequestChecksOutput{
+		Checks:  checks,
+		Failing: []string{},
+		Pending: []string{},
+	}
+
+	for _, check := range checks {
+		switch check.State {
+		case ChecksStateFailure:
+			output.Failing = append(output.Failing, check.Name)
+		case ChecksStatePending:
+			output.Pending = append(output.Pending, check.Name)
+		}
+	}
+
+	switch {
+	case len(output.Failing) > 0:
+		output.State = ChecksStateFailure
+	case len(output.Pending) > 0:
+		output.State = ChecksStatePending
+	case len(checks) == 0 && !requiredOnly:
+		output.State = ChecksStatePending
+	default:
+		output.State = ChecksStateSuccess
+	}
+
+	return output
+}
+
+func commitStatusCheckState(state string) string {
+	switch state {
+	case "success":
+		return ChecksStateSuccess
+	case "failure", "error":
+		return ChecksStateFailure
+	default:
+		return ChecksStatePending
+	}
+}
+
+func checkRunCheckState(status, conclusion string) string {
+	if status != "completed" {
+		return ChecksStatePending
+	}
+
+	if slices.Contains(successfulCheckConclusions, conclusion) {
+		return ChecksStateSuccess
+	}
+
+	return ChecksStateFailure
+}
+
+func (c *ListChecksForPR) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListChecksForPR) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListChecksForPR) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListChecksForPR) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListChecksForPR) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListChecksForPR) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListChecksForPR__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListChecksForPR{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "requiredOnly": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListChecksForPR__List(t *testing.T) {
+	checksTransport := func(requiredChecks *http.Response) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/pulls/42":
+				return mockResponse(http.StatusOK, `{"number":42,"head":{"sha":"abc123"},"base":{"ref":"main"}}`), nil
+			case "/repos/testhq/hello/commits/abc123/status":
+				return mockResponse(http.StatusOK, `{"state":"failure","statuses":[
+					{"context":"ci/lint","state":"success"},
+					{"context":"ci/coverage","state":"error","target_url":"https://ci.example.com/1"}
+				]}`), nil
+			case "/repos/testhq/hello/commits/abc123/check-runs":
+				return mockResponse(http.StatusOK, `{"total_count":2,"check_runs":[
+					{"id":1,"name":"build","status":"completed","conclusion":"success"},
+					{"id":2,"name":"e2e","status":"in_progress"}
+				]}`), nil
+			case "/repos/testhq/hello/branches/main/protection/required_status_checks":
+				return requiredChecks, nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+	}
+
+	t.Run("all checks are combined", func(t *testing.T) {
+		transport := checksTransport(nil)
+		output, err := listChecksForPullRequest(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", 42, false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, "latest", transport.requests[2].URL.Query().Get("filter"))
+
+		assert.Equal(t, 42, output.PullNumber)
+		assert.Equal(t, "abc123", output.HeadSHA)
+		assert.Equal(t, ChecksStateFailure, output.State)
+		assert.Len(t, output.Checks, 4)
+		assert.Equal(t, []string{"ci/coverage"}, output.Failing)
+		assert.Equal(t, []string{"e2e"}, output.Pending)
+	})
+
+	t.Run("required only", func(t *testing.T) {
+		transport := checksTransport(mockResponse(http.StatusOK, `{"strict":true,"checks":[{"context":"build"},{"context":"ci/lint"},{"context":"deploy-preview"}]}`))
+		output, err := listChecksForPullRequest(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", 42, true)
+		require.NoError(t, err)
+
+		assert.Equal(t, ChecksStatePending, output.State)
+		assert.Len(t, output.Checks, 3)
+		assert.Empty(t, output.Failing)
+		assert.Equal(t, []string{"deploy-preview"}, output.Pending)
+	})
+
+	t.Run("required only, without required checks -> success", func(t *testing.T) {
+		transport := checksTransport(mockResponse(http.StatusNotFound, `{"message":"Branch not protected"}`))
+		output, err := listChecksForPullRequest(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", 42, true)
+		require.NoError(t, err)
+		assert.Equal(t, ChecksStateSuccess, output.State)
+		assert.Empty(t, output.Checks)
+	})
+
+	t.Run("pull request not found -> error", func(t *testing.T) {
+		transport := checksTransport(nil)
+		_, err := listChecksForPullRequest(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", 7, false)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Len(t, transport.requests, 1)
+	})
+}
+
+func Test__ListChecksForPR__Summary(t *testing.T) {
+	t.Run("no checks -> pending", func(t *testing.T) {
+		assert.Equal(t, ChecksStatePending, summarizeChecks([]PullRequestCheck{}, false).State)
+	})
+
+	t.Run("all successful -> success", func(t *testing.T) {
+		output := summarizeChecks([]PullRequestCheck{
+			{Name: "build", Type: CheckTypeCheckRun, State: checkRunCheckState("completed", "skipped")},
+			{Name: "ci/lint", Type: CheckTypeStatus, State: commitStatusCheckState("success")},
+		}, false)
+
+		assert.Equal(t, ChecksStateSuccess, output.State)
+	})
+
+	t.Run("check states", func(t *testing.T) {
+		assert.Equal(t, ChecksStateFailure, checkRunCheckState("completed", "timed_out"))
+		assert.Equal(t, ChecksStatePending, checkRunCheckState("queued", ""))
+		assert.Equal(t, ChecksStatePending, commitStatusCheckState("pending"))
+		assert.Equal(t, ChecksStateFailure, commitStatusCheckState("failure"))
+	})
+}
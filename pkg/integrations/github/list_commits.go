@@ -0,0 +1,331 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultCommitsLimit = 30
+
+type ListCommits struct{}
+
+type ListCommitsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Ref        string `json:"ref" mapstructure:"ref"`
+	Path       string `json:"path" mapstructure:"path"`
+	Since      string `json:"since" mapstructure:"since"`
+	Limit      *int   `json:"limit" mapstructure:"limit"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type CommitSummary struct {
+	SHA     string     `json:"sha"`
+	Message string     `json:"message"`
+	Author  string     `json:"author"`
+	Date    *time.Time `json:"date"`
+	URL     string     `json:"html_url"`
+}
+
+func (c *ListCommits) Name() string {
+	return "github.listCommits"
+}
+
+func (c *ListCommits) Label() string {
+	return "List Commits"
+}
+
+func (c *ListCommits) Description() string {
+	return "List the commits in a GitHub repository"
+}
+
+func (c *ListCommits) Documentation() string {
+	return `The List Commits component lists recent commits in a GitHub repository.
+
+## Use Cases
+
+- **Changelogs**: Collect the commits since the last release
+- **Audits**: Process every commit that touched a path
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Ref**: Branch, tag, or SHA to list commits from. Defaults to the default branch
+- **Path**: Only include commits touching this path
+- **Since**: Only include commits after this date, in ISO 8601 format
+- **Limit**: Maximum number of commits to list. Defaults to 30
+- **Emit Mode**: Emit all commits in a single event, one event per commit, or one event per chunk of commits
+- **Chunk Size**: Number of commits in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each commit includes its ` + "`sha`" + `, ` + "`message`" + `, ` + "`author`" + `, and ` + "`date`" + `.
+
+- **Batch** mode emits a single event with the list of commits in ` + "`commits`" + `
+- **Per item** mode emits one ` + "`github.commit`" + ` event for each commit
+- **Chunked** mode emits one event for each chunk, with its commits in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+With per item and chunked modes, no events are emitted if there are no commits.`
+}
+
+func (c *ListCommits) Icon() string {
+	return "github"
+}
+
+func (c *ListCommits) Color() string {
+	return "gray"
+}
+
+func (c *ListCommits) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListCommits) EventTypes() []string {
+	return []string{"github.commits", "github.commit"}
+}
+
+func (c *ListCommits) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "ref",
+			Label:       "Ref",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Branch, tag, or SHA. Defaults to the default branch",
+		},
+		{
+			Name:        "path",
+			Label:       "Path",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., docs/",
+			Description: "Only include commits touching this path",
+		},
+		{
+			Name:        "since",
+			Label:       "Since",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., 2026-01-01T00:00:00Z",
+			Description: "Only include commits after this date",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultCommitsLimit,
+			Description: "Maximum number of commits to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListCommits) Setup(ctx core.SetupContext) error {
+	var config ListCommitsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	if config.Since != "" && !strings.Contains(config.Since, "{{") {
+		if _, err := time.Parse(time.RFC3339, config.Since); err != nil {
+			return fmt.Errorf("invalid since date %q: %w", config.Since, err)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListCommits) Execute(ctx core.ExecutionContext) error {
+	var config ListCommitsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	limit := commitsLimit(config)
+	opts, err := buildCommitsOptions(config, limit)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	summaries := []CommitSummary{}
+	for {
+		commits, response, err := client.Repositories.ListCommits(context.Background(), appMetadata.Owner, config.Repository, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list commits: %w", wrapGitHubError(err))
+		}
+
+		for _, commit := range commits {
+			if len(summaries) >= limit {
+				break
+			}
+
+			summaries = append(summaries, summarizeCommit(commit))
+		}
+
+		if len(summaries) >= limit || response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	payloads := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		payloads = append(payloads, summary)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.commit", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.commits", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.commits",
+		[]any{map[string]any{"commits": summaries}},
+	)
+}
+
+func commitsLimit(config ListCommitsConfiguration) int {
+	if config.Limit == nil || *config.Limit < 1 {
+		return DefaultCommitsLimit
+	}
+
+	return *config.Limit
+}
+
+func buildCommitsOptions(config ListCommitsConfiguration, limit int) (*github.CommitsListOptions, error) {
+	opts := &github.CommitsListOptions{
+		SHA:         config.Ref,
+		Path:        config.Path,
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+
+	if config.Since != "" {
+		since, err := time.Parse(time.RFC3339, config.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since date %q: %w", config.Since, err)
+		}
+
+		opts.Since = since
+	}
+
+	return opts, nil
+}
+
+/*
+ * Only the first line of the commit message is kept,
+ * since the full message is rarely needed, and can be large.
+ */
+func summarizeCommit(commit *github.RepositoryCommit) CommitSummary {
+	message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+	summary := CommitSummary{
+		SHA:     commit.GetSHA(),
+		Message: message,
+		Author:  commit.GetCommit().GetAuthor().GetName(),
+		URL:     commit.GetHTMLURL(),
+	}
+
+	if commit.GetAuthor().GetLogin() != "" {
+		summary.Author = commit.GetAuthor().GetLogin()
+	}
+
+	if date := commit.GetCommit().GetAuthor().Date; date != nil {
+		summary.Date = &date.Time
+	}
+
+	return summary
+}
+
+func (c *ListCommits) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListCommits) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListCommits) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListCommits) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListCommits) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListCommits) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
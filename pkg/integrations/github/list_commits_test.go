@@ -0,0 +1,106 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListCommits__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListCommits{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid chunk size -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": EmitModeChunked, "chunkSize": 0},
+		})
+
+		require.ErrorContains(t, err, "chunk size must be between 1 and 100")
+	})
+
+	t.Run("invalid since -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "since": "yesterday"},
+		})
+
+		require.ErrorContains(t, err, "invalid since date")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository": "hello",
+				"emitMode":   EmitModeChunked,
+				"chunkSize":  25,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListCommits__SummarizeCommit(t *testing.T) {
+	date := time.Now()
+	commit := &github.RepositoryCommit{
+		SHA:     github.Ptr("abc123"),
+		HTMLURL: github.Ptr("https://github.com/testhq/hello/commit/abc123"),
+		Author:  &github.User{Login: github.Ptr("octocat")},
+		Commit: &github.Commit{
+			Message: github.Ptr("Fix flaky test\n\nThe test depended on the order of map keys."),
+			Author:  &github.CommitAuthor{Name: github.Ptr("The Octocat"), Date: &github.Timestamp{Time: date}},
+		},
+	}
+
+	summary := summarizeCommit(commit)
+	assert.Equal(t, "abc123", summary.SHA)
+	assert.Equal(t, "Fix flaky test", summary.Message)
+	assert.Equal(t, "octocat", summary.Author)
+	assert.Equal(t, date, *summary.Date)
+}
+
+func Test__ChunkEmit(t *testing.T) {
+	items := []any{"a", "b", "c", "d", "e"}
+
+	t.Run("items are split in chunks", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{}
+		require.NoError(t, chunkEmit(state, core.DefaultOutputChannel.Name, "github.commits", items, 2))
+
+		require.Len(t, state.Payloads, 3)
+		last := state.Payloads[2].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, []any{"e"}, last["items"])
+		assert.Equal(t, 3, last["chunk"])
+		assert.Equal(t, 3, last["chunks"])
+	})
+
+	t.Run("chunk size larger than the list -> single event", func(t *testing.T) {
+		payloads := chunkPayloads(items, 10)
+		require.Len(t, payloads, 1)
+		assert.Equal(t, items, payloads[0].(map[string]any)["items"])
+	})
+
+	t.Run("no items -> no events", func(t *testing.T) {
+		assert.Empty(t, chunkPayloads([]any{}, 10))
+	})
+}
@@ -0,0 +1,354 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+var (
+	dependabotAlertStates     = []string{"open", "dismissed", "fixed", "auto_dismissed"}
+	dependabotAlertSeverities = []string{"low", "medium", "high", "critical"}
+)
+
+type ListDependabotAlerts struct{}
+
+type ListDependabotAlertsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	State      string `json:"state" mapstructure:"state"`
+	Severity   string `json:"severity" mapstructure:"severity"`
+	Ecosystem  string `json:"ecosystem" mapstructure:"ecosystem"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type DependabotAlertOutput struct {
+	Number              int                    `json:"number"`
+	State               string                 `json:"state"`
+	Package             DependabotAlertPackage `json:"package"`
+	ManifestPath        string                 `json:"manifest_path,omitempty"`
+	Severity            string                 `json:"severity"`
+	CVEID               string                 `json:"cve_id,omitempty"`
+	GHSAID              string                 `json:"ghsa_id,omitempty"`
+	Summary             string                 `json:"summary,omitempty"`
+	VulnerableRange     string                 `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion string                 `json:"first_patched_version,omitempty"`
+	DismissedReason     string                 `json:"dismissed_reason,omitempty"`
+	DismissedComment    string                 `json:"dismissed_comment,omitempty"`
+	URL                 string                 `json:"html_url"`
+	CreatedAt           string                 `json:"created_at,omitempty"`
+}
+
+type DependabotAlertPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+func (c *ListDependabotAlerts) Name() string {
+	return "github.listDependabotAlerts"
+}
+
+func (c *ListDependabotAlerts) Label() string {
+	return "List Dependabot Alerts"
+}
+
+func (c *ListDependabotAlerts) Description() string {
+	return "List Dependabot alerts in a GitHub repository"
+}
+
+func (c *ListDependabotAlerts) Documentation() string {
+	return `The List Dependabot Alerts component lists the Dependabot alerts of a GitHub repository.
+
+## Use Cases
+
+- **Vulnerability triage**: Route new critical alerts to the owning team
+- **Reporting**: Collect vulnerable dependencies across repositories
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **State**: Only list alerts in this state. Leave empty to list alerts in any state
+- **Severity**: Only list alerts with this severity. Leave empty to list alerts of any severity
+- **Ecosystem**: Only list alerts for this package ecosystem, for example ` + "`npm`" + ` or ` + "`go`" + `
+- **Emit Mode**: Emit all alerts in a single event, one event per alert, or one event per chunk of alerts
+- **Chunk Size**: Number of alerts in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each alert includes its ` + "`number`" + `, ` + "`state`" + `, ` + "`package`" + `, ` + "`severity`" + `, and ` + "`cve_id`" + `.
+
+- **Batch** mode emits a single event with the list of alerts in ` + "`alerts`" + `
+- **Per item** mode emits one ` + "`github.dependabotAlert`" + ` event for each alert. If no alerts are found, no events are emitted
+- **Chunked** mode emits one ` + "`github.dependabotAlerts`" + ` event for each chunk, with its alerts in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- Dependabot alerts must be enabled for the repository`
+}
+
+func (c *ListDependabotAlerts) Icon() string {
+	return "github"
+}
+
+func (c *ListDependabotAlerts) Color() string {
+	return "gray"
+}
+
+func (c *ListDependabotAlerts) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListDependabotAlerts) EventTypes() []string {
+	return []string{"github.dependabotAlerts", "github.dependabotAlert"}
+}
+
+func (c *ListDependabotAlerts) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:    "state",
+			Label:   "State",
+			Type:    configuration.FieldTypeSelect,
+			Default: "open",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Open", Value: "open"},
+						{Label: "Dismissed", Value: "dismissed"},
+						{Label: "Fixed", Value: "fixed"},
+						{Label: "Auto dismissed", Value: "auto_dismissed"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "severity",
+			Label: "Severity",
+			Type:  configuration.FieldTypeSelect,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Low", Value: "low"},
+						{Label: "Medium", Value: "medium"},
+						{Label: "High", Value: "high"},
+						{Label: "Critical", Value: "critical"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "ecosystem",
+			Label:       "Ecosystem",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., npm",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListDependabotAlerts) Setup(ctx core.SetupContext) error {
+	var config ListDependabotAlertsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.State != "" && !slices.Contains(dependabotAlertStates, config.State) {
+		return fmt.Errorf("invalid state: %s", config.State)
+	}
+
+	if config.Severity != "" && !slices.Contains(dependabotAlertSeverities, config.Severity) {
+		return fmt.Errorf("invalid severity: %s", config.Severity)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListDependabotAlerts) Execute(ctx core.ExecutionContext) error {
+	var config ListDependabotAlertsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	opts := buildDependabotAlertsOptions(config)
+	outputs := []DependabotAlertOutput{}
+
+	//
+	// The Dependabot alerts endpoint uses cursor based pagination.
+	//
+	for {
+		alerts, response, err := client.Dependabot.ListRepoAlerts(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			opts,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list Dependabot alerts: %w", wrapFeatureError(err, "Dependabot alerts"))
+		}
+
+		for _, alert := range alerts {
+			outputs = append(outputs, buildDependabotAlertOutput(alert))
+		}
+
+		if response.After == "" {
+			break
+		}
+
+		opts.ListCursorOptions.After = response.After
+	}
+
+	payloads := make([]any, 0, len(outputs))
+	for _, output := range outputs {
+		payloads = append(payloads, output)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.dependabotAlert", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.dependabotAlerts", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.dependabotAlerts",
+		[]any{map[string]any{"alerts": outputs}},
+	)
+}
+
+func buildDependabotAlertsOptions(config ListDependabotAlertsConfiguration) *github.ListAlertsOptions {
+	opts := &github.ListAlertsOptions{
+		ListCursorOptions: github.ListCursorOptions{PerPage: 100},
+	}
+
+	if config.State != "" {
+		opts.State = &config.State
+	}
+
+	if config.Severity != "" {
+		opts.Severity = &config.Severity
+	}
+
+	if config.Ecosystem != "" {
+		opts.Ecosystem = &config.Ecosystem
+	}
+
+	return opts
+}
+
+func buildDependabotAlertOutput(alert *github.DependabotAlert) DependabotAlertOutput {
+	output := DependabotAlertOutput{
+		Number:           alert.GetNumber(),
+		State:            alert.GetState(),
+		DismissedReason:  alert.GetDismissedReason(),
+		DismissedComment: alert.GetDismissedComment(),
+		URL:              alert.GetHTMLURL(),
+	}
+
+	if alert.Dependency != nil {
+		output.ManifestPath = alert.Dependency.GetManifestPath()
+		output.Package = DependabotAlertPackage{
+			Name:      alert.Dependency.GetPackage().GetName(),
+			Ecosystem: alert.Dependency.GetPackage().GetEcosystem(),
+		}
+	}
+
+	if alert.SecurityAdvisory != nil {
+		output.Severity = alert.SecurityAdvisory.GetSeverity()
+		output.CVEID = alert.SecurityAdvisory.GetCVEID()
+		output.GHSAID = alert.SecurityAdvisory.GetGHSAID()
+		output.Summary = alert.SecurityAdvisory.GetSummary()
+	}
+
+	if alert.SecurityVulnerability != nil {
+		output.VulnerableRange = alert.SecurityVulnerability.GetVulnerableVersionRange()
+		output.FirstPatchedVersion = alert.SecurityVulnerability.GetFirstPatchedVersion().GetIdentifier()
+	}
+
+	if alert.CreatedAt != nil {
+		output.CreatedAt = alert.CreatedAt.Format(time.RFC3339)
+	}
+
+	return output
+}
+
+func (c *ListDependabotAlerts) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListDependabotAlerts) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListDependabotAlerts) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListDependabotAlerts) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListDependabotAlerts) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListDependabotAlerts) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListDependabotAlerts__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListDependabotAlerts{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid severity -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "severity": "urgent"},
+		})
+
+		require.ErrorContains(t, err, "invalid severity")
+	})
+
+	t.Run("invalid state -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "state": "closed"},
+		})
+
+		require.ErrorContains(t, err, "invalid state")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository": "hello",
+				"state":      "open",
+				"severity":   "critical",
+				"ecosystem":  "npm",
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListDependabotAlerts__BuildOptions(t *testing.T) {
+	t.Run("empty filters are not sent", func(t *testing.T) {
+		opts := buildDependabotAlertsOptions(ListDependabotAlertsConfiguration{})
+		assert.Nil(t, opts.State)
+		assert.Nil(t, opts.Severity)
+		assert.Nil(t, opts.Ecosystem)
+		assert.Equal(t, 100, opts.ListCursorOptions.PerPage)
+	})
+
+	t.Run("filters are sent", func(t *testing.T) {
+		opts := buildDependabotAlertsOptions(ListDependabotAlertsConfiguration{
+			State:     "open",
+			Severity:  "high",
+			Ecosystem: "npm",
+		})
+
+		assert.Equal(t, "open", opts.GetState())
+		assert.Equal(t, "high", opts.GetSeverity())
+		assert.Equal(t, "npm", opts.GetEcosystem())
+	})
+}
+
+func Test__ListDependabotAlerts__BuildOutput(t *testing.T) {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	output := buildDependabotAlertOutput(&github.DependabotAlert{
+		Number: github.Ptr(7),
+		State:  github.Ptr("open"),
+		Dependency: &github.Dependency{
+			Package:      &github.VulnerabilityPackage{Name: github.Ptr("lodash"), Ecosystem: github.Ptr("npm")},
+			ManifestPath: github.Ptr("package-lock.json"),
+		},
+		SecurityAdvisory: &github.DependabotSecurityAdvisory{
+			Severity: github.Ptr("high"),
+			CVEID:    github.Ptr("CVE-2021-23337"),
+		},
+		SecurityVulnerability: &github.AdvisoryVulnerability{
+			FirstPatchedVersion: &github.FirstPatchedVersion{Identifier: github.Ptr("4.17.21")},
+		},
+		CreatedAt: &github.Timestamp{Time: createdAt},
+	})
+
+	assert.Equal(t, 7, output.Number)
+	assert.Equal(t, DependabotAlertPackage{Name: "lodash", Ecosystem: "npm"}, output.Package)
+	assert.Equal(t, "high", output.Severity)
+	assert.Equal(t, "CVE-2021-23337", output.CVEID)
+	assert.Equal(t, "4.17.21", output.FirstPatchedVersion)
+	assert.Equal(t, createdAt.Format(time.RFC3339), output.CreatedAt)
+}
@@ -0,0 +1,317 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultDeploymentsLimit = 30
+
+type ListDeployments struct{}
+
+type ListDeploymentsConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	Environment string `json:"environment" mapstructure:"environment"`
+	Ref         string `json:"ref" mapstructure:"ref"`
+	SHA         string `json:"sha" mapstructure:"sha"`
+	Limit       *int   `json:"limit" mapstructure:"limit"`
+	EmitMode    string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize   *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+/*
+ * DeploymentWithStatus is a deployment
+ * with its most recent status attached.
+ */
+type DeploymentWithStatus struct {
+	*github.Deployment
+	LatestStatus *github.DeploymentStatus `json:"latest_status"`
+}
+
+func (c *ListDeployments) Name() string {
+	return "github.listDeployments"
+}
+
+func (c *ListDeployments) Label() string {
+	return "List Deployments"
+}
+
+func (c *ListDeployments) Description() string {
+	return "List deployments in a GitHub repository, with their latest status"
+}
+
+func (c *ListDeployments) Documentation() string {
+	return `The List Deployments component lists the deployments in a GitHub repository, with the latest status of each deployment.
+
+## Use Cases
+
+- **Audits**: Review the deployment history of an environment
+- **Release tracking**: Find which commit is currently deployed to an environment
+- **Reporting**: Collect deployment data for dashboards
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: Only list deployments to this environment (e.g. ` + "`production`" + `)
+- **Ref**: Only list deployments of this branch, tag, or SHA
+- **SHA**: Only list deployments of this commit SHA
+- **Limit**: Maximum number of deployments to list. Defaults to 30
+- **Emit Mode**: Emit all deployments in a single event, one event per deployment, or one event per chunk of deployments
+- **Chunk Size**: Number of deployments in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Deployments are listed from newest to oldest. Each deployment includes its most recent status in ` + "`latest_status`" + `, or ` + "`null`" + ` if it has no statuses.
+
+- **Batch** mode emits a single event with the list of deployments in ` + "`deployments`" + `
+- **Per item** mode emits one ` + "`github.deployment`" + ` event for each deployment. If no deployments are found, no events are emitted
+- **Chunked** mode emits one ` + "`github.deployments`" + ` event for each chunk, with its deployments in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- The latest status is fetched with one extra request per deployment, so keep the limit low for large histories`
+}
+
+func (c *ListDeployments) Icon() string {
+	return "github"
+}
+
+func (c *ListDeployments) Color() string {
+	return "gray"
+}
+
+func (c *ListDeployments) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListDeployments) EventTypes() []string {
+	return []string{"github.deployments", "github.deployment"}
+}
+
+func (c *ListDeployments) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., production",
+		},
+		{
+			Name:        "ref",
+			Label:       "Ref",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "Branch, tag, or SHA",
+		},
+		{
+			Name:  "sha",
+			Label: "SHA",
+			Type:  configuration.FieldTypeString,
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultDeploymentsLimit,
+			Description: "Maximum number of deployments to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListDeployments) Setup(ctx core.SetupContext) error {
+	var config ListDeploymentsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListDeployments) Execute(ctx core.ExecutionContext) error {
+	var config ListDeploymentsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	limit := deploymentsLimit(config)
+	opts := &github.DeploymentsListOptions{
+		Environment: config.Environment,
+		Ref:         config.Ref,
+		SHA:         config.SHA,
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+
+	deployments := []*github.Deployment{}
+	for len(deployments) < limit {
+		page, response, err := client.Repositories.ListDeployments(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			opts,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list deployments: %w", wrapGitHubError(err))
+		}
+
+		deployments = append(deployments, page...)
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	if len(deployments) > limit {
+		deployments = deployments[:limit]
+	}
+
+	results := make([]DeploymentWithStatus, 0, len(deployments))
+	for _, deployment := range deployments {
+		statuses, _, err := client.Repositories.ListDeploymentStatuses(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			deployment.GetID(),
+			&github.ListOptions{PerPage: 1},
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list statuses for deployment %d: %w", deployment.GetID(), wrapGitHubError(err))
+		}
+
+		results = append(results, DeploymentWithStatus{
+			Deployment:   deployment,
+			LatestStatus: latestDeploymentStatus(statuses),
+		})
+	}
+
+	payloads := make([]any, 0, len(results))
+	for _, result := range results {
+		payloads = append(payloads, result)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.deployment", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.deployments", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.deployments",
+		[]any{map[string]any{"deployments": results}},
+	)
+}
+
+func deploymentsLimit(config ListDeploymentsConfiguration) int {
+	if config.Limit == nil || *config.Limit < 1 {
+		return DefaultDeploymentsLimit
+	}
+
+	return *config.Limit
+}
+
+/*
+ * GitHub returns deployment statuses from newest to oldest.
+ */
+func latestDeploymentStatus(statuses []*github.DeploymentStatus) *github.DeploymentStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	return statuses[0]
+}
+
+func (c *ListDeployments) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListDeployments) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListDeployments) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListDeployments) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListDeployments) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListDeployments) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListDeployments__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListDeployments{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "limit": 0},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"environment": "production",
+				"limit":       10,
+				"emitMode":    EmitModePerItem,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListDeployments__Limit(t *testing.T) {
+	limit := 10
+	assert.Equal(t, 10, deploymentsLimit(ListDeploymentsConfiguration{Limit: &limit}))
+	assert.Equal(t, DefaultDeploymentsLimit, deploymentsLimit(ListDeploymentsConfiguration{}))
+}
+
+func Test__ListDeployments__LatestDeploymentStatus(t *testing.T) {
+	t.Run("no statuses -> nil", func(t *testing.T) {
+		assert.Nil(t, latestDeploymentStatus(nil))
+	})
+
+	t.Run("first status is the latest", func(t *testing.T) {
+		status := latestDeploymentStatus([]*github.DeploymentStatus{
+			{State: github.Ptr("success")},
+			{State: github.Ptr("in_progress")},
+		})
+
+		require.NotNil(t, status)
+		assert.Equal(t, "success", status.GetState())
+	})
+}
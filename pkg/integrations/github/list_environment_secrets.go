@@ -0,0 +1,202 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListEnvironmentSecrets struct{}
+
+type ListEnvironmentSecretsConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	Environment string `json:"environment" mapstructure:"environment"`
+}
+
+type EnvironmentSecret struct {
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (c *ListEnvironmentSecrets) Name() string {
+	return "github.listEnvironmentSecrets"
+}
+
+func (c *ListEnvironmentSecrets) Label() string {
+	return "List Environment Secrets"
+}
+
+func (c *ListEnvironmentSecrets) Description() string {
+	return "List the names of the GitHub Actions secrets of a repository environment"
+}
+
+func (c *ListEnvironmentSecrets) Documentation() string {
+	return `The List Environment Secrets component lists the names of the GitHub Actions secrets of a deployment environment.
+
+## Use Cases
+
+- **Drift detection**: Check that an environment has all the secrets the desired configuration expects
+- **Audits**: Find secrets that were not rotated recently
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: The environment name (supports expressions)
+
+## Output
+
+Emits a single event with the secrets in ` + "`secrets`" + `, each with its ` + "`name`" + ` and ` + "`updated_at`" + `.
+
+## Notes
+
+- GitHub never returns secret values, so only names are listed
+- If the environment does not exist, the execution fails with an environment not found error`
+}
+
+func (c *ListEnvironmentSecrets) Icon() string {
+	return "github"
+}
+
+func (c *ListEnvironmentSecrets) Color() string {
+	return "gray"
+}
+
+func (c *ListEnvironmentSecrets) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListEnvironmentSecrets) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+	}
+}
+
+func (c *ListEnvironmentSecrets) Setup(ctx core.SetupContext) error {
+	var config ListEnvironmentSecretsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListEnvironmentSecrets) Execute(ctx core.ExecutionContext) error {
+	var config ListEnvironmentSecretsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	secrets, err := listEnvironmentSecrets(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.Environment)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d secrets in environment %s of %s", len(secrets), config.Environment, config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.environmentSecrets",
+		[]any{map[string]any{
+			"repository":  config.Repository,
+			"environment": config.Environment,
+			"secrets":     secrets,
+		}},
+	)
+}
+
+func listEnvironmentSecrets(client *github.Client, installationID, owner, repo, environment string) ([]EnvironmentSecret, error) {
+	//
+	// The environment secrets endpoint takes the repository ID, not its name.
+	//
+	repository, err := getRepository(client, installationID, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &github.ListOptions{PerPage: 100}
+	secrets := []EnvironmentSecret{}
+	for {
+		page, response, err := client.Actions.ListEnvSecrets(context.Background(), int(repository.GetID()), environment, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets: %w", wrapEnvironmentError(err, environment))
+		}
+
+		for _, secret := range page.Secrets {
+			secrets = append(secrets, EnvironmentSecret{Name: secret.Name, UpdatedAt: secret.UpdatedAt.Time})
+		}
+
+		if response.NextPage == 0 {
+			return secrets, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (c *ListEnvironmentSecrets) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListEnvironmentSecrets) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListEnvironmentSecrets) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListEnvironmentSecrets) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListEnvironmentSecrets) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListEnvironmentSecrets) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListEnvironmentSecrets__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListEnvironmentSecrets{}
+
+	t.Run("environment is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "environment is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "environment": "production"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListEnvironmentSecrets__List(t *testing.T) {
+	t.Run("secret names are listed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(request.URL.Path, "/secrets") {
+				return mockResponse(http.StatusOK, `{"total_count":1,"secrets":[{"name":"DEPLOY_KEY","created_at":"2026-01-01T10:00:00Z","updated_at":"2026-01-10T10:00:00Z"}]}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":123456,"name":"hello"}`), nil
+		}}
+
+		secrets, err := listEnvironmentSecrets(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", "production")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repositories/123456/environments/production/secrets", transport.requests[1].URL.Path)
+		require.Len(t, secrets, 1)
+		assert.Equal(t, "DEPLOY_KEY", secrets[0].Name)
+		assert.Equal(t, 2026, secrets[0].UpdatedAt.Year())
+	})
+
+	t.Run("environment does not exist -> environment not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(request.URL.Path, "/secrets") {
+				return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":123456,"name":"hello"}`), nil
+		}}
+
+		_, err := listEnvironmentSecrets(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", "staging")
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+	})
+}
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListEnvironmentVariables struct{}
+
+type ListEnvironmentVariablesConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	Environment string `json:"environment" mapstructure:"environment"`
+}
+
+type EnvironmentVariable struct {
+	Name      string     `json:"name"`
+	Value     string     `json:"value"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func (c *ListEnvironmentVariables) Name() string {
+	return "github.listEnvironmentVariables"
+}
+
+func (c *ListEnvironmentVariables) Label() string {
+	return "List Environment Variables"
+}
+
+func (c *ListEnvironmentVariables) Description() string {
+	return "List the GitHub Actions variables of a repository environment"
+}
+
+func (c *ListEnvironmentVariables) Documentation() string {
+	return `The List Environment Variables component lists the GitHub Actions variables of a deployment environment, with their values.
+
+## Use Cases
+
+- **Drift detection**: Compare the variables of an environment with the desired configuration
+- **Audits**: Report the configuration of each environment
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: The environment name (supports expressions)
+
+## Output
+
+Emits a single event with the variables in ` + "`variables`" + `, each with its ` + "`name`" + `, ` + "`value`" + ` and ` + "`updated_at`" + `.
+
+## Notes
+
+- Only variables are listed. Use **List Environment Secrets** for the names of the environment secrets
+- If the environment does not exist, the execution fails with an environment not found error`
+}
+
+func (c *ListEnvironmentVariables) Icon() string {
+	return "github"
+}
+
+func (c *ListEnvironmentVariables) Color() string {
+	return "gray"
+}
+
+func (c *ListEnvironmentVariables) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListEnvironmentVariables) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+	}
+}
+
+func (c *ListEnvironmentVariables) Setup(ctx core.SetupContext) error {
+	var config ListEnvironmentVariablesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListEnvironmentVariables) Execute(ctx core.ExecutionContext) error {
+	var config ListEnvironmentVariablesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	variables, err := listEnvironmentVariables(client, appMetadata.Owner, config.Repository, config.Environment)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d variables in environment %s of %s", len(variables), config.Environment, config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.environmentVariables",
+		[]any{map[string]any{
+			"repository":  config.Repository,
+			"environment": config.Environment,
+			"variables":   variables,
+		}},
+	)
+}
+
+func listEnvironmentVariables(client *github.Client, owner, repo, environment string) ([]EnvironmentVariable, error) {
+	//
+	// The variables endpoints return at most 30 variables per page.
+	//
+	opts := &github.ListOptions{PerPage: 30}
+	variables := []EnvironmentVariable{}
+	for {
+		page, response, err := client.Actions.ListEnvVariables(context.Background(), owner, repo, environment, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list variables: %w", wrapEnvironmentError(err, environment))
+		}
+
+		for _, variable := range page.Variables {
+			output := EnvironmentVariable{Name: variable.Name, Value: variable.Value}
+			if variable.UpdatedAt != nil {
+				output.UpdatedAt = &variable.UpdatedAt.Time
+			}
+
+			variables = append(variables, output)
+		}
+
+		if response.NextPage == 0 {
+			return variables, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (c *ListEnvironmentVariables) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListEnvironmentVariables) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListEnvironmentVariables) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListEnvironmentVariables) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListEnvironmentVariables) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListEnvironmentVariables) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListEnvironmentVariables__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListEnvironmentVariables{}
+
+	t.Run("environment is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "environment": " "},
+		})
+
+		require.ErrorContains(t, err, "environment is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "environment": "production"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListEnvironmentVariables__List(t *testing.T) {
+	t.Run("variables from all pages are listed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "2" {
+				return mockResponse(http.StatusOK, `{"total_count":2,"variables":[{"name":"REGION","value":"eu-west-1"}]}`), nil
+			}
+
+			response := mockResponse(http.StatusOK, `{"total_count":2,"variables":[{"name":"LOG_LEVEL","value":"info","updated_at":"2026-01-10T10:00:00Z"}]}`)
+			response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/environments/production/variables?page=2>; rel="next"`)
+			return response, nil
+		}}
+
+		variables, err := listEnvironmentVariables(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "production")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/environments/production/variables", transport.requests[0].URL.Path)
+		require.Len(t, variables, 2)
+		assert.Equal(t, "LOG_LEVEL", variables[0].Name)
+		assert.Equal(t, "info", variables[0].Value)
+		assert.NotNil(t, variables[0].UpdatedAt)
+		assert.Equal(t, EnvironmentVariable{Name: "REGION", Value: "eu-west-1"}, variables[1])
+	})
+
+	t.Run("environment does not exist -> environment not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := listEnvironmentVariables(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "staging")
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+		assert.ErrorContains(t, err, "environment staging does not exist")
+	})
+}
@@ -0,0 +1,302 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultForksLimit       = 30
+	DefaultActiveWithinDays = 90
+	ForksSortNewest         = "newest"
+	ForksSortOldest         = "oldest"
+	ForksSortStargazers     = "stargazers"
+	ForksSortWatchers       = "watchers"
+)
+
+type ListForks struct{}
+
+type ListForksConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	Sort             string `json:"sort" mapstructure:"sort"`
+	Limit            *int   `json:"limit" mapstructure:"limit"`
+	ActiveOnly       bool   `json:"activeOnly" mapstructure:"activeOnly"`
+	ActiveWithinDays *int   `json:"activeWithinDays" mapstructure:"activeWithinDays"`
+}
+
+type Fork struct {
+	Owner    string     `json:"owner"`
+	FullName string     `json:"full_name"`
+	URL      string     `json:"html_url"`
+	PushedAt *time.Time `json:"pushed_at"`
+}
+
+func (c *ListForks) Name() string {
+	return "github.listForks"
+}
+
+func (c *ListForks) Label() string {
+	return "List Forks"
+}
+
+func (c *ListForks) Description() string {
+	return "List the forks of a GitHub repository"
+}
+
+func (c *ListForks) Documentation() string {
+	return `The List Forks component lists the forks of a repository, optionally only the ones pushed to recently.
+
+## Use Cases
+
+- **Supply-chain audits**: Review who forked a repository, and which forks are still active
+- **Security review**: Find active forks of a repository after a secret leaked in its history
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Sort**: Newest, oldest, most starred, or most watched first. Defaults to newest
+- **Limit**: Maximum number of forks to list. Defaults to 30
+- **Active Only**: Leave out forks that were not pushed to recently
+- **Active Within Days**: With **Active Only**, forks not pushed to in this number of days are left out. Defaults to 90
+
+## Output
+
+Emits the forks in ` + "`forks`" + `, each with its ` + "`owner`" + `, ` + "`full_name`" + `, ` + "`html_url`" + `, and ` + "`pushed_at`" + ` time.
+
+## Notes
+
+- Only the forks of the repository itself are listed, not the forks of its forks
+- With **Active Only**, the limit applies to the active forks, so more forks may be fetched to reach it`
+}
+
+func (c *ListForks) Icon() string {
+	return "github"
+}
+
+func (c *ListForks) Color() string {
+	return "gray"
+}
+
+func (c *ListForks) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListForks) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:    "sort",
+			Label:   "Sort",
+			Type:    configuration.FieldTypeSelect,
+			Default: ForksSortNewest,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Newest", Value: ForksSortNewest},
+						{Label: "Oldest", Value: ForksSortOldest},
+						{Label: "Most starred", Value: ForksSortStargazers},
+						{Label: "Most watched", Value: ForksSortWatchers},
+					},
+				},
+			},
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultForksLimit,
+			Description: "Maximum number of forks to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "activeOnly",
+			Label:       "Active Only",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Leave out forks that were not pushed to recently",
+		},
+		{
+			Name:        "activeWithinDays",
+			Label:       "Active Within Days",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultActiveWithinDays,
+			Description: "Forks not pushed to in this number of days are left out",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "activeOnly", Values: []string{"true"}},
+			},
+		},
+	}
+}
+
+func (c *ListForks) Setup(ctx core.SetupContext) error {
+	var config ListForksConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Sort != "" && !slices.Contains([]string{ForksSortNewest, ForksSortOldest, ForksSortStargazers, ForksSortWatchers}, config.Sort) {
+		return fmt.Errorf("invalid sort: %s", config.Sort)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.ActiveWithinDays != nil && *config.ActiveWithinDays < 1 {
+		return errors.New("active within days must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListForks) Execute(ctx core.ExecutionContext) error {
+	var config ListForksConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	limit := DefaultForksLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	//
+	// A zero threshold keeps all forks.
+	//
+	var activeSince time.Time
+	if config.ActiveOnly {
+		activeWithinDays := DefaultActiveWithinDays
+		if config.ActiveWithinDays != nil {
+			activeWithinDays = *config.ActiveWithinDays
+		}
+
+		activeSince = time.Now().Add(-time.Duration(activeWithinDays) * 24 * time.Hour)
+	}
+
+	forks, err := listForks(client, appMetadata.Owner, config.Repository, config.Sort, limit, activeSince)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d forks of %s", len(forks), config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.forks",
+		[]any{map[string]any{"forks": forks}},
+	)
+}
+
+/*
+ * Lists forks until the limit is reached,
+ * leaving out the ones last pushed to before activeSince.
+ */
+func listForks(client *github.Client, owner, repo, sort string, limit int, activeSince time.Time) ([]Fork, error) {
+	opts := &github.RepositoryListForksOptions{
+		Sort:        sort,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	forks := []Fork{}
+	for {
+		page, response, err := client.Repositories.ListForks(context.Background(), owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list forks of %s: %w", repo, wrapGitHubError(err))
+		}
+
+		for _, repository := range page {
+			var pushedAt *time.Time
+			if repository.PushedAt != nil {
+				pushedAt = &repository.PushedAt.Time
+			}
+
+			if !activeSince.IsZero() && (pushedAt == nil || pushedAt.Before(activeSince)) {
+				continue
+			}
+
+			forks = append(forks, Fork{
+				Owner:    repository.GetOwner().GetLogin(),
+				FullName: repository.GetFullName(),
+				URL:      repository.GetHTMLURL(),
+				PushedAt: pushedAt,
+			})
+
+			if len(forks) == limit {
+				return forks, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return forks, nil
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+}
+
+func (c *ListForks) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListForks) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListForks) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListForks) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListForks) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListForks) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
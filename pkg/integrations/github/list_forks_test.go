@@ -0,0 +1,108 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListForks__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListForks{}
+
+	t.Run("invalid sort -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "sort": "biggest"},
+		})
+
+		require.ErrorContains(t, err, "invalid sort: biggest")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "limit": 0},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("invalid active within days -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "activeOnly": true, "activeWithinDays": 0},
+		})
+
+		require.ErrorContains(t, err, "active within days must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "sort": ForksSortStargazers, "activeOnly": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListForks__List(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		if request.URL.Query().Get("page") == "" {
+			response := mockResponse(http.StatusOK, `[
+				{"full_name":"alice/hello","html_url":"https://github.com/alice/hello","owner":{"login":"alice"},"pushed_at":"2026-01-10T00:00:00Z"},
+				{"full_name":"bob/hello","html_url":"https://github.com/bob/hello","owner":{"login":"bob"},"pushed_at":"2025-01-01T00:00:00Z"}
+			]`)
+			response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/forks?page=2>; rel="next"`)
+			return response, nil
+		}
+
+		return mockResponse(http.StatusOK, `[
+			{"full_name":"carol/hello","html_url":"https://github.com/carol/hello","owner":{"login":"carol"},"pushed_at":"2026-01-12T00:00:00Z"},
+			{"full_name":"dave/hello","html_url":"https://github.com/dave/hello","owner":{"login":"dave"}}
+		]`), nil
+	}}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+
+	t.Run("all forks are listed", func(t *testing.T) {
+		forks, err := listForks(client, "testhq", "hello", ForksSortOldest, 30, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, forks, 4)
+
+		assert.Equal(t, "alice", forks[0].Owner)
+		assert.Equal(t, "alice/hello", forks[0].FullName)
+		assert.Equal(t, "https://github.com/alice/hello", forks[0].URL)
+		assert.Equal(t, time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), *forks[0].PushedAt)
+		assert.Nil(t, forks[3].PushedAt)
+		assert.Equal(t, ForksSortOldest, transport.requests[0].URL.Query().Get("sort"))
+	})
+
+	t.Run("inactive forks are left out", func(t *testing.T) {
+		forks, err := listForks(client, "testhq", "hello", "", 30, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, forks, 2)
+		assert.Equal(t, "alice/hello", forks[0].FullName)
+		assert.Equal(t, "carol/hello", forks[1].FullName)
+	})
+
+	t.Run("forks are capped by the limit", func(t *testing.T) {
+		transport.requests = nil
+		forks, err := listForks(client, "testhq", "hello", "", 1, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, forks, 1)
+		assert.Len(t, transport.requests, 1)
+	})
+}
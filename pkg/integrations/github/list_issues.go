@@ -0,0 +1,385 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	EmitModeBatch   = "batch"
+	EmitModePerItem = "perItem"
+)
+
+type ListIssues struct{}
+
+type ListIssuesConfiguration struct {
+	Repository          string   `json:"repository" mapstructure:"repository"`
+	State               string   `json:"state" mapstructure:"state"`
+	Labels              []string `json:"labels" mapstructure:"labels"`
+	Assignee            string   `json:"assignee" mapstructure:"assignee"`
+	Since               string   `json:"since" mapstructure:"since"`
+	Sort                string   `json:"sort" mapstructure:"sort"`
+	Direction           string   `json:"direction" mapstructure:"direction"`
+	IncludePullRequests bool     `json:"includePullRequests" mapstructure:"includePullRequests"`
+	EmitMode            string   `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize           *int     `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+func (c *ListIssues) Name() string {
+	return "github.listIssues"
+}
+
+func (c *ListIssues) Label() string {
+	return "List Issues"
+}
+
+func (c *ListIssues) Description() string {
+	return "List issues in a GitHub repository"
+}
+
+func (c *ListIssues) Documentation() string {
+	return `The List Issues component lists the issues in a GitHub repository, with optional filters.
+
+## Use Cases
+
+- **Dashboards**: Collect issue data for reporting and dashboards
+- **Triage**: Find open issues with specific labels or assignees
+- **Housekeeping**: Find issues that were not updated since a given date
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **State**: Only list open, closed, or all issues
+- **Labels**: Only list issues with all of these labels
+- **Assignee**: Only list issues assigned to this user. Use ` + "`none`" + ` for unassigned issues, or ` + "`*`" + ` for issues with any assignee
+- **Since**: Only list issues updated at or after this time (RFC 3339 format, e.g. ` + "`2025-01-01T00:00:00Z`" + `)
+- **Sort** and **Direction**: How to order the results
+- **Include Pull Requests**: The GitHub API returns pull requests as issues. By default, they are excluded
+- **Emit Mode**: Emit all issues in a single event, one event per issue, or one event per chunk of issues
+- **Chunk Size**: Number of issues in each event, in chunked mode. Defaults to 10
+
+## Output
+
+- **Batch** mode emits a single event with the list of issues in ` + "`issues`" + `
+- **Per item** mode emits one ` + "`github.issue`" + ` event for each issue. If no issues are found, no events are emitted
+- **Chunked** mode emits one ` + "`github.issues`" + ` event for each chunk, with its issues in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- All pages of results are fetched, so listing issues in large repositories can take a while
+- In per item mode, the issues of each page are emitted as the page is fetched. Pages are fetched in steps of 10, and if the execution is interrupted, it resumes from the last completed step
+- In batch and chunked modes, all issues are emitted at the end, so at most 10000 issues can be listed. Use filters to narrow down larger results`
+}
+
+func (c *ListIssues) Icon() string {
+	return "github"
+}
+
+func (c *ListIssues) Color() string {
+	return "gray"
+}
+
+func (c *ListIssues) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListIssues) EventTypes() []string {
+	return []string{"github.issues", "github.issue"}
+}
+
+func (c *ListIssues) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "state",
+			Label:    "State",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "open",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Open", Value: "open"},
+						{Label: "Closed", Value: "closed"},
+						{Label: "All", Value: "all"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "labels",
+			Label: "Labels",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Label",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "assignee",
+			Label:       "Assignee",
+			Type:        configuration.FieldTypeString,
+			Description: "GitHub username, 'none' for unassigned issues, or '*' for any assignee",
+		},
+		{
+			Name:        "since",
+			Label:       "Updated Since",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., 2025-01-01T00:00:00Z",
+			Description: "Only issues updated at or after this time are returned",
+		},
+		{
+			Name:    "sort",
+			Label:   "Sort",
+			Type:    configuration.FieldTypeSelect,
+			Default: "created",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Created", Value: "created"},
+						{Label: "Updated", Value: "updated"},
+						{Label: "Comments", Value: "comments"},
+					},
+				},
+			},
+		},
+		{
+			Name:    "direction",
+			Label:   "Direction",
+			Type:    configuration.FieldTypeSelect,
+			Default: "desc",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Descending", Value: "desc"},
+						{Label: "Ascending", Value: "asc"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "includePullRequests",
+			Label:       "Include Pull Requests",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "GitHub returns pull requests as issues. Enable this to keep them in the results.",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListIssues) Setup(ctx core.SetupContext) error {
+	var config ListIssuesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListIssues) Execute(ctx core.ExecutionContext) error {
+	return c.list(ctx.Integration, ctx.ExecutionState, batchContext{
+		Configuration: ctx.Configuration,
+		Metadata:      ctx.Metadata,
+		Requests:      ctx.Requests,
+		Logger:        ctx.Logger,
+	})
+}
+
+func (c *ListIssues) list(integration core.IntegrationContext, state core.ExecutionStateContext, batchCtx batchContext) error {
+	var config ListIssuesConfiguration
+	if err := mapstructure.Decode(batchCtx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	opts, err := c.buildListOptions(config)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	fetch := func(page int) ([]any, int, error) {
+		opts.ListOptions.Page = page
+		issues, response, err := client.Issues.ListByRepo(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			opts,
+		)
+
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list issues: %w", err)
+		}
+
+		items := []any{}
+		for _, issue := range filterIssues(issues, config.IncludePullRequests) {
+			items = append(items, issue)
+		}
+
+		return items, response.NextPage, nil
+	}
+
+	if config.EmitMode == EmitModePerItem {
+		return batchEmit(batchCtx, fetch, func(issues []any, last bool) error {
+			if last {
+				return state.Emit(core.DefaultOutputChannel.Name, "github.issue", issues)
+			}
+
+			return state.EmitPartial(core.DefaultOutputChannel.Name, "github.issue", issues)
+		})
+	}
+
+	issues, err := batchFetchAll(fetch)
+	if err != nil {
+		return err
+	}
+
+	if config.EmitMode == EmitModeChunked {
+		return chunkEmit(state, core.DefaultOutputChannel.Name, "github.issues", issues, chunkSize(config.ChunkSize))
+	}
+
+	return state.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issues",
+		[]any{map[string]any{"issues": issues}},
+	)
+}
+
+func (c *ListIssues) buildListOptions(config ListIssuesConfiguration) (*github.IssueListByRepoOptions, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       config.State,
+		Assignee:    config.Assignee,
+		Labels:      config.Labels,
+		Sort:        config.Sort,
+		Direction:   config.Direction,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	if config.Since != "" {
+		since, err := time.Parse(time.RFC3339, config.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since %q: must be in RFC 3339 format", config.Since)
+		}
+
+		opts.Since = since
+	}
+
+	return opts, nil
+}
+
+func filterIssues(issues []*github.Issue, includePullRequests bool) []*github.Issue {
+	if includePullRequests {
+		return issues
+	}
+
+	return slices.DeleteFunc(issues, func(issue *github.Issue) bool {
+		return issue.PullRequestLinks != nil
+	})
+}
+
+func (c *ListIssues) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListIssues) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListIssues) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           BatchNextPageAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *ListIssues) HandleAction(ctx core.ActionContext) error {
+	if ctx.Name != BatchNextPageAction {
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	configuration, err := batchConfiguration(ctx.Metadata)
+	if err != nil {
+		return err
+	}
+
+	return c.list(ctx.Integration, ctx.ExecutionState, batchContext{
+		Configuration: configuration,
+		Metadata:      ctx.Metadata,
+		Requests:      ctx.Requests,
+		Logger:        ctx.Logger,
+	})
+}
+
+func (c *ListIssues) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListIssues) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
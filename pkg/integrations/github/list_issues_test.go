@@ -0,0 +1,137 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListIssues__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListIssues{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("invalid chunk size -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": EmitModeChunked, "chunkSize": 0},
+		})
+
+		require.ErrorContains(t, err, "chunk size must be between 1 and 100")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "emitMode": EmitModePerItem},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListIssues__BuildListOptions(t *testing.T) {
+	component := ListIssues{}
+
+	t.Run("filters are passed to the API", func(t *testing.T) {
+		since := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+		opts, err := component.buildListOptions(ListIssuesConfiguration{
+			State:     "closed",
+			Labels:    []string{"bug", "p1"},
+			Assignee:  "octocat",
+			Since:     since.Format(time.RFC3339),
+			Sort:      "updated",
+			Direction: "asc",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "closed", opts.State)
+		assert.Equal(t, []string{"bug", "p1"}, opts.Labels)
+		assert.Equal(t, "octocat", opts.Assignee)
+		assert.True(t, since.Equal(opts.Since))
+		assert.Equal(t, "updated", opts.Sort)
+		assert.Equal(t, "asc", opts.Direction)
+		assert.Equal(t, 100, opts.ListOptions.PerPage)
+	})
+
+	t.Run("invalid since -> error", func(t *testing.T) {
+		_, err := component.buildListOptions(ListIssuesConfiguration{Since: "yesterday"})
+		require.ErrorContains(t, err, "must be in RFC 3339 format")
+	})
+}
+
+func Test__ListIssues__FilterIssues(t *testing.T) {
+	newIssues := func() []*github.Issue {
+		return []*github.Issue{
+			{Number: github.Ptr(1)},
+			{Number: github.Ptr(2), PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/pulls/2")}},
+			{Number: github.Ptr(3)},
+		}
+	}
+
+	t.Run("pull requests are excluded by default", func(t *testing.T) {
+		issues := filterIssues(newIssues(), false)
+		require.Len(t, issues, 2)
+		assert.Equal(t, 1, issues[0].GetNumber())
+		assert.Equal(t, 3, issues[1].GetNumber())
+	})
+
+	t.Run("pull requests are kept when included", func(t *testing.T) {
+		issues := filterIssues(newIssues(), true)
+		require.Len(t, issues, 3)
+	})
+}
+
+func Test__ListIssues__HandleAction(t *testing.T) {
+	component := ListIssues{}
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		err := component.HandleAction(core.ActionContext{Name: "poll", ExecutionState: &contexts.ExecutionStateContext{}})
+		require.ErrorContains(t, err, "unknown action")
+	})
+
+	t.Run("finished execution -> next page is not fetched", func(t *testing.T) {
+		err := component.HandleAction(core.ActionContext{
+			Name:           BatchNextPageAction,
+			ExecutionState: &contexts.ExecutionStateContext{Finished: true},
+		})
+
+		require.NoError(t, err)
+	})
+	t.Run("no batch in progress -> error", func(t *testing.T) {
+		err := component.HandleAction(core.ActionContext{
+			Name:           BatchNextPageAction,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "no batch in progress")
+	})
+}
@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultNotificationsLimit = 50
+
+type ListNotifications struct{}
+
+type ListNotificationsConfiguration struct {
+	All           bool   `json:"all" mapstructure:"all"`
+	Participating bool   `json:"participating" mapstructure:"participating"`
+	Since         string `json:"since" mapstructure:"since"`
+	Limit         *int   `json:"limit" mapstructure:"limit"`
+}
+
+type Notification struct {
+	ID         string              `json:"id"`
+	Reason     string              `json:"reason"`
+	Unread     bool                `json:"unread"`
+	Repository string              `json:"repository"`
+	Subject    NotificationSubject `json:"subject"`
+	UpdatedAt  *time.Time          `json:"updated_at"`
+}
+
+type NotificationSubject struct {
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+func (c *ListNotifications) Name() string {
+	return "github.listNotifications"
+}
+
+func (c *ListNotifications) Label() string {
+	return "List Notifications"
+}
+
+func (c *ListNotifications) Description() string {
+	return "List the GitHub notifications of the authenticated account"
+}
+
+func (c *ListNotifications) Documentation() string {
+	return `The List Notifications component lists the notification inbox of the account the integration is authenticated as, newest first.
+
+## Use Cases
+
+- **Triage bots**: Process the mentions and review requests of a bot account
+- **Inbox cleanup**: Find notifications to mark as read with the Mark Notification Read component
+
+## Configuration
+
+- **All**: Include notifications that were already read
+- **Participating**: Only include notifications the account is directly participating in or mentioned in
+- **Since**: Only include notifications updated after this time, in RFC 3339 format (supports expressions)
+- **Limit**: Maximum number of notifications to list. Defaults to 50
+
+## Output
+
+Emits a ` + "`github.notifications`" + ` event with the notifications in ` + "`notifications`" + `, each with its thread ` + "`id`" + `, ` + "`reason`" + `, ` + "`unread`" + ` state, ` + "`repository`" + `, ` + "`updated_at`" + ` time, and ` + "`subject`" + ` with its ` + "`title`" + `, ` + "`type`" + ` and API ` + "`url`" + `.
+
+## Notes
+
+- Notifications belong to user accounts. GitHub does not give app installations access to them, so the integration must be authenticated as a user, like a bot account
+- Pages are fetched until the limit is reached, so large inboxes are not listed in full`
+}
+
+func (c *ListNotifications) Icon() string {
+	return "github"
+}
+
+func (c *ListNotifications) Color() string {
+	return "gray"
+}
+
+func (c *ListNotifications) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListNotifications) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "all",
+			Label:       "All",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Include notifications that were already read",
+		},
+		{
+			Name:        "participating",
+			Label:       "Participating",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only include notifications the account is participating in or mentioned in",
+		},
+		{
+			Name:        "since",
+			Label:       "Since",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., 2026-01-01T00:00:00Z",
+			Description: "Only include notifications updated after this time",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultNotificationsLimit,
+			Description: "Maximum number of notifications to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *ListNotifications) Setup(ctx core.SetupContext) error {
+	var config ListNotificationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.Since != "" && !strings.Contains(config.Since, "{{") {
+		if _, err := time.Parse(time.RFC3339, config.Since); err != nil {
+			return fmt.Errorf("invalid since date %q: %w", config.Since, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *ListNotifications) Execute(ctx core.ExecutionContext) error {
+	var config ListNotificationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	notifications, err := listNotifications(client, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d notifications", len(notifications))
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.notifications",
+		[]any{map[string]any{"notifications": notifications}},
+	)
+}
+
+func listNotifications(client *github.Client, config ListNotificationsConfiguration) ([]Notification, error) {
+	limit := DefaultNotificationsLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	opts := &github.NotificationListOptions{
+		All:           config.All,
+		Participating: config.Participating,
+		ListOptions:   github.ListOptions{PerPage: min(limit, 50)},
+	}
+
+	if config.Since != "" {
+		since, err := time.Parse(time.RFC3339, config.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since date %q: %w", config.Since, err)
+		}
+
+		opts.Since = since
+	}
+
+	notifications := []Notification{}
+	for {
+		page, response, err := client.Activity.ListNotifications(context.Background(), opts)
+		if err != nil {
+			return nil, notificationsError(err, "failed to list notifications")
+		}
+
+		for _, notification := range page {
+			var updatedAt *time.Time
+			if notification.UpdatedAt != nil {
+				updatedAt = &notification.UpdatedAt.Time
+			}
+
+			notifications = append(notifications, Notification{
+				ID:         notification.GetID(),
+				Reason:     notification.GetReason(),
+				Unread:     notification.GetUnread(),
+				Repository: notification.GetRepository().GetFullName(),
+				UpdatedAt:  updatedAt,
+				Subject: NotificationSubject{
+					Title: notification.GetSubject().GetTitle(),
+					Type:  notification.GetSubject().GetType(),
+					URL:   notification.GetSubject().GetURL(),
+				},
+			})
+
+			if len(notifications) == limit {
+				return notifications, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return notifications, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * GitHub answers notification requests made with an installation token with a 403,
+ * which would otherwise read like a missing app permission.
+ */
+func notificationsError(err error, message string) error {
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf("%s, notifications are only available to user accounts, not app installations: %w", message, err)
+	}
+
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+func (c *ListNotifications) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListNotifications) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListNotifications) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListNotifications) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListNotifications) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListNotifications) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,104 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListNotifications__Setup(t *testing.T) {
+	component := ListNotifications{}
+
+	t.Run("invalid since -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"since": "yesterday"},
+		})
+
+		require.ErrorContains(t, err, "invalid since date")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"limit": 0},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("since expression is not validated", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"since": "{{ $.data.since }}"},
+		}))
+	})
+}
+
+func Test__ListNotifications__List(t *testing.T) {
+	notification := func(id string) string {
+		return `{"id":"` + id + `","reason":"mention","unread":true,"updated_at":"2026-01-16T15:10:00Z",` +
+			`"repository":{"full_name":"testhq/hello"},` +
+			`"subject":{"title":"Deploy fails","type":"Issue","url":"https://api.github.com/repos/testhq/hello/issues/42"}}`
+	}
+
+	t.Run("filters are sent and notifications are mapped", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[`+notification("1")+`]`), nil
+		}}
+
+		notifications, err := listNotifications(github.NewClient(&http.Client{Transport: transport}), ListNotificationsConfiguration{
+			All:           true,
+			Participating: true,
+			Since:         "2026-01-01T00:00:00Z",
+		})
+
+		require.NoError(t, err)
+		query := transport.requests[0].URL.Query()
+		assert.Equal(t, "/notifications", transport.requests[0].URL.Path)
+		assert.Equal(t, "true", query.Get("all"))
+		assert.Equal(t, "true", query.Get("participating"))
+		assert.Equal(t, "2026-01-01T00:00:00Z", query.Get("since"))
+
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "1", notifications[0].ID)
+		assert.Equal(t, "mention", notifications[0].Reason)
+		assert.Equal(t, "testhq/hello", notifications[0].Repository)
+		assert.Equal(t, "Deploy fails", notifications[0].Subject.Title)
+		assert.Equal(t, "Issue", notifications[0].Subject.Type)
+		require.NotNil(t, notifications[0].UpdatedAt)
+	})
+
+	t.Run("pages are fetched until the limit is reached", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			response := mockResponse(http.StatusOK, `[`+notification("1")+`,`+notification("2")+`]`)
+			response.Header.Set("Link", `<https://api.github.com/notifications?page=2>; rel="next"`)
+			return response, nil
+		}}
+
+		limit := 3
+		notifications, err := listNotifications(github.NewClient(&http.Client{Transport: transport}), ListNotificationsConfiguration{Limit: &limit})
+		require.NoError(t, err)
+		assert.Len(t, notifications, 3)
+		assert.Len(t, transport.requests, 2)
+		assert.Equal(t, "3", transport.requests[0].URL.Query().Get("per_page"))
+	})
+
+	t.Run("installation tokens -> explained permission error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := listNotifications(github.NewClient(&http.Client{Transport: transport}), ListNotificationsConfiguration{})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "only available to user accounts")
+	})
+}
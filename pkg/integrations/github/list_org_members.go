@@ -0,0 +1,342 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	OrgMemberRoleAll    = "all"
+	OrgMemberRoleAdmin  = "admin"
+	OrgMemberRoleMember = "member"
+
+	DefaultOrgMembersLimit = 100
+	MaxOrgMembersLimit     = 10000
+)
+
+type ListOrgMembers struct{}
+
+type ListOrgMembersConfiguration struct {
+	Organization     string `json:"organization" mapstructure:"organization"`
+	Role             string `json:"role" mapstructure:"role"`
+	IncludeTwoFactor bool   `json:"includeTwoFactor" mapstructure:"includeTwoFactor"`
+	Limit            *int   `json:"limit" mapstructure:"limit"`
+}
+
+type OrgMember struct {
+	Login            string `json:"login"`
+	ID               int64  `json:"id"`
+	Role             string `json:"role"`
+	URL              string `json:"html_url"`
+	TwoFactorEnabled *bool  `json:"two_factor_enabled,omitempty"`
+}
+
+type OrgMembersOutput struct {
+	Organization      string      `json:"organization"`
+	Role              string      `json:"role"`
+	Members           []OrgMember `json:"members"`
+	TwoFactorDisabled []string    `json:"two_factor_disabled,omitempty"`
+}
+
+func (c *ListOrgMembers) Name() string {
+	return "github.listOrgMembers"
+}
+
+func (c *ListOrgMembers) Label() string {
+	return "List Organization Members"
+}
+
+func (c *ListOrgMembers) Description() string {
+	return "List the members of a GitHub organization, by role"
+}
+
+func (c *ListOrgMembers) Documentation() string {
+	return `The List Organization Members component lists the members of an organization, optionally filtered by role, with their two-factor authentication status.
+
+## Use Cases
+
+- **Access reviews**: Review who the owners of the organization are, on a schedule
+- **Security compliance**: Find the members without two-factor authentication
+
+## Configuration
+
+- **Organization**: The organization to list. Defaults to the organization of the integration
+- **Role**: List **All** members, only **Admins** (owners), or only **Members**
+- **Include Two-Factor Status**: Also check which members have two-factor authentication disabled
+- **Limit**: Maximum number of members to list. Defaults to 100
+
+## Output
+
+Emits a ` + "`github.orgMembers`" + ` event with the ` + "`organization`" + ` and the ` + "`members`" + `, each with its ` + "`login`" + `, ` + "`id`" + `, ` + "`role`" + ` (` + "`admin`" + ` or ` + "`member`" + `) and ` + "`html_url`" + `.
+With **Include Two-Factor Status**, each member also has ` + "`two_factor_enabled`" + `, and the logins without it are listed in ` + "`two_factor_disabled`" + `.
+
+## Notes
+
+- The GitHub App needs the **Members** organization read permission
+- GitHub only lets organization owners filter members by two-factor status. When the GitHub App cannot, the execution fails with an error saying so
+- Listing all members, instead of one role, takes an extra request per 100 admins, to find the role of each member`
+}
+
+func (c *ListOrgMembers) Icon() string {
+	return "github"
+}
+
+func (c *ListOrgMembers) Color() string {
+	return "gray"
+}
+
+func (c *ListOrgMembers) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListOrgMembers) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., acme",
+			Description: "Defaults to the organization of the integration",
+		},
+		{
+			Name:     "role",
+			Label:    "Role",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  OrgMemberRoleAll,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "All", Value: OrgMemberRoleAll},
+						{Label: "Admins", Value: OrgMemberRoleAdmin},
+						{Label: "Members", Value: OrgMemberRoleMember},
+					},
+				},
+			},
+		},
+		{
+			Name:        "includeTwoFactor",
+			Label:       "Include Two-Factor Status",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Check which members have two-factor authentication disabled",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultOrgMembersLimit,
+			Description: "Maximum number of members to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxOrgMembersLimit; return &max }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *ListOrgMembers) Setup(ctx core.SetupContext) error {
+	var config ListOrgMembersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	switch config.Role {
+	case "", OrgMemberRoleAll, OrgMemberRoleAdmin, OrgMemberRoleMember:
+	default:
+		return fmt.Errorf("invalid role: %s", config.Role)
+	}
+
+	if config.Limit != nil && (*config.Limit < 1 || *config.Limit > MaxOrgMembersLimit) {
+		return fmt.Errorf("limit must be between 1 and %d", MaxOrgMembersLimit)
+	}
+
+	return nil
+}
+
+func (c *ListOrgMembers) Execute(ctx core.ExecutionContext) error {
+	var config ListOrgMembersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	limit := DefaultOrgMembersLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	organization := cmp.Or(config.Organization, appMetadata.Owner)
+	output, err := listOrgMembers(client, organization, cmp.Or(config.Role, OrgMemberRoleAll), config.IncludeTwoFactor, limit)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d members in %s", len(output.Members), organization)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.orgMembers",
+		[]any{output},
+	)
+}
+
+func listOrgMembers(client *github.Client, organization, role string, includeTwoFactor bool, limit int) (*OrgMembersOutput, error) {
+	users, err := listOrgMemberUsers(client, organization, role, "", limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of %s: %w", organization, wrapGitHubError(err))
+	}
+
+	//
+	// Members are only listed with their role when filtering by it,
+	// so the admins are listed on their own to find the role of each member.
+	//
+	admins := map[int64]bool{}
+	if role == OrgMemberRoleAll {
+		adminUsers, err := listOrgMemberUsers(client, organization, OrgMemberRoleAdmin, "", MaxOrgMembersLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list admins of %s: %w", organization, wrapGitHubError(err))
+		}
+
+		for _, user := range adminUsers {
+			admins[user.GetID()] = true
+		}
+	}
+
+	output := &OrgMembersOutput{Organization: organization, Role: role, Members: []OrgMember{}}
+	for _, user := range users {
+		memberRole := role
+		if role == OrgMemberRoleAll {
+			memberRole = OrgMemberRoleMember
+			if admins[user.GetID()] {
+				memberRole = OrgMemberRoleAdmin
+			}
+		}
+
+		output.Members = append(output.Members, OrgMember{
+			Login: user.GetLogin(),
+			ID:    user.GetID(),
+			Role:  memberRole,
+			URL:   user.GetHTMLURL(),
+		})
+	}
+
+	if !includeTwoFactor {
+		return output, nil
+	}
+
+	disabledUsers, err := listOrgMemberUsers(client, organization, role, "2fa_disabled", MaxOrgMembersLimit)
+	if err != nil {
+		return nil, twoFactorFilterError(err, organization)
+	}
+
+	disabled := map[int64]bool{}
+	output.TwoFactorDisabled = []string{}
+	for _, user := range disabledUsers {
+		disabled[user.GetID()] = true
+	}
+
+	for i := range output.Members {
+		output.Members[i].TwoFactorEnabled = github.Ptr(!disabled[output.Members[i].ID])
+		if disabled[output.Members[i].ID] {
+			output.TwoFactorDisabled = append(output.TwoFactorDisabled, output.Members[i].Login)
+		}
+	}
+
+	return output, nil
+}
+
+func listOrgMemberUsers(client *github.Client, organization, role, filter string, limit int) ([]*github.User, error) {
+	users := []*github.User{}
+	opts := &github.ListMembersOptions{
+		Role:        role,
+		Filter:      filter,
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+
+	for {
+		page, response, err := client.Organizations.ListMembers(context.Background(), organization, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range page {
+			if len(users) >= limit {
+				return users, nil
+			}
+
+			users = append(users, user)
+		}
+
+		if response.NextPage == 0 {
+			return users, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Only organization owners can filter members by two-factor status.
+ * GitHub answers with a 403 or a 422 to anyone else.
+ */
+func twoFactorFilterError(err error, organization string) error {
+	var responseErr *github.ErrorResponse
+	if errors.As(err, &responseErr) && responseErr.Response != nil {
+		statusCode := responseErr.Response.StatusCode
+		if statusCode == http.StatusForbidden || statusCode == http.StatusUnprocessableEntity {
+			return fmt.Errorf(
+				"%w: only owners of %s can check the two-factor status of members, and the GitHub App is not allowed to: %s",
+				ErrPermissionDenied,
+				organization,
+				responseErr.Message,
+			)
+		}
+	}
+
+	return fmt.Errorf("failed to list members of %s without two-factor authentication: %w", organization, wrapGitHubError(err))
+}
+
+func (c *ListOrgMembers) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListOrgMembers) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListOrgMembers) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListOrgMembers) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListOrgMembers) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListOrgMembers) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListOrgMembers__Setup(t *testing.T) {
+	component := ListOrgMembers{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("invalid role -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"role": "owner"}), "invalid role: owner")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"role": "all", "limit": 0}), "limit must be between 1 and 10000")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"role": "admin", "includeTwoFactor": true, "limit": 50}))
+	})
+}
+
+func Test__ListOrgMembers__List(t *testing.T) {
+	members := `[{"login":"octocat","id":1},{"login":"hubot","id":2}]`
+
+	t.Run("all roles -> admins listed to find the role of each member", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("role") == "admin" {
+				return mockResponse(http.StatusOK, `[{"login":"octocat","id":1}]`), nil
+			}
+
+			return mockResponse(http.StatusOK, members), nil
+		}}
+
+		output, err := listOrgMembers(github.NewClient(&http.Client{Transport: transport}), "testhq", OrgMemberRoleAll, false, 100)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/orgs/testhq/members", transport.requests[0].URL.Path)
+		assert.Equal(t, []OrgMember{{Login: "octocat", ID: 1, Role: "admin"}, {Login: "hubot", ID: 2, Role: "member"}}, output.Members)
+		assert.Nil(t, output.TwoFactorDisabled)
+	})
+
+	t.Run("members are paginated up to the limit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			response := mockResponse(http.StatusOK, members)
+			if request.URL.Query().Get("page") == "" {
+				response.Header.Set("Link", `<https://api.github.com/orgs/testhq/members?page=2>; rel="next"`)
+			}
+
+			return response, nil
+		}}
+
+		output, err := listOrgMembers(github.NewClient(&http.Client{Transport: transport}), "testhq", OrgMemberRoleMember, false, 3)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 2)
+		assert.Len(t, output.Members, 3)
+		assert.Equal(t, "member", output.Members[2].Role)
+	})
+
+	t.Run("two-factor status -> members without it flagged", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("filter") == "2fa_disabled" {
+				return mockResponse(http.StatusOK, `[{"login":"hubot","id":2}]`), nil
+			}
+
+			return mockResponse(http.StatusOK, members), nil
+		}}
+
+		output, err := listOrgMembers(github.NewClient(&http.Client{Transport: transport}), "testhq", OrgMemberRoleMember, true, 100)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "member", transport.requests[1].URL.Query().Get("role"))
+		assert.True(t, *output.Members[0].TwoFactorEnabled)
+		assert.False(t, *output.Members[1].TwoFactorEnabled)
+		assert.Equal(t, []string{"hubot"}, output.TwoFactorDisabled)
+	})
+
+	t.Run("two-factor filter not allowed -> clear error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("filter") == "2fa_disabled" {
+				return mockResponse(http.StatusUnprocessableEntity, `{"message":"Only owners can use this filter."}`), nil
+			}
+
+			return mockResponse(http.StatusOK, members), nil
+		}}
+
+		_, err := listOrgMembers(github.NewClient(&http.Client{Transport: transport}), "testhq", OrgMemberRoleMember, true, 100)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "only owners of testhq can check the two-factor status of members")
+	})
+}
@@ -0,0 +1,332 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultOrgRepositoriesLimit = 100
+
+var OrgRepositoryTypes = []string{"all", "public", "private", "forks", "sources", "member"}
+
+type ListOrgRepositories struct{}
+
+type ListOrgRepositoriesConfiguration struct {
+	Organization    string `json:"organization" mapstructure:"organization"`
+	Type            string `json:"type" mapstructure:"type"`
+	IncludeArchived bool   `json:"includeArchived" mapstructure:"includeArchived"`
+	TopicFilter     string `json:"topicFilter" mapstructure:"topicFilter"`
+	Limit           *int   `json:"limit" mapstructure:"limit"`
+	EmitMode        string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize       *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type OrgRepository struct {
+	AccessibleRepository
+	Fork   bool     `json:"fork"`
+	Topics []string `json:"topics"`
+}
+
+func (c *ListOrgRepositories) Name() string {
+	return "github.listOrgRepositories"
+}
+
+func (c *ListOrgRepositories) Label() string {
+	return "List Organization Repositories"
+}
+
+func (c *ListOrgRepositories) Description() string {
+	return "List the repositories of a GitHub organization, with optional filters"
+}
+
+func (c *ListOrgRepositories) Documentation() string {
+	return `The List Organization Repositories component lists the repositories of a GitHub organization.
+
+## Use Cases
+
+- **Fan-out**: Run the same steps on every service repository of the organization
+- **Audits**: Find the forks, or the public repositories, of the organization
+
+## Configuration
+
+- **Organization**: The organization to list the repositories of. Defaults to the organization of the integration
+- **Type**: Only list repositories of this type: all, public, private, forks, sources or member. Defaults to all
+- **Include Archived**: Also list archived repositories
+- **Topic Filter**: Only list repositories with this topic
+- **Limit**: Maximum number of repositories to list. Defaults to 100
+- **Emit Mode**: Emit one event per repository, all repositories in a single event, or one event per chunk of repositories
+- **Chunk Size**: Number of repositories in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Repositories are listed by name. Each repository includes its ` + "`name`" + `, ` + "`full_name`" + `, ` + "`default_branch`" + `, ` + "`private`" + `,
+` + "`archived`" + `, ` + "`fork`" + ` and ` + "`topics`" + `.
+
+- **Per item** mode emits one ` + "`github.repository`" + ` event for each repository. If no repositories match, no events are emitted
+- **Batch** mode emits a single event with the list of repositories in ` + "`repositories`" + `
+- **Chunked** mode emits one ` + "`github.repositories`" + ` event for each chunk, with its repositories in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- Only the repositories the GitHub app can access are listed
+- The API cannot filter by topic or archived state, so all repositories of the type are read, and filtered afterwards`
+}
+
+func (c *ListOrgRepositories) Icon() string {
+	return "github"
+}
+
+func (c *ListOrgRepositories) Color() string {
+	return "gray"
+}
+
+func (c *ListOrgRepositories) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListOrgRepositories) EventTypes() []string {
+	return []string{"github.repositories", "github.repository"}
+}
+
+func (c *ListOrgRepositories) Configuration() []configuration.Field {
+	types := []configuration.FieldOption{}
+	for _, repositoryType := range OrgRepositoryTypes {
+		types = append(types, configuration.FieldOption{Label: strings.ToUpper(repositoryType[:1]) + repositoryType[1:], Value: repositoryType})
+	}
+
+	return []configuration.Field{
+		{
+			Name:        "organization",
+			Label:       "Organization",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., acme",
+			Description: "Leave empty to use the organization of the integration",
+		},
+		{
+			Name:     "type",
+			Label:    "Type",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "all",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: types,
+				},
+			},
+		},
+		{
+			Name:        "includeArchived",
+			Label:       "Include Archived",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Also list archived repositories",
+		},
+		{
+			Name:        "topicFilter",
+			Label:       "Topic Filter",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., service",
+			Description: "Only list repositories with this topic",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultOrgRepositoriesLimit,
+			Description: "Maximum number of repositories to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModePerItem,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListOrgRepositories) Setup(ctx core.SetupContext) error {
+	var config ListOrgRepositoriesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Type != "" && !slices.Contains(OrgRepositoryTypes, config.Type) {
+		return fmt.Errorf("invalid type: %s", config.Type)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	return validateChunkSize(config.ChunkSize)
+}
+
+func (c *ListOrgRepositories) Execute(ctx core.ExecutionContext) error {
+	var config ListOrgRepositoriesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	organization := strings.TrimSpace(config.Organization)
+	if organization == "" {
+		organization = appMetadata.Owner
+	}
+
+	repositories, err := listOrgRepositories(client, organization, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d repositories in %s", len(repositories), organization)
+
+	payloads := make([]any, 0, len(repositories))
+	for _, repository := range repositories {
+		payloads = append(payloads, repository)
+	}
+
+	switch config.EmitMode {
+	case EmitModeBatch:
+		return ctx.ExecutionState.Emit(
+			core.DefaultOutputChannel.Name,
+			"github.repositories",
+			[]any{map[string]any{"organization": organization, "repositories": repositories}},
+		)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.repositories", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.repository", payloads)
+}
+
+func listOrgRepositories(client *github.Client, organization string, config ListOrgRepositoriesConfiguration) ([]OrgRepository, error) {
+	limit := DefaultOrgRepositoriesLimit
+	if config.Limit != nil && *config.Limit > 0 {
+		limit = *config.Limit
+	}
+
+	opts := &github.RepositoryListByOrgOptions{
+		Type:        config.Type,
+		Sort:        "full_name",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	repositories := []OrgRepository{}
+	for {
+		page, response, err := client.Repositories.ListByOrg(context.Background(), organization, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories of %s: %w", organization, wrapGitHubError(err))
+		}
+
+		for _, repository := range page {
+			if !orgRepositoryMatches(repository, config) {
+				continue
+			}
+
+			repositories = append(repositories, summarizeOrgRepository(repository))
+			if len(repositories) >= limit {
+				return repositories, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return repositories, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Topics are always lowercase on GitHub.
+ */
+func orgRepositoryMatches(repository *github.Repository, config ListOrgRepositoriesConfiguration) bool {
+	if repository.GetArchived() && !config.IncludeArchived {
+		return false
+	}
+
+	topic := strings.ToLower(strings.TrimSpace(config.TopicFilter))
+	return topic == "" || slices.Contains(repository.Topics, topic)
+}
+
+func summarizeOrgRepository(repository *github.Repository) OrgRepository {
+	topics := repository.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+
+	return OrgRepository{
+		AccessibleRepository: AccessibleRepository{
+			Name:          repository.GetName(),
+			FullName:      repository.GetFullName(),
+			DefaultBranch: repository.GetDefaultBranch(),
+			Private:       repository.GetPrivate(),
+			Archived:      repository.GetArchived(),
+			URL:           repository.GetHTMLURL(),
+		},
+		Fork:   repository.GetFork(),
+		Topics: topics,
+	}
+}
+
+func (c *ListOrgRepositories) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListOrgRepositories) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListOrgRepositories) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListOrgRepositories) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListOrgRepositories) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListOrgRepositories) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
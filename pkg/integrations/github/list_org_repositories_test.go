@@ -0,0 +1,134 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListOrgRepositories__Setup(t *testing.T) {
+	component := ListOrgRepositories{}
+
+	t.Run("no configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{},
+		}))
+	})
+
+	t.Run("invalid type -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"type": "internal"},
+		})
+
+		require.ErrorContains(t, err, "invalid type")
+	})
+
+	t.Run("limit below 1 -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"limit": 0},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+}
+
+func Test__ListOrgRepositories__List(t *testing.T) {
+	firstPage := `[
+		{"name": "service-api", "full_name": "acme/service-api", "topics": ["service", "go"]},
+		{"name": "legacy-api", "full_name": "acme/legacy-api", "archived": true, "topics": ["service"]}
+	]`
+
+	secondPage := `[
+		{"name": "docs", "full_name": "acme/docs", "fork": true},
+		{"name": "service-web", "full_name": "acme/service-web", "topics": ["service"]}
+	]`
+
+	newClient := func() (*github.Client, *mockTransport) {
+		transport := &mockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Query().Get("page") == "2" {
+					return mockResponse(http.StatusOK, secondPage), nil
+				}
+
+				response := mockResponse(http.StatusOK, firstPage)
+				response.Header.Set("Link", `<https://api.github.com/orgs/acme/repos?page=2>; rel="next"`)
+				return response, nil
+			},
+		}
+
+		return github.NewClient(&http.Client{Transport: transport}), transport
+	}
+
+	t.Run("archived repositories are skipped by default", func(t *testing.T) {
+		client, transport := newClient()
+
+		repositories, err := listOrgRepositories(client, "acme", ListOrgRepositoriesConfiguration{Type: "sources"})
+		require.NoError(t, err)
+		require.Len(t, repositories, 3)
+		assert.Equal(t, "acme/service-api", repositories[0].FullName)
+		assert.True(t, repositories[1].Fork)
+		assert.Equal(t, []string{}, repositories[1].Topics)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/orgs/acme/repos", transport.requests[0].URL.Path)
+		assert.Equal(t, "sources", transport.requests[0].URL.Query().Get("type"))
+	})
+
+	t.Run("include archived and topic filter", func(t *testing.T) {
+		client, _ := newClient()
+
+		repositories, err := listOrgRepositories(client, "acme", ListOrgRepositoriesConfiguration{
+			IncludeArchived: true,
+			TopicFilter:     "Service",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, repositories, 3)
+		assert.Equal(t, "legacy-api", repositories[1].Name)
+		assert.True(t, repositories[1].Archived)
+		assert.Equal(t, "service-web", repositories[2].Name)
+	})
+
+	t.Run("limit stops pagination", func(t *testing.T) {
+		client, transport := newClient()
+
+		repositories, err := listOrgRepositories(client, "acme", ListOrgRepositoriesConfiguration{Limit: github.Ptr(1)})
+		require.NoError(t, err)
+		require.Len(t, repositories, 1)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("organization not found -> error", func(t *testing.T) {
+		transport := &mockTransport{
+			handler: func(req *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusNotFound, `{"message": "Not Found"}`), nil
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		_, err := listOrgRepositories(client, "missing", ListOrgRepositoriesConfiguration{})
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to list repositories of missing")
+	})
+}
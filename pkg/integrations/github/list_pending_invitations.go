@@ -0,0 +1,346 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	InvitationScopeRepository   = "repository"
+	InvitationScopeOrganization = "organization"
+)
+
+type ListPendingInvitations struct{}
+
+type ListPendingInvitationsConfiguration struct {
+	Scope          string `json:"scope" mapstructure:"scope"`
+	Repository     string `json:"repository" mapstructure:"repository"`
+	StaleAfterDays *int   `json:"staleAfterDays" mapstructure:"staleAfterDays"`
+}
+
+type PendingInvitation struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Role      string `json:"role"`
+	Inviter   string `json:"inviter"`
+	CreatedAt string `json:"created_at"`
+	AgeDays   int    `json:"age_days"`
+	Expired   bool   `json:"expired,omitempty"`
+}
+
+type PendingInvitationsOutput struct {
+	Scope          string              `json:"scope"`
+	Repository     string              `json:"repository,omitempty"`
+	StaleAfterDays int                 `json:"stale_after_days,omitempty"`
+	Invitations    []PendingInvitation `json:"invitations"`
+	TotalCount     int                 `json:"total_count"`
+}
+
+func (c *ListPendingInvitations) Name() string {
+	return "github.listPendingInvitations"
+}
+
+func (c *ListPendingInvitations) Label() string {
+	return "List Pending Invitations"
+}
+
+func (c *ListPendingInvitations) Description() string {
+	return "List the pending invitations to a GitHub repository or organization"
+}
+
+func (c *ListPendingInvitations) Documentation() string {
+	return `The List Pending Invitations component lists the invitations to a repository or organization that were not accepted yet.
+
+## Use Cases
+
+- **Onboarding audits**: Check that new team members accepted their invitations
+- **Invitation cleanup**: Find old invitations to re-send or cancel
+
+## Configuration
+
+- **Scope**: List the invitations to a repository, or to the organization
+- **Repository**: Select the GitHub repository, for the repository scope
+- **Stale After (days)**: Only list the invitations created at least this many days ago
+
+## Output
+
+Emits a ` + "`github.pendingInvitations`" + ` event with the ` + "`invitations`" + ` and their ` + "`total_count`" + `. Each invitation includes:
+- ` + "`id`" + `, and the ` + "`login`" + ` or ` + "`email`" + ` of the invitee
+- ` + "`role`" + `: The organization role, like ` + "`direct_member`" + ` or ` + "`admin`" + `, or the repository permission, like ` + "`write`" + `
+- ` + "`inviter`" + `: Login of the user who sent the invitation
+- ` + "`created_at`" + ` and ` + "`age_days`" + `
+- ` + "`expired`" + `: Whether a repository invitation expired
+
+## Notes
+
+- Repository invitations need the **Administration** read permission, and organization invitations the **Members** organization read permission
+- Organization invitations are listed for the organization that owns the integration's repositories`
+}
+
+func (c *ListPendingInvitations) Icon() string {
+	return "github"
+}
+
+func (c *ListPendingInvitations) Color() string {
+	return "gray"
+}
+
+func (c *ListPendingInvitations) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListPendingInvitations) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "scope",
+			Label:    "Scope",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  InvitationScopeRepository,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Repository", Value: InvitationScopeRepository},
+						{Label: "Organization", Value: InvitationScopeOrganization},
+					},
+				},
+			},
+		},
+		{
+			Name:  "repository",
+			Label: "Repository",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "scope", Values: []string{InvitationScopeRepository}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "scope", Values: []string{InvitationScopeRepository}},
+			},
+		},
+		{
+			Name:        "staleAfterDays",
+			Label:       "Stale After (days)",
+			Type:        configuration.FieldTypeNumber,
+			Togglable:   true,
+			Description: "Only list the invitations created at least this many days ago",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *ListPendingInvitations) Setup(ctx core.SetupContext) error {
+	var config ListPendingInvitationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.StaleAfterDays != nil && *config.StaleAfterDays < 1 {
+		return errors.New("stale after days must be greater than 0")
+	}
+
+	switch config.Scope {
+	case InvitationScopeOrganization:
+		return nil
+	case "", InvitationScopeRepository:
+		return ensureRepoInMetadata(
+			ctx.Metadata,
+			ctx.Integration,
+			ctx.Configuration,
+		)
+	}
+
+	return fmt.Errorf("invalid scope: %s", config.Scope)
+}
+
+func (c *ListPendingInvitations) Execute(ctx core.ExecutionContext) error {
+	var config ListPendingInvitationsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := listPendingInvitations(client, appMetadata.Owner, config, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d pending invitations", output.TotalCount)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pendingInvitations",
+		[]any{output},
+	)
+}
+
+func listPendingInvitations(client *github.Client, owner string, config ListPendingInvitationsConfiguration, now time.Time) (*PendingInvitationsOutput, error) {
+	scope := config.Scope
+	if scope == "" {
+		scope = InvitationScopeRepository
+	}
+
+	output := &PendingInvitationsOutput{Scope: scope, Invitations: []PendingInvitation{}}
+	if scope == InvitationScopeRepository {
+		output.Repository = config.Repository
+	}
+
+	if config.StaleAfterDays != nil {
+		output.StaleAfterDays = *config.StaleAfterDays
+	}
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		var invitations []PendingInvitation
+		var response *github.Response
+		var err error
+		if scope == InvitationScopeOrganization {
+			invitations, response, err = listOrgInvitationsPage(client, owner, opts)
+		} else {
+			invitations, response, err = listRepositoryInvitationsPage(client, owner, config.Repository, opts)
+		}
+
+		if err != nil {
+			return nil, invitationsError(err, scope)
+		}
+
+		for _, invitation := range invitations {
+			invitation.AgeDays = invitationAgeDays(invitation.CreatedAt, now)
+			if output.StaleAfterDays > 0 && invitation.AgeDays < output.StaleAfterDays {
+				continue
+			}
+
+			output.Invitations = append(output.Invitations, invitation)
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	output.TotalCount = len(output.Invitations)
+	return output, nil
+}
+
+func listOrgInvitationsPage(client *github.Client, org string, opts *github.ListOptions) ([]PendingInvitation, *github.Response, error) {
+	invitations, response, err := client.Organizations.ListPendingOrgInvitations(context.Background(), org, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := []PendingInvitation{}
+	for _, invitation := range invitations {
+		pending = append(pending, PendingInvitation{
+			ID:        invitation.GetID(),
+			Login:     invitation.GetLogin(),
+			Email:     invitation.GetEmail(),
+			Role:      invitation.GetRole(),
+			Inviter:   invitation.GetInviter().GetLogin(),
+			CreatedAt: invitation.GetCreatedAt().Format(time.RFC3339),
+		})
+	}
+
+	return pending, response, nil
+}
+
+func listRepositoryInvitationsPage(client *github.Client, owner, repo string, opts *github.ListOptions) ([]PendingInvitation, *github.Response, error) {
+	invitations, response, err := client.Repositories.ListInvitations(context.Background(), owner, repo, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := []PendingInvitation{}
+	for _, invitation := range invitations {
+		pending = append(pending, PendingInvitation{
+			ID:        invitation.GetID(),
+			Login:     invitation.GetInvitee().GetLogin(),
+			Email:     invitation.GetInvitee().GetEmail(),
+			Role:      invitation.GetPermissions(),
+			Inviter:   invitation.GetInviter().GetLogin(),
+			CreatedAt: invitation.GetCreatedAt().Format(time.RFC3339),
+			Expired:   invitation.GetExpired(),
+		})
+	}
+
+	return pending, response, nil
+}
+
+func invitationAgeDays(createdAt string, now time.Time) int {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return 0
+	}
+
+	return int(now.Sub(created).Hours() / 24)
+}
+
+/*
+ * Each scope needs a different app permission,
+ * and GitHub only answers with a 403 when it is missing.
+ */
+func invitationsError(err error, scope string) error {
+	err = wrapGitHubError(err)
+	if !errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf("failed to list %s invitations: %w", scope, err)
+	}
+
+	permission := "Administration"
+	if scope == InvitationScopeOrganization {
+		permission = "organization Members"
+	}
+
+	return fmt.Errorf("failed to list %s invitations, the GitHub app needs the %s read permission: %w", scope, permission, err)
+}
+
+func (c *ListPendingInvitations) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListPendingInvitations) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListPendingInvitations) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListPendingInvitations) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListPendingInvitations) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListPendingInvitations) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
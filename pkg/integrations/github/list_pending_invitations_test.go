@@ -0,0 +1,110 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListPendingInvitations__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListPendingInvitations{}
+
+	t.Run("invalid scope -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": "enterprise"},
+		})
+
+		require.ErrorContains(t, err, "invalid scope: enterprise")
+	})
+
+	t.Run("organization scope does not need a repository", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": InvitationScopeOrganization, "staleAfterDays": 14},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"scope": InvitationScopeRepository, "repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListPendingInvitations__List(t *testing.T) {
+	now := time.Date(2026, 1, 16, 12, 0, 0, 0, time.UTC)
+
+	t.Run("repository invitations of every page are listed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "2" {
+				return mockResponse(http.StatusOK, `[{"id":2,"invitee":{"login":"hubot"},"inviter":{"login":"octocat"},"permissions":"read","created_at":"2026-01-15T12:00:00Z"}]`), nil
+			}
+
+			response := mockResponse(http.StatusOK, `[{"id":1,"invitee":{"login":"monalisa"},"inviter":{"login":"octocat"},"permissions":"write","created_at":"2025-12-17T12:00:00Z","expired":true}]`)
+			response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/invitations?page=2>; rel="next"`)
+			return response, nil
+		}}
+
+		config := ListPendingInvitationsConfiguration{Repository: "hello"}
+		output, err := listPendingInvitations(github.NewClient(&http.Client{Transport: transport}), "testhq", config, now)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, "/repos/testhq/hello/invitations", transport.requests[0].URL.Path)
+		assert.Equal(t, 2, output.TotalCount)
+		assert.Equal(t, PendingInvitation{
+			ID:        1,
+			Login:     "monalisa",
+			Role:      "write",
+			Inviter:   "octocat",
+			CreatedAt: "2025-12-17T12:00:00Z",
+			AgeDays:   30,
+			Expired:   true,
+		}, output.Invitations[0])
+		assert.Equal(t, 1, output.Invitations[1].AgeDays)
+	})
+
+	t.Run("stale after days -> only old organization invitations", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `[
+				{"id":1,"email":"new@example.com","role":"direct_member","inviter":{"login":"octocat"},"created_at":"2026-01-10T12:00:00Z"},
+				{"id":2,"login":"monalisa","role":"admin","inviter":{"login":"octocat"},"created_at":"2026-01-01T12:00:00Z"}
+			]`), nil
+		}}
+
+		staleAfterDays := 7
+		config := ListPendingInvitationsConfiguration{Scope: InvitationScopeOrganization, StaleAfterDays: &staleAfterDays}
+		output, err := listPendingInvitations(github.NewClient(&http.Client{Transport: transport}), "testhq", config, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, "/orgs/testhq/invitations", transport.requests[0].URL.Path)
+		require.Len(t, output.Invitations, 1)
+		assert.Equal(t, "monalisa", output.Invitations[0].Login)
+		assert.Equal(t, 15, output.Invitations[0].AgeDays)
+	})
+
+	t.Run("missing permission -> error names the permission", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := listPendingInvitations(github.NewClient(&http.Client{Transport: transport}), "testhq", ListPendingInvitationsConfiguration{Scope: InvitationScopeOrganization}, now)
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "organization Members read permission")
+	})
+}
@@ -0,0 +1,251 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListPullRequestCommits struct{}
+
+type ListPullRequestCommitsConfiguration struct {
+	Repository    string `json:"repository" mapstructure:"repository"`
+	PullNumber    string `json:"pullNumber" mapstructure:"pullNumber"`
+	FirstLineOnly bool   `json:"firstLineOnly" mapstructure:"firstLineOnly"`
+	EmitMode      string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize     *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+func (c *ListPullRequestCommits) Name() string {
+	return "github.listPullRequestCommits"
+}
+
+func (c *ListPullRequestCommits) Label() string {
+	return "List Pull Request Commits"
+}
+
+func (c *ListPullRequestCommits) Description() string {
+	return "List the commits of a GitHub pull request"
+}
+
+func (c *ListPullRequestCommits) Documentation() string {
+	return `The List Pull Request Commits component lists the commits of a pull request, oldest first.
+
+## Use Cases
+
+- **Commit message linting**: Check every commit of a pull request against the commit conventions
+- **Sign-off checks**: Verify each commit has a ` + "`Signed-off-by`" + ` trailer
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+- **First Line Only**: Only keep the first line of each commit message, its subject
+- **Emit Mode**: Emit all commits in a single event, one event per commit, or one event per chunk of commits
+- **Chunk Size**: Number of commits in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each commit includes its ` + "`sha`" + `, ` + "`message`" + `, ` + "`author`" + `, and ` + "`date`" + `.
+
+- **Batch** mode emits a single event with the pull request ` + "`number`" + ` and the list of commits in ` + "`commits`" + `
+- **Per item** mode emits one ` + "`github.commit`" + ` event for each commit
+- **Chunked** mode emits one event for each chunk, with its commits in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- Unlike List Commits, which lists the history of a branch, only the commits of the pull request are listed
+- GitHub lists at most 250 commits for a pull request`
+}
+
+func (c *ListPullRequestCommits) Icon() string {
+	return "github"
+}
+
+func (c *ListPullRequestCommits) Color() string {
+	return "gray"
+}
+
+func (c *ListPullRequestCommits) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListPullRequestCommits) EventTypes() []string {
+	return []string{"github.pullRequestCommits", "github.commit"}
+}
+
+func (c *ListPullRequestCommits) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "firstLineOnly",
+			Label:       "First Line Only",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only keep the first line of each commit message",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListPullRequestCommits) Setup(ctx core.SetupContext) error {
+	var config ListPullRequestCommitsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListPullRequestCommits) Execute(ctx core.ExecutionContext) error {
+	var config ListPullRequestCommitsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	summaries, err := listPullRequestCommits(client, appMetadata.Owner, config.Repository, pullNumber, config.FirstLineOnly)
+	if err != nil {
+		return err
+	}
+
+	payloads := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		payloads = append(payloads, summary)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.commit", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.pullRequestCommits", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequestCommits",
+		[]any{map[string]any{"number": pullNumber, "commits": summaries}},
+	)
+}
+
+func listPullRequestCommits(client *github.Client, owner, repo string, number int, firstLineOnly bool) ([]CommitSummary, error) {
+	summaries := []CommitSummary{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		commits, response, err := client.PullRequests.ListCommits(context.Background(), owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list commits of pull request %d: %w", number, wrapGitHubError(err))
+		}
+
+		for _, commit := range commits {
+			summary := summarizeCommit(commit)
+			if !firstLineOnly {
+				summary.Message = commit.GetCommit().GetMessage()
+			}
+
+			summaries = append(summaries, summary)
+		}
+
+		if response.NextPage == 0 {
+			return summaries, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (c *ListPullRequestCommits) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListPullRequestCommits) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListPullRequestCommits) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListPullRequestCommits) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListPullRequestCommits) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListPullRequestCommits) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListPullRequestCommits__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListPullRequestCommits{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListPullRequestCommits__List(t *testing.T) {
+	transport := func() *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "" {
+				response := mockResponse(http.StatusOK, `[{"sha":"a1","commit":{"message":"feat: add login\n\nSigned-off-by: Octo Cat","author":{"name":"Octo Cat"}},"author":{"login":"octocat"}}]`)
+				response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/pulls/42/commits?page=2>; rel="next"`)
+				return response, nil
+			}
+
+			return mockResponse(http.StatusOK, `[{"sha":"b2","commit":{"message":"fix typo","author":{"name":"Mona"}}}]`), nil
+		}}
+	}
+
+	t.Run("full messages", func(t *testing.T) {
+		transport := transport()
+		commits, err := listPullRequestCommits(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, false)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "a1", commits[0].SHA)
+		assert.Equal(t, "octocat", commits[0].Author)
+		assert.Equal(t, "feat: add login\n\nSigned-off-by: Octo Cat", commits[0].Message)
+		assert.Equal(t, "Mona", commits[1].Author)
+		assert.Equal(t, "/repos/testhq/hello/pulls/42/commits", transport.requests[0].URL.Path)
+	})
+
+	t.Run("first line only", func(t *testing.T) {
+		commits, err := listPullRequestCommits(github.NewClient(&http.Client{Transport: transport()}), "testhq", "hello", 42, true)
+		require.NoError(t, err)
+		assert.Equal(t, "feat: add login", commits[0].Message)
+	})
+}
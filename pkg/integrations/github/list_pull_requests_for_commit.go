@@ -0,0 +1,265 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListPullRequestsForCommit struct{}
+
+type ListPullRequestsForCommitConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	SHA        string `json:"sha" mapstructure:"sha"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type PullRequestSummary struct {
+	Number  int    `json:"number"`
+	State   string `json:"state"`
+	Title   string `json:"title"`
+	Merged  bool   `json:"merged"`
+	HeadRef string `json:"head_ref"`
+	BaseRef string `json:"base_ref"`
+	Author  string `json:"author"`
+	URL     string `json:"html_url"`
+}
+
+func (c *ListPullRequestsForCommit) Name() string {
+	return "github.listPullRequestsForCommit"
+}
+
+func (c *ListPullRequestsForCommit) Label() string {
+	return "List Pull Requests for Commit"
+}
+
+func (c *ListPullRequestsForCommit) Description() string {
+	return "List the GitHub pull requests that contain a commit"
+}
+
+func (c *ListPullRequestsForCommit) Documentation() string {
+	return `The List Pull Requests for Commit component lists the pull requests that contain a given commit.
+
+## Use Cases
+
+- **Deploy notifications**: Map a failing deploy SHA back to its pull request, and notify its author
+- **Release notes**: Find the pull request a commit was merged with
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **SHA**: The commit SHA (supports expressions)
+- **Emit Mode**: Emit all pull requests in a single event, one event per pull request, or one event per chunk of pull requests
+- **Chunk Size**: Number of pull requests in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each pull request includes its ` + "`number`" + `, ` + "`state`" + `, ` + "`title`" + `, ` + "`merged`" + ` flag, and ` + "`author`" + `.
+
+- **Batch** mode emits a single event with the list of pull requests in ` + "`pull_requests`" + `. If the commit is not in any pull request, the list is empty
+- **Per item** mode emits one ` + "`github.pullRequest`" + ` event for each pull request. If the commit is not in any pull request, no events are emitted
+- **Chunked** mode emits one ` + "`github.pullRequests`" + ` event for each chunk, with its pull requests in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- For commits on the default branch, only the pull request the commit was merged with is returned`
+}
+
+func (c *ListPullRequestsForCommit) Icon() string {
+	return "github"
+}
+
+func (c *ListPullRequestsForCommit) Color() string {
+	return "gray"
+}
+
+func (c *ListPullRequestsForCommit) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListPullRequestsForCommit) EventTypes() []string {
+	return []string{"github.pullRequests", "github.pullRequest"}
+}
+
+func (c *ListPullRequestsForCommit) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "sha",
+			Label:       "SHA",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.head_sha}}",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListPullRequestsForCommit) Setup(ctx core.SetupContext) error {
+	var config ListPullRequestsForCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.SHA == "" {
+		return errors.New("sha is required")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListPullRequestsForCommit) Execute(ctx core.ExecutionContext) error {
+	var config ListPullRequestsForCommitConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	summaries, err := listPullRequestsForCommit(client, appMetadata.Owner, config.Repository, config.SHA)
+	if err != nil {
+		return err
+	}
+
+	if len(summaries) == 0 {
+		ctx.Logger.Infof("No pull requests found for commit %s", config.SHA)
+	}
+
+	payloads := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		payloads = append(payloads, summary)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.pullRequest", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.pullRequests", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequests",
+		[]any{map[string]any{"pull_requests": summaries}},
+	)
+}
+
+func listPullRequestsForCommit(client *github.Client, owner, repository, sha string) ([]PullRequestSummary, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	summaries := []PullRequestSummary{}
+	for {
+		pullRequests, response, err := client.PullRequests.ListPullRequestsWithCommit(
+			context.Background(),
+			owner,
+			repository,
+			sha,
+			opts,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests for commit %s: %w", sha, wrapGitHubError(err))
+		}
+
+		for _, pullRequest := range pullRequests {
+			summaries = append(summaries, summarizePullRequest(pullRequest))
+		}
+
+		if response.NextPage == 0 {
+			return summaries, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func summarizePullRequest(pullRequest *github.PullRequest) PullRequestSummary {
+	return PullRequestSummary{
+		Number:  pullRequest.GetNumber(),
+		State:   pullRequest.GetState(),
+		Title:   pullRequest.GetTitle(),
+		Merged:  pullRequest.MergedAt != nil,
+		HeadRef: pullRequest.GetHead().GetRef(),
+		BaseRef: pullRequest.GetBase().GetRef(),
+		Author:  pullRequest.GetUser().GetLogin(),
+		URL:     pullRequest.GetHTMLURL(),
+	}
+}
+
+func (c *ListPullRequestsForCommit) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListPullRequestsForCommit) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListPullRequestsForCommit) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListPullRequestsForCommit) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListPullRequestsForCommit) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListPullRequestsForCommit) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListPullRequestsForCommit__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListPullRequestsForCommit{}
+
+	t.Run("sha is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "sha is required")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "sha": "abc123", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("invalid chunk size -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "sha": "abc123", "emitMode": EmitModeChunked, "chunkSize": 101},
+		})
+
+		require.ErrorContains(t, err, "chunk size must be between 1 and 100")
+	})
+
+	t.Run("chunked mode is accepted", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "sha": "abc123", "emitMode": EmitModeChunked, "chunkSize": 5},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "sha": "abc123"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListPullRequestsForCommit__Summarize(t *testing.T) {
+	t.Run("merged pull request", func(t *testing.T) {
+		summary := summarizePullRequest(&github.PullRequest{
+			Number:   github.Ptr(42),
+			State:    github.Ptr("closed"),
+			Title:    github.Ptr("Add retries"),
+			MergedAt: &github.Timestamp{Time: time.Now()},
+			Head:     &github.PullRequestBranch{Ref: github.Ptr("retries")},
+			Base:     &github.PullRequestBranch{Ref: github.Ptr("main")},
+			User:     &github.User{Login: github.Ptr("octocat")},
+		})
+
+		assert.Equal(t, 42, summary.Number)
+		assert.Equal(t, "closed", summary.State)
+		assert.True(t, summary.Merged)
+		assert.Equal(t, "retries", summary.HeadRef)
+		assert.Equal(t, "main", summary.BaseRef)
+		assert.Equal(t, "octocat", summary.Author)
+	})
+
+	t.Run("open pull request", func(t *testing.T) {
+		summary := summarizePullRequest(&github.PullRequest{Number: github.Ptr(7), State: github.Ptr("open")})
+		assert.Equal(t, "open", summary.State)
+		assert.False(t, summary.Merged)
+	})
+}
@@ -0,0 +1,260 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListRepositoryLabels struct{}
+
+type ListRepositoryLabelsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	NameFilter string `json:"nameFilter" mapstructure:"nameFilter"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type RepositoryLabel struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+func (c *ListRepositoryLabels) Name() string {
+	return "github.listRepositoryLabels"
+}
+
+func (c *ListRepositoryLabels) Label() string {
+	return "List Repository Labels"
+}
+
+func (c *ListRepositoryLabels) Description() string {
+	return "List the labels of a GitHub repository"
+}
+
+func (c *ListRepositoryLabels) Documentation() string {
+	return `The List Repository Labels component lists the labels defined in a repository.
+
+## Use Cases
+
+- **Label sync**: Compare the labels of a repository with a standard set, and add the missing ones with **Add Labels**
+- **Audits**: Report on labels that do not follow naming conventions
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Name Filter**: Optional glob matched against the label name, for example ` + "`priority/*`" + `
+- **Emit Mode**: Emit all labels in a single event, one event per label, or one event per chunk of labels
+- **Chunk Size**: Number of labels in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each label includes its ` + "`name`" + `, ` + "`color`" + `, ` + "`description`" + `, and whether it is one of the GitHub ` + "`default`" + ` labels.
+
+- **Batch** mode emits a single event with the list of labels in ` + "`labels`" + `
+- **Per item** mode emits one ` + "`github.label`" + ` event for each label. If no labels match, no events are emitted
+- **Chunked** mode emits one ` + "`github.repositoryLabels`" + ` event for each chunk, with its labels in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + ``
+}
+
+func (c *ListRepositoryLabels) Icon() string {
+	return "github"
+}
+
+func (c *ListRepositoryLabels) Color() string {
+	return "gray"
+}
+
+func (c *ListRepositoryLabels) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListRepositoryLabels) EventTypes() []string {
+	return []string{"github.repositoryLabels", "github.label"}
+}
+
+func (c *ListRepositoryLabels) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "nameFilter",
+			Label:       "Name Filter",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., priority/*",
+			Description: "Only include labels whose name matches this glob",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListRepositoryLabels) Setup(ctx core.SetupContext) error {
+	var config ListRepositoryLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	if _, err := path.Match(config.NameFilter, ""); err != nil {
+		return fmt.Errorf("invalid name filter %q: %w", config.NameFilter, err)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListRepositoryLabels) Execute(ctx core.ExecutionContext) error {
+	var config ListRepositoryLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	labels, err := listRepositoryLabels(client, appMetadata.Owner, config.Repository, config.NameFilter)
+	if err != nil {
+		return err
+	}
+
+	payloads := make([]any, 0, len(labels))
+	for _, label := range labels {
+		payloads = append(payloads, label)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.label", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.repositoryLabels", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.repositoryLabels",
+		[]any{map[string]any{"labels": labels}},
+	)
+}
+
+func listRepositoryLabels(client *github.Client, owner, repository, nameFilter string) ([]RepositoryLabel, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	labels := []RepositoryLabel{}
+	for {
+		page, response, err := client.Issues.ListLabels(context.Background(), owner, repository, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", wrapGitHubError(err))
+		}
+
+		matching, err := filterRepositoryLabels(page, nameFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, matching...)
+		if response.NextPage == 0 {
+			return labels, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func filterRepositoryLabels(labels []*github.Label, nameFilter string) ([]RepositoryLabel, error) {
+	result := []RepositoryLabel{}
+	for _, label := range labels {
+		if nameFilter != "" {
+			match, err := path.Match(nameFilter, label.GetName())
+			if err != nil {
+				return nil, fmt.Errorf("invalid name filter %q: %w", nameFilter, err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		result = append(result, RepositoryLabel{
+			Name:        label.GetName(),
+			Color:       label.GetColor(),
+			Description: label.GetDescription(),
+			Default:     label.GetDefault(),
+		})
+	}
+
+	return result, nil
+}
+
+func (c *ListRepositoryLabels) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListRepositoryLabels) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListRepositoryLabels) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListRepositoryLabels) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListRepositoryLabels) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListRepositoryLabels) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
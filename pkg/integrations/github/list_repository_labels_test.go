@@ -0,0 +1,97 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListRepositoryLabels__Setup(t *testing.T) {
+	component := ListRepositoryLabels{}
+
+	t.Run("invalid name filter -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "nameFilter": "priority/["},
+		})
+
+		require.ErrorContains(t, err, "invalid name filter")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("repository is not accessible -> error", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}},
+			},
+		}
+
+		err := component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "world"},
+		})
+
+		require.ErrorContains(t, err, "repository world is not accessible to app installation")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}},
+			},
+		}
+
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "nameFilter": "priority/*"},
+		}))
+	})
+}
+
+func Test__ListRepositoryLabels__Filter(t *testing.T) {
+	labels := []*github.Label{
+		{Name: github.Ptr("priority/high"), Color: github.Ptr("d73a4a"), Description: github.Ptr("Urgent")},
+		{Name: github.Ptr("priority/low"), Color: github.Ptr("c2e0c6")},
+		{Name: github.Ptr("bug"), Color: github.Ptr("ee0701"), Default: github.Ptr(true)},
+	}
+
+	t.Run("no filter -> all labels", func(t *testing.T) {
+		result, err := filterRepositoryLabels(labels, "")
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		assert.True(t, result[2].Default)
+	})
+
+	t.Run("glob filter -> matching labels", func(t *testing.T) {
+		result, err := filterRepositoryLabels(labels, "priority/*")
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "priority/high", result[0].Name)
+		assert.Equal(t, "d73a4a", result[0].Color)
+		assert.Equal(t, "Urgent", result[0].Description)
+		assert.Equal(t, "", result[1].Description)
+	})
+
+	t.Run("no matches -> empty list", func(t *testing.T) {
+		result, err := filterRepositoryLabels(labels, "area/*")
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type ListSecretScanningAlerts struct{}
+
+type ListSecretScanningAlertsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	State      string `json:"state" mapstructure:"state"`
+	EmitMode   string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize  *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+/*
+ * SecretScanningAlertOutput is the data we emit for secret scanning alerts.
+ * The secret itself is never included, so it does not end up in event data.
+ */
+type SecretScanningAlertOutput struct {
+	Number                int                                   `json:"number"`
+	State                 string                                `json:"state"`
+	SecretType            string                                `json:"secret_type"`
+	SecretTypeDisplayName string                                `json:"secret_type_display_name"`
+	Resolution            string                                `json:"resolution,omitempty"`
+	ResolutionComment     string                                `json:"resolution_comment,omitempty"`
+	Validity              string                                `json:"validity,omitempty"`
+	URL                   string                                `json:"html_url"`
+	CreatedAt             string                                `json:"created_at,omitempty"`
+	Locations             []*github.SecretScanningAlertLocation `json:"locations,omitempty"`
+}
+
+func (c *ListSecretScanningAlerts) Name() string {
+	return "github.listSecretScanningAlerts"
+}
+
+func (c *ListSecretScanningAlerts) Label() string {
+	return "List Secret Scanning Alerts"
+}
+
+func (c *ListSecretScanningAlerts) Description() string {
+	return "List secret scanning alerts in a GitHub repository"
+}
+
+func (c *ListSecretScanningAlerts) Documentation() string {
+	return `The List Secret Scanning Alerts component lists the secret scanning alerts of a GitHub repository, with the locations of each leaked secret.
+
+## Use Cases
+
+- **Incident response**: Notify the security team about open alerts, with the files where the secrets were found
+- **Reporting**: Collect secret scanning data for dashboards
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **State**: Only list open, resolved, or all alerts
+- **Emit Mode**: Emit all alerts in a single event, one event per alert, or one event per chunk of alerts
+- **Chunk Size**: Number of alerts in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Each alert includes its ` + "`number`" + `, ` + "`secret_type`" + `, ` + "`state`" + `, and ` + "`locations`" + `.
+
+- **Batch** mode emits a single event with the list of alerts in ` + "`alerts`" + `
+- **Per item** mode emits one ` + "`github.secretScanningAlert`" + ` event for each alert. If no alerts are found, no events are emitted
+- **Chunked** mode emits one ` + "`github.secretScanningAlerts`" + ` event for each chunk, with its alerts in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + `
+
+## Notes
+
+- The secret values are never included in the output
+- Locations are fetched with one extra request per alert
+- Secret scanning must be enabled for the repository`
+}
+
+func (c *ListSecretScanningAlerts) Icon() string {
+	return "github"
+}
+
+func (c *ListSecretScanningAlerts) Color() string {
+	return "gray"
+}
+
+func (c *ListSecretScanningAlerts) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListSecretScanningAlerts) EventTypes() []string {
+	return []string{"github.secretScanningAlerts", "github.secretScanningAlert"}
+}
+
+func (c *ListSecretScanningAlerts) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "state",
+			Label:    "State",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "open",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Open", Value: "open"},
+						{Label: "Resolved", Value: "resolved"},
+						{Label: "All", Value: "all"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListSecretScanningAlerts) Setup(ctx core.SetupContext) error {
+	var config ListSecretScanningAlertsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListSecretScanningAlerts) Execute(ctx core.ExecutionContext) error {
+	var config ListSecretScanningAlertsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	opts := &github.SecretScanningAlertListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	if config.State != "all" {
+		opts.State = config.State
+	}
+
+	alerts := []*github.SecretScanningAlert{}
+	for {
+		page, response, err := client.SecretScanning.ListAlertsForRepo(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			opts,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list secret scanning alerts: %w", wrapFeatureError(err, "secret scanning"))
+		}
+
+		alerts = append(alerts, page...)
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	outputs := make([]SecretScanningAlertOutput, 0, len(alerts))
+	for _, alert := range alerts {
+		locations, _, err := client.SecretScanning.ListLocationsForAlert(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			int64(alert.GetNumber()),
+			&github.ListOptions{PerPage: 100},
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to list locations for alert %d: %w", alert.GetNumber(), wrapGitHubError(err))
+		}
+
+		output := buildSecretScanningAlertOutput(alert)
+		output.Locations = locations
+		outputs = append(outputs, output)
+	}
+
+	payloads := make([]any, 0, len(outputs))
+	for _, output := range outputs {
+		payloads = append(payloads, output)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.secretScanningAlert", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.secretScanningAlerts", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.secretScanningAlerts",
+		[]any{map[string]any{"alerts": outputs}},
+	)
+}
+
+func buildSecretScanningAlertOutput(alert *github.SecretScanningAlert) SecretScanningAlertOutput {
+	output := SecretScanningAlertOutput{
+		Number:                alert.GetNumber(),
+		State:                 alert.GetState(),
+		SecretType:            alert.GetSecretType(),
+		SecretTypeDisplayName: alert.GetSecretTypeDisplayName(),
+		Resolution:            alert.GetResolution(),
+		ResolutionComment:     alert.GetResolutionComment(),
+		Validity:              alert.GetValidity(),
+		URL:                   alert.GetHTMLURL(),
+	}
+
+	if alert.CreatedAt != nil {
+		output.CreatedAt = alert.CreatedAt.Format(time.RFC3339)
+	}
+
+	return output
+}
+
+func (c *ListSecretScanningAlerts) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListSecretScanningAlerts) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListSecretScanningAlerts) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListSecretScanningAlerts) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListSecretScanningAlerts) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListSecretScanningAlerts) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
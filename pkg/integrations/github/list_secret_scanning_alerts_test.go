@@ -0,0 +1,68 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListSecretScanningAlerts__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListSecretScanningAlerts{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid emit mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "emitMode": "stream"},
+		})
+
+		require.ErrorContains(t, err, "invalid emit mode")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "state": "open", "emitMode": EmitModePerItem},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListSecretScanningAlerts__BuildOutput(t *testing.T) {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	output := buildSecretScanningAlertOutput(&github.SecretScanningAlert{
+		Number:     github.Ptr(2),
+		State:      github.Ptr("open"),
+		SecretType: github.Ptr("github_personal_access_token"),
+		Secret:     github.Ptr("ghp_supersecret"),
+		CreatedAt:  &github.Timestamp{Time: createdAt},
+	})
+
+	assert.Equal(t, 2, output.Number)
+	assert.Equal(t, "open", output.State)
+	assert.Equal(t, createdAt.Format(time.RFC3339), output.CreatedAt)
+
+	data, err := json.Marshal(output)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "ghp_supersecret")
+}
@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	RunnerScopeRepository   = "repository"
+	RunnerScopeOrganization = "organization"
+)
+
+type ListSelfHostedRunners struct{}
+
+type ListSelfHostedRunnersConfiguration struct {
+	Scope      string `json:"scope" mapstructure:"scope"`
+	Repository string `json:"repository" mapstructure:"repository"`
+}
+
+type SelfHostedRunner struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	OS     string   `json:"os"`
+	Status string   `json:"status"`
+	Busy   bool     `json:"busy"`
+	Labels []string `json:"labels"`
+}
+
+type SelfHostedRunnersOutput struct {
+	Scope          string             `json:"scope"`
+	Runners        []SelfHostedRunner `json:"runners"`
+	TotalCount     int                `json:"total_count"`
+	OnlineCount    int                `json:"online_count"`
+	AvailableCount int                `json:"available_count"`
+}
+
+func (c *ListSelfHostedRunners) Name() string {
+	return "github.listSelfHostedRunners"
+}
+
+func (c *ListSelfHostedRunners) Label() string {
+	return "List Self-Hosted Runners"
+}
+
+func (c *ListSelfHostedRunners) Description() string {
+	return "List the self-hosted GitHub Actions runners of a repository or organization, with their status"
+}
+
+func (c *ListSelfHostedRunners) Documentation() string {
+	return `The List Self-Hosted Runners component lists the self-hosted GitHub Actions runners registered to a repository or organization, with their status.
+
+## Use Cases
+
+- **Fleet monitoring**: Alert when runners go offline
+- **Capacity checks**: Check that runners are available before dispatching a workflow
+
+## Configuration
+
+- **Scope**: List the runners of a repository, or of the organization
+- **Repository**: Select the GitHub repository, for the repository scope
+
+## Output
+
+Emits a ` + "`github.selfHostedRunners`" + ` event with the ` + "`runners`" + `, each with its ` + "`id`" + `, ` + "`name`" + `, ` + "`os`" + `, ` + "`status`" + ` (` + "`online`" + ` or ` + "`offline`" + `), ` + "`busy`" + ` flag and ` + "`labels`" + `, and the counts:
+- ` + "`total_count`" + `: All runners
+- ` + "`online_count`" + `: Runners that are online
+- ` + "`available_count`" + `: Runners that are online and not busy
+
+## Notes
+
+- Repository runners need the **Administration** read permission, and organization runners the **Self-hosted runners** organization permission.
+  Without them, the execution fails with an error naming the missing permission
+- Organization runners are listed for the organization that owns the integration's repositories`
+}
+
+func (c *ListSelfHostedRunners) Icon() string {
+	return "github"
+}
+
+func (c *ListSelfHostedRunners) Color() string {
+	return "gray"
+}
+
+func (c *ListSelfHostedRunners) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListSelfHostedRunners) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "scope",
+			Label:    "Scope",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  RunnerScopeRepository,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Repository", Value: RunnerScopeRepository},
+						{Label: "Organization", Value: RunnerScopeOrganization},
+					},
+				},
+			},
+		},
+		{
+			Name:  "repository",
+			Label: "Repository",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "scope", Values: []string{RunnerScopeRepository}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "scope", Values: []string{RunnerScopeRepository}},
+			},
+		},
+	}
+}
+
+func (c *ListSelfHostedRunners) Setup(ctx core.SetupContext) error {
+	var config ListSelfHostedRunnersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	switch config.Scope {
+	case RunnerScopeOrganization:
+		return nil
+	case "", RunnerScopeRepository:
+		return ensureRepoInMetadata(
+			ctx.Metadata,
+			ctx.Integration,
+			ctx.Configuration,
+		)
+	}
+
+	return fmt.Errorf("invalid scope: %s", config.Scope)
+}
+
+func (c *ListSelfHostedRunners) Execute(ctx core.ExecutionContext) error {
+	var config ListSelfHostedRunnersConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := listSelfHostedRunners(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d runners, %d online, %d available", output.TotalCount, output.OnlineCount, output.AvailableCount)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.selfHostedRunners",
+		[]any{output},
+	)
+}
+
+func listSelfHostedRunners(client *github.Client, owner string, config ListSelfHostedRunnersConfiguration) (*SelfHostedRunnersOutput, error) {
+	scope := config.Scope
+	if scope == "" {
+		scope = RunnerScopeRepository
+	}
+
+	output := &SelfHostedRunnersOutput{Scope: scope, Runners: []SelfHostedRunner{}}
+	opts := &github.ListRunnersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		var runners *github.Runners
+		var response *github.Response
+		var err error
+		if scope == RunnerScopeOrganization {
+			runners, response, err = client.Actions.ListOrganizationRunners(context.Background(), owner, opts)
+		} else {
+			runners, response, err = client.Actions.ListRunners(context.Background(), owner, config.Repository, opts)
+		}
+
+		if err != nil {
+			return nil, runnersError(err, scope)
+		}
+
+		for _, runner := range runners.Runners {
+			labels := []string{}
+			for _, label := range runner.Labels {
+				labels = append(labels, label.GetName())
+			}
+
+			output.Runners = append(output.Runners, SelfHostedRunner{
+				ID:     runner.GetID(),
+				Name:   runner.GetName(),
+				OS:     runner.GetOS(),
+				Status: runner.GetStatus(),
+				Busy:   runner.GetBusy(),
+				Labels: labels,
+			})
+
+			if runner.GetStatus() == "online" {
+				output.OnlineCount++
+				if !runner.GetBusy() {
+					output.AvailableCount++
+				}
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	output.TotalCount = len(output.Runners)
+	return output, nil
+}
+
+/*
+ * Each scope needs a different app permission,
+ * and GitHub only answers with a 403 when it is missing.
+ */
+func runnersError(err error, scope string) error {
+	err = wrapGitHubError(err)
+	if !errors.Is(err, ErrPermissionDenied) {
+		return fmt.Errorf("failed to list %s runners: %w", scope, err)
+	}
+
+	permission := "Administration"
+	if scope == RunnerScopeOrganization {
+		permission = "organization Self-hosted runners"
+	}
+
+	return fmt.Errorf("failed to list %s runners, the GitHub app needs the %s read permission: %w", scope, permission, err)
+}
+
+func (c *ListSelfHostedRunners) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListSelfHostedRunners) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListSelfHostedRunners) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListSelfHostedRunners) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListSelfHostedRunners) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListSelfHostedRunners) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListSelfHostedRunners__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListSelfHostedRunners{}
+
+	t.Run("invalid scope -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": "enterprise"},
+		})
+
+		require.ErrorContains(t, err, "invalid scope: enterprise")
+	})
+
+	t.Run("organization scope does not need a repository", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"scope": RunnerScopeOrganization},
+		}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"scope": RunnerScopeRepository, "repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListSelfHostedRunners__List(t *testing.T) {
+	t.Run("runners of every page are listed and counted", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "2" {
+				return mockResponse(http.StatusOK, `{"total_count":3,"runners":[{"id":25,"name":"builder-3","os":"linux","status":"offline","busy":false,"labels":[]}]}`), nil
+			}
+
+			response := mockResponse(http.StatusOK, `{"total_count":3,"runners":[
+				{"id":23,"name":"builder-1","os":"linux","status":"online","busy":true,"labels":[{"name":"self-hosted"}]},
+				{"id":24,"name":"builder-2","os":"linux","status":"online","busy":false,"labels":[{"name":"self-hosted"},{"name":"x64"}]}
+			]}`)
+
+			response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/actions/runners?page=2>; rel="next"`)
+			return response, nil
+		}}
+
+		output, err := listSelfHostedRunners(github.NewClient(&http.Client{Transport: transport}), "testhq", ListSelfHostedRunnersConfiguration{Repository: "hello"})
+		require.NoError(t, err)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "/repos/testhq/hello/actions/runners", transport.requests[0].URL.Path)
+		assert.Equal(t, RunnerScopeRepository, output.Scope)
+		assert.Equal(t, 3, output.TotalCount)
+		assert.Equal(t, 2, output.OnlineCount)
+		assert.Equal(t, 1, output.AvailableCount)
+		assert.Equal(t, SelfHostedRunner{ID: 24, Name: "builder-2", OS: "linux", Status: "online", Labels: []string{"self-hosted", "x64"}}, output.Runners[1])
+	})
+
+	t.Run("organization runners are listed for the owner", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"total_count":0,"runners":[]}`), nil
+		}}
+
+		output, err := listSelfHostedRunners(github.NewClient(&http.Client{Transport: transport}), "testhq", ListSelfHostedRunnersConfiguration{Scope: RunnerScopeOrganization})
+		require.NoError(t, err)
+		assert.Equal(t, "/orgs/testhq/actions/runners", transport.requests[0].URL.Path)
+		assert.Empty(t, output.Runners)
+	})
+
+	t.Run("missing permission -> error naming it", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		_, err := listSelfHostedRunners(github.NewClient(&http.Client{Transport: transport}), "testhq", ListSelfHostedRunnersConfiguration{Scope: RunnerScopeOrganization})
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "organization Self-hosted runners read permission")
+	})
+}
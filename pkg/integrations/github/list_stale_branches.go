@@ -0,0 +1,272 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultStaleAfterDays     = 90
+	DefaultStaleBranchesLimit = 100
+	staleBranchDay            = 24 * time.Hour
+)
+
+type ListStaleBranches struct{}
+
+type ListStaleBranchesConfiguration struct {
+	Repository     string `json:"repository" mapstructure:"repository"`
+	StaleAfterDays *int   `json:"staleAfterDays" mapstructure:"staleAfterDays"`
+	Limit          *int   `json:"limit" mapstructure:"limit"`
+}
+
+type StaleBranch struct {
+	Name         string     `json:"name"`
+	SHA          string     `json:"sha"`
+	Author       string     `json:"author"`
+	LastCommitAt *time.Time `json:"last_commit_at"`
+	AgeDays      int        `json:"age_days"`
+}
+
+func (c *ListStaleBranches) Name() string {
+	return "github.listStaleBranches"
+}
+
+func (c *ListStaleBranches) Label() string {
+	return "List Stale Branches"
+}
+
+func (c *ListStaleBranches) Description() string {
+	return "List the branches of a GitHub repository with no recent commits"
+}
+
+func (c *ListStaleBranches) Documentation() string {
+	return `The List Stale Branches component lists the branches of a repository whose last commit is older than a threshold.
+
+## Use Cases
+
+- **Branch cleanup**: Find stale branches, and delete them in a later step
+- **Repository hygiene**: Report abandoned work to the branch authors
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Stale After Days**: Branches whose last commit is older than this number of days are stale. Defaults to 90
+- **Limit**: Maximum number of stale branches to list. Defaults to 100
+
+## Output
+
+Emits a single event with the stale branches in ` + "`branches`" + `, from the oldest to the most recent.
+Each branch includes its ` + "`name`" + `, the ` + "`sha`" + ` and ` + "`author`" + ` of its last commit, ` + "`last_commit_at`" + `, and ` + "`age_days`" + `.
+
+## Notes
+
+- The default branch and protected branches are never listed
+- The last commit of each branch is fetched with one extra request per branch, so this can be slow for repositories with many branches`
+}
+
+func (c *ListStaleBranches) Icon() string {
+	return "github"
+}
+
+func (c *ListStaleBranches) Color() string {
+	return "gray"
+}
+
+func (c *ListStaleBranches) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListStaleBranches) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "staleAfterDays",
+			Label:       "Stale After Days",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultStaleAfterDays,
+			Description: "Branches whose last commit is older than this number of days are stale",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultStaleBranchesLimit,
+			Description: "Maximum number of stale branches to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *ListStaleBranches) Setup(ctx core.SetupContext) error {
+	var config ListStaleBranchesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.StaleAfterDays != nil && *config.StaleAfterDays < 1 {
+		return errors.New("stale after days must be greater than 0")
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListStaleBranches) Execute(ctx core.ExecutionContext) error {
+	var config ListStaleBranchesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	staleAfterDays := DefaultStaleAfterDays
+	if config.StaleAfterDays != nil {
+		staleAfterDays = *config.StaleAfterDays
+	}
+
+	limit := DefaultStaleBranchesLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	branches, err := listStaleBranches(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, staleAfterDays, limit, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d stale branches in %s", len(branches), config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.staleBranches",
+		[]any{map[string]any{
+			"branches":         branches,
+			"stale_after_days": staleAfterDays,
+		}},
+	)
+}
+
+func listStaleBranches(client *github.Client, installationID, owner, repo string, staleAfterDays, limit int, now time.Time) ([]StaleBranch, error) {
+	repository, err := getRepository(client, installationID, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := now.Add(-time.Duration(staleAfterDays) * staleBranchDay)
+	stale := []StaleBranch{}
+	opts := &github.BranchListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		branches, response, err := client.Repositories.ListBranches(context.Background(), owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list branches of %s: %w", repo, wrapGitHubError(err))
+		}
+
+		for _, branch := range branches {
+			if branch.GetProtected() || branch.GetName() == repository.GetDefaultBranch() {
+				continue
+			}
+
+			commit, _, err := client.Repositories.GetCommit(context.Background(), owner, repo, branch.GetCommit().GetSHA(), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get last commit of branch %s: %w", branch.GetName(), wrapGitHubError(err))
+			}
+
+			committedAt := commit.GetCommit().GetCommitter().GetDate().Time
+			if !committedAt.Before(threshold) {
+				continue
+			}
+
+			stale = append(stale, StaleBranch{
+				Name:         branch.GetName(),
+				SHA:          commit.GetSHA(),
+				Author:       commit.GetCommit().GetAuthor().GetName(),
+				LastCommitAt: &committedAt,
+				AgeDays:      int(now.Sub(committedAt) / staleBranchDay),
+			})
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	slices.SortStableFunc(stale, func(a, b StaleBranch) int {
+		return a.LastCommitAt.Compare(*b.LastCommitAt)
+	})
+
+	if len(stale) > limit {
+		stale = stale[:limit]
+	}
+
+	return stale, nil
+}
+
+func (c *ListStaleBranches) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListStaleBranches) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListStaleBranches) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListStaleBranches) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListStaleBranches) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListStaleBranches) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
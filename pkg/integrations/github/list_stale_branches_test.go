@@ -0,0 +1,106 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListStaleBranches__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListStaleBranches{}
+
+	t.Run("invalid stale after days -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "staleAfterDays": 0},
+		})
+
+		require.ErrorContains(t, err, "stale after days must be greater than 0")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "limit": 0},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "staleAfterDays": 30},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListStaleBranches__List(t *testing.T) {
+	now := time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)
+	commitDates := map[string]string{
+		"sha-main":   "2026-01-15T00:00:00Z",
+		"sha-old":    "2025-06-01T00:00:00Z",
+		"sha-older":  "2025-01-01T00:00:00Z",
+		"sha-recent": "2026-01-10T00:00:00Z",
+		"sha-locked": "2024-01-01T00:00:00Z",
+	}
+
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		switch request.URL.Path {
+		case "/repos/testhq/hello":
+			return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"main"}`), nil
+		case "/repos/testhq/hello/branches":
+			if request.URL.Query().Get("page") == "" {
+				response := mockResponse(http.StatusOK, `[
+					{"name":"main","commit":{"sha":"sha-main"}},
+					{"name":"old","commit":{"sha":"sha-old"}},
+					{"name":"release","protected":true,"commit":{"sha":"sha-locked"}}
+				]`)
+				response.Header.Set("Link", `<https://api.github.com/repos/testhq/hello/branches?page=2>; rel="next"`)
+				return response, nil
+			}
+
+			return mockResponse(http.StatusOK, `[
+				{"name":"recent","commit":{"sha":"sha-recent"}},
+				{"name":"older","commit":{"sha":"sha-older"}}
+			]`), nil
+		}
+
+		sha := request.URL.Path[len("/repos/testhq/hello/commits/"):]
+		return mockResponse(http.StatusOK, `{"sha":"`+sha+`","commit":{"author":{"name":"Octocat"},"committer":{"date":"`+commitDates[sha]+`"}}}`), nil
+	}}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+
+	t.Run("stale branches are listed from the oldest", func(t *testing.T) {
+		branches, err := listStaleBranches(client, "1001", "testhq", "hello", 90, 100, now)
+		require.NoError(t, err)
+		require.Len(t, branches, 2)
+
+		assert.Equal(t, "older", branches[0].Name)
+		assert.Equal(t, "sha-older", branches[0].SHA)
+		assert.Equal(t, "Octocat", branches[0].Author)
+		assert.Equal(t, 380, branches[0].AgeDays)
+		assert.Equal(t, "old", branches[1].Name)
+	})
+
+	t.Run("stale branches are capped by the limit", func(t *testing.T) {
+		branches, err := listStaleBranches(client, "1001", "testhq", "hello", 90, 1, now)
+		require.NoError(t, err)
+		require.Len(t, branches, 1)
+		assert.Equal(t, "older", branches[0].Name)
+	})
+}
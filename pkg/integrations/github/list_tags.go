@@ -0,0 +1,352 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ListTagsModeAll    = "all"
+	ListTagsModeLatest = "latest"
+)
+
+// Semantic version tag pattern: optional "v" prefix, major, minor, patch, pre-release, build metadata
+var semverTagRegex = regexp.MustCompile(`^[vV]?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?$`)
+
+type ListTags struct{}
+
+type ListTagsConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Mode       string `json:"mode" mapstructure:"mode"`
+	SemverSort bool   `json:"semverSort" mapstructure:"semverSort"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+	SHA  string `json:"sha"`
+}
+
+type LatestTagOutput struct {
+	Found bool   `json:"found"`
+	Name  string `json:"name,omitempty"`
+	SHA   string `json:"sha,omitempty"`
+}
+
+type semverTag struct {
+	tag        Tag
+	version    [3]uint64
+	prerelease []string
+}
+
+func (c *ListTags) Name() string {
+	return "github.listTags"
+}
+
+func (c *ListTags) Label() string {
+	return "List Tags"
+}
+
+func (c *ListTags) Description() string {
+	return "List the tags of a GitHub repository, or get the latest semantic version tag"
+}
+
+func (c *ListTags) Documentation() string {
+	return `The List Tags component lists the tags of a repository, optionally sorted by semantic version, or gets the highest semantic version tag.
+
+## Use Cases
+
+- **Release flows**: Get the latest version tag, and compute the next version from it
+- **Deployment**: Find the tags to deploy, newest version first
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Mode**: List all tags, or only get the latest semantic version tag
+- **Sort by Semantic Version**: In list mode, sort tags from the highest to the lowest version. Tags that are not semantic versions are left out
+
+## Output
+
+- **All** mode emits a ` + "`github.tags`" + ` event with the tags in ` + "`tags`" + `, each with its ` + "`name`" + ` and commit ` + "`sha`" + `
+- **Latest** mode emits a ` + "`github.latestTag`" + ` event with the ` + "`name`" + ` and ` + "`sha`" + ` of the highest semantic version tag. ` + "`found`" + ` is false if the repository has no semantic version tags
+
+## Notes
+
+- Tags may have a ` + "`v`" + ` prefix, like ` + "`v1.2.3`" + `
+- Versions are compared following the semantic versioning rules, so ` + "`1.2.0-rc.1`" + ` is lower than ` + "`1.2.0`" + `, and build metadata is ignored
+- Without semantic version sorting, tags are listed in the order GitHub returns them`
+}
+
+func (c *ListTags) Icon() string {
+	return "github"
+}
+
+func (c *ListTags) Color() string {
+	return "gray"
+}
+
+func (c *ListTags) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListTags) EventTypes() []string {
+	return []string{"github.tags", "github.latestTag"}
+}
+
+func (c *ListTags) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ListTagsModeAll,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "All", Value: ListTagsModeAll},
+						{Label: "Latest", Value: ListTagsModeLatest},
+					},
+				},
+			},
+		},
+		{
+			Name:        "semverSort",
+			Label:       "Sort by Semantic Version",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Sort tags from the highest to the lowest version, leaving out tags that are not semantic versions",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ListTagsModeAll}},
+			},
+		},
+	}
+}
+
+func (c *ListTags) Setup(ctx core.SetupContext) error {
+	var config ListTagsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Mode != "" && !slices.Contains([]string{ListTagsModeAll, ListTagsModeLatest}, config.Mode) {
+		return fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListTags) Execute(ctx core.ExecutionContext) error {
+	var config ListTagsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	tags, err := listTags(client, appMetadata.Owner, config.Repository)
+	if err != nil {
+		return err
+	}
+
+	if config.Mode == ListTagsModeLatest {
+		return ctx.ExecutionState.Emit(
+			core.DefaultOutputChannel.Name,
+			"github.latestTag",
+			[]any{latestSemverTag(tags)},
+		)
+	}
+
+	if config.SemverSort {
+		tags = sortSemverTags(tags)
+	}
+
+	ctx.Logger.Infof("Found %d tags in %s", len(tags), config.Repository)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.tags",
+		[]any{map[string]any{"tags": tags}},
+	)
+}
+
+func listTags(client *github.Client, owner, repository string) ([]Tag, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	tags := []Tag{}
+	for {
+		page, response, err := client.Repositories.ListTags(context.Background(), owner, repository, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags: %w", wrapGitHubError(err))
+		}
+
+		for _, tag := range page {
+			tags = append(tags, Tag{Name: tag.GetName(), SHA: tag.GetCommit().GetSHA()})
+		}
+
+		if response.NextPage == 0 {
+			return tags, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Returns the semantic version tags, from the highest to the lowest version.
+ * Tags that are not semantic versions are left out.
+ */
+func sortSemverTags(tags []Tag) []Tag {
+	versions := []semverTag{}
+	for _, tag := range tags {
+		if version, ok := parseSemverTag(tag); ok {
+			versions = append(versions, version)
+		}
+	}
+
+	slices.SortStableFunc(versions, func(a, b semverTag) int {
+		return compareSemver(b, a)
+	})
+
+	sorted := make([]Tag, 0, len(versions))
+	for _, version := range versions {
+		sorted = append(sorted, version.tag)
+	}
+
+	return sorted
+}
+
+func latestSemverTag(tags []Tag) LatestTagOutput {
+	sorted := sortSemverTags(tags)
+	if len(sorted) == 0 {
+		return LatestTagOutput{Found: false}
+	}
+
+	return LatestTagOutput{Found: true, Name: sorted[0].Name, SHA: sorted[0].SHA}
+}
+
+func parseSemverTag(tag Tag) (semverTag, bool) {
+	matches := semverTagRegex.FindStringSubmatch(tag.Name)
+	if matches == nil {
+		return semverTag{}, false
+	}
+
+	version := semverTag{tag: tag}
+	for i := range version.version {
+		number, err := strconv.ParseUint(matches[i+1], 10, 64)
+		if err != nil {
+			return semverTag{}, false
+		}
+
+		version.version[i] = number
+	}
+
+	if matches[4] != "" {
+		version.prerelease = strings.Split(matches[4], ".")
+	}
+
+	return version, true
+}
+
+/*
+ * Compares two versions following the semantic versioning precedence rules:
+ * a version without pre-release identifiers is higher than one with them,
+ * and pre-release identifiers are compared one by one, numerically if both are numbers.
+ */
+func compareSemver(a, b semverTag) int {
+	for i := range a.version {
+		if result := cmp.Compare(a.version[i], b.version[i]); result != 0 {
+			return result
+		}
+	}
+
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		if result := comparePrereleaseIdentifier(a.prerelease[i], b.prerelease[i]); result != 0 {
+			return result
+		}
+	}
+
+	return cmp.Compare(len(a.prerelease), len(b.prerelease))
+}
+
+func comparePrereleaseIdentifier(a, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+func (c *ListTags) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListTags) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListTags) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListTags) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListTags) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListTags) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListTags__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListTags{}
+
+	t.Run("invalid mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "mode": "oldest"},
+		})
+
+		require.ErrorContains(t, err, "invalid mode: oldest")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "mode": "latest"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListTags__List(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		if request.URL.Query().Get("page") == "2" {
+			return mockResponse(http.StatusOK, `[{"name":"v1.10.0","commit":{"sha":"c3"}}]`), nil
+		}
+
+		response := mockResponse(http.StatusOK, `[{"name":"v1.2.0","commit":{"sha":"c1"}},{"name":"nightly","commit":{"sha":"c2"}}]`)
+		response.Header.Set("Link", `<https://api.github.com/repositories/1/tags?per_page=100&page=2>; rel="next"`)
+		return response, nil
+	}}
+
+	tags, err := listTags(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello")
+	require.NoError(t, err)
+	require.Len(t, transport.requests, 2)
+	assert.Equal(t, []Tag{{Name: "v1.2.0", SHA: "c1"}, {Name: "nightly", SHA: "c2"}, {Name: "v1.10.0", SHA: "c3"}}, tags)
+}
+
+func Test__ListTags__SemverSort(t *testing.T) {
+	names := func(tags []Tag) []string {
+		result := []string{}
+		for _, tag := range tags {
+			result = append(result, tag.Name)
+		}
+
+		return result
+	}
+
+	tags := []Tag{
+		{Name: "v1.2.0"},
+		{Name: "nightly"},
+		{Name: "1.10.0"},
+		{Name: "v1.2.0-rc.1"},
+		{Name: "v1.2.0-rc.10"},
+		{Name: "v1.2.0-rc.2"},
+		{Name: "v1.2.0-beta"},
+		{Name: "v1.2"},
+		{Name: "v0.9.1+build.5"},
+	}
+
+	t.Run("tags are sorted from the highest version, non-semver tags are left out", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"1.10.0",
+			"v1.2.0",
+			"v1.2.0-rc.10",
+			"v1.2.0-rc.2",
+			"v1.2.0-rc.1",
+			"v1.2.0-beta",
+			"v0.9.1+build.5",
+		}, names(sortSemverTags(tags)))
+	})
+
+	t.Run("latest -> highest version", func(t *testing.T) {
+		assert.Equal(t, LatestTagOutput{Found: true, Name: "1.10.0"}, latestSemverTag(tags))
+	})
+
+	t.Run("no semver tags -> not found", func(t *testing.T) {
+		assert.Equal(t, LatestTagOutput{Found: false}, latestSemverTag([]Tag{{Name: "nightly"}}))
+	})
+}
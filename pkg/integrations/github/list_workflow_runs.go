@@ -0,0 +1,401 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultWorkflowRunsLimit = 30
+
+type ListWorkflowRuns struct{}
+
+type ListWorkflowRunsConfiguration struct {
+	Repository       string `json:"repository" mapstructure:"repository"`
+	WorkflowFileName string `json:"workflowFileName" mapstructure:"workflowFileName"`
+	Branch           string `json:"branch" mapstructure:"branch"`
+	Status           string `json:"status" mapstructure:"status"`
+	Conclusion       string `json:"conclusion" mapstructure:"conclusion"`
+	Event            string `json:"event" mapstructure:"event"`
+	Limit            *int   `json:"limit" mapstructure:"limit"`
+	LatestPerBranch  bool   `json:"latestPerBranch" mapstructure:"latestPerBranch"`
+	EmitMode         string `json:"emitMode" mapstructure:"emitMode"`
+	ChunkSize        *int   `json:"chunkSize" mapstructure:"chunkSize"`
+}
+
+type WorkflowRunSummary struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Conclusion string     `json:"conclusion"`
+	HeadBranch string     `json:"head_branch"`
+	HeadSHA    string     `json:"head_sha"`
+	Event      string     `json:"event"`
+	RunNumber  int        `json:"run_number"`
+	URL        string     `json:"html_url"`
+	CreatedAt  *time.Time `json:"created_at"`
+}
+
+func (c *ListWorkflowRuns) Name() string {
+	return "github.listWorkflowRuns"
+}
+
+func (c *ListWorkflowRuns) Label() string {
+	return "List Workflow Runs"
+}
+
+func (c *ListWorkflowRuns) Description() string {
+	return "List GitHub Actions workflow runs, with optional filters"
+}
+
+func (c *ListWorkflowRuns) Documentation() string {
+	return `The List Workflow Runs component lists recent GitHub Actions workflow runs in a repository.
+
+## Use Cases
+
+- **Dashboards**: Collect recent CI results for reporting
+- **Health checks**: Find failed runs on the default branch
+- **Release checks**: Check the latest result of a workflow on each branch
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Workflow File**: Only list runs of this workflow (e.g. ` + "`ci.yml`" + `). If empty, runs of all workflows are listed
+- **Branch**: Only list runs for this branch
+- **Status**: Only list runs with this status (e.g. ` + "`completed`" + `, ` + "`in_progress`" + `)
+- **Conclusion**: Only list runs with this conclusion (e.g. ` + "`success`" + `, ` + "`failure`" + `)
+- **Event**: Only list runs triggered by this event (e.g. ` + "`push`" + `, ` + "`pull_request`" + `)
+- **Limit**: Maximum number of runs to list. Defaults to 30
+- **Latest Per Branch**: Only keep the most recent run for each branch
+- **Emit Mode**: Emit all runs in a single event, one event per run, or one event per chunk of runs
+- **Chunk Size**: Number of runs in each event, in chunked mode. Defaults to 10
+
+## Output
+
+Runs are listed from newest to oldest. Each run includes its ` + "`id`" + `, ` + "`status`" + `, ` + "`conclusion`" + `, ` + "`head_branch`" + `, and ` + "`head_sha`" + `.
+
+- **Batch** mode emits a single event with the list of runs in ` + "`runs`" + `
+- **Per item** mode emits one ` + "`github.workflowRun`" + ` event for each run. If no runs are found, no events are emitted
+- **Chunked** mode emits one ` + "`github.workflowRuns`" + ` event for each chunk, with its runs in ` + "`items`" + `, and its position in ` + "`chunk`" + ` and ` + "`chunks`" + ``
+}
+
+func (c *ListWorkflowRuns) Icon() string {
+	return "github"
+}
+
+func (c *ListWorkflowRuns) Color() string {
+	return "gray"
+}
+
+func (c *ListWorkflowRuns) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ListWorkflowRuns) EventTypes() []string {
+	return []string{"github.workflowRuns", "github.workflowRun"}
+}
+
+func (c *ListWorkflowRuns) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "workflowFileName",
+			Label:       "Workflow File",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., ci.yml",
+			Description: "Leave empty to list runs of all workflows",
+		},
+		{
+			Name:  "branch",
+			Label: "Branch",
+			Type:  configuration.FieldTypeString,
+		},
+		{
+			Name:  "status",
+			Label: "Status",
+			Type:  configuration.FieldTypeSelect,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Queued", Value: "queued"},
+						{Label: "In progress", Value: "in_progress"},
+						{Label: "Completed", Value: "completed"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "conclusion",
+			Label: "Conclusion",
+			Type:  configuration.FieldTypeSelect,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Success", Value: "success"},
+						{Label: "Failure", Value: "failure"},
+						{Label: "Cancelled", Value: "cancelled"},
+						{Label: "Skipped", Value: "skipped"},
+						{Label: "Timed out", Value: "timed_out"},
+						{Label: "Action required", Value: "action_required"},
+						{Label: "Neutral", Value: "neutral"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "event",
+			Label:       "Event",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., push",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultWorkflowRunsLimit,
+			Description: "Maximum number of runs to list",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "latestPerBranch",
+			Label:       "Latest Per Branch",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only keep the most recent run for each branch",
+		},
+		{
+			Name:     "emitMode",
+			Label:    "Emit Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  EmitModeBatch,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Batch", Value: EmitModeBatch},
+						{Label: "Per item", Value: EmitModePerItem},
+						{Label: "Chunked", Value: EmitModeChunked},
+					},
+				},
+			},
+		},
+		ChunkSizeField,
+	}
+}
+
+func (c *ListWorkflowRuns) Setup(ctx core.SetupContext) error {
+	var config ListWorkflowRunsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Limit != nil && *config.Limit < 1 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	if config.EmitMode != "" && !slices.Contains([]string{EmitModeBatch, EmitModePerItem, EmitModeChunked}, config.EmitMode) {
+		return fmt.Errorf("invalid emit mode: %s", config.EmitMode)
+	}
+
+	if err := validateChunkSize(config.ChunkSize); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ListWorkflowRuns) Execute(ctx core.ExecutionContext) error {
+	var config ListWorkflowRunsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	limit := workflowRunsLimit(config)
+	opts := buildWorkflowRunsOptions(config, limit)
+	runs := []*github.WorkflowRun{}
+	summaries := []WorkflowRunSummary{}
+	for {
+		page, response, err := listWorkflowRunsPage(client, appMetadata.Owner, config, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list workflow runs: %w", wrapGitHubError(err))
+		}
+
+		//
+		// Filters applied on our side can drop runs,
+		// so we keep fetching pages until the limit is reached.
+		//
+		runs = append(runs, page.WorkflowRuns...)
+		summaries = summarizeWorkflowRuns(runs, config, limit)
+		if len(summaries) >= limit || response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	payloads := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		payloads = append(payloads, summary)
+	}
+
+	switch config.EmitMode {
+	case EmitModePerItem:
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "github.workflowRun", payloads)
+	case EmitModeChunked:
+		return chunkEmit(ctx.ExecutionState, core.DefaultOutputChannel.Name, "github.workflowRuns", payloads, chunkSize(config.ChunkSize))
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.workflowRuns",
+		[]any{map[string]any{"runs": summaries}},
+	)
+}
+
+func listWorkflowRunsPage(client *github.Client, owner string, config ListWorkflowRunsConfiguration, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+	if config.WorkflowFileName == "" {
+		return client.Actions.ListRepositoryWorkflowRuns(context.Background(), owner, config.Repository, opts)
+	}
+
+	//
+	// Accept both the full path of the workflow file,
+	// or just the file name accepted by the API.
+	//
+	workflowFile := strings.Replace(config.WorkflowFileName, ".github/workflows/", "", 1)
+	return client.Actions.ListWorkflowRunsByFileName(context.Background(), owner, config.Repository, workflowFile, opts)
+}
+
+func workflowRunsLimit(config ListWorkflowRunsConfiguration) int {
+	if config.Limit == nil || *config.Limit < 1 {
+		return DefaultWorkflowRunsLimit
+	}
+
+	return *config.Limit
+}
+
+/*
+ * The API status filter also accepts conclusions,
+ * so we use it for the conclusion when no status is given.
+ * The conclusion is always checked again in summarizeWorkflowRuns.
+ */
+func buildWorkflowRunsOptions(config ListWorkflowRunsConfiguration, limit int) *github.ListWorkflowRunsOptions {
+	status := config.Status
+	if status == "" {
+		status = config.Conclusion
+	}
+
+	return &github.ListWorkflowRunsOptions{
+		Branch:      config.Branch,
+		Event:       config.Event,
+		Status:      status,
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+}
+
+func summarizeWorkflowRuns(runs []*github.WorkflowRun, config ListWorkflowRunsConfiguration, limit int) []WorkflowRunSummary {
+	summaries := []WorkflowRunSummary{}
+	branches := map[string]bool{}
+	for _, run := range runs {
+		if len(summaries) >= limit {
+			break
+		}
+
+		if config.Conclusion != "" && run.GetConclusion() != config.Conclusion {
+			continue
+		}
+
+		if config.LatestPerBranch {
+			if branches[run.GetHeadBranch()] {
+				continue
+			}
+
+			branches[run.GetHeadBranch()] = true
+		}
+
+		summaries = append(summaries, summarizeWorkflowRun(run))
+	}
+
+	return summaries
+}
+
+func summarizeWorkflowRun(run *github.WorkflowRun) WorkflowRunSummary {
+	summary := WorkflowRunSummary{
+		ID:         run.GetID(),
+		Name:       run.GetName(),
+		Status:     run.GetStatus(),
+		Conclusion: run.GetConclusion(),
+		HeadBranch: run.GetHeadBranch(),
+		HeadSHA:    run.GetHeadSHA(),
+		Event:      run.GetEvent(),
+		RunNumber:  run.GetRunNumber(),
+		URL:        run.GetHTMLURL(),
+	}
+
+	if run.CreatedAt != nil {
+		summary.CreatedAt = &run.CreatedAt.Time
+	}
+
+	return summary
+}
+
+func (c *ListWorkflowRuns) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ListWorkflowRuns) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ListWorkflowRuns) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ListWorkflowRuns) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ListWorkflowRuns) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ListWorkflowRuns) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ListWorkflowRuns__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ListWorkflowRuns{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("invalid limit -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "limit": -1},
+		})
+
+		require.ErrorContains(t, err, "limit must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":       "hello",
+				"workflowFileName": "ci.yml",
+				"conclusion":       "failure",
+				"latestPerBranch":  true,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ListWorkflowRuns__BuildOptions(t *testing.T) {
+	t.Run("conclusion is used as status filter when no status is given", func(t *testing.T) {
+		opts := buildWorkflowRunsOptions(ListWorkflowRunsConfiguration{Branch: "main", Event: "push", Conclusion: "failure"}, 10)
+		assert.Equal(t, "main", opts.Branch)
+		assert.Equal(t, "push", opts.Event)
+		assert.Equal(t, "failure", opts.Status)
+		assert.Equal(t, 10, opts.ListOptions.PerPage)
+	})
+
+	t.Run("status takes precedence", func(t *testing.T) {
+		opts := buildWorkflowRunsOptions(ListWorkflowRunsConfiguration{Status: "completed", Conclusion: "failure"}, 500)
+		assert.Equal(t, "completed", opts.Status)
+		assert.Equal(t, 100, opts.ListOptions.PerPage)
+	})
+}
+
+func Test__ListWorkflowRuns__SummarizeWorkflowRuns(t *testing.T) {
+	runs := []*github.WorkflowRun{
+		{ID: github.Ptr(int64(4)), HeadBranch: github.Ptr("main"), HeadSHA: github.Ptr("d4"), Conclusion: github.Ptr("failure")},
+		{ID: github.Ptr(int64(3)), HeadBranch: github.Ptr("feature"), HeadSHA: github.Ptr("c3"), Conclusion: github.Ptr("success")},
+		{ID: github.Ptr(int64(2)), HeadBranch: github.Ptr("main"), HeadSHA: github.Ptr("b2"), Conclusion: github.Ptr("success")},
+		{ID: github.Ptr(int64(1)), HeadBranch: github.Ptr("feature"), HeadSHA: github.Ptr("a1"), Conclusion: github.Ptr("failure")},
+	}
+
+	t.Run("all runs up to the limit", func(t *testing.T) {
+		summaries := summarizeWorkflowRuns(runs, ListWorkflowRunsConfiguration{}, 3)
+		require.Len(t, summaries, 3)
+		assert.Equal(t, int64(4), summaries[0].ID)
+		assert.Equal(t, "d4", summaries[0].HeadSHA)
+		assert.Equal(t, "failure", summaries[0].Conclusion)
+	})
+
+	t.Run("conclusion filter", func(t *testing.T) {
+		summaries := summarizeWorkflowRuns(runs, ListWorkflowRunsConfiguration{Conclusion: "success"}, 10)
+		require.Len(t, summaries, 2)
+		assert.Equal(t, int64(3), summaries[0].ID)
+		assert.Equal(t, int64(2), summaries[1].ID)
+	})
+
+	t.Run("latest run per branch", func(t *testing.T) {
+		summaries := summarizeWorkflowRuns(runs, ListWorkflowRunsConfiguration{LatestPerBranch: true}, 10)
+		require.Len(t, summaries, 2)
+		assert.Equal(t, "main", summaries[0].HeadBranch)
+		assert.Equal(t, int64(4), summaries[0].ID)
+		assert.Equal(t, "feature", summaries[1].HeadBranch)
+		assert.Equal(t, int64(3), summaries[1].ID)
+	})
+}
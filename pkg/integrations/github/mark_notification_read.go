@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type MarkNotificationRead struct{}
+
+type MarkNotificationReadConfiguration struct {
+	ThreadID string `json:"threadId" mapstructure:"threadId"`
+}
+
+func (c *MarkNotificationRead) Name() string {
+	return "github.markNotificationRead"
+}
+
+func (c *MarkNotificationRead) Label() string {
+	return "Mark Notification Read"
+}
+
+func (c *MarkNotificationRead) Description() string {
+	return "Mark a GitHub notification thread as read"
+}
+
+func (c *MarkNotificationRead) Documentation() string {
+	return `The Mark Notification Read component marks a notification thread of the authenticated account as read.
+
+## Use Cases
+
+- **Triage bots**: Mark notifications as read once they are handled, so they are not processed again
+- **Inbox cleanup**: Clear notifications listed with the List Notifications component
+
+## Configuration
+
+- **Thread ID**: The ID of the notification thread, as listed by List Notifications (supports expressions)
+
+## Output
+
+Emits a ` + "`github.notificationRead`" + ` event with the ` + "`thread_id`" + `.
+
+## Notes
+
+- Notifications belong to user accounts. GitHub does not give app installations access to them, so the integration must be authenticated as a user, like a bot account
+- Marking a thread that is already read succeeds`
+}
+
+func (c *MarkNotificationRead) Icon() string {
+	return "github"
+}
+
+func (c *MarkNotificationRead) Color() string {
+	return "gray"
+}
+
+func (c *MarkNotificationRead) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *MarkNotificationRead) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "threadId",
+			Label:       "Thread ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., 1234567890",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *MarkNotificationRead) Setup(ctx core.SetupContext) error {
+	var config MarkNotificationReadConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.ThreadID) == "" {
+		return errors.New("thread ID is required")
+	}
+
+	return nil
+}
+
+func (c *MarkNotificationRead) Execute(ctx core.ExecutionContext) error {
+	var config MarkNotificationReadConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	if err := markNotificationRead(client, config.ThreadID); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Marked notification thread %s as read", config.ThreadID)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.notificationRead",
+		[]any{map[string]any{"thread_id": config.ThreadID}},
+	)
+}
+
+func markNotificationRead(client *github.Client, threadID string) error {
+	_, err := client.Activity.MarkThreadRead(context.Background(), threadID)
+	if err != nil {
+		return notificationsError(err, fmt.Sprintf("failed to mark notification thread %s as read", threadID))
+	}
+
+	return nil
+}
+
+func (c *MarkNotificationRead) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *MarkNotificationRead) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *MarkNotificationRead) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *MarkNotificationRead) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *MarkNotificationRead) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *MarkNotificationRead) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__MarkNotificationRead__Setup(t *testing.T) {
+	component := MarkNotificationRead{}
+
+	t.Run("thread ID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"threadId": " "},
+		})
+
+		require.ErrorContains(t, err, "thread ID is required")
+	})
+}
+
+func Test__MarkNotificationRead__Mark(t *testing.T) {
+	t.Run("thread is marked as read", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusResetContent, ``), nil
+		}}
+
+		require.NoError(t, markNotificationRead(github.NewClient(&http.Client{Transport: transport}), "1234567890"))
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, http.MethodPatch, transport.requests[0].Method)
+		assert.Equal(t, "/notifications/threads/1234567890", transport.requests[0].URL.Path)
+	})
+
+	t.Run("installation tokens -> explained permission error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusForbidden, `{"message":"Resource not accessible by integration"}`), nil
+		}}
+
+		err := markNotificationRead(github.NewClient(&http.Client{Transport: transport}), "1234567890")
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorContains(t, err, "failed to mark notification thread 1234567890 as read")
+	})
+}
@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	BranchMergedOutputChannel   = "merged"
+	BranchConflictOutputChannel = "conflict"
+
+	BranchMergeMerged   = "merged"
+	BranchMergeUpToDate = "up_to_date"
+	BranchMergeConflict = "conflict"
+)
+
+type MergeBranch struct{}
+
+type MergeBranchConfiguration struct {
+	Repository    string `json:"repository" mapstructure:"repository"`
+	Base          string `json:"base" mapstructure:"base"`
+	Head          string `json:"head" mapstructure:"head"`
+	CommitMessage string `json:"commitMessage" mapstructure:"commitMessage"`
+}
+
+type BranchMergeOutput struct {
+	Base    string `json:"base"`
+	Head    string `json:"head"`
+	Status  string `json:"status"`
+	SHA     string `json:"sha,omitempty"`
+	URL     string `json:"html_url,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (c *MergeBranch) Name() string {
+	return "github.mergeBranch"
+}
+
+func (c *MergeBranch) Label() string {
+	return "Merge Branch"
+}
+
+func (c *MergeBranch) Description() string {
+	return "Merge a branch into another branch of a GitHub repository, without a pull request"
+}
+
+func (c *MergeBranch) Documentation() string {
+	return `The Merge Branch component merges a head branch, tag or commit into a base branch of the same repository.
+
+## Use Cases
+
+- **Branch sync**: Keep integration or release branches up to date with main
+- **Back-merges**: Merge release branches back into main after a release
+- **Conflict handling**: Open an issue or notify the team when branches can no longer be merged automatically
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Base**: The branch to merge into (supports expressions)
+- **Head**: The branch, tag or commit SHA to merge (supports expressions)
+- **Commit Message**: The message of the merge commit. Defaults to the GitHub merge message
+
+## Output Channels
+
+- **Merged**: The head was merged, or the base already contained it
+- **Conflict**: The branches conflict, and must be merged manually
+
+## Output
+
+Returns the ` + "`base`" + ` and ` + "`head`" + `, and the ` + "`status`" + ` of the merge:
+
+- ` + "`merged`" + `: A merge commit was created. Its ` + "`sha`" + ` and ` + "`html_url`" + ` are included
+- ` + "`up_to_date`" + `: The base already contained the head, so nothing was merged
+- ` + "`conflict`" + `: The branches could not be merged because of conflicts
+
+## Notes
+
+- GitHub always creates a merge commit. Branches are never fast-forwarded
+- Branch protection rules of the base branch apply, so protected branches may reject the merge`
+}
+
+func (c *MergeBranch) Icon() string {
+	return "github"
+}
+
+func (c *MergeBranch) Color() string {
+	return "gray"
+}
+
+func (c *MergeBranch) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: BranchMergedOutputChannel, Label: "Merged"},
+		{Name: BranchConflictOutputChannel, Label: "Conflict"},
+	}
+}
+
+func (c *MergeBranch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "base",
+			Label:       "Base",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., integration",
+			Description: "The branch to merge into",
+		},
+		{
+			Name:        "head",
+			Label:       "Head",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., main",
+			Description: "The branch, tag or commit SHA to merge",
+		},
+		{
+			Name:        "commitMessage",
+			Label:       "Commit Message",
+			Type:        configuration.FieldTypeText,
+			Description: "Defaults to the GitHub merge message",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *MergeBranch) Setup(ctx core.SetupContext) error {
+	var config MergeBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Base == "" {
+		return errors.New("base is required")
+	}
+
+	if config.Head == "" {
+		return errors.New("head is required")
+	}
+
+	if config.Base == config.Head {
+		return errors.New("base and head must be different")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *MergeBranch) Execute(ctx core.ExecutionContext) error {
+	var config MergeBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := mergeBranch(client, appMetadata.Owner, config.Repository, config)
+	if err != nil {
+		return err
+	}
+
+	channel := BranchMergedOutputChannel
+	switch output.Status {
+	case BranchMergeConflict:
+		ctx.Logger.Infof("%s conflicts with %s in %s: %s", config.Head, config.Base, config.Repository, output.Message)
+		channel = BranchConflictOutputChannel
+	case BranchMergeUpToDate:
+		ctx.Logger.Infof("%s already contains %s", config.Base, config.Head)
+	}
+
+	return ctx.ExecutionState.Emit(channel, "github.branchMerge", []any{output})
+}
+
+/*
+ * GitHub answers with a 201 and the merge commit when the head is merged,
+ * a 204 when the base already contains the head, and a 409 on conflicts.
+ * Conflicts are reported as a status instead of an error,
+ * so the workflow can route them to a manual resolution.
+ */
+func mergeBranch(client *github.Client, owner, repo string, config MergeBranchConfiguration) (*BranchMergeOutput, error) {
+	output := &BranchMergeOutput{Base: config.Base, Head: config.Head}
+	request := &github.RepositoryMergeRequest{
+		Base: github.Ptr(config.Base),
+		Head: github.Ptr(config.Head),
+	}
+
+	if config.CommitMessage != "" {
+		request.CommitMessage = github.Ptr(config.CommitMessage)
+	}
+
+	commit, response, err := client.Repositories.Merge(context.Background(), owner, repo, request)
+	if err != nil {
+		var responseErr *github.ErrorResponse
+		if errors.As(err, &responseErr) && responseErr.Response != nil && responseErr.Response.StatusCode == http.StatusConflict {
+			output.Status = BranchMergeConflict
+			output.Message = responseErr.Message
+			return output, nil
+		}
+
+		return nil, fmt.Errorf("failed to merge %s into %s: %w", config.Head, config.Base, wrapGitHubError(err))
+	}
+
+	if response.StatusCode == http.StatusNoContent {
+		output.Status = BranchMergeUpToDate
+		return output, nil
+	}
+
+	output.Status = BranchMergeMerged
+	output.SHA = commit.GetSHA()
+	output.URL = commit.GetHTMLURL()
+	return output, nil
+}
+
+func (c *MergeBranch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *MergeBranch) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *MergeBranch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *MergeBranch) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *MergeBranch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *MergeBranch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
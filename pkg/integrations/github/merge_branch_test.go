@@ -0,0 +1,120 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__MergeBranch__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := MergeBranch{}
+
+	t.Run("base is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "head": "main"},
+		})
+
+		require.ErrorContains(t, err, "base is required")
+	})
+
+	t.Run("head is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "base": "integration"},
+		})
+
+		require.ErrorContains(t, err, "head is required")
+	})
+
+	t.Run("base and head must be different", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "base": "main", "head": "main"},
+		})
+
+		require.ErrorContains(t, err, "base and head must be different")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "base": "integration", "head": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__MergeBranch__Merge(t *testing.T) {
+	config := MergeBranchConfiguration{Base: "integration", Head: "main"}
+
+	t.Run("merged", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"base":"integration","head":"main","commit_message":"Sync main"}`, string(body))
+			return mockResponse(http.StatusCreated, `{"sha":"6dcb09b5b57875f334f61aebed695e2e4193db5e","html_url":"https://github.com/testhq/hello/commit/6dcb09b"}`), nil
+		}}
+
+		output, err := mergeBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", MergeBranchConfiguration{
+			Base:          "integration",
+			Head:          "main",
+			CommitMessage: "Sync main",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+		assert.Equal(t, "/repos/testhq/hello/merges", transport.requests[0].URL.Path)
+		assert.Equal(t, &BranchMergeOutput{
+			Base:   "integration",
+			Head:   "main",
+			Status: BranchMergeMerged,
+			SHA:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			URL:    "https://github.com/testhq/hello/commit/6dcb09b",
+		}, output)
+	})
+
+	t.Run("nothing to merge -> up to date", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNoContent, ``), nil
+		}}
+
+		output, err := mergeBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", config)
+		require.NoError(t, err)
+		assert.Equal(t, BranchMergeUpToDate, output.Status)
+		assert.Empty(t, output.SHA)
+	})
+
+	t.Run("conflict -> conflict status, not an error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusConflict, `{"message":"Merge conflict"}`), nil
+		}}
+
+		output, err := mergeBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", config)
+		require.NoError(t, err)
+		assert.Equal(t, BranchMergeConflict, output.Status)
+		assert.Equal(t, "Merge conflict", output.Message)
+	})
+
+	t.Run("branch not found -> error", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Base does not exist"}`), nil
+		}}
+
+		_, err := mergeBranch(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", config)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to merge main into integration")
+	})
+}
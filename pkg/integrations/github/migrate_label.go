@@ -0,0 +1,470 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	LabelMigrationRenamed         = "renamed"
+	LabelMigrationMerged          = "merged"
+	LabelMigrationAlreadyMigrated = "already_migrated"
+	LabelMigrationPayloadType     = "github.labelMigration"
+)
+
+type MigrateLabel struct{}
+
+type MigrateLabelConfiguration struct {
+	MultiRepositoryConfiguration `mapstructure:",squash"`
+	RepositoryQuery              string `json:"repositoryQuery" mapstructure:"repositoryQuery"`
+	FromName                     string `json:"fromName" mapstructure:"fromName"`
+	ToName                       string `json:"toName" mapstructure:"toName"`
+}
+
+type LabelMigrationResult struct {
+	Status           string `json:"status"`
+	Label            string `json:"label"`
+	Color            string `json:"color"`
+	ReassignedIssues int    `json:"reassigned_issues"`
+}
+
+type LabelMigrationSummary struct {
+	Renamed          int `json:"renamed"`
+	Merged           int `json:"merged"`
+	AlreadyMigrated  int `json:"already_migrated"`
+	ReassignedIssues int `json:"reassigned_issues"`
+}
+
+type LabelMigrationOutput struct {
+	MultiRepositoryOutput
+	From    string                `json:"from"`
+	To      string                `json:"to"`
+	Summary LabelMigrationSummary `json:"summary"`
+}
+
+func (c *MigrateLabel) Name() string {
+	return "github.migrateLabel"
+}
+
+func (c *MigrateLabel) Label() string {
+	return "Migrate Label"
+}
+
+func (c *MigrateLabel) Description() string {
+	return "Rename a label across GitHub repositories, keeping it on its issues and pull requests"
+}
+
+func (c *MigrateLabel) Documentation() string {
+	return `The Migrate Label component renames a label in one or more repositories. Issues and pull requests with the old label end up with the new one.
+
+## Use Cases
+
+- **Label conventions**: Rename labels across the organization, like ` + "`bug`" + ` to ` + "`type: bug`" + `
+- **Label cleanup**: Merge duplicate labels into one
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Repositories**: Select several repositories instead of a single one
+- **Repository Query**: Migrate the repositories matching a repository search query instead, like ` + "`topic:backend archived:false`" + `.
+  The search is always limited to the owner of the integration
+- **From Name**: The label to rename
+- **To Name**: The new label name
+- **Max Repositories**: If more repositories than this are selected or found, nothing is changed and the execution fails. Defaults to 10
+
+## Output
+
+Emits the ` + "`results`" + ` for each repository, with the ` + "`status`" + ` of the migration, the resulting ` + "`label`" + ` and its ` + "`color`" + `, and the number of ` + "`reassigned_issues`" + `:
+- ` + "`renamed`" + `: The label was renamed, keeping its color, description, issues and pull requests
+- ` + "`merged`" + `: The new label already existed. Issues and pull requests were moved to it, and the old label was deleted
+- ` + "`already_migrated`" + `: Only the new label exists, so nothing was changed
+
+Failed repositories have the ` + "`error`" + ` instead. ` + "`succeeded_count`" + `, ` + "`failed_count`" + ` and the ` + "`summary`" + ` of statuses and reassigned issues summarize the results.
+
+## Notes
+
+- Failing in one repository does not stop the other repositories from being processed. The execution only fails if every repository failed
+- Running the migration again is safe. Repositories that were migrated are reported as ` + "`already_migrated`" + `, and merges that were interrupted continue where they stopped
+- Repositories where neither label exists fail
+- With multiple repositories, executions lock the whole owner, unless a concurrency key is configured`
+}
+
+func (c *MigrateLabel) Icon() string {
+	return "github"
+}
+
+func (c *MigrateLabel) Color() string {
+	return "gray"
+}
+
+func (c *MigrateLabel) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *MigrateLabel) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:  "repository",
+			Label: "Repository",
+			Type:  configuration.FieldTypeIntegrationResource,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		RepositoriesField,
+		{
+			Name:        "repositoryQuery",
+			Label:       "Repository Query",
+			Type:        configuration.FieldTypeString,
+			Togglable:   true,
+			Placeholder: "e.g., topic:backend archived:false",
+			Description: "Migrate the repositories matching this search query, instead of the selected ones",
+		},
+		{
+			Name:        "fromName",
+			Label:       "From Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., bug",
+		},
+		{
+			Name:        "toName",
+			Label:       "To Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., type: bug",
+		},
+		MaxRepositoriesField,
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *MigrateLabel) Setup(ctx core.SetupContext) error {
+	var config MigrateLabelConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateMigrateLabel(config); err != nil {
+		return err
+	}
+
+	//
+	// The repositories matching the query are only known at execution time.
+	//
+	if strings.TrimSpace(config.RepositoryQuery) != "" {
+		return ctx.Metadata.Set(NodeMetadata{})
+	}
+
+	return ensureReposInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateMigrateLabel(config MigrateLabelConfiguration) error {
+	if strings.TrimSpace(config.FromName) == "" {
+		return errors.New("from name is required")
+	}
+
+	if strings.TrimSpace(config.ToName) == "" {
+		return errors.New("to name is required")
+	}
+
+	if strings.TrimSpace(config.FromName) == strings.TrimSpace(config.ToName) {
+		return errors.New("from name and to name must be different")
+	}
+
+	if bulkCloseScopeQualifierRegex.MatchString(config.RepositoryQuery) {
+		return errors.New("repository query cannot use repo:, org: or user: qualifiers - the owner of the integration is always used")
+	}
+
+	return nil
+}
+
+func (c *MigrateLabel) Execute(ctx core.ExecutionContext) error {
+	var config MigrateLabelConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateMigrateLabel(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	if strings.TrimSpace(config.RepositoryQuery) != "" {
+		config.Repositories, err = searchRepositoryNames(client, appMetadata.Owner, config.RepositoryQuery, MaxRepositoriesLimit+1)
+		if err != nil {
+			return err
+		}
+
+		if len(config.Repositories) == 0 {
+			return fmt.Errorf("no repositories match %q", config.RepositoryQuery)
+		}
+	}
+
+	repositories, err := targetRepositories(config.MultiRepositoryConfiguration)
+	if err != nil {
+		return err
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	from := strings.TrimSpace(config.FromName)
+	to := strings.TrimSpace(config.ToName)
+	results := forEachRepository(ctx.Logger, repositories, func(repository string) (any, error) {
+		return migrateLabel(client, appMetadata.Owner, repository, from, to)
+	})
+
+	if results.SucceededCount == 0 {
+		return fmt.Errorf("failed to migrate label in all %d repositories: %s", results.FailedCount, results.Results[0].Error)
+	}
+
+	output := LabelMigrationOutput{MultiRepositoryOutput: *results, From: from, To: to}
+	for _, result := range results.Results {
+		migration, ok := result.Result.(*LabelMigrationResult)
+		if !ok {
+			continue
+		}
+
+		output.Summary.ReassignedIssues += migration.ReassignedIssues
+		switch migration.Status {
+		case LabelMigrationRenamed:
+			output.Summary.Renamed++
+		case LabelMigrationMerged:
+			output.Summary.Merged++
+		case LabelMigrationAlreadyMigrated:
+			output.Summary.AlreadyMigrated++
+		}
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		LabelMigrationPayloadType,
+		[]any{output},
+	)
+}
+
+/*
+ * Returns the names of up to limit repositories of the owner matching the query.
+ */
+func searchRepositoryNames(client *github.Client, owner, query string, limit int) ([]string, error) {
+	query = fmt.Sprintf("%s org:%s", strings.TrimSpace(query), owner)
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: min(limit, 100)}}
+	names := []string{}
+
+	for {
+		var result *github.RepositoriesSearchResult
+		var response *github.Response
+		err := withRateLimitRetry("search repositories", func() error {
+			var err error
+			result, response, err = client.Search.Repositories(context.Background(), query, opts)
+			return err
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to search repositories: %w", err)
+		}
+
+		for _, repository := range result.Repositories {
+			names = append(names, repository.GetName())
+			if len(names) == limit {
+				return names, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return names, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Renames the label if the new one does not exist yet, which keeps it on its issues.
+ * Otherwise, the issues are moved to the new label before the old one is deleted,
+ * so a migration that fails halfway can be run again.
+ */
+func migrateLabel(client *github.Client, owner, repository, from, to string) (*LabelMigrationResult, error) {
+	source, err := getLabel(client, owner, repository, from)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := getLabel(client, owner, repository, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if source == nil && target == nil {
+		return nil, fmt.Errorf("neither label %s nor %s exists in %s", from, to, repository)
+	}
+
+	if source == nil {
+		return &LabelMigrationResult{Status: LabelMigrationAlreadyMigrated, Label: target.GetName(), Color: target.GetColor()}, nil
+	}
+
+	//
+	// Label names are case-insensitive, so a rename that only changes the case
+	// finds the source label under the new name too.
+	//
+	if target == nil || target.GetID() == source.GetID() {
+		renamed, err := renameLabel(client, owner, repository, source.GetName(), to)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LabelMigrationResult{Status: LabelMigrationRenamed, Label: renamed.GetName(), Color: renamed.GetColor()}, nil
+	}
+
+	reassigned, err := reassignLabel(client, owner, repository, source.GetName(), target.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Issues.DeleteLabel(context.Background(), owner, repository, source.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete label %s after moving %d issues: %w", from, reassigned, wrapGitHubError(err))
+	}
+
+	return &LabelMigrationResult{
+		Status:           LabelMigrationMerged,
+		Label:            target.GetName(),
+		Color:            target.GetColor(),
+		ReassignedIssues: reassigned,
+	}, nil
+}
+
+/*
+ * The label endpoint takes the new name as new_name,
+ * which github.Label has no field for.
+ */
+func renameLabel(client *github.Client, owner, repository, from, to string) (*github.Label, error) {
+	path := fmt.Sprintf("repos/%s/%s/labels/%s", owner, repository, url.PathEscape(from))
+	request, err := client.NewRequest(http.MethodPatch, path, map[string]string{"new_name": to})
+	if err != nil {
+		return nil, err
+	}
+
+	label := &github.Label{}
+	if _, err := client.Do(context.Background(), request, label); err != nil {
+		return nil, fmt.Errorf("failed to rename label %s: %w", from, wrapGitHubError(err))
+	}
+
+	return label, nil
+}
+
+func getLabel(client *github.Client, owner, repository, name string) (*github.Label, error) {
+	label, response, err := client.Issues.GetLabel(context.Background(), owner, repository, name)
+	if isNotFound(response) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label %s: %w", name, wrapGitHubError(err))
+	}
+
+	return label, nil
+}
+
+/*
+ * Moves the issues and pull requests from one label to the other.
+ * They are all listed first, since removing the label changes the pages.
+ */
+func reassignLabel(client *github.Client, owner, repository, from, to string) (int, error) {
+	numbers := []int{}
+	opts := &github.IssueListByRepoOptions{
+		Labels:      []string{from},
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		issues, response, err := client.Issues.ListByRepo(context.Background(), owner, repository, opts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list issues labeled %s: %w", from, wrapGitHubError(err))
+		}
+
+		for _, issue := range issues {
+			numbers = append(numbers, issue.GetNumber())
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+
+	for i, number := range numbers {
+		_, _, err := client.Issues.AddLabelsToIssue(context.Background(), owner, repository, number, []string{to})
+		if err != nil {
+			return i, fmt.Errorf("failed to label issue %d with %s: %w", number, to, wrapGitHubError(err))
+		}
+
+		response, err := client.Issues.RemoveLabelForIssue(context.Background(), owner, repository, number, from)
+		if err != nil && !isNotFound(response) {
+			return i, fmt.Errorf("failed to remove label %s from issue %d: %w", from, number, wrapGitHubError(err))
+		}
+	}
+
+	return len(numbers), nil
+}
+
+func (c *MigrateLabel) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *MigrateLabel) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *MigrateLabel) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *MigrateLabel) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *MigrateLabel) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *MigrateLabel) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
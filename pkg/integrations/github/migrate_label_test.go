@@ -0,0 +1,173 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__MigrateLabel__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := MigrateLabel{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("names are required", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "toName": "type: bug"}), "from name is required")
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "fromName": "bug"}), "to name is required")
+	})
+
+	t.Run("same names -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repository": "hello", "fromName": "bug", "toName": "bug"})
+		require.ErrorContains(t, err, "must be different")
+	})
+
+	t.Run("scope qualifiers in query -> error", func(t *testing.T) {
+		err := setup(map[string]any{"repositoryQuery": "org:other topic:api", "fromName": "bug", "toName": "type: bug"})
+		require.ErrorContains(t, err, "cannot use repo:, org: or user: qualifiers")
+	})
+
+	t.Run("repository query does not need a repository", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"repositoryQuery": "topic:api", "fromName": "bug", "toName": "type: bug"}))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "fromName": "bug", "toName": "type: bug"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__MigrateLabel__Migrate(t *testing.T) {
+	labelsTransport := func(labels map[string]string, handler func(request *http.Request) *http.Response) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				switch request.URL.Path {
+				case "/repos/testhq/hello/labels/bug", "/repos/testhq/hello/labels/type: bug":
+					name := request.URL.Path[len("/repos/testhq/hello/labels/"):]
+					if label, ok := labels[name]; ok {
+						return mockResponse(http.StatusOK, label), nil
+					}
+
+					return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+				}
+			}
+
+			return handler(request), nil
+		}}
+	}
+
+	t.Run("label is renamed when the new one does not exist", func(t *testing.T) {
+		transport := labelsTransport(map[string]string{"bug": `{"id":1,"name":"bug","color":"d73a4a"}`}, func(request *http.Request) *http.Response {
+			body, _ := io.ReadAll(request.Body)
+			assert.Equal(t, http.MethodPatch, request.Method)
+			assert.JSONEq(t, `{"new_name":"type: bug"}`, string(body))
+			return mockResponse(http.StatusOK, `{"id":1,"name":"type: bug","color":"d73a4a"}`)
+		})
+
+		result, err := migrateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug", "type: bug")
+		require.NoError(t, err)
+		assert.Equal(t, LabelMigrationRenamed, result.Status)
+		assert.Equal(t, "d73a4a", result.Color)
+		assert.Len(t, transport.requests, 3)
+	})
+
+	t.Run("rename that only changes the case", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"id":1,"name":"bug","color":"d73a4a"}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":1,"name":"Bug","color":"d73a4a"}`), nil
+		}}
+
+		result, err := migrateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug", "Bug")
+		require.NoError(t, err)
+		assert.Equal(t, LabelMigrationRenamed, result.Status)
+		assert.Equal(t, "Bug", result.Label)
+	})
+
+	t.Run("issues are moved when the new label exists", func(t *testing.T) {
+		labels := map[string]string{
+			"bug":       `{"id":1,"name":"bug","color":"d73a4a"}`,
+			"type: bug": `{"id":2,"name":"type: bug","color":"b60205"}`,
+		}
+
+		transport := labelsTransport(labels, func(request *http.Request) *http.Response {
+			switch {
+			case request.Method == http.MethodGet && request.URL.Path == "/repos/testhq/hello/issues":
+				assert.Equal(t, "bug", request.URL.Query().Get("labels"))
+				assert.Equal(t, "all", request.URL.Query().Get("state"))
+				return mockResponse(http.StatusOK, `[{"number":3},{"number":7}]`)
+			case request.Method == http.MethodPost:
+				return mockResponse(http.StatusOK, `[]`)
+			}
+
+			return mockResponse(http.StatusOK, `[]`)
+		})
+
+		result, err := migrateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug", "type: bug")
+		require.NoError(t, err)
+		assert.Equal(t, LabelMigrationMerged, result.Status)
+		assert.Equal(t, "b60205", result.Color)
+		assert.Equal(t, 2, result.ReassignedIssues)
+
+		requests := []string{}
+		for _, request := range transport.requests[3:] {
+			requests = append(requests, request.Method+" "+request.URL.Path)
+		}
+
+		assert.Equal(t, []string{
+			"POST /repos/testhq/hello/issues/3/labels",
+			"DELETE /repos/testhq/hello/issues/3/labels/bug",
+			"POST /repos/testhq/hello/issues/7/labels",
+			"DELETE /repos/testhq/hello/issues/7/labels/bug",
+			"DELETE /repos/testhq/hello/labels/bug",
+		}, requests)
+	})
+
+	t.Run("only the new label exists -> already migrated", func(t *testing.T) {
+		transport := labelsTransport(map[string]string{"type: bug": `{"id":2,"name":"type: bug","color":"b60205"}`}, func(request *http.Request) *http.Response {
+			t.Fatalf("unexpected request %s %s", request.Method, request.URL.Path)
+			return nil
+		})
+
+		result, err := migrateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug", "type: bug")
+		require.NoError(t, err)
+		assert.Equal(t, LabelMigrationAlreadyMigrated, result.Status)
+	})
+
+	t.Run("neither label exists -> error", func(t *testing.T) {
+		transport := labelsTransport(map[string]string{}, nil)
+		_, err := migrateLabel(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "bug", "type: bug")
+		require.ErrorContains(t, err, "neither label bug nor type: bug exists in hello")
+	})
+}
+
+func Test__MigrateLabel__SearchRepositoryNames(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		return mockResponse(http.StatusOK, `{"total_count":3,"items":[{"name":"api"},{"name":"web"},{"name":"docs"}]}`), nil
+	}}
+
+	names, err := searchRepositoryNames(github.NewClient(&http.Client{Transport: transport}), "testhq", "topic:backend", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"api", "web"}, names)
+	assert.Equal(t, "topic:backend org:testhq", transport.requests[0].URL.Query().Get("q"))
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultMaxRepositories = 10
+	MaxRepositoriesLimit   = 100
+)
+
+/*
+ * Mutating components can target several repositories at once,
+ * with RepositoriesField as an alternative to the single repository field.
+ * If repositories are selected, the single repository is ignored.
+ */
+var RepositoriesField = configuration.Field{
+	Name:      "repositories",
+	Label:     "Repositories",
+	Type:      configuration.FieldTypeIntegrationResource,
+	Togglable: true,
+	TypeOptions: &configuration.TypeOptions{
+		Resource: &configuration.ResourceTypeOptions{
+			Type:           "repository",
+			UseNameAsValue: true,
+			Multi:          true,
+		},
+	},
+	Description: "Run for each of these repositories, instead of a single one",
+}
+
+var MaxRepositoriesField = configuration.Field{
+	Name:        "maxRepositories",
+	Label:       "Max Repositories",
+	Type:        configuration.FieldTypeNumber,
+	Default:     DefaultMaxRepositories,
+	Description: "Fail without changing anything if more repositories than this are selected",
+	TypeOptions: &configuration.TypeOptions{
+		Number: &configuration.NumberTypeOptions{
+			Min: func() *int { min := 1; return &min }(),
+			Max: func() *int { max := MaxRepositoriesLimit; return &max }(),
+		},
+	},
+}
+
+type MultiRepositoryConfiguration struct {
+	Repository      string   `json:"repository" mapstructure:"repository"`
+	Repositories    []string `json:"repositories" mapstructure:"repositories"`
+	MaxRepositories *int     `json:"maxRepositories" mapstructure:"maxRepositories"`
+}
+
+type RepositoryResult struct {
+	Repository string `json:"repository"`
+	Success    bool   `json:"success"`
+	Result     any    `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type MultiRepositoryOutput struct {
+	Results        []RepositoryResult `json:"results"`
+	SucceededCount int                `json:"succeeded_count"`
+	FailedCount    int                `json:"failed_count"`
+}
+
+/*
+ * Returns the repositories targeted by the configuration,
+ * without duplicates, and capped by the max repositories.
+ */
+func targetRepositories(config MultiRepositoryConfiguration) ([]string, error) {
+	repositories := []string{}
+	for _, repository := range config.Repositories {
+		repository = strings.TrimSpace(repository)
+		if repository != "" && !slices.Contains(repositories, repository) {
+			repositories = append(repositories, repository)
+		}
+	}
+
+	if len(repositories) == 0 && config.Repository != "" {
+		repositories = append(repositories, config.Repository)
+	}
+
+	if len(repositories) == 0 {
+		return nil, errors.New("repository is required")
+	}
+
+	maxRepositories := DefaultMaxRepositories
+	if config.MaxRepositories != nil && *config.MaxRepositories > 0 {
+		maxRepositories = min(*config.MaxRepositories, MaxRepositoriesLimit)
+	}
+
+	if len(repositories) > maxRepositories {
+		return nil, fmt.Errorf("%d repositories selected, but at most %d are allowed - raise max repositories", len(repositories), maxRepositories)
+	}
+
+	return repositories, nil
+}
+
+/*
+ * Same as ensureRepoInMetadata, for components that support multiple repositories.
+ * With multiple repositories, there is no single repository to show on the node,
+ * so all of them are only checked to be accessible.
+ */
+func ensureReposInMetadata(ctx core.MetadataContext, app core.IntegrationContext, c any) error {
+	var config MultiRepositoryConfiguration
+	if err := mapstructure.Decode(c, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.Repositories) == 0 {
+		return ensureRepoInMetadata(ctx, app, c)
+	}
+
+	repositories, err := targetRepositories(config)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(app.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	for _, repository := range repositories {
+		if isExpression(repository) {
+			continue
+		}
+
+		accessible := slices.ContainsFunc(appMetadata.Repositories, func(r Repository) bool {
+			return r.Name == repository
+		})
+
+		if !accessible {
+			return fmt.Errorf("repository %s is not accessible to app installation", repository)
+		}
+	}
+
+	return ctx.Set(NodeMetadata{})
+}
+
+/*
+ * Calls the function for each repository, in order.
+ * A failure in one repository is recorded in its result,
+ * and does not stop the other repositories from being processed.
+ */
+func forEachRepository(logger *log.Entry, repositories []string, call func(repository string) (any, error)) *MultiRepositoryOutput {
+	output := &MultiRepositoryOutput{Results: make([]RepositoryResult, 0, len(repositories))}
+	for _, repository := range repositories {
+		result, err := call(repository)
+		if err != nil {
+			logger.Warnf("Failed for repository %s: %v", repository, err)
+			output.Results = append(output.Results, RepositoryResult{Repository: repository, Error: err.Error()})
+			output.FailedCount++
+			continue
+		}
+
+		output.Results = append(output.Results, RepositoryResult{Repository: repository, Success: true, Result: result})
+		output.SucceededCount++
+	}
+
+	return output
+}
@@ -0,0 +1,82 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__TargetRepositories(t *testing.T) {
+	t.Run("single repository", func(t *testing.T) {
+		repositories, err := targetRepositories(MultiRepositoryConfiguration{Repository: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hello"}, repositories)
+	})
+
+	t.Run("repositories take precedence, without duplicates", func(t *testing.T) {
+		repositories, err := targetRepositories(MultiRepositoryConfiguration{
+			Repository:   "hello",
+			Repositories: []string{"api", " web ", "api", ""},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"api", "web"}, repositories)
+	})
+
+	t.Run("no repository -> error", func(t *testing.T) {
+		_, err := targetRepositories(MultiRepositoryConfiguration{})
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("more repositories than the max -> error", func(t *testing.T) {
+		max := 2
+		_, err := targetRepositories(MultiRepositoryConfiguration{Repositories: []string{"a", "b", "c"}, MaxRepositories: &max})
+		require.ErrorContains(t, err, "3 repositories selected, but at most 2 are allowed")
+	})
+}
+
+func Test__EnsureReposInMetadata(t *testing.T) {
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{{Name: "api"}, {Name: "web"}}}}
+
+	t.Run("all repositories accessible", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		require.NoError(t, ensureReposInMetadata(metadata, integration, map[string]any{"repositories": []string{"api", "web"}}))
+		assert.Equal(t, NodeMetadata{}, metadata.Get())
+	})
+
+	t.Run("inaccessible repository -> error", func(t *testing.T) {
+		err := ensureReposInMetadata(&contexts.MetadataContext{}, integration, map[string]any{"repositories": []string{"api", "secret"}})
+		require.ErrorContains(t, err, "repository secret is not accessible")
+	})
+
+	t.Run("single repository is stored in metadata", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		require.NoError(t, ensureReposInMetadata(metadata, integration, map[string]any{"repository": "web"}))
+		assert.Equal(t, "web", metadata.Get().(NodeMetadata).Repository.Name)
+	})
+}
+
+func Test__ForEachRepository(t *testing.T) {
+	output := forEachRepository(log.NewEntry(log.StandardLogger()), []string{"api", "web", "docs"}, func(repository string) (any, error) {
+		if repository == "web" {
+			return nil, errors.New("boom")
+		}
+
+		return repository + "-done", nil
+	})
+
+	assert.Equal(t, 2, output.SucceededCount)
+	assert.Equal(t, 1, output.FailedCount)
+	require.Len(t, output.Results, 3)
+	assert.Equal(t, RepositoryResult{Repository: "web", Error: "boom"}, output.Results[1])
+	assert.Equal(t, RepositoryResult{Repository: "docs", Success: true, Result: "docs-done"}, output.Results[2])
+}
+
+func Test__ConcurrencyKey__MultipleRepositories(t *testing.T) {
+	assert.Equal(t, "testhq", concurrencyKey(map[string]any{"repository": "hello", "repositories": []any{"api"}}, "testhq"))
+	assert.Equal(t, "testhq", concurrencyKey(map[string]any{}, "testhq"))
+}
@@ -14,8 +14,9 @@ import (
 type OnIssueComment struct{}
 
 type OnIssueCommentConfiguration struct {
-	Repository    string `json:"repository" mapstructure:"repository"`
-	ContentFilter string `json:"contentFilter" mapstructure:"contentFilter"`
+	Repository      string `json:"repository" mapstructure:"repository"`
+	ContentFilter   string `json:"contentFilter" mapstructure:"contentFilter"`
+	AutoAcknowledge bool   `json:"autoAcknowledge" mapstructure:"autoAcknowledge"`
 }
 
 func (i *OnIssueComment) Name() string {
@@ -44,6 +45,8 @@ func (i *OnIssueComment) Documentation() string {
 
 - **Repository**: Select the GitHub repository to monitor
 - **Content Filter**: Optional regex pattern to filter comments (e.g., ` + "`/solve`" + ` to only trigger on comments containing "/solve")
+- **Auto Acknowledge**: Add an ` + "`eyes`" + ` reaction to matching comments as soon as they are received, before the workflow runs.
+  Gives commenters instant feedback that a slash command was picked up. Failing to react does not stop the workflow
 
 ## Event Data
 
@@ -88,6 +91,7 @@ func (i *OnIssueComment) Configuration() []configuration.Field {
 			Placeholder: "e.g., /solve",
 			Description: "Optional regex pattern to filter comments by content",
 		},
+		AutoAcknowledgeField,
 	}
 }
 
@@ -191,6 +195,10 @@ func (i *OnIssueComment) HandleWebhook(ctx core.WebhookRequestContext) (int, err
 		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
 	}
 
+	if config.AutoAcknowledge {
+		acknowledgeComment(ctx, data)
+	}
+
 	return http.StatusOK, nil
 }
 
@@ -293,6 +293,36 @@ func Test__OnIssueComment__HandleWebhook(t *testing.T) {
 	})
 }
 
+func Test__OnIssueComment__AutoAcknowledge(t *testing.T) {
+	trigger := &OnIssueComment{}
+	body := []byte(`{"action":"created","comment":{"id":1001,"body":"/deploy"},"repository":{"name":"hello","owner":{"login":"testhq"}}}`)
+
+	secret := "test-secret"
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+
+	headers := http.Header{}
+	headers.Set("X-Hub-Signature-256", "sha256="+fmt.Sprintf("%x", h.Sum(nil)))
+	headers.Set("X-GitHub-Event", "issue_comment")
+
+	assert.Contains(t, trigger.Configuration(), AutoAcknowledgeField)
+
+	t.Run("failing to acknowledge does not fail the webhook", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		code, err := trigger.HandleWebhook(core.WebhookRequestContext{
+			Body:          body,
+			Headers:       headers,
+			Configuration: map[string]any{"repository": "hello", "autoAcknowledge": true},
+			Webhook:       &contexts.WebhookContext{Secret: secret},
+			Events:        eventContext,
+		})
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, eventContext.Count())
+	})
+}
+
 func Test__OnIssueComment__Setup(t *testing.T) {
 	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
 	trigger := OnIssueComment{}
@@ -156,6 +156,8 @@ func (p *OnPullRequest) HandleWebhook(ctx core.WebhookRequestContext) (int, erro
 		return code, err
 	}
 
+	invalidateReadsForWebhook(ctx)
+
 	data := map[string]any{}
 	err = json.Unmarshal(ctx.Body, &data)
 	if err != nil {
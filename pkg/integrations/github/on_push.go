@@ -151,6 +151,8 @@ func (p *OnPush) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 		return code, err
 	}
 
+	invalidateReadsForWebhook(ctx)
+
 	data := map[string]any{}
 	err = json.Unmarshal(ctx.Body, &data)
 	if err != nil {
@@ -153,6 +153,8 @@ func (r *OnRelease) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 		return code, err
 	}
 
+	invalidateReadsForWebhook(ctx)
+
 	data := map[string]any{}
 	err = json.Unmarshal(ctx.Body, &data)
 	if err != nil {
@@ -0,0 +1,86 @@
+package github
+
+/*
+ * Permissions every installation of the GitHub app gets,
+ * used by the triggers and by most components.
+ */
+var DefaultPermissions = map[string]string{
+	"issues":           "write",
+	"actions":          "write",
+	"contents":         "write",
+	"pull_requests":    "write",
+	"repository_hooks": "write",
+	"statuses":         "write",
+}
+
+/*
+ * ComponentPermissions lists the permissions each component needs
+ * beyond the default ones. The app manifest requests all of them,
+ * so a component is never added without the permissions it needs
+ * being requested too - except for administration write, see manifestPermissions().
+ */
+var ComponentPermissions = map[string]map[string]string{
+	"github.addDiscussionComment":      {"discussions": "write"},
+	"github.addToProject":              {"organization_projects": "write"},
+	"github.approveDeployment":         {"deployments": "write"},
+	"github.archiveRepository":         {"administration": "write"},
+	"github.blockUser":                 {"organization_user_blocking": "write"},
+	"github.cancelInvitation":          {"administration": "write", "members": "write"},
+	"github.checkActionsSecret":        {"secrets": "read", "organization_secrets": "read", "environments": "read"},
+	"github.createAnnotation":          {"checks": "write"},
+	"github.createOrUpdateEnvironment": {"administration": "write", "members": "read"},
+	"github.createTeamDiscussion":      {"team_discussions": "write", "members": "read"},
+	"github.dismissDependabotAlert":    {"vulnerability_alerts": "write"},
+	"github.getLatestDeploymentStatus": {"deployments": "read"},
+	"github.getUser":                   {"members": "read"},
+	"github.inviteCollaborator":        {"administration": "write"},
+	"github.listAuditLog":              {"organization_administration": "read"},
+	"github.listCheckRuns":             {"checks": "read"},
+	"github.listChecksForPR":           {"checks": "read", "administration": "read"},
+	"github.listDependabotAlerts":      {"vulnerability_alerts": "read"},
+	"github.listDeployments":           {"deployments": "read"},
+	"github.listEnvironmentSecrets":    {"environments": "read", "secrets": "read"},
+	"github.listEnvironmentVariables":  {"environments": "read"},
+	"github.listOrgMembers":            {"members": "read"},
+	"github.listPendingInvitations":    {"administration": "read", "members": "read"},
+	"github.listSecretScanningAlerts":  {"secret_scanning_alerts": "read"},
+	"github.listSelfHostedRunners":     {"administration": "read", "organization_self_hosted_runners": "read"},
+	"github.rerequestCheckSuite":       {"checks": "write"},
+	"github.setDefaultBranch":          {"administration": "write"},
+	"github.setEnvironmentVariable":    {"environments": "write", "administration": "write"},
+	"github.setRepositoryTopics":       {"administration": "write"},
+	"github.updateSecretScanningAlert": {"secret_scanning_alerts": "write"},
+	"github.waitForCheckRun":           {"checks": "read"},
+	"github.waitForRequiredChecks":     {"checks": "read", "administration": "read"},
+}
+
+var permissionLevels = map[string]int{"read": 1, "write": 2, "admin": 3}
+
+/*
+ * Returns the permissions requested in the app manifest:
+ * the default ones, and the highest level each component needs.
+ *
+ * Administration write access lets the app change any setting of the repositories,
+ * so it is only requested if the integration is configured with repository administration.
+ * Otherwise, the components that need it fail with a permission error.
+ */
+func manifestPermissions(config Configuration) map[string]string {
+	permissions := map[string]string{}
+	for name, level := range DefaultPermissions {
+		permissions[name] = level
+	}
+
+	for _, required := range ComponentPermissions {
+		for name, level := range required {
+			if permissionLevels[level] > permissionLevels[permissions[name]] {
+				permissions[name] = level
+			}
+		}
+	}
+
+	if !config.RepositoryAdministration && permissions["administration"] == "write" {
+		permissions["administration"] = "read"
+	}
+
+	return permissions
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__GitHub__ManifestPermissions(t *testing.T) {
+	g := &GitHub{}
+
+	manifestFor := func(config Configuration) map[string]string {
+		var manifest struct {
+			DefaultPermissions map[string]string `json:"default_permissions"`
+		}
+
+		data := g.appManifest(core.SyncContext{BaseURL: "https://superplane.local", Integration: &contexts.IntegrationContext{}}, config)
+		require.NoError(t, json.Unmarshal([]byte(data), &manifest))
+		return manifest.DefaultPermissions
+	}
+
+	permissions := manifestFor(Configuration{RepositoryAdministration: true})
+
+	t.Run("permissions are for existing components", func(t *testing.T) {
+		names := map[string]bool{}
+		for _, component := range g.Components() {
+			names[component.Name()] = true
+		}
+
+		for name := range ComponentPermissions {
+			assert.True(t, names[name], "%s is not a component", name)
+		}
+	})
+
+	t.Run("manifest with repository administration requests what each component needs", func(t *testing.T) {
+		for component, required := range ComponentPermissions {
+			for name, level := range required {
+				assert.GreaterOrEqual(t, permissionLevels[permissions[name]], permissionLevels[level], "%s needs %s: %s", component, name, level)
+			}
+		}
+
+		for name, level := range DefaultPermissions {
+			assert.Equal(t, level, permissions[name])
+		}
+	})
+
+	t.Run("secrets and checks", func(t *testing.T) {
+		assert.Equal(t, "read", permissions["secrets"])
+		assert.Equal(t, "read", permissions["organization_secrets"])
+		assert.Equal(t, "write", permissions["checks"])
+	})
+
+	t.Run("environments", func(t *testing.T) {
+		assert.Equal(t, "write", permissions["environments"])
+	})
+
+	t.Run("administration write is opt-in", func(t *testing.T) {
+		assert.Equal(t, "write", permissions["administration"])
+		assert.Equal(t, "read", manifestFor(Configuration{})["administration"])
+	})
+}
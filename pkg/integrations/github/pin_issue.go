@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * GitHub allows at most 3 pinned issues per repository.
+ */
+const MaxPinnedIssues = 3
+
+type PinIssue struct{}
+
+type PinIssueConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	IssueNumber string `json:"issueNumber" mapstructure:"issueNumber"`
+	Pin         bool   `json:"pin" mapstructure:"pin"`
+}
+
+type PinIssueOutput struct {
+	IssueNumber int    `json:"issue_number"`
+	Pinned      bool   `json:"pinned"`
+	Changed     bool   `json:"changed"`
+	URL         string `json:"html_url"`
+}
+
+func (c *PinIssue) Name() string {
+	return "github.pinIssue"
+}
+
+func (c *PinIssue) Label() string {
+	return "Pin Issue"
+}
+
+func (c *PinIssue) Description() string {
+	return "Pin or unpin a GitHub issue"
+}
+
+func (c *PinIssue) Documentation() string {
+	return `The Pin Issue component pins an issue to the top of the issues list of its repository, or unpins it.
+
+## Use Cases
+
+- **Incidents**: Pin the issue of an active incident, and unpin it once it is resolved
+- **Announcements**: Keep release or migration issues visible while they are relevant
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue number (supports expressions)
+- **Pin**: Pin the issue if enabled, unpin it otherwise
+
+## Output
+
+Returns whether the issue is ` + "`pinned`" + `, and whether it ` + "`changed`" + `.
+
+## Notes
+
+- A repository can have at most 3 pinned issues. Pinning a fourth one fails, without unpinning any other issue
+- Pinning an issue that is already pinned, or unpinning one that is not, does nothing
+- Pull requests cannot be pinned`
+}
+
+func (c *PinIssue) Icon() string {
+	return "github"
+}
+
+func (c *PinIssue) Color() string {
+	return "gray"
+}
+
+func (c *PinIssue) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PinIssue) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:        "pin",
+			Label:       "Pin",
+			Type:        configuration.FieldTypeBool,
+			Default:     true,
+			Description: "Pin the issue if enabled, unpin it otherwise",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *PinIssue) Setup(ctx core.SetupContext) error {
+	var config PinIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *PinIssue) Execute(ctx core.ExecutionContext) error {
+	var config PinIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	//
+	// The pinned issue limit is per repository,
+	// so the whole owner is locked while checking it.
+	//
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setIssuePinned(client, appMetadata.Owner, config.Repository, issueNumber, config.Pin)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issuePin",
+		[]any{output},
+	)
+}
+
+func setIssuePinned(client *githubv4.Client, owner, repository string, number int, pin bool) (*PinIssueOutput, error) {
+	var query struct {
+		Repository struct {
+			Issue *struct {
+				ID       githubv4.ID
+				IsPinned bool
+				URL      string
+			} `graphql:"issue(number: $number)"`
+			PinnedIssues struct {
+				TotalCount int
+			}
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to find issue %d: %w", number, err)
+	}
+
+	issue := query.Repository.Issue
+	if issue == nil || issue.ID == nil {
+		return nil, fmt.Errorf("%w: issue %d", ErrNotFound, number)
+	}
+
+	output := &PinIssueOutput{IssueNumber: number, Pinned: issue.IsPinned, URL: issue.URL}
+	if issue.IsPinned == pin {
+		return output, nil
+	}
+
+	if pin && query.Repository.PinnedIssues.TotalCount >= MaxPinnedIssues {
+		return nil, fmt.Errorf(
+			"cannot pin issue %d: %s already has %d pinned issues, which is the most GitHub allows - unpin one first",
+			number,
+			repository,
+			query.Repository.PinnedIssues.TotalCount,
+		)
+	}
+
+	if pin {
+		var mutation struct {
+			PinIssue struct {
+				Issue struct {
+					IsPinned bool
+				}
+			} `graphql:"pinIssue(input: $input)"`
+		}
+
+		if err := client.Mutate(context.Background(), &mutation, githubv4.PinIssueInput{IssueID: issue.ID}, nil); err != nil {
+			return nil, fmt.Errorf("failed to pin issue %d: %w", number, err)
+		}
+	} else {
+		var mutation struct {
+			UnpinIssue struct {
+				Issue struct {
+					IsPinned bool
+				}
+			} `graphql:"unpinIssue(input: $input)"`
+		}
+
+		if err := client.Mutate(context.Background(), &mutation, githubv4.UnpinIssueInput{IssueID: issue.ID}, nil); err != nil {
+			return nil, fmt.Errorf("failed to unpin issue %d: %w", number, err)
+		}
+	}
+
+	output.Pinned = pin
+	output.Changed = true
+	return output, nil
+}
+
+func (c *PinIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PinIssue) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *PinIssue) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PinIssue) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PinIssue) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PinIssue) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__PinIssue__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := PinIssue{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pin": true},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "7", "pin": true},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__PinIssue__SetIssuePinned(t *testing.T) {
+	graphQLTransport := func(isPinned bool, pinnedCount int) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			switch {
+			case strings.Contains(string(body), "pinIssue("), strings.Contains(string(body), "unpinIssue("):
+				return mockResponse(http.StatusOK, `{"data":{}}`), nil
+			default:
+				return mockResponse(http.StatusOK, fmt.Sprintf(
+					`{"data":{"repository":{"issue":{"id":"I_1","isPinned":%t,"url":"https://github.com/testhq/hello/issues/7"},"pinnedIssues":{"totalCount":%d}}}}`,
+					isPinned,
+					pinnedCount,
+				)), nil
+			}
+		}}
+	}
+
+	t.Run("issue is pinned", func(t *testing.T) {
+		transport := graphQLTransport(false, 1)
+		output, err := setIssuePinned(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.True(t, output.Pinned)
+		assert.True(t, output.Changed)
+	})
+
+	t.Run("issue is unpinned", func(t *testing.T) {
+		transport := graphQLTransport(true, 3)
+		output, err := setIssuePinned(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.False(t, output.Pinned)
+		assert.True(t, output.Changed)
+	})
+
+	t.Run("already pinned -> nothing changes", func(t *testing.T) {
+		transport := graphQLTransport(true, 3)
+		output, err := setIssuePinned(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, true)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.True(t, output.Pinned)
+		assert.False(t, output.Changed)
+	})
+
+	t.Run("pinned issue limit reached -> error", func(t *testing.T) {
+		transport := graphQLTransport(false, 3)
+		_, err := setIssuePinned(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, true)
+		require.ErrorContains(t, err, "already has 3 pinned issues")
+		assert.Len(t, transport.requests, 1)
+	})
+}
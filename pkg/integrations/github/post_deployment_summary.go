@@ -0,0 +1,439 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * The commit table is cut after this many commits,
+ * so a large range still fits in a comment.
+ */
+const MaxDeploymentSummaryCommits = 50
+
+var DeploymentSummaryStatuses = []string{"success", "failure", "error", "in_progress", "queued", "pending", "inactive"}
+
+type PostDeploymentSummary struct{}
+
+type PostDeploymentSummaryConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	IssueNumber string `json:"issueNumber" mapstructure:"issueNumber"`
+	Environment string `json:"environment" mapstructure:"environment"`
+	FromSHA     string `json:"fromSha" mapstructure:"fromSha"`
+	ToSHA       string `json:"toSha" mapstructure:"toSha"`
+	Status      string `json:"status" mapstructure:"status"`
+}
+
+type DeploymentSummary struct {
+	Repository  string
+	Environment string
+	Status      string
+	FromSHA     string
+	ToSHA       string
+	CompareURL  string
+	Commits     []*github.RepositoryCommit
+}
+
+type DeploymentSummaryComment struct {
+	IssueNumber int    `json:"issue_number"`
+	CommentID   int64  `json:"comment_id"`
+	URL         string `json:"html_url"`
+	Created     bool   `json:"created"`
+	Environment string `json:"environment"`
+	Status      string `json:"status"`
+	CommitCount int    `json:"commit_count"`
+	CompareURL  string `json:"compare_url,omitempty"`
+	Truncated   bool   `json:"truncated"`
+}
+
+func (c *PostDeploymentSummary) Name() string {
+	return "github.postDeploymentSummary"
+}
+
+func (c *PostDeploymentSummary) Label() string {
+	return "Post Deployment Summary"
+}
+
+func (c *PostDeploymentSummary) Description() string {
+	return "Post or update a comment summarizing a deployment and the commits it shipped"
+}
+
+func (c *PostDeploymentSummary) Documentation() string {
+	return `The Post Deployment Summary component comments on an issue or pull request with the status of a deployment,
+and a table of the commits it shipped. Later runs for the same environment update the same comment.
+
+## Use Cases
+
+- **Deployment feedback**: Tell the authors of a pull request when their changes reach an environment
+- **Release tracking**: Keep a single, up to date deployment comment on a release issue
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue or pull request to comment on (supports expressions)
+- **Environment**: The environment that was deployed to (e.g., production)
+- **From SHA**: The commit that was deployed before. If empty, the commit table is left out
+- **To SHA**: The commit that was deployed
+- **Status**: The status of the deployment: success, failure, error, in_progress, queued, pending or inactive
+
+## Output
+
+Emits a ` + "`github.deploymentSummaryComment`" + ` event with the ` + "`comment_id`" + ` and ` + "`html_url`" + ` of the comment, whether it was ` + "`created`" + ` or updated,
+and the ` + "`commit_count`" + ` and ` + "`compare_url`" + ` of the commit range.
+
+## Notes
+
+- The comment is tagged with a hidden marker for the environment. If a comment with the marker exists, it is updated, so there is one comment per environment
+- The commit table lists at most 50 commits, with a link to the full comparison
+- Comments longer than GitHub allows are truncated`
+}
+
+func (c *PostDeploymentSummary) Icon() string {
+	return "github"
+}
+
+func (c *PostDeploymentSummary) Color() string {
+	return "gray"
+}
+
+func (c *PostDeploymentSummary) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PostDeploymentSummary) Configuration() []configuration.Field {
+	statuses := []configuration.FieldOption{}
+	for _, status := range DeploymentSummaryStatuses {
+		label := strings.ReplaceAll(status, "_", " ")
+		statuses = append(statuses, configuration.FieldOption{Label: strings.ToUpper(label[:1]) + label[1:], Value: status})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+			Description: "The issue or pull request to comment on",
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+		{
+			Name:        "fromSha",
+			Label:       "From SHA",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.previous_sha}}",
+			Description: "The commit deployed before. Leave empty to leave out the commit table",
+		},
+		{
+			Name:        "toSha",
+			Label:       "To SHA",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.sha}}",
+			Description: "The commit that was deployed",
+		},
+		{
+			Name:     "status",
+			Label:    "Status",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "success",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: statuses,
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *PostDeploymentSummary) Setup(ctx core.SetupContext) error {
+	var config PostDeploymentSummaryConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if !isExpression(config.IssueNumber) {
+		if _, err := strconv.Atoi(config.IssueNumber); err != nil {
+			return fmt.Errorf("issue number is not a number: %s", config.IssueNumber)
+		}
+	}
+
+	if strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required")
+	}
+
+	if strings.TrimSpace(config.ToSHA) == "" {
+		return errors.New("to SHA is required")
+	}
+
+	if config.Status != "" && !isExpression(config.Status) && !slices.Contains(DeploymentSummaryStatuses, config.Status) {
+		return fmt.Errorf("invalid status: %s", config.Status)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *PostDeploymentSummary) Execute(ctx core.ExecutionContext) error {
+	var config PostDeploymentSummaryConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	if !slices.Contains(DeploymentSummaryStatuses, config.Status) {
+		return fmt.Errorf("invalid status: %s", config.Status)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := postDeploymentSummary(client, appMetadata.Owner, issueNumber, config)
+	if err != nil {
+		return err
+	}
+
+	if output.Truncated {
+		ctx.Logger.Warnf("Deployment summary is longer than %d characters, truncating it", MaxCommentBodyLength)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.deploymentSummaryComment",
+		[]any{output},
+	)
+}
+
+func postDeploymentSummary(client *github.Client, owner string, issueNumber int, config PostDeploymentSummaryConfiguration) (*DeploymentSummaryComment, error) {
+	summary := DeploymentSummary{
+		Repository:  config.Repository,
+		Environment: strings.TrimSpace(config.Environment),
+		Status:      config.Status,
+		FromSHA:     strings.TrimSpace(config.FromSHA),
+		ToSHA:       strings.TrimSpace(config.ToSHA),
+	}
+
+	if summary.FromSHA != "" {
+		commits, compareURL, err := compareAllCommits(client, owner, config.Repository, summary.FromSHA, summary.ToSHA)
+		if err != nil {
+			return nil, err
+		}
+
+		summary.Commits = commits
+		summary.CompareURL = compareURL
+	}
+
+	body, truncated, err := guardBodySize(renderDeploymentSummary(owner, summary), MaxCommentBodyLength, OnOversizeTruncate)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, created, err := upsertMarkedComment(client, owner, config.Repository, issueNumber, deploymentSummaryMarker(summary.Environment), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeploymentSummaryComment{
+		IssueNumber: issueNumber,
+		CommentID:   comment.GetID(),
+		URL:         comment.GetHTMLURL(),
+		Created:     created,
+		Environment: summary.Environment,
+		Status:      summary.Status,
+		CommitCount: len(summary.Commits),
+		CompareURL:  summary.CompareURL,
+		Truncated:   truncated,
+	}, nil
+}
+
+/*
+ * Markers are HTML comments, which GitHub does not render.
+ */
+func deploymentSummaryMarker(environment string) string {
+	return fmt.Sprintf("<!-- superplane:deployment-summary:%s -->", environment)
+}
+
+func renderDeploymentSummary(owner string, summary DeploymentSummary) string {
+	commitURL := func(sha string) string {
+		return fmt.Sprintf("[`%s`](https://github.com/%s/%s/commit/%s)", shortSHA(sha), owner, summary.Repository, sha)
+	}
+
+	var markdown strings.Builder
+	markdown.WriteString(deploymentSummaryMarker(summary.Environment) + "\n")
+	fmt.Fprintf(&markdown, "### Deployment to `%s`: %s\n\n", summary.Environment, strings.ReplaceAll(summary.Status, "_", " "))
+	markdown.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&markdown, "| **Environment** | `%s` |\n", summary.Environment)
+	fmt.Fprintf(&markdown, "| **Status** | %s |\n", summary.Status)
+	fmt.Fprintf(&markdown, "| **Deployed commit** | %s |\n", commitURL(summary.ToSHA))
+	if summary.FromSHA == "" {
+		return markdown.String()
+	}
+
+	fmt.Fprintf(&markdown, "| **Previous commit** | %s |\n", commitURL(summary.FromSHA))
+	fmt.Fprintf(&markdown, "| **Commits** | %d |\n", len(summary.Commits))
+	if len(summary.Commits) == 0 {
+		return markdown.String()
+	}
+
+	markdown.WriteString("\n#### Commits\n\n| Commit | Author | Message |\n|---|---|---|\n")
+
+	//
+	// Compare lists commits from oldest to newest,
+	// so the newest ones are shown first.
+	//
+	commits := slices.Clone(summary.Commits)
+	slices.Reverse(commits)
+	for _, commit := range commits[:min(len(commits), MaxDeploymentSummaryCommits)] {
+		message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+		author := commit.GetCommit().GetAuthor().GetName()
+		if login := commit.GetAuthor().GetLogin(); login != "" {
+			author = "@" + login
+		}
+
+		fmt.Fprintf(&markdown, "| %s | %s | %s |\n", commitURL(commit.GetSHA()), escapeTableCell(author), escapeTableCell(message))
+	}
+
+	if len(commits) > MaxDeploymentSummaryCommits {
+		fmt.Fprintf(&markdown, "\n…and %d more commits.\n", len(commits)-MaxDeploymentSummaryCommits)
+	}
+
+	if summary.CompareURL != "" {
+		fmt.Fprintf(&markdown, "\n**Full comparison**: %s\n", summary.CompareURL)
+	}
+
+	return markdown.String()
+}
+
+func shortSHA(sha string) string {
+	return sha[:min(len(sha), 7)]
+}
+
+func escapeTableCell(text string) string {
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+/*
+ * Updates the latest comment containing the marker, or creates one if there is none.
+ * Returns the comment, and whether it was created.
+ */
+func upsertMarkedComment(client *github.Client, owner, repo string, issueNumber int, marker, body string) (*github.IssueComment, bool, error) {
+	var existing *github.IssueComment
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, response, err := client.Issues.ListComments(context.Background(), owner, repo, issueNumber, opts)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list comments of issue %d: %w", issueNumber, wrapGitHubError(err))
+		}
+
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				existing = comment
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	if existing != nil {
+		comment, _, err := client.Issues.EditComment(context.Background(), owner, repo, existing.GetID(), &github.IssueComment{Body: &body})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to update comment %d: %w", existing.GetID(), wrapGitHubError(err))
+		}
+
+		return comment, false, nil
+	}
+
+	comment, _, err := client.Issues.CreateComment(context.Background(), owner, repo, issueNumber, &github.IssueComment{Body: &body})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to comment on issue %d: %w", issueNumber, wrapGitHubError(err))
+	}
+
+	return comment, true, nil
+}
+
+func (c *PostDeploymentSummary) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PostDeploymentSummary) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *PostDeploymentSummary) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PostDeploymentSummary) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PostDeploymentSummary) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PostDeploymentSummary) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
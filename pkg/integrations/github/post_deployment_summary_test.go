@@ -0,0 +1,222 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__PostDeploymentSummary__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := PostDeploymentSummary{}
+
+	t.Run("invalid issue number -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "abc", "environment": "production", "toSha": "abc1234"},
+		})
+
+		require.ErrorContains(t, err, "issue number is not a number")
+	})
+
+	t.Run("missing environment -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "toSha": "abc1234"},
+		})
+
+		require.ErrorContains(t, err, "environment is required")
+	})
+
+	t.Run("missing to SHA -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "environment": "production"},
+		})
+
+		require.ErrorContains(t, err, "to SHA is required")
+	})
+
+	t.Run("invalid status -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "environment": "production", "toSha": "abc1234", "status": "done"},
+		})
+
+		require.ErrorContains(t, err, "invalid status: done")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "{{$.data.number}}", "environment": "production", "toSha": "abc1234", "status": "success"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__PostDeploymentSummary__Render(t *testing.T) {
+	t.Run("no previous commit -> only deployment details", func(t *testing.T) {
+		markdown := renderDeploymentSummary("testhq", DeploymentSummary{
+			Repository:  "hello",
+			Environment: "staging",
+			Status:      "in_progress",
+			ToSHA:       "9e1d4b7aa",
+		})
+
+		assert.Equal(t, "<!-- superplane:deployment-summary:staging -->\n"+
+			"### Deployment to `staging`: in progress\n\n"+
+			"| | |\n|---|---|\n"+
+			"| **Environment** | `staging` |\n"+
+			"| **Status** | in_progress |\n"+
+			"| **Deployed commit** | [`9e1d4b7`](https://github.com/testhq/hello/commit/9e1d4b7aa) |\n", markdown)
+	})
+
+	t.Run("commits listed newest first, with escaped cells", func(t *testing.T) {
+		markdown := renderDeploymentSummary("testhq", DeploymentSummary{
+			Repository:  "hello",
+			Environment: "production",
+			Status:      "success",
+			FromSHA:     "5c2a8f1aa",
+			ToSHA:       "9e1d4b7aa",
+			CompareURL:  "https://github.com/testhq/hello/compare/5c2a8f1aa...9e1d4b7aa",
+			Commits: []*github.RepositoryCommit{
+				{SHA: github.Ptr("1111111aa"), Commit: &github.Commit{Message: github.Ptr("Add A | B\n\nDetails"), Author: &github.CommitAuthor{Name: github.Ptr("Octo Cat")}}},
+				{SHA: github.Ptr("2222222aa"), Author: &github.User{Login: github.Ptr("hubot")}, Commit: &github.Commit{Message: github.Ptr("Fix upload")}},
+			},
+		})
+
+		assert.Equal(t, "<!-- superplane:deployment-summary:production -->\n"+
+			"### Deployment to `production`: success\n\n"+
+			"| | |\n|---|---|\n"+
+			"| **Environment** | `production` |\n"+
+			"| **Status** | success |\n"+
+			"| **Deployed commit** | [`9e1d4b7`](https://github.com/testhq/hello/commit/9e1d4b7aa) |\n"+
+			"| **Previous commit** | [`5c2a8f1`](https://github.com/testhq/hello/commit/5c2a8f1aa) |\n"+
+			"| **Commits** | 2 |\n"+
+			"\n#### Commits\n\n| Commit | Author | Message |\n|---|---|---|\n"+
+			"| [`2222222`](https://github.com/testhq/hello/commit/2222222aa) | @hubot | Fix upload |\n"+
+			"| [`1111111`](https://github.com/testhq/hello/commit/1111111aa) | Octo Cat | Add A \\| B |\n"+
+			"\n**Full comparison**: https://github.com/testhq/hello/compare/5c2a8f1aa...9e1d4b7aa\n", markdown)
+	})
+
+	t.Run("too many commits -> table is cut", func(t *testing.T) {
+		commits := []*github.RepositoryCommit{}
+		for i := range MaxDeploymentSummaryCommits + 2 {
+			commits = append(commits, &github.RepositoryCommit{SHA: github.Ptr(fmt.Sprintf("%07d", i))})
+		}
+
+		markdown := renderDeploymentSummary("testhq", DeploymentSummary{
+			Repository:  "hello",
+			Environment: "production",
+			Status:      "success",
+			FromSHA:     "5c2a8f1",
+			ToSHA:       "9e1d4b7",
+			Commits:     commits,
+		})
+
+		assert.Contains(t, markdown, "| **Commits** | 52 |\n")
+		assert.Contains(t, markdown, "[`0000051`]")
+		assert.NotContains(t, markdown, "[`0000001`]")
+		assert.True(t, strings.HasSuffix(markdown, "\n…and 2 more commits.\n"))
+	})
+}
+
+func Test__PostDeploymentSummary__Post(t *testing.T) {
+	config := PostDeploymentSummaryConfiguration{
+		Repository:  "hello",
+		IssueNumber: "42",
+		Environment: "production",
+		FromSHA:     "5c2a8f1",
+		ToSHA:       "9e1d4b7",
+		Status:      "success",
+	}
+
+	handler := func(comments string) func(request *http.Request) (*http.Response, error) {
+		return func(request *http.Request) (*http.Response, error) {
+			path := request.URL.Path
+			switch {
+			case strings.Contains(path, "/compare/"):
+				return mockResponse(http.StatusOK, `{"html_url":"https://github.com/testhq/hello/compare/5c2a8f1...9e1d4b7","commits":[{"sha":"1111111"},{"sha":"2222222"}]}`), nil
+			case request.Method == http.MethodGet && strings.HasSuffix(path, "/issues/42/comments"):
+				return mockResponse(http.StatusOK, comments), nil
+			case request.Method == http.MethodPost && strings.HasSuffix(path, "/issues/42/comments"):
+				return mockResponse(http.StatusCreated, `{"id":300,"html_url":"https://github.com/testhq/hello/pull/42#issuecomment-300"}`), nil
+			case request.Method == http.MethodPatch && strings.HasSuffix(path, "/issues/comments/200"):
+				return mockResponse(http.StatusOK, `{"id":200,"html_url":"https://github.com/testhq/hello/pull/42#issuecomment-200"}`), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}
+	}
+
+	t.Run("no comment with the marker -> comment is created", func(t *testing.T) {
+		transport := &mockTransport{handler: handler(`[
+			{"id":100,"body":"<!-- superplane:deployment-summary:staging -->\nStaging"},
+			{"id":101,"body":"Looks good"}
+		]`)}
+
+		output, err := postDeploymentSummary(github.NewClient(&http.Client{Transport: transport}), "testhq", 42, config)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(300), output.CommentID)
+		assert.True(t, output.Created)
+		assert.Equal(t, 2, output.CommitCount)
+		assert.Equal(t, "https://github.com/testhq/hello/compare/5c2a8f1...9e1d4b7", output.CompareURL)
+
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, "/repos/testhq/hello/compare/5c2a8f1...9e1d4b7", transport.requests[0].URL.Path)
+		assert.Equal(t, http.MethodPost, transport.requests[2].Method)
+		body, err := io.ReadAll(transport.requests[2].Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "<!-- superplane:deployment-summary:production -->")
+	})
+
+	t.Run("comment with the marker -> comment is updated", func(t *testing.T) {
+		transport := &mockTransport{handler: handler(`[
+			{"id":100,"body":"<!-- superplane:deployment-summary:staging -->\nStaging"},
+			{"id":200,"body":"<!-- superplane:deployment-summary:production -->\nProduction"}
+		]`)}
+
+		output, err := postDeploymentSummary(github.NewClient(&http.Client{Transport: transport}), "testhq", 42, config)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(200), output.CommentID)
+		assert.False(t, output.Created)
+		assert.Equal(t, "https://github.com/testhq/hello/pull/42#issuecomment-200", output.URL)
+
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPatch, transport.requests[2].Method)
+	})
+
+	t.Run("compare fails -> error, nothing is posted", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		_, err := postDeploymentSummary(github.NewClient(&http.Client{Transport: transport}), "testhq", 42, config)
+		require.Error(t, err)
+		require.Len(t, transport.requests, 1)
+	})
+}
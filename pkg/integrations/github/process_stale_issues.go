@@ -0,0 +1,583 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultStaleLabel           = "stale"
+	DefaultStaleCloseAfterDays  = 7
+	DefaultStaleIssuesMaxIssues = 50
+
+	StaleIssueMarked   = "marked_stale"
+	StaleIssueUnmarked = "unmarked"
+	StaleIssueClosed   = "closed"
+)
+
+/*
+ * Timeline events that count as activity on a stale issue.
+ * Events like labeling or subscribing do not, since they are
+ * often done by bots, and do not mean the issue is still relevant.
+ */
+var staleIssueActivityEvents = []string{
+	"commented",
+	"committed",
+	"cross-referenced",
+	"reopened",
+	"renamed",
+	"reviewed",
+}
+
+type ProcessStaleIssues struct{}
+
+type ProcessStaleIssuesConfiguration struct {
+	Repository     string   `json:"repository" mapstructure:"repository"`
+	Query          string   `json:"query" mapstructure:"query"`
+	StaleAfterDays *int     `json:"staleAfterDays" mapstructure:"staleAfterDays"`
+	CloseAfterDays *int     `json:"closeAfterDays" mapstructure:"closeAfterDays"`
+	StaleLabel     string   `json:"staleLabel" mapstructure:"staleLabel"`
+	StaleComment   string   `json:"staleComment" mapstructure:"staleComment"`
+	ExemptLabels   []string `json:"exemptLabels" mapstructure:"exemptLabels"`
+	MaxIssues      *int     `json:"maxIssues" mapstructure:"maxIssues"`
+}
+
+type StaleIssueResult struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"html_url"`
+	Action string `json:"action"`
+	Error  string `json:"error,omitempty"`
+}
+
+type StaleIssuesOutput struct {
+	Results       []StaleIssueResult `json:"results"`
+	MarkedCount   int                `json:"marked_count"`
+	UnmarkedCount int                `json:"unmarked_count"`
+	ClosedCount   int                `json:"closed_count"`
+	FailedCount   int                `json:"failed_count"`
+}
+
+func (c *ProcessStaleIssues) Name() string {
+	return "github.processStaleIssues"
+}
+
+func (c *ProcessStaleIssues) Label() string {
+	return "Process Stale Issues"
+}
+
+func (c *ProcessStaleIssues) Description() string {
+	return "Mark inactive GitHub issues as stale, and close them if they stay inactive"
+}
+
+func (c *ProcessStaleIssues) Documentation() string {
+	return `The Process Stale Issues component marks inactive issues as stale, and closes the ones that stay inactive. It is meant to run on a schedule, like a nightly stale bot.
+
+## Use Cases
+
+- **Stale bot**: Keep the issue tracker focused on issues that are still relevant
+- **Triage queues**: Close unanswered questions after a grace period
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Query**: Optional issue search qualifiers limiting which issues are processed, for example ` + "`label:question`" + `
+- **Stale After Days**: Issues not updated for this number of days are marked as stale. Defaults to 90
+- **Close After Days**: Stale issues without activity for this number of days after being marked are closed. Defaults to 7
+- **Stale Label**: The label marking stale issues. Defaults to ` + "`stale`" + `
+- **Stale Comment**: Optional comment added when an issue is marked as stale (supports markdown and expressions)
+- **Exempt Labels**: Issues with any of these labels are never processed
+- **Max Issues**: Maximum number of issues to mark, and to check for closing, in each run. Defaults to 50
+
+## Output
+
+Emits a ` + "`github.staleIssues`" + ` event with the ` + "`results`" + ` for each issue acted on, with its ` + "`number`" + `, ` + "`title`" + `, the ` + "`action`" + ` taken, and the ` + "`error`" + ` if it failed:
+
+- ` + "`marked_stale`" + `: The issue was inactive, so it was commented on and labeled as stale
+- ` + "`unmarked`" + `: The stale issue had activity since it was marked, so the stale label was removed
+- ` + "`closed`" + `: The stale issue had no activity since it was marked, so it was closed as not planned
+
+` + "`marked_count`" + `, ` + "`unmarked_count`" + `, ` + "`closed_count`" + ` and ` + "`failed_count`" + ` summarize the results.
+
+## Notes
+
+- The state is kept on GitHub: issues are marked with the stale label, and the timeline of stale issues is inspected for comments, commits, references, reviews, renames and reopens after the label was added. Runs can be repeated safely
+- Activity from the account that added the stale label does not count
+- Stale issues that are still within the close period are left untouched, and not included in the results
+- Failing to process one issue does not stop the others
+- The query is always scoped to the open issues of the selected repository, so it cannot use repo:, org: or user: qualifiers`
+}
+
+func (c *ProcessStaleIssues) Icon() string {
+	return "github"
+}
+
+func (c *ProcessStaleIssues) Color() string {
+	return "gray"
+}
+
+func (c *ProcessStaleIssues) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ProcessStaleIssues) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "query",
+			Label:       "Query",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., label:question",
+			Description: "Issue search qualifiers. Only open issues of the repository are matched",
+		},
+		{
+			Name:        "staleAfterDays",
+			Label:       "Stale After Days",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultStaleAfterDays,
+			Description: "Issues not updated for this number of days are marked as stale",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "closeAfterDays",
+			Label:       "Close After Days",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultStaleCloseAfterDays,
+			Description: "Stale issues without activity for this number of days are closed",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:    "staleLabel",
+			Label:   "Stale Label",
+			Type:    configuration.FieldTypeString,
+			Default: DefaultStaleLabel,
+		},
+		{
+			Name:        "staleComment",
+			Label:       "Stale Comment",
+			Type:        configuration.FieldTypeText,
+			Description: "Comment added when an issue is marked as stale",
+		},
+		{
+			Name:  "exemptLabels",
+			Label: "Exempt Labels",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Label",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "maxIssues",
+			Label:       "Max Issues",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultStaleIssuesMaxIssues,
+			Description: "Maximum number of issues to mark, and to check for closing, in each run",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxBulkCloseIssues; return &max }(),
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *ProcessStaleIssues) Setup(ctx core.SetupContext) error {
+	var config ProcessStaleIssuesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateStaleIssuesConfiguration(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateStaleIssuesConfiguration(config ProcessStaleIssuesConfiguration) error {
+	if err := validateBulkCloseQuery(config.Query); err != nil {
+		return err
+	}
+
+	if config.StaleAfterDays != nil && *config.StaleAfterDays < 1 {
+		return errors.New("stale after days must be greater than 0")
+	}
+
+	if config.CloseAfterDays != nil && *config.CloseAfterDays < 1 {
+		return errors.New("close after days must be greater than 0")
+	}
+
+	if config.MaxIssues != nil && (*config.MaxIssues < 1 || *config.MaxIssues > MaxBulkCloseIssues) {
+		return fmt.Errorf("max issues must be between 1 and %d", MaxBulkCloseIssues)
+	}
+
+	for _, label := range config.ExemptLabels {
+		if strings.TrimSpace(label) == "" {
+			return errors.New("exempt labels cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+func (c *ProcessStaleIssues) Execute(ctx core.ExecutionContext) error {
+	var config ProcessStaleIssuesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	//
+	// The query can use expressions, so it is validated again once resolved.
+	//
+	if err := validateStaleIssuesConfiguration(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := processStaleIssues(client, appMetadata.Owner, config, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof(
+		"Marked %d issues as stale, unmarked %d and closed %d in %s",
+		output.MarkedCount,
+		output.UnmarkedCount,
+		output.ClosedCount,
+		config.Repository,
+	)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.staleIssues",
+		[]any{output},
+	)
+}
+
+/*
+ * Issues already marked as stale are handled first, so an issue
+ * marked in this run is not checked for closing right away.
+ * Marking an issue updates it, so the next run does not mark it again.
+ */
+func processStaleIssues(client *github.Client, owner string, config ProcessStaleIssuesConfiguration, now time.Time) (*StaleIssuesOutput, error) {
+	staleLabel := strings.TrimSpace(config.StaleLabel)
+	if staleLabel == "" {
+		staleLabel = DefaultStaleLabel
+	}
+
+	staleAfterDays := DefaultStaleAfterDays
+	if config.StaleAfterDays != nil {
+		staleAfterDays = *config.StaleAfterDays
+	}
+
+	closeAfterDays := DefaultStaleCloseAfterDays
+	if config.CloseAfterDays != nil {
+		closeAfterDays = *config.CloseAfterDays
+	}
+
+	maxIssues := DefaultStaleIssuesMaxIssues
+	if config.MaxIssues != nil {
+		maxIssues = *config.MaxIssues
+	}
+
+	baseQuery := bulkCloseQuery(config.Query, owner, config.Repository)
+	for _, label := range config.ExemptLabels {
+		baseQuery += fmt.Sprintf(` -label:"%s"`, strings.TrimSpace(label))
+	}
+
+	output := &StaleIssuesOutput{Results: []StaleIssueResult{}}
+
+	stale, _, err := searchIssues(client, fmt.Sprintf(`%s label:"%s"`, baseQuery, staleLabel), maxIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	closeBefore := now.Add(-time.Duration(closeAfterDays) * 24 * time.Hour)
+	for _, issue := range stale {
+		if !issueInRepository(issue.GetRepositoryURL(), owner, config.Repository) {
+			continue
+		}
+
+		action, err := processStaleIssue(client, owner, config.Repository, issue, staleLabel, closeBefore)
+		if action == "" && err == nil {
+			continue
+		}
+
+		output.add(issue, action, err)
+	}
+
+	cutoff := now.Add(-time.Duration(staleAfterDays) * 24 * time.Hour)
+	inactive, _, err := searchIssues(client, fmt.Sprintf(`%s -label:"%s" updated:<%s`, baseQuery, staleLabel, cutoff.UTC().Format(time.RFC3339)), maxIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range inactive {
+		if !issueInRepository(issue.GetRepositoryURL(), owner, config.Repository) {
+			continue
+		}
+
+		err := markIssueStale(client, owner, config.Repository, issue.GetNumber(), staleLabel, config.StaleComment)
+		output.add(issue, StaleIssueMarked, err)
+	}
+
+	return output, nil
+}
+
+func (o *StaleIssuesOutput) add(issue *github.Issue, action string, err error) {
+	result := StaleIssueResult{
+		Number: issue.GetNumber(),
+		Title:  issue.GetTitle(),
+		URL:    issue.GetHTMLURL(),
+		Action: action,
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+		o.FailedCount++
+		o.Results = append(o.Results, result)
+		return
+	}
+
+	switch action {
+	case StaleIssueMarked:
+		o.MarkedCount++
+	case StaleIssueUnmarked:
+		o.UnmarkedCount++
+	case StaleIssueClosed:
+		o.ClosedCount++
+	}
+
+	o.Results = append(o.Results, result)
+}
+
+/*
+ * Returns the action taken on an issue marked as stale,
+ * or an empty action if it is still within the close period.
+ */
+func processStaleIssue(client *github.Client, owner, repository string, issue *github.Issue, staleLabel string, closeBefore time.Time) (string, error) {
+	number := issue.GetNumber()
+	timeline, err := listIssueTimeline(client, owner, repository, number)
+	if err != nil {
+		return "", err
+	}
+
+	markedAt, active := staleIssueActivity(timeline, staleLabel)
+	if markedAt.IsZero() {
+		markedAt = issue.GetUpdatedAt().Time
+	}
+
+	if active {
+		err := withRateLimitRetry(fmt.Sprintf("unmark issue %d", number), func() error {
+			_, err := client.Issues.RemoveLabelForIssue(context.Background(), owner, repository, number, staleLabel)
+			return err
+		})
+
+		if err != nil {
+			return StaleIssueUnmarked, fmt.Errorf("failed to remove stale label: %w", err)
+		}
+
+		return StaleIssueUnmarked, nil
+	}
+
+	if markedAt.After(closeBefore) {
+		return "", nil
+	}
+
+	err = withRateLimitRetry(fmt.Sprintf("close issue %d", number), func() error {
+		_, _, err := client.Issues.Edit(context.Background(), owner, repository, number, &github.IssueRequest{
+			State:       github.Ptr("closed"),
+			StateReason: github.Ptr("not_planned"),
+		})
+
+		return err
+	})
+
+	if err != nil {
+		return StaleIssueClosed, fmt.Errorf("failed to close: %w", err)
+	}
+
+	return StaleIssueClosed, nil
+}
+
+/*
+ * Finds when the stale label was last added to the issue,
+ * and whether anyone other than who added it was active on the issue since.
+ */
+func staleIssueActivity(timeline []*github.Timeline, staleLabel string) (time.Time, bool) {
+	var markedAt time.Time
+	marker := ""
+	for _, event := range timeline {
+		if event.GetEvent() == "labeled" && strings.EqualFold(event.GetLabel().GetName(), staleLabel) {
+			markedAt = event.GetCreatedAt().Time
+			marker = event.GetActor().GetLogin()
+		}
+	}
+
+	if markedAt.IsZero() {
+		return markedAt, false
+	}
+
+	for _, event := range timeline {
+		if !slices.Contains(staleIssueActivityEvents, event.GetEvent()) {
+			continue
+		}
+
+		at := event.GetCreatedAt().Time
+		if event.SubmittedAt != nil {
+			at = event.GetSubmittedAt().Time
+		}
+
+		if !at.After(markedAt) {
+			continue
+		}
+
+		actor := event.GetActor().GetLogin()
+		if actor == "" {
+			actor = event.GetUser().GetLogin()
+		}
+
+		if actor != marker {
+			return markedAt, true
+		}
+	}
+
+	return markedAt, false
+}
+
+func listIssueTimeline(client *github.Client, owner, repository string, number int) ([]*github.Timeline, error) {
+	events := []*github.Timeline{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		var page []*github.Timeline
+		var response *github.Response
+		err := withRateLimitRetry(fmt.Sprintf("list timeline of issue %d", number), func() error {
+			var err error
+			page, response, err = client.Issues.ListIssueTimeline(context.Background(), owner, repository, number, opts)
+			return err
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list timeline: %w", err)
+		}
+
+		events = append(events, page...)
+		if response.NextPage == 0 {
+			return events, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * The comment is added before the label, so it is not
+ * mistaken for activity after the issue was marked.
+ */
+func markIssueStale(client *github.Client, owner, repository string, number int, staleLabel, comment string) error {
+	if comment != "" {
+		err := withRateLimitRetry(fmt.Sprintf("comment on issue %d", number), func() error {
+			_, _, err := client.Issues.CreateComment(context.Background(), owner, repository, number, &github.IssueComment{Body: &comment})
+			return err
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to comment: %w", err)
+		}
+	}
+
+	err := withRateLimitRetry(fmt.Sprintf("mark issue %d as stale", number), func() error {
+		_, _, err := client.Issues.AddLabelsToIssue(context.Background(), owner, repository, number, []string{staleLabel})
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to add stale label: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ProcessStaleIssues) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ProcessStaleIssues) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ProcessStaleIssues) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ProcessStaleIssues) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ProcessStaleIssues) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ProcessStaleIssues) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,183 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ProcessStaleIssues__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := ProcessStaleIssues{}
+
+	setup := func(configuration map[string]any) error {
+		configuration["repository"] = "hello"
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("invalid configuration -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"query": "org:other"}), "cannot use repo:, org: or user: qualifiers")
+		require.ErrorContains(t, setup(map[string]any{"staleAfterDays": 0}), "stale after days must be greater than 0")
+		require.ErrorContains(t, setup(map[string]any{"closeAfterDays": 0}), "close after days must be greater than 0")
+		require.ErrorContains(t, setup(map[string]any{"exemptLabels": []string{" "}}), "exempt labels cannot be empty")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "exemptLabels": []string{"pinned"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__ProcessStaleIssues__Process(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	issue := func(number int) string {
+		return `{"number":` + strconv.Itoa(number) + `,"title":"Issue","repository_url":"https://api.github.com/repos/testhq/hello"}`
+	}
+
+	timelines := map[string]string{
+		// marked 10 days ago, no activity since -> closed
+		"/repos/testhq/hello/issues/1/timeline": `[
+			{"event":"commented","actor":{"login":"superplane[bot]"},"created_at":"2026-02-19T00:00:00Z"},
+			{"event":"labeled","label":{"name":"stale"},"actor":{"login":"superplane[bot]"},"created_at":"2026-02-19T00:00:01Z"}
+		]`,
+		// marked 10 days ago, commented on since -> unmarked
+		"/repos/testhq/hello/issues/2/timeline": `[
+			{"event":"labeled","label":{"name":"stale"},"actor":{"login":"superplane[bot]"},"created_at":"2026-02-19T00:00:00Z"},
+			{"event":"commented","actor":{"login":"octocat"},"created_at":"2026-02-20T00:00:00Z"}
+		]`,
+		// marked 2 days ago -> left untouched
+		"/repos/testhq/hello/issues/3/timeline": `[
+			{"event":"labeled","label":{"name":"stale"},"actor":{"login":"superplane[bot]"},"created_at":"2026-02-27T00:00:00Z"},
+			{"event":"subscribed","actor":{"login":"octocat"},"created_at":"2026-02-28T00:00:00Z"}
+		]`,
+	}
+
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		path := request.URL.Path
+		switch {
+		case path == "/search/issues" && strings.Contains(request.URL.Query().Get("q"), `-label:"stale"`):
+			return mockResponse(http.StatusOK, `{"total_count":1,"items":[`+issue(4)+`]}`), nil
+		case path == "/search/issues":
+			return mockResponse(http.StatusOK, `{"total_count":3,"items":[`+issue(1)+`,`+issue(2)+`,`+issue(3)+`]}`), nil
+		case strings.HasSuffix(path, "/timeline"):
+			return mockResponse(http.StatusOK, timelines[path]), nil
+		case request.Method == http.MethodPost && strings.HasSuffix(path, "/labels"):
+			return mockResponse(http.StatusOK, `[{"name":"stale"}]`), nil
+		case request.Method == http.MethodPost:
+			return mockResponse(http.StatusCreated, `{"id":1}`), nil
+		case request.Method == http.MethodDelete:
+			return mockResponse(http.StatusOK, `[]`), nil
+		}
+
+		return mockResponse(http.StatusOK, `{"number":1}`), nil
+	}}
+
+	config := ProcessStaleIssuesConfiguration{
+		Repository:     "hello",
+		Query:          "label:question",
+		StaleAfterDays: github.Ptr(30),
+		CloseAfterDays: github.Ptr(7),
+		StaleComment:   "This issue is stale",
+		ExemptLabels:   []string{"pinned"},
+	}
+
+	output, err := processStaleIssues(github.NewClient(&http.Client{Transport: transport}), "testhq", config, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, []StaleIssueResult{
+		{Number: 1, Title: "Issue", Action: StaleIssueClosed},
+		{Number: 2, Title: "Issue", Action: StaleIssueUnmarked},
+		{Number: 4, Title: "Issue", Action: StaleIssueMarked},
+	}, output.Results)
+
+	assert.Equal(t, 1, output.ClosedCount)
+	assert.Equal(t, 1, output.UnmarkedCount)
+	assert.Equal(t, 1, output.MarkedCount)
+	assert.Equal(t, 0, output.FailedCount)
+
+	queries := []string{}
+	requests := map[string]string{}
+	for _, request := range transport.requests {
+		if request.URL.Path == "/search/issues" {
+			queries = append(queries, request.URL.Query().Get("q"))
+			continue
+		}
+
+		if request.Method != http.MethodGet {
+			body := ""
+			if request.Body != nil {
+				data, _ := io.ReadAll(request.Body)
+				body = string(data)
+			}
+
+			requests[request.Method+" "+request.URL.Path] = body
+		}
+	}
+
+	assert.Equal(t, []string{
+		`label:question repo:testhq/hello is:issue is:open -label:"pinned" label:"stale"`,
+		`label:question repo:testhq/hello is:issue is:open -label:"pinned" -label:"stale" updated:<2026-01-30T00:00:00Z`,
+	}, queries)
+
+	assert.JSONEq(t, `{"state":"closed","state_reason":"not_planned"}`, requests["PATCH /repos/testhq/hello/issues/1"])
+	assert.Contains(t, requests, "DELETE /repos/testhq/hello/issues/2/labels/stale")
+	assert.JSONEq(t, `{"body":"This issue is stale"}`, requests["POST /repos/testhq/hello/issues/4/comments"])
+	assert.JSONEq(t, `["stale"]`, requests["POST /repos/testhq/hello/issues/4/labels"])
+	assert.Len(t, requests, 4)
+}
+
+func Test__ProcessStaleIssues__Activity(t *testing.T) {
+	markedAt := time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC)
+	labeled := &github.Timeline{
+		Event:     github.Ptr("labeled"),
+		Label:     &github.Label{Name: github.Ptr("Stale")},
+		Actor:     &github.User{Login: github.Ptr("superplane[bot]")},
+		CreatedAt: &github.Timestamp{Time: markedAt},
+	}
+
+	t.Run("no stale label in the timeline -> not marked", func(t *testing.T) {
+		at, active := staleIssueActivity([]*github.Timeline{}, "stale")
+		assert.True(t, at.IsZero())
+		assert.False(t, active)
+	})
+
+	t.Run("activity by the marker does not count", func(t *testing.T) {
+		at, active := staleIssueActivity([]*github.Timeline{labeled, {
+			Event:     github.Ptr("commented"),
+			Actor:     &github.User{Login: github.Ptr("superplane[bot]")},
+			CreatedAt: &github.Timestamp{Time: markedAt.Add(time.Hour)},
+		}}, "stale")
+
+		assert.Equal(t, markedAt, at)
+		assert.False(t, active)
+	})
+
+	t.Run("review submitted after marking -> active", func(t *testing.T) {
+		_, active := staleIssueActivity([]*github.Timeline{labeled, {
+			Event:       github.Ptr("reviewed"),
+			User:        &github.User{Login: github.Ptr("octocat")},
+			SubmittedAt: &github.Timestamp{Time: markedAt.Add(time.Hour)},
+		}}, "stale")
+
+		assert.True(t, active)
+	})
+}
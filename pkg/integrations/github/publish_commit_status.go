@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/google/uuid"
@@ -25,6 +28,8 @@ type PublishCommitStatusConfiguration struct {
 
 var shaRegex = regexp.MustCompile(`^[a-f0-9]{40}$`)
 
+var CommitStatusStates = []string{"pending", "success", "failure", "error"}
+
 func (c *PublishCommitStatus) Name() string {
 	return "github.publishCommitStatus"
 }
@@ -147,6 +152,7 @@ func (c *PublishCommitStatus) Configuration() []configuration.Field {
 			Placeholder: "https://...",
 			Description: "e.g. Link to build logs, test results, ...",
 		},
+		ConcurrencyKeyField,
 	}
 }
 
@@ -165,8 +171,13 @@ func (c *PublishCommitStatus) Execute(ctx core.ExecutionContext) error {
 	}
 
 	// Validate SHA format
-	if !shaRegex.MatchString(config.SHA) {
-		return fmt.Errorf("invalid commit SHA format: expected 40-character hexadecimal string, got %q", config.SHA)
+	if err := validateCommitSHA(config.SHA); err != nil {
+		return err
+	}
+
+	repoStatus, err := buildRepoStatus(config.State, config.Context, config.Description, config.TargetURL)
+	if err != nil {
+		return err
 	}
 
 	var appMetadata Metadata
@@ -174,45 +185,74 @@ func (c *PublishCommitStatus) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
 
-	//
-	// Prepare the status request based on the configuration
-	//
-	repoStatus := &github.RepoStatus{
-		State:   &config.State,
-		Context: &config.Context,
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
 	}
 
-	if config.Description != "" {
-		repoStatus.Description = &config.Description
+	if !acquired {
+		return nil
 	}
 
-	if config.TargetURL != "" {
-		repoStatus.TargetURL = &config.TargetURL
-	}
+	defer unlock()
 
 	// Create the commit status
-	status, _, err := client.Repositories.CreateStatus(
-		context.Background(),
-		appMetadata.Owner,
-		config.Repository,
-		config.SHA,
-		repoStatus,
-	)
+	return withIdempotency(ctx, "github.commitStatus", func() (any, error) {
+		status, _, err := client.Repositories.CreateStatus(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			config.SHA,
+			repoStatus,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create commit status: %w", err)
+		}
+
+		return status, nil
+	})
+}
 
-	if err != nil {
-		return fmt.Errorf("failed to create commit status: %w", err)
+func validateCommitSHA(sha string) error {
+	if !shaRegex.MatchString(sha) {
+		return fmt.Errorf("invalid commit SHA format: expected 40-character hexadecimal string, got %q", sha)
 	}
 
-	return ctx.ExecutionState.Emit(
-		core.DefaultOutputChannel.Name,
-		"github.commitStatus",
-		[]any{status},
-	)
+	return nil
+}
+
+/*
+ * Prepare the status request based on the configuration.
+ */
+func buildRepoStatus(state, statusContext, description, targetURL string) (*github.RepoStatus, error) {
+	if !slices.Contains(CommitStatusStates, state) {
+		return nil, fmt.Errorf("invalid state %q: must be one of %s", state, strings.Join(CommitStatusStates, ", "))
+	}
+
+	if strings.TrimSpace(statusContext) == "" {
+		return nil, errors.New("context is required")
+	}
+
+	repoStatus := &github.RepoStatus{
+		State:   &state,
+		Context: &statusContext,
+	}
+
+	if description != "" {
+		repoStatus.Description = &description
+	}
+
+	if targetURL != "" {
+		repoStatus.TargetURL = &targetURL
+	}
+
+	return repoStatus, nil
 }
 
 func (c *PublishCommitStatus) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+/*
+ * GitHub Apps get at least 5000 core requests per hour.
+ */
+const MaxRateLimitThreshold = 5000
+
+/*
+ * RateLimitThresholdField is used by bulk components.
+ * When it is set, they pause before running out of requests, instead of failing halfway.
+ */
+var RateLimitThresholdField = configuration.Field{
+	Name:        "rateLimitThreshold",
+	Label:       "Rate Limit Threshold",
+	Type:        configuration.FieldTypeNumber,
+	Togglable:   true,
+	Description: "Pause until the rate limit resets when fewer than this many API requests are left",
+	TypeOptions: &configuration.TypeOptions{
+		Number: &configuration.NumberTypeOptions{
+			Min: func() *int { min := 1; return &min }(),
+			Max: func() *int { max := MaxRateLimitThreshold; return &max }(),
+		},
+	},
+}
+
+func validateRateLimitThreshold(threshold *int) error {
+	if threshold == nil {
+		return nil
+	}
+
+	if *threshold < 1 || *threshold > MaxRateLimitThreshold {
+		return fmt.Errorf("rate limit threshold must be between 1 and %d", MaxRateLimitThreshold)
+	}
+
+	return nil
+}
+
+/*
+ * Bulk components pause by scheduling this action for when the rate limit resets,
+ * instead of holding the execution while they wait. The action resumes
+ * the operation from the cursor kept in the execution metadata.
+ */
+const RateLimitResumeAction = "resume"
+
+/*
+ * The progress of a bulk operation, reported before each of its items.
+ * PausedUntil is set when the operation is paused until the rate limit resets.
+ */
+type RateLimitProgress struct {
+	Completed   int    `json:"completed" mapstructure:"completed"`
+	Total       int    `json:"total" mapstructure:"total"`
+	Remaining   int    `json:"remaining" mapstructure:"remaining"`
+	PausedUntil string `json:"paused_until,omitempty" mapstructure:"paused_until"`
+}
+
+/*
+ * The fields of a search result that bulk components need.
+ * They are kept in the execution metadata, so a paused operation
+ * resumes with the same items, without searching again.
+ */
+type BulkIssue struct {
+	Number        int    `json:"number" mapstructure:"number"`
+	Title         string `json:"title" mapstructure:"title"`
+	URL           string `json:"html_url" mapstructure:"html_url"`
+	RepositoryURL string `json:"repository_url" mapstructure:"repository_url"`
+	PullRequest   bool   `json:"pull_request" mapstructure:"pull_request"`
+	Locked        bool   `json:"locked" mapstructure:"locked"`
+}
+
+func bulkIssues(issues []*github.Issue) []BulkIssue {
+	result := make([]BulkIssue, 0, len(issues))
+	seen := map[int]bool{}
+	for _, issue := range issues {
+		//
+		// Search results can shift between pages while they are fetched,
+		// so the same item can be returned twice.
+		//
+		if seen[issue.GetNumber()] {
+			continue
+		}
+
+		seen[issue.GetNumber()] = true
+		result = append(result, BulkIssue{
+			Number:        issue.GetNumber(),
+			Title:         issue.GetTitle(),
+			URL:           issue.GetHTMLURL(),
+			RepositoryURL: issue.GetRepositoryURL(),
+			PullRequest:   issue.IsPullRequest(),
+			Locked:        issue.GetLocked(),
+		})
+	}
+
+	return result
+}
+
+/*
+ * rateLimitScheduler paces the items of a bulk operation against the core rate limit.
+ * Before each item, it reads the remaining budget, and if it is below the threshold,
+ * returns how long to pause for the rate limit to reset.
+ * Reading the rate limit does not count against it.
+ *
+ * A nil scheduler never pauses, so bulk loops can use one unconditionally.
+ */
+type rateLimitScheduler struct {
+	client     *github.Client
+	threshold  int
+	onProgress func(RateLimitProgress)
+
+	now func() time.Time
+}
+
+func newRateLimitScheduler(client *github.Client, threshold *int, onProgress func(RateLimitProgress)) *rateLimitScheduler {
+	if threshold == nil {
+		return nil
+	}
+
+	return &rateLimitScheduler{
+		client:     client,
+		threshold:  *threshold,
+		onProgress: onProgress,
+		now:        time.Now,
+	}
+}
+
+/*
+ * Returns how long the operation should pause before its next item,
+ * or zero if it can go on.
+ */
+func (s *rateLimitScheduler) Pause(completed, total int) (time.Duration, error) {
+	if s == nil {
+		return 0, nil
+	}
+
+	limits, _, err := s.client.RateLimit.Get(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rate limit: %w", wrapGitHubError(err))
+	}
+
+	rate := limits.GetCore()
+	progress := RateLimitProgress{Completed: completed, Total: total, Remaining: rate.Remaining}
+	if rate.Remaining >= s.threshold {
+		s.report(progress)
+		return 0, nil
+	}
+
+	//
+	// The reset time can already be in the past,
+	// if the window rolled over since the rate limit was read.
+	//
+	wait := rate.Reset.Sub(s.now())
+	if wait <= 0 {
+		s.report(progress)
+		return 0, nil
+	}
+
+	progress.PausedUntil = rate.Reset.Format(time.RFC3339)
+	s.report(progress)
+	return wait, nil
+}
+
+func (s *rateLimitScheduler) report(progress RateLimitProgress) {
+	if s.onProgress != nil {
+		s.onProgress(progress)
+	}
+}
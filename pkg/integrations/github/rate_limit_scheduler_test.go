@@ -0,0 +1,157 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/mitchellh/mapstructure"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RateLimitScheduler(t *testing.T) {
+	now := time.Date(2026, 1, 16, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(10 * time.Minute)
+
+	schedulerFor := func(remaining *int, threshold int) (*rateLimitScheduler, *[]RateLimitProgress) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Path == "/rate_limit" {
+				return mockResponse(http.StatusOK, `{"resources":{"core":{"limit":5000,"remaining":`+strconv.Itoa(*remaining)+`,"reset":`+strconv.FormatInt(reset.Unix(), 10)+`}}}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"number":1,"state":"closed"}`), nil
+		}}
+
+		progress := []RateLimitProgress{}
+		scheduler := newRateLimitScheduler(github.NewClient(&http.Client{Transport: transport}), &threshold, func(p RateLimitProgress) {
+			progress = append(progress, p)
+		})
+
+		scheduler.now = func() time.Time { return now }
+		return scheduler, &progress
+	}
+
+	t.Run("no threshold -> no scheduler", func(t *testing.T) {
+		scheduler := newRateLimitScheduler(github.NewClient(nil), nil, nil)
+		assert.Nil(t, scheduler)
+
+		pause, err := scheduler.Pause(0, 1)
+		require.NoError(t, err)
+		assert.Zero(t, pause)
+	})
+
+	t.Run("enough requests left -> progress without pausing", func(t *testing.T) {
+		scheduler, progress := schedulerFor(github.Ptr(4000), 100)
+		pause, err := scheduler.Pause(3, 10)
+		require.NoError(t, err)
+
+		assert.Zero(t, pause)
+		assert.Equal(t, []RateLimitProgress{{Completed: 3, Total: 10, Remaining: 4000}}, *progress)
+	})
+
+	t.Run("below the threshold -> pause until the reset", func(t *testing.T) {
+		scheduler, progress := schedulerFor(github.Ptr(50), 100)
+		pause, err := scheduler.Pause(3, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, 10*time.Minute, pause)
+		assert.Equal(t, []RateLimitProgress{{Completed: 3, Total: 10, Remaining: 50, PausedUntil: reset.Local().Format(time.RFC3339)}}, *progress)
+	})
+
+	t.Run("bulk close pauses with a cursor, and resumes from it", func(t *testing.T) {
+		remaining := 4000
+		scheduler, _ := schedulerFor(&remaining, 100)
+		client := scheduler.client
+		issues := bulkIssues([]*github.Issue{
+			{Number: github.Ptr(1), RepositoryURL: github.Ptr("https://api.github.com/repos/testhq/hello")},
+			{Number: github.Ptr(2), RepositoryURL: github.Ptr("https://api.github.com/repos/testhq/hello")},
+		})
+
+		//
+		// The budget runs out after the first issue is closed.
+		//
+		scheduler.onProgress = func(p RateLimitProgress) { remaining = 50 }
+
+		results, pause, err := closeIssues(client, "testhq", "hello", issues, "", scheduler, []BulkCloseResult{})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Closed)
+		assert.Equal(t, 10*time.Minute, pause)
+
+		remaining = 4000
+		results, pause, err = closeIssues(client, "testhq", "hello", issues, "", scheduler, results)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.True(t, results[1].Closed)
+		assert.Zero(t, pause)
+	})
+}
+
+func Test__BulkCloseIssues__PauseAndResume(t *testing.T) {
+	now := time.Now()
+	remaining := 50
+	closed := []string{}
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		if request.URL.Path == "/rate_limit" {
+			return mockResponse(http.StatusOK, `{"resources":{"core":{"limit":5000,"remaining":`+strconv.Itoa(remaining)+`,"reset":`+strconv.FormatInt(now.Add(time.Hour).Unix(), 10)+`}}}`), nil
+		}
+
+		closed = append(closed, request.URL.Path)
+		return mockResponse(http.StatusOK, `{"number":1,"state":"closed"}`), nil
+	}}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	metadataCtx := &contexts.MetadataContext{}
+	stateCtx := &contexts.ExecutionStateContext{}
+	requestCtx := &contexts.RequestContext{}
+	component := &BulkCloseIssues{}
+	logger := log.NewEntry(log.StandardLogger())
+
+	require.NoError(t, component.closeAll(client, logger, metadataCtx, stateCtx, requestCtx, "testhq", BulkCloseIssuesMetadata{
+		Repository:         "hello",
+		Query:              "label:stale repo:testhq/hello is:issue is:open",
+		TotalCount:         1,
+		RateLimitThreshold: github.Ptr(100),
+		Issues:             []BulkIssue{{Number: 1, RepositoryURL: "https://api.github.com/repos/testhq/hello"}},
+		Results:            []BulkCloseResult{},
+	}))
+
+	//
+	// Paused: nothing was closed or emitted, and the resume is scheduled for the reset.
+	//
+	assert.Empty(t, closed)
+	assert.False(t, stateCtx.Finished)
+	assert.Equal(t, RateLimitResumeAction, requestCtx.Action)
+	assert.InDelta(t, time.Hour.Seconds(), requestCtx.Duration.Seconds(), 5)
+
+	//
+	// The metadata goes through JSON, like it does when it is stored.
+	//
+	data, err := json.Marshal(metadataCtx.Get())
+	require.NoError(t, err)
+	stored := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &stored))
+	metadataCtx.Set(stored)
+
+	resumed := BulkCloseIssuesMetadata{}
+	require.NoError(t, mapstructure.Decode(stored, &resumed))
+	assert.Empty(t, resumed.Results)
+	assert.Len(t, resumed.Issues, 1)
+	assert.NotEmpty(t, resumed.Progress.PausedUntil)
+
+	remaining = 4000
+	require.NoError(t, component.closeAll(client, logger, metadataCtx, stateCtx, requestCtx, "testhq", resumed))
+	assert.Equal(t, []string{"/repos/testhq/hello/issues/1"}, closed)
+	require.True(t, stateCtx.Finished)
+	assert.Equal(t, "github.issues.closed", stateCtx.Type)
+
+	output := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+	assert.Equal(t, 1, output["closed_count"])
+	assert.Equal(t, 1, output["total_count"])
+}
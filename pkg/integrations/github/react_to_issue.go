@@ -0,0 +1,334 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ReactionModeAdd    = "add"
+	ReactionModeRemove = "remove"
+)
+
+var ReactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+type ReactToIssue struct{}
+
+type ReactToIssueConfiguration struct {
+	Repository  string `json:"repository" mapstructure:"repository"`
+	IssueNumber string `json:"issueNumber" mapstructure:"issueNumber"`
+	Content     string `json:"content" mapstructure:"content"`
+	Mode        string `json:"mode" mapstructure:"mode"`
+}
+
+type IssueReaction struct {
+	ID      int64  `json:"id"`
+	Content string `json:"content"`
+	User    string `json:"user"`
+}
+
+type ReactToIssueOutput struct {
+	IssueNumber int             `json:"issue_number"`
+	Mode        string          `json:"mode"`
+	Content     string          `json:"content"`
+	Reaction    *IssueReaction  `json:"reaction,omitempty"`
+	Removed     bool            `json:"removed"`
+	Reactions   []IssueReaction `json:"reactions,omitempty"`
+}
+
+func (c *ReactToIssue) Name() string {
+	return "github.reactToIssue"
+}
+
+func (c *ReactToIssue) Label() string {
+	return "React to Issue"
+}
+
+func (c *ReactToIssue) Description() string {
+	return "Add or remove a reaction on a GitHub issue or pull request"
+}
+
+func (c *ReactToIssue) Documentation() string {
+	return `The React to Issue component adds a reaction to a GitHub issue or pull request, or removes the one it added before.
+
+## Use Cases
+
+- **Acknowledgement**: React with ` + "`eyes`" + ` when a workflow picks up an issue, and remove it when the work is done
+- **Status signals**: Swap reactions, like ` + "`rocket`" + ` for a deployed change, as the state of the issue changes
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue or pull request number (supports expressions)
+- **Reaction**: One of ` + "`+1`" + `, ` + "`-1`" + `, ` + "`laugh`" + `, ` + "`confused`" + `, ` + "`heart`" + `, ` + "`hooray`" + `, ` + "`rocket`" + `, or ` + "`eyes`" + `
+- **Mode**: Add the reaction, or remove it
+
+## Output
+
+- **Add** returns the created ` + "`reaction`" + `
+- **Remove** returns whether the reaction was ` + "`removed`" + `, and the ` + "`reactions`" + ` left on the issue
+
+## Notes
+
+- Only reactions made by the SuperPlane GitHub App are removed. Reactions from people are never touched
+- Removing a reaction that is not there is not an error: ` + "`removed`" + ` is false
+- Adding a reaction that is already there returns the existing reaction`
+}
+
+func (c *ReactToIssue) Icon() string {
+	return "github"
+}
+
+func (c *ReactToIssue) Color() string {
+	return "gray"
+}
+
+func (c *ReactToIssue) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ReactToIssue) Configuration() []configuration.Field {
+	options := make([]configuration.FieldOption, 0, len(ReactionContents))
+	for _, content := range ReactionContents {
+		options = append(options, configuration.FieldOption{Label: content, Value: content})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:     "content",
+			Label:    "Reaction",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  AcknowledgeReaction,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: options,
+				},
+			},
+		},
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  ReactionModeAdd,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Add", Value: ReactionModeAdd},
+						{Label: "Remove", Value: ReactionModeRemove},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *ReactToIssue) Setup(ctx core.SetupContext) error {
+	var config ReactToIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if !slices.Contains(ReactionContents, config.Content) {
+		return fmt.Errorf("invalid reaction: %s", config.Content)
+	}
+
+	if config.Mode != "" && !slices.Contains([]string{ReactionModeAdd, ReactionModeRemove}, config.Mode) {
+		return fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *ReactToIssue) Execute(ctx core.ExecutionContext) error {
+	var config ReactToIssueConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	var output *ReactToIssueOutput
+	if config.Mode == ReactionModeRemove {
+		output, err = removeIssueReaction(client, appMetadata.Owner, config.Repository, issueNumber, config.Content, appBotLogin(appMetadata.GitHubApp))
+	} else {
+		output, err = addIssueReaction(client, appMetadata.Owner, config.Repository, issueNumber, config.Content)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issueReaction",
+		[]any{output},
+	)
+}
+
+/*
+ * GitHub Apps act as a bot user, named after the app slug.
+ */
+func appBotLogin(app GitHubAppMetadata) string {
+	return app.Slug + "[bot]"
+}
+
+func addIssueReaction(client *github.Client, owner, repository string, issueNumber int, content string) (*ReactToIssueOutput, error) {
+	reaction, _, err := client.Reactions.CreateIssueReaction(context.Background(), owner, repository, issueNumber, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to react to issue %d: %w", issueNumber, wrapGitHubError(err))
+	}
+
+	created := issueReaction(reaction)
+	return &ReactToIssueOutput{
+		IssueNumber: issueNumber,
+		Mode:        ReactionModeAdd,
+		Content:     content,
+		Reaction:    &created,
+	}, nil
+}
+
+func removeIssueReaction(client *github.Client, owner, repository string, issueNumber int, content, login string) (*ReactToIssueOutput, error) {
+	reactions, err := listIssueReactions(client, owner, repository, issueNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ReactToIssueOutput{
+		IssueNumber: issueNumber,
+		Mode:        ReactionModeRemove,
+		Content:     content,
+		Reactions:   []IssueReaction{},
+	}
+
+	for _, reaction := range reactions {
+		if output.Removed || reaction.Content != content || reaction.User != login {
+			output.Reactions = append(output.Reactions, reaction)
+			continue
+		}
+
+		_, err := client.Reactions.DeleteIssueReaction(context.Background(), owner, repository, issueNumber, reaction.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove reaction %d from issue %d: %w", reaction.ID, issueNumber, wrapGitHubError(err))
+		}
+
+		output.Removed = true
+	}
+
+	return output, nil
+}
+
+func listIssueReactions(client *github.Client, owner, repository string, issueNumber int) ([]IssueReaction, error) {
+	opts := &github.ListReactionOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	reactions := []IssueReaction{}
+	for {
+		page, response, err := client.Reactions.ListIssueReactions(context.Background(), owner, repository, issueNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list reactions of issue %d: %w", issueNumber, wrapGitHubError(err))
+		}
+
+		for _, reaction := range page {
+			reactions = append(reactions, issueReaction(reaction))
+		}
+
+		if response.NextPage == 0 {
+			return reactions, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func issueReaction(reaction *github.Reaction) IssueReaction {
+	return IssueReaction{
+		ID:      reaction.GetID(),
+		Content: reaction.GetContent(),
+		User:    reaction.GetUser().GetLogin(),
+	}
+}
+
+func (c *ReactToIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ReactToIssue) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ReactToIssue) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ReactToIssue) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ReactToIssue) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ReactToIssue) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ReactToIssue__Setup(t *testing.T) {
+	component := ReactToIssue{}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{{Name: "api"}}}}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "content": "eyes"},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("invalid reaction -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "issueNumber": "1", "content": "thumbsup"},
+		})
+
+		require.ErrorContains(t, err, "invalid reaction: thumbsup")
+	})
+
+	t.Run("invalid mode -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "issueNumber": "1", "content": "eyes", "mode": "toggle"},
+		})
+
+		require.ErrorContains(t, err, "invalid mode: toggle")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "api", "issueNumber": "{{$.data.issue.number}}", "content": "eyes", "mode": "remove"},
+		}))
+	})
+}
+
+func Test__ReactToIssue__Add(t *testing.T) {
+	transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		return mockResponse(http.StatusCreated, `{"id":10,"content":"eyes","user":{"login":"superplane-app[bot]"}}`), nil
+	}}
+
+	output, err := addIssueReaction(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", 7, "eyes")
+	require.NoError(t, err)
+	require.NotNil(t, output.Reaction)
+	assert.Equal(t, int64(10), output.Reaction.ID)
+	assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+	assert.Equal(t, "/repos/testhq/api/issues/7/reactions", transport.requests[0].URL.Path)
+}
+
+func Test__ReactToIssue__Remove(t *testing.T) {
+	reactions := `[
+		{"id":1,"content":"eyes","user":{"login":"octocat"}},
+		{"id":2,"content":"rocket","user":{"login":"superplane-app[bot]"}},
+		{"id":3,"content":"eyes","user":{"login":"superplane-app[bot]"}}
+	]`
+
+	t.Run("removes the reaction of the app only", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodDelete {
+				return mockResponse(http.StatusNoContent, ``), nil
+			}
+
+			return mockResponse(http.StatusOK, reactions), nil
+		}}
+
+		output, err := removeIssueReaction(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", 7, "eyes", "superplane-app[bot]")
+		require.NoError(t, err)
+		assert.True(t, output.Removed)
+		require.Len(t, output.Reactions, 2)
+		assert.Equal(t, int64(1), output.Reactions[0].ID)
+		assert.Equal(t, int64(2), output.Reactions[1].ID)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, http.MethodDelete, transport.requests[1].Method)
+		assert.Equal(t, "/repos/testhq/api/issues/7/reactions/3", transport.requests[1].URL.Path)
+	})
+
+	t.Run("no matching reaction -> nothing removed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, reactions), nil
+		}}
+
+		output, err := removeIssueReaction(github.NewClient(&http.Client{Transport: transport}), "testhq", "api", 7, "heart", "superplane-app[bot]")
+		require.NoError(t, err)
+		assert.False(t, output.Removed)
+		assert.Len(t, output.Reactions, 3)
+		assert.Len(t, transport.requests, 1)
+	})
+}
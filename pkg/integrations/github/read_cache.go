@@ -0,0 +1,256 @@
+package github
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * Read components can opt in to caching their results for a while,
+ * so workflows reading the same data over and over do not call GitHub each time.
+ *
+ * Results are keyed on the installation, the method and its arguments, like coalesced reads,
+ * so they are never shared across integrations. Errors are never cached.
+ * The least recently used results are evicted when the cache is full,
+ * and webhook events for a repository drop its cached results.
+ *
+ * Cached results are shared by all callers, so they must be treated as read-only.
+ */
+const (
+	MaxCachedReads  = 1000
+	MaxCacheTTLSecs = 3600
+)
+
+var CacheTTLField = configuration.Field{
+	Name:        "cacheTtl",
+	Label:       "Cache TTL (seconds)",
+	Type:        configuration.FieldTypeNumber,
+	Togglable:   true,
+	Description: "Reuse the result of an identical read made in the last number of seconds, instead of calling GitHub again",
+	TypeOptions: &configuration.TypeOptions{
+		Number: &configuration.NumberTypeOptions{
+			Min: func() *int { min := 1; return &min }(),
+			Max: func() *int { max := MaxCacheTTLSecs; return &max }(),
+		},
+	},
+}
+
+var BypassCacheField = configuration.Field{
+	Name:        "bypassCache",
+	Label:       "Bypass Cache",
+	Type:        configuration.FieldTypeBool,
+	Default:     false,
+	Description: "Always call GitHub, and refresh the cached result",
+}
+
+type ReadCacheOptions struct {
+	TTL    time.Duration
+	Bypass bool
+}
+
+func (o ReadCacheOptions) enabled() bool {
+	return o.TTL > 0
+}
+
+/*
+ * Reads the cache options of a component from its configuration.
+ * Without a TTL, results are not cached.
+ */
+func readCacheOptions(c any) ReadCacheOptions {
+	configMap, ok := c.(map[string]any)
+	if !ok {
+		return ReadCacheOptions{}
+	}
+
+	options := ReadCacheOptions{}
+	options.Bypass, _ = configMap["bypassCache"].(bool)
+
+	var seconds int
+	switch ttl := configMap["cacheTtl"].(type) {
+	case int:
+		seconds = ttl
+	case float64:
+		seconds = int(ttl)
+	}
+
+	if seconds > 0 {
+		options.TTL = time.Duration(min(seconds, MaxCacheTTLSecs)) * time.Second
+	}
+
+	return options
+}
+
+var readCache = NewReadCache(MaxCachedReads, time.Now)
+
+/*
+ * Returns the cached result of the read, if there is one that has not expired.
+ * Otherwise, makes the read and caches its result.
+ * Bypassing the cache skips the lookup, but still caches the new result.
+ * The read itself is usually coalesced, so misses for the same key share one API call.
+ */
+func cachedRead[T any](installationID string, options ReadCacheOptions, method string, args []any, read func() (T, error)) (T, error) {
+	if !options.enabled() {
+		return read()
+	}
+
+	key := coalesceKey(installationID, method, args)
+	if !options.Bypass {
+		if value, ok := readCache.Get(key); ok {
+			return value.(T), nil
+		}
+	}
+
+	value, err := read()
+	if err != nil {
+		return value, err
+	}
+
+	readCache.Set(key, repositoryScope(installationID, args), value, options.TTL)
+	return value, nil
+}
+
+/*
+ * Cached reads take the owner and repository as their first arguments,
+ * so the results of a repository can be dropped together.
+ */
+func repositoryScope(installationID string, args []any) string {
+	if len(args) < 2 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s\x00%v/%v", installationID, args[0], args[1])
+}
+
+/*
+ * Drops the cached results of a repository,
+ * after GitHub tells us something in it changed.
+ */
+func invalidateRepositoryReads(installationID, owner, repo string) {
+	readCache.Invalidate(repositoryScope(installationID, []any{owner, repo}))
+}
+
+/*
+ * Triggers receiving events that change a repository,
+ * like pushes, pull requests and releases, drop its cached reads.
+ * Nodes without an integration, like replayed webhooks, have no cached reads to drop.
+ */
+func invalidateReadsForWebhook(ctx core.WebhookRequestContext) {
+	if ctx.Integration == nil {
+		return
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return
+	}
+
+	var event struct {
+		Repository struct {
+			Name  string `json:"name"`
+			Owner struct {
+				Login string `json:"login"`
+			} `json:"owner"`
+		} `json:"repository"`
+	}
+
+	if err := json.Unmarshal(ctx.Body, &event); err != nil || event.Repository.Name == "" {
+		return
+	}
+
+	invalidateRepositoryReads(appMetadata.InstallationID, event.Repository.Owner.Login, event.Repository.Name)
+}
+
+/*
+ * ReadCache is an in-memory LRU cache where each entry has its own expiration.
+ */
+type ReadCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	now        func() time.Time
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+type readCacheEntry struct {
+	key       string
+	scope     string
+	value     any
+	expiresAt time.Time
+}
+
+func NewReadCache(maxEntries int, now func() time.Time) *ReadCache {
+	return &ReadCache{
+		maxEntries: maxEntries,
+		now:        now,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+func (c *ReadCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*readCacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (c *ReadCache) Set(key, scope string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	entry := &readCacheEntry{key: key, scope: scope, value: value, expiresAt: c.now().Add(ttl)}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *ReadCache) Invalidate(scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if element.Value.(*readCacheEntry).scope == scope {
+			c.remove(element)
+		}
+
+		element = next
+	}
+}
+
+func (c *ReadCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *ReadCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*readCacheEntry).key)
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ReadCache(t *testing.T) {
+	now := time.Date(2026, 1, 16, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("entries expire after their TTL", func(t *testing.T) {
+		cache := NewReadCache(10, clock)
+		cache.Set("a", "", "value", time.Minute)
+
+		value, ok := cache.Get("a")
+		require.True(t, ok)
+		assert.Equal(t, "value", value)
+
+		now = now.Add(time.Minute)
+		_, ok = cache.Get("a")
+		assert.False(t, ok)
+		assert.Zero(t, cache.Len())
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		cache := NewReadCache(2, clock)
+		cache.Set("a", "", 1, time.Minute)
+		cache.Set("b", "", 2, time.Minute)
+		_, _ = cache.Get("a")
+		cache.Set("c", "", 3, time.Minute)
+
+		_, ok := cache.Get("b")
+		assert.False(t, ok)
+		_, ok = cache.Get("a")
+		assert.True(t, ok)
+		_, ok = cache.Get("c")
+		assert.True(t, ok)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("invalidating a scope drops only its entries", func(t *testing.T) {
+		cache := NewReadCache(10, clock)
+		cache.Set("a", "hello", 1, time.Minute)
+		cache.Set("b", "hello", 2, time.Minute)
+		cache.Set("c", "world", 3, time.Minute)
+
+		cache.Invalidate("hello")
+		assert.Equal(t, 1, cache.Len())
+		_, ok := cache.Get("c")
+		assert.True(t, ok)
+	})
+}
+
+func Test__CachedRead(t *testing.T) {
+	original := readCache
+	readCache = NewReadCache(MaxCachedReads, time.Now)
+	t.Cleanup(func() { readCache = original })
+
+	calls := 0
+	read := func() (string, error) {
+		calls++
+		return "main", nil
+	}
+
+	cached := ReadCacheOptions{TTL: time.Minute}
+	args := []any{"testhq", "hello"}
+
+	t.Run("without TTL -> no caching", func(t *testing.T) {
+		calls = 0
+		_, _ = cachedRead("1001", ReadCacheOptions{}, "GetDefaultBranch", args, read)
+		_, _ = cachedRead("1001", ReadCacheOptions{}, "GetDefaultBranch", args, read)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("identical reads are served from the cache", func(t *testing.T) {
+		calls = 0
+		value, err := cachedRead("1001", cached, "GetDefaultBranch", args, read)
+		require.NoError(t, err)
+		assert.Equal(t, "main", value)
+
+		value, err = cachedRead("1001", cached, "GetDefaultBranch", args, read)
+		require.NoError(t, err)
+		assert.Equal(t, "main", value)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("results are not shared across installations", func(t *testing.T) {
+		calls = 0
+		_, _ = cachedRead("2002", cached, "GetDefaultBranch", args, read)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("bypass -> read again, and refresh the cache", func(t *testing.T) {
+		calls = 0
+		_, _ = cachedRead("1001", ReadCacheOptions{TTL: time.Minute, Bypass: true}, "GetDefaultBranch", args, read)
+		_, _ = cachedRead("1001", cached, "GetDefaultBranch", args, read)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		calls = 0
+		failing := func() (string, error) {
+			calls++
+			return "", errors.New("boom")
+		}
+
+		_, err := cachedRead("1001", cached, "GetDefaultBranch", []any{"testhq", "missing"}, failing)
+		require.Error(t, err)
+		_, err = cachedRead("1001", cached, "GetDefaultBranch", []any{"testhq", "missing"}, failing)
+		require.Error(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("webhook events drop the reads of their repository", func(t *testing.T) {
+		calls = 0
+		_, _ = cachedRead("1001", cached, "GetDefaultBranch", args, read)
+		_, _ = cachedRead("1001", cached, "GetDefaultBranch", []any{"testhq", "other"}, read)
+		assert.Equal(t, 1, calls)
+
+		invalidateReadsForWebhook(core.WebhookRequestContext{
+			Headers:     http.Header{},
+			Body:        []byte(`{"ref":"refs/heads/main","repository":{"name":"hello","owner":{"login":"testhq"}}}`),
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{InstallationID: "1001"}},
+		})
+
+		_, _ = cachedRead("1001", cached, "GetDefaultBranch", args, read)
+		_, _ = cachedRead("1001", cached, "GetDefaultBranch", []any{"testhq", "other"}, read)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func Test__ReadCacheOptions(t *testing.T) {
+	assert.Equal(t, ReadCacheOptions{}, readCacheOptions(map[string]any{}))
+	assert.Equal(t, ReadCacheOptions{TTL: 30 * time.Second}, readCacheOptions(map[string]any{"cacheTtl": float64(30)}))
+	assert.Equal(t, ReadCacheOptions{TTL: MaxCacheTTLSecs * time.Second, Bypass: true}, readCacheOptions(map[string]any{"cacheTtl": 7200, "bypassCache": true}))
+}
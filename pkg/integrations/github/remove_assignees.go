@@ -0,0 +1,255 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type RemoveAssignees struct{}
+
+type RemoveAssigneesConfiguration struct {
+	Repository  string   `json:"repository" mapstructure:"repository"`
+	IssueNumber string   `json:"issueNumber" mapstructure:"issueNumber"`
+	Assignees   []string `json:"assignees" mapstructure:"assignees"`
+	RemoveAll   bool     `json:"removeAll" mapstructure:"removeAll"`
+}
+
+type RemoveAssigneesOutput struct {
+	IssueNumber int      `json:"issue_number"`
+	Removed     []string `json:"removed"`
+	Assignees   []string `json:"assignees"`
+	URL         string   `json:"html_url"`
+}
+
+func (c *RemoveAssignees) Name() string {
+	return "github.removeAssignees"
+}
+
+func (c *RemoveAssignees) Label() string {
+	return "Remove Assignees"
+}
+
+func (c *RemoveAssignees) Description() string {
+	return "Remove assignees from a GitHub issue or pull request"
+}
+
+func (c *RemoveAssignees) Documentation() string {
+	return `The Remove Assignees component removes people from the assignees of a GitHub issue or pull request.
+
+## Use Cases
+
+- **Handoffs**: Clear the assignees when an issue moves to another team
+- **Stale work**: Unassign issues that have not been updated for a while
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue or pull request number (supports expressions)
+- **Remove All**: Remove everyone assigned to the issue
+- **Assignees**: The GitHub usernames to remove, if not removing all
+
+## Output
+
+Returns the usernames that were asked to be ` + "`removed`" + `, and the ` + "`assignees`" + ` left on the issue.
+
+## Notes
+
+- Removing someone who is not assigned does nothing`
+}
+
+func (c *RemoveAssignees) Icon() string {
+	return "github"
+}
+
+func (c *RemoveAssignees) Color() string {
+	return "gray"
+}
+
+func (c *RemoveAssignees) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *RemoveAssignees) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:        "removeAll",
+			Label:       "Remove All",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Remove everyone assigned to the issue",
+		},
+		{
+			Name:  "assignees",
+			Label: "Assignees",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Assignee",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "removeAll", Values: []string{"false"}},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *RemoveAssignees) Setup(ctx core.SetupContext) error {
+	var config RemoveAssigneesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	if !config.RemoveAll && len(config.Assignees) == 0 {
+		return errors.New("at least one assignee is required, unless removing all")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *RemoveAssignees) Execute(ctx core.ExecutionContext) error {
+	var config RemoveAssigneesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := removeAssignees(client, appMetadata.Owner, config.Repository, issueNumber, config.Assignees, config.RemoveAll)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issueAssignees",
+		[]any{output},
+	)
+}
+
+func removeAssignees(client *github.Client, owner, repository string, issueNumber int, assignees []string, removeAll bool) (*RemoveAssigneesOutput, error) {
+	if removeAll {
+		issue, _, err := client.Issues.Get(context.Background(), owner, repository, issueNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue %d: %w", issueNumber, wrapGitHubError(err))
+		}
+
+		assignees = assigneeLogins(issue)
+		if len(assignees) == 0 {
+			return &RemoveAssigneesOutput{
+				IssueNumber: issueNumber,
+				Removed:     []string{},
+				Assignees:   []string{},
+				URL:         issue.GetHTMLURL(),
+			}, nil
+		}
+	}
+
+	issue, _, err := client.Issues.RemoveAssignees(context.Background(), owner, repository, issueNumber, assignees)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove assignees from issue %d: %w", issueNumber, wrapGitHubError(err))
+	}
+
+	return &RemoveAssigneesOutput{
+		IssueNumber: issueNumber,
+		Removed:     assignees,
+		Assignees:   assigneeLogins(issue),
+		URL:         issue.GetHTMLURL(),
+	}, nil
+}
+
+func assigneeLogins(issue *github.Issue) []string {
+	logins := []string{}
+	for _, assignee := range issue.Assignees {
+		logins = append(logins, assignee.GetLogin())
+	}
+
+	return logins
+}
+
+func (c *RemoveAssignees) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RemoveAssignees) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *RemoveAssignees) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *RemoveAssignees) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *RemoveAssignees) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RemoveAssignees) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
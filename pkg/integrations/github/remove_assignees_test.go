@@ -0,0 +1,94 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RemoveAssignees__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	integration := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}}
+	component := RemoveAssignees{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "assignees": []any{"octocat"}},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("assignees are required unless removing all", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "7"},
+		})
+
+		require.ErrorContains(t, err, "at least one assignee is required")
+	})
+
+	t.Run("remove all -> ok", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integration,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "7", "removeAll": true},
+		}))
+	})
+}
+
+func Test__RemoveAssignees__Remove(t *testing.T) {
+	t.Run("configured assignees are removed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"assignees":["octocat","ghost"]}`, string(body))
+			return mockResponse(http.StatusOK, `{"number":7,"assignees":[{"login":"hubot"}]}`), nil
+		}}
+
+		output, err := removeAssignees(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, []string{"octocat", "ghost"}, false)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, http.MethodDelete, transport.requests[0].Method)
+		assert.Equal(t, "/repos/testhq/hello/issues/7/assignees", transport.requests[0].URL.Path)
+		assert.Equal(t, []string{"octocat", "ghost"}, output.Removed)
+		assert.Equal(t, []string{"hubot"}, output.Assignees)
+	})
+
+	t.Run("remove all -> current assignees are removed", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"number":7,"assignees":[{"login":"octocat"},{"login":"hubot"}]}`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"assignees":["octocat","hubot"]}`, string(body))
+			return mockResponse(http.StatusOK, `{"number":7,"assignees":[]}`), nil
+		}}
+
+		output, err := removeAssignees(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, nil, true)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, []string{"octocat", "hubot"}, output.Removed)
+		assert.Empty(t, output.Assignees)
+	})
+
+	t.Run("remove all with nobody assigned -> nothing to remove", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"number":7,"assignees":[]}`), nil
+		}}
+
+		output, err := removeAssignees(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 7, nil, true)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.Empty(t, output.Removed)
+	})
+}
@@ -0,0 +1,317 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+)
+
+const WebhookReplayDisabledError = "webhook replay is only available in development and test environments"
+
+type ReplayWebhook struct{}
+
+type ReplayWebhookConfiguration struct {
+	Trigger              string `json:"trigger" mapstructure:"trigger"`
+	TriggerConfiguration any    `json:"triggerConfiguration" mapstructure:"triggerConfiguration"`
+	Event                string `json:"event" mapstructure:"event"`
+	Payload              any    `json:"payload" mapstructure:"payload"`
+}
+
+type ReplayedEvent struct {
+	Type string `json:"type"`
+	Data any    `json:"data"`
+}
+
+type ReplayWebhookOutput struct {
+	Trigger    string          `json:"trigger"`
+	Event      string          `json:"event"`
+	StatusCode int             `json:"status_code"`
+	Events     []ReplayedEvent `json:"events"`
+}
+
+/*
+ * Replaying webhooks is only possible in development and test environments,
+ * so recorded payloads can never trigger anything in production.
+ */
+func webhookReplayEnabled() bool {
+	appEnv := os.Getenv("APP_ENV")
+	return appEnv == "development" || appEnv == "test"
+}
+
+func (c *ReplayWebhook) Name() string {
+	return "github.replayWebhook"
+}
+
+func (c *ReplayWebhook) Label() string {
+	return "Replay Webhook"
+}
+
+func (c *ReplayWebhook) Description() string {
+	return "Run a recorded GitHub webhook delivery through a trigger, as if it was received live"
+}
+
+func (c *ReplayWebhook) Documentation() string {
+	return `The Replay Webhook component runs a recorded GitHub webhook delivery through the webhook handler of a GitHub trigger, and emits the events the trigger would emit.
+
+## Use Cases
+
+- **Trigger development**: Test how a trigger handles a delivery without waiting for GitHub to send it
+- **ChatOps testing**: Check how comment commands are parsed, in a sandbox
+
+## Configuration
+
+- **Trigger**: The GitHub trigger to replay the delivery through
+- **Trigger Configuration**: The configuration of the trigger, as JSON
+- **Event**: The GitHub event name, sent in the ` + "`X-GitHub-Event`" + ` header, e.g. ` + "`issue_comment`" + `
+- **Payload**: The recorded webhook payload, as JSON
+
+## Output
+
+Emits a ` + "`github.webhookReplay`" + ` event with the ` + "`status_code`" + ` returned by the trigger, and the events it emitted in ` + "`events`" + `, each with its ` + "`type`" + ` and ` + "`data`" + `.
+If the trigger filters the delivery out, ` + "`events`" + ` is empty.
+
+## Notes
+
+- Only available when ` + "`APP_ENV`" + ` is ` + "`development`" + ` or ` + "`test`" + `. The component is not registered in other environments, and fails if it runs there
+- The delivery is signed with a one-time secret, so the trigger's signature check passes
+- Nothing is sent to GitHub. Triggers that acknowledge comments skip the acknowledgement`
+}
+
+func (c *ReplayWebhook) Icon() string {
+	return "github"
+}
+
+func (c *ReplayWebhook) Color() string {
+	return "gray"
+}
+
+func (c *ReplayWebhook) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *ReplayWebhook) Configuration() []configuration.Field {
+	options := []configuration.FieldOption{}
+	for _, trigger := range (&GitHub{}).Triggers() {
+		options = append(options, configuration.FieldOption{Label: trigger.Label(), Value: trigger.Name()})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "trigger",
+			Label:    "Trigger",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: options,
+				},
+			},
+		},
+		{
+			Name:        "triggerConfiguration",
+			Label:       "Trigger Configuration",
+			Type:        configuration.FieldTypeJSON,
+			Default:     map[string]any{},
+			Description: "The configuration of the trigger",
+			TypeOptions: &configuration.TypeOptions{
+				JSON: &configuration.JSONTypeOptions{
+					Schema: map[string]any{"type": "object"},
+				},
+			},
+		},
+		{
+			Name:        "event",
+			Label:       "Event",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., issue_comment",
+			Description: "The GitHub event name of the delivery",
+		},
+		{
+			Name:        "payload",
+			Label:       "Payload",
+			Type:        configuration.FieldTypeJSON,
+			Required:    true,
+			Description: "The recorded webhook payload",
+			TypeOptions: &configuration.TypeOptions{
+				JSON: &configuration.JSONTypeOptions{
+					Schema: map[string]any{"type": "object"},
+				},
+			},
+		},
+	}
+}
+
+func (c *ReplayWebhook) Setup(ctx core.SetupContext) error {
+	if !webhookReplayEnabled() {
+		return errors.New(WebhookReplayDisabledError)
+	}
+
+	var config ReplayWebhookConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if findGitHubTrigger(config.Trigger) == nil {
+		return fmt.Errorf("invalid trigger: %s", config.Trigger)
+	}
+
+	if config.Event == "" {
+		return errors.New("event is required")
+	}
+
+	if config.Payload == nil {
+		return errors.New("payload is required")
+	}
+
+	return nil
+}
+
+func (c *ReplayWebhook) Execute(ctx core.ExecutionContext) error {
+	if !webhookReplayEnabled() {
+		return errors.New(WebhookReplayDisabledError)
+	}
+
+	var config ReplayWebhookConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	trigger := findGitHubTrigger(config.Trigger)
+	if trigger == nil {
+		return fmt.Errorf("invalid trigger: %s", config.Trigger)
+	}
+
+	body, err := json.Marshal(config.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	statusCode, events, err := replayWebhook(trigger, config.Event, body, config.TriggerConfiguration)
+	if err != nil {
+		return fmt.Errorf("%s rejected the delivery with status %d: %w", config.Trigger, statusCode, err)
+	}
+
+	ctx.Logger.Infof("%s emitted %d events for the replayed %s delivery", config.Trigger, len(events), config.Event)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.webhookReplay",
+		[]any{ReplayWebhookOutput{
+			Trigger:    config.Trigger,
+			Event:      config.Event,
+			StatusCode: statusCode,
+			Events:     events,
+		}},
+	)
+}
+
+func findGitHubTrigger(name string) core.Trigger {
+	triggers := (&GitHub{}).Triggers()
+	i := slices.IndexFunc(triggers, func(trigger core.Trigger) bool {
+		return trigger.Name() == name
+	})
+
+	if i < 0 {
+		return nil
+	}
+
+	return triggers[i]
+}
+
+/*
+ * Runs a recorded delivery through the webhook handler of a trigger,
+ * signed with a one-time secret, and collects the events it emits.
+ * The trigger gets no integration, so it cannot call the GitHub API,
+ * for example, to acknowledge a replayed comment.
+ */
+func replayWebhook(trigger core.Trigger, event string, body []byte, configuration any) (int, []ReplayedEvent, error) {
+	secret, err := crypto.Base64String(32)
+	if err != nil {
+		return http.StatusInternalServerError, nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	headers := http.Header{}
+	headers.Set("X-GitHub-Event", event)
+	headers.Set("X-Hub-Signature-256", "sha256="+crypto.Sign([]byte(secret), body))
+
+	events := &replayEventContext{events: []ReplayedEvent{}}
+	statusCode, err := trigger.HandleWebhook(core.WebhookRequestContext{
+		Body:          body,
+		Headers:       headers,
+		Configuration: configuration,
+		Webhook:       &replayWebhookContext{secret: []byte(secret)},
+		Events:        events,
+		FindExecutionByKV: func(key string, value string) (*core.ExecutionContext, error) {
+			return nil, nil
+		},
+	})
+
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	return statusCode, events.events, nil
+}
+
+type replayEventContext struct {
+	events []ReplayedEvent
+}
+
+func (e *replayEventContext) Emit(payloadType string, payload any) error {
+	e.events = append(e.events, ReplayedEvent{Type: payloadType, Data: payload})
+	return nil
+}
+
+type replayWebhookContext struct {
+	secret []byte
+}
+
+func (w *replayWebhookContext) Setup() (string, error) {
+	return "", errors.New("replayed webhooks cannot be set up")
+}
+
+func (w *replayWebhookContext) GetSecret() ([]byte, error) {
+	return w.secret, nil
+}
+
+func (w *replayWebhookContext) ResetSecret() ([]byte, []byte, error) {
+	return nil, nil, errors.New("replayed webhooks cannot reset their secret")
+}
+
+func (w *replayWebhookContext) GetBaseURL() string {
+	return ""
+}
+
+func (c *ReplayWebhook) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *ReplayWebhook) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *ReplayWebhook) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *ReplayWebhook) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *ReplayWebhook) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *ReplayWebhook) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
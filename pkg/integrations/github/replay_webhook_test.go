@@ -0,0 +1,127 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ReplayWebhook__Setup(t *testing.T) {
+	component := ReplayWebhook{}
+	configuration := map[string]any{
+		"trigger": "github.onIssueComment",
+		"event":   "issue_comment",
+		"payload": map[string]any{"action": "created"},
+	}
+
+	t.Run("production -> error", func(t *testing.T) {
+		t.Setenv("APP_ENV", "production")
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+
+		require.ErrorContains(t, err, WebhookReplayDisabledError)
+	})
+
+	t.Run("unknown trigger -> error", func(t *testing.T) {
+		t.Setenv("APP_ENV", "test")
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"trigger": "github.onStar", "event": "star", "payload": map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "invalid trigger: github.onStar")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		}))
+	})
+}
+
+func Test__ReplayWebhook__Registration(t *testing.T) {
+	isRegistered := func() bool {
+		for _, component := range (&GitHub{}).Components() {
+			if component.Name() == "github.replayWebhook" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	t.Setenv("APP_ENV", "production")
+	assert.False(t, isRegistered())
+
+	t.Setenv("APP_ENV", "development")
+	assert.True(t, isRegistered())
+}
+
+func Test__ReplayWebhook__Replay(t *testing.T) {
+	trigger := findGitHubTrigger("github.onIssueComment")
+	require.NotNil(t, trigger)
+
+	body := []byte(`{"action":"created","issue":{"number":42},"comment":{"id":1,"body":"/deploy staging"}}`)
+
+	t.Run("matching delivery -> trigger events", func(t *testing.T) {
+		code, events, err := replayWebhook(trigger, "issue_comment", body, map[string]any{"repository": "hello", "contentFilter": "^/deploy"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, events, 1)
+		assert.Equal(t, "github.issueComment", events[0].Type)
+		assert.Equal(t, float64(42), events[0].Data.(map[string]any)["issue"].(map[string]any)["number"])
+	})
+
+	t.Run("filtered delivery -> no events", func(t *testing.T) {
+		code, events, err := replayWebhook(trigger, "issue_comment", body, map[string]any{"repository": "hello", "contentFilter": "^/rollback"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, events)
+	})
+
+	t.Run("auto acknowledge does not call GitHub", func(t *testing.T) {
+		_, events, err := replayWebhook(trigger, "issue_comment", body, map[string]any{"repository": "hello", "autoAcknowledge": true})
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("invalid payload -> trigger error", func(t *testing.T) {
+		code, _, err := replayWebhook(trigger, "issue_comment", []byte(`not json`), map[string]any{"repository": "hello"})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.ErrorContains(t, err, "error parsing request body")
+	})
+}
+
+func Test__WebhookContext__Sign(t *testing.T) {
+	trigger := &OnIssueComment{}
+	webhook := &contexts.WebhookContext{Secret: "test-secret"}
+	body := []byte(`{"action":"created","issue":{"number":42},"comment":{"body":"hello"}}`)
+
+	headers := http.Header{}
+	headers.Set("X-GitHub-Event", "issue_comment")
+	headers.Set("X-Hub-Signature-256", "sha256="+webhook.Sign(body))
+
+	events := &contexts.EventContext{}
+	code, err := trigger.HandleWebhook(core.WebhookRequestContext{
+		Body:          body,
+		Headers:       headers,
+		Configuration: map[string]any{"repository": "hello"},
+		Webhook:       webhook,
+		Events:        events,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1, events.Count())
+}
@@ -0,0 +1,269 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const CopilotReviewerLogin = "copilot-pull-request-reviewer[bot]"
+
+type RequestCopilotReview struct{}
+
+type RequestCopilotReviewConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+}
+
+type CopilotReviewRequest struct {
+	Number   int    `json:"number"`
+	Reviewer string `json:"reviewer"`
+	URL      string `json:"html_url"`
+}
+
+func (c *RequestCopilotReview) Name() string {
+	return "github.requestCopilotReview"
+}
+
+func (c *RequestCopilotReview) Label() string {
+	return "Request Copilot Review"
+}
+
+func (c *RequestCopilotReview) Description() string {
+	return "Request a review from Copilot on a GitHub pull request"
+}
+
+func (c *RequestCopilotReview) Documentation() string {
+	return `The Request Copilot Review component requests a code review from GitHub Copilot on a pull request.
+
+## Use Cases
+
+- **Automated first pass**: Have Copilot review every pull request before human reviewers are assigned
+- **Re-reviews**: Ask Copilot for another review after new commits are pushed
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+
+## Output
+
+Emits the pull request ` + "`number`" + `, its ` + "`html_url`" + `, and the ` + "`reviewer`" + ` that was requested, ` + "`" + CopilotReviewerLogin + "`" + `.
+
+## Notes
+
+- Copilot code review must be available to the repository, through a Copilot plan of the organization or its owner.
+  If it is not, the execution fails with an error saying so
+- Already requested reviewers are kept. To request human reviewers, use Request Reviewers`
+}
+
+func (c *RequestCopilotReview) Icon() string {
+	return "github"
+}
+
+func (c *RequestCopilotReview) Color() string {
+	return "gray"
+}
+
+func (c *RequestCopilotReview) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *RequestCopilotReview) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *RequestCopilotReview) Setup(ctx core.SetupContext) error {
+	var config RequestCopilotReviewConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *RequestCopilotReview) Execute(ctx core.ExecutionContext) error {
+	var config RequestCopilotReviewConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.copilotReviewRequest", func() (any, error) {
+		return requestCopilotReview(client, appMetadata.Owner, config.Repository, pullNumber)
+	})
+}
+
+/*
+ * The Copilot reviewer is a bot, and the REST API only requests reviews from users and teams,
+ * so the review is requested through GraphQL, by the login of the bot.
+ */
+func requestCopilotReview(client *githubv4.Client, owner, repository string, number int) (*CopilotReviewRequest, error) {
+	var query struct {
+		Repository struct {
+			PullRequest *struct {
+				ID  githubv4.ID
+				URL string
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to find pull request %d: %w", number, err)
+	}
+
+	pullRequest := query.Repository.PullRequest
+	if pullRequest == nil || pullRequest.ID == nil {
+		return nil, fmt.Errorf("%w: pull request %d", ErrNotFound, number)
+	}
+
+	var mutation struct {
+		RequestReviewsByLogin struct {
+			PullRequest struct {
+				ReviewRequests struct {
+					Nodes []struct {
+						RequestedReviewer struct {
+							Bot struct {
+								Login string
+							} `graphql:"... on Bot"`
+						}
+					}
+				} `graphql:"reviewRequests(first: 100)"`
+			}
+		} `graphql:"requestReviewsByLogin(input: $input)"`
+	}
+
+	input := githubv4.RequestReviewsByLoginInput{
+		PullRequestID: pullRequest.ID,
+		BotLogins:     &[]githubv4.String{CopilotReviewerLogin},
+		Union:         githubv4.NewBoolean(true),
+	}
+
+	if err := client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "could not resolve") {
+			return nil, copilotReviewUnavailable(repository, err)
+		}
+
+		return nil, fmt.Errorf("failed to request Copilot review on pull request %d: %w", number, err)
+	}
+
+	//
+	// When Copilot review is not available to the repository,
+	// GitHub can accept the mutation without requesting the review.
+	//
+	for _, request := range mutation.RequestReviewsByLogin.PullRequest.ReviewRequests.Nodes {
+		if copilotReviewerLogin(request.RequestedReviewer.Bot.Login) {
+			return &CopilotReviewRequest{Number: number, Reviewer: CopilotReviewerLogin, URL: pullRequest.URL}, nil
+		}
+	}
+
+	return nil, copilotReviewUnavailable(repository, nil)
+}
+
+/*
+ * GitHub reports bot logins with or without the [bot] suffix, depending on the API.
+ */
+func copilotReviewerLogin(login string) bool {
+	return strings.EqualFold(strings.TrimSuffix(login, "[bot]"), strings.TrimSuffix(CopilotReviewerLogin, "[bot]"))
+}
+
+func copilotReviewUnavailable(repository string, err error) error {
+	message := fmt.Sprintf("Copilot code review is not available for %s, enable Copilot for the organization and allow Copilot code review in its policies", repository)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrFeatureDisabled, message, err)
+	}
+
+	return fmt.Errorf("%w: %s", ErrFeatureDisabled, message)
+}
+
+func (c *RequestCopilotReview) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RequestCopilotReview) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *RequestCopilotReview) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *RequestCopilotReview) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *RequestCopilotReview) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RequestCopilotReview) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RequestCopilotReview__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := RequestCopilotReview{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__RequestCopilotReview__Request(t *testing.T) {
+	var mutationBody string
+	graphQLTransport := func(pullRequest, mutation string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			if strings.Contains(string(body), "requestReviewsByLogin(") {
+				mutationBody = string(body)
+				return mockResponse(http.StatusOK, mutation), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"data":{"repository":{"pullRequest":`+pullRequest+`}}}`), nil
+		}}
+	}
+
+	pullRequest := `{"id":"PR_1","url":"https://github.com/testhq/hello/pull/42"}`
+
+	t.Run("copilot review is requested", func(t *testing.T) {
+		transport := graphQLTransport(pullRequest, `{"data":{"requestReviewsByLogin":{"pullRequest":{"reviewRequests":{"nodes":[
+			{"requestedReviewer":{}},
+			{"requestedReviewer":{"login":"copilot-pull-request-reviewer"}}
+		]}}}}}`)
+
+		request, err := requestCopilotReview(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, 42, request.Number)
+		assert.Equal(t, CopilotReviewerLogin, request.Reviewer)
+		assert.Equal(t, "https://github.com/testhq/hello/pull/42", request.URL)
+
+		assert.Contains(t, mutationBody, `"botLogins":["copilot-pull-request-reviewer[bot]"]`)
+		assert.Contains(t, mutationBody, `"union":true`)
+	})
+
+	t.Run("copilot login not resolved -> feature disabled", func(t *testing.T) {
+		transport := graphQLTransport(pullRequest, `{"data":null,"errors":[{"message":"Could not resolve to a Bot with the login of 'copilot-pull-request-reviewer[bot]'."}]}`)
+
+		_, err := requestCopilotReview(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+		assert.Contains(t, err.Error(), "enable Copilot")
+	})
+
+	t.Run("copilot not in review requests -> feature disabled", func(t *testing.T) {
+		transport := graphQLTransport(pullRequest, `{"data":{"requestReviewsByLogin":{"pullRequest":{"reviewRequests":{"nodes":[]}}}}}`)
+
+		_, err := requestCopilotReview(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrFeatureDisabled)
+	})
+
+	t.Run("other mutation errors are not feature disabled", func(t *testing.T) {
+		transport := graphQLTransport(pullRequest, `{"data":null,"errors":[{"message":"Resource not accessible by integration"}]}`)
+
+		_, err := requestCopilotReview(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrFeatureDisabled)
+	})
+
+	t.Run("pull request not found -> error", func(t *testing.T) {
+		transport := graphQLTransport(`null`, `{}`)
+
+		_, err := requestCopilotReview(githubv4.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Len(t, transport.requests, 1)
+	})
+}
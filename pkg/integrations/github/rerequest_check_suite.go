@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const CheckSuiteRerequestedPayloadType = "github.checkSuite.rerequested"
+
+type RerequestCheckSuite struct{}
+
+type RerequestCheckSuiteConfiguration struct {
+	Repository   string `json:"repository" mapstructure:"repository"`
+	CheckSuiteID string `json:"checkSuiteId" mapstructure:"checkSuiteId"`
+	HeadSHA      string `json:"headSha" mapstructure:"headSha"`
+	App          string `json:"app" mapstructure:"app"`
+}
+
+type RerequestCheckSuiteOutput struct {
+	CheckSuiteID int64  `json:"check_suite_id"`
+	HeadSHA      string `json:"head_sha"`
+	App          string `json:"app"`
+	Rerequested  bool   `json:"rerequested"`
+}
+
+func (c *RerequestCheckSuite) Name() string {
+	return "github.rerequestCheckSuite"
+}
+
+func (c *RerequestCheckSuite) Label() string {
+	return "Rerequest Check Suite"
+}
+
+func (c *RerequestCheckSuite) Description() string {
+	return "Rerequest a GitHub check suite, running all of its checks again"
+}
+
+func (c *RerequestCheckSuite) Documentation() string {
+	return `The Rerequest Check Suite component asks GitHub to run a check suite again, without pushing new code.
+
+## Use Cases
+
+- **Flaky CI**: Rerun all the checks of a commit, not just one workflow
+- **External CI**: Ask a CI app to check a commit again after fixing its configuration
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Check Suite ID**: The ID of the check suite to rerequest (supports expressions)
+- **Head SHA**: Or, the commit whose check suite to rerequest (supports expressions)
+- **App**: When using the head SHA, the slug of the app whose check suite to rerequest, e.g. ` + "`github-actions`" + `
+
+## Output
+
+Emits the ` + "`check_suite_id`" + `, its ` + "`head_sha`" + ` and ` + "`app`" + `, once GitHub accepted the request.
+
+## Notes
+
+- Either a check suite ID or a head SHA is required. The check suite ID is used when both are set
+- A commit has one check suite per app. If it has several, set **App** to pick one
+- GitHub only confirms the request. The checks run afterwards, so use Wait For Check Run to wait for them`
+}
+
+func (c *RerequestCheckSuite) Icon() string {
+	return "github"
+}
+
+func (c *RerequestCheckSuite) Color() string {
+	return "gray"
+}
+
+func (c *RerequestCheckSuite) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *RerequestCheckSuite) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "checkSuiteId",
+			Label:       "Check Suite ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.check_suite.id}}",
+			Description: "The check suite to rerequest",
+		},
+		{
+			Name:        "headSha",
+			Label:       "Head SHA",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.pull_request.head.sha}}",
+			Description: "Or, the commit whose check suite to rerequest",
+		},
+		{
+			Name:        "app",
+			Label:       "App",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., github-actions",
+			Description: "The slug of the app whose check suite to rerequest, when using the head SHA",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *RerequestCheckSuite) Setup(ctx core.SetupContext) error {
+	var config RerequestCheckSuiteConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.CheckSuiteID == "" && config.HeadSHA == "" {
+		return errors.New("check suite ID or head SHA is required")
+	}
+
+	if config.CheckSuiteID != "" && !isExpression(config.CheckSuiteID) {
+		if _, err := strconv.ParseInt(config.CheckSuiteID, 10, 64); err != nil {
+			return fmt.Errorf("check suite ID is not a number: %s", config.CheckSuiteID)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *RerequestCheckSuite) Execute(ctx core.ExecutionContext) error {
+	var config RerequestCheckSuiteConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	suite, err := resolveCheckSuite(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Only webhook payloads say whether a suite can be rerequested,
+	// so suites taken from the API are left for GitHub to reject.
+	//
+	if suite.Rerequestable != nil && !suite.GetRerequestable() {
+		return fmt.Errorf("check suite %d cannot be rerequested", suite.GetID())
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	_, err = client.Checks.ReRequestCheckSuite(context.Background(), appMetadata.Owner, config.Repository, suite.GetID())
+	if err != nil {
+		return rerequestError(suite.GetID(), err)
+	}
+
+	ctx.Logger.Infof("Rerequested check suite %d for %s", suite.GetID(), suite.GetHeadSHA())
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, CheckSuiteRerequestedPayloadType, []any{
+		RerequestCheckSuiteOutput{
+			CheckSuiteID: suite.GetID(),
+			HeadSHA:      suite.GetHeadSHA(),
+			App:          suite.GetApp().GetSlug(),
+			Rerequested:  true,
+		},
+	})
+}
+
+/*
+ * Returns the check suite with the configured ID,
+ * or the only check suite of the head SHA, after filtering by app.
+ */
+func resolveCheckSuite(client *github.Client, owner string, config RerequestCheckSuiteConfiguration) (*github.CheckSuite, error) {
+	if config.CheckSuiteID != "" {
+		checkSuiteID, err := strconv.ParseInt(config.CheckSuiteID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("check suite ID is not a number: %s", config.CheckSuiteID)
+		}
+
+		suite, _, err := client.Checks.GetCheckSuite(context.Background(), owner, config.Repository, checkSuiteID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get check suite %d: %w", checkSuiteID, wrapGitHubError(err))
+		}
+
+		return suite, nil
+	}
+
+	suites := []*github.CheckSuite{}
+	opts := &github.ListCheckSuiteOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		page, response, err := client.Checks.ListCheckSuitesForRef(context.Background(), owner, config.Repository, config.HeadSHA, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list check suites: %w", wrapGitHubError(err))
+		}
+
+		for _, suite := range page.CheckSuites {
+			if config.App == "" || suite.GetApp().GetSlug() == config.App {
+				suites = append(suites, suite)
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	switch len(suites) {
+	case 0:
+		return nil, fmt.Errorf("no check suites found for %s: %w", config.HeadSHA, ErrNotFound)
+	case 1:
+		return suites[0], nil
+	}
+
+	apps := make([]string, 0, len(suites))
+	for _, suite := range suites {
+		apps = append(apps, suite.GetApp().GetSlug())
+	}
+
+	return nil, fmt.Errorf("found %d check suites for %s, set the app to one of: %s", len(suites), config.HeadSHA, strings.Join(apps, ", "))
+}
+
+/*
+ * GitHub rejects rerequests of suites that are still running,
+ * and of suites that can no longer be run again.
+ */
+func rerequestError(checkSuiteID int64, err error) error {
+	var responseErr *github.ErrorResponse
+	if errors.As(err, &responseErr) && responseErr.Response != nil {
+		statusCode := responseErr.Response.StatusCode
+		if statusCode == http.StatusConflict || statusCode == http.StatusUnprocessableEntity {
+			return fmt.Errorf("check suite %d cannot be rerequested: %s", checkSuiteID, responseErr.Message)
+		}
+	}
+
+	return fmt.Errorf("failed to rerequest check suite %d: %w", checkSuiteID, wrapGitHubError(err))
+}
+
+func (c *RerequestCheckSuite) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RerequestCheckSuite) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *RerequestCheckSuite) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *RerequestCheckSuite) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *RerequestCheckSuite) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RerequestCheckSuite) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
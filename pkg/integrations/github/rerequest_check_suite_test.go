@@ -0,0 +1,131 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RerequestCheckSuite__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := RerequestCheckSuite{}
+
+	t.Run("check suite ID or head SHA is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "check suite ID or head SHA is required")
+	})
+
+	t.Run("check suite ID must be a number", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "checkSuiteId": "latest"},
+		})
+
+		require.ErrorContains(t, err, "check suite ID is not a number")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "headSha": "{{ $.data.pull_request.head.sha }}", "app": "github-actions"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__RerequestCheckSuite__ResolveCheckSuite(t *testing.T) {
+	suites := `{"total_count":2,"check_suites":[
+		{"id":1,"head_sha":"abc123","app":{"slug":"github-actions"}},
+		{"id":2,"head_sha":"abc123","app":{"slug":"circleci"}}
+	]}`
+
+	suitesTransport := func() *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, suites), nil
+		}}
+	}
+
+	t.Run("check suite ID is used when set", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"id":42,"head_sha":"abc123","app":{"slug":"github-actions"}}`), nil
+		}}
+
+		suite, err := resolveCheckSuite(github.NewClient(&http.Client{Transport: transport}), "testhq", RerequestCheckSuiteConfiguration{
+			Repository:   "hello",
+			CheckSuiteID: "42",
+			HeadSHA:      "abc123",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), suite.GetID())
+		require.Len(t, transport.requests, 1)
+		assert.Equal(t, "/repos/testhq/hello/check-suites/42", transport.requests[0].URL.Path)
+	})
+
+	t.Run("head SHA is resolved by app", func(t *testing.T) {
+		transport := suitesTransport()
+		suite, err := resolveCheckSuite(github.NewClient(&http.Client{Transport: transport}), "testhq", RerequestCheckSuiteConfiguration{
+			Repository: "hello",
+			HeadSHA:    "abc123",
+			App:        "circleci",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), suite.GetID())
+		assert.Equal(t, "/repos/testhq/hello/commits/abc123/check-suites", transport.requests[0].URL.Path)
+	})
+
+	t.Run("several check suites -> error listing apps", func(t *testing.T) {
+		_, err := resolveCheckSuite(github.NewClient(&http.Client{Transport: suitesTransport()}), "testhq", RerequestCheckSuiteConfiguration{
+			Repository: "hello",
+			HeadSHA:    "abc123",
+		})
+
+		require.ErrorContains(t, err, "found 2 check suites for abc123, set the app to one of: github-actions, circleci")
+	})
+
+	t.Run("no check suite for app -> not found", func(t *testing.T) {
+		_, err := resolveCheckSuite(github.NewClient(&http.Client{Transport: suitesTransport()}), "testhq", RerequestCheckSuiteConfiguration{
+			Repository: "hello",
+			HeadSHA:    "abc123",
+			App:        "travis-ci",
+		})
+
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func Test__RerequestCheckSuite__RerequestError(t *testing.T) {
+	t.Run("suite not rerequestable -> clear error", func(t *testing.T) {
+		err := rerequestError(42, &github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+			Message:  "This check suite is not rerequestable",
+		})
+
+		require.ErrorContains(t, err, "check suite 42 cannot be rerequested: This check suite is not rerequestable")
+	})
+
+	t.Run("other errors are categorized", func(t *testing.T) {
+		err := rerequestError(42, &github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusForbidden},
+			Message:  "Resource not accessible by integration",
+		})
+
+		require.ErrorIs(t, err, ErrPermissionDenied)
+		require.ErrorContains(t, err, "failed to rerequest check suite 42")
+	})
+}
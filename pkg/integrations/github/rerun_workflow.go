@@ -0,0 +1,426 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const RerunWorkflowPayloadType = "github.workflowRun.rerun"
+
+type RerunWorkflow struct{}
+
+type RerunWorkflowConfiguration struct {
+	Repository        string `json:"repository" mapstructure:"repository"`
+	RunID             string `json:"runId" mapstructure:"runId"`
+	FailedJobsOnly    bool   `json:"failedJobsOnly" mapstructure:"failedJobsOnly"`
+	WaitForCompletion bool   `json:"waitForCompletion" mapstructure:"waitForCompletion"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so the resolved repository is recorded here too.
+ */
+type RerunWorkflowExecutionMetadata struct {
+	Repository      string `json:"repository" mapstructure:"repository"`
+	RunID           int64  `json:"runId" mapstructure:"runId"`
+	PreviousAttempt int    `json:"previousAttempt" mapstructure:"previousAttempt"`
+	PollAttempts    int    `json:"pollAttempts" mapstructure:"pollAttempts"`
+}
+
+type RerunWorkflowOutput struct {
+	RunID           int64                `json:"run_id"`
+	RunAttempt      int                  `json:"run_attempt,omitempty"`
+	PreviousAttempt int                  `json:"previous_attempt"`
+	FailedJobsOnly  bool                 `json:"failed_jobs_only"`
+	Status          string               `json:"status,omitempty"`
+	Conclusion      string               `json:"conclusion,omitempty"`
+	URL             string               `json:"html_url,omitempty"`
+	Attempts        []WorkflowRunAttempt `json:"attempts"`
+}
+
+/*
+ * One attempt of a workflow run.
+ * Every rerun of a run is a new attempt, with its own conclusion.
+ */
+type WorkflowRunAttempt struct {
+	Attempt    int    `json:"attempt"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+}
+
+func (c *RerunWorkflow) Name() string {
+	return "github.rerunWorkflow"
+}
+
+func (c *RerunWorkflow) Label() string {
+	return "Rerun Workflow"
+}
+
+func (c *RerunWorkflow) Description() string {
+	return "Rerun a GitHub Actions workflow run, or only its failed jobs"
+}
+
+func (c *RerunWorkflow) Documentation() string {
+	return `The Rerun Workflow component reruns an existing GitHub Actions workflow run.
+
+## Use Cases
+
+- **Flaky CI**: Rerun the failed jobs of a run automatically
+- **Retries**: Rerun a deploy workflow after fixing an external dependency
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Run ID**: The ID of the workflow run to rerun (supports expressions)
+- **Failed Jobs Only**: Only rerun the failed jobs of the run, and the jobs that depend on them
+- **Wait For Completion**: Wait for the new attempt of the run to finish before emitting
+
+## Output
+
+Emits the run ID after the rerun is requested, with the ` + "`previous_attempt`" + ` and the new ` + "`run_attempt`" + ` numbers.
+If **Wait For Completion** is enabled, the event is only emitted after the new attempt finishes, and includes its ` + "`status`" + ` and ` + "`conclusion`" + `.
+
+The ` + "`attempts`" + ` list has every attempt of the run, oldest first, with its ` + "`attempt`" + ` number, ` + "`status`" + ` and ` + "`conclusion`" + `, to track how many retries a run needed.
+
+## Notes
+
+- GitHub only allows rerunning workflow runs created in the last 30 days
+- When waiting for completion, the run is polled every 15 seconds at first, slowing down to every 5 minutes
+- Reading the attempts takes one request per attempt`
+}
+
+func (c *RerunWorkflow) Icon() string {
+	return "github"
+}
+
+func (c *RerunWorkflow) Color() string {
+	return "gray"
+}
+
+func (c *RerunWorkflow) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *RerunWorkflow) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "runId",
+			Label:       "Run ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.workflow_run.id}}",
+		},
+		{
+			Name:        "failedJobsOnly",
+			Label:       "Failed Jobs Only",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Only rerun the failed jobs of the run",
+		},
+		{
+			Name:        "waitForCompletion",
+			Label:       "Wait For Completion",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Wait for the rerun to finish before emitting",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *RerunWorkflow) Setup(ctx core.SetupContext) error {
+	var config RerunWorkflowConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.RunID == "" {
+		return errors.New("run ID is required")
+	}
+
+	if !isExpression(config.RunID) {
+		if _, err := strconv.ParseInt(config.RunID, 10, 64); err != nil {
+			return fmt.Errorf("run ID is not a number: %s", config.RunID)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *RerunWorkflow) Execute(ctx core.ExecutionContext) error {
+	var config RerunWorkflowConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	runID, err := strconv.ParseInt(config.RunID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("run ID is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	//
+	// Reruns keep the same run ID, and increase the run attempt,
+	// so we record the current attempt to know when the rerun finishes.
+	//
+	run, _, err := client.Actions.GetWorkflowRunByID(context.Background(), appMetadata.Owner, config.Repository, runID)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow run: %w", wrapGitHubError(err))
+	}
+
+	if config.FailedJobsOnly {
+		_, err = client.Actions.RerunFailedJobsByID(context.Background(), appMetadata.Owner, config.Repository, runID)
+	} else {
+		_, err = client.Actions.RerunWorkflowByID(context.Background(), appMetadata.Owner, config.Repository, runID)
+	}
+
+	if err != nil {
+		return rerunError(runID, err)
+	}
+
+	ctx.Logger.Infof("Requested rerun of workflow run %d", runID)
+
+	if !config.WaitForCompletion {
+		output, err := rerunOutput(client, appMetadata.Owner, config.Repository, runID, run.GetRunAttempt())
+		if err != nil {
+			return err
+		}
+
+		output.FailedJobsOnly = config.FailedJobsOnly
+		return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, RerunWorkflowPayloadType, []any{output})
+	}
+
+	err = ctx.Metadata.Set(RerunWorkflowExecutionMetadata{
+		Repository:      config.Repository,
+		RunID:           runID,
+		PreviousAttempt: run.GetRunAttempt(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollBackoff.Interval(1))
+}
+
+/*
+ * GitHub rejects reruns of runs older than 30 days,
+ * and of runs that are still in progress.
+ */
+func rerunError(runID int64, err error) error {
+	var responseErr *github.ErrorResponse
+	if errors.As(err, &responseErr) && responseErr.Response != nil {
+		statusCode := responseErr.Response.StatusCode
+		if statusCode == http.StatusForbidden || statusCode == http.StatusConflict || statusCode == http.StatusUnprocessableEntity {
+			if strings.Contains(strings.ToLower(responseErr.Message), "re-run") {
+				return fmt.Errorf("workflow run %d cannot be rerun: %s", runID, responseErr.Message)
+			}
+		}
+	}
+
+	return fmt.Errorf("failed to rerun workflow run %d: %w", runID, wrapGitHubError(err))
+}
+
+func (c *RerunWorkflow) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           "poll",
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *RerunWorkflow) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case "poll":
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *RerunWorkflow) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	var config RerunWorkflowConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	metadata := RerunWorkflowExecutionMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	repository := cmp.Or(metadata.Repository, config.Repository)
+	run, _, err := client.Actions.GetWorkflowRunByID(context.Background(), appMetadata.Owner, repository, metadata.RunID)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow run: %w", wrapGitHubError(err))
+	}
+
+	metadata.PollAttempts++
+	if !rerunFinished(run, metadata.PreviousAttempt) {
+		err = ctx.Metadata.Set(metadata)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollBackoff.Interval(metadata.PollAttempts+1))
+	}
+
+	attempts, err := workflowRunAttempts(client, appMetadata.Owner, repository, run.GetID(), run.GetRunAttempt())
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, RerunWorkflowPayloadType, []any{
+		RerunWorkflowOutput{
+			RunID:           run.GetID(),
+			RunAttempt:      run.GetRunAttempt(),
+			PreviousAttempt: metadata.PreviousAttempt,
+			FailedJobsOnly:  config.FailedJobsOnly,
+			Status:          run.GetStatus(),
+			Conclusion:      run.GetConclusion(),
+			URL:             run.GetHTMLURL(),
+			Attempts:        attempts,
+		},
+	})
+}
+
+/*
+ * Reads the run again after the rerun is requested, for its new attempt.
+ * GitHub can still return the previous attempt right after the request,
+ * and the rerun is always the attempt after it, so it is reported as queued until GitHub has it.
+ */
+func rerunOutput(client *github.Client, owner, repository string, runID int64, previousAttempt int) (*RerunWorkflowOutput, error) {
+	run, _, err := client.Actions.GetWorkflowRunByID(context.Background(), owner, repository, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow run: %w", wrapGitHubError(err))
+	}
+
+	attempts, err := workflowRunAttempts(client, owner, repository, runID, previousAttempt)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := previousAttempt + 1
+	latest := WorkflowRunAttempt{Attempt: attempt, Status: "queued"}
+	if run.GetRunAttempt() == attempt {
+		latest.Status = run.GetStatus()
+		latest.Conclusion = run.GetConclusion()
+	}
+
+	attempts = append(attempts, latest)
+	return &RerunWorkflowOutput{
+		RunID:           runID,
+		RunAttempt:      attempt,
+		PreviousAttempt: previousAttempt,
+		URL:             run.GetHTMLURL(),
+		Attempts:        attempts,
+	}, nil
+}
+
+/*
+ * Lists the attempts of a run, up to the given one.
+ * GitHub has no endpoint listing them, so each attempt is read on its own.
+ */
+func workflowRunAttempts(client *github.Client, owner, repository string, runID int64, latest int) ([]WorkflowRunAttempt, error) {
+	attempts := []WorkflowRunAttempt{}
+	for attempt := 1; attempt <= latest; attempt++ {
+		run, _, err := client.Actions.GetWorkflowRunAttempt(context.Background(), owner, repository, runID, attempt, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attempt %d of workflow run %d: %w", attempt, runID, wrapGitHubError(err))
+		}
+
+		attempts = append(attempts, WorkflowRunAttempt{
+			Attempt:    attempt,
+			Status:     run.GetStatus(),
+			Conclusion: run.GetConclusion(),
+		})
+	}
+
+	return attempts, nil
+}
+
+/*
+ * Right after the rerun is requested, GitHub can still
+ * return the previous attempt, which is already completed.
+ */
+func rerunFinished(run *github.WorkflowRun, previousAttempt int) bool {
+	return run.GetRunAttempt() > previousAttempt && run.GetStatus() == WorkflowRunStatusCompleted
+}
+
+func (c *RerunWorkflow) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RerunWorkflow) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *RerunWorkflow) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RerunWorkflow) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
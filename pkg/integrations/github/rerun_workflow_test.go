@@ -0,0 +1,137 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RerunWorkflow__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := RerunWorkflow{}
+
+	t.Run("run ID is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "run ID is required")
+	})
+
+	t.Run("run ID must be a number", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "runId": "latest"},
+		})
+
+		require.ErrorContains(t, err, "run ID is not a number")
+	})
+
+	t.Run("expressions are accepted", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":        "hello",
+				"runId":             "{{ $.data.workflow_run.id }}",
+				"failedJobsOnly":    true,
+				"waitForCompletion": true,
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__RerunWorkflow__RerunError(t *testing.T) {
+	t.Run("run too old -> clear error", func(t *testing.T) {
+		err := rerunError(42, &github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusForbidden},
+			Message:  "Unable to re-run this workflow run because it was created over a month ago",
+		})
+
+		require.ErrorContains(t, err, "workflow run 42 cannot be rerun: Unable to re-run this workflow run")
+	})
+
+	t.Run("other errors are categorized", func(t *testing.T) {
+		err := rerunError(42, &github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusNotFound},
+			Message:  "Not Found",
+		})
+
+		require.ErrorIs(t, err, ErrNotFound)
+		require.ErrorContains(t, err, "failed to rerun workflow run 42")
+	})
+}
+
+func Test__RerunWorkflow__RerunFinished(t *testing.T) {
+	assert.False(t, rerunFinished(&github.WorkflowRun{RunAttempt: github.Ptr(1), Status: github.Ptr("completed")}, 1))
+	assert.False(t, rerunFinished(&github.WorkflowRun{RunAttempt: github.Ptr(2), Status: github.Ptr("in_progress")}, 1))
+	assert.True(t, rerunFinished(&github.WorkflowRun{RunAttempt: github.Ptr(2), Status: github.Ptr("completed")}, 1))
+}
+
+func Test__RerunWorkflow__Attempts(t *testing.T) {
+	attemptsTransport := func(run string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/repos/testhq/hello/actions/runs/42/attempts/1":
+				return mockResponse(http.StatusOK, `{"id":42,"run_attempt":1,"status":"completed","conclusion":"failure"}`), nil
+			case "/repos/testhq/hello/actions/runs/42/attempts/2":
+				return mockResponse(http.StatusOK, `{"id":42,"run_attempt":2,"status":"completed","conclusion":"timed_out"}`), nil
+			case "/repos/testhq/hello/actions/runs/42":
+				return mockResponse(http.StatusOK, run), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+	}
+
+	t.Run("every attempt is read with its conclusion", func(t *testing.T) {
+		transport := attemptsTransport("")
+		attempts, err := workflowRunAttempts(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []WorkflowRunAttempt{
+			{Attempt: 1, Status: "completed", Conclusion: "failure"},
+			{Attempt: 2, Status: "completed", Conclusion: "timed_out"},
+		}, attempts)
+	})
+
+	t.Run("rerun output has the new attempt", func(t *testing.T) {
+		transport := attemptsTransport(`{"id":42,"run_attempt":3,"status":"queued","html_url":"https://github.com/testhq/hello/actions/runs/42"}`)
+		output, err := rerunOutput(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, output.PreviousAttempt)
+		assert.Equal(t, 3, output.RunAttempt)
+		assert.Equal(t, "https://github.com/testhq/hello/actions/runs/42", output.URL)
+		assert.Equal(t, []WorkflowRunAttempt{
+			{Attempt: 1, Status: "completed", Conclusion: "failure"},
+			{Attempt: 2, Status: "completed", Conclusion: "timed_out"},
+			{Attempt: 3, Status: "queued"},
+		}, output.Attempts)
+	})
+
+	t.Run("new attempt not visible yet is reported as queued", func(t *testing.T) {
+		transport := attemptsTransport(`{"id":42,"run_attempt":2,"status":"completed","conclusion":"timed_out"}`)
+		output, err := rerunOutput(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, output.RunAttempt)
+		assert.Equal(t, WorkflowRunAttempt{Attempt: 3, Status: "queued"}, output.Attempts[2])
+	})
+
+	t.Run("missing attempt -> error", func(t *testing.T) {
+		_, err := workflowRunAttempts(github.NewClient(&http.Client{Transport: attemptsTransport("")}), "testhq", "hello", 42, 3)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, "failed to get attempt 3 of workflow run 42")
+	})
+}
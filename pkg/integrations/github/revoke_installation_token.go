@@ -0,0 +1,134 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type RevokeInstallationToken struct{}
+
+type RevokeInstallationTokenOutput struct {
+	InstallationID string     `json:"installation_id"`
+	Revoked        bool       `json:"revoked"`
+	RevokedAt      time.Time  `json:"revoked_at"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+func (c *RevokeInstallationToken) Name() string {
+	return "github.revokeInstallationToken"
+}
+
+func (c *RevokeInstallationToken) Label() string {
+	return "Revoke Installation Token"
+}
+
+func (c *RevokeInstallationToken) Description() string {
+	return "Revoke the GitHub App installation token used by the component"
+}
+
+func (c *RevokeInstallationToken) Documentation() string {
+	return `The Revoke Installation Token component revokes the GitHub App installation access token it authenticates with, before it expires.
+
+## Use Cases
+
+- **High-security flows**: End a flow by revoking its token, instead of leaving it valid until it expires
+- **Compliance**: Record that the token of a flow was revoked
+
+## Configuration
+
+This component has no configuration.
+
+## Output
+
+Emits a ` + "`github.installationTokenRevoked`" + ` event with the ` + "`installation_id`" + `, ` + "`revoked_at`" + `, and ` + "`expires_at`" + `, when the token would have expired otherwise.
+
+## Notes
+
+- This component is opt-in. Installation tokens expire one hour after being minted anyway
+- Each execution mints its own installation token, so only the token of this execution is revoked. Tokens minted by other executions are not affected
+- Clients whose token was revoked mint a new token if they are used again`
+}
+
+func (c *RevokeInstallationToken) Icon() string {
+	return "github"
+}
+
+func (c *RevokeInstallationToken) Color() string {
+	return "gray"
+}
+
+func (c *RevokeInstallationToken) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *RevokeInstallationToken) Configuration() []configuration.Field {
+	return []configuration.Field{}
+}
+
+func (c *RevokeInstallationToken) Setup(ctx core.SetupContext) error {
+	return nil
+}
+
+func (c *RevokeInstallationToken) Execute(ctx core.ExecutionContext) error {
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	expiresAt, err := revokeInstallationToken(client)
+	if err != nil {
+		return err
+	}
+
+	output := RevokeInstallationTokenOutput{
+		InstallationID: appMetadata.InstallationID,
+		Revoked:        true,
+		RevokedAt:      time.Now().UTC(),
+	}
+
+	if !expiresAt.IsZero() {
+		output.ExpiresAt = &expiresAt
+	}
+
+	ctx.Logger.Infof("Revoked installation token of installation %s", appMetadata.InstallationID)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.installationTokenRevoked",
+		[]any{output},
+	)
+}
+
+func (c *RevokeInstallationToken) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *RevokeInstallationToken) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *RevokeInstallationToken) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *RevokeInstallationToken) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *RevokeInstallationToken) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *RevokeInstallationToken) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -3,8 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -22,13 +24,38 @@ const (
 	WorkflowFailedOutputChannel  = "failed"
 	WorkflowRunStatusCompleted   = "completed"
 	WorkflowRunConclusionSuccess = "success"
+	WorkflowRunConclusionTimeout = "timed_out"
 	WorkflowPollInterval         = 5 * time.Minute
+
+	//
+	// Runs are searched from a bit before the dispatch,
+	// in case the GitHub clock is behind ours.
+	//
+	WorkflowRunCreatedSkew = 15 * time.Second
 )
 
+/*
+ * SuperPlane execution IDs are UUIDs.
+ * Runs named after one were dispatched by an execution,
+ * so they are never taken as the run of another one.
+ */
+var executionIDPattern = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+
+/*
+ * Polls start fast, to pick up short workflow runs quickly,
+ * and slow down up to WorkflowPollInterval for long ones.
+ */
+var WorkflowPollBackoff = core.Backoff{
+	Initial: 15 * time.Second,
+	Max:     WorkflowPollInterval,
+}
+
 type RunWorkflow struct{}
 
 type RunWorkflowExecutionMetadata struct {
-	WorkflowRun *WorkflowRunMetadata `json:"workflowRun" mapstructure:"workflowRun"`
+	WorkflowRun  *WorkflowRunMetadata `json:"workflowRun" mapstructure:"workflowRun"`
+	PollAttempts int                  `json:"pollAttempts" mapstructure:"pollAttempts"`
+	Deadline     string               `json:"deadline,omitempty" mapstructure:"deadline"`
 }
 
 type WorkflowRunMetadata struct {
@@ -36,6 +63,7 @@ type WorkflowRunMetadata struct {
 	Status     string `json:"status"`
 	Conclusion string `json:"conclusion"`
 	URL        string `json:"url"`
+	LogsURL    string `json:"logsUrl,omitempty"`
 }
 
 type RunWorkflowSpec struct {
@@ -43,6 +71,7 @@ type RunWorkflowSpec struct {
 	WorkflowFile string  `json:"workflowFile"`
 	Ref          string  `json:"ref"`
 	Inputs       []Input `json:"inputs"`
+	Timeout      *int    `json:"timeout"`
 }
 
 type Input struct {
@@ -86,17 +115,22 @@ func (r *RunWorkflow) Documentation() string {
 - **Workflow File**: Path to the workflow file (e.g., ` + "`.github/workflows/ci.yml`" + `)
 - **Branch or Tag**: Git reference to run the workflow on (default: main)
 - **Inputs**: Optional workflow inputs as key-value pairs (supports expressions)
+- **Timeout**: Optional minutes to wait for the workflow run to complete
 
 ## Output Channels
 
 - **Passed**: Emitted when workflow completes successfully
-- **Failed**: Emitted when workflow fails or is cancelled
+- **Failed**: Emitted when workflow fails, is cancelled, or times out. The ` + "`logs_url`" + ` of the run is included, to download its logs
 
 ## Notes
 
 - The component automatically sets up webhook monitoring for workflow completion
-- Falls back to polling if webhook doesn't arrive
-- Can be cancelled, which will cancel the running GitHub Actions workflow`
+- Falls back to polling if webhook doesn't arrive. Polls start every 15 seconds and slow down to every 5 minutes.
+  The number of polls is available in the execution metadata as ` + "`pollAttempts`" + `
+- Can be cancelled, which will cancel the running GitHub Actions workflow
+- The dispatched run is found by its name, if the workflow sets ` + "`run-name`" + ` with the ` + "`superplane_execution_id`" + ` input.
+  Otherwise, it must be the only run of the workflow dispatched on the same ref since the dispatch, or the execution fails
+- When the timeout is reached, the workflow run is cancelled, and an event with the ` + "`timed_out`" + ` conclusion is emitted on the failed channel`
 }
 
 func (r *RunWorkflow) Icon() string {
@@ -176,6 +210,17 @@ func (r *RunWorkflow) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Minutes to wait for the workflow run to complete",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
 	}
 }
 
@@ -199,6 +244,10 @@ func (r *RunWorkflow) Setup(ctx core.SetupContext) error {
 		return err
 	}
 
+	if spec.Timeout != nil && *spec.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
 	// Request webhook for workflow_run events
 	ctx.Integration.RequestWebhook(WebhookConfiguration{
 		EventType:  "workflow_run",
@@ -220,20 +269,64 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 
+	run, dispatchedAt, err := r.dispatchWorkflow(ctx, client, appMetadata.Owner, spec)
+	if err != nil {
+		return err
+	}
+
+	// Save workflow run to metadata
+	metadata := RunWorkflowExecutionMetadata{
+		WorkflowRun: &WorkflowRunMetadata{
+			ID:         run.GetID(),
+			Status:     run.GetStatus(),
+			Conclusion: run.GetConclusion(),
+			URL:        run.GetHTMLURL(),
+			LogsURL:    run.GetLogsURL(),
+		},
+	}
+
+	if spec.Timeout != nil {
+		metadata.Deadline = dispatchedAt.Add(time.Duration(*spec.Timeout) * time.Minute).Format(time.RFC3339)
+	}
+
+	err = ctx.Metadata.Set(metadata)
+
+	if err != nil {
+		return err
+	}
+
+	// Store workflow run ID in KV for webhook matching
+	err = ctx.ExecutionState.SetKV("workflow_run_id", fmt.Sprintf("%d", run.GetID()))
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Started workflow run %d", run.GetID())
+
+	// Schedule poll to check workflow status updates (in case webhook doesn't arrive)
+	return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollBackoff.Interval(1))
+}
+
+/*
+ * Dispatches the workflow, and finds the run the dispatch created.
+ * If the run is not found, the workflow was still dispatched, so the dispatch time is returned with the error.
+ */
+func (r *RunWorkflow) dispatchWorkflow(ctx core.ExecutionContext, client *github.Client, owner string, spec RunWorkflowSpec) (*github.WorkflowRun, time.Time, error) {
 	//
 	// Dispatch the workflow
 	// Make sure it works if user specifies full path,
 	// or just the path accepted by the API.
 	//
 	workflowFile := strings.Replace(spec.WorkflowFile, ".github/workflows/", "", 1)
-	_, err = client.Actions.CreateWorkflowDispatchEventByFileName(
+	dispatchedAt := time.Now()
+	_, err := client.Actions.CreateWorkflowDispatchEventByFileName(
 		context.Background(),
-		appMetadata.Owner,
+		owner,
 		spec.Repository,
 		workflowFile,
 		github.CreateWorkflowDispatchEventRequest{
@@ -243,7 +336,7 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 	)
 
 	if err != nil {
-		return fmt.Errorf("failed to dispatch workflow: %w", err)
+		return nil, time.Time{}, fmt.Errorf("failed to dispatch workflow: %w", err)
 	}
 
 	ctx.Logger.Infof("Workflow dispatched - repository=%s, workflow=%s, ref=%s", spec.Repository, spec.WorkflowFile, spec.Ref)
@@ -255,7 +348,7 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 	var run *github.WorkflowRun
 	err = retry.WithConstantWait(func() error {
 		var findErr error
-		run, findErr = r.findWorkflowRun(client, appMetadata.Owner, spec.Repository, ctx.ID.String())
+		run, findErr = r.findWorkflowRun(client, owner, spec.Repository, workflowFile, spec.Ref, ctx.ID.String(), dispatchedAt)
 		return findErr
 	}, retry.Options{
 		Task:         "find workflow run",
@@ -266,33 +359,10 @@ func (r *RunWorkflow) Execute(ctx core.ExecutionContext) error {
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to find workflow run: %w", err)
+		return nil, dispatchedAt, fmt.Errorf("failed to find workflow run: %w", err)
 	}
 
-	// Save workflow run to metadata
-	err = ctx.Metadata.Set(RunWorkflowExecutionMetadata{
-		WorkflowRun: &WorkflowRunMetadata{
-			ID:         run.GetID(),
-			Status:     run.GetStatus(),
-			Conclusion: run.GetConclusion(),
-			URL:        run.GetHTMLURL(),
-		},
-	})
-
-	if err != nil {
-		return err
-	}
-
-	// Store workflow run ID in KV for webhook matching
-	err = ctx.ExecutionState.SetKV("workflow_run_id", fmt.Sprintf("%d", run.GetID()))
-	if err != nil {
-		return err
-	}
-
-	ctx.Logger.Infof("Started workflow run %d", run.GetID())
-
-	// Schedule poll to check workflow status updates (in case webhook doesn't arrive)
-	return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollInterval)
+	return run, dispatchedAt, nil
 }
 
 func (r *RunWorkflow) Cancel(ctx core.ExecutionContext) error {
@@ -331,7 +401,7 @@ func (r *RunWorkflow) Cancel(ctx core.ExecutionContext) error {
 	//
 	// Create GitHub client, and cancel workflow run
 	//
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -469,12 +539,15 @@ func metadataFromPayload(payload map[string]any) (*RunWorkflowExecutionMetadata,
 		return nil, nil, fmt.Errorf("run URL not found or invalid")
 	}
 
+	logsURL, _ := workflowRun["logs_url"].(string)
+
 	return &RunWorkflowExecutionMetadata{
 		WorkflowRun: &WorkflowRunMetadata{
 			ID:         int64(runID),
 			Status:     status,
 			Conclusion: conclusion,
 			URL:        htmlURL,
+			LogsURL:    logsURL,
 		},
 	}, workflowRun, nil
 }
@@ -540,9 +613,33 @@ func (r *RunWorkflow) poll(ctx core.ActionContext) error {
 		return err
 	}
 
-	// If not finished, poll again
+	metadata.PollAttempts++
+
+	// If not finished, poll again, unless the timeout is reached
 	if run.GetStatus() != WorkflowRunStatusCompleted {
-		return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, WorkflowPollInterval)
+		metadata.WorkflowRun.Status = run.GetStatus()
+
+		interval := WorkflowPollBackoff.Interval(metadata.PollAttempts + 1)
+		if metadata.Deadline != "" {
+			deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+			if err != nil {
+				return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+			}
+
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return r.timeout(ctx, client, appMetadata.Owner, spec.Repository, metadata, run)
+			}
+
+			interval = min(interval, remaining)
+		}
+
+		err = ctx.Metadata.Set(metadata)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Requests.ScheduleActionCall("poll", map[string]any{}, interval)
 	}
 
 	// Update metadata with final status
@@ -561,20 +658,57 @@ func (r *RunWorkflow) poll(ctx core.ActionContext) error {
 	return ctx.ExecutionState.Emit(WorkflowFailedOutputChannel, WorkflowPayloadType, []any{run})
 }
 
-func (r *RunWorkflow) findWorkflowRun(client *github.Client, owner, repo, executionID string) (*github.WorkflowRun, error) {
-	// List recent workflow runs
-	runs, _, err := client.Actions.ListRepositoryWorkflowRuns(
+/*
+ * Cancels a workflow run that did not complete before the timeout,
+ * and emits it on the failed channel with the timed_out conclusion.
+ * The run is marked as completed, so a late webhook for it is ignored.
+ */
+func (r *RunWorkflow) timeout(ctx core.ActionContext, client *github.Client, owner, repo string, metadata RunWorkflowExecutionMetadata, run *github.WorkflowRun) error {
+	response, err := client.Actions.CancelWorkflowRunByID(context.Background(), owner, repo, run.GetID())
+	if err != nil && (response == nil || response.StatusCode != http.StatusAccepted) {
+		ctx.Logger.Warnf("Failed to cancel timed out workflow run %d: %v", run.GetID(), err)
+	}
+
+	metadata.WorkflowRun.Status = WorkflowRunStatusCompleted
+	metadata.WorkflowRun.Conclusion = WorkflowRunConclusionTimeout
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Workflow run %d timed out", run.GetID())
+
+	return ctx.ExecutionState.Emit(WorkflowFailedOutputChannel, WorkflowPayloadType, []any{map[string]any{
+		"id":         run.GetID(),
+		"name":       run.GetName(),
+		"status":     run.GetStatus(),
+		"conclusion": WorkflowRunConclusionTimeout,
+		"html_url":   run.GetHTMLURL(),
+		"logs_url":   run.GetLogsURL(),
+	}})
+}
+
+/*
+ * The dispatch API does not return the run it creates, so it is searched among
+ * the runs of the workflow dispatched since the dispatch. The run named after
+ * the execution wins. Without one, the only run on the same ref is taken,
+ * leaving out runs named after other executions.
+ */
+func (r *RunWorkflow) findWorkflowRun(client *github.Client, owner, repo, workflowFile, ref, executionID string, dispatchedAt time.Time) (*github.WorkflowRun, error) {
+	runs, _, err := client.Actions.ListWorkflowRunsByFileName(
 		context.Background(),
 		owner,
 		repo,
+		workflowFile,
 		&github.ListWorkflowRunsOptions{
+			Event:   "workflow_dispatch",
+			Created: ">=" + dispatchedAt.Add(-WorkflowRunCreatedSkew).UTC().Format(time.RFC3339),
 			ListOptions: github.ListOptions{
 				PerPage: 100,
 			},
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, wrapGitHubError(err)
 	}
 
 	// Find the run with our execution ID in the name
@@ -584,7 +718,26 @@ func (r *RunWorkflow) findWorkflowRun(client *github.Client, owner, repo, execut
 		}
 	}
 
-	return nil, fmt.Errorf("workflow run with execution ID %s not found", executionID)
+	branch := strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+	candidates := []*github.WorkflowRun{}
+	for _, run := range runs.WorkflowRuns {
+		if run.GetHeadBranch() == branch && !executionIDPattern.MatchString(run.GetName()) {
+			candidates = append(candidates, run)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("workflow run with execution ID %s not found", executionID)
+	case 1:
+		return candidates[0], nil
+	}
+
+	return nil, fmt.Errorf(
+		"found %d runs dispatched on %s since the dispatch, set the run-name of the workflow with the superplane_execution_id input to tell them apart",
+		len(candidates),
+		ref,
+	)
 }
 
 func (r *RunWorkflow) buildInputs(ctx core.ExecutionContext, inputs []Input) map[string]any {
@@ -0,0 +1,118 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__RunWorkflow__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := RunWorkflow{}
+
+	t.Run("invalid timeout -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "workflowFile": "ci.yml", "ref": "main", "timeout": 0},
+		})
+
+		require.ErrorContains(t, err, "timeout must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "workflowFile": "ci.yml", "ref": "main", "timeout": 30},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__RunWorkflow__FindWorkflowRun(t *testing.T) {
+	component := RunWorkflow{}
+	executionID := "5f2b3c4d-1a2b-4c3d-8e9f-0a1b2c3d4e5f"
+	otherExecutionID := "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	dispatchedAt := time.Date(2026, 1, 16, 10, 0, 0, 0, time.UTC)
+
+	runsTransport := func(body string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, body), nil
+		}}
+	}
+
+	find := func(transport *mockTransport) (*github.WorkflowRun, error) {
+		return component.findWorkflowRun(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", "ci.yml", "main", executionID, dispatchedAt)
+	}
+
+	t.Run("dispatched runs of the workflow are searched since the dispatch", func(t *testing.T) {
+		transport := runsTransport(`{"total_count":1,"workflow_runs":[{"id":1,"name":"Deploy ` + executionID + `","head_branch":"main"}]}`)
+
+		run, err := find(transport)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), run.GetID())
+
+		query := transport.requests[0].URL.Query()
+		assert.Equal(t, "/repos/testhq/hello/actions/workflows/ci.yml/runs", transport.requests[0].URL.Path)
+		assert.Equal(t, "workflow_dispatch", query.Get("event"))
+		assert.Equal(t, ">=2026-01-16T09:59:45Z", query.Get("created"))
+	})
+
+	t.Run("run named after the execution wins", func(t *testing.T) {
+		run, err := find(runsTransport(`{"total_count":2,"workflow_runs":[
+			{"id":1,"name":"CI","head_branch":"main"},
+			{"id":2,"name":"CI ` + executionID + `","head_branch":"main"}
+		]}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), run.GetID())
+	})
+
+	t.Run("only run on the ref -> taken", func(t *testing.T) {
+		run, err := find(runsTransport(`{"total_count":2,"workflow_runs":[
+			{"id":1,"name":"CI","head_branch":"release"},
+			{"id":2,"name":"CI","head_branch":"main"}
+		]}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), run.GetID())
+	})
+
+	t.Run("runs of other executions are not taken", func(t *testing.T) {
+		_, err := find(runsTransport(`{"total_count":1,"workflow_runs":[{"id":1,"name":"CI ` + otherExecutionID + `","head_branch":"main"}]}`))
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("several runs on the ref -> error", func(t *testing.T) {
+		_, err := find(runsTransport(`{"total_count":2,"workflow_runs":[
+			{"id":1,"name":"CI","head_branch":"main"},
+			{"id":2,"name":"CI","head_branch":"main"}
+		]}`))
+
+		require.ErrorContains(t, err, "found 2 runs dispatched on main")
+	})
+}
+
+func Test__RunWorkflow__MetadataFromPayload(t *testing.T) {
+	metadata, _, err := metadataFromPayload(map[string]any{
+		"workflow_run": map[string]any{
+			"id":         float64(42),
+			"status":     "completed",
+			"conclusion": "failure",
+			"html_url":   "https://github.com/testhq/hello/actions/runs/42",
+			"logs_url":   "https://api.github.com/repos/testhq/hello/actions/runs/42/logs",
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/repos/testhq/hello/actions/runs/42/logs", metadata.WorkflowRun.LogsURL)
+}
@@ -0,0 +1,258 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const DefaultSearchCodeLimit = 50
+
+type SearchCode struct{}
+
+type SearchCodeConfiguration struct {
+	Query string `json:"query" mapstructure:"query"`
+	Limit *int   `json:"limit" mapstructure:"limit"`
+}
+
+type CodeMatch struct {
+	Repository string   `json:"repository"`
+	Path       string   `json:"path"`
+	SHA        string   `json:"sha"`
+	URL        string   `json:"html_url"`
+	Fragments  []string `json:"fragments"`
+}
+
+type SearchCodeOutput struct {
+	Query             string      `json:"query"`
+	TotalCount        int         `json:"total_count"`
+	IncompleteResults bool        `json:"incomplete_results"`
+	Matches           []CodeMatch `json:"matches"`
+}
+
+func (c *SearchCode) Name() string {
+	return "github.searchCode"
+}
+
+func (c *SearchCode) Label() string {
+	return "Search Code"
+}
+
+func (c *SearchCode) Description() string {
+	return "Search code across the repositories of the organization, on their default branches"
+}
+
+func (c *SearchCode) Documentation() string {
+	return `The Search Code component searches files across repositories with GitHub code search, and emits the matching files with the fragments that matched.
+
+## Use Cases
+
+- **Config drift detection**: Find repositories with outdated configuration, like an old base image or a deprecated setting
+- **Migrations**: List the files still using an API that is being removed
+
+## Configuration
+
+- **Query**: The code search query, like ` + "`ubuntu-20.04 path:.github/workflows`" + ` (supports expressions).
+  Without a ` + "`repo:`" + `, ` + "`org:`" + ` or ` + "`user:`" + ` qualifier, the search is limited to the owner of the integration
+- **Limit**: Maximum number of files to return. Defaults to 50
+
+## Output
+
+Emits a ` + "`github.codeSearch`" + ` event with the ` + "`query`" + ` that was run, the ` + "`total_count`" + ` of matching files, ` + "`incomplete_results`" + `, and the ` + "`matches`" + `, each with its ` + "`repository`" + `, ` + "`path`" + `, ` + "`sha`" + `, ` + "`html_url`" + `, and the matching ` + "`fragments`" + `.
+
+## Notes
+
+- **Only default branches are searched.** Code on other branches, and files larger than 384 KB, are not found
+- Code search has its own rate limit of a few requests per minute. When it is exceeded, the execution fails with the time the limit resets
+- GitHub returns at most 1000 results for a query`
+}
+
+func (c *SearchCode) Icon() string {
+	return "github"
+}
+
+func (c *SearchCode) Color() string {
+	return "gray"
+}
+
+func (c *SearchCode) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SearchCode) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "query",
+			Label:       "Query",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., ubuntu-20.04 path:.github/workflows",
+		},
+		{
+			Name:        "limit",
+			Label:       "Limit",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultSearchCodeLimit,
+			Description: "Maximum number of files to return",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := 1000; return &max }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *SearchCode) Setup(ctx core.SetupContext) error {
+	var config SearchCodeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Query) == "" {
+		return errors.New("query is required")
+	}
+
+	if config.Limit != nil && (*config.Limit < 1 || *config.Limit > 1000) {
+		return errors.New("limit must be between 1 and 1000")
+	}
+
+	return nil
+}
+
+func (c *SearchCode) Execute(ctx core.ExecutionContext) error {
+	var config SearchCodeConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	limit := DefaultSearchCodeLimit
+	if config.Limit != nil {
+		limit = *config.Limit
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	output, err := searchCode(client, searchCodeQuery(config.Query, appMetadata.Owner), limit)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Found %d of %d files matching %q", len(output.Matches), output.TotalCount, output.Query)
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.codeSearch",
+		[]any{output},
+	)
+}
+
+/*
+ * Installation tokens can search public code of other owners too,
+ * so queries are limited to the owner of the integration, unless they name their scope.
+ */
+func searchCodeQuery(query, owner string) string {
+	query = strings.TrimSpace(query)
+	if bulkCloseScopeQualifierRegex.MatchString(query) {
+		return query
+	}
+
+	return fmt.Sprintf("%s org:%s", query, owner)
+}
+
+func searchCode(client *github.Client, query string, limit int) (*SearchCodeOutput, error) {
+	output := &SearchCodeOutput{Query: query, Matches: []CodeMatch{}}
+	opts := &github.SearchOptions{
+		TextMatch:   true,
+		ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+	}
+
+	for {
+		result, response, err := client.Search.Code(context.Background(), query, opts)
+		if err != nil {
+			return nil, searchCodeError(err)
+		}
+
+		output.TotalCount = result.GetTotal()
+		output.IncompleteResults = output.IncompleteResults || result.GetIncompleteResults()
+		for _, code := range result.CodeResults {
+			fragments := []string{}
+			for _, match := range code.TextMatches {
+				fragments = append(fragments, match.GetFragment())
+			}
+
+			output.Matches = append(output.Matches, CodeMatch{
+				Repository: code.GetRepository().GetFullName(),
+				Path:       code.GetPath(),
+				SHA:        code.GetSHA(),
+				URL:        code.GetHTMLURL(),
+				Fragments:  fragments,
+			})
+
+			if len(output.Matches) == limit {
+				return output, nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			return output, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Code search allows only a few requests per minute,
+ * so retrying right away does not help. The reset time is surfaced instead.
+ */
+func searchCodeError(err error) error {
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		reset := rateLimitErr.Rate.Reset.UTC().Format(time.RFC3339)
+		return fmt.Errorf("%w: code search rate limit exceeded, it resets at %s: %w", ErrRateLimited, reset, err)
+	}
+
+	return fmt.Errorf("failed to search code: %w", wrapGitHubError(err))
+}
+
+func (c *SearchCode) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SearchCode) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SearchCode) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SearchCode) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SearchCode) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SearchCode) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
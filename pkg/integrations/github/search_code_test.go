@@ -0,0 +1,103 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SearchCode__Setup(t *testing.T) {
+	component := SearchCode{}
+
+	t.Run("query is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"query": " "},
+		})
+
+		require.ErrorContains(t, err, "query is required")
+	})
+
+	t.Run("limit over the search maximum -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"query": "ubuntu-20.04", "limit": 5000},
+		})
+
+		require.ErrorContains(t, err, "limit must be between 1 and 1000")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"query": "ubuntu-20.04 path:.github/workflows", "limit": 10},
+		}))
+	})
+}
+
+func Test__SearchCode__Query(t *testing.T) {
+	assert.Equal(t, "ubuntu-20.04 org:testhq", searchCodeQuery(" ubuntu-20.04 ", "testhq"))
+	assert.Equal(t, "ubuntu-20.04 repo:testhq/hello", searchCodeQuery("ubuntu-20.04 repo:testhq/hello", "testhq"))
+	assert.Equal(t, "org:other ubuntu-20.04", searchCodeQuery("org:other ubuntu-20.04", "testhq"))
+}
+
+func Test__SearchCode__Search(t *testing.T) {
+	t.Run("matches of every page are returned up to the limit", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.URL.Query().Get("page") == "2" {
+				return mockResponse(http.StatusOK, `{"total_count":3,"items":[
+					{"path":"c.yml","repository":{"full_name":"testhq/c"}},
+					{"path":"d.yml","repository":{"full_name":"testhq/d"}}
+				]}`), nil
+			}
+
+			response := mockResponse(http.StatusOK, `{"total_count":3,"incomplete_results":true,"items":[
+				{"path":".github/workflows/ci.yml","sha":"bbcd538","html_url":"https://github.com/testhq/hello/blob/main/.github/workflows/ci.yml","repository":{"full_name":"testhq/hello"},"text_matches":[{"fragment":"runs-on: ubuntu-20.04"},{"fragment":"image: ubuntu-20.04"}]},
+				{"path":"b.yml","repository":{"full_name":"testhq/b"}}
+			]}`)
+
+			response.Header.Set("Link", `<https://api.github.com/search/code?page=2>; rel="next"`)
+			return response, nil
+		}}
+
+		output, err := searchCode(github.NewClient(&http.Client{Transport: transport}), "ubuntu-20.04 org:testhq", 3)
+		require.NoError(t, err)
+
+		require.Len(t, transport.requests, 2)
+		assert.Equal(t, "ubuntu-20.04 org:testhq", transport.requests[0].URL.Query().Get("q"))
+		assert.Contains(t, transport.requests[0].Header.Get("Accept"), "text-match")
+		assert.Equal(t, 3, output.TotalCount)
+		assert.True(t, output.IncompleteResults)
+		require.Len(t, output.Matches, 3)
+		assert.Equal(t, CodeMatch{
+			Repository: "testhq/hello",
+			Path:       ".github/workflows/ci.yml",
+			SHA:        "bbcd538",
+			URL:        "https://github.com/testhq/hello/blob/main/.github/workflows/ci.yml",
+			Fragments:  []string{"runs-on: ubuntu-20.04", "image: ubuntu-20.04"},
+		}, output.Matches[0])
+		assert.Equal(t, "testhq/c", output.Matches[2].Repository)
+	})
+
+	t.Run("rate limited -> error with the reset time", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			response := mockResponse(http.StatusForbidden, `{"message":"API rate limit exceeded"}`)
+			response.Header.Set("X-RateLimit-Limit", "10")
+			response.Header.Set("X-RateLimit-Remaining", "0")
+			response.Header.Set("X-RateLimit-Reset", "1768586236")
+			return response, nil
+		}}
+
+		_, err := searchCode(github.NewClient(&http.Client{Transport: transport}), "ubuntu-20.04 org:testhq", 10)
+		require.ErrorIs(t, err, ErrRateLimited)
+		assert.ErrorContains(t, err, "code search rate limit exceeded, it resets at 2026-01-16T17:57:16Z")
+	})
+}
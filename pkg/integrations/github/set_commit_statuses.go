@@ -0,0 +1,331 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type SetCommitStatuses struct{}
+
+type SetCommitStatusesConfiguration struct {
+	Repository string              `json:"repository" mapstructure:"repository"`
+	SHA        string              `json:"sha" mapstructure:"sha"`
+	Statuses   []CommitStatusEntry `json:"statuses" mapstructure:"statuses"`
+}
+
+type CommitStatusEntry struct {
+	Context     string `json:"context" mapstructure:"context"`
+	State       string `json:"state" mapstructure:"state"`
+	Description string `json:"description" mapstructure:"description"`
+	TargetURL   string `json:"targetUrl" mapstructure:"targetUrl"`
+}
+
+type CommitStatusResult struct {
+	Context   string `json:"context"`
+	State     string `json:"state"`
+	Created   bool   `json:"created"`
+	ID        int64  `json:"id,omitempty"`
+	TargetURL string `json:"target_url,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (c *SetCommitStatuses) Name() string {
+	return "github.setCommitStatuses"
+}
+
+func (c *SetCommitStatuses) Label() string {
+	return "Set Commit Statuses"
+}
+
+func (c *SetCommitStatuses) Description() string {
+	return "Publish several status checks to a GitHub commit"
+}
+
+func (c *SetCommitStatuses) Documentation() string {
+	return `The Set Commit Statuses component creates several status checks on a GitHub commit at once, one for each context.
+
+## Use Cases
+
+- **Monorepos**: Report the result of each project of a monorepo build as its own status
+- **Fan-in**: Report the results of several parallel checks from a single node
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Commit SHA**: The full 40-character commit SHA (supports expressions)
+- **Statuses**: The statuses to create. Each status has:
+  - **Context**: A label to identify the status check (e.g., "ci/api", "ci/web")
+  - **State**: pending, success, failure, or error
+  - **Description**: Short description of the status (max ~140 characters, optional)
+  - **Target URL**: Link to build logs, test results, or deployment details (optional)
+
+## Output
+
+Emits a ` + "`github.commitStatuses`" + ` event with the ` + "`sha`" + ` and the ` + "`results`" + ` for each context: its ` + "`context`" + `, ` + "`state`" + `,
+whether it was ` + "`created`" + `, and the ` + "`error`" + ` if it was not. ` + "`created_count`" + ` and ` + "`failed_count`" + ` summarize the results.
+
+## Notes
+
+- Every status is validated like in Publish Commit Status before any is created, so an invalid entry fails the execution without creating any status
+- Statuses are created one after the other. If some fail, the others are still created, and the failures are reported in the results
+- If every status fails, the execution fails`
+}
+
+func (c *SetCommitStatuses) Icon() string {
+	return "github"
+}
+
+func (c *SetCommitStatuses) Color() string {
+	return "gray"
+}
+
+func (c *SetCommitStatuses) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetCommitStatuses) Configuration() []configuration.Field {
+	states := []configuration.FieldOption{}
+	for _, state := range CommitStatusStates {
+		states = append(states, configuration.FieldOption{Label: strings.ToUpper(state[:1]) + state[1:], Value: state})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "sha",
+			Label:       "Commit SHA",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., abc123def456... or {{event.data.after}}",
+			Description: "The full SHA of the commit to attach the statuses to",
+		},
+		{
+			Name:     "statuses",
+			Label:    "Statuses",
+			Type:     configuration.FieldTypeList,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Status",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "context",
+								Label:       "Context",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Placeholder: "e.g., ci/api",
+							},
+							{
+								Name:     "state",
+								Label:    "State",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: states,
+									},
+								},
+							},
+							{
+								Name:        "description",
+								Label:       "Description",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "e.g., Build completed successfully",
+							},
+							{
+								Name:        "targetUrl",
+								Label:       "Target URL",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "https://...",
+							},
+						},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetCommitStatuses) Setup(ctx core.SetupContext) error {
+	var config SetCommitStatusesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.Statuses) == 0 {
+		return errors.New("at least one status is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *SetCommitStatuses) Execute(ctx core.ExecutionContext) error {
+	var config SetCommitStatusesConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateCommitSHA(config.SHA); err != nil {
+		return err
+	}
+
+	statuses, err := buildRepoStatuses(config.Statuses)
+	if err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.commitStatuses", func() (any, error) {
+		results := createCommitStatuses(client, appMetadata.Owner, config.Repository, config.SHA, statuses)
+
+		failed := 0
+		for _, result := range results {
+			if !result.Created {
+				failed++
+				ctx.Logger.Warnf("Failed to create status %s: %s", result.Context, result.Error)
+			}
+		}
+
+		if failed == len(results) {
+			return nil, fmt.Errorf("failed to create any of the %d commit statuses: %s", len(results), results[0].Error)
+		}
+
+		return map[string]any{
+			"sha":           config.SHA,
+			"created_count": len(results) - failed,
+			"failed_count":  failed,
+			"results":       results,
+		}, nil
+	})
+}
+
+/*
+ * All entries are validated before any status is created,
+ * so a typo in the last entry does not leave the commit half reported.
+ */
+func buildRepoStatuses(entries []CommitStatusEntry) ([]*github.RepoStatus, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("at least one status is required")
+	}
+
+	statuses := make([]*github.RepoStatus, 0, len(entries))
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		status, err := buildRepoStatus(entry.State, entry.Context, entry.Description, entry.TargetURL)
+		if err != nil {
+			return nil, fmt.Errorf("status %d: %w", i+1, err)
+		}
+
+		//
+		// A second status with the same context replaces the first one,
+		// so a duplicate is most likely a mistake.
+		//
+		if seen[entry.Context] {
+			return nil, fmt.Errorf("status %d: duplicate context %q", i+1, entry.Context)
+		}
+
+		seen[entry.Context] = true
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func createCommitStatuses(client *github.Client, owner, repository, sha string, statuses []*github.RepoStatus) []CommitStatusResult {
+	results := make([]CommitStatusResult, 0, len(statuses))
+	for _, status := range statuses {
+		result := CommitStatusResult{
+			Context: status.GetContext(),
+			State:   status.GetState(),
+		}
+
+		var created *github.RepoStatus
+		err := withRateLimitRetry(fmt.Sprintf("create status %s", result.Context), func() error {
+			var err error
+			created, _, err = client.Repositories.CreateStatus(context.Background(), owner, repository, sha, status)
+			return err
+		})
+
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to create commit status: %v", err)
+		} else {
+			result.Created = true
+			result.ID = created.GetID()
+			result.TargetURL = created.GetTargetURL()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func (c *SetCommitStatuses) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetCommitStatuses) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetCommitStatuses) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetCommitStatuses) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetCommitStatuses) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetCommitStatuses) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
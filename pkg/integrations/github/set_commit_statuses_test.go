@@ -0,0 +1,127 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetCommitStatuses__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetCommitStatuses{}
+
+	t.Run("statuses are required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "at least one status is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: integrationCtx,
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository": "hello",
+				"statuses":   []any{map[string]any{"context": "ci/api", "state": "success"}},
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__SetCommitStatuses__BuildRepoStatuses(t *testing.T) {
+	t.Run("valid statuses", func(t *testing.T) {
+		statuses, err := buildRepoStatuses([]CommitStatusEntry{
+			{Context: "ci/api", State: "success", Description: "Passed", TargetURL: "https://ci.example.com/1"},
+			{Context: "ci/web", State: "pending"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "Passed", statuses[0].GetDescription())
+		assert.Nil(t, statuses[1].Description)
+		assert.Nil(t, statuses[1].TargetURL)
+	})
+
+	t.Run("invalid state -> error", func(t *testing.T) {
+		_, err := buildRepoStatuses([]CommitStatusEntry{
+			{Context: "ci/api", State: "success"},
+			{Context: "ci/web", State: "passed"},
+		})
+
+		require.ErrorContains(t, err, `status 2: invalid state "passed"`)
+	})
+
+	t.Run("missing context -> error", func(t *testing.T) {
+		_, err := buildRepoStatuses([]CommitStatusEntry{{State: "success"}})
+		require.ErrorContains(t, err, "status 1: context is required")
+	})
+
+	t.Run("duplicate context -> error", func(t *testing.T) {
+		_, err := buildRepoStatuses([]CommitStatusEntry{
+			{Context: "ci/api", State: "success"},
+			{Context: "ci/api", State: "failure"},
+		})
+
+		require.ErrorContains(t, err, `duplicate context "ci/api"`)
+	})
+}
+
+func Test__SetCommitStatuses__Create(t *testing.T) {
+	sha := "abc123def456789012345678901234567890abcd"
+	statuses, err := buildRepoStatuses([]CommitStatusEntry{
+		{Context: "ci/api", State: "success"},
+		{Context: "ci/web", State: "failure"},
+	})
+
+	require.NoError(t, err)
+
+	bodies := []map[string]any{}
+	transport := &mockTransport{
+		handler: func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			body := map[string]any{}
+			_ = json.Unmarshal(data, &body)
+			bodies = append(bodies, body)
+
+			if body["context"] == "ci/web" {
+				return mockResponse(http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`), nil
+			}
+
+			return mockResponse(http.StatusCreated, `{"id": 1001, "state": "success", "context": "ci/api"}`), nil
+		},
+	}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	results := createCommitStatuses(client, "testhq", "hello", sha, statuses)
+
+	require.Len(t, transport.requests, 2)
+	assert.Equal(t, "/repos/testhq/hello/statuses/"+sha, transport.requests[0].URL.Path)
+	assert.Equal(t, "success", bodies[0]["state"])
+
+	require.Len(t, results, 2)
+	assert.True(t, results[0].Created)
+	assert.Equal(t, int64(1001), results[0].ID)
+	assert.False(t, results[1].Created)
+	assert.Equal(t, "ci/web", results[1].Context)
+	assert.Contains(t, results[1].Error, "failed to create commit status")
+}
@@ -0,0 +1,256 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type SetDefaultBranch struct{}
+
+type SetDefaultBranchConfiguration struct {
+	Repository    string `json:"repository" mapstructure:"repository"`
+	DefaultBranch string `json:"defaultBranch" mapstructure:"defaultBranch"`
+}
+
+type SetDefaultBranchOutput struct {
+	Repository            string `json:"repository"`
+	DefaultBranch         string `json:"default_branch"`
+	PreviousDefaultBranch string `json:"previous_default_branch"`
+	Changed               bool   `json:"changed"`
+	OpenPullRequests      int    `json:"open_pull_requests"`
+	Note                  string `json:"note,omitempty"`
+	URL                   string `json:"html_url"`
+}
+
+func (c *SetDefaultBranch) Name() string {
+	return "github.setDefaultBranch"
+}
+
+func (c *SetDefaultBranch) Label() string {
+	return "Set Default Branch"
+}
+
+func (c *SetDefaultBranch) Description() string {
+	return "Change the default branch of a GitHub repository"
+}
+
+func (c *SetDefaultBranch) Documentation() string {
+	return `The Set Default Branch component changes the default branch of a repository to an existing branch.
+
+## Use Cases
+
+- **Repository standardization**: Move repositories from ` + "`master`" + ` to ` + "`main`" + `
+- **Release branches**: Point the default branch to a new long-lived branch
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Default Branch**: The branch to make the default (supports expressions). It must already exist
+
+## Output
+
+Returns the ` + "`default_branch`" + `, the ` + "`previous_default_branch`" + `, and ` + "`changed`" + `, which is false if the branch was already the default.
+
+Open pull requests keep targeting the previous default branch.
+` + "`open_pull_requests`" + ` counts the open pull requests that target the previous default branch, and ` + "`note`" + ` explains it when there are any, so they can be retargeted.
+
+## Notes
+
+- Changing the default branch requires admin access to the repository, and the GitHub integration to be set up with **Repository Administration** enabled`
+}
+
+func (c *SetDefaultBranch) Icon() string {
+	return "github"
+}
+
+func (c *SetDefaultBranch) Color() string {
+	return "gray"
+}
+
+func (c *SetDefaultBranch) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetDefaultBranch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "defaultBranch",
+			Label:       "Default Branch",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., main",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetDefaultBranch) Setup(ctx core.SetupContext) error {
+	var config SetDefaultBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.DefaultBranch == "" {
+		return errors.New("default branch is required")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *SetDefaultBranch) Execute(ctx core.ExecutionContext) error {
+	var config SetDefaultBranchConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setDefaultBranch(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, config.DefaultBranch)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.defaultBranch",
+		[]any{output},
+	)
+}
+
+func setDefaultBranch(client *github.Client, installationID, owner, repo, branch string) (*SetDefaultBranchOutput, error) {
+	//
+	// GetBranch does not return a *github.ErrorResponse for unexpected status codes,
+	// so a missing branch is detected from the response.
+	//
+	_, response, err := client.Repositories.GetBranch(context.Background(), owner, repo, branch, 0)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: branch %s does not exist in %s", ErrNotFound, branch, repo)
+		}
+
+		return nil, fmt.Errorf("failed to get branch %s: %w", branch, wrapGitHubError(err))
+	}
+
+	repository, err := getRepository(client, installationID, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SetDefaultBranchOutput{
+		Repository:            repo,
+		DefaultBranch:         branch,
+		PreviousDefaultBranch: repository.GetDefaultBranch(),
+		URL:                   repository.GetHTMLURL(),
+	}
+
+	if output.PreviousDefaultBranch == branch {
+		return output, nil
+	}
+
+	_, _, err = client.Repositories.Edit(context.Background(), owner, repo, &github.Repository{DefaultBranch: github.Ptr(branch)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default branch of %s to %s: %w", repo, branch, wrapGitHubError(err))
+	}
+
+	output.Changed = true
+	output.OpenPullRequests, err = countOpenPullRequests(client, owner, repo, output.PreviousDefaultBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.OpenPullRequests > 0 {
+		output.Note = fmt.Sprintf("%d open pull requests still target %s", output.OpenPullRequests, output.PreviousDefaultBranch)
+	}
+
+	return output, nil
+}
+
+func countOpenPullRequests(client *github.Client, owner, repo, base string) (int, error) {
+	count := 0
+	opts := &github.PullRequestListOptions{
+		State:       "open",
+		Base:        base,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		pullRequests, response, err := client.PullRequests.List(context.Background(), owner, repo, opts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list open pull requests for %s: %w", base, wrapGitHubError(err))
+		}
+
+		count += len(pullRequests)
+		if response.NextPage == 0 {
+			return count, nil
+		}
+
+		opts.ListOptions.Page = response.NextPage
+	}
+}
+
+func (c *SetDefaultBranch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetDefaultBranch) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetDefaultBranch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetDefaultBranch) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetDefaultBranch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetDefaultBranch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,92 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetDefaultBranch__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetDefaultBranch{}
+
+	t.Run("default branch is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "default branch is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "defaultBranch": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__SetDefaultBranch__Set(t *testing.T) {
+	repositoryTransport := func(defaultBranch string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case request.URL.Path == "/repos/testhq/hello/branches/main":
+				return mockResponse(http.StatusOK, `{"name":"main"}`), nil
+			case request.URL.Path == "/repos/testhq/hello" && request.Method == http.MethodGet:
+				return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"`+defaultBranch+`","html_url":"https://github.com/testhq/hello"}`), nil
+			case request.URL.Path == "/repos/testhq/hello" && request.Method == http.MethodPatch:
+				return mockResponse(http.StatusOK, `{"name":"hello","default_branch":"main"}`), nil
+			case request.URL.Path == "/repos/testhq/hello/pulls":
+				return mockResponse(http.StatusOK, `[{"number":1},{"number":2}]`), nil
+			}
+
+			return mockResponse(http.StatusNotFound, `{"message":"Branch not found"}`), nil
+		}}
+	}
+
+	t.Run("default branch is changed", func(t *testing.T) {
+		transport := repositoryTransport("master")
+		output, err := setDefaultBranch(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", "main")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+
+		body, _ := io.ReadAll(transport.requests[2].Body)
+		assert.JSONEq(t, `{"default_branch":"main"}`, string(body))
+		assert.Equal(t, "master", transport.requests[3].URL.Query().Get("base"))
+		assert.Equal(t, "open", transport.requests[3].URL.Query().Get("state"))
+
+		assert.True(t, output.Changed)
+		assert.Equal(t, "master", output.PreviousDefaultBranch)
+		assert.Equal(t, 2, output.OpenPullRequests)
+		assert.Equal(t, "2 open pull requests still target master", output.Note)
+	})
+
+	t.Run("already the default branch -> not changed", func(t *testing.T) {
+		transport := repositoryTransport("main")
+		output, err := setDefaultBranch(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", "main")
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 2)
+		assert.False(t, output.Changed)
+		assert.Empty(t, output.Note)
+	})
+
+	t.Run("branch does not exist -> error", func(t *testing.T) {
+		transport := repositoryTransport("master")
+		_, err := setDefaultBranch(github.NewClient(&http.Client{Transport: transport}), "1001", "testhq", "hello", "trunk")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.ErrorContains(t, err, "branch trunk does not exist in hello")
+		assert.Len(t, transport.requests, 1)
+	})
+}
@@ -0,0 +1,318 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+// GitHub variable names: letters, numbers and underscores, not starting with a number
+var variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var reservedVariablePrefixes = []string{"GITHUB_", "GH_"}
+
+type SetEnvironmentVariable struct{}
+
+type SetEnvironmentVariableConfiguration struct {
+	Repository        string `json:"repository" mapstructure:"repository"`
+	Environment       string `json:"environment" mapstructure:"environment"`
+	Name              string `json:"name" mapstructure:"name"`
+	Value             string `json:"value" mapstructure:"value"`
+	CreateEnvironment bool   `json:"createEnvironment" mapstructure:"createEnvironment"`
+}
+
+type SetEnvironmentVariableOutput struct {
+	Repository         string `json:"repository"`
+	Environment        string `json:"environment"`
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Created            bool   `json:"created"`
+	Changed            bool   `json:"changed"`
+	EnvironmentCreated bool   `json:"environment_created"`
+}
+
+func (c *SetEnvironmentVariable) Name() string {
+	return "github.setEnvironmentVariable"
+}
+
+func (c *SetEnvironmentVariable) Label() string {
+	return "Set Environment Variable"
+}
+
+func (c *SetEnvironmentVariable) Description() string {
+	return "Create or update a GitHub Actions variable of a repository environment"
+}
+
+func (c *SetEnvironmentVariable) Documentation() string {
+	return `The Set Environment Variable component creates or updates a GitHub Actions variable of a deployment environment.
+
+## Use Cases
+
+- **Configuration sync**: Keep the variables of GitHub environments in sync with a source of truth
+- **Release flows**: Record the deployed version in an environment variable
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Environment**: The environment name (supports expressions)
+- **Name**: The variable name. Only letters, numbers and underscores, not starting with a number
+- **Value**: The variable value (supports expressions)
+- **Create Environment**: Create the environment if it does not exist
+
+## Output
+
+Returns the variable ` + "`name`" + ` and ` + "`value`" + `, with ` + "`created`" + ` set if the variable did not exist, and ` + "`changed`" + ` set if it was created or its value changed.
+` + "`environment_created`" + ` is true if the environment was created.
+
+## Notes
+
+- Names starting with ` + "`GITHUB_`" + ` or ` + "`GH_`" + ` are reserved by GitHub
+- If the variable already has the value, nothing is changed
+- If the environment does not exist and **Create Environment** is disabled, the execution fails with an environment not found error
+- Creating environments needs write access to the repository administration, on top of the environments access needed for variables,
+  so **Create Environment** requires the GitHub integration to be set up with **Repository Administration** enabled`
+}
+
+func (c *SetEnvironmentVariable) Icon() string {
+	return "github"
+}
+
+func (c *SetEnvironmentVariable) Color() string {
+	return "gray"
+}
+
+func (c *SetEnvironmentVariable) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetEnvironmentVariable) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., production",
+		},
+		{
+			Name:        "name",
+			Label:       "Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., APP_VERSION",
+		},
+		{
+			Name:     "value",
+			Label:    "Value",
+			Type:     configuration.FieldTypeString,
+			Required: true,
+		},
+		{
+			Name:        "createEnvironment",
+			Label:       "Create Environment",
+			Type:        configuration.FieldTypeBool,
+			Default:     false,
+			Description: "Create the environment if it does not exist",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetEnvironmentVariable) Setup(ctx core.SetupContext) error {
+	var config SetEnvironmentVariableConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(config.Environment) == "" {
+		return errors.New("environment is required")
+	}
+
+	if err := validateVariableName(config.Name); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateVariableName(name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	if isExpression(name) {
+		return nil
+	}
+
+	if !variableNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid variable name %s: only letters, numbers and underscores are allowed, and it cannot start with a number", name)
+	}
+
+	for _, prefix := range reservedVariablePrefixes {
+		if strings.HasPrefix(strings.ToUpper(name), prefix) {
+			return fmt.Errorf("invalid variable name %s: the %s prefix is reserved", name, prefix)
+		}
+	}
+
+	return nil
+}
+
+func (c *SetEnvironmentVariable) Execute(ctx core.ExecutionContext) error {
+	var config SetEnvironmentVariableConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateVariableName(config.Name); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setEnvironmentVariable(client, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.environmentVariable",
+		[]any{output},
+	)
+}
+
+func setEnvironmentVariable(client *github.Client, owner string, config SetEnvironmentVariableConfiguration) (*SetEnvironmentVariableOutput, error) {
+	output := &SetEnvironmentVariableOutput{
+		Repository:  config.Repository,
+		Environment: config.Environment,
+		Name:        config.Name,
+		Value:       config.Value,
+	}
+
+	environmentCreated, err := ensureEnvironment(client, owner, config.Repository, config.Environment, config.CreateEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	output.EnvironmentCreated = environmentCreated
+
+	variable := &github.ActionsVariable{Name: config.Name, Value: config.Value}
+	existing, _, err := client.Actions.GetEnvVariable(context.Background(), owner, config.Repository, config.Environment, config.Name)
+	err = wrapGitHubError(err)
+	if errors.Is(err, ErrNotFound) {
+		_, err := client.Actions.CreateEnvVariable(context.Background(), owner, config.Repository, config.Environment, variable)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create variable %s: %w", config.Name, wrapGitHubError(err))
+		}
+
+		output.Created = true
+		output.Changed = true
+		return output, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get variable %s: %w", config.Name, err)
+	}
+
+	if existing.Value == config.Value {
+		return output, nil
+	}
+
+	_, err = client.Actions.UpdateEnvVariable(context.Background(), owner, config.Repository, config.Environment, variable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update variable %s: %w", config.Name, wrapGitHubError(err))
+	}
+
+	output.Changed = true
+	return output, nil
+}
+
+/*
+ * Checks that the environment exists, creating it if allowed.
+ * Returns whether the environment was created.
+ */
+func ensureEnvironment(client *github.Client, owner, repo, environment string, create bool) (bool, error) {
+	_, _, err := client.Repositories.GetEnvironment(context.Background(), owner, repo, environment)
+	err = wrapEnvironmentError(err, environment)
+	if err == nil {
+		return false, nil
+	}
+
+	if !errors.Is(err, ErrEnvironmentNotFound) || !create {
+		return false, fmt.Errorf("failed to get environment %s: %w", environment, err)
+	}
+
+	_, _, err = client.Repositories.CreateUpdateEnvironment(context.Background(), owner, repo, environment, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create environment %s: %w", environment, wrapGitHubError(err))
+	}
+
+	return true, nil
+}
+
+func (c *SetEnvironmentVariable) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetEnvironmentVariable) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetEnvironmentVariable) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetEnvironmentVariable) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetEnvironmentVariable) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetEnvironmentVariable) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,134 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetEnvironmentVariable__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetEnvironmentVariable{}
+
+	setup := func(name string) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "environment": "production", "name": name, "value": "1.2.3"},
+		})
+	}
+
+	t.Run("invalid names -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(""), "name is required")
+		require.ErrorContains(t, setup("1VERSION"), "invalid variable name 1VERSION")
+		require.ErrorContains(t, setup("APP-VERSION"), "invalid variable name APP-VERSION")
+	})
+
+	t.Run("reserved prefixes -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup("GITHUB_TOKEN"), "the GITHUB_ prefix is reserved")
+		require.ErrorContains(t, setup("gh_version"), "the GH_ prefix is reserved")
+	})
+
+	t.Run("expression names are not validated", func(t *testing.T) {
+		require.NoError(t, setup("{{$.data.name}}"))
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "environment": "production", "name": "APP_VERSION", "value": "1.2.3"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__SetEnvironmentVariable__Set(t *testing.T) {
+	config := SetEnvironmentVariableConfiguration{Repository: "hello", Environment: "production", Name: "APP_VERSION", Value: "1.2.3"}
+
+	transportFor := func(environmentExists bool, existingVariable string) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			path := request.URL.Path
+			switch {
+			case strings.HasSuffix(path, "/environments/production") && request.Method == http.MethodGet:
+				if !environmentExists {
+					return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+				}
+
+				return mockResponse(http.StatusOK, `{"name":"production"}`), nil
+			case strings.HasSuffix(path, "/environments/production") && request.Method == http.MethodPut:
+				return mockResponse(http.StatusOK, `{"name":"production"}`), nil
+			case strings.HasSuffix(path, "/variables/APP_VERSION") && request.Method == http.MethodGet:
+				if existingVariable == "" {
+					return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+				}
+
+				return mockResponse(http.StatusOK, `{"name":"APP_VERSION","value":"`+existingVariable+`"}`), nil
+			}
+
+			return mockResponse(http.StatusNoContent, ``), nil
+		}}
+	}
+
+	t.Run("missing variable -> created", func(t *testing.T) {
+		transport := transportFor(true, "")
+		output, err := setEnvironmentVariable(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPost, transport.requests[2].Method)
+		assert.Equal(t, "/repos/testhq/hello/environments/production/variables", transport.requests[2].URL.Path)
+
+		body, _ := io.ReadAll(transport.requests[2].Body)
+		assert.JSONEq(t, `{"name":"APP_VERSION","value":"1.2.3"}`, string(body))
+		assert.True(t, output.Created)
+		assert.True(t, output.Changed)
+		assert.False(t, output.EnvironmentCreated)
+	})
+
+	t.Run("different value -> updated", func(t *testing.T) {
+		transport := transportFor(true, "1.2.2")
+		output, err := setEnvironmentVariable(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 3)
+		assert.Equal(t, http.MethodPatch, transport.requests[2].Method)
+		assert.False(t, output.Created)
+		assert.True(t, output.Changed)
+	})
+
+	t.Run("same value -> no-op", func(t *testing.T) {
+		transport := transportFor(true, "1.2.3")
+		output, err := setEnvironmentVariable(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.NoError(t, err)
+		assert.Len(t, transport.requests, 2)
+		assert.False(t, output.Changed)
+	})
+
+	t.Run("missing environment -> environment not found", func(t *testing.T) {
+		transport := transportFor(false, "")
+		_, err := setEnvironmentVariable(github.NewClient(&http.Client{Transport: transport}), "testhq", config)
+		require.ErrorIs(t, err, ErrEnvironmentNotFound)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("missing environment with createEnvironment -> environment created", func(t *testing.T) {
+		transport := transportFor(false, "")
+		withCreate := config
+		withCreate.CreateEnvironment = true
+
+		output, err := setEnvironmentVariable(github.NewClient(&http.Client{Transport: transport}), "testhq", withCreate)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+		assert.Equal(t, http.MethodPut, transport.requests[1].Method)
+		assert.True(t, output.EnvironmentCreated)
+		assert.True(t, output.Created)
+	})
+}
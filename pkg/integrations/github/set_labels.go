@@ -0,0 +1,309 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type SetLabels struct{}
+
+type SetLabelsConfiguration struct {
+	Repository       string   `json:"repository" mapstructure:"repository"`
+	IssueNumber      string   `json:"issueNumber" mapstructure:"issueNumber"`
+	Labels           []string `json:"labels" mapstructure:"labels"`
+	PreservePrefixes []string `json:"preservePrefixes" mapstructure:"preservePrefixes"`
+}
+
+type SetLabelsOutput struct {
+	IssueNumber int      `json:"issue_number"`
+	Labels      []string `json:"labels"`
+	Preserved   []string `json:"preserved"`
+}
+
+func (c *SetLabels) Name() string {
+	return "github.setLabels"
+}
+
+func (c *SetLabels) Label() string {
+	return "Set Labels"
+}
+
+func (c *SetLabels) Description() string {
+	return "Replace all labels of a GitHub issue or pull request"
+}
+
+func (c *SetLabels) Documentation() string {
+	return `The Set Labels component replaces the labels of a GitHub issue or pull request, so it ends up with exactly the configured labels.
+
+## Use Cases
+
+- **Label sync**: Make the labels of an issue match the state of another system
+- **Triage**: Replace the triage labels of an issue once it is classified
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Issue Number**: The issue or pull request number (supports expressions)
+- **Labels**: The labels the issue should have. Leave empty to remove all labels
+- **Preserve Prefixes**: Labels of the issue starting with one of these prefixes, like ` + "`status:`" + `, are kept even if they are not in **Labels**
+
+## Output
+
+Emits a ` + "`github.issueLabels`" + ` event with the ` + "`issue_number`" + `, the resulting ` + "`labels`" + `, and the labels that were ` + "`preserved`" + ` by a prefix.
+
+## Notes
+
+- Labels that do not exist in the repository are created by GitHub, with a default color
+- Prefixes are matched ignoring case, like label names`
+}
+
+func (c *SetLabels) Icon() string {
+	return "github"
+}
+
+func (c *SetLabels) Color() string {
+	return "gray"
+}
+
+func (c *SetLabels) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetLabels) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "issueNumber",
+			Label:       "Issue Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.issue.number}}",
+		},
+		{
+			Name:  "labels",
+			Label: "Labels",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Label",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "preservePrefixes",
+			Label:       "Preserve Prefixes",
+			Type:        configuration.FieldTypeList,
+			Togglable:   true,
+			Description: "Keep the labels of the issue starting with these prefixes",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Prefix",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetLabels) Setup(ctx core.SetupContext) error {
+	var config SetLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.IssueNumber == "" {
+		return errors.New("issue number is required")
+	}
+
+	for _, prefix := range config.PreservePrefixes {
+		if strings.TrimSpace(prefix) == "" {
+			return errors.New("preserve prefixes cannot be empty")
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *SetLabels) Execute(ctx core.ExecutionContext) error {
+	var config SetLabelsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	issueNumber, err := strconv.Atoi(config.IssueNumber)
+	if err != nil {
+		return fmt.Errorf("issue number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setLabels(client, appMetadata.Owner, config.Repository, issueNumber, config.Labels, config.PreservePrefixes)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.issueLabels",
+		[]any{output},
+	)
+}
+
+/*
+ * GitHub only replaces the whole set of labels,
+ * so the labels to preserve are read first, and sent along with the new ones.
+ */
+func setLabels(client *github.Client, owner, repository string, issueNumber int, labels []string, preservePrefixes []string) (*SetLabelsOutput, error) {
+	names := []string{}
+	for _, label := range labels {
+		names = appendLabelName(names, label)
+	}
+
+	preserved := []string{}
+	if len(preservePrefixes) > 0 {
+		current, err := listIssueLabels(client, owner, repository, issueNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, label := range current {
+			if hasLabelPrefix(label, preservePrefixes) && !containsLabelName(names, label) {
+				preserved = append(preserved, label)
+				names = append(names, label)
+			}
+		}
+	}
+
+	result, _, err := client.Issues.ReplaceLabelsForIssue(context.Background(), owner, repository, issueNumber, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace labels of issue %d: %w", issueNumber, wrapGitHubError(err))
+	}
+
+	output := &SetLabelsOutput{IssueNumber: issueNumber, Labels: []string{}, Preserved: preserved}
+	for _, label := range result {
+		output.Labels = append(output.Labels, label.GetName())
+	}
+
+	return output, nil
+}
+
+func listIssueLabels(client *github.Client, owner, repository string, issueNumber int) ([]string, error) {
+	names := []string{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		labels, response, err := client.Issues.ListLabelsByIssue(context.Background(), owner, repository, issueNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels of issue %d: %w", issueNumber, wrapGitHubError(err))
+		}
+
+		for _, label := range labels {
+			names = append(names, label.GetName())
+		}
+
+		if response.NextPage == 0 {
+			return names, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+/*
+ * Label names are case-insensitive on GitHub,
+ * so labels only differing by case are the same label.
+ */
+func appendLabelName(names []string, name string) []string {
+	name = strings.TrimSpace(name)
+	if name == "" || containsLabelName(names, name) {
+		return names
+	}
+
+	return append(names, name)
+}
+
+func containsLabelName(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(n string) bool {
+		return strings.EqualFold(n, name)
+	})
+}
+
+func hasLabelPrefix(name string, prefixes []string) bool {
+	return slices.ContainsFunc(prefixes, func(prefix string) bool {
+		prefix = strings.TrimSpace(prefix)
+		return prefix != "" && strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix))
+	})
+}
+
+func (c *SetLabels) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetLabels) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetLabels) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetLabels) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetLabels) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetLabels) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
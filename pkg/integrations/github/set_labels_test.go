@@ -0,0 +1,96 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetLabels__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetLabels{}
+
+	t.Run("issue number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "labels": []string{"bug"}},
+		})
+
+		require.ErrorContains(t, err, "issue number is required")
+	})
+
+	t.Run("empty prefix -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "preservePrefixes": []string{" "}},
+		})
+
+		require.ErrorContains(t, err, "preserve prefixes cannot be empty")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "issueNumber": "42", "labels": []string{"bug"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__SetLabels__Set(t *testing.T) {
+	t.Run("labels are replaced", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.Equal(t, http.MethodPut, request.Method)
+			assert.JSONEq(t, `["bug","p1"]`, string(body))
+			return mockResponse(http.StatusOK, `[{"name":"bug"},{"name":"p1"}]`), nil
+		}}
+
+		output, err := setLabels(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, []string{"bug", "Bug", "p1"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bug", "p1"}, output.Labels)
+		assert.Empty(t, output.Preserved)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("no labels removes all of them", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `[]`, string(body))
+			return mockResponse(http.StatusOK, `[]`), nil
+		}}
+
+		output, err := setLabels(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, output.Labels)
+	})
+
+	t.Run("labels matching a prefix are preserved", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `[{"name":"Status: blocked"},{"name":"old"},{"name":"team:api"},{"name":"bug"}]`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `["bug","Status: blocked","team:api"]`, string(body))
+			return mockResponse(http.StatusOK, `[{"name":"bug"},{"name":"Status: blocked"},{"name":"team:api"}]`), nil
+		}}
+
+		output, err := setLabels(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, []string{"bug"}, []string{"status:", "team:"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Status: blocked", "team:api"}, output.Preserved)
+		assert.Equal(t, []string{"bug", "Status: blocked", "team:api"}, output.Labels)
+		assert.Equal(t, "/repos/testhq/hello/issues/42/labels", transport.requests[0].URL.Path)
+	})
+}
@@ -0,0 +1,302 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/shurcooL/githubv4"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type SetPullRequestDraft struct{}
+
+type SetPullRequestDraftConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	Draft      bool   `json:"draft" mapstructure:"draft"`
+}
+
+type pullRequestDraftRef struct {
+	ID      githubv4.ID
+	IsDraft bool
+}
+
+func (c *SetPullRequestDraft) Name() string {
+	return "github.setPullRequestDraft"
+}
+
+func (c *SetPullRequestDraft) Label() string {
+	return "Set Pull Request Draft"
+}
+
+func (c *SetPullRequestDraft) Description() string {
+	return "Convert a GitHub pull request to draft, or mark it as ready for review"
+}
+
+func (c *SetPullRequestDraft) Documentation() string {
+	return `The Set Pull Request Draft component converts a GitHub pull request to a draft, or marks it as ready for review.
+
+## Use Cases
+
+- **CI gates**: Convert pull requests to drafts while their checks are failing
+- **Automation**: Mark pull requests as ready for review once generated changes are pushed
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Draft**: Enable to convert the pull request to a draft, disable to mark it as ready for review
+
+## Output
+
+Emits the pull request ` + "`number`" + `, its ` + "`draft`" + ` state, and whether it was ` + "`changed`" + `.
+
+## Notes
+
+- If the pull request already is in the desired state, nothing is done, and ` + "`changed`" + ` is false
+- When the pull request number is not an expression, the pull request is checked to exist when the canvas is saved`
+}
+
+func (c *SetPullRequestDraft) Icon() string {
+	return "github"
+}
+
+func (c *SetPullRequestDraft) Color() string {
+	return "gray"
+}
+
+func (c *SetPullRequestDraft) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetPullRequestDraft) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "draft",
+			Label:       "Draft",
+			Type:        configuration.FieldTypeBool,
+			Default:     true,
+			Description: "Convert to draft when enabled, mark as ready for review when disabled",
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetPullRequestDraft) Setup(ctx core.SetupContext) error {
+	return c.setup(ctx, http.DefaultTransport)
+}
+
+func (c *SetPullRequestDraft) setup(ctx core.SetupContext, transport http.RoundTripper) error {
+	var config SetPullRequestDraftConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	err := ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	//
+	// Expressions are only resolved on execution,
+	// so the pull request can only be checked here for literal numbers.
+	//
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return nil
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := newGraphQLClient(ctx.Integration, transport, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	_, err = findPullRequestDraft(client, appMetadata.Owner, config.Repository, pullNumber)
+	return err
+}
+
+func (c *SetPullRequestDraft) Execute(ctx core.ExecutionContext) error {
+	var config SetPullRequestDraftConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionGraphQLClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub GraphQL client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.pullRequestDraft", func() (any, error) {
+		pullRequest, err := findPullRequestDraft(client, appMetadata.Owner, config.Repository, pullNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		output := map[string]any{
+			"number":  pullNumber,
+			"draft":   config.Draft,
+			"changed": false,
+		}
+
+		if pullRequest.IsDraft == config.Draft {
+			ctx.Logger.Infof("Pull request %d already has draft=%t", pullNumber, config.Draft)
+			return output, nil
+		}
+
+		isDraft, err := c.setDraft(client, pullRequest.ID, config.Draft)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update draft state of pull request %d: %w", pullNumber, err)
+		}
+
+		output["draft"] = isDraft
+		output["changed"] = true
+		return output, nil
+	})
+}
+
+/*
+ * The REST API cannot change the draft state of a pull request,
+ * so separate GraphQL mutations are used for each direction.
+ */
+func (c *SetPullRequestDraft) setDraft(client *githubv4.Client, pullRequestID githubv4.ID, draft bool) (bool, error) {
+	if draft {
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest struct {
+					IsDraft bool
+				}
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+
+		input := githubv4.ConvertPullRequestToDraftInput{PullRequestID: pullRequestID}
+		if err := client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+			return false, err
+		}
+
+		return mutation.ConvertPullRequestToDraft.PullRequest.IsDraft, nil
+	}
+
+	var mutation struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest struct {
+				IsDraft bool
+			}
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+
+	input := githubv4.MarkPullRequestReadyForReviewInput{PullRequestID: pullRequestID}
+	if err := client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+		return false, err
+	}
+
+	return mutation.MarkPullRequestReadyForReview.PullRequest.IsDraft, nil
+}
+
+func findPullRequestDraft(client *githubv4.Client, owner, repository string, number int) (*pullRequestDraftRef, error) {
+	var query struct {
+		Repository struct {
+			PullRequest *pullRequestDraftRef `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]any{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(repository),
+		"number": githubv4.Int(number),
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request %d: %w", number, err)
+	}
+
+	if query.Repository.PullRequest == nil || query.Repository.PullRequest.ID == nil {
+		return nil, fmt.Errorf("%w: pull request %d", ErrNotFound, number)
+	}
+
+	return query.Repository.PullRequest, nil
+}
+
+func (c *SetPullRequestDraft) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetPullRequestDraft) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetPullRequestDraft) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetPullRequestDraft) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetPullRequestDraft) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetPullRequestDraft) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
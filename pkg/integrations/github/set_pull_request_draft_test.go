@@ -0,0 +1,71 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetPullRequestDraft__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetPullRequestDraft{}
+
+	graphQLTransport := func(body string) *mockTransport {
+		return installationTransport(func(request *http.Request) (*http.Response, error) {
+			switch request.URL.Path {
+			case "/graphql":
+				return mockResponse(http.StatusOK, body), nil
+			default:
+				return nil, fmt.Errorf("unexpected request: %s", request.URL.String())
+			}
+		})
+	}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "draft": true},
+		}, graphQLTransport(`{}`))
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("expression -> pull request is not checked", func(t *testing.T) {
+		transport := graphQLTransport(`{}`)
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "{{$.data.pull_request.number}}", "draft": true},
+		}, transport))
+
+		require.Empty(t, transport.requests)
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+
+	t.Run("pull request does not exist -> error", func(t *testing.T) {
+		err := component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "draft": true},
+		}, graphQLTransport(`{"data":{"repository":{"pullRequest":null}}}`))
+
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("pull request exists -> ok", func(t *testing.T) {
+		transport := graphQLTransport(`{"data":{"repository":{"pullRequest":{"id":"PR_1","isDraft":false}}}}`)
+		require.NoError(t, component.setup(core.SetupContext{
+			Integration:   testInstallation(t, "testhq", helloRepo),
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "draft": true},
+		}, transport))
+
+		require.Len(t, transport.requests, 2)
+	})
+}
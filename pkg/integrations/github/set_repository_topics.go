@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	TopicsModeReplace = "replace"
+	TopicsModeAppend  = "append"
+	MaxTopics         = 20
+)
+
+var topicRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+type SetRepositoryTopics struct{}
+
+type SetRepositoryTopicsConfiguration struct {
+	Repository string   `json:"repository" mapstructure:"repository"`
+	Topics     []string `json:"topics" mapstructure:"topics"`
+	Mode       string   `json:"mode" mapstructure:"mode"`
+}
+
+type RepositoryTopicsOutput struct {
+	Repository string   `json:"repository"`
+	Topics     []string `json:"topics"`
+}
+
+func (c *SetRepositoryTopics) Name() string {
+	return "github.setRepositoryTopics"
+}
+
+func (c *SetRepositoryTopics) Label() string {
+	return "Set Repository Topics"
+}
+
+func (c *SetRepositoryTopics) Description() string {
+	return "Set the topics of a GitHub repository"
+}
+
+func (c *SetRepositoryTopics) Documentation() string {
+	return `The Set Repository Topics component sets the topics of a repository, replacing them or adding to them.
+
+## Use Cases
+
+- **Repository catalogs**: Tag repositories with their team, language or service tier
+- **Repository provisioning**: Add the standard topics to new repositories
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Topics**: The topics to set
+- **Mode**: **Replace** the topics of the repository with these, or **Append** them to the existing ones
+
+## Output
+
+Emits a ` + "`github.repositoryTopics`" + ` event with the ` + "`repository`" + ` and its resulting ` + "`topics`" + `.
+
+## Notes
+
+- Topics must be lowercase, start with a letter or number, contain only letters, numbers and hyphens, and be at most 50 characters long
+- A repository has at most 20 topics. In append mode, the execution fails if the existing topics and the new ones add up to more
+- Replacing with an empty list removes all topics
+- Requires the GitHub integration to be set up with **Repository Administration** enabled`
+}
+
+func (c *SetRepositoryTopics) Icon() string {
+	return "github"
+}
+
+func (c *SetRepositoryTopics) Color() string {
+	return "gray"
+}
+
+func (c *SetRepositoryTopics) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SetRepositoryTopics) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:  "topics",
+			Label: "Topics",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Topic",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:     "mode",
+			Label:    "Mode",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  TopicsModeReplace,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Replace", Value: TopicsModeReplace},
+						{Label: "Append", Value: TopicsModeAppend},
+					},
+				},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *SetRepositoryTopics) Setup(ctx core.SetupContext) error {
+	var config SetRepositoryTopicsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateRepositoryTopics(config); err != nil {
+		return err
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func validateRepositoryTopics(config SetRepositoryTopicsConfiguration) error {
+	if config.Mode != "" && config.Mode != TopicsModeReplace && config.Mode != TopicsModeAppend {
+		return fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	if len(config.Topics) > MaxTopics {
+		return fmt.Errorf("%d topics configured, but a repository has at most %d", len(config.Topics), MaxTopics)
+	}
+
+	for _, topic := range config.Topics {
+		if isExpression(topic) {
+			continue
+		}
+
+		if !topicRegex.MatchString(topic) {
+			return fmt.Errorf("invalid topic %q: topics must be lowercase, start with a letter or number, contain only letters, numbers and hyphens, and have at most 50 characters", topic)
+		}
+	}
+
+	return nil
+}
+
+func (c *SetRepositoryTopics) Execute(ctx core.ExecutionContext) error {
+	var config SetRepositoryTopicsConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if err := validateRepositoryTopics(config); err != nil {
+		return err
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := setRepositoryTopics(client, appMetadata.Owner, config.Repository, config.Topics, config.Mode == TopicsModeAppend)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Repository %s has %d topics", config.Repository, len(output.Topics))
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.repositoryTopics",
+		[]any{output},
+	)
+}
+
+func setRepositoryTopics(client *github.Client, owner, repository string, topics []string, appendTopics bool) (*RepositoryTopicsOutput, error) {
+	names := []string{}
+	if appendTopics {
+		current, _, err := client.Repositories.ListAllTopics(context.Background(), owner, repository)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list topics of %s: %w", repository, wrapGitHubError(err))
+		}
+
+		names = append(names, current...)
+	}
+
+	for _, topic := range topics {
+		topic = strings.TrimSpace(topic)
+		if topic != "" && !slices.Contains(names, topic) {
+			names = append(names, topic)
+		}
+	}
+
+	if len(names) > MaxTopics {
+		return nil, fmt.Errorf("%s would have %d topics, but a repository has at most %d", repository, len(names), MaxTopics)
+	}
+
+	result, _, err := client.Repositories.ReplaceAllTopics(context.Background(), owner, repository, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set topics of %s: %w", repository, wrapGitHubError(err))
+	}
+
+	if result == nil {
+		result = []string{}
+	}
+
+	return &RepositoryTopicsOutput{Repository: repository, Topics: result}, nil
+}
+
+func (c *SetRepositoryTopics) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SetRepositoryTopics) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SetRepositoryTopics) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SetRepositoryTopics) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SetRepositoryTopics) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SetRepositoryTopics) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
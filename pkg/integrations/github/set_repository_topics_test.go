@@ -0,0 +1,98 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SetRepositoryTopics__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SetRepositoryTopics{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("invalid topics -> error", func(t *testing.T) {
+		for _, topic := range []string{"Backend", "-api", "go_lang", "with space", strings.Repeat("a", 51)} {
+			require.ErrorContains(t, setup(map[string]any{"repository": "hello", "topics": []string{topic}}), "invalid topic", topic)
+		}
+	})
+
+	t.Run("too many topics -> error", func(t *testing.T) {
+		topics := []string{}
+		for i := 0; i < 21; i++ {
+			topics = append(topics, "topic-"+strings.Repeat("a", i+1))
+		}
+
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "topics": topics}), "at most 20")
+	})
+
+	t.Run("invalid mode -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"repository": "hello", "topics": []string{"api"}, "mode": "merge"}), "invalid mode")
+	})
+
+	t.Run("valid topics and expressions", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"repository": "hello", "topics": []string{"api", "go-1-22", "{{$.data.team}}"}}))
+	})
+}
+
+func Test__SetRepositoryTopics__Set(t *testing.T) {
+	t.Run("topics are replaced", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(request.Body)
+			assert.Equal(t, http.MethodPut, request.Method)
+			assert.JSONEq(t, `{"names":["api","backend"]}`, string(body))
+			return mockResponse(http.StatusOK, `{"names":["api","backend"]}`), nil
+		}}
+
+		output, err := setRepositoryTopics(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", []string{"api", "backend", "api"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"api", "backend"}, output.Topics)
+		assert.Len(t, transport.requests, 1)
+		assert.Equal(t, "/repos/testhq/hello/topics", transport.requests[0].URL.Path)
+	})
+
+	t.Run("topics are appended to the existing ones", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			if request.Method == http.MethodGet {
+				return mockResponse(http.StatusOK, `{"names":["go","api"]}`), nil
+			}
+
+			body, _ := io.ReadAll(request.Body)
+			assert.JSONEq(t, `{"names":["go","api","backend"]}`, string(body))
+			return mockResponse(http.StatusOK, `{"names":["go","api","backend"]}`), nil
+		}}
+
+		output, err := setRepositoryTopics(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", []string{"api", "backend"}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "api", "backend"}, output.Topics)
+	})
+
+	t.Run("appending past the limit fails without changes", func(t *testing.T) {
+		existing := []string{}
+		for i := 0; i < 20; i++ {
+			existing = append(existing, `"t`+strings.Repeat("a", i+1)+`"`)
+		}
+
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"names":[`+strings.Join(existing, ",")+`]}`), nil
+		}}
+
+		_, err := setRepositoryTopics(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", []string{"api"}, true)
+		require.ErrorContains(t, err, "would have 21 topics")
+		assert.Len(t, transport.requests, 1)
+	})
+}
@@ -0,0 +1,363 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"golang.org/x/sync/errgroup"
+)
+
+/*
+ * How many sections of the snapshot are fetched at the same time,
+ * so a single execution does not use up the secondary rate limit.
+ */
+const SummarizePullRequestConcurrency = 3
+
+const (
+	PullRequestSectionFiles     = "files"
+	PullRequestSectionReviews   = "reviews"
+	PullRequestSectionChecks    = "checks"
+	PullRequestSectionReviewers = "requested_reviewers"
+)
+
+type SummarizePullRequest struct{}
+
+type SummarizePullRequestConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+}
+
+/*
+ * A section that could not be fetched is left empty,
+ * and the reason is recorded in Errors, under the name of the section.
+ */
+type PullRequestSnapshot struct {
+	PullRequest        *github.PullRequest       `json:"pull_request"`
+	Files              []PullRequestFile         `json:"files"`
+	Reviews            *PullRequestReviewsOutput `json:"reviews"`
+	Checks             *PullRequestChecksOutput  `json:"checks"`
+	RequestedReviewers *RequestedReviewers       `json:"requested_reviewers"`
+	Errors             map[string]string         `json:"errors,omitempty"`
+}
+
+type PullRequestFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+}
+
+type RequestedReviewers struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
+func (c *SummarizePullRequest) Name() string {
+	return "github.summarizePullRequest"
+}
+
+func (c *SummarizePullRequest) Label() string {
+	return "Summarize Pull Request"
+}
+
+func (c *SummarizePullRequest) Description() string {
+	return "Get a GitHub pull request with its files, reviews, checks and requested reviewers"
+}
+
+func (c *SummarizePullRequest) Documentation() string {
+	return `The Summarize Pull Request component collects everything about a pull request in a single event:
+the pull request itself, its changed files, its reviews, the state of its checks, and its requested reviewers.
+
+## Use Cases
+
+- **Review bots**: Give a bot or an AI agent a complete snapshot of a pull request
+- **Merge gates**: Decide whether to merge from the reviews and checks at once
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+
+## Output
+
+Emits a ` + "`github.pullRequestSummary`" + ` event with:
+
+- ` + "`pull_request`" + `: the complete pull request object, like in Get Pull Request
+- ` + "`files`" + `: the ` + "`filename`" + `, ` + "`status`" + `, ` + "`additions`" + ` and ` + "`deletions`" + ` of each changed file
+- ` + "`reviews`" + `: the latest review of each reviewer, like in Get Pull Request Reviews, with one required approval
+- ` + "`checks`" + `: the combined state of the commit statuses and check runs of the head commit, like in List Checks for Pull Request
+- ` + "`requested_reviewers`" + `: the ` + "`users`" + ` and ` + "`teams`" + ` whose review is still requested
+- ` + "`errors`" + `: for each section that could not be fetched, the reason
+
+## Notes
+
+- The files, reviews, checks and requested reviewers are fetched in parallel, once the pull request is fetched
+- If a section cannot be fetched, it is left empty, and the execution still succeeds. Check ` + "`errors`" + ` before relying on a section
+- If the pull request itself cannot be fetched, the execution fails
+- GitHub lists at most 3000 files for a pull request`
+}
+
+func (c *SummarizePullRequest) Icon() string {
+	return "github"
+}
+
+func (c *SummarizePullRequest) Color() string {
+	return "gray"
+}
+
+func (c *SummarizePullRequest) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *SummarizePullRequest) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+	}
+}
+
+func (c *SummarizePullRequest) Setup(ctx core.SetupContext) error {
+	var config SummarizePullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if !isExpression(config.PullNumber) {
+		if _, err := strconv.Atoi(config.PullNumber); err != nil {
+			return fmt.Errorf("pull request number is not a number: %s", config.PullNumber)
+		}
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *SummarizePullRequest) Execute(ctx core.ExecutionContext) error {
+	var config SummarizePullRequestConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	snapshot, err := snapshotPullRequest(client, appMetadata.InstallationID, appMetadata.Owner, config.Repository, pullNumber)
+	if err != nil {
+		return err
+	}
+
+	for section, message := range snapshot.Errors {
+		ctx.Logger.Warnf("Failed to fetch the %s of pull request %d: %s", section, pullNumber, message)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.pullRequestSummary",
+		[]any{snapshot},
+	)
+}
+
+/*
+ * The checks are read for the head commit,
+ * so the pull request is fetched before the other sections.
+ */
+func snapshotPullRequest(client *github.Client, installationID, owner, repo string, pullNumber int) (*PullRequestSnapshot, error) {
+	pullRequest, err := getPullRequest(client, installationID, owner, repo, pullNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request %d: %w", pullNumber, err)
+	}
+
+	snapshot := &PullRequestSnapshot{PullRequest: pullRequest}
+
+	var mu sync.Mutex
+	fetch := func(section string, call func() error) func() error {
+		return func() error {
+			if err := call(); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if snapshot.Errors == nil {
+					snapshot.Errors = map[string]string{}
+				}
+
+				snapshot.Errors[section] = err.Error()
+			}
+
+			//
+			// Failures are recorded instead of returned,
+			// so one failing section does not stop the others.
+			//
+			return nil
+		}
+	}
+
+	var group errgroup.Group
+	group.SetLimit(SummarizePullRequestConcurrency)
+
+	group.Go(fetch(PullRequestSectionFiles, func() error {
+		files, err := listPullRequestFiles(client, owner, repo, pullNumber)
+		snapshot.Files = files
+		return err
+	}))
+
+	group.Go(fetch(PullRequestSectionReviews, func() error {
+		reviews, err := listPullRequestReviews(client, owner, repo, pullNumber)
+		if err != nil {
+			return err
+		}
+
+		snapshot.Reviews = summarizeReviews(latestReviewPerAuthor(reviews), DefaultRequiredApprovals)
+		snapshot.Reviews.PullNumber = pullNumber
+		return nil
+	}))
+
+	group.Go(fetch(PullRequestSectionChecks, func() error {
+		checks, err := listHeadChecks(client, owner, repo, pullRequest.GetHead().GetSHA())
+		if err != nil {
+			return err
+		}
+
+		snapshot.Checks = summarizeChecks(checks, false)
+		snapshot.Checks.PullNumber = pullNumber
+		snapshot.Checks.HeadSHA = pullRequest.GetHead().GetSHA()
+		return nil
+	}))
+
+	group.Go(fetch(PullRequestSectionReviewers, func() error {
+		reviewers, err := listRequestedReviewers(client, owner, repo, pullNumber)
+		snapshot.RequestedReviewers = reviewers
+		return err
+	}))
+
+	_ = group.Wait()
+	return snapshot, nil
+}
+
+func listPullRequestFiles(client *github.Client, owner, repo string, pullNumber int) ([]PullRequestFile, error) {
+	files := []PullRequestFile{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, response, err := client.PullRequests.ListFiles(context.Background(), owner, repo, pullNumber, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files of pull request %d: %w", pullNumber, wrapGitHubError(err))
+		}
+
+		for _, file := range page {
+			files = append(files, PullRequestFile{
+				Filename:         file.GetFilename(),
+				PreviousFilename: file.GetPreviousFilename(),
+				Status:           file.GetStatus(),
+				Additions:        file.GetAdditions(),
+				Deletions:        file.GetDeletions(),
+				Changes:          file.GetChanges(),
+			})
+		}
+
+		if response.NextPage == 0 {
+			return files, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func listHeadChecks(client *github.Client, owner, repo, sha string) ([]PullRequestCheck, error) {
+	statuses, err := listCommitStatuses(client, owner, repo, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns, err := listCheckRunsForSHA(client, owner, repo, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(statuses, checkRuns...), nil
+}
+
+func listRequestedReviewers(client *github.Client, owner, repo string, pullNumber int) (*RequestedReviewers, error) {
+	reviewers, _, err := client.PullRequests.ListReviewers(context.Background(), owner, repo, pullNumber, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requested reviewers of pull request %d: %w", pullNumber, wrapGitHubError(err))
+	}
+
+	requested := &RequestedReviewers{Users: []string{}, Teams: []string{}}
+	for _, user := range reviewers.Users {
+		requested.Users = append(requested.Users, user.GetLogin())
+	}
+
+	for _, team := range reviewers.Teams {
+		requested.Teams = append(requested.Teams, team.GetSlug())
+	}
+
+	return requested, nil
+}
+
+func (c *SummarizePullRequest) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *SummarizePullRequest) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *SummarizePullRequest) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *SummarizePullRequest) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *SummarizePullRequest) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *SummarizePullRequest) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+/*
+ * The sections are fetched concurrently, so requests are recorded under a lock,
+ * and the number of requests in flight is tracked.
+ */
+type sectionTransport struct {
+	mu          sync.Mutex
+	paths       []string
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	responses   map[string]*http.Response
+}
+
+func (s *sectionTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	current := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	for {
+		max := s.maxInFlight.Load()
+		if current <= max || s.maxInFlight.CompareAndSwap(max, current) {
+			break
+		}
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paths = append(s.paths, request.URL.Path)
+	for suffix, response := range s.responses {
+		if strings.HasSuffix(request.URL.Path, suffix) {
+			return response, nil
+		}
+	}
+
+	return mockResponse(http.StatusNotFound, `{"message": "Not Found"}`), nil
+}
+
+func Test__SummarizePullRequest__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := SummarizePullRequest{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("pull request number is not a number", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "abc"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is not a number")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "{{$.data.number}}"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__SummarizePullRequest__Summarize(t *testing.T) {
+	pullRequest := `{"number": 42, "title": "Add feature", "head": {"ref": "feature", "sha": "abc123"}, "base": {"ref": "main"}}`
+
+	t.Run("all sections are fetched", func(t *testing.T) {
+		transport := &sectionTransport{
+			responses: map[string]*http.Response{
+				"/pulls/42":                     mockResponse(http.StatusOK, pullRequest),
+				"/pulls/42/files":               mockResponse(http.StatusOK, `[{"filename": "main.go", "status": "modified", "additions": 10, "deletions": 2, "changes": 12}]`),
+				"/pulls/42/reviews":             mockResponse(http.StatusOK, `[{"id": 1, "user": {"login": "alice"}, "state": "APPROVED"}]`),
+				"/pulls/42/requested_reviewers": mockResponse(http.StatusOK, `{"users": [{"login": "bob"}], "teams": [{"slug": "platform"}]}`),
+				"/commits/abc123/status":        mockResponse(http.StatusOK, `{"state": "success", "statuses": [{"context": "ci/build", "state": "success"}]}`),
+				"/commits/abc123/check-runs":    mockResponse(http.StatusOK, `{"total_count": 1, "check_runs": [{"name": "lint", "status": "in_progress"}]}`),
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		summary, err := snapshotPullRequest(client, "summary-all", "testhq", "hello", 42)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Add feature", summary.PullRequest.GetTitle())
+		require.Len(t, summary.Files, 1)
+		assert.Equal(t, "main.go", summary.Files[0].Filename)
+		assert.Equal(t, 10, summary.Files[0].Additions)
+		require.NotNil(t, summary.Reviews)
+		assert.True(t, summary.Reviews.Approved)
+		require.NotNil(t, summary.Checks)
+		assert.Equal(t, ChecksStatePending, summary.Checks.State)
+		assert.Equal(t, "abc123", summary.Checks.HeadSHA)
+		assert.Equal(t, []string{"lint"}, summary.Checks.Pending)
+		assert.Equal(t, &RequestedReviewers{Users: []string{"bob"}, Teams: []string{"platform"}}, summary.RequestedReviewers)
+		assert.Nil(t, summary.Errors)
+
+		assert.Len(t, transport.paths, 6)
+		assert.Equal(t, "/repos/testhq/hello/pulls/42", transport.paths[0])
+		assert.Greater(t, transport.maxInFlight.Load(), int32(1))
+		assert.LessOrEqual(t, transport.maxInFlight.Load(), int32(SummarizePullRequestConcurrency))
+	})
+
+	t.Run("failed sections are reported in errors", func(t *testing.T) {
+		transport := &sectionTransport{
+			responses: map[string]*http.Response{
+				"/pulls/42":                     mockResponse(http.StatusOK, pullRequest),
+				"/pulls/42/files":               mockResponse(http.StatusOK, `[]`),
+				"/pulls/42/reviews":             mockResponse(http.StatusOK, `[]`),
+				"/pulls/42/requested_reviewers": mockResponse(http.StatusOK, `{"users": [], "teams": []}`),
+				"/commits/abc123/status":        mockResponse(http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			},
+		}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		summary, err := snapshotPullRequest(client, "summary-partial", "testhq", "hello", 42)
+		require.NoError(t, err)
+
+		assert.Nil(t, summary.Checks)
+		require.Len(t, summary.Errors, 1)
+		assert.Contains(t, summary.Errors[PullRequestSectionChecks], "failed to get combined status for abc123")
+		assert.Empty(t, summary.Files)
+		require.NotNil(t, summary.Reviews)
+		assert.False(t, summary.Reviews.Approved)
+	})
+
+	t.Run("pull request not found -> error", func(t *testing.T) {
+		transport := &sectionTransport{responses: map[string]*http.Response{}}
+
+		client := github.NewClient(&http.Client{Transport: transport})
+		_, err := snapshotPullRequest(client, "summary-missing", "testhq", "hello", 42)
+		require.ErrorIs(t, err, ErrNotFound)
+		assert.Len(t, transport.paths, 1)
+	})
+}
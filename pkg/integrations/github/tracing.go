@@ -0,0 +1,220 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const CorrelationIDHeader = "X-SuperPlane-Correlation-ID"
+
+/*
+ * UserAgent is the base User-Agent used for all GitHub API requests.
+ * The IDs of the execution and node making the request are appended to it,
+ * so requests can be identified in the GitHub audit logs.
+ */
+var UserAgent = "SuperPlane"
+
+type requestTrace struct {
+	IntegrationID string
+	ExecutionID   string
+	NodeID        string
+
+	//
+	// Components call GitHub with context.Background(),
+	// so request spans are parented to the execution span through this context,
+	// and requests are aborted when it is cancelled or its deadline passes.
+	//
+	Parent context.Context
+}
+
+func (t requestTrace) userAgent() string {
+	details := []string{}
+	if t.IntegrationID != "" {
+		details = append(details, "integration "+t.IntegrationID)
+	}
+
+	if t.ExecutionID != "" {
+		details = append(details, "execution "+t.ExecutionID)
+	}
+
+	if t.NodeID != "" {
+		details = append(details, "node "+t.NodeID)
+	}
+
+	if len(details) == 0 {
+		return UserAgent
+	}
+
+	return fmt.Sprintf("%s (%s)", UserAgent, strings.Join(details, "; "))
+}
+
+/*
+ * tracingTransport adds the User-Agent and a new correlation ID to every request,
+ * and logs the correlation ID, to tie GitHub-side and SuperPlane-side logs together.
+ * Every request also gets an OpenTelemetry client span.
+ *
+ * It wraps the transport used by the installation transport,
+ * so requests for installation tokens are traced too.
+ */
+type tracingTransport struct {
+	base   http.RoundTripper
+	trace  requestTrace
+	logger *log.Entry
+}
+
+func newTracingTransport(base http.RoundTripper, trace requestTrace, logger *log.Entry) *tracingTransport {
+	return &tracingTransport{base: base, trace: trace, logger: logger}
+}
+
+func (t *tracingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	correlationID := uuid.NewString()
+
+	span := t.startSpan(request, correlationID)
+	defer span.End()
+
+	//
+	// RoundTrippers must not modify the request they receive.
+	// The span is added to the request context, instead of replacing it,
+	// so the request is still cancelled with its original context.
+	//
+	ctx, release := t.requestContext(request)
+	request = request.Clone(trace.ContextWithSpan(ctx, span))
+	request.Header.Set("User-Agent", t.trace.userAgent())
+	request.Header.Set(CorrelationIDHeader, correlationID)
+
+	logger := t.logger.WithField("correlation_id", correlationID)
+	response, err := t.base.RoundTrip(request)
+	recordAPIRequest(request, response)
+	if err != nil {
+		release()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.Debugf("GitHub request %s %s failed: %v", request.Method, request.URL.Path, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", response.StatusCode))
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, response.Status)
+	}
+
+	logger.Debugf("GitHub request %s %s: %d", request.Method, request.URL.Path, response.StatusCode)
+	if response.Body == nil {
+		release()
+		return response, nil
+	}
+
+	response.Body = &releasingBody{ReadCloser: response.Body, release: release}
+	return response, nil
+}
+
+/*
+ * Returns the request context, also cancelled when the execution context is done,
+ * so cancelling an execution aborts its in-flight requests.
+ * The context is not cancelled when the request returns,
+ * because the response body is still read with it.
+ * Instead, the returned release function cancels it, and removes it from the execution context.
+ * RoundTrip calls it when the request fails, or when the response body is closed.
+ */
+func (t *tracingTransport) requestContext(request *http.Request) (context.Context, func()) {
+	if t.trace.Parent == nil || t.trace.Parent.Done() == nil {
+		return request.Context(), func() {}
+	}
+
+	ctx, cancel := context.WithCancelCause(request.Context())
+	stop := context.AfterFunc(t.trace.Parent, func() {
+		cancel(context.Cause(t.trace.Parent))
+	})
+
+	return ctx, func() {
+		stop()
+		cancel(nil)
+	}
+}
+
+// releasingBody releases the request context once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+func (t *tracingTransport) startSpan(request *http.Request, correlationID string) trace.Span {
+	parent := request.Context()
+	if !trace.SpanContextFromContext(parent).IsValid() && t.trace.Parent != nil {
+		parent = t.trace.Parent
+	}
+
+	_, span := telemetry.Tracer().Start(parent, "GitHub "+request.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", request.Method),
+			attribute.String("url.path", request.URL.Path),
+			attribute.String("server.address", request.URL.Host),
+			attribute.String("superplane.correlation_id", correlationID),
+		),
+	)
+
+	return span
+}
+
+/*
+ * Records a GitHub API request in the github.api.requests metric.
+ * Requests that got no response, like the ones that timed out, are recorded with the "error" status.
+ */
+func recordAPIRequest(request *http.Request, response *http.Response) {
+	status := "error"
+	if response != nil {
+		status = strconv.Itoa(response.StatusCode)
+	}
+
+	telemetry.RecordGitHubAPIRequest(request.Context(), apiEndpoint(request), status)
+}
+
+/*
+ * Returns the endpoint of a request, like "POST /repos/{owner}/{repo}/issues/{id}/comments".
+ * Owners, repositories, IDs and commit SHAs are replaced with placeholders,
+ * so the metric gets one series per endpoint, and not one per repository or issue.
+ */
+func apiEndpoint(request *http.Request) string {
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		switch {
+		case segments[0] == "repos" && i == 1:
+			segments[i] = "{owner}"
+		case segments[0] == "repos" && i == 2:
+			segments[i] = "{repo}"
+		case i == 1 && (segments[0] == "orgs" || segments[0] == "users"):
+			segments[i] = "{" + strings.TrimSuffix(segments[0], "s") + "}"
+		case isNumber(segment):
+			segments[i] = "{id}"
+		case commitSHARegex.MatchString(segment):
+			segments[i] = "{sha}"
+		}
+	}
+
+	return request.Method + " /" + strings.Join(segments, "/")
+}
+
+func isNumber(segment string) bool {
+	_, err := strconv.ParseInt(segment, 10, 64)
+	return err == nil
+}
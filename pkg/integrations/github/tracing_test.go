@@ -0,0 +1,228 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test__TracingTransport(t *testing.T) {
+	newTransport := func(trace requestTrace) (*tracingTransport, *mockTransport) {
+		base := &mockTransport{
+			handler: func(request *http.Request) (*http.Response, error) {
+				return mockResponse(http.StatusOK, `{}`), nil
+			},
+		}
+
+		return newTracingTransport(base, trace, log.NewEntry(log.StandardLogger())), base
+	}
+
+	t.Run("user agent includes the execution and node", func(t *testing.T) {
+		transport, base := newTransport(requestTrace{IntegrationID: "i-1", ExecutionID: "e-1", NodeID: "n-1"})
+		request, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/testhq/hello", nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(request)
+		require.NoError(t, err)
+		require.Len(t, base.requests, 1)
+		assert.Equal(t, "SuperPlane (integration i-1; execution e-1; node n-1)", base.requests[0].Header.Get("User-Agent"))
+		assert.Empty(t, request.Header.Get(CorrelationIDHeader), "original request is not modified")
+	})
+
+	t.Run("each request gets its own correlation ID", func(t *testing.T) {
+		transport, base := newTransport(requestTrace{})
+		for range 2 {
+			request, err := http.NewRequest(http.MethodGet, "https://api.github.com/installation/repositories", nil)
+			require.NoError(t, err)
+			_, err = transport.RoundTrip(request)
+			require.NoError(t, err)
+		}
+
+		require.Len(t, base.requests, 2)
+		first := base.requests[0].Header.Get(CorrelationIDHeader)
+		assert.NotEmpty(t, first)
+		assert.NotEqual(t, first, base.requests[1].Header.Get(CorrelationIDHeader))
+		assert.Equal(t, "SuperPlane", base.requests[0].Header.Get("User-Agent"))
+	})
+
+	t.Run("base user agent is configurable", func(t *testing.T) {
+		original := UserAgent
+		UserAgent = "SuperPlane-Staging"
+		defer func() { UserAgent = original }()
+
+		assert.Equal(t, "SuperPlane-Staging (node n-1)", requestTrace{NodeID: "n-1"}.userAgent())
+	})
+}
+
+func Test__TracingTransport__Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	telemetry.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer telemetry.SetTracerProvider(nil)
+
+	t.Run("request span is a child of the execution span", func(t *testing.T) {
+		parent, executionSpan := telemetry.Tracer().Start(context.Background(), "component.execute")
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		transport := newTracingTransport(base, requestTrace{Parent: parent}, log.NewEntry(log.StandardLogger()))
+		request, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/testhq/hello", nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(request)
+		require.NoError(t, err)
+		executionSpan.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		span := spans[0]
+		assert.Equal(t, "GitHub GET", span.Name())
+		assert.Equal(t, executionSpan.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, executionSpan.SpanContext().TraceID(), span.SpanContext().TraceID())
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Contains(t, span.Attributes(), attribute.String("url.path", "/repos/testhq/hello"))
+		assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+	})
+
+	t.Run("transport errors are recorded", func(t *testing.T) {
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection reset")
+		}}
+
+		transport := newTracingTransport(base, requestTrace{}, log.NewEntry(log.StandardLogger()))
+		request, err := http.NewRequest(http.MethodPost, "https://api.github.com/repos/testhq/hello/issues", nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(request)
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		assert.Equal(t, "GitHub POST", span.Name())
+		assert.False(t, span.Parent().IsValid())
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "connection reset", span.Status().Description)
+	})
+	t.Run("request is aborted when the execution context is cancelled", func(t *testing.T) {
+		parent, cancel := context.WithCancel(context.Background())
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			cancel()
+
+			select {
+			case <-request.Context().Done():
+				return nil, context.Cause(request.Context())
+			case <-time.After(time.Second):
+				return mockResponse(http.StatusOK, `{}`), nil
+			}
+		}}
+
+		transport := newTracingTransport(base, requestTrace{Parent: parent}, log.NewEntry(log.StandardLogger()))
+		request, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/testhq/hello/commits/main/check-runs", nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(request)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("request context is released when the response body is closed", func(t *testing.T) {
+		parent, cancel := context.WithCancelCause(context.Background())
+		defer cancel(nil)
+
+		base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{}`), nil
+		}}
+
+		transport := newTracingTransport(base, requestTrace{Parent: parent}, log.NewEntry(log.StandardLogger()))
+		request, err := http.NewRequest(http.MethodGet, "https://api.github.com/repos/testhq/hello", nil)
+		require.NoError(t, err)
+
+		response, err := transport.RoundTrip(request)
+		require.NoError(t, err)
+
+		requestCtx := base.requests[0].Context()
+		require.NoError(t, requestCtx.Err(), "body can still be read")
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, string(body))
+
+		require.NoError(t, response.Body.Close())
+		require.NoError(t, response.Body.Close())
+		require.ErrorIs(t, requestCtx.Err(), context.Canceled)
+
+		cancel(errors.New("execution cancelled"))
+		assert.Equal(t, context.Canceled, context.Cause(requestCtx), "released, not cancelled by the execution")
+	})
+}
+
+func Test__TracingTransport__Metrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	metrics, err := telemetry.NewComponentMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	require.NoError(t, err)
+	telemetry.SetComponentMetrics(metrics)
+	defer telemetry.SetComponentMetrics(nil)
+
+	statuses := map[string]int{"/repos/testhq/hello/issues/42/comments": http.StatusCreated, "/repos/testhq/world/issues/7/comments": http.StatusForbidden}
+	base := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+		status, ok := statuses[request.URL.Path]
+		if !ok {
+			return nil, errors.New("connection reset")
+		}
+
+		return mockResponse(status, `{}`), nil
+	}}
+
+	transport := newTracingTransport(base, requestTrace{}, log.NewEntry(log.StandardLogger()))
+	for _, path := range []string{"/repos/testhq/hello/issues/42/comments", "/repos/testhq/world/issues/7/comments", "/repos/testhq/hello/issues/8/comments"} {
+		request, err := http.NewRequest(http.MethodPost, "https://api.github.com"+path, nil)
+		require.NoError(t, err)
+		_, _ = transport.RoundTrip(request)
+	}
+
+	var collected metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &collected))
+	require.Len(t, collected.ScopeMetrics, 1)
+	require.Len(t, collected.ScopeMetrics[0].Metrics, 1)
+
+	requests := collected.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "github.api.requests", requests.Name)
+
+	recorded := map[string]int64{}
+	for _, point := range requests.Data.(metricdata.Sum[int64]).DataPoints {
+		endpoint, _ := point.Attributes.Value("endpoint")
+		status, _ := point.Attributes.Value("status")
+		assert.Equal(t, "POST /repos/{owner}/{repo}/issues/{id}/comments", endpoint.AsString())
+		recorded[status.AsString()] = point.Value
+	}
+
+	assert.Equal(t, map[string]int64{"201": 1, "403": 1, "error": 1}, recorded)
+}
+
+func Test__APIEndpoint(t *testing.T) {
+	endpoint := func(method, path string) string {
+		request, err := http.NewRequest(method, "https://api.github.com"+path, nil)
+		require.NoError(t, err)
+		return apiEndpoint(request)
+	}
+
+	assert.Equal(t, "GET /repos/{owner}/{repo}", endpoint(http.MethodGet, "/repos/testhq/hello"))
+	assert.Equal(t, "GET /repos/{owner}/{repo}/commits/{sha}/status", endpoint(http.MethodGet, "/repos/testhq/hello/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e/status"))
+	assert.Equal(t, "GET /orgs/{org}/members", endpoint(http.MethodGet, "/orgs/testhq/members"))
+	assert.Equal(t, "GET /users/{user}", endpoint(http.MethodGet, "/users/octocat"))
+	assert.Equal(t, "POST /app/installations/{id}/access_tokens", endpoint(http.MethodPost, "/app/installations/123/access_tokens"))
+	assert.Equal(t, "POST /graphql", endpoint(http.MethodPost, "/graphql"))
+}
@@ -2,7 +2,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v74/github"
 	"github.com/google/uuid"
@@ -13,15 +17,43 @@ import (
 
 type UpdateRelease struct{}
 
+const ReleaseStrategyID = "id"
+
 type UpdateReleaseConfiguration struct {
-	Repository           string `mapstructure:"repository"`
-	ReleaseStrategy      string `mapstructure:"releaseStrategy"`
-	TagName              string `mapstructure:"tagName"`
-	Name                 string `mapstructure:"name"`
-	Body                 string `mapstructure:"body"`
-	Draft                bool   `mapstructure:"draft"`
-	Prerelease           bool   `mapstructure:"prerelease"`
-	GenerateReleaseNotes bool   `mapstructure:"generateReleaseNotes"`
+	Repository           string               `mapstructure:"repository"`
+	ReleaseStrategy      string               `mapstructure:"releaseStrategy"`
+	ReleaseID            string               `mapstructure:"releaseId"`
+	TagName              string               `mapstructure:"tagName"`
+	NewTagName           string               `mapstructure:"newTagName"`
+	Name                 string               `mapstructure:"name"`
+	Body                 string               `mapstructure:"body"`
+	Draft                *bool                `mapstructure:"draft"`
+	Prerelease           *bool                `mapstructure:"prerelease"`
+	GenerateReleaseNotes bool                 `mapstructure:"generateReleaseNotes"`
+	Assets               []ReleaseAssetSource `mapstructure:"assets"`
+	OnOversize           string               `mapstructure:"onOversize"`
+}
+
+/*
+ * A release asset, uploaded from text content,
+ * like checksums, an SBOM or a manifest.
+ */
+type ReleaseAssetSource struct {
+	Name        string `mapstructure:"name"`
+	Content     string `mapstructure:"content"`
+	ContentType string `mapstructure:"contentType"`
+}
+
+const (
+	ReleaseAssetCreated  = "created"
+	ReleaseAssetReplaced = "replaced"
+)
+
+type ReleaseAssetChange struct {
+	Name   string `json:"name" mapstructure:"name"`
+	ID     int64  `json:"id" mapstructure:"id"`
+	Action string `json:"action" mapstructure:"action"`
+	Size   int    `json:"size" mapstructure:"size"`
 }
 
 func (c *UpdateRelease) Name() string {
@@ -49,17 +81,28 @@ func (c *UpdateRelease) Documentation() string {
 ## Configuration
 
 - **Repository**: Select the GitHub repository
-- **Release Strategy**: How to find the release (by tag name or latest)
+- **Release Strategy**: How to find the release (by tag name, by ID, or latest)
+- **Release ID**: ID of the release to update (if using ID strategy)
 - **Tag Name**: Git tag name of the release to update (if using tag strategy)
+- **New Tag Name**: Move the release to another tag (optional, supports expressions)
 - **Name**: New release title/name (optional, supports expressions)
 - **Body**: New release notes/description (optional, supports markdown and expressions)
-- **Draft**: Update draft status
-- **Prerelease**: Update prerelease status
+- **Draft**: Update draft status. Leave it off to keep the current status
+- **Prerelease**: Update prerelease status. Leave it off to keep the current status
 - **Generate Release Notes**: Regenerate release notes from commits
+- **Assets**: Files to attach to the release, each with a name, its text content, and a content type
+- **On Oversize**: GitHub rejects release notes over 125000 characters. Truncate the notes with a notice, or fail before updating the release
 
 ## Output
 
-Returns the updated release object with all current information.`
+Returns the updated release object with all current information.
+` + "`truncated`" + ` is true if the release notes were cut to fit the GitHub limit.
+` + "`asset_changes`" + ` lists the uploaded assets, with their ` + "`name`" + `, ` + "`id`" + `, ` + "`size`" + `, and whether they were ` + "`created`" + ` or ` + "`replaced`" + `.
+
+## Notes
+
+- Only the configured fields are changed, everything else is kept
+- Assets are matched by name. An asset that already exists is replaced, so running the component again updates the assets instead of failing`
 }
 
 func (c *UpdateRelease) Icon() string {
@@ -101,6 +144,10 @@ func (c *UpdateRelease) Configuration() []configuration.Field {
 							Label: "Specific tag",
 							Value: "specific",
 						},
+						{
+							Label: "Release ID",
+							Value: ReleaseStrategyID,
+						},
 						{
 							Label: "Latest release",
 							Value: "latest",
@@ -137,6 +184,33 @@ func (c *UpdateRelease) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "releaseId",
+			Label:       "Release ID",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.id}}",
+			Description: "ID of the release to update",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "releaseStrategy",
+					Values: []string{ReleaseStrategyID},
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{
+					Field:  "releaseStrategy",
+					Values: []string{ReleaseStrategyID},
+				},
+			},
+		},
+		{
+			Name:        "newTagName",
+			Label:       "New Tag Name",
+			Type:        configuration.FieldTypeString,
+			Togglable:   true,
+			Placeholder: "e.g., v1.0.1",
+			Description: "Move the release to another tag",
+		},
 		{
 			Name:        "name",
 			Label:       "Release Name",
@@ -166,6 +240,7 @@ func (c *UpdateRelease) Configuration() []configuration.Field {
 			Label:       "Draft",
 			Type:        configuration.FieldTypeBool,
 			Required:    false,
+			Togglable:   true,
 			Default:     false,
 			Description: "Mark release as draft or publish it",
 		},
@@ -174,13 +249,76 @@ func (c *UpdateRelease) Configuration() []configuration.Field {
 			Label:       "Prerelease",
 			Type:        configuration.FieldTypeBool,
 			Required:    false,
+			Togglable:   true,
 			Default:     false,
 			Description: "Mark as prerelease or stable release",
 		},
+		{
+			Name:  "assets",
+			Label: "Assets",
+			Type:  configuration.FieldTypeList,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Asset",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:        "name",
+								Label:       "Name",
+								Type:        configuration.FieldTypeString,
+								Required:    true,
+								Placeholder: "e.g., checksums.txt",
+							},
+							{
+								Name:     "content",
+								Label:    "Content",
+								Type:     configuration.FieldTypeText,
+								Required: true,
+							},
+							{
+								Name:        "contentType",
+								Label:       "Content Type",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "e.g., application/json",
+								Description: "Defaults to text/plain",
+							},
+						},
+					},
+				},
+			},
+		},
+		OnOversizeField,
+		ConcurrencyKeyField,
 	}
 }
 
 func (c *UpdateRelease) Setup(ctx core.SetupContext) error {
+	var config UpdateReleaseConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.ReleaseStrategy == ReleaseStrategyID && !isExpression(config.ReleaseID) {
+		if _, err := strconv.ParseInt(config.ReleaseID, 10, 64); err != nil {
+			return fmt.Errorf("release ID is not a number: %s", config.ReleaseID)
+		}
+	}
+
+	names := []string{}
+	for _, asset := range config.Assets {
+		name := strings.TrimSpace(asset.Name)
+		if name == "" {
+			return errors.New("asset name is required")
+		}
+
+		if containsLabelName(names, name) {
+			return fmt.Errorf("asset %s is configured more than once", name)
+		}
+
+		names = append(names, name)
+	}
+
 	return ensureRepoInMetadata(
 		ctx.Metadata,
 		ctx.Integration,
@@ -204,21 +342,49 @@ func (c *UpdateRelease) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
 	}
 
-	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
 	if err != nil {
 		return fmt.Errorf("failed to initialize GitHub client: %w", err)
 	}
 
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	output, err := c.updateRelease(client, appMetadata.InstallationID, appMetadata.Owner, config)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Emit output with updated release data
+	//
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.release",
+		[]any{output},
+	)
+}
+
+func (c *UpdateRelease) updateRelease(client *github.Client, installationID, owner string, config UpdateReleaseConfiguration) (*ReleaseOutput, error) {
 	//
 	// Fetch the existing release based on the selected strategy
 	//
-	release, err := fetchReleaseByStrategy(client, appMetadata.Owner, config.Repository, config.ReleaseStrategy, config.TagName)
+	release, err := c.findRelease(client, installationID, owner, config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	//
-	// Build the update request with partial updates
+	// Build the update request with partial updates.
+	// Fields left nil are not sent, so GitHub keeps their current value.
 	//
 	releaseRequest := &github.RepositoryRelease{}
 
@@ -227,11 +393,15 @@ func (c *UpdateRelease) Execute(ctx core.ExecutionContext) error {
 		releaseRequest.Name = &config.Name
 	}
 
+	if config.NewTagName != "" {
+		releaseRequest.TagName = &config.NewTagName
+	}
+
 	// Handle body/notes logic
 	if config.GenerateReleaseNotes {
-		generatedNotes, err := c.generateReleaseNotes(ctx, client, appMetadata.Owner, config.Repository, release.GetTagName())
+		generatedNotes, err := c.generateReleaseNotes(client, owner, config.Repository, release.GetTagName())
 		if err != nil {
-			return fmt.Errorf("failed to generate release notes: %w", err)
+			return nil, fmt.Errorf("failed to generate release notes: %w", err)
 		}
 		body := generatedNotes
 
@@ -244,17 +414,23 @@ func (c *UpdateRelease) Execute(ctx core.ExecutionContext) error {
 		releaseRequest.Body = &config.Body
 	}
 
-	// Handle boolean fields - compare against current state
-	// to distinguish "keep current" vs "change to false"
-	currentDraft := release.GetDraft()
-	currentPrerelease := release.GetPrerelease()
+	truncated := false
+	if releaseRequest.Body != nil {
+		body, wasTruncated, err := guardBodySize(*releaseRequest.Body, MaxReleaseBodyLength, config.OnOversize)
+		if err != nil {
+			return nil, err
+		}
 
-	// Only set if value changed from current state
-	if config.Draft != currentDraft {
-		releaseRequest.Draft = &config.Draft
+		releaseRequest.Body = &body
+		truncated = wasTruncated
 	}
-	if config.Prerelease != currentPrerelease {
-		releaseRequest.Prerelease = &config.Prerelease
+
+	// Draft and prerelease are only changed when configured
+	if config.Draft != nil && *config.Draft != release.GetDraft() {
+		releaseRequest.Draft = config.Draft
+	}
+	if config.Prerelease != nil && *config.Prerelease != release.GetPrerelease() {
+		releaseRequest.Prerelease = config.Prerelease
 	}
 
 	//
@@ -262,26 +438,131 @@ func (c *UpdateRelease) Execute(ctx core.ExecutionContext) error {
 	//
 	updatedRelease, _, err := client.Repositories.EditRelease(
 		context.Background(),
-		appMetadata.Owner,
+		owner,
 		config.Repository,
 		release.GetID(),
 		releaseRequest,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to update release: %w", err)
+		return nil, fmt.Errorf("failed to update release: %w", err)
+	}
+
+	if len(config.Assets) == 0 {
+		return &ReleaseOutput{RepositoryRelease: updatedRelease, Truncated: truncated}, nil
+	}
+
+	changes, err := syncReleaseAssets(client, owner, config.Repository, updatedRelease, config.Assets)
+	if err != nil {
+		return nil, err
 	}
 
 	//
-	// Emit output with updated release data
+	// The release is read again, so its assets include the uploaded ones.
 	//
-	return ctx.ExecutionState.Emit(
-		core.DefaultOutputChannel.Name,
-		"github.release",
-		[]any{updatedRelease},
-	)
+	updatedRelease, _, err = client.Repositories.GetRelease(context.Background(), owner, config.Repository, updatedRelease.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release: %w", wrapGitHubError(err))
+	}
+
+	return &ReleaseOutput{RepositoryRelease: updatedRelease, Truncated: truncated, AssetChanges: changes}, nil
+}
+
+func (c *UpdateRelease) findRelease(client *github.Client, installationID, owner string, config UpdateReleaseConfiguration) (*github.RepositoryRelease, error) {
+	if config.ReleaseStrategy != ReleaseStrategyID {
+		return fetchReleaseByStrategy(client, installationID, owner, config.Repository, config.ReleaseStrategy, config.TagName)
+	}
+
+	releaseID, err := strconv.ParseInt(config.ReleaseID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("release ID is not a number: %v", err)
+	}
+
+	release, response, err := client.Repositories.GetRelease(context.Background(), owner, config.Repository, releaseID)
+	if isNotFound(response) {
+		return nil, fmt.Errorf("%w: release %d does not exist in %s", ErrNotFound, releaseID, config.Repository)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %d: %w", releaseID, wrapGitHubError(err))
+	}
+
+	return release, nil
+}
+
+/*
+ * GitHub rejects uploads of assets whose name is already used in the release,
+ * so existing assets with the same name are deleted and uploaded again.
+ */
+func syncReleaseAssets(client *github.Client, owner, repository string, release *github.RepositoryRelease, sources []ReleaseAssetSource) ([]ReleaseAssetChange, error) {
+	existing := map[string]int64{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		assets, response, err := client.Repositories.ListReleaseAssets(context.Background(), owner, repository, release.GetID(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list release assets: %w", wrapGitHubError(err))
+		}
+
+		for _, asset := range assets {
+			existing[strings.ToLower(asset.GetName())] = asset.GetID()
+		}
+
+		if response.NextPage == 0 {
+			break
+		}
+
+		opts.Page = response.NextPage
+	}
+
+	changes := []ReleaseAssetChange{}
+	for _, source := range sources {
+		name := strings.TrimSpace(source.Name)
+		action := ReleaseAssetCreated
+		if id, ok := existing[strings.ToLower(name)]; ok {
+			_, err := client.Repositories.DeleteReleaseAsset(context.Background(), owner, repository, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete release asset %s: %w", name, wrapGitHubError(err))
+			}
+
+			action = ReleaseAssetReplaced
+		}
+
+		asset, err := uploadReleaseAsset(client, owner, repository, release.GetID(), name, source)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, ReleaseAssetChange{Name: asset.GetName(), ID: asset.GetID(), Action: action, Size: asset.GetSize()})
+	}
+
+	return changes, nil
+}
+
+/*
+ * go-github only uploads assets from files,
+ * so the upload request is built from the content directly.
+ */
+func uploadReleaseAsset(client *github.Client, owner, repository string, releaseID int64, name string, source ReleaseAssetSource) (*github.ReleaseAsset, error) {
+	contentType := source.ContentType
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", owner, repository, releaseID, url.QueryEscape(name))
+	request, err := client.NewUploadRequest(path, strings.NewReader(source.Content), int64(len(source.Content)), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build upload of release asset %s: %w", name, err)
+	}
+
+	asset := &github.ReleaseAsset{}
+	_, err = client.Do(context.Background(), request, asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload release asset %s: %w", name, wrapGitHubError(err))
+	}
+
+	return asset, nil
 }
 
-func (c *UpdateRelease) generateReleaseNotes(ctx core.ExecutionContext, client *github.Client, owner, repo, tagName string) (string, error) {
+func (c *UpdateRelease) generateReleaseNotes(client *github.Client, owner, repo, tagName string) (string, error) {
 	opts := &github.GenerateNotesOptions{
 		TagName: tagName,
 	}
@@ -1,8 +1,12 @@
 package github
 
 import (
+	"io"
+	"net/http"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
 	contexts "github.com/superplanehq/superplane/test/support/contexts"
@@ -38,6 +42,32 @@ func Test__UpdateRelease__Setup(t *testing.T) {
 		require.ErrorContains(t, err, "repository world is not accessible to app installation")
 	})
 
+	t.Run("release ID is not a number -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "releaseStrategy": "id", "releaseId": "abc"},
+		})
+
+		require.ErrorContains(t, err, "release ID is not a number")
+	})
+
+	t.Run("asset configured twice -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"repository": "hello",
+				"assets": []any{
+					map[string]any{"name": "checksums.txt", "content": "a"},
+					map[string]any{"name": "CHECKSUMS.txt", "content": "b"},
+				},
+			},
+		})
+
+		require.ErrorContains(t, err, "asset CHECKSUMS.txt is configured more than once")
+	})
+
 	t.Run("metadata is set successfully", func(t *testing.T) {
 		integrationCtx := &contexts.IntegrationContext{
 			Metadata: Metadata{
@@ -55,3 +85,77 @@ func Test__UpdateRelease__Setup(t *testing.T) {
 		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
 	})
 }
+
+func Test__UpdateRelease__UpdateRelease(t *testing.T) {
+	component := UpdateRelease{}
+
+	t.Run("release by ID -> only configured fields are sent", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusOK, `{"id":42,"tag_name":"v1.0.0","draft":true,"prerelease":false}`), nil
+		}}
+
+		draft := false
+		config := UpdateReleaseConfiguration{Repository: "hello", ReleaseStrategy: ReleaseStrategyID, ReleaseID: "42", NewTagName: "v1.0.1", Draft: &draft}
+		output, err := component.updateRelease(github.NewClient(&http.Client{Transport: transport}), "123", "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, "/repos/testhq/hello/releases/42", transport.requests[0].URL.Path)
+		assert.Equal(t, http.MethodPatch, transport.requests[1].Method)
+		body, _ := io.ReadAll(transport.requests[1].Body)
+		assert.JSONEq(t, `{"tag_name":"v1.0.1","draft":false}`, string(body))
+		assert.Equal(t, int64(42), output.GetID())
+		assert.Nil(t, output.AssetChanges)
+	})
+
+	t.Run("release ID does not exist -> not found", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			return mockResponse(http.StatusNotFound, `{"message":"Not Found"}`), nil
+		}}
+
+		config := UpdateReleaseConfiguration{Repository: "hello", ReleaseStrategy: ReleaseStrategyID, ReleaseID: "42"}
+		_, err := component.updateRelease(github.NewClient(&http.Client{Transport: transport}), "123", "testhq", config)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("assets -> existing asset is replaced and new asset is created", func(t *testing.T) {
+		transport := &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case request.Method == http.MethodGet && request.URL.Path == "/repos/testhq/hello/releases/42/assets":
+				return mockResponse(http.StatusOK, `[{"id":7,"name":"checksums.txt"}]`), nil
+			case request.Method == http.MethodDelete:
+				return mockResponse(http.StatusNoContent, ``), nil
+			case request.Method == http.MethodPost:
+				name := request.URL.Query().Get("name")
+				return mockResponse(http.StatusCreated, `{"id":9,"name":"`+name+`","size":5}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"id":42,"tag_name":"v1.0.0"}`), nil
+		}}
+
+		config := UpdateReleaseConfiguration{
+			Repository:      "hello",
+			ReleaseStrategy: ReleaseStrategyID,
+			ReleaseID:       "42",
+			Assets: []ReleaseAssetSource{
+				{Name: "checksums.txt", Content: "abcde"},
+				{Name: "sbom.json", Content: "{}", ContentType: "application/json"},
+			},
+		}
+
+		output, err := component.updateRelease(github.NewClient(&http.Client{Transport: transport}), "123", "testhq", config)
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 7)
+
+		assert.Equal(t, "/repos/testhq/hello/releases/assets/7", transport.requests[3].URL.Path)
+		assert.Equal(t, "/repos/testhq/hello/releases/42/assets", transport.requests[4].URL.Path)
+		assert.Equal(t, "text/plain", transport.requests[4].Header.Get("Content-Type"))
+		assert.Equal(t, "application/json", transport.requests[5].Header.Get("Content-Type"))
+		body, _ := io.ReadAll(transport.requests[5].Body)
+		assert.Equal(t, "{}", string(body))
+		assert.Equal(t, []ReleaseAssetChange{
+			{Name: "checksums.txt", ID: 9, Action: ReleaseAssetReplaced, Size: 5},
+			{Name: "sbom.json", ID: 9, Action: ReleaseAssetCreated, Size: 5},
+		}, output.AssetChanges)
+	})
+}
@@ -0,0 +1,253 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+var secretScanningResolutions = []string{"false_positive", "wont_fix", "revoked", "used_in_tests"}
+
+type UpdateSecretScanningAlert struct{}
+
+type UpdateSecretScanningAlertConfiguration struct {
+	Repository        string `json:"repository" mapstructure:"repository"`
+	AlertNumber       string `json:"alertNumber" mapstructure:"alertNumber"`
+	State             string `json:"state" mapstructure:"state"`
+	Resolution        string `json:"resolution" mapstructure:"resolution"`
+	ResolutionComment string `json:"resolutionComment" mapstructure:"resolutionComment"`
+}
+
+func (c *UpdateSecretScanningAlert) Name() string {
+	return "github.updateSecretScanningAlert"
+}
+
+func (c *UpdateSecretScanningAlert) Label() string {
+	return "Update Secret Scanning Alert"
+}
+
+func (c *UpdateSecretScanningAlert) Description() string {
+	return "Resolve or reopen a GitHub secret scanning alert"
+}
+
+func (c *UpdateSecretScanningAlert) Documentation() string {
+	return `The Update Secret Scanning Alert component resolves or reopens a secret scanning alert in a GitHub repository.
+
+## Use Cases
+
+- **Remediation**: Resolve an alert as revoked after rotating the leaked secret
+- **Triage**: Dismiss alerts for test credentials as used in tests
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Alert Number**: The number of the alert (supports expressions)
+- **State**: Resolve or reopen the alert
+- **Resolution**: Why the alert is resolved: false positive, won't fix, revoked, or used in tests. Required when resolving
+- **Resolution Comment**: Optional comment explaining the resolution
+
+## Output
+
+Returns the updated alert. The secret value is never included.`
+}
+
+func (c *UpdateSecretScanningAlert) Icon() string {
+	return "github"
+}
+
+func (c *UpdateSecretScanningAlert) Color() string {
+	return "gray"
+}
+
+func (c *UpdateSecretScanningAlert) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *UpdateSecretScanningAlert) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "alertNumber",
+			Label:       "Alert Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.number}}",
+		},
+		{
+			Name:     "state",
+			Label:    "State",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "resolved",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Resolved", Value: "resolved"},
+						{Label: "Open", Value: "open"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "resolution",
+			Label: "Resolution",
+			Type:  configuration.FieldTypeSelect,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "False positive", Value: "false_positive"},
+						{Label: "Won't fix", Value: "wont_fix"},
+						{Label: "Revoked", Value: "revoked"},
+						{Label: "Used in tests", Value: "used_in_tests"},
+					},
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "state", Values: []string{"resolved"}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "state", Values: []string{"resolved"}},
+			},
+		},
+		{
+			Name:  "resolutionComment",
+			Label: "Resolution Comment",
+			Type:  configuration.FieldTypeText,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "state", Values: []string{"resolved"}},
+			},
+		},
+		ConcurrencyKeyField,
+	}
+}
+
+func (c *UpdateSecretScanningAlert) Setup(ctx core.SetupContext) error {
+	var config UpdateSecretScanningAlertConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.AlertNumber == "" {
+		return errors.New("alert number is required")
+	}
+
+	if config.State != "open" && config.State != "resolved" {
+		return fmt.Errorf("invalid state: %s", config.State)
+	}
+
+	if config.State == "resolved" && !slices.Contains(secretScanningResolutions, config.Resolution) {
+		return fmt.Errorf("a resolution is required to resolve the alert: must be one of %v", secretScanningResolutions)
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *UpdateSecretScanningAlert) Execute(ctx core.ExecutionContext) error {
+	var config UpdateSecretScanningAlertConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	alertNumber, err := strconv.ParseInt(config.AlertNumber, 10, 64)
+	if err != nil {
+		return fmt.Errorf("alert number is not a number: %v", err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewExecutionClient(ctx, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	unlock, acquired, err := acquireConcurrencyLock(ctx, appMetadata.Owner)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer unlock()
+
+	return withIdempotency(ctx, "github.secretScanningAlert", func() (any, error) {
+		alert, _, err := client.SecretScanning.UpdateAlert(
+			context.Background(),
+			appMetadata.Owner,
+			config.Repository,
+			alertNumber,
+			buildSecretScanningAlertUpdate(config),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update secret scanning alert: %w", wrapFeatureError(err, "secret scanning"))
+		}
+
+		return buildSecretScanningAlertOutput(alert), nil
+	})
+}
+
+func buildSecretScanningAlertUpdate(config UpdateSecretScanningAlertConfiguration) *github.SecretScanningAlertUpdateOptions {
+	opts := &github.SecretScanningAlertUpdateOptions{State: config.State}
+	if config.State != "resolved" {
+		return opts
+	}
+
+	opts.Resolution = &config.Resolution
+	if config.ResolutionComment != "" {
+		opts.ResolutionComment = &config.ResolutionComment
+	}
+
+	return opts
+}
+
+func (c *UpdateSecretScanningAlert) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *UpdateSecretScanningAlert) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *UpdateSecretScanningAlert) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *UpdateSecretScanningAlert) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *UpdateSecretScanningAlert) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *UpdateSecretScanningAlert) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
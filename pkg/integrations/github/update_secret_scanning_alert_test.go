@@ -0,0 +1,71 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__UpdateSecretScanningAlert__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := UpdateSecretScanningAlert{}
+
+	t.Run("alert number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "state": "open"},
+		})
+
+		require.ErrorContains(t, err, "alert number is required")
+	})
+
+	t.Run("resolving without resolution -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "alertNumber": "2", "state": "resolved"},
+		})
+
+		require.ErrorContains(t, err, "a resolution is required")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:    &nodeMetadataCtx,
+			Configuration: map[string]any{
+				"repository":  "hello",
+				"alertNumber": "2",
+				"state":       "resolved",
+				"resolution":  "revoked",
+			},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__UpdateSecretScanningAlert__BuildUpdate(t *testing.T) {
+	t.Run("reopening does not send a resolution", func(t *testing.T) {
+		opts := buildSecretScanningAlertUpdate(UpdateSecretScanningAlertConfiguration{State: "open", Resolution: "revoked"})
+		assert.Equal(t, "open", opts.State)
+		assert.Nil(t, opts.Resolution)
+		assert.Nil(t, opts.ResolutionComment)
+	})
+
+	t.Run("resolving sends resolution and comment", func(t *testing.T) {
+		opts := buildSecretScanningAlertUpdate(UpdateSecretScanningAlertConfiguration{
+			State:             "resolved",
+			Resolution:        "used_in_tests",
+			ResolutionComment: "Fixture credentials",
+		})
+
+		assert.Equal(t, "used_in_tests", opts.GetResolution())
+		assert.Equal(t, "Fixture credentials", opts.GetResolutionComment())
+	})
+}
@@ -0,0 +1,411 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	CheckRunPayloadType            = "github.checkRun.finished"
+	CheckRunTimeoutPayloadType     = "github.checkRun.timeout"
+	CheckRunCancelledPayloadType   = "github.checkRun.cancelled"
+	CheckRunSuccessOutputChannel   = "success"
+	CheckRunFailureOutputChannel   = "failure"
+	CheckRunTimeoutOutputChannel   = "timeout"
+	CheckRunCancelledOutputChannel = "cancelled"
+	CheckRunPollAction             = "poll"
+	DefaultCheckRunTimeoutMinutes  = 60
+)
+
+var CheckRunPollBackoff = core.Backoff{
+	Initial: 10 * time.Second,
+	Max:     2 * time.Minute,
+}
+
+type WaitForCheckRun struct{}
+
+type WaitForCheckRunConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	Ref        string `json:"ref" mapstructure:"ref"`
+	CheckName  string `json:"checkName" mapstructure:"checkName"`
+	Timeout    *int   `json:"timeout" mapstructure:"timeout"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so Execute records the resolved values it needs here.
+ */
+type WaitForCheckRunMetadata struct {
+	Repository   string           `json:"repository" mapstructure:"repository"`
+	Ref          string           `json:"ref" mapstructure:"ref"`
+	CheckName    string           `json:"checkName" mapstructure:"checkName"`
+	StartedAt    string           `json:"startedAt" mapstructure:"startedAt"`
+	Deadline     string           `json:"deadline" mapstructure:"deadline"`
+	PollAttempts int              `json:"pollAttempts" mapstructure:"pollAttempts"`
+	CheckRun     *CheckRunSummary `json:"checkRun,omitempty" mapstructure:"checkRun"`
+	Cancelled    bool             `json:"cancelled,omitempty" mapstructure:"cancelled"`
+}
+
+/*
+ * The emitted check run, with the number of polls it took.
+ */
+type CheckRunWaitResult struct {
+	CheckRunSummary
+	PollAttempts int `json:"poll_attempts"`
+}
+
+func (c *WaitForCheckRun) Name() string {
+	return "github.waitForCheckRun"
+}
+
+func (c *WaitForCheckRun) Label() string {
+	return "Wait for Check Run"
+}
+
+func (c *WaitForCheckRun) Description() string {
+	return "Wait for a GitHub check run to complete"
+}
+
+func (c *WaitForCheckRun) Documentation() string {
+	return `The Wait for Check Run component waits for a named check run on a commit, branch, or tag to complete.
+
+## Use Cases
+
+- **Deploy gates**: Only deploy a commit once its build check passes
+- **Release flows**: Wait for an external check, like a security scan, before publishing
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Ref**: Commit SHA, branch, or tag (supports expressions)
+- **Check Name**: The name of the check run to wait for
+- **Timeout**: Minutes to wait for the check run to complete. Defaults to 60
+
+## Output Channels
+
+- **Success**: The check run completed with a success, neutral, or skipped conclusion
+- **Failure**: The check run completed with any other conclusion
+- **Timeout**: The check run did not complete before the timeout
+- **Cancelled**: The execution was cancelled while waiting
+
+## Notes
+
+- The check run is polled, starting every 10 seconds, and slowing down up to every 2 minutes.
+  The number of polls is emitted in ` + "`poll_attempts`" + `
+- Re-running a check creates a new check run, so the latest check run with the given name is used on every poll
+- If the check run does not exist yet, the component keeps waiting for it until the timeout
+- Cancelling the execution stops the polling. The check run itself is not cancelled`
+}
+
+func (c *WaitForCheckRun) Icon() string {
+	return "github"
+}
+
+func (c *WaitForCheckRun) Color() string {
+	return "gray"
+}
+
+func (c *WaitForCheckRun) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: CheckRunSuccessOutputChannel, Label: "Success"},
+		{Name: CheckRunFailureOutputChannel, Label: "Failure"},
+		{Name: CheckRunTimeoutOutputChannel, Label: "Timeout"},
+		{Name: CheckRunCancelledOutputChannel, Label: "Cancelled"},
+	}
+}
+
+func (c *WaitForCheckRun) EventTypes() []string {
+	return []string{CheckRunPayloadType, CheckRunTimeoutPayloadType, CheckRunCancelledPayloadType}
+}
+
+func (c *WaitForCheckRun) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "ref",
+			Label:       "Ref",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.head_sha}}",
+		},
+		{
+			Name:        "checkName",
+			Label:       "Check Name",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., build",
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultCheckRunTimeoutMinutes,
+			Description: "Minutes to wait for the check run to complete",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *WaitForCheckRun) Setup(ctx core.SetupContext) error {
+	var config WaitForCheckRunConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Ref == "" {
+		return errors.New("ref is required")
+	}
+
+	if config.CheckName == "" {
+		return errors.New("check name is required")
+	}
+
+	if config.Timeout != nil && *config.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *WaitForCheckRun) Execute(ctx core.ExecutionContext) error {
+	var config WaitForCheckRunConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	startedAt := time.Now()
+	err := ctx.Metadata.Set(WaitForCheckRunMetadata{
+		Repository: config.Repository,
+		Ref:        config.Ref,
+		CheckName:  config.CheckName,
+		StartedAt:  startedAt.Format(time.RFC3339),
+		Deadline:   startedAt.Add(checkRunTimeout(config)).Format(time.RFC3339),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Waiting for check run %s on %s", config.CheckName, config.Ref)
+	return ctx.Requests.ScheduleActionCall(CheckRunPollAction, map[string]any{}, CheckRunPollBackoff.Interval(1))
+}
+
+func (c *WaitForCheckRun) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           CheckRunPollAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *WaitForCheckRun) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case CheckRunPollAction:
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *WaitForCheckRun) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForCheckRunMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	//
+	// A poll scheduled before the execution was cancelled
+	// must not schedule the next one.
+	//
+	if metadata.Cancelled {
+		return nil
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	if err != nil {
+		return fmt.Errorf("invalid start time %q: %w", metadata.StartedAt, err)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	result, _, err := client.Checks.ListCheckRunsForRef(
+		context.Background(),
+		appMetadata.Owner,
+		metadata.Repository,
+		metadata.Ref,
+		&github.ListCheckRunsOptions{
+			CheckName:   github.Ptr(metadata.CheckName),
+			Filter:      github.Ptr("latest"),
+			ListOptions: github.ListOptions{PerPage: 100},
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to list check runs for %s: %w", metadata.Ref, wrapGitHubError(err))
+	}
+
+	metadata.PollAttempts++
+	metadata.CheckRun = latestCheckRun(result.CheckRuns)
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	channel := checkRunOutputChannel(metadata.CheckRun, deadline, time.Now())
+	switch channel {
+	case "":
+		return ctx.Requests.ScheduleActionCall(CheckRunPollAction, map[string]any{}, CheckRunPollBackoff.Interval(metadata.PollAttempts+1))
+
+	case CheckRunTimeoutOutputChannel:
+		return ctx.ExecutionState.Emit(channel, CheckRunTimeoutPayloadType, []any{map[string]any{
+			"ref":           metadata.Ref,
+			"check_name":    metadata.CheckName,
+			"check_run":     metadata.CheckRun,
+			"timeout":       deadline.Sub(startedAt).String(),
+			"poll_attempts": metadata.PollAttempts,
+		}})
+	}
+
+	return ctx.ExecutionState.Emit(channel, CheckRunPayloadType, []any{CheckRunWaitResult{
+		CheckRunSummary: *metadata.CheckRun,
+		PollAttempts:    metadata.PollAttempts,
+	}})
+}
+
+func checkRunTimeout(config WaitForCheckRunConfiguration) time.Duration {
+	if config.Timeout == nil || *config.Timeout < 1 {
+		return DefaultCheckRunTimeoutMinutes * time.Minute
+	}
+
+	return time.Duration(*config.Timeout) * time.Minute
+}
+
+/*
+ * A rerun creates a new check run with the same name,
+ * and the newest one has the highest ID.
+ */
+func latestCheckRun(checkRuns []*github.CheckRun) *CheckRunSummary {
+	var latest *github.CheckRun
+	for _, checkRun := range checkRuns {
+		if latest == nil || checkRun.GetID() > latest.GetID() {
+			latest = checkRun
+		}
+	}
+
+	if latest == nil {
+		return nil
+	}
+
+	return &CheckRunSummary{
+		ID:         latest.GetID(),
+		Name:       latest.GetName(),
+		Status:     latest.GetStatus(),
+		Conclusion: latest.GetConclusion(),
+		DetailsURL: latest.GetDetailsURL(),
+	}
+}
+
+/*
+ * Returns the output channel to emit on,
+ * or an empty string if the check run should be polled again.
+ */
+func checkRunOutputChannel(checkRun *CheckRunSummary, deadline time.Time, now time.Time) string {
+	if checkRun != nil && checkRun.Status == "completed" {
+		if slices.Contains(successfulCheckConclusions, checkRun.Conclusion) {
+			return CheckRunSuccessOutputChannel
+		}
+
+		return CheckRunFailureOutputChannel
+	}
+
+	if !now.Before(deadline) {
+		return CheckRunTimeoutOutputChannel
+	}
+
+	return ""
+}
+
+func (c *WaitForCheckRun) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *WaitForCheckRun) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+/*
+ * Marks the wait as cancelled, so pending polls stop rescheduling,
+ * and emits the last known state of the check run.
+ */
+func (c *WaitForCheckRun) Cancel(ctx core.ExecutionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForCheckRunMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	metadata.Cancelled = true
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Stopped waiting for check run %s on %s", metadata.CheckName, metadata.Ref)
+	return ctx.ExecutionState.Emit(CheckRunCancelledOutputChannel, CheckRunCancelledPayloadType, []any{map[string]any{
+		"ref":           metadata.Ref,
+		"check_name":    metadata.CheckName,
+		"check_run":     metadata.CheckRun,
+		"poll_attempts": metadata.PollAttempts,
+	}})
+}
+
+func (c *WaitForCheckRun) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
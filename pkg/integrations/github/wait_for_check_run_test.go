@@ -0,0 +1,180 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__WaitForCheckRun__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := WaitForCheckRun{}
+
+	t.Run("check name is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "ref": "main"},
+		})
+
+		require.ErrorContains(t, err, "check name is required")
+	})
+
+	t.Run("invalid timeout -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "checkName": "build", "timeout": 0},
+		})
+
+		require.ErrorContains(t, err, "timeout must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "main", "checkName": "build", "timeout": 30},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__WaitForCheckRun__Execute(t *testing.T) {
+	component := WaitForCheckRun{}
+	metadataCtx := &contexts.MetadataContext{}
+	requestCtx := &contexts.RequestContext{}
+
+	require.NoError(t, component.Execute(core.ExecutionContext{
+		Configuration: map[string]any{"repository": "hello", "ref": "abc123", "checkName": "build", "timeout": 30},
+		Metadata:      metadataCtx,
+		Requests:      requestCtx,
+		Logger:        log.NewEntry(log.StandardLogger()),
+	}))
+
+	assert.Equal(t, CheckRunPollAction, requestCtx.Action)
+	assert.Equal(t, CheckRunPollBackoff.Initial, requestCtx.Duration)
+
+	metadata := metadataCtx.Get().(WaitForCheckRunMetadata)
+	assert.Equal(t, "hello", metadata.Repository)
+	assert.Equal(t, "abc123", metadata.Ref)
+	assert.Equal(t, "build", metadata.CheckName)
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	require.NoError(t, err)
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, deadline.Sub(startedAt))
+}
+
+func Test__WaitForCheckRun__Cancel(t *testing.T) {
+	component := WaitForCheckRun{}
+	metadataCtx := &contexts.MetadataContext{}
+	requestCtx := &contexts.RequestContext{}
+	stateCtx := &contexts.ExecutionStateContext{}
+
+	require.NoError(t, component.Execute(core.ExecutionContext{
+		Configuration: map[string]any{"repository": "hello", "ref": "abc123", "checkName": "build"},
+		Metadata:      metadataCtx,
+		Requests:      requestCtx,
+		Logger:        log.NewEntry(log.StandardLogger()),
+	}))
+
+	require.Equal(t, CheckRunPollAction, requestCtx.Action)
+
+	t.Run("cancelling a waiting execution emits on the cancelled channel", func(t *testing.T) {
+		require.NoError(t, component.Cancel(core.ExecutionContext{
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Logger:         log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, CheckRunCancelledOutputChannel, stateCtx.Channel)
+		assert.Equal(t, CheckRunCancelledPayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+		data := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "abc123", data["ref"])
+		assert.Equal(t, "build", data["check_name"])
+		assert.True(t, metadataCtx.Get().(WaitForCheckRunMetadata).Cancelled)
+	})
+
+	t.Run("pending poll stops without rescheduling", func(t *testing.T) {
+		*requestCtx = contexts.RequestContext{}
+		require.NoError(t, component.HandleAction(core.ActionContext{
+			Name:           CheckRunPollAction,
+			Metadata:       metadataCtx,
+			Requests:       requestCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Logger:         log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.Empty(t, requestCtx.Action)
+	})
+
+	t.Run("finished execution is not cancelled again", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{Finished: true}
+		require.NoError(t, component.Cancel(core.ExecutionContext{
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+			Logger:         log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.Empty(t, stateCtx.Channel)
+	})
+}
+
+func Test__WaitForCheckRun__LatestCheckRun(t *testing.T) {
+	assert.Nil(t, latestCheckRun([]*github.CheckRun{}))
+
+	latest := latestCheckRun([]*github.CheckRun{
+		{ID: github.Ptr(int64(1)), Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+		{ID: github.Ptr(int64(3)), Name: github.Ptr("build"), Status: github.Ptr("in_progress")},
+		{ID: github.Ptr(int64(2)), Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+	})
+
+	require.NotNil(t, latest)
+	assert.Equal(t, int64(3), latest.ID)
+	assert.Equal(t, "in_progress", latest.Status)
+}
+
+func Test__WaitForCheckRun__OutputChannel(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(50 * time.Minute)
+
+	t.Run("no check run yet -> poll again", func(t *testing.T) {
+		assert.Equal(t, "", checkRunOutputChannel(nil, deadline, now))
+	})
+
+	t.Run("check run in progress -> poll again", func(t *testing.T) {
+		assert.Equal(t, "", checkRunOutputChannel(&CheckRunSummary{Status: "in_progress"}, deadline, now))
+	})
+
+	t.Run("successful check run -> success", func(t *testing.T) {
+		checkRun := &CheckRunSummary{Status: "completed", Conclusion: "success"}
+		assert.Equal(t, CheckRunSuccessOutputChannel, checkRunOutputChannel(checkRun, deadline, now))
+	})
+
+	t.Run("failed check run -> failure", func(t *testing.T) {
+		checkRun := &CheckRunSummary{Status: "completed", Conclusion: "timed_out"}
+		assert.Equal(t, CheckRunFailureOutputChannel, checkRunOutputChannel(checkRun, deadline, now))
+	})
+
+	t.Run("timeout reached -> timeout", func(t *testing.T) {
+		checkRun := &CheckRunSummary{Status: "queued"}
+		assert.Equal(t, CheckRunTimeoutOutputChannel, checkRunOutputChannel(checkRun, now.Add(-time.Hour), now))
+	})
+
+	t.Run("completed on the last poll after the timeout -> conclusion wins", func(t *testing.T) {
+		checkRun := &CheckRunSummary{Status: "completed", Conclusion: "success"}
+		assert.Equal(t, CheckRunSuccessOutputChannel, checkRunOutputChannel(checkRun, now.Add(-time.Hour), now))
+	})
+}
@@ -0,0 +1,365 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MergeabilityPayloadType          = "github.pullRequest.mergeability"
+	MergeabilityTimeoutPayloadType   = "github.pullRequest.mergeability.timeout"
+	MergeabilityCancelledPayloadType = "github.pullRequest.mergeability.cancelled"
+	MergeableOutputChannel           = "mergeable"
+	ConflictingOutputChannel         = "conflicting"
+	MergeableTimeoutOutputChannel    = "timeout"
+	MergeableCancelledOutputChannel  = "cancelled"
+	MergeablePollAction              = "poll"
+	DefaultMergeableTimeoutMinutes   = 10
+)
+
+var MergeablePollBackoff = core.Backoff{
+	Initial: 5 * time.Second,
+	Max:     time.Minute,
+}
+
+type WaitForMergeable struct{}
+
+type WaitForMergeableConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	Timeout    *int   `json:"timeout" mapstructure:"timeout"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so Execute records the resolved values it needs here.
+ */
+type WaitForMergeableMetadata struct {
+	Repository   string `json:"repository" mapstructure:"repository"`
+	PullNumber   int    `json:"pullNumber" mapstructure:"pullNumber"`
+	StartedAt    string `json:"startedAt" mapstructure:"startedAt"`
+	Deadline     string `json:"deadline" mapstructure:"deadline"`
+	PollAttempts int    `json:"pollAttempts" mapstructure:"pollAttempts"`
+	Cancelled    bool   `json:"cancelled,omitempty" mapstructure:"cancelled"`
+}
+
+type MergeabilityOutput struct {
+	Number         int    `json:"number"`
+	Mergeable      *bool  `json:"mergeable"`
+	MergeableState string `json:"mergeable_state"`
+	HeadSHA        string `json:"head_sha"`
+	URL            string `json:"html_url"`
+	PollAttempts   int    `json:"poll_attempts"`
+}
+
+func (c *WaitForMergeable) Name() string {
+	return "github.waitForMergeable"
+}
+
+func (c *WaitForMergeable) Label() string {
+	return "Wait for Mergeable"
+}
+
+func (c *WaitForMergeable) Description() string {
+	return "Wait for GitHub to compute whether a pull request can be merged"
+}
+
+func (c *WaitForMergeable) Documentation() string {
+	return `The Wait for Mergeable component waits for GitHub to finish computing the mergeability of a pull request, and routes it by the result.
+
+## Use Cases
+
+- **Auto-merge flows**: Only try to merge once GitHub knows the pull request has no conflicts
+- **Conflict handling**: Notify the author, or rebase, when a pull request conflicts with its base branch
+
+## Configuration
+
+- **Repository**: Select the GitHub repository containing the pull request
+- **Pull Request Number**: The pull request number (supports expressions)
+- **Timeout**: Minutes to wait for the mergeability to be computed. Defaults to 10
+
+## Output Channels
+
+- **Mergeable**: The pull request has no conflicts with its base branch
+- **Conflicting**: The pull request has conflicts with its base branch
+- **Timeout**: GitHub did not compute the mergeability before the timeout
+- **Cancelled**: The execution was cancelled while waiting
+
+## Output
+
+Emits the pull request ` + "`number`" + `, ` + "`mergeable`" + `, ` + "`mergeable_state`" + `, ` + "`head_sha`" + ` and ` + "`html_url`" + `, with the number of ` + "`poll_attempts`" + `.
+
+## Notes
+
+- GitHub computes the mergeability in the background after every push to the pull request or its base branch, and reports it as ` + "`null`" + ` until it is known
+- A mergeable pull request can still be blocked by required reviews or checks. ` + "`mergeable_state`" + ` is ` + "`blocked`" + ` then
+- Polling starts every 5 seconds, and slows down up to once a minute`
+}
+
+func (c *WaitForMergeable) Icon() string {
+	return "github"
+}
+
+func (c *WaitForMergeable) Color() string {
+	return "gray"
+}
+
+func (c *WaitForMergeable) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: MergeableOutputChannel, Label: "Mergeable"},
+		{Name: ConflictingOutputChannel, Label: "Conflicting"},
+		{Name: MergeableTimeoutOutputChannel, Label: "Timeout"},
+		{Name: MergeableCancelledOutputChannel, Label: "Cancelled"},
+	}
+}
+
+func (c *WaitForMergeable) EventTypes() []string {
+	return []string{MergeabilityPayloadType, MergeabilityTimeoutPayloadType, MergeabilityCancelledPayloadType}
+}
+
+func (c *WaitForMergeable) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultMergeableTimeoutMinutes,
+			Description: "Minutes to wait for the mergeability to be computed",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *WaitForMergeable) Setup(ctx core.SetupContext) error {
+	var config WaitForMergeableConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" {
+		return errors.New("pull request number is required")
+	}
+
+	if config.Timeout != nil && *config.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *WaitForMergeable) Execute(ctx core.ExecutionContext) error {
+	var config WaitForMergeableConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	pullNumber, err := strconv.Atoi(config.PullNumber)
+	if err != nil {
+		return fmt.Errorf("pull request number is not a number: %v", err)
+	}
+
+	timeout := DefaultMergeableTimeoutMinutes * time.Minute
+	if config.Timeout != nil && *config.Timeout > 0 {
+		timeout = time.Duration(*config.Timeout) * time.Minute
+	}
+
+	startedAt := time.Now()
+	err = ctx.Metadata.Set(WaitForMergeableMetadata{
+		Repository: config.Repository,
+		PullNumber: pullNumber,
+		StartedAt:  startedAt.Format(time.RFC3339),
+		Deadline:   startedAt.Add(timeout).Format(time.RFC3339),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Waiting for mergeability of pull request %d", pullNumber)
+	return ctx.Requests.ScheduleActionCall(MergeablePollAction, map[string]any{}, MergeablePollBackoff.Interval(1))
+}
+
+func (c *WaitForMergeable) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           MergeablePollAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *WaitForMergeable) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case MergeablePollAction:
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *WaitForMergeable) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForMergeableMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	//
+	// A poll scheduled before the execution was cancelled
+	// must not schedule the next one.
+	//
+	if metadata.Cancelled {
+		return nil
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	if err != nil {
+		return fmt.Errorf("invalid start time %q: %w", metadata.StartedAt, err)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	pr, _, err := client.PullRequests.Get(context.Background(), appMetadata.Owner, metadata.Repository, metadata.PullNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request %d: %w", metadata.PullNumber, wrapGitHubError(err))
+	}
+
+	metadata.PollAttempts++
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	output := MergeabilityOutput{
+		Number:         pr.GetNumber(),
+		Mergeable:      pr.Mergeable,
+		MergeableState: pr.GetMergeableState(),
+		HeadSHA:        pr.GetHead().GetSHA(),
+		URL:            pr.GetHTMLURL(),
+		PollAttempts:   metadata.PollAttempts,
+	}
+
+	channel := mergeableOutputChannel(pr, deadline, time.Now())
+	switch channel {
+	case "":
+		return ctx.Requests.ScheduleActionCall(MergeablePollAction, map[string]any{}, MergeablePollBackoff.Interval(metadata.PollAttempts+1))
+
+	case MergeableTimeoutOutputChannel:
+		return ctx.ExecutionState.Emit(channel, MergeabilityTimeoutPayloadType, []any{map[string]any{
+			"number":        metadata.PullNumber,
+			"timeout":       deadline.Sub(startedAt).String(),
+			"poll_attempts": metadata.PollAttempts,
+		}})
+	}
+
+	return ctx.ExecutionState.Emit(channel, MergeabilityPayloadType, []any{output})
+}
+
+/*
+ * Returns the output channel to emit on,
+ * or an empty string if the pull request should be polled again.
+ * GitHub reports conflicts with mergeable false, and the dirty mergeable state.
+ */
+func mergeableOutputChannel(pr *github.PullRequest, deadline time.Time, now time.Time) string {
+	if pr.Mergeable != nil && pr.GetMergeableState() != "unknown" {
+		if !pr.GetMergeable() || pr.GetMergeableState() == "dirty" {
+			return ConflictingOutputChannel
+		}
+
+		return MergeableOutputChannel
+	}
+
+	if !now.Before(deadline) {
+		return MergeableTimeoutOutputChannel
+	}
+
+	return ""
+}
+
+func (c *WaitForMergeable) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *WaitForMergeable) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+/*
+ * Marks the wait as cancelled, so pending polls stop rescheduling.
+ */
+func (c *WaitForMergeable) Cancel(ctx core.ExecutionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForMergeableMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	metadata.Cancelled = true
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Stopped waiting for mergeability of pull request %d", metadata.PullNumber)
+	return ctx.ExecutionState.Emit(MergeableCancelledOutputChannel, MergeabilityCancelledPayloadType, []any{map[string]any{
+		"number":        metadata.PullNumber,
+		"poll_attempts": metadata.PollAttempts,
+	}})
+}
+
+func (c *WaitForMergeable) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
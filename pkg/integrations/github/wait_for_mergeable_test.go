@@ -0,0 +1,166 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__WaitForMergeable__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := WaitForMergeable{}
+
+	t.Run("pull request number is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number is required")
+	})
+
+	t.Run("invalid timeout -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "timeout": 0},
+		})
+
+		require.ErrorContains(t, err, "timeout must be greater than 0")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__WaitForMergeable__Execute(t *testing.T) {
+	component := WaitForMergeable{}
+
+	t.Run("invalid pull request number -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "abc"},
+			Metadata:      &contexts.MetadataContext{},
+			Requests:      &contexts.RequestContext{},
+			Logger:        log.NewEntry(log.StandardLogger()),
+		})
+
+		require.ErrorContains(t, err, "pull request number is not a number")
+	})
+
+	t.Run("poll is scheduled", func(t *testing.T) {
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+		require.NoError(t, component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "timeout": 5},
+			Metadata:      metadataCtx,
+			Requests:      requestCtx,
+			Logger:        log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.Equal(t, MergeablePollAction, requestCtx.Action)
+		assert.Equal(t, MergeablePollBackoff.Initial, requestCtx.Duration)
+
+		metadata := metadataCtx.Get().(WaitForMergeableMetadata)
+		assert.Equal(t, "hello", metadata.Repository)
+		assert.Equal(t, 42, metadata.PullNumber)
+
+		startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+		require.NoError(t, err)
+		deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, deadline.Sub(startedAt))
+	})
+}
+
+func Test__WaitForMergeable__Cancel(t *testing.T) {
+	component := WaitForMergeable{}
+	metadataCtx := &contexts.MetadataContext{}
+	requestCtx := &contexts.RequestContext{}
+	stateCtx := &contexts.ExecutionStateContext{}
+
+	require.NoError(t, component.Execute(core.ExecutionContext{
+		Configuration: map[string]any{"repository": "hello", "pullNumber": "42"},
+		Metadata:      metadataCtx,
+		Requests:      requestCtx,
+		Logger:        log.NewEntry(log.StandardLogger()),
+	}))
+
+	t.Run("cancelling a waiting execution emits on the cancelled channel", func(t *testing.T) {
+		require.NoError(t, component.Cancel(core.ExecutionContext{
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Logger:         log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, MergeableCancelledOutputChannel, stateCtx.Channel)
+		assert.Equal(t, MergeabilityCancelledPayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+		data := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 42, data["number"])
+		assert.True(t, metadataCtx.Get().(WaitForMergeableMetadata).Cancelled)
+	})
+
+	t.Run("pending poll stops without rescheduling", func(t *testing.T) {
+		*requestCtx = contexts.RequestContext{}
+		require.NoError(t, component.HandleAction(core.ActionContext{
+			Name:           MergeablePollAction,
+			Metadata:       metadataCtx,
+			Requests:       requestCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Logger:         log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.Empty(t, requestCtx.Action)
+	})
+}
+
+func Test__WaitForMergeable__OutputChannel(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(10 * time.Minute)
+
+	t.Run("mergeability not computed yet -> poll again", func(t *testing.T) {
+		pr := &github.PullRequest{MergeableState: github.Ptr("unknown")}
+		assert.Equal(t, "", mergeableOutputChannel(pr, deadline, now))
+	})
+
+	t.Run("no conflicts -> mergeable", func(t *testing.T) {
+		pr := &github.PullRequest{Mergeable: github.Ptr(true), MergeableState: github.Ptr("clean")}
+		assert.Equal(t, MergeableOutputChannel, mergeableOutputChannel(pr, deadline, now))
+	})
+
+	t.Run("blocked by required checks -> mergeable", func(t *testing.T) {
+		pr := &github.PullRequest{Mergeable: github.Ptr(true), MergeableState: github.Ptr("blocked")}
+		assert.Equal(t, MergeableOutputChannel, mergeableOutputChannel(pr, deadline, now))
+	})
+
+	t.Run("conflicts -> conflicting", func(t *testing.T) {
+		pr := &github.PullRequest{Mergeable: github.Ptr(false), MergeableState: github.Ptr("dirty")}
+		assert.Equal(t, ConflictingOutputChannel, mergeableOutputChannel(pr, deadline, now))
+	})
+
+	t.Run("timeout reached -> timeout", func(t *testing.T) {
+		pr := &github.PullRequest{}
+		assert.Equal(t, MergeableTimeoutOutputChannel, mergeableOutputChannel(pr, now.Add(-time.Minute), now))
+	})
+
+	t.Run("computed on the last poll after the timeout -> result wins", func(t *testing.T) {
+		pr := &github.PullRequest{Mergeable: github.Ptr(false), MergeableState: github.Ptr("dirty")}
+		assert.Equal(t, ConflictingOutputChannel, mergeableOutputChannel(pr, now.Add(-time.Minute), now))
+	})
+}
@@ -0,0 +1,444 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	RequiredChecksPayloadType            = "github.requiredChecks.finished"
+	RequiredChecksTimeoutPayloadType     = "github.requiredChecks.timeout"
+	RequiredChecksCancelledPayloadType   = "github.requiredChecks.cancelled"
+	RequiredChecksSuccessOutputChannel   = "success"
+	RequiredChecksFailureOutputChannel   = "failure"
+	RequiredChecksTimeoutOutputChannel   = "timeout"
+	RequiredChecksCancelledOutputChannel = "cancelled"
+	RequiredChecksPollAction             = "poll"
+	DefaultRequiredChecksTimeoutMinutes  = 60
+)
+
+var RequiredChecksPollBackoff = core.Backoff{
+	Initial: 10 * time.Second,
+	Max:     2 * time.Minute,
+}
+
+type WaitForRequiredChecks struct{}
+
+type WaitForRequiredChecksConfiguration struct {
+	Repository string `json:"repository" mapstructure:"repository"`
+	PullNumber string `json:"pullNumber" mapstructure:"pullNumber"`
+	Ref        string `json:"ref" mapstructure:"ref"`
+	Timeout    *int   `json:"timeout" mapstructure:"timeout"`
+}
+
+/*
+ * The poll action receives the node configuration with expressions not resolved,
+ * so Execute records the resolved values it needs here.
+ */
+type WaitForRequiredChecksMetadata struct {
+	Repository   string                `json:"repository" mapstructure:"repository"`
+	PullNumber   int                   `json:"pullNumber,omitempty" mapstructure:"pullNumber"`
+	Ref          string                `json:"ref,omitempty" mapstructure:"ref"`
+	StartedAt    string                `json:"startedAt" mapstructure:"startedAt"`
+	Deadline     string                `json:"deadline" mapstructure:"deadline"`
+	PollAttempts int                   `json:"pollAttempts" mapstructure:"pollAttempts"`
+	Checks       *RequiredChecksOutput `json:"checks,omitempty" mapstructure:"checks"`
+	Cancelled    bool                  `json:"cancelled,omitempty" mapstructure:"cancelled"`
+}
+
+type RequiredChecksOutput struct {
+	PullNumber   int                `json:"pull_number,omitempty" mapstructure:"pull_number"`
+	Branch       string             `json:"branch" mapstructure:"branch"`
+	HeadSHA      string             `json:"head_sha" mapstructure:"head_sha"`
+	State        string             `json:"state" mapstructure:"state"`
+	Required     []string           `json:"required" mapstructure:"required"`
+	Checks       []PullRequestCheck `json:"checks" mapstructure:"checks"`
+	Failing      []string           `json:"failing" mapstructure:"failing"`
+	Pending      []string           `json:"pending" mapstructure:"pending"`
+	PollAttempts int                `json:"poll_attempts" mapstructure:"poll_attempts"`
+}
+
+func (c *WaitForRequiredChecks) Name() string {
+	return "github.waitForRequiredChecks"
+}
+
+func (c *WaitForRequiredChecks) Label() string {
+	return "Wait for Required Checks"
+}
+
+func (c *WaitForRequiredChecks) Description() string {
+	return "Wait for the required checks of a GitHub pull request or branch to complete"
+}
+
+func (c *WaitForRequiredChecks) Documentation() string {
+	return `The Wait for Required Checks component waits for the status checks required by branch protection to complete, and routes by their result.
+
+## Use Cases
+
+- **Merge gates**: Merge a pull request once all the checks that protect its base branch pass
+- **Deploy gates**: Deploy a branch once its required checks pass, ignoring optional ones
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Pull Request Number**: The pull request to wait for (supports expressions). Its head commit is checked, against the required checks of its base branch
+- **Branch**: The branch to wait for, if no pull request is set. Its head commit is checked, against its own required checks
+- **Timeout**: Minutes to wait for the required checks to complete. Defaults to 60
+
+## Output Channels
+
+- **Success**: All required checks completed successfully
+- **Failure**: All required checks completed, and at least one of them failed
+- **Timeout**: Some required checks did not complete before the timeout
+- **Cancelled**: The execution was cancelled while waiting
+
+## Output
+
+Emits the ` + "`branch`" + `, the ` + "`head_sha`" + ` that was checked, the ` + "`required`" + ` check names, and the state of each of them in ` + "`checks`" + `,
+with the names of the ` + "`failing`" + ` and ` + "`pending`" + ` ones, and the number of ` + "`poll_attempts`" + `.
+
+## Notes
+
+- Both check runs and commit statuses count. Checks that are not required are ignored
+- The required checks, and the head commit, are read again on every poll, so changes to the branch protection or new pushes are taken into account
+- A required check that was not reported yet is pending
+- If the branch is not protected, or does not require any checks, the component emits on **Success** right away
+- Polling starts every 10 seconds, and slows down up to every 2 minutes`
+}
+
+func (c *WaitForRequiredChecks) Icon() string {
+	return "github"
+}
+
+func (c *WaitForRequiredChecks) Color() string {
+	return "gray"
+}
+
+func (c *WaitForRequiredChecks) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: RequiredChecksSuccessOutputChannel, Label: "Success"},
+		{Name: RequiredChecksFailureOutputChannel, Label: "Failure"},
+		{Name: RequiredChecksTimeoutOutputChannel, Label: "Timeout"},
+		{Name: RequiredChecksCancelledOutputChannel, Label: "Cancelled"},
+	}
+}
+
+func (c *WaitForRequiredChecks) EventTypes() []string {
+	return []string{RequiredChecksPayloadType, RequiredChecksTimeoutPayloadType, RequiredChecksCancelledPayloadType}
+}
+
+func (c *WaitForRequiredChecks) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "pullNumber",
+			Label:       "Pull Request Number",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., {{$.data.pull_request.number}}",
+			Description: "The pull request to wait for",
+		},
+		{
+			Name:        "ref",
+			Label:       "Branch",
+			Type:        configuration.FieldTypeString,
+			Placeholder: "e.g., main",
+			Description: "The branch to wait for, if no pull request is set",
+		},
+		{
+			Name:        "timeout",
+			Label:       "Timeout (minutes)",
+			Type:        configuration.FieldTypeNumber,
+			Default:     DefaultRequiredChecksTimeoutMinutes,
+			Description: "Minutes to wait for the required checks to complete",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+	}
+}
+
+func (c *WaitForRequiredChecks) Setup(ctx core.SetupContext) error {
+	var config WaitForRequiredChecksConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.PullNumber == "" && config.Ref == "" {
+		return errors.New("pull request number or branch is required")
+	}
+
+	if config.PullNumber != "" && config.Ref != "" {
+		return errors.New("only one of pull request number and branch can be set")
+	}
+
+	if config.Timeout != nil && *config.Timeout < 1 {
+		return errors.New("timeout must be greater than 0")
+	}
+
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *WaitForRequiredChecks) Execute(ctx core.ExecutionContext) error {
+	var config WaitForRequiredChecksConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	metadata := WaitForRequiredChecksMetadata{Repository: config.Repository, Ref: config.Ref}
+	target := fmt.Sprintf("branch %s", config.Ref)
+	if config.PullNumber != "" {
+		pullNumber, err := strconv.Atoi(config.PullNumber)
+		if err != nil {
+			return fmt.Errorf("pull request number is not a number: %v", err)
+		}
+
+		metadata.PullNumber = pullNumber
+		metadata.Ref = ""
+		target = fmt.Sprintf("pull request %d", pullNumber)
+	}
+
+	timeout := DefaultRequiredChecksTimeoutMinutes * time.Minute
+	if config.Timeout != nil && *config.Timeout > 0 {
+		timeout = time.Duration(*config.Timeout) * time.Minute
+	}
+
+	startedAt := time.Now()
+	metadata.StartedAt = startedAt.Format(time.RFC3339)
+	metadata.Deadline = startedAt.Add(timeout).Format(time.RFC3339)
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Waiting for required checks of %s", target)
+	return ctx.Requests.ScheduleActionCall(RequiredChecksPollAction, map[string]any{}, RequiredChecksPollBackoff.Interval(1))
+}
+
+func (c *WaitForRequiredChecks) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           RequiredChecksPollAction,
+			UserAccessible: false,
+		},
+	}
+}
+
+func (c *WaitForRequiredChecks) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case RequiredChecksPollAction:
+		return c.poll(ctx)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
+}
+
+func (c *WaitForRequiredChecks) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForRequiredChecksMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	//
+	// A poll scheduled before the execution was cancelled
+	// must not schedule the next one.
+	//
+	if metadata.Cancelled {
+		return nil
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+	if err != nil {
+		return fmt.Errorf("invalid start time %q: %w", metadata.StartedAt, err)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("invalid deadline %q: %w", metadata.Deadline, err)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode application metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	checks, err := getRequiredChecks(client, appMetadata.Owner, metadata.Repository, metadata.PullNumber, metadata.Ref)
+	if err != nil {
+		return err
+	}
+
+	metadata.PollAttempts++
+	checks.PollAttempts = metadata.PollAttempts
+	metadata.Checks = checks
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	channel := requiredChecksOutputChannel(checks, deadline, time.Now())
+	switch channel {
+	case "":
+		return ctx.Requests.ScheduleActionCall(RequiredChecksPollAction, map[string]any{}, RequiredChecksPollBackoff.Interval(metadata.PollAttempts+1))
+
+	case RequiredChecksTimeoutOutputChannel:
+		return ctx.ExecutionState.Emit(channel, RequiredChecksTimeoutPayloadType, []any{map[string]any{
+			"checks":        checks,
+			"timeout":       deadline.Sub(startedAt).String(),
+			"poll_attempts": metadata.PollAttempts,
+		}})
+	}
+
+	return ctx.ExecutionState.Emit(channel, RequiredChecksPayloadType, []any{checks})
+}
+
+/*
+ * The pull request is read on every poll, since its head moves on every push,
+ * and its base branch can be changed.
+ */
+func getRequiredChecks(client *github.Client, owner, repo string, pullNumber int, branch string) (*RequiredChecksOutput, error) {
+	var headSHA string
+	if pullNumber > 0 {
+		pr, _, err := client.PullRequests.Get(context.Background(), owner, repo, pullNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request %d: %w", pullNumber, wrapGitHubError(err))
+		}
+
+		branch = pr.GetBase().GetRef()
+		headSHA = pr.GetHead().GetSHA()
+	} else {
+		b, _, err := client.Repositories.GetBranch(context.Background(), owner, repo, branch, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branch %s: %w", branch, wrapGitHubError(err))
+		}
+
+		headSHA = b.GetCommit().GetSHA()
+	}
+
+	required, err := listRequiredStatusChecks(client, owner, repo, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RequiredChecksOutput{
+		PullNumber: pullNumber,
+		Branch:     branch,
+		HeadSHA:    headSHA,
+		Required:   required,
+		Checks:     []PullRequestCheck{},
+		Failing:    []string{},
+		Pending:    []string{},
+		State:      ChecksStateSuccess,
+	}
+
+	if len(required) == 0 {
+		return output, nil
+	}
+
+	statuses, err := listCommitStatuses(client, owner, repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	checkRuns, err := listCheckRunsForSHA(client, owner, repo, headSHA)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeChecks(filterRequiredChecks(append(statuses, checkRuns...), required), true)
+	output.Checks = summary.Checks
+	output.Failing = summary.Failing
+	output.Pending = summary.Pending
+	output.State = summary.State
+	return output, nil
+}
+
+/*
+ * Returns the output channel to emit on,
+ * or an empty string if the checks should be polled again.
+ * A failed required check does not end the wait while others are still pending.
+ */
+func requiredChecksOutputChannel(checks *RequiredChecksOutput, deadline time.Time, now time.Time) string {
+	if len(checks.Pending) == 0 {
+		if len(checks.Failing) > 0 {
+			return RequiredChecksFailureOutputChannel
+		}
+
+		return RequiredChecksSuccessOutputChannel
+	}
+
+	if !now.Before(deadline) {
+		return RequiredChecksTimeoutOutputChannel
+	}
+
+	return ""
+}
+
+func (c *WaitForRequiredChecks) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *WaitForRequiredChecks) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+/*
+ * Marks the wait as cancelled, so pending polls stop rescheduling,
+ * and emits the last known state of the required checks.
+ */
+func (c *WaitForRequiredChecks) Cancel(ctx core.ExecutionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := WaitForRequiredChecksMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	metadata.Cancelled = true
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	ctx.Logger.Infof("Stopped waiting for required checks")
+	return ctx.ExecutionState.Emit(RequiredChecksCancelledOutputChannel, RequiredChecksCancelledPayloadType, []any{map[string]any{
+		"checks":        metadata.Checks,
+		"poll_attempts": metadata.PollAttempts,
+	}})
+}
+
+func (c *WaitForRequiredChecks) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,153 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__WaitForRequiredChecks__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := WaitForRequiredChecks{}
+
+	t.Run("pull request number or branch is required", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello"},
+		})
+
+		require.ErrorContains(t, err, "pull request number or branch is required")
+	})
+
+	t.Run("pull request number and branch -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "ref": "main"},
+		})
+
+		require.ErrorContains(t, err, "only one of pull request number and branch can be set")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}},
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "ref": "main"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__WaitForRequiredChecks__Execute(t *testing.T) {
+	component := WaitForRequiredChecks{}
+
+	t.Run("poll is scheduled", func(t *testing.T) {
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+		require.NoError(t, component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{"repository": "hello", "pullNumber": "42", "timeout": 5},
+			Metadata:      metadataCtx,
+			Requests:      requestCtx,
+			Logger:        log.NewEntry(log.StandardLogger()),
+		}))
+
+		assert.Equal(t, RequiredChecksPollAction, requestCtx.Action)
+		assert.Equal(t, RequiredChecksPollBackoff.Initial, requestCtx.Duration)
+
+		metadata := metadataCtx.Get().(WaitForRequiredChecksMetadata)
+		assert.Equal(t, 42, metadata.PullNumber)
+
+		startedAt, err := time.Parse(time.RFC3339, metadata.StartedAt)
+		require.NoError(t, err)
+		deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, deadline.Sub(startedAt))
+	})
+}
+
+func Test__WaitForRequiredChecks__GetRequiredChecks(t *testing.T) {
+	transportFor := func(protectionStatus int) *mockTransport {
+		return &mockTransport{handler: func(request *http.Request) (*http.Response, error) {
+			switch {
+			case request.URL.Path == "/repos/testhq/hello/pulls/42":
+				return mockResponse(http.StatusOK, `{"number":42,"head":{"sha":"abc"},"base":{"ref":"main"}}`), nil
+			case request.URL.Path == "/repos/testhq/hello/branches/release":
+				return mockResponse(http.StatusOK, `{"name":"release","commit":{"sha":"def"}}`), nil
+			case strings.HasSuffix(request.URL.Path, "/protection/required_status_checks"):
+				if protectionStatus != http.StatusOK {
+					return mockResponse(protectionStatus, `{"message":"Branch not protected"}`), nil
+				}
+
+				return mockResponse(http.StatusOK, `{"contexts":["build","ci/lint","deploy"]}`), nil
+			case strings.HasSuffix(request.URL.Path, "/status"):
+				return mockResponse(http.StatusOK, `{"statuses":[{"context":"ci/lint","state":"failure"},{"context":"optional","state":"pending"}]}`), nil
+			}
+
+			return mockResponse(http.StatusOK, `{"check_runs":[{"name":"build","status":"completed","conclusion":"success"}]}`), nil
+		}}
+	}
+
+	t.Run("pull request -> required checks of the base branch on the head commit", func(t *testing.T) {
+		transport := transportFor(http.StatusOK)
+		output, err := getRequiredChecks(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 42, "")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 4)
+
+		assert.Equal(t, "/repos/testhq/hello/branches/main/protection/required_status_checks", transport.requests[1].URL.Path)
+		assert.Equal(t, "/repos/testhq/hello/commits/abc/status", transport.requests[2].URL.Path)
+		assert.Equal(t, "main", output.Branch)
+		assert.Equal(t, "abc", output.HeadSHA)
+		assert.Equal(t, []string{"build", "ci/lint", "deploy"}, output.Required)
+		assert.Equal(t, []string{"ci/lint"}, output.Failing)
+		assert.Equal(t, []string{"deploy"}, output.Pending)
+		assert.Len(t, output.Checks, 3)
+	})
+
+	t.Run("unprotected branch -> no required checks", func(t *testing.T) {
+		transport := transportFor(http.StatusNotFound)
+		output, err := getRequiredChecks(github.NewClient(&http.Client{Transport: transport}), "testhq", "hello", 0, "release")
+		require.NoError(t, err)
+		require.Len(t, transport.requests, 2)
+
+		assert.Equal(t, "def", output.HeadSHA)
+		assert.Empty(t, output.Required)
+		assert.Equal(t, ChecksStateSuccess, output.State)
+		assert.Equal(t, RequiredChecksSuccessOutputChannel, requiredChecksOutputChannel(output, time.Now().Add(time.Minute), time.Now()))
+	})
+}
+
+func Test__WaitForRequiredChecks__OutputChannel(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(10 * time.Minute)
+
+	t.Run("failing and pending -> poll again", func(t *testing.T) {
+		checks := &RequiredChecksOutput{Failing: []string{"lint"}, Pending: []string{"build"}}
+		assert.Equal(t, "", requiredChecksOutputChannel(checks, deadline, now))
+	})
+
+	t.Run("all completed with a failure -> failure", func(t *testing.T) {
+		checks := &RequiredChecksOutput{Failing: []string{"lint"}}
+		assert.Equal(t, RequiredChecksFailureOutputChannel, requiredChecksOutputChannel(checks, deadline, now))
+	})
+
+	t.Run("all completed -> success", func(t *testing.T) {
+		assert.Equal(t, RequiredChecksSuccessOutputChannel, requiredChecksOutputChannel(&RequiredChecksOutput{}, deadline, now))
+	})
+
+	t.Run("pending after the deadline -> timeout", func(t *testing.T) {
+		checks := &RequiredChecksOutput{Pending: []string{"build"}}
+		assert.Equal(t, RequiredChecksTimeoutOutputChannel, requiredChecksOutputChannel(checks, now, now))
+	})
+}
@@ -0,0 +1,259 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const WebhookRouterPayloadType = "github.webhook"
+
+/*
+ * The event types the webhook router has an output channel for.
+ * Channels are named after the GitHub event, as sent in the X-GitHub-Event header.
+ */
+var WebhookRouterEvents = []configuration.FieldOption{
+	{Label: "Push", Value: "push"},
+	{Label: "Pull Request", Value: "pull_request"},
+	{Label: "Pull Request Review", Value: "pull_request_review"},
+	{Label: "Issues", Value: "issues"},
+	{Label: "Issue Comment", Value: "issue_comment"},
+	{Label: "Release", Value: "release"},
+	{Label: "Workflow Run", Value: "workflow_run"},
+	{Label: "Check Run", Value: "check_run"},
+	{Label: "Check Suite", Value: "check_suite"},
+	{Label: "Deployment Status", Value: "deployment_status"},
+	{Label: "Create", Value: "create"},
+	{Label: "Delete", Value: "delete"},
+}
+
+type WebhookRouter struct{}
+
+type WebhookRouterConfiguration struct {
+	Repository string   `json:"repository" mapstructure:"repository"`
+	Events     []string `json:"events" mapstructure:"events"`
+}
+
+type WebhookRouterOutput struct {
+	Event      string         `json:"event"`
+	Action     string         `json:"action,omitempty"`
+	DeliveryID string         `json:"delivery_id,omitempty"`
+	Payload    map[string]any `json:"payload"`
+}
+
+func (c *WebhookRouter) Name() string {
+	return "github.webhookRouter"
+}
+
+func (c *WebhookRouter) Label() string {
+	return "Webhook Router"
+}
+
+func (c *WebhookRouter) Description() string {
+	return "Receive GitHub webhooks of many event types, and route each one to the output channel of its event type"
+}
+
+func (c *WebhookRouter) Documentation() string {
+	return `The Webhook Router trigger receives the GitHub webhooks of a repository, and emits each delivery on the output channel of its event type.
+
+## Use Cases
+
+- **Single entry point**: Start a workflow from one node, and branch downstream by event type, instead of adding one trigger per event type
+- **Event fan-out**: Handle pushes, pull requests and comments in the same workflow
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Events**: The event types to receive. Each one gets its own output channel
+
+## Output Channels
+
+One channel per selected event type, named after the GitHub event, e.g. ` + "`push`" + `, ` + "`pull_request`" + ` or ` + "`issue_comment`" + `.
+
+## Output
+
+Emits a ` + "`github.webhook`" + ` event with the ` + "`event`" + ` type, the ` + "`action`" + ` of the delivery, if it has one, the ` + "`delivery_id`" + `, and the webhook ` + "`payload`" + `.
+
+## Notes
+
+- Every delivery is verified with the webhook signature, and rejected if the signature does not match
+- Deliveries of event types that are not selected, like GitHub's ping, are ignored`
+}
+
+func (c *WebhookRouter) Icon() string {
+	return "github"
+}
+
+func (c *WebhookRouter) Color() string {
+	return "gray"
+}
+
+/*
+ * The channels the router emits on, one per selected event.
+ * Edges from the router connect to them by event name.
+ */
+func (c *WebhookRouter) OutputChannels(config any) []core.OutputChannel {
+	events := webhookRouterEvents(config)
+
+	channels := []core.OutputChannel{}
+	for _, event := range WebhookRouterEvents {
+		if slices.Contains(events, event.Value) {
+			channels = append(channels, core.OutputChannel{
+				Name:        event.Value,
+				Label:       event.Label,
+				Description: fmt.Sprintf("Emitted for %s webhooks", event.Value),
+			})
+		}
+	}
+
+	return channels
+}
+
+/*
+ * Returns the selected events, or all of them if none are selected,
+ * so all channels are advertised while the node is being configured.
+ */
+func webhookRouterEvents(config any) []string {
+	var routerConfig WebhookRouterConfiguration
+	if err := mapstructure.Decode(config, &routerConfig); err != nil || len(routerConfig.Events) == 0 {
+		events := []string{}
+		for _, event := range WebhookRouterEvents {
+			events = append(events, event.Value)
+		}
+
+		return events
+	}
+
+	return routerConfig.Events
+}
+
+func (c *WebhookRouter) Configuration() []configuration.Field {
+	defaultEvents := []string{}
+	for _, event := range WebhookRouterEvents {
+		defaultEvents = append(defaultEvents, event.Value)
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:     "events",
+			Label:    "Events",
+			Type:     configuration.FieldTypeMultiSelect,
+			Required: true,
+			Default:  defaultEvents,
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: WebhookRouterEvents,
+				},
+			},
+		},
+	}
+}
+
+func (c *WebhookRouter) Setup(ctx core.TriggerContext) error {
+	var config WebhookRouterConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(config.Events) == 0 {
+		return errors.New("at least one event is required")
+	}
+
+	for _, event := range config.Events {
+		if !slices.ContainsFunc(WebhookRouterEvents, func(option configuration.FieldOption) bool { return option.Value == event }) {
+			return fmt.Errorf("invalid event: %s", event)
+		}
+	}
+
+	err := ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Integration.RequestWebhook(WebhookConfiguration{
+		EventTypes: config.Events,
+		Repository: config.Repository,
+	})
+}
+
+func (c *WebhookRouter) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	var config WebhookRouterConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	event := ctx.Headers.Get("X-GitHub-Event")
+	if event == "" {
+		return http.StatusBadRequest, fmt.Errorf("missing X-GitHub-Event header")
+	}
+
+	code, err := verifySignature(ctx)
+	if err != nil {
+		return code, err
+	}
+
+	if !slices.Contains(config.Events, event) {
+		return http.StatusOK, nil
+	}
+
+	invalidateReadsForWebhook(ctx)
+
+	payload := map[string]any{}
+	if err := json.Unmarshal(ctx.Body, &payload); err != nil {
+		return http.StatusBadRequest, fmt.Errorf("error parsing request body: %v", err)
+	}
+
+	events, ok := ctx.Events.(core.ChannelEventContext)
+	if !ok {
+		return http.StatusInternalServerError, errors.New("events cannot be emitted on output channels")
+	}
+
+	action, _ := payload["action"].(string)
+	err = events.EmitOnChannel(event, WebhookRouterPayloadType, WebhookRouterOutput{
+		Event:      event,
+		Action:     action,
+		DeliveryID: ctx.Headers.Get("X-GitHub-Delivery"),
+		Payload:    payload,
+	})
+
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
+	}
+
+	return http.StatusOK, nil
+}
+
+func (c *WebhookRouter) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *WebhookRouter) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	return nil, nil
+}
+
+func (c *WebhookRouter) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
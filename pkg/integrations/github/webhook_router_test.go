@@ -0,0 +1,159 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__WebhookRouter__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	trigger := WebhookRouter{}
+
+	t.Run("events are required", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "events": []string{}},
+		})
+
+		require.ErrorContains(t, err, "at least one event is required")
+	})
+
+	t.Run("invalid event -> error", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "hello", "events": []string{"push", "star"}},
+		})
+
+		require.ErrorContains(t, err, "invalid event: star")
+	})
+
+	t.Run("metadata is set and webhook is requested", func(t *testing.T) {
+		nodeMetadataCtx := contexts.MetadataContext{}
+		integrationCtx := &contexts.IntegrationContext{Metadata: Metadata{Repositories: []Repository{helloRepo}}}
+		require.NoError(t, trigger.Setup(core.TriggerContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello", "events": []string{"push", "issue_comment"}},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+		require.Len(t, integrationCtx.WebhookRequests, 1)
+		assert.Equal(t, WebhookConfiguration{EventTypes: []string{"push", "issue_comment"}, Repository: "hello"}, integrationCtx.WebhookRequests[0])
+	})
+}
+
+func Test__WebhookRouter__OutputChannels(t *testing.T) {
+	trigger := WebhookRouter{}
+
+	t.Run("one channel per selected event", func(t *testing.T) {
+		channels := trigger.OutputChannels(map[string]any{"events": []string{"issue_comment", "push"}})
+		require.Len(t, channels, 2)
+		assert.Equal(t, "push", channels[0].Name)
+		assert.Equal(t, "issue_comment", channels[1].Name)
+	})
+
+	t.Run("no events selected -> all channels", func(t *testing.T) {
+		assert.Len(t, trigger.OutputChannels(map[string]any{}), len(WebhookRouterEvents))
+	})
+}
+
+func Test__WebhookRouter__HandleWebhook(t *testing.T) {
+	trigger := &WebhookRouter{}
+	secret := "test-secret"
+	configuration := map[string]any{"repository": "hello", "events": []string{"push", "pull_request"}}
+
+	request := func(event string, body []byte) core.WebhookRequestContext {
+		h := hmac.New(sha256.New, []byte(secret))
+		h.Write(body)
+
+		headers := http.Header{}
+		headers.Set("X-Hub-Signature-256", fmt.Sprintf("sha256=%x", h.Sum(nil)))
+		headers.Set("X-GitHub-Delivery", "delivery-1")
+		if event != "" {
+			headers.Set("X-GitHub-Event", event)
+		}
+
+		return core.WebhookRequestContext{
+			Body:          body,
+			Headers:       headers,
+			Configuration: configuration,
+			Webhook:       &contexts.WebhookContext{Secret: secret},
+			Events:        &contexts.EventContext{},
+		}
+	}
+
+	t.Run("no X-GitHub-Event -> 400", func(t *testing.T) {
+		code, err := trigger.HandleWebhook(request("", []byte(`{}`)))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.ErrorContains(t, err, "missing X-GitHub-Event header")
+	})
+
+	t.Run("invalid signature -> 403", func(t *testing.T) {
+		ctx := request("push", []byte(`{}`))
+		ctx.Headers.Set("X-Hub-Signature-256", "sha256=invalid")
+
+		code, err := trigger.HandleWebhook(ctx)
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.Error(t, err)
+		assert.Zero(t, ctx.Events.(*contexts.EventContext).Count())
+	})
+
+	t.Run("event that is not selected -> ignored", func(t *testing.T) {
+		ctx := request("ping", []byte(`{"zen":"Keep it logically awesome."}`))
+
+		code, err := trigger.HandleWebhook(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Zero(t, ctx.Events.(*contexts.EventContext).Count())
+	})
+
+	t.Run("event is emitted on the channel of its type", func(t *testing.T) {
+		ctx := request("pull_request", []byte(`{"action":"opened","number":42}`))
+
+		code, err := trigger.HandleWebhook(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		events := ctx.Events.(*contexts.EventContext)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "pull_request", events.Payloads[0].Channel)
+		assert.Equal(t, WebhookRouterPayloadType, events.Payloads[0].Type)
+
+		output := events.Payloads[0].Data.(WebhookRouterOutput)
+		assert.Equal(t, "pull_request", output.Event)
+		assert.Equal(t, "opened", output.Action)
+		assert.Equal(t, "delivery-1", output.DeliveryID)
+		assert.Equal(t, float64(42), output.Payload["number"])
+	})
+
+	t.Run("events without an action have no action", func(t *testing.T) {
+		ctx := request("push", []byte(`{"ref":"refs/heads/main"}`))
+
+		_, err := trigger.HandleWebhook(ctx)
+		require.NoError(t, err)
+
+		events := ctx.Events.(*contexts.EventContext)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "push", events.Payloads[0].Channel)
+		assert.Empty(t, events.Payloads[0].Data.(WebhookRouterOutput).Action)
+	})
+
+	t.Run("event context without channels -> 500", func(t *testing.T) {
+		ctx := request("push", []byte(`{}`))
+		ctx.Events = &replayEventContext{}
+
+		code, err := trigger.HandleWebhook(ctx)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.ErrorContains(t, err, "cannot be emitted on output channels")
+	})
+}
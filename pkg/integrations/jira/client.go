@@ -151,11 +151,29 @@ type CreateIssueRequest struct {
 }
 
 // CreateIssueFields contains the fields for creating an issue.
+// Custom fields are sent next to the standard fields, keyed by their ID (e.g. customfield_10010).
 type CreateIssueFields struct {
-	Project     ProjectRef `json:"project"`
-	IssueType   IssueType  `json:"issuetype"`
-	Summary     string     `json:"summary"`
-	Description *ADFDoc    `json:"description,omitempty"`
+	Project      ProjectRef     `json:"project"`
+	IssueType    IssueType      `json:"issuetype"`
+	Summary      string         `json:"summary"`
+	Description  *ADFDoc        `json:"description,omitempty"`
+	CustomFields map[string]any `json:"-"`
+}
+
+func (f CreateIssueFields) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{}
+	for name, value := range f.CustomFields {
+		fields[name] = value
+	}
+
+	fields["project"] = f.Project
+	fields["issuetype"] = f.IssueType
+	fields["summary"] = f.Summary
+	if f.Description != nil {
+		fields["description"] = f.Description
+	}
+
+	return json.Marshal(fields)
 }
 
 // ProjectRef references a project by key.
@@ -234,3 +252,77 @@ func (c *Client) CreateIssue(req *CreateIssueRequest) (*CreateIssueResponse, err
 
 	return &response, nil
 }
+
+// ListIssueTypes returns the issue types available for creating issues in a project.
+func (c *Client) ListIssueTypes(projectKey string) ([]IssueTypeMeta, error) {
+	url := fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes", c.BaseURL, projectKey)
+	responseBody, err := c.execRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		IssueTypes []IssueTypeMeta `json:"issueTypes"`
+	}
+
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error parsing issue types response: %v", err)
+	}
+
+	return response.IssueTypes, nil
+}
+
+// IssueTypeMeta represents an issue type available in a project.
+type IssueTypeMeta struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Subtask bool   `json:"subtask"`
+}
+
+// Transition represents a workflow transition available for an issue.
+type Transition struct {
+	ID   string           `json:"id"`
+	Name string           `json:"name"`
+	To   TransitionStatus `json:"to"`
+}
+
+// TransitionStatus is the status an issue moves to after a transition.
+type TransitionStatus struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetTransitions returns the transitions available from the current status of an issue.
+func (c *Client) GetTransitions(issueKey string) ([]Transition, error) {
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", c.BaseURL, issueKey)
+	responseBody, err := c.execRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Transitions []Transition `json:"transitions"`
+	}
+
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error parsing transitions response: %v", err)
+	}
+
+	return response.Transitions, nil
+}
+
+// TransitionIssue performs a transition on an issue.
+func (c *Client) TransitionIssue(issueKey, transitionID string) error {
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", c.BaseURL, issueKey)
+
+	body, err := json.Marshal(map[string]any{
+		"transition": map[string]string{"id": transitionID},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	_, err = c.execRequest(http.MethodPost, url, bytes.NewReader(body))
+	return err
+}
@@ -1,6 +1,14 @@
 package jira
 
+import "strings"
+
 // NodeMetadata stores metadata on trigger/component nodes.
 type NodeMetadata struct {
 	Project *Project `json:"project,omitempty"`
 }
+
+// isExpression reports whether a configuration value is an expression,
+// which is only resolved on execution.
+func isExpression(value string) bool {
+	return strings.Contains(value, "{{")
+}
@@ -1,8 +1,11 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
@@ -15,10 +18,25 @@ const CreateIssuePayloadType = "jira.issue"
 type CreateIssue struct{}
 
 type CreateIssueSpec struct {
-	Project     string `json:"project"`
-	IssueType   string `json:"issueType"`
-	Summary     string `json:"summary"`
-	Description string `json:"description"`
+	Project      string        `json:"project"`
+	IssueType    string        `json:"issueType"`
+	Summary      string        `json:"summary"`
+	Description  string        `json:"description"`
+	CustomFields []CustomField `json:"customFields"`
+}
+
+type CustomField struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	JSON  bool   `json:"json"`
+}
+
+// CreateIssueOutput is the created issue, with a link to it in the Jira UI.
+type CreateIssueOutput struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Self string `json:"self"`
+	URL  string `json:"url"`
 }
 
 func (c *CreateIssue) Name() string {
@@ -48,13 +66,17 @@ func (c *CreateIssue) Documentation() string {
 - **Issue Type**: The type of issue (e.g. Task, Bug, Story)
 - **Summary**: The issue summary/title
 - **Description**: Optional description text
+- **Custom Fields**: Optional custom field values, keyed by field ID (e.g. ` + "`customfield_10010`" + `).
+  Values starting with ` + "`{`" + ` or ` + "`[`" + `, like ` + "`{\"value\": \"High\"}`" + `, are sent as JSON. Other values are sent as strings,
+  unless **Send as JSON** is enabled for the field - use it for number and boolean fields, like ` + "`5`" + `.
 
 ## Output
 
 Returns the created issue including:
 - **id**: The issue ID
 - **key**: The issue key (e.g. PROJ-123)
-- **self**: API URL for the issue`
+- **self**: API URL for the issue
+- **url**: Browse URL for the issue in Jira`
 }
 
 func (c *CreateIssue) Icon() string {
@@ -107,6 +129,47 @@ func (c *CreateIssue) Configuration() []configuration.Field {
 			Required:    false,
 			Description: "Optional description text",
 		},
+		{
+			Name:        "customFields",
+			Label:       "Custom Fields",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Togglable:   true,
+			Description: "Custom field values, keyed by field ID",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Custom Field",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "field",
+								Type:               configuration.FieldTypeString,
+								Label:              "Field ID",
+								Required:           true,
+								Placeholder:        "customfield_10010",
+								DisallowExpression: true,
+							},
+							{
+								Name:        "value",
+								Type:        configuration.FieldTypeString,
+								Label:       "Value",
+								Required:    true,
+								Placeholder: "{\"value\": \"High\"}",
+							},
+							{
+								Name:        "json",
+								Type:        configuration.FieldTypeBool,
+								Label:       "Send as JSON",
+								Required:    false,
+								Default:     false,
+								Description: "Send the value as JSON, e.g. for number and boolean fields",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -150,9 +213,48 @@ func (c *CreateIssue) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("project %s not found", spec.Project)
 	}
 
+	for _, field := range spec.CustomFields {
+		if field.Field == "" {
+			return fmt.Errorf("custom field ID is required")
+		}
+
+		if field.JSON && !isExpression(field.Value) && !json.Valid([]byte(field.Value)) {
+			return fmt.Errorf("custom field %s value is not valid JSON", field.Field)
+		}
+	}
+
+	//
+	// Issue types are only validated when known at setup time.
+	//
+	if !isExpression(spec.IssueType) {
+		err = c.validateIssueType(client, spec.Project, spec.IssueType)
+		if err != nil {
+			return err
+		}
+	}
+
 	return ctx.Metadata.Set(NodeMetadata{Project: project})
 }
 
+func (c *CreateIssue) validateIssueType(client *Client, project, issueType string) error {
+	issueTypes, err := client.ListIssueTypes(project)
+	if err != nil {
+		return fmt.Errorf("failed to list issue types: %v", err)
+	}
+
+	names := make([]string, 0, len(issueTypes))
+	for _, t := range issueTypes {
+		if strings.EqualFold(t.Name, issueType) {
+			return nil
+		}
+
+		names = append(names, t.Name)
+	}
+
+	slices.Sort(names)
+	return fmt.Errorf("issue type %s not available in project %s - available types: %s", issueType, project, strings.Join(names, ", "))
+}
+
 func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 	spec := CreateIssueSpec{}
 	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
@@ -164,12 +266,18 @@ func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to create client: %v", err)
 	}
 
+	customFields, err := buildCustomFields(spec.CustomFields)
+	if err != nil {
+		return err
+	}
+
 	req := &CreateIssueRequest{
 		Fields: CreateIssueFields{
-			Project:     ProjectRef{Key: spec.Project},
-			IssueType:   IssueType{Name: spec.IssueType},
-			Summary:     spec.Summary,
-			Description: WrapInADF(spec.Description),
+			Project:      ProjectRef{Key: spec.Project},
+			IssueType:    IssueType{Name: spec.IssueType},
+			Summary:      spec.Summary,
+			Description:  WrapInADF(spec.Description),
+			CustomFields: customFields,
 		},
 	}
 
@@ -178,13 +286,52 @@ func (c *CreateIssue) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to create issue: %v", err)
 	}
 
+	output := CreateIssueOutput{
+		ID:   response.ID,
+		Key:  response.Key,
+		Self: response.Self,
+		URL:  fmt.Sprintf("%s/browse/%s", strings.TrimSuffix(client.BaseURL, "/"), response.Key),
+	}
+
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		CreateIssuePayloadType,
-		[]any{response},
+		[]any{output},
 	)
 }
 
+// buildCustomFields sends objects and arrays as JSON, since option and user fields need them.
+// Other values are sent as strings, so text fields like "123" or "true" are kept as they are,
+// unless the field is configured to be sent as JSON, like number fields.
+func buildCustomFields(fields []CustomField) (map[string]any, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]any, len(fields))
+	for _, field := range fields {
+		trimmed := strings.TrimSpace(field.Value)
+		if !field.JSON && !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			result[field.Field] = field.Value
+			continue
+		}
+
+		var value any
+		if err := json.Unmarshal([]byte(trimmed), &value); err != nil {
+			if !field.JSON {
+				result[field.Field] = field.Value
+				continue
+			}
+
+			return nil, fmt.Errorf("custom field %s value is not valid JSON: %v", field.Field, err)
+		}
+
+		result[field.Field] = value
+	}
+
+	return result, nil
+}
+
 func (c *CreateIssue) Cancel(ctx core.ExecutionContext) error {
 	return nil
 }
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"strings"
@@ -120,6 +121,77 @@ func Test__CreateIssue__Setup(t *testing.T) {
 		require.ErrorContains(t, err, "project TEST not found")
 	})
 
+	t.Run("issue type not available -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`[{"id":"10000","key":"TEST","name":"Test Project"}]`)),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"issueTypes":[{"id":"1","name":"Task"},{"id":"2","name":"Bug"}]}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"baseUrl":  "https://test.atlassian.net",
+				"email":    "test@example.com",
+				"apiToken": "test-token",
+			},
+		}
+
+		err := component.Setup(core.SetupContext{
+			HTTP:        httpContext,
+			Integration: appCtx,
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"project":   "TEST",
+				"issueType": "Epic",
+				"summary":   "Test summary",
+			},
+		})
+
+		require.ErrorContains(t, err, "issue type Epic not available in project TEST - available types: Bug, Task")
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, "https://test.atlassian.net/rest/api/3/issue/createmeta/TEST/issuetypes", httpContext.Requests[1].URL.String())
+	})
+
+	t.Run("issue type expression -> not validated", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`[{"id":"10000","key":"TEST","name":"Test Project"}]`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"baseUrl":  "https://test.atlassian.net",
+				"email":    "test@example.com",
+				"apiToken": "test-token",
+			},
+		}
+
+		err := component.Setup(core.SetupContext{
+			HTTP:        httpContext,
+			Integration: appCtx,
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"project":   "TEST",
+				"issueType": "{{$.data.type}}",
+				"summary":   "Test summary",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+	})
+
 	t.Run("valid setup", func(t *testing.T) {
 		httpContext := &contexts.HTTPContext{
 			Responses: []*http.Response{
@@ -127,6 +199,10 @@ func Test__CreateIssue__Setup(t *testing.T) {
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(strings.NewReader(`[{"id":"10000","key":"TEST","name":"Test Project"}]`)),
 				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"issueTypes":[{"id":"1","name":"Task"}]}`)),
+				},
 			},
 		}
 
@@ -192,6 +268,65 @@ func Test__CreateIssue__Execute(t *testing.T) {
 		assert.True(t, execCtx.Passed)
 		assert.Equal(t, CreateIssuePayloadType, execCtx.Type)
 		require.Len(t, execCtx.Payloads, 1)
+
+		payload := execCtx.Payloads[0].(map[string]any)["data"].(CreateIssueOutput)
+		assert.Equal(t, "TEST-123", payload.Key)
+		assert.Equal(t, "https://test.atlassian.net/browse/TEST-123", payload.URL)
+	})
+
+	t.Run("custom fields are sent with the issue", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(strings.NewReader(`{"id":"10003","key":"TEST-125","self":"https://test.atlassian.net/rest/api/3/issue/10003"}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"baseUrl":  "https://test.atlassian.net",
+				"email":    "test@example.com",
+				"apiToken": "test-token",
+			},
+		}
+
+		execCtx := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"project":   "TEST",
+				"issueType": "Task",
+				"summary":   "New task",
+				"customFields": []any{
+					map[string]any{"field": "customfield_10010", "value": `{"value": "High"}`},
+					map[string]any{"field": "customfield_10020", "value": "5", "json": true},
+					map[string]any{"field": "customfield_10030", "value": "team-a"},
+					map[string]any{"field": "customfield_10040", "value": "123"},
+					map[string]any{"field": "customfield_10050", "value": "true"},
+					map[string]any{"field": "customfield_10060", "value": "null"},
+				},
+			},
+			HTTP:           httpContext,
+			Integration:    appCtx,
+			ExecutionState: execCtx,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+
+		body, err := io.ReadAll(httpContext.Requests[0].Body)
+		require.NoError(t, err)
+
+		var request map[string]map[string]any
+		require.NoError(t, json.Unmarshal(body, &request))
+		assert.Equal(t, map[string]any{"value": "High"}, request["fields"]["customfield_10010"])
+		assert.Equal(t, float64(5), request["fields"]["customfield_10020"])
+		assert.Equal(t, "team-a", request["fields"]["customfield_10030"])
+		assert.Equal(t, "123", request["fields"]["customfield_10040"])
+		assert.Equal(t, "true", request["fields"]["customfield_10050"])
+		assert.Equal(t, "null", request["fields"]["customfield_10060"])
+		assert.Equal(t, "New task", request["fields"]["summary"])
 	})
 
 	t.Run("successful issue creation with description", func(t *testing.T) {
@@ -265,6 +400,19 @@ func Test__CreateIssue__Execute(t *testing.T) {
 	})
 }
 
+func Test__BuildCustomFields(t *testing.T) {
+	t.Run("invalid JSON object -> sent as a string", func(t *testing.T) {
+		fields, err := buildCustomFields([]CustomField{{Field: "customfield_10010", Value: "{not json"}})
+		require.NoError(t, err)
+		assert.Equal(t, "{not json", fields["customfield_10010"])
+	})
+
+	t.Run("invalid JSON value sent as JSON -> error", func(t *testing.T) {
+		_, err := buildCustomFields([]CustomField{{Field: "customfield_10020", Value: "five", JSON: true}})
+		require.ErrorContains(t, err, "custom field customfield_10020 value is not valid JSON")
+	})
+}
+
 func Test__CreateIssue__ComponentInfo(t *testing.T) {
 	component := CreateIssue{}
 
@@ -280,7 +428,7 @@ func Test__CreateIssue__Configuration(t *testing.T) {
 	component := CreateIssue{}
 
 	config := component.Configuration()
-	assert.Len(t, config, 4)
+	assert.Len(t, config, 5)
 
 	fieldNames := make([]string, len(config))
 	for i, f := range config {
@@ -291,10 +439,13 @@ func Test__CreateIssue__Configuration(t *testing.T) {
 	assert.Contains(t, fieldNames, "issueType")
 	assert.Contains(t, fieldNames, "summary")
 	assert.Contains(t, fieldNames, "description")
+	assert.Contains(t, fieldNames, "customFields")
 
 	for _, f := range config {
 		if f.Name == "description" {
 			assert.False(t, f.Required, "description should be optional")
+		} else if f.Name == "customFields" {
+			assert.False(t, f.Required, "customFields should be optional")
 		} else {
 			assert.True(t, f.Required, "%s should be required", f.Name)
 		}
@@ -75,6 +75,7 @@ func (j *Jira) Configuration() []configuration.Field {
 func (j *Jira) Components() []core.Component {
 	return []core.Component{
 		&CreateIssue{},
+		&TransitionIssue{},
 	}
 }
 
@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const TransitionIssuePayloadType = "jira.issue.transitioned"
+
+type TransitionIssue struct{}
+
+type TransitionIssueSpec struct {
+	IssueKey   string `json:"issueKey"`
+	Transition string `json:"transition"`
+}
+
+// TransitionIssueOutput is the issue key, the transition performed and the new status.
+type TransitionIssueOutput struct {
+	Key          string `json:"key"`
+	TransitionID string `json:"transitionId"`
+	Transition   string `json:"transition"`
+	Status       string `json:"status"`
+	StatusID     string `json:"statusId"`
+}
+
+func (c *TransitionIssue) Name() string {
+	return "jira.transitionIssue"
+}
+
+func (c *TransitionIssue) Label() string {
+	return "Transition Issue"
+}
+
+func (c *TransitionIssue) Description() string {
+	return "Move a Jira issue to a new status"
+}
+
+func (c *TransitionIssue) Documentation() string {
+	return `The Transition Issue component moves a Jira issue to a new status, using one of the transitions of its workflow.
+
+## Use Cases
+
+- **Status sync**: Move issues to "In Progress" or "Done" as deployments happen
+- **Release flows**: Close issues when the release that includes them is published
+
+## Configuration
+
+- **Issue Key**: The key of the issue to transition (e.g. PROJ-123)
+- **Transition**: The name of the transition to perform (e.g. Start Progress, Done)
+
+## Output
+
+Returns the issue key, the transition performed, and the new status of the issue.
+
+## Notes
+
+- Transition IDs differ between workflows, so the transition is looked up by name, ignoring case
+- If the transition is not available from the current status of the issue, the execution fails with the list of available transitions`
+}
+
+func (c *TransitionIssue) Icon() string {
+	return "jira"
+}
+
+func (c *TransitionIssue) Color() string {
+	return "blue"
+}
+
+func (c *TransitionIssue) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *TransitionIssue) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "issueKey",
+			Label:       "Issue Key",
+			Type:        configuration.FieldTypeExpression,
+			Required:    true,
+			Description: "The key of the issue to transition",
+			Placeholder: "PROJ-123",
+		},
+		{
+			Name:        "transition",
+			Label:       "Transition",
+			Type:        configuration.FieldTypeExpression,
+			Required:    true,
+			Description: "The name of the transition to perform",
+			Placeholder: "Done",
+		},
+	}
+}
+
+func (c *TransitionIssue) Setup(ctx core.SetupContext) error {
+	spec := TransitionIssueSpec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	if spec.IssueKey == "" {
+		return fmt.Errorf("issueKey is required")
+	}
+
+	if spec.Transition == "" {
+		return fmt.Errorf("transition is required")
+	}
+
+	return nil
+}
+
+func (c *TransitionIssue) Execute(ctx core.ExecutionContext) error {
+	spec := TransitionIssueSpec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %v", err)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %v", err)
+	}
+
+	transitions, err := client.GetTransitions(spec.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %v", err)
+	}
+
+	transition := findTransition(transitions, spec.Transition)
+	if transition == nil {
+		return fmt.Errorf(
+			"transition %s not available for issue %s - available transitions: %s",
+			spec.Transition,
+			spec.IssueKey,
+			transitionNames(transitions),
+		)
+	}
+
+	err = client.TransitionIssue(spec.IssueKey, transition.ID)
+	if err != nil {
+		return fmt.Errorf("failed to transition issue: %v", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		TransitionIssuePayloadType,
+		[]any{TransitionIssueOutput{
+			Key:          spec.IssueKey,
+			TransitionID: transition.ID,
+			Transition:   transition.Name,
+			Status:       transition.To.Name,
+			StatusID:     transition.To.ID,
+		}},
+	)
+}
+
+func findTransition(transitions []Transition, name string) *Transition {
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, strings.TrimSpace(name)) {
+			return &t
+		}
+	}
+
+	return nil
+}
+
+func transitionNames(transitions []Transition) string {
+	if len(transitions) == 0 {
+		return "none"
+	}
+
+	names := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		names = append(names, t.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func (c *TransitionIssue) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *TransitionIssue) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *TransitionIssue) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *TransitionIssue) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *TransitionIssue) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *TransitionIssue) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
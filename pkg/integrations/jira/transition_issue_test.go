@@ -0,0 +1,126 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const transitionsResponse = `{
+	"transitions": [
+		{"id": "11", "name": "Start Progress", "to": {"id": "3", "name": "In Progress"}},
+		{"id": "31", "name": "Done", "to": {"id": "10002", "name": "Done"}}
+	]
+}`
+
+func Test__TransitionIssue__Setup(t *testing.T) {
+	component := TransitionIssue{}
+
+	t.Run("missing issueKey -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"transition": "Done"},
+		})
+
+		require.ErrorContains(t, err, "issueKey is required")
+	})
+
+	t.Run("missing transition -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"issueKey": "TEST-1"},
+		})
+
+		require.ErrorContains(t, err, "transition is required")
+	})
+
+	t.Run("valid setup", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"issueKey": "{{$.data.key}}", "transition": "Done"},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__TransitionIssue__Execute(t *testing.T) {
+	component := TransitionIssue{}
+	appCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{
+			"baseUrl":  "https://test.atlassian.net",
+			"email":    "test@example.com",
+			"apiToken": "test-token",
+		},
+	}
+
+	t.Run("transition is resolved by name and performed", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(transitionsResponse)),
+				},
+				{
+					StatusCode: http.StatusNoContent,
+					Body:       io.NopCloser(strings.NewReader("")),
+				},
+			},
+		}
+
+		execCtx := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"issueKey": "TEST-1", "transition": "start progress"},
+			HTTP:           httpContext,
+			Integration:    appCtx,
+			ExecutionState: execCtx,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, http.MethodPost, httpContext.Requests[1].Method)
+		assert.Equal(t, "https://test.atlassian.net/rest/api/3/issue/TEST-1/transitions", httpContext.Requests[1].URL.String())
+
+		body, err := io.ReadAll(httpContext.Requests[1].Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"transition":{"id":"11"}}`, string(body))
+
+		assert.Equal(t, TransitionIssuePayloadType, execCtx.Type)
+		require.Len(t, execCtx.Payloads, 1)
+		payload := execCtx.Payloads[0].(map[string]any)["data"].(TransitionIssueOutput)
+		assert.Equal(t, "In Progress", payload.Status)
+		assert.Equal(t, "Start Progress", payload.Transition)
+	})
+
+	t.Run("transition not available -> error with available transitions", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(transitionsResponse)),
+				},
+			},
+		}
+
+		execCtx := &contexts.ExecutionStateContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"issueKey": "TEST-1", "transition": "Reopen"},
+			HTTP:           httpContext,
+			Integration:    appCtx,
+			ExecutionState: execCtx,
+		})
+
+		require.ErrorContains(t, err, "transition Reopen not available for issue TEST-1 - available transitions: Start Progress, Done")
+		require.Len(t, httpContext.Requests, 1)
+		assert.False(t, execCtx.Finished)
+	})
+}
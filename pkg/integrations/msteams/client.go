@@ -0,0 +1,104 @@
+package msteams
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const AdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+
+type Client struct {
+	WebhookURL string
+	http       core.HTTPContext
+}
+
+func NewClient(http core.HTTPContext, ctx core.IntegrationContext) (*Client, error) {
+	webhookURL, err := ctx.GetConfig("webhookUrl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook URL: %w", err)
+	}
+
+	if string(webhookURL) == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+
+	return &Client{WebhookURL: string(webhookURL), http: http}, nil
+}
+
+/*
+ * Both Workflows and Connectors webhooks accept
+ * adaptive cards sent as message attachments.
+ */
+type MessageRequest struct {
+	Type        string              `json:"type"`
+	Attachments []MessageAttachment `json:"attachments"`
+}
+
+type MessageAttachment struct {
+	ContentType string         `json:"contentType"`
+	Content     map[string]any `json:"content"`
+}
+
+type MessageResponse struct {
+	StatusCode int    `json:"status_code"`
+	Body       string `json:"body,omitempty"`
+}
+
+func NewCardMessage(card map[string]any) MessageRequest {
+	return MessageRequest{
+		Type: "message",
+		Attachments: []MessageAttachment{
+			{ContentType: AdaptiveCardContentType, Content: card},
+		},
+	}
+}
+
+func (c *Client) PostMessage(message MessageRequest) (*MessageResponse, error) {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	response := &MessageResponse{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(responseBody)),
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("request failed with %d: %s", resp.StatusCode, response.Body)
+	}
+
+	//
+	// Connectors webhooks answer errors like throttling
+	// with a 200 and the error in the body.
+	//
+	if strings.Contains(response.Body, "HTTP error") {
+		return nil, fmt.Errorf("request failed: %s", response.Body)
+	}
+
+	return response, nil
+}
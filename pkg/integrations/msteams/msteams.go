@@ -0,0 +1,129 @@
+package msteams
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+func init() {
+	registry.RegisterIntegration("teams", &MSTeams{})
+}
+
+type MSTeams struct{}
+
+func (t *MSTeams) Name() string {
+	return "teams"
+}
+
+func (t *MSTeams) Label() string {
+	return "Microsoft Teams"
+}
+
+func (t *MSTeams) Icon() string {
+	return "message-square"
+}
+
+func (t *MSTeams) Description() string {
+	return "Send messages to Microsoft Teams channels"
+}
+
+func (t *MSTeams) Instructions() string {
+	return `To set up the Microsoft Teams integration:
+
+1. In Microsoft Teams, open the channel you want to post to
+2. Click **...** next to the channel name, and select **Workflows**
+3. Choose the **Post to a channel when a webhook request is received** template, and follow the steps
+4. Copy the webhook URL shown at the end, and paste it in the **Webhook URL** field below
+
+Incoming webhooks created with the older **Connectors** are also supported.`
+}
+
+func (t *MSTeams) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "webhookUrl",
+			Label:       "Webhook URL",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Incoming webhook URL of the Teams channel",
+		},
+	}
+}
+
+func (t *MSTeams) Components() []core.Component {
+	return []core.Component{
+		&PostMessage{},
+	}
+}
+
+func (t *MSTeams) Triggers() []core.Trigger {
+	return []core.Trigger{}
+}
+
+/*
+ * Teams webhooks have no endpoint to verify them without posting a message,
+ * so we only check the URL looks right.
+ */
+func (t *MSTeams) Sync(ctx core.SyncContext) error {
+	webhookURL, err := ctx.Integration.GetConfig("webhookUrl")
+	if err != nil || string(webhookURL) == "" {
+		return fmt.Errorf("webhookUrl is required")
+	}
+
+	if err := validateWebhookURL(string(webhookURL)); err != nil {
+		return err
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
+
+func validateWebhookURL(webhookURL string) error {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %v", err)
+	}
+
+	if parsed.Scheme != "https" || parsed.Host == "" {
+		return fmt.Errorf("webhook URL must be an https URL")
+	}
+
+	return nil
+}
+
+func (t *MSTeams) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op: Teams incoming webhooks don't send requests back
+}
+
+func (t *MSTeams) CompareWebhookConfig(a, b any) (bool, error) {
+	return true, nil
+}
+
+func (t *MSTeams) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (t *MSTeams) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (t *MSTeams) SetupWebhook(ctx core.SetupWebhookContext) (any, error) {
+	return nil, nil
+}
+
+func (t *MSTeams) CleanupWebhook(ctx core.CleanupWebhookContext) error {
+	return nil
+}
+
+func (t *MSTeams) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (t *MSTeams) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+package msteams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__MSTeams__Sync(t *testing.T) {
+	integration := &MSTeams{}
+
+	t.Run("missing webhook URL -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Integration: &contexts.IntegrationContext{Configuration: map[string]any{}},
+		})
+
+		require.ErrorContains(t, err, "webhookUrl is required")
+	})
+
+	t.Run("non https webhook URL -> error", func(t *testing.T) {
+		err := integration.Sync(core.SyncContext{
+			Integration: &contexts.IntegrationContext{
+				Configuration: map[string]any{"webhookUrl": "http://example.webhook.office.com/webhookb2/abc"},
+			},
+		})
+
+		require.ErrorContains(t, err, "must be an https URL")
+	})
+
+	t.Run("valid webhook URL -> ready", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{"webhookUrl": "https://example.webhook.office.com/webhookb2/abc"},
+		}
+
+		require.NoError(t, integration.Sync(core.SyncContext{Integration: integrationCtx}))
+		assert.Equal(t, "ready", integrationCtx.State)
+	})
+}
@@ -0,0 +1,263 @@
+package msteams
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MessageFormatText = "text"
+	MessageFormatCard = "card"
+
+	AdaptiveCardVersion = "1.4"
+)
+
+type PostMessage struct{}
+
+type PostMessageConfiguration struct {
+	Format string `json:"format" mapstructure:"format"`
+	Text   string `json:"text" mapstructure:"text"`
+	Card   any    `json:"card" mapstructure:"card"`
+}
+
+/*
+ * A subset of the adaptive card schema,
+ * enough to catch the mistakes Teams silently drops cards for.
+ */
+var adaptiveCardSchema = map[string]any{
+	"type":     "object",
+	"required": []any{"type", "version", "body"},
+	"properties": map[string]any{
+		"type":    map[string]any{"const": "AdaptiveCard"},
+		"version": map[string]any{"type": "string"},
+		"body": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type":     "object",
+				"required": []any{"type"},
+				"properties": map[string]any{
+					"type": map[string]any{"type": "string"},
+				},
+			},
+		},
+		"actions": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type":     "object",
+				"required": []any{"type"},
+			},
+		},
+	},
+}
+
+var cardField = configuration.Field{
+	Name:        "card",
+	Label:       "Adaptive Card",
+	Type:        configuration.FieldTypeJSON,
+	Description: "Adaptive card JSON, for example designed with the Adaptive Cards Designer",
+	TypeOptions: &configuration.TypeOptions{
+		JSON: &configuration.JSONTypeOptions{Schema: adaptiveCardSchema},
+	},
+	RequiredConditions: []configuration.RequiredCondition{
+		{Field: "format", Values: []string{MessageFormatCard}},
+	},
+	VisibilityConditions: []configuration.VisibilityCondition{
+		{Field: "format", Values: []string{MessageFormatCard}},
+	},
+}
+
+func (c *PostMessage) Name() string {
+	return "teams.postMessage"
+}
+
+func (c *PostMessage) Label() string {
+	return "Post Message"
+}
+
+func (c *PostMessage) Description() string {
+	return "Post a message to a Microsoft Teams channel"
+}
+
+func (c *PostMessage) Documentation() string {
+	return `The Post Message component posts a message to the Microsoft Teams channel of the integration's incoming webhook.
+
+## Use Cases
+
+- **Notifications**: Notify teams about deployments, releases, or workflow results
+- **Alerts**: Post alerts with rich formatting and links using adaptive cards
+
+## Configuration
+
+- **Format**: Post plain text, or an adaptive card
+- **Text**: The message text (supports expressions)
+- **Adaptive Card**: The adaptive card JSON (supports expressions). The card is checked against the adaptive card schema when the node is saved
+
+## Output
+
+Returns the HTTP status code and body of the webhook response.
+
+## Notes
+
+- Text messages are sent as a single text block adaptive card, which every kind of Teams webhook accepts
+- Messages are posted as the owner of the webhook`
+}
+
+func (c *PostMessage) Icon() string {
+	return "message-square"
+}
+
+func (c *PostMessage) Color() string {
+	return "gray"
+}
+
+func (c *PostMessage) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *PostMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "format",
+			Label:    "Format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  MessageFormatText,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Text", Value: MessageFormatText},
+						{Label: "Adaptive Card", Value: MessageFormatCard},
+					},
+				},
+			},
+		},
+		{
+			Name:  "text",
+			Label: "Text",
+			Type:  configuration.FieldTypeText,
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "format", Values: []string{MessageFormatText}},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "format", Values: []string{MessageFormatText}},
+			},
+		},
+		cardField,
+	}
+}
+
+func (c *PostMessage) Setup(ctx core.SetupContext) error {
+	var config PostMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if config.Format != "" && !slices.Contains([]string{MessageFormatText, MessageFormatCard}, config.Format) {
+		return fmt.Errorf("invalid format: %s", config.Format)
+	}
+
+	if config.Format != MessageFormatCard {
+		if config.Text == "" {
+			return errors.New("text is required")
+		}
+
+		return nil
+	}
+
+	if config.Card == nil || config.Card == "" {
+		return errors.New("card is required")
+	}
+
+	return configuration.ValidateConfiguration([]configuration.Field{cardField}, map[string]any{"card": config.Card})
+}
+
+func (c *PostMessage) Execute(ctx core.ExecutionContext) error {
+	var config PostMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	message, err := buildMessage(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to create Teams client: %w", err)
+	}
+
+	response, err := client.PostMessage(message)
+	if err != nil {
+		return fmt.Errorf("failed to post message: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"teams.message.sent",
+		[]any{response},
+	)
+}
+
+func buildMessage(config PostMessageConfiguration) (MessageRequest, error) {
+	if config.Format != MessageFormatCard {
+		if config.Text == "" {
+			return MessageRequest{}, errors.New("text is required")
+		}
+
+		return NewCardMessage(textCard(config.Text)), nil
+	}
+
+	parsed, err := configuration.ParseJSON(config.Card)
+	if err != nil {
+		return MessageRequest{}, fmt.Errorf("invalid card: %w", err)
+	}
+
+	card, ok := parsed.(map[string]any)
+	if !ok {
+		return MessageRequest{}, errors.New("card must be a JSON object")
+	}
+
+	return NewCardMessage(card), nil
+}
+
+func textCard(text string) map[string]any {
+	return map[string]any{
+		"type":    "AdaptiveCard",
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"version": AdaptiveCardVersion,
+		"body": []any{
+			map[string]any{"type": "TextBlock", "text": text, "wrap": true},
+		},
+	}
+}
+
+func (c *PostMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *PostMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *PostMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *PostMessage) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *PostMessage) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *PostMessage) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
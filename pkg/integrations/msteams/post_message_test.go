@@ -0,0 +1,141 @@
+package msteams
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+const testWebhookURL = "https://example.webhook.office.com/webhookb2/abc"
+
+func Test__PostMessage__Setup(t *testing.T) {
+	component := &PostMessage{}
+
+	setup := func(configuration map[string]any) error {
+		return component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: configuration,
+		})
+	}
+
+	t.Run("missing text -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"format": MessageFormatText}), "text is required")
+	})
+
+	t.Run("invalid format -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"format": "html", "text": "hi"}), "invalid format")
+	})
+
+	t.Run("missing card -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"format": MessageFormatCard}), "card is required")
+	})
+
+	t.Run("invalid card JSON -> error", func(t *testing.T) {
+		require.ErrorContains(t, setup(map[string]any{"format": MessageFormatCard, "card": `{"type": "AdaptiveCard"`}), "invalid JSON")
+	})
+
+	t.Run("card not matching the schema -> error", func(t *testing.T) {
+		err := setup(map[string]any{
+			"format": MessageFormatCard,
+			"card":   `{"type": "MessageCard", "version": "1.4", "body": [{"text": "hi"}]}`,
+		})
+
+		require.ErrorContains(t, err, "does not match schema")
+	})
+
+	t.Run("valid card -> no error", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{
+			"format": MessageFormatCard,
+			"card":   `{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock", "text": "hi"}]}`,
+		}))
+	})
+
+	t.Run("card with expressions -> no error", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{
+			"format": MessageFormatCard,
+			"card":   `{"type": "AdaptiveCard", "version": "1.4", "body": {{ $.data.blocks }}}`,
+		}))
+	})
+
+	t.Run("valid text -> no error", func(t *testing.T) {
+		require.NoError(t, setup(map[string]any{"text": "Deployed"}))
+	})
+}
+
+func Test__PostMessage__Execute(t *testing.T) {
+	component := &PostMessage{}
+	integrationCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{"webhookUrl": testWebhookURL},
+	}
+
+	t.Run("text is sent as an adaptive card", func(t *testing.T) {
+		httpCtx := &contexts.HTTPContext{Responses: []*http.Response{jsonResponse(http.StatusAccepted, "")}}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			HTTP:           httpCtx,
+			Integration:    integrationCtx,
+			ExecutionState: execState,
+			Configuration:  map[string]any{"format": MessageFormatText, "text": "Deployed"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpCtx.Requests, 1)
+		assert.Equal(t, testWebhookURL, httpCtx.Requests[0].URL.String())
+		message := decodeMessage(t, httpCtx.Requests[0])
+		assert.Equal(t, "message", message.Type)
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, AdaptiveCardContentType, message.Attachments[0].ContentType)
+		assert.Equal(t, "AdaptiveCard", message.Attachments[0].Content["type"])
+
+		assert.Equal(t, "teams.message.sent", execState.Type)
+		require.Len(t, execState.Payloads, 1)
+		payload := execState.Payloads[0].(map[string]any)
+		assert.Equal(t, &MessageResponse{StatusCode: http.StatusAccepted}, payload["data"])
+	})
+
+	t.Run("card is sent as is", func(t *testing.T) {
+		httpCtx := &contexts.HTTPContext{Responses: []*http.Response{jsonResponse(http.StatusOK, "1")}}
+		err := component.Execute(core.ExecutionContext{
+			HTTP:           httpCtx,
+			Integration:    integrationCtx,
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration: map[string]any{
+				"format": MessageFormatCard,
+				"card":   `{"type": "AdaptiveCard", "version": "1.5", "body": [{"type": "TextBlock", "text": "hi"}]}`,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpCtx.Requests, 1)
+		message := decodeMessage(t, httpCtx.Requests[0])
+		require.Len(t, message.Attachments, 1)
+		assert.Equal(t, "1.5", message.Attachments[0].Content["version"])
+	})
+
+	t.Run("webhook error -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			HTTP:           &contexts.HTTPContext{Responses: []*http.Response{jsonResponse(http.StatusBadRequest, "Bad payload")}},
+			Integration:    integrationCtx,
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"text": "Deployed"},
+		})
+
+		require.ErrorContains(t, err, "request failed with 400: Bad payload")
+	})
+
+	t.Run("throttled connector webhook -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			HTTP:           &contexts.HTTPContext{Responses: []*http.Response{jsonResponse(http.StatusOK, "Microsoft Teams endpoint returned HTTP error 429")}},
+			Integration:    integrationCtx,
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Configuration:  map[string]any{"text": "Deployed"},
+		})
+
+		require.ErrorContains(t, err, "HTTP error 429")
+	})
+}
@@ -0,0 +1,28 @@
+package msteams
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func decodeMessage(t *testing.T, req *http.Request) MessageRequest {
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+
+	var message MessageRequest
+	require.NoError(t, json.Unmarshal(body, &message))
+	return message
+}
@@ -0,0 +1,119 @@
+package opsgenie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type Client struct {
+	APIKey  string
+	BaseURL string
+	http    core.HTTPContext
+}
+
+func NewClient(http core.HTTPContext, ctx core.IntegrationContext) (*Client, error) {
+	apiKey, err := ctx.GetConfig("apiKey")
+	if err != nil {
+		return nil, fmt.Errorf("error getting apiKey: %v", err)
+	}
+
+	baseURL := "https://api.opsgenie.com"
+	region, err := ctx.GetConfig("region")
+	if err == nil && string(region) == RegionEU {
+		baseURL = "https://api.eu.opsgenie.com"
+	}
+
+	return &Client{
+		APIKey:  string(apiKey),
+		BaseURL: baseURL,
+		http:    http,
+	}, nil
+}
+
+func (c *Client) execRequest(method, url string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "GenieKey "+c.APIKey)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %v", err)
+	}
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading body: %v", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("request got %d code: %s", res.StatusCode, string(responseBody))
+	}
+
+	return responseBody, nil
+}
+
+// ValidateCredentials verifies that the API key is valid
+// by listing at most one alert.
+func (c *Client) ValidateCredentials() error {
+	url := fmt.Sprintf("%s/v2/alerts?limit=1", c.BaseURL)
+	_, err := c.execRequest(http.MethodGet, url, nil)
+	return err
+}
+
+// Responder is a team, user, escalation or schedule notified about an alert.
+type Responder struct {
+	Type     string `json:"type"`
+	Name     string `json:"name,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// CreateAlertRequest represents the request payload for creating an Opsgenie alert.
+type CreateAlertRequest struct {
+	Message     string      `json:"message"`
+	Alias       string      `json:"alias,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Priority    string      `json:"priority,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	Responders  []Responder `json:"responders,omitempty"`
+}
+
+// CreateAlertResponse represents the response from creating an alert.
+// Alerts are created asynchronously, so only the request ID is returned.
+type CreateAlertResponse struct {
+	Result    string  `json:"result"`
+	Took      float64 `json:"took"`
+	RequestID string  `json:"requestId"`
+}
+
+// CreateAlert creates a new alert in Opsgenie.
+func (c *Client) CreateAlert(req CreateAlertRequest) (*CreateAlertResponse, error) {
+	url := fmt.Sprintf("%s/v2/alerts", c.BaseURL)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %v", err)
+	}
+
+	responseBody, err := c.execRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateAlertResponse
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing response: %v", err)
+	}
+
+	return &response, nil
+}
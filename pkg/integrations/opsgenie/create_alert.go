@@ -0,0 +1,318 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MaxMessageLength = 130
+	MaxAliasLength   = 512
+)
+
+var (
+	priorities     = []string{"P1", "P2", "P3", "P4", "P5"}
+	responderTypes = []string{"team", "user", "escalation", "schedule"}
+)
+
+type CreateAlert struct{}
+
+type CreateAlertSpec struct {
+	Message     string          `json:"message" mapstructure:"message"`
+	Description string          `json:"description" mapstructure:"description"`
+	Priority    string          `json:"priority" mapstructure:"priority"`
+	Alias       string          `json:"alias" mapstructure:"alias"`
+	Tags        []string        `json:"tags" mapstructure:"tags"`
+	Responders  []ResponderSpec `json:"responders" mapstructure:"responders"`
+}
+
+type ResponderSpec struct {
+	Type string `json:"type" mapstructure:"type"`
+	Name string `json:"name" mapstructure:"name"`
+}
+
+func (c *CreateAlert) Name() string {
+	return "opsgenie.createAlert"
+}
+
+func (c *CreateAlert) Label() string {
+	return "Create Alert"
+}
+
+func (c *CreateAlert) Description() string {
+	return "Create a new alert in Opsgenie"
+}
+
+func (c *CreateAlert) Icon() string {
+	return "alert-triangle"
+}
+
+func (c *CreateAlert) Color() string {
+	return "gray"
+}
+
+func (c *CreateAlert) Documentation() string {
+	return `The Create Alert component creates a new alert in Opsgenie.
+
+## Use Cases
+
+- **Incident alerting**: Page the on-call team when a deployment or a health check fails
+- **Workflow notifications**: Alert responders about events that need attention
+
+## Configuration
+
+- **Message**: The alert message (max 130 characters)
+- **Description**: Optional alert description
+- **Priority**: P1 (critical) to P5 (informational). Defaults to P3
+- **Alias**: Optional alias used to deduplicate alerts. While an alert with the same alias is open, Opsgenie increases its count instead of creating a new alert
+- **Tags**: Optional tags for the alert
+- **Responders**: Optional teams, users, escalations, or schedules to notify. Users are identified by their username, the others by their name
+
+## Outputs
+
+Opsgenie processes alerts asynchronously, so the component emits an event containing:
+- ` + "`request_id`" + `: The ID of the request, to look up its status in Opsgenie
+- ` + "`result`" + `: The result message returned by Opsgenie
+- ` + "`message`" + `, ` + "`alias`" + ` and ` + "`priority`" + `: The alert that was requested
+`
+}
+
+func (c *CreateAlert) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateAlert) Configuration() []configuration.Field {
+	priorityOptions := []configuration.FieldOption{}
+	for _, priority := range priorities {
+		priorityOptions = append(priorityOptions, configuration.FieldOption{Label: priority, Value: priority})
+	}
+
+	return []configuration.Field{
+		{
+			Name:        "message",
+			Label:       "Message",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "The alert message (max 130 characters)",
+		},
+		{
+			Name:        "description",
+			Label:       "Description",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Description: "Optional alert description",
+		},
+		{
+			Name:     "priority",
+			Label:    "Priority",
+			Type:     configuration.FieldTypeSelect,
+			Required: false,
+			Default:  "P3",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: priorityOptions,
+				},
+			},
+		},
+		{
+			Name:        "alias",
+			Label:       "Alias",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Description: "Alerts with the same alias are deduplicated while open",
+			Placeholder: "e.g., deploy-failed-{{$.data.service}}",
+		},
+		{
+			Name:        "tags",
+			Label:       "Tags",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Description: "Tags for the alert",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Tag",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeString,
+					},
+				},
+			},
+		},
+		{
+			Name:        "responders",
+			Label:       "Responders",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Description: "Teams, users, escalations, or schedules to notify",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Responder",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "type",
+								Type:     configuration.FieldTypeSelect,
+								Label:    "Type",
+								Required: true,
+								Default:  "team",
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "Team", Value: "team"},
+											{Label: "User", Value: "user"},
+											{Label: "Escalation", Value: "escalation"},
+											{Label: "Schedule", Value: "schedule"},
+										},
+									},
+								},
+							},
+							{
+								Name:        "name",
+								Type:        configuration.FieldTypeString,
+								Label:       "Name",
+								Required:    true,
+								Placeholder: "e.g., SRE, or jane@example.com for users",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *CreateAlert) Setup(ctx core.SetupContext) error {
+	spec := CreateAlertSpec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("error decoding configuration: %v", err)
+	}
+
+	if spec.Message == "" {
+		return errors.New("message is required")
+	}
+
+	if spec.Priority != "" && !slices.Contains(priorities, spec.Priority) {
+		return fmt.Errorf("invalid priority %s: must be one of P1, P2, P3, P4, P5", spec.Priority)
+	}
+
+	if len(spec.Alias) > MaxAliasLength {
+		return fmt.Errorf("alias is too long: max %d characters", MaxAliasLength)
+	}
+
+	for _, responder := range spec.Responders {
+		if !slices.Contains(responderTypes, responder.Type) {
+			return fmt.Errorf("invalid responder type: %s", responder.Type)
+		}
+
+		if responder.Name == "" {
+			return errors.New("responder name is required")
+		}
+	}
+
+	return nil
+}
+
+func (c *CreateAlert) Execute(ctx core.ExecutionContext) error {
+	spec := CreateAlertSpec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("error decoding configuration: %v", err)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("error creating client: %v", err)
+	}
+
+	req := buildCreateAlertRequest(spec)
+	response, err := client.CreateAlert(req)
+	if err != nil {
+		return fmt.Errorf("failed to create alert: %v", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"opsgenie.alert",
+		[]any{map[string]any{
+			"request_id": response.RequestID,
+			"result":     response.Result,
+			"message":    req.Message,
+			"alias":      req.Alias,
+			"priority":   req.Priority,
+		}},
+	)
+}
+
+func buildCreateAlertRequest(spec CreateAlertSpec) CreateAlertRequest {
+	//
+	// Opsgenie rejects messages over 130 characters,
+	// so longer messages are truncated, and kept whole in the description.
+	//
+	message := spec.Message
+	description := spec.Description
+	if runes := []rune(message); len(runes) > MaxMessageLength {
+		if description == "" {
+			description = message
+		}
+
+		message = string(runes[:MaxMessageLength])
+	}
+
+	req := CreateAlertRequest{
+		Message:     message,
+		Description: description,
+		Alias:       spec.Alias,
+		Priority:    spec.Priority,
+	}
+
+	for _, tag := range spec.Tags {
+		trimmed := strings.TrimSpace(tag)
+		if trimmed != "" {
+			req.Tags = append(req.Tags, trimmed)
+		}
+	}
+
+	for _, responder := range spec.Responders {
+		if responder.Type == "user" {
+			req.Responders = append(req.Responders, Responder{Type: responder.Type, Username: responder.Name})
+			continue
+		}
+
+		req.Responders = append(req.Responders, Responder{Type: responder.Type, Name: responder.Name})
+	}
+
+	return req
+}
+
+func (c *CreateAlert) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateAlert) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateAlert) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateAlert) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateAlert) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *CreateAlert) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package opsgenie
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateAlert__Setup(t *testing.T) {
+	component := &CreateAlert{}
+
+	t.Run("invalid configuration -> decode error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: "invalid",
+		})
+
+		require.ErrorContains(t, err, "error decoding configuration")
+	})
+
+	t.Run("missing message -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"message": ""},
+		})
+
+		require.ErrorContains(t, err, "message is required")
+	})
+
+	t.Run("invalid priority -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{"message": "Deployment failed", "priority": "P0"},
+		})
+
+		require.ErrorContains(t, err, "invalid priority P0")
+	})
+
+	t.Run("invalid responder type -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"message":    "Deployment failed",
+				"responders": []any{map[string]any{"type": "group", "name": "SRE"}},
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid responder type: group")
+	})
+
+	t.Run("valid configuration -> success", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"message":    "Deployment failed",
+				"priority":   "P1",
+				"alias":      "deploy-failed",
+				"tags":       []any{"deploy"},
+				"responders": []any{map[string]any{"type": "team", "name": "SRE"}},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__CreateAlert__Execute(t *testing.T) {
+	component := &CreateAlert{}
+
+	t.Run("successful alert creation", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusAccepted,
+					Body:       io.NopCloser(strings.NewReader(`{"result":"Request will be processed","took":0.302,"requestId":"43a29c5c-3dbf-4fa4-9c26-f4f71023e120"}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "us",
+				"apiKey": "test-api-key",
+			},
+		}
+
+		executionState := &contexts.ExecutionStateContext{
+			KVs: make(map[string]string),
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"message":  "Deployment failed",
+				"priority": "P2",
+				"alias":    "deploy-failed-checkout",
+				"tags":     []any{"deploy", " "},
+				"responders": []any{
+					map[string]any{"type": "team", "name": "SRE"},
+					map[string]any{"type": "user", "name": "jane@example.com"},
+				},
+			},
+			HTTP:           httpContext,
+			Integration:    appCtx,
+			ExecutionState: executionState,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, executionState.Passed)
+		assert.Equal(t, "default", executionState.Channel)
+		assert.Equal(t, "opsgenie.alert", executionState.Type)
+
+		require.Len(t, httpContext.Requests, 1)
+		req := httpContext.Requests[0]
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "https://api.opsgenie.com/v2/alerts", req.URL.String())
+		assert.Equal(t, "GenieKey test-api-key", req.Header.Get("Authorization"))
+
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{
+			"message": "Deployment failed",
+			"alias": "deploy-failed-checkout",
+			"priority": "P2",
+			"tags": ["deploy"],
+			"responders": [
+				{"type": "team", "name": "SRE"},
+				{"type": "user", "username": "jane@example.com"}
+			]
+		}`, string(body))
+	})
+
+	t.Run("API error -> returns error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       io.NopCloser(strings.NewReader(`{"message":"Request body is not processable"}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "us",
+				"apiKey": "test-api-key",
+			},
+		}
+
+		executionState := &contexts.ExecutionStateContext{
+			KVs: make(map[string]string),
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"message": "Deployment failed"},
+			HTTP:           httpContext,
+			Integration:    appCtx,
+			ExecutionState: executionState,
+		})
+
+		require.ErrorContains(t, err, "failed to create alert")
+		assert.False(t, executionState.Passed)
+	})
+}
+
+func Test__BuildCreateAlertRequest(t *testing.T) {
+	t.Run("long message -> truncated, and kept in the description", func(t *testing.T) {
+		message := strings.Repeat("é", MaxMessageLength+10)
+		req := buildCreateAlertRequest(CreateAlertSpec{Message: message})
+		assert.Equal(t, strings.Repeat("é", MaxMessageLength), req.Message)
+		assert.Equal(t, message, req.Description)
+	})
+
+	t.Run("long message with description -> description is kept", func(t *testing.T) {
+		req := buildCreateAlertRequest(CreateAlertSpec{Message: strings.Repeat("a", MaxMessageLength+1), Description: "details"})
+		assert.Len(t, req.Message, MaxMessageLength)
+		assert.Equal(t, "details", req.Description)
+	})
+}
@@ -0,0 +1,18 @@
+package opsgenie
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/utils"
+)
+
+//go:embed example_output_create_alert.json
+var exampleOutputCreateAlertBytes []byte
+
+var exampleOutputCreateAlertOnce sync.Once
+var exampleOutputCreateAlert map[string]any
+
+func (c *CreateAlert) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateAlertOnce, exampleOutputCreateAlertBytes, &exampleOutputCreateAlert)
+}
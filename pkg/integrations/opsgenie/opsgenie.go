@@ -0,0 +1,155 @@
+package opsgenie
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	RegionUS = "us"
+	RegionEU = "eu"
+)
+
+const installationInstructions = `
+To configure Opsgenie to work with SuperPlane:
+
+1. **Create an API integration**: In Opsgenie, go to Settings > Integrations, and add an **API** integration
+2. **Set access rights**: Enable **Read** and **Create and Update** access for the integration
+3. **Copy the API key**: Copy the API key of the integration
+4. **Select Region**: Choose EU if your Opsgenie account is hosted in the EU (app.eu.opsgenie.com), otherwise US
+5. **Enter Credentials**: Provide the API key and region in the integration configuration
+`
+
+func init() {
+	registry.RegisterIntegration("opsgenie", &Opsgenie{})
+}
+
+type Opsgenie struct{}
+
+type Configuration struct {
+	APIKey string `json:"apiKey"`
+	Region string `json:"region"`
+}
+
+func (o *Opsgenie) Name() string {
+	return "opsgenie"
+}
+
+func (o *Opsgenie) Label() string {
+	return "Opsgenie"
+}
+
+func (o *Opsgenie) Icon() string {
+	return "alert-triangle"
+}
+
+func (o *Opsgenie) Description() string {
+	return "Create alerts in Opsgenie"
+}
+
+func (o *Opsgenie) Instructions() string {
+	return installationInstructions
+}
+
+func (o *Opsgenie) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "region",
+			Label:    "Region",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  RegionUS,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "US (api.opsgenie.com)", Value: RegionUS},
+						{Label: "EU (api.eu.opsgenie.com)", Value: RegionEU},
+					},
+				},
+			},
+		},
+		{
+			Name:        "apiKey",
+			Label:       "API Key",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "API key of an Opsgenie API integration",
+		},
+	}
+}
+
+func (o *Opsgenie) Components() []core.Component {
+	return []core.Component{
+		&CreateAlert{},
+	}
+}
+
+func (o *Opsgenie) Triggers() []core.Trigger {
+	return []core.Trigger{}
+}
+
+func (o *Opsgenie) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (o *Opsgenie) Sync(ctx core.SyncContext) error {
+	config := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode config: %v", err)
+	}
+
+	if config.APIKey == "" {
+		return fmt.Errorf("apiKey is required")
+	}
+
+	if config.Region != "" && config.Region != RegionUS && config.Region != RegionEU {
+		return fmt.Errorf("invalid region: %s", config.Region)
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("error creating client: %v", err)
+	}
+
+	err = client.ValidateCredentials()
+	if err != nil {
+		return fmt.Errorf("invalid credentials: %v", err)
+	}
+
+	ctx.Integration.Ready()
+	return nil
+}
+
+func (o *Opsgenie) HandleRequest(ctx core.HTTPRequestContext) {
+	// no-op - Opsgenie has no triggers yet
+}
+
+func (o *Opsgenie) CleanupWebhook(ctx core.CleanupWebhookContext) error {
+	return nil
+}
+
+func (o *Opsgenie) CompareWebhookConfig(a, b any) (bool, error) {
+	return true, nil
+}
+
+func (o *Opsgenie) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return []core.IntegrationResource{}, nil
+}
+
+func (o *Opsgenie) SetupWebhook(ctx core.SetupWebhookContext) (any, error) {
+	return nil, nil
+}
+
+func (o *Opsgenie) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (o *Opsgenie) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
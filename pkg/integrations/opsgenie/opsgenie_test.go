@@ -0,0 +1,107 @@
+package opsgenie
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Opsgenie__Sync(t *testing.T) {
+	o := &Opsgenie{}
+
+	t.Run("no apiKey -> error", func(t *testing.T) {
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "us",
+				"apiKey": "",
+			},
+		}
+
+		err := o.Sync(core.SyncContext{
+			Configuration: appCtx.Configuration,
+			Integration:   appCtx,
+		})
+
+		require.ErrorContains(t, err, "apiKey is required")
+	})
+
+	t.Run("invalid region -> error", func(t *testing.T) {
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "apac",
+				"apiKey": "test-api-key",
+			},
+		}
+
+		err := o.Sync(core.SyncContext{
+			Configuration: appCtx.Configuration,
+			Integration:   appCtx,
+		})
+
+		require.ErrorContains(t, err, "invalid region: apac")
+	})
+
+	t.Run("successful validation -> ready", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data": []}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "eu",
+				"apiKey": "test-api-key",
+			},
+		}
+
+		err := o.Sync(core.SyncContext{
+			Configuration: appCtx.Configuration,
+			HTTP:          httpContext,
+			Integration:   appCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ready", appCtx.State)
+
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, "https://api.eu.opsgenie.com/v2/alerts?limit=1", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "GenieKey test-api-key", httpContext.Requests[0].Header.Get("Authorization"))
+	})
+
+	t.Run("invalid API key -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusUnauthorized,
+					Body:       io.NopCloser(strings.NewReader(`{"message": "Could not authenticate"}`)),
+				},
+			},
+		}
+
+		appCtx := &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"region": "us",
+				"apiKey": "invalid",
+			},
+		}
+
+		err := o.Sync(core.SyncContext{
+			Configuration: appCtx.Configuration,
+			HTTP:          httpContext,
+			Integration:   appCtx,
+		})
+
+		require.ErrorContains(t, err, "invalid credentials")
+		assert.NotEqual(t, "ready", appCtx.State)
+	})
+}
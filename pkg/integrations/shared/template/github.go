@@ -0,0 +1,220 @@
+package template
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/expr-lang/expr"
+)
+
+/*
+ * Functions for formatting GitHub data in comment bodies.
+ * They are available in templates of GitHub components,
+ * and in {{ }} expressions, through GitHubExprFunctions().
+ */
+var GitHubFuncs = texttemplate.FuncMap{
+	"shortSha":     shortSHA,
+	"prUrl":        pullRequestURL,
+	"mentionUser":  mentionUser,
+	"relativeTime": relativeTime,
+}
+
+/*
+ * GitHubExprFunctions exposes GitHubFuncs to {{ }} expressions,
+ * like {{ shortSha($["On Push"].data.after) }}.
+ * Expression values are untyped, so arguments are checked when the functions are called.
+ */
+func GitHubExprFunctions() []expr.Option {
+	return []expr.Option{
+		expr.Function("shortSha", func(params ...any) (any, error) {
+			args, err := stringArguments("shortSha", params, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return shortSHA(args[0]), nil
+		}),
+		expr.Function("prUrl", func(params ...any) (any, error) {
+			if len(params) != 2 {
+				return nil, fmt.Errorf("prUrl() takes 2 arguments")
+			}
+
+			args, err := stringArguments("prUrl", params[:1], 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return pullRequestURL(args[0], params[1])
+		}),
+		expr.Function("mentionUser", func(params ...any) (any, error) {
+			args, err := stringArguments("mentionUser", params, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return mentionUser(args[0]), nil
+		}),
+		expr.Function("relativeTime", func(params ...any) (any, error) {
+			if len(params) != 1 {
+				return nil, fmt.Errorf("relativeTime() takes 1 argument")
+			}
+
+			return relativeTime(params[0])
+		}),
+	}
+}
+
+func stringArguments(name string, params []any, count int) ([]string, error) {
+	if len(params) != count {
+		return nil, fmt.Errorf("%s() takes %d argument(s)", name, count)
+	}
+
+	args := make([]string, 0, count)
+	for _, param := range params {
+		arg, ok := param.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s() takes a string, got %v", name, param)
+		}
+
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
+
+/*
+ * now is replaced in tests, so relative times are stable.
+ */
+var now = time.Now
+
+const shortSHALength = 7
+
+/*
+ * shortSHA returns the abbreviated commit SHA, as GitHub shows it.
+ */
+func shortSHA(sha string) string {
+	if len(sha) <= shortSHALength {
+		return sha
+	}
+
+	return sha[:shortSHALength]
+}
+
+/*
+ * pullRequestURL builds the URL of a pull request from the full repository name, like "octocat/hello".
+ * Event data is JSON, so the number is usually a float64.
+ */
+func pullRequestURL(repository string, number any) (string, error) {
+	if strings.Count(repository, "/") != 1 {
+		return "", fmt.Errorf("repository %q is not in owner/name format", repository)
+	}
+
+	n, err := pullRequestNumber(number)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://github.com/%s/pull/%d", repository, n), nil
+}
+
+func pullRequestNumber(number any) (int64, error) {
+	switch v := number.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("pull request number %v is not a whole number", v)
+		}
+
+		return int64(v), nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("pull request number %q is not a number", v)
+		}
+
+		return n, nil
+	default:
+		return 0, fmt.Errorf("unsupported pull request number %v", number)
+	}
+}
+
+/*
+ * mentionUser returns the @mention of a login, so it works with logins that already have the "@".
+ */
+func mentionUser(login string) string {
+	return "@" + strings.TrimPrefix(login, "@")
+}
+
+/*
+ * relativeTime describes how long ago, or in how long, a time is,
+ * using the largest whole unit, like "3 hours ago" or "in 2 days".
+ */
+func relativeTime(value any) (string, error) {
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return "", fmt.Errorf("time %q is not in RFC 3339 format", v)
+		}
+
+		t = parsed
+	default:
+		return "", fmt.Errorf("unsupported time value %v", value)
+	}
+
+	diff := now().Sub(t)
+	future := diff < 0
+	if future {
+		diff = -diff
+	}
+
+	if diff < time.Minute {
+		return "just now", nil
+	}
+
+	description := describeDuration(diff)
+	if future {
+		return "in " + description, nil
+	}
+
+	return description + " ago", nil
+}
+
+func describeDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	units := []struct {
+		name   string
+		length time.Duration
+	}{
+		{"year", 365 * day},
+		{"month", 30 * day},
+		{"day", day},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	for _, unit := range units {
+		count := int64(d / unit.length)
+		if count == 0 {
+			continue
+		}
+
+		if count == 1 {
+			return "1 " + unit.name
+		}
+
+		return fmt.Sprintf("%d %ss", count, unit.name)
+	}
+
+	return "0 minutes"
+}
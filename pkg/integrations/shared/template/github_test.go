@@ -0,0 +1,106 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__GitHubFuncs(t *testing.T) {
+	data := map[string]any{
+		"data": map[string]any{
+			"repository":   map[string]any{"full_name": "testhq/hello"},
+			"number":       float64(42),
+			"after":        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"sender":       map[string]any{"login": "octocat"},
+			"created_at":   "2026-01-16T10:00:00Z",
+			"scheduled_at": "2026-01-18T14:00:00Z",
+		},
+	}
+
+	originalNow := now
+	now = func() time.Time { return time.Date(2026, 1, 16, 13, 30, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = originalNow })
+
+	t.Run("shortSha", func(t *testing.T) {
+		output, err := Render("body", `{{ shortSha .data.after }}`, data, GitHubFuncs)
+		require.NoError(t, err)
+		assert.Equal(t, "6dcb09b", output)
+		assert.Equal(t, "abc", shortSHA("abc"))
+	})
+
+	t.Run("prUrl", func(t *testing.T) {
+		output, err := Render("body", `{{ prUrl .data.repository.full_name .data.number }}`, data, GitHubFuncs)
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/testhq/hello/pull/42", output)
+
+		url, err := pullRequestURL("testhq/hello", "7")
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/testhq/hello/pull/7", url)
+
+		_, err = pullRequestURL("hello", 7)
+		require.ErrorContains(t, err, `repository "hello" is not in owner/name format`)
+
+		_, err = pullRequestURL("testhq/hello", 7.5)
+		require.ErrorContains(t, err, "is not a whole number")
+	})
+
+	t.Run("mentionUser", func(t *testing.T) {
+		output, err := Render("body", `{{ mentionUser .data.sender.login }}`, data, GitHubFuncs)
+		require.NoError(t, err)
+		assert.Equal(t, "@octocat", output)
+		assert.Equal(t, "@octocat", mentionUser("@octocat"))
+	})
+
+	t.Run("relativeTime", func(t *testing.T) {
+		output, err := Render("body", `{{ relativeTime .data.created_at }}, {{ relativeTime .data.scheduled_at }}`, data, GitHubFuncs)
+		require.NoError(t, err)
+		assert.Equal(t, "3 hours ago, in 2 days", output)
+
+		output, err = relativeTime(now().Add(-30 * time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, "just now", output)
+
+		output, err = relativeTime(now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, "1 minute ago", output)
+
+		_, err = relativeTime("yesterday")
+		require.ErrorContains(t, err, `time "yesterday" is not in RFC 3339 format`)
+	})
+
+	t.Run("expressions", func(t *testing.T) {
+		env := map[string]any{"$": map[string]any{"On Push": data}}
+		options := append([]expr.Option{expr.Env(env), expr.AsAny()}, GitHubExprFunctions()...)
+
+		vm, err := expr.Compile(`shortSha($["On Push"].data.after) + " " + prUrl($["On Push"].data.repository.full_name, $["On Push"].data.number)`, options...)
+		require.NoError(t, err)
+		output, err := expr.Run(vm, env)
+		require.NoError(t, err)
+		assert.Equal(t, "6dcb09b https://github.com/testhq/hello/pull/42", output)
+
+		vm, err = expr.Compile(`mentionUser($["On Push"].data.sender.login) + ", " + relativeTime($["On Push"].data.created_at)`, options...)
+		require.NoError(t, err)
+		output, err = expr.Run(vm, env)
+		require.NoError(t, err)
+		assert.Equal(t, "@octocat, 3 hours ago", output)
+
+		vm, err = expr.Compile(`shortSha($["On Push"].data.number)`, options...)
+		require.NoError(t, err)
+		_, err = expr.Run(vm, env)
+		require.ErrorContains(t, err, "shortSha() takes a string")
+
+		vm, err = expr.Compile(`prUrl("testhq/hello")`, options...)
+		require.NoError(t, err)
+		_, err = expr.Run(vm, env)
+		require.ErrorContains(t, err, "prUrl() takes 2 arguments")
+	})
+
+	t.Run("not available without GitHubFuncs", func(t *testing.T) {
+		_, err := Parse("body", `{{ shortSha .data.after }}`)
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+	})
+}
@@ -0,0 +1,122 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+/*
+ * Errors returned by Parse and Render wrap one of these,
+ * so components can tell configuration errors,
+ * which should be reported at Setup(), from errors that depend on the execution data.
+ */
+var (
+	ErrInvalidTemplate = errors.New("invalid template")
+	ErrRenderFailed    = errors.New("failed to render template")
+)
+
+/*
+ * Functions available in all templates.
+ */
+var funcs = texttemplate.FuncMap{
+	"json":     toJSON,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"truncate": truncate,
+	"date":     formatDate,
+}
+
+/*
+ * Parse parses a Go text/template with the shared function set,
+ * and the extra functions given, like GitHubFuncs.
+ * Referencing missing keys is an error when rendering,
+ * so typos do not silently produce empty values.
+ */
+func Parse(name, text string, extraFuncs ...texttemplate.FuncMap) (*texttemplate.Template, error) {
+	tmpl := texttemplate.New(name).Funcs(funcs)
+	for _, extra := range extraFuncs {
+		tmpl = tmpl.Funcs(extra)
+	}
+
+	tmpl, err := tmpl.Option("missingkey=error").Parse(text)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	return tmpl, nil
+}
+
+/*
+ * Render parses the template and executes it against data.
+ */
+func Render(name, text string, data any, extraFuncs ...texttemplate.FuncMap) (string, error) {
+	tmpl, err := Parse(name, text, extraFuncs...)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	err = tmpl.Execute(&output, data)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrRenderFailed, err)
+	}
+
+	return output.String(), nil
+}
+
+func toJSON(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+/*
+ * truncate cuts the text to at most length characters,
+ * ending it with "..." when it is cut.
+ */
+func truncate(length int, text string) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+
+	if length <= 3 {
+		return string(runes[:length])
+	}
+
+	return string(runes[:length-3]) + "..."
+}
+
+/*
+ * formatDate formats a time using a Go layout.
+ * Event data is JSON, so times are usually RFC 3339 strings.
+ */
+func formatDate(layout string, value any) (string, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(layout), nil
+	case *time.Time:
+		if v == nil {
+			return "", nil
+		}
+
+		return v.Format(layout), nil
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return "", fmt.Errorf("date %q is not in RFC 3339 format", v)
+		}
+
+		return t.Format(layout), nil
+	default:
+		return "", fmt.Errorf("unsupported date value %v", value)
+	}
+}
@@ -0,0 +1,48 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Render(t *testing.T) {
+	data := map[string]any{
+		"data": map[string]any{
+			"title":  "Fix flaky build",
+			"labels": []any{"bug", "ci"},
+		},
+	}
+
+	t.Run("renders data and functions", func(t *testing.T) {
+		output, err := Render("body", `{{ upper .data.title }} {{ json .data.labels }} {{ truncate 8 .data.title }}`, data)
+		require.NoError(t, err)
+		assert.Equal(t, `FIX FLAKY BUILD ["bug","ci"] Fix f...`, output)
+	})
+
+	t.Run("formats dates", func(t *testing.T) {
+		now := time.Now().UTC()
+		output, err := Render("body", `{{ date "2006-01-02" .at }}`, map[string]any{"at": now.Format(time.RFC3339)})
+		require.NoError(t, err)
+		assert.Equal(t, now.Format("2006-01-02"), output)
+	})
+
+	t.Run("syntax error -> invalid template", func(t *testing.T) {
+		_, err := Render("body", `{{ .data.title `, data)
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+		require.NotErrorIs(t, err, ErrRenderFailed)
+	})
+
+	t.Run("unknown function -> invalid template", func(t *testing.T) {
+		_, err := Parse("body", `{{ shout .data.title }}`)
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+	})
+
+	t.Run("missing key -> render error", func(t *testing.T) {
+		_, err := Render("body", `{{ .data.missing }}`, data)
+		require.ErrorIs(t, err, ErrRenderFailed)
+		require.NotErrorIs(t, err, ErrInvalidTemplate)
+	})
+}
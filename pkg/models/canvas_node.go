@@ -188,6 +188,7 @@ func ListCanvasNodesReady() ([]CanvasNode, error) {
 		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
 		Where("workflow_nodes.state = ?", CanvasNodeStateReady).
 		Where("workflow_nodes.type IN ?", []string{NodeTypeComponent, NodeTypeBlueprint}).
+		Where("workflow_node_queue_items.run_at IS NULL OR workflow_node_queue_items.run_at <= ?", time.Now()).
 		Where("workflows.deleted_at IS NULL").
 		Find(&nodes).
 		Error
@@ -320,6 +321,14 @@ type CanvasNodeQueueItem struct {
 	// which holds the input for this queue item.
 	//
 	EventID uuid.UUID
+
+	//
+	// Set when the component reschedules the queue item.
+	// The item is not processed again before RunAt,
+	// and RescheduleAttempts counts how many times it was rescheduled.
+	//
+	RescheduleAttempts int
+	RunAt              *time.Time
 }
 
 func (i *CanvasNodeQueueItem) TableName() string {
@@ -330,6 +339,28 @@ func (i *CanvasNodeQueueItem) Delete(tx *gorm.DB) error {
 	return tx.Delete(i).Error
 }
 
+func (i *CanvasNodeQueueItem) IsDue(now time.Time) bool {
+	return i.RunAt == nil || !now.Before(*i.RunAt)
+}
+
+func (i *CanvasNodeQueueItem) Reschedule(tx *gorm.DB, runAt time.Time) error {
+	attempts := i.RescheduleAttempts + 1
+	err := tx.Model(i).
+		Updates(map[string]any{
+			"reschedule_attempts": attempts,
+			"run_at":              runAt,
+		}).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	i.RescheduleAttempts = attempts
+	i.RunAt = &runAt
+	return nil
+}
+
 func ListNodeQueueItems(workflowID uuid.UUID, nodeID string, limit int, beforeTime *time.Time) ([]CanvasNodeQueueItem, error) {
 	var queueItems []CanvasNodeQueueItem
 	query := database.Conn().
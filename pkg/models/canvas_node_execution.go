@@ -372,6 +372,21 @@ func (e *CanvasNodeExecution) StartInTransaction(tx *gorm.DB) error {
 		Error
 }
 
+/*
+ * Puts a started execution back to pending,
+ * so the executor runs it again.
+ */
+func (e *CanvasNodeExecution) RetryInTransaction(tx *gorm.DB) error {
+	if e.State != CanvasNodeExecutionStateStarted {
+		return fmt.Errorf("cannot retry execution %s in state %s", e.ID, e.State)
+	}
+
+	return tx.Model(e).
+		Update("state", CanvasNodeExecutionStatePending).
+		Update("updated_at", time.Now()).
+		Error
+}
+
 func (e *CanvasNodeExecution) Pass(outputs map[string][]any) ([]CanvasEvent, error) {
 	var events []CanvasEvent
 	err := database.Conn().Transaction(func(tx *gorm.DB) error {
@@ -392,26 +407,9 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	// Create events for outputs
 	//
-	events := []CanvasEvent{}
-	for channel, outputs := range channelOutputs {
-		for _, event := range outputs {
-			events = append(events, CanvasEvent{
-				WorkflowID:  e.WorkflowID,
-				NodeID:      e.NodeID,
-				Channel:     channel,
-				Data:        datatypes.NewJSONType(event),
-				ExecutionID: &e.ID,
-				State:       CanvasEventStatePending,
-				CreatedAt:   &now,
-			})
-		}
-	}
-
-	if len(events) > 0 {
-		err := tx.Create(&events).Error
-		if err != nil {
-			return nil, fmt.Errorf("failed to create events: %w", err)
-		}
+	events, err := e.CreateOutputsInTransaction(tx, channelOutputs)
+	if err != nil {
+		return nil, err
 	}
 
 	//
@@ -448,6 +446,37 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	return events, nil
 }
 
+/*
+ * Creates the events for the outputs of the execution,
+ * without changing its state.
+ */
+func (e *CanvasNodeExecution) CreateOutputsInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
+	now := time.Now()
+	events := []CanvasEvent{}
+	for channel, outputs := range channelOutputs {
+		for _, event := range outputs {
+			events = append(events, CanvasEvent{
+				WorkflowID:  e.WorkflowID,
+				NodeID:      e.NodeID,
+				Channel:     channel,
+				Data:        datatypes.NewJSONType(event),
+				ExecutionID: &e.ID,
+				State:       CanvasEventStatePending,
+				CreatedAt:   &now,
+			})
+		}
+	}
+
+	if len(events) > 0 {
+		err := tx.Create(&events).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to create events: %w", err)
+		}
+	}
+
+	return events, nil
+}
+
 func (e *CanvasNodeExecution) Fail(reason, message string) error {
 	return database.Conn().Transaction(func(tx *gorm.DB) error {
 		return e.FailInTransaction(tx, reason, message)
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// CanvasNodeExecutionIdempotencyKey records that an execution is about to cause
+// a side effect, and its result once it did.
+//
+// Executions run inside a transaction that is rolled back if the process dies,
+// so these records are written with their own connection, and committed right away.
+// That way, the next attempt of the execution knows what the previous one did.
+//
+
+type CanvasNodeExecutionIdempotencyKey struct {
+	ExecutionID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Key         string    `gorm:"type:varchar(64);primaryKey"`
+	WorkflowID  uuid.UUID `gorm:"type:uuid;not null"`
+	NodeID      string    `gorm:"type:varchar(128);not null"`
+	Result      datatypes.JSON
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+func (c *CanvasNodeExecutionIdempotencyKey) TableName() string {
+	return "workflow_node_execution_idempotency_keys"
+}
+
+/*
+ * Records the key for the execution, unless an earlier attempt already did.
+ * Returns the record, and whether it already existed.
+ */
+func ClaimNodeExecutionIdempotencyKey(workflowID uuid.UUID, nodeID string, executionID uuid.UUID, key string) (*CanvasNodeExecutionIdempotencyKey, bool, error) {
+	return ClaimNodeExecutionIdempotencyKeyInTransaction(database.Conn(), workflowID, nodeID, executionID, key)
+}
+
+func ClaimNodeExecutionIdempotencyKeyInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string, executionID uuid.UUID, key string) (*CanvasNodeExecutionIdempotencyKey, bool, error) {
+	now := time.Now()
+	record := CanvasNodeExecutionIdempotencyKey{
+		ExecutionID: executionID,
+		Key:         key,
+		WorkflowID:  workflowID,
+		NodeID:      nodeID,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&record)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+
+	if result.RowsAffected == 1 {
+		return &record, false, nil
+	}
+
+	existing, err := FindNodeExecutionIdempotencyKeyInTransaction(tx, executionID, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return existing, true, nil
+}
+
+func FindNodeExecutionIdempotencyKeyInTransaction(tx *gorm.DB, executionID uuid.UUID, key string) (*CanvasNodeExecutionIdempotencyKey, error) {
+	var record CanvasNodeExecutionIdempotencyKey
+	err := tx.
+		Where("execution_id = ?", executionID).
+		Where("key = ?", key).
+		First(&record).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func CompleteNodeExecutionIdempotencyKey(executionID uuid.UUID, key string, result []byte) error {
+	return CompleteNodeExecutionIdempotencyKeyInTransaction(database.Conn(), executionID, key, result)
+}
+
+func CompleteNodeExecutionIdempotencyKeyInTransaction(tx *gorm.DB, executionID uuid.UUID, key string, result []byte) error {
+	return tx.
+		Model(&CanvasNodeExecutionIdempotencyKey{}).
+		Where("execution_id = ?", executionID).
+		Where("key = ?", key).
+		Updates(map[string]any{"result": datatypes.JSON(result), "updated_at": time.Now()}).
+		Error
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func Test__CanvasNodeExecutionIdempotencyKey(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	workflowID := uuid.New()
+
+	t.Run("first claim records the key", func(t *testing.T) {
+		executionID := uuid.New()
+		record, existing, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", executionID, "key-1")
+		require.NoError(t, err)
+		assert.False(t, existing)
+		assert.Equal(t, executionID, record.ExecutionID)
+		assert.Empty(t, record.Result)
+	})
+
+	t.Run("second claim finds the key, with the recorded result", func(t *testing.T) {
+		executionID := uuid.New()
+		_, _, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", executionID, "key-1")
+		require.NoError(t, err)
+		require.NoError(t, CompleteNodeExecutionIdempotencyKey(executionID, "key-1", []byte(`{"id":1}`)))
+
+		record, existing, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", executionID, "key-1")
+		require.NoError(t, err)
+		assert.True(t, existing)
+		assert.JSONEq(t, `{"id":1}`, string(record.Result))
+	})
+
+	t.Run("keys are recorded per execution", func(t *testing.T) {
+		_, _, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", uuid.New(), "key-2")
+		require.NoError(t, err)
+
+		_, existing, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", uuid.New(), "key-2")
+		require.NoError(t, err)
+		assert.False(t, existing)
+	})
+
+	t.Run("claim is kept when the transaction of the execution is rolled back", func(t *testing.T) {
+		executionID := uuid.New()
+		tx := database.Conn().Begin()
+		_, _, err := ClaimNodeExecutionIdempotencyKey(workflowID, "node-1", executionID, "key-3")
+		require.NoError(t, err)
+		require.NoError(t, tx.Rollback().Error)
+
+		_, err = FindNodeExecutionIdempotencyKeyInTransaction(database.Conn(), executionID, "key-3")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// ConcurrencyLock records who holds a concurrency key.
+// Locks are written with their own connection, not within
+// the execution transaction, so other instances see them right away.
+// Locks expire, so a holder that crashes does not keep the key forever.
+//
+
+type ConcurrencyLock struct {
+	LockKey   string
+	Holder    string
+	ExpiresAt *time.Time
+	CreatedAt *time.Time
+}
+
+func (l *ConcurrencyLock) TableName() string {
+	return "concurrency_locks"
+}
+
+/*
+ * Acquires the lock for the key, and returns false if someone else holds it.
+ * The holder acquiring a key it already holds extends the lease,
+ * and expired locks are taken over.
+ */
+func AcquireConcurrencyLock(key, holder string, lease time.Duration) (bool, error) {
+	return AcquireConcurrencyLockInTransaction(database.Conn(), key, holder, lease)
+}
+
+func AcquireConcurrencyLockInTransaction(tx *gorm.DB, key, holder string, lease time.Duration) (bool, error) {
+	now := time.Now()
+	expiresAt := now.Add(lease)
+	lock := ConcurrencyLock{
+		LockKey:   key,
+		Holder:    holder,
+		ExpiresAt: &expiresAt,
+		CreatedAt: &now,
+	}
+
+	result := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "lock_key"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"holder":     holder,
+			"expires_at": expiresAt,
+			"created_at": now,
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Or(
+				clause.Lt{Column: clause.Column{Table: "concurrency_locks", Name: "expires_at"}, Value: now},
+				clause.Eq{Column: clause.Column{Table: "concurrency_locks", Name: "holder"}, Value: holder},
+			),
+		}},
+	}).Create(&lock)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func ReleaseConcurrencyLock(key, holder string) error {
+	return ReleaseConcurrencyLockInTransaction(database.Conn(), key, holder)
+}
+
+func ReleaseConcurrencyLockInTransaction(tx *gorm.DB, key, holder string) error {
+	return tx.
+		Where("lock_key = ?", key).
+		Where("holder = ?", holder).
+		Delete(&ConcurrencyLock{}).
+		Error
+}
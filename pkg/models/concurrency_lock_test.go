@@ -0,0 +1,65 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func Test__ConcurrencyLock(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	t.Run("lock can only be held by one holder", func(t *testing.T) {
+		acquired, err := AcquireConcurrencyLock("testhq/hello", "execution-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = AcquireConcurrencyLock("testhq/hello", "execution-2", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		//
+		// The holder can acquire it again, and other keys are not affected.
+		//
+		acquired, err = AcquireConcurrencyLock("testhq/hello", "execution-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = AcquireConcurrencyLock("testhq/world", "execution-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("released lock can be acquired by someone else", func(t *testing.T) {
+		acquired, err := AcquireConcurrencyLock("testhq/release", "execution-1", time.Hour)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		//
+		// Only the holder releases the lock.
+		//
+		require.NoError(t, ReleaseConcurrencyLock("testhq/release", "execution-2"))
+		acquired, err = AcquireConcurrencyLock("testhq/release", "execution-2", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		require.NoError(t, ReleaseConcurrencyLock("testhq/release", "execution-1"))
+		acquired, err = AcquireConcurrencyLock("testhq/release", "execution-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("expired lock can be acquired by someone else", func(t *testing.T) {
+		acquired, err := AcquireConcurrencyLock("testhq/expired", "execution-1", 10*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		time.Sleep(20 * time.Millisecond)
+		acquired, err = AcquireConcurrencyLock("testhq/expired", "execution-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
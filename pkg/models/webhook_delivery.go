@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// WebhookDelivery records that a node handled a webhook delivery,
+// so a retried delivery does not run the node again.
+// Records are kept per node, so when one node fails,
+// the retry only runs the nodes that did not handle the delivery yet.
+//
+
+type WebhookDelivery struct {
+	WebhookID  uuid.UUID
+	DeliveryID string
+	WorkflowID uuid.UUID
+	NodeID     string
+	CreatedAt  *time.Time
+}
+
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+/*
+ * Records the delivery for the node, and returns false if it was already recorded.
+ * Records older than the TTL are claimed again, since the delivery
+ * can no longer be a retry of the one that created them.
+ */
+func ClaimWebhookDelivery(webhookID uuid.UUID, deliveryID string, workflowID uuid.UUID, nodeID string, ttl time.Duration) (bool, error) {
+	return ClaimWebhookDeliveryInTransaction(database.Conn(), webhookID, deliveryID, workflowID, nodeID, ttl)
+}
+
+func ClaimWebhookDeliveryInTransaction(tx *gorm.DB, webhookID uuid.UUID, deliveryID string, workflowID uuid.UUID, nodeID string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	delivery := WebhookDelivery{
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+		WorkflowID: workflowID,
+		NodeID:     nodeID,
+		CreatedAt:  &now,
+	}
+
+	result := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "webhook_id"},
+			{Name: "delivery_id"},
+			{Name: "workflow_id"},
+			{Name: "node_id"},
+		},
+		DoUpdates: clause.Assignments(map[string]any{"created_at": now}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Lt{Column: clause.Column{Table: "webhook_deliveries", Name: "created_at"}, Value: now.Add(-ttl)},
+		}},
+	}).Create(&delivery)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func ReleaseWebhookDelivery(webhookID uuid.UUID, deliveryID string, workflowID uuid.UUID, nodeID string) error {
+	return ReleaseWebhookDeliveryInTransaction(database.Conn(), webhookID, deliveryID, workflowID, nodeID)
+}
+
+func ReleaseWebhookDeliveryInTransaction(tx *gorm.DB, webhookID uuid.UUID, deliveryID string, workflowID uuid.UUID, nodeID string) error {
+	return tx.
+		Where("webhook_id = ?", webhookID).
+		Where("delivery_id = ?", deliveryID).
+		Where("workflow_id = ?", workflowID).
+		Where("node_id = ?", nodeID).
+		Delete(&WebhookDelivery{}).
+		Error
+}
+
+func DeleteWebhookDeliveriesBefore(before time.Time) error {
+	return database.Conn().
+		Where("created_at < ?", before).
+		Delete(&WebhookDelivery{}).
+		Error
+}
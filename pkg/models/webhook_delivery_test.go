@@ -0,0 +1,74 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+func Test__WebhookDelivery(t *testing.T) {
+	require.NoError(t, database.TruncateTables())
+
+	webhookID := uuid.New()
+	workflowID := uuid.New()
+
+	t.Run("delivery can only be claimed once per node", func(t *testing.T) {
+		claimed, err := ClaimWebhookDelivery(webhookID, "delivery-1", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = ClaimWebhookDelivery(webhookID, "delivery-1", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = ClaimWebhookDelivery(webhookID, "delivery-1", workflowID, "node-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = ClaimWebhookDelivery(webhookID, "delivery-2", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("released delivery can be claimed again, other nodes keep theirs", func(t *testing.T) {
+		_, err := ClaimWebhookDelivery(webhookID, "delivery-3", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		_, err = ClaimWebhookDelivery(webhookID, "delivery-3", workflowID, "node-2", time.Hour)
+		require.NoError(t, err)
+
+		require.NoError(t, ReleaseWebhookDelivery(webhookID, "delivery-3", workflowID, "node-2"))
+
+		claimed, err := ClaimWebhookDelivery(webhookID, "delivery-3", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = ClaimWebhookDelivery(webhookID, "delivery-3", workflowID, "node-2", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("expired delivery can be claimed again", func(t *testing.T) {
+		_, err := ClaimWebhookDelivery(webhookID, "delivery-4", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+		claimed, err := ClaimWebhookDelivery(webhookID, "delivery-4", workflowID, "node-1", 10*time.Millisecond)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("old deliveries are deleted", func(t *testing.T) {
+		_, err := ClaimWebhookDelivery(webhookID, "delivery-5", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+
+		require.NoError(t, DeleteWebhookDeliveriesBefore(time.Now().Add(time.Minute)))
+
+		claimed, err := ClaimWebhookDelivery(webhookID, "delivery-5", workflowID, "node-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+}
@@ -0,0 +1,133 @@
+// Package deliveries keeps track of webhook deliveries already handled by each node,
+// so retried deliveries do not run the same node twice.
+package deliveries
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// GitHub sends a unique ID for each delivery, kept when a delivery is retried.
+const GitHubDeliveryHeader = "X-GitHub-Delivery"
+
+// How long a delivery is remembered. GitHub only retries deliveries for a short time.
+const DefaultTTL = time.Hour
+
+// Key identifies a delivery handled by a node.
+type Key struct {
+	WebhookID  uuid.UUID
+	DeliveryID string
+	WorkflowID uuid.UUID
+	NodeID     string
+}
+
+// Store records the deliveries handled by each node.
+type Store interface {
+
+	// Claim records the delivery for the node, and returns false if it was already recorded.
+	Claim(key Key) (bool, error)
+
+	// Release removes the delivery for the node, so it can be handled again.
+	// Used when the node fails to handle the delivery, so the retry is not dropped.
+	Release(key Key) error
+}
+
+// DatabaseStore is a Store backed by the database,
+// so deliveries are recorded across all instances.
+// Records older than the TTL are deleted, at most once per TTL.
+type DatabaseStore struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	lastPurge time.Time
+}
+
+func NewDatabaseStore(ttl time.Duration) *DatabaseStore {
+	return &DatabaseStore{ttl: ttl}
+}
+
+func (s *DatabaseStore) Claim(key Key) (bool, error) {
+	s.purge(time.Now())
+	return models.ClaimWebhookDelivery(key.WebhookID, key.DeliveryID, key.WorkflowID, key.NodeID, s.ttl)
+}
+
+func (s *DatabaseStore) Release(key Key) error {
+	return models.ReleaseWebhookDelivery(key.WebhookID, key.DeliveryID, key.WorkflowID, key.NodeID)
+}
+
+func (s *DatabaseStore) purge(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastPurge) < s.ttl {
+		return
+	}
+
+	err := models.DeleteWebhookDeliveriesBefore(now.Add(-s.ttl))
+	if err != nil {
+		log.Warnf("Error deleting expired webhook deliveries: %v", err)
+		return
+	}
+
+	s.lastPurge = now
+}
+
+// InMemoryStore is a Store that keeps deliveries in memory,
+// removing them once their TTL is over.
+// It only works within a single process.
+type InMemoryStore struct {
+	ttl        time.Duration
+	mu         sync.Mutex
+	deliveries map[Key]time.Time
+	lastPurge  time.Time
+}
+
+func NewInMemoryStore(ttl time.Duration) *InMemoryStore {
+	return &InMemoryStore{
+		ttl:        ttl,
+		deliveries: map[Key]time.Time{},
+		lastPurge:  time.Now(),
+	}
+}
+
+func (s *InMemoryStore) Claim(key Key) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.purge(now)
+
+	expiresAt, ok := s.deliveries[key]
+	if ok && now.Before(expiresAt) {
+		return false, nil
+	}
+
+	s.deliveries[key] = now.Add(s.ttl)
+	return true, nil
+}
+
+func (s *InMemoryStore) Release(key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.deliveries, key)
+	return nil
+}
+
+// purge removes expired deliveries, at most once per TTL.
+func (s *InMemoryStore) purge(now time.Time) {
+	if now.Sub(s.lastPurge) < s.ttl {
+		return
+	}
+
+	for key, expiresAt := range s.deliveries {
+		if !now.Before(expiresAt) {
+			delete(s.deliveries, key)
+		}
+	}
+
+	s.lastPurge = now
+}
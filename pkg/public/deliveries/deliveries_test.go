@@ -0,0 +1,80 @@
+package deliveries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__InMemoryStore(t *testing.T) {
+	webhookID := uuid.New()
+	workflowID := uuid.New()
+	delivery1 := Key{WebhookID: webhookID, DeliveryID: "delivery-1", WorkflowID: workflowID, NodeID: "node-1"}
+	delivery2 := Key{WebhookID: webhookID, DeliveryID: "delivery-2", WorkflowID: workflowID, NodeID: "node-1"}
+
+	t.Run("delivery can only be claimed once", func(t *testing.T) {
+		store := NewInMemoryStore(time.Minute)
+
+		claimed, err := store.Claim(delivery1)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = store.Claim(delivery1)
+		require.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = store.Claim(delivery2)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("released delivery can be claimed again", func(t *testing.T) {
+		store := NewInMemoryStore(time.Minute)
+
+		claimed, err := store.Claim(delivery1)
+		require.NoError(t, err)
+		require.True(t, claimed)
+
+		require.NoError(t, store.Release(delivery1))
+
+		claimed, err = store.Claim(delivery1)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("expired delivery can be claimed again and is purged", func(t *testing.T) {
+		store := NewInMemoryStore(10 * time.Millisecond)
+
+		claimed, err := store.Claim(delivery1)
+		require.NoError(t, err)
+		require.True(t, claimed)
+
+		time.Sleep(20 * time.Millisecond)
+
+		claimed, err = store.Claim(delivery2)
+		require.NoError(t, err)
+		require.True(t, claimed)
+		assert.NotContains(t, store.deliveries, delivery1)
+
+		claimed, err = store.Claim(delivery1)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("delivery is claimed per node", func(t *testing.T) {
+		store := NewInMemoryStore(time.Minute)
+
+		claimed, err := store.Claim(delivery1)
+		require.NoError(t, err)
+		require.True(t, claimed)
+
+		otherNode := delivery1
+		otherNode.NodeID = "node-2"
+		claimed, err = store.Claim(otherNode)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+	})
+}
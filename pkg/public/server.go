@@ -27,6 +27,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	nooptrace "go.opentelemetry.io/otel/trace/noop"
@@ -46,12 +47,14 @@ import (
 	pbTriggers "github.com/superplanehq/superplane/pkg/protos/triggers"
 	pbUsers "github.com/superplanehq/superplane/pkg/protos/users"
 	pbWidgets "github.com/superplanehq/superplane/pkg/protos/widgets"
+	"github.com/superplanehq/superplane/pkg/public/deliveries"
 	"github.com/superplanehq/superplane/pkg/public/middleware"
 	"github.com/superplanehq/superplane/pkg/public/ws"
 	"github.com/superplanehq/superplane/pkg/web"
 	"github.com/superplanehq/superplane/pkg/web/assets"
 	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
 )
 
 const (
@@ -78,6 +81,7 @@ type Server struct {
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
 	isDev                 bool
+	deliveries            deliveries.Store
 }
 
 // WebsocketHub returns the websocket hub for this server
@@ -119,6 +123,7 @@ func NewServer(
 		oidcProvider:          oidcProvider,
 		registry:              registry,
 		authService:           authorizationService,
+		deliveries:            deliveries.NewDatabaseStore(deliveries.DefaultTTL),
 		upgrader: &websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
 				// Allow all connections - you may want to restrict this in production
@@ -762,9 +767,20 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	deliveryID := r.Header.Get(deliveries.GitHubDeliveryHeader)
 	for _, node := range nodes {
+		deliveryKey, claimed := s.claimWebhookDelivery(r, webhookID, deliveryID, node)
+		if !claimed {
+			continue
+		}
+
+		//
+		// Only the node that failed is released, so the retry
+		// does not run again the nodes that already handled the delivery.
+		//
 		code, err := s.executeWebhookNode(r.Context(), body, r.Header, node)
 		if err != nil {
+			s.releaseWebhookDelivery(deliveryKey)
 			http.Error(w, fmt.Sprintf("error handling webhook: %v", err), code)
 			return
 		}
@@ -773,6 +789,49 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+/*
+ * GitHub retries deliveries with the same delivery ID,
+ * so we skip the nodes that already handled it.
+ * If the delivery store is not available, the delivery is handled anyway.
+ */
+func (s *Server) claimWebhookDelivery(r *http.Request, webhookID uuid.UUID, deliveryID string, node models.CanvasNode) (*deliveries.Key, bool) {
+	if deliveryID == "" {
+		return nil, true
+	}
+
+	key := deliveries.Key{
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+		WorkflowID: node.WorkflowID,
+		NodeID:     node.NodeID,
+	}
+
+	claimed, err := s.deliveries.Claim(key)
+	if err != nil {
+		log.Warnf("Error checking delivery %s for webhook %s: %v", deliveryID, webhookID, err)
+		return nil, true
+	}
+
+	if !claimed {
+		log.Infof("Delivery %s for webhook %s already handled by node %s - skipping", deliveryID, webhookID, node.NodeID)
+		telemetry.RecordWebhookDuplicateDelivery(r.Context())
+		return nil, false
+	}
+
+	return &key, true
+}
+
+func (s *Server) releaseWebhookDelivery(key *deliveries.Key) {
+	if key == nil {
+		return
+	}
+
+	err := s.deliveries.Release(*key)
+	if err != nil {
+		log.Warnf("Error releasing delivery %s for webhook %s: %v", key.DeliveryID, key.WebhookID, err)
+	}
+}
+
 func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
 	if node.Type == models.NodeTypeTrigger {
 		return s.executeTriggerNode(ctx, body, headers, node)
@@ -789,6 +848,11 @@ func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers ht
 	}
 
 	tx := database.Conn()
+	integration, err := s.webhookIntegrationContext(tx, &node)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
 	return trigger.HandleWebhook(core.WebhookRequestContext{
 		Body:          body,
 		Headers:       headers,
@@ -797,9 +861,23 @@ func (s *Server) executeTriggerNode(ctx context.Context, body []byte, headers ht
 		Configuration: node.Configuration.Data(),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, s.encryptor, &node, s.BaseURL+s.BasePath),
 		Events:        contexts.NewEventContext(tx, &node),
+		Integration:   integration,
 	})
 }
 
+func (s *Server) webhookIntegrationContext(tx *gorm.DB, node *models.CanvasNode) (core.IntegrationContext, error) {
+	if node.AppInstallationID == nil {
+		return nil, nil
+	}
+
+	integration, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+	if err != nil {
+		return nil, fmt.Errorf("integration not found: %w", err)
+	}
+
+	return contexts.NewIntegrationContext(tx, node, integration, s.encryptor, s.registry), nil
+}
+
 func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
 	ref := node.Ref.Data()
 	component, err := s.registry.GetComponent(ref.Component.Name)
@@ -808,6 +886,11 @@ func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers
 	}
 
 	tx := database.Conn()
+	integration, err := s.webhookIntegrationContext(tx, &node)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
 	return component.HandleWebhook(core.WebhookRequestContext{
 		Body:          body,
 		Headers:       headers,
@@ -816,6 +899,7 @@ func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers
 		Configuration: node.Configuration.Data(),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, s.encryptor, &node, s.BaseURL+s.BasePath),
 		Events:        contexts.NewEventContext(tx, &node),
+		Integration:   integration,
 		FindExecutionByKV: func(key string, value string) (*core.ExecutionContext, error) {
 			execution, err := models.FirstNodeExecutionByKVInTransaction(tx, node.WorkflowID, node.NodeID, key, value)
 			if err != nil {
@@ -54,7 +54,11 @@ func (s *PanicableComponent) ExampleOutput() map[string]any {
 }
 
 func (s *PanicableComponent) Configuration() []configuration.Field {
-	return s.underlying.Configuration()
+	return core.WithRunIf(s.underlying.Configuration())
+}
+
+func (s *PanicableComponent) Inputs() []core.InputField {
+	return core.ComponentInputs(s.underlying)
 }
 
 func (s *PanicableComponent) Actions() []core.Action {
@@ -62,7 +66,7 @@ func (s *PanicableComponent) Actions() []core.Action {
 }
 
 func (s *PanicableComponent) OutputChannels(config any) []core.OutputChannel {
-	return s.underlying.OutputChannels(config)
+	return core.WithSkippedChannel(s.underlying.OutputChannels(config))
 }
 
 /*
@@ -135,3 +135,31 @@ func TestPanicableComponent_Cleanup_CatchesPanic(t *testing.T) {
 	assert.Contains(t, err.Error(), "panicking-comp panicked in Cleanup()")
 	assert.Contains(t, err.Error(), "cleanup panic")
 }
+
+// componentWithInputs is a component that declares its inputs
+type componentWithInputs struct {
+	panickingComponent
+}
+
+func (c *componentWithInputs) Inputs() []core.InputField {
+	return []core.InputField{{Name: "issueNumber", Required: true}}
+}
+
+func TestPanicableComponent_Inputs(t *testing.T) {
+	t.Run("component without inputs -> no inputs", func(t *testing.T) {
+		component := NewPanicableComponent(&panickingComponent{name: "test"})
+		assert.Empty(t, core.ComponentInputs(component))
+	})
+
+	t.Run("component with inputs -> inputs are returned", func(t *testing.T) {
+		component := NewPanicableComponent(&componentWithInputs{panickingComponent{name: "test"}})
+		assert.Equal(t, []core.InputField{{Name: "issueNumber", Required: true}}, core.ComponentInputs(component))
+	})
+}
+
+func TestPanicableComponent_RunIf(t *testing.T) {
+	component := NewPanicableComponent(&panickingComponent{name: "test"})
+
+	assert.True(t, core.SupportsRunIf(component.Configuration()))
+	assert.Equal(t, []core.OutputChannel{core.SkippedOutputChannel}, component.OutputChannels(nil))
+}
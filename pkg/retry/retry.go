@@ -38,3 +38,28 @@ func WithConstantWait(f func() error, options Options) error {
 		time.Sleep(options.Wait)
 	}
 }
+
+// WithExponentialBackoff tries to execute the task and if it fails,
+// awaits before retrying maxAttempts times, doubling the wait after each attempt.
+func WithExponentialBackoff(f func() error, options Options) error {
+	wait := options.Wait
+	for attempt := 1; ; attempt++ {
+		time.Sleep(options.InitialDelay)
+
+		err := f()
+		if err == nil {
+			return nil
+		}
+
+		if attempt > options.MaxAttempts {
+			return fmt.Errorf("[%s] failed after [%d] attempts - giving up: %v", options.Task, attempt, err)
+		}
+
+		if options.Verbose {
+			log.Infof("[%s] attempt [%d] failed with [%v] - retrying in %s", options.Task, attempt, err, wait)
+		}
+
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
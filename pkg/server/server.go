@@ -37,15 +37,17 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/integrations/datadog"
 	_ "github.com/superplanehq/superplane/pkg/integrations/daytona"
 	_ "github.com/superplanehq/superplane/pkg/integrations/discord"
+	_ "github.com/superplanehq/superplane/pkg/integrations/email"
 	_ "github.com/superplanehq/superplane/pkg/integrations/github"
 	_ "github.com/superplanehq/superplane/pkg/integrations/jira"
+	_ "github.com/superplanehq/superplane/pkg/integrations/msteams"
 	_ "github.com/superplanehq/superplane/pkg/integrations/openai"
+	_ "github.com/superplanehq/superplane/pkg/integrations/opsgenie"
 	_ "github.com/superplanehq/superplane/pkg/integrations/pagerduty"
 	_ "github.com/superplanehq/superplane/pkg/integrations/rootly"
 	_ "github.com/superplanehq/superplane/pkg/integrations/semaphore"
 	_ "github.com/superplanehq/superplane/pkg/integrations/sendgrid"
 	_ "github.com/superplanehq/superplane/pkg/integrations/slack"
-	_ "github.com/superplanehq/superplane/pkg/integrations/smtp"
 	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
 	_ "github.com/superplanehq/superplane/pkg/triggers/start"
 	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
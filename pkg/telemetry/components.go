@@ -0,0 +1,100 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+/*
+ * ComponentMetrics are recorded in the shared execution path, for every component.
+ * Metrics are created on the meter provider they are given, so tests can read them
+ * from their own provider instead of the global one.
+ *
+ * Exported to Prometheus, the names become
+ * component_executions_total, component_execution_duration_seconds and github_api_requests_total.
+ */
+type ComponentMetrics struct {
+	executions        metric.Int64Counter
+	executionDuration metric.Float64Histogram
+	githubRequests    metric.Int64Counter
+}
+
+var componentMetrics atomic.Pointer[ComponentMetrics]
+
+func NewComponentMetrics(provider metric.MeterProvider) (*ComponentMetrics, error) {
+	meter := provider.Meter("superplane")
+
+	executions, err := meter.Int64Counter(
+		"component.executions",
+		metric.WithDescription("Number of component executions, by component and outcome"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	executionDuration, err := meter.Float64Histogram(
+		"component.execution.duration",
+		metric.WithDescription("Duration of component executions, by component and outcome"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	githubRequests, err := meter.Int64Counter(
+		"github.api.requests",
+		metric.WithDescription("Number of GitHub API requests, by endpoint and response status"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComponentMetrics{
+		executions:        executions,
+		executionDuration: executionDuration,
+		githubRequests:    githubRequests,
+	}, nil
+}
+
+/*
+ * Sets the metrics recorded by RecordComponentExecution and RecordGitHubAPIRequest.
+ * Until it is called - or after it is called with nil - nothing is recorded.
+ */
+func SetComponentMetrics(metrics *ComponentMetrics) {
+	componentMetrics.Store(metrics)
+}
+
+func (m *ComponentMetrics) RecordExecution(ctx context.Context, component, outcome string, d time.Duration) {
+	attributes := metric.WithAttributes(
+		attribute.String("component", component),
+		attribute.String("outcome", outcome),
+	)
+
+	m.executions.Add(ctx, 1, attributes)
+	m.executionDuration.Record(ctx, d.Seconds(), attributes)
+}
+
+func (m *ComponentMetrics) RecordGitHubAPIRequest(ctx context.Context, endpoint, status string) {
+	m.githubRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("endpoint", endpoint),
+		attribute.String("status", status),
+	))
+}
+
+func RecordComponentExecution(ctx context.Context, component, outcome string, d time.Duration) {
+	if metrics := componentMetrics.Load(); metrics != nil {
+		metrics.RecordExecution(ctx, component, outcome, d)
+	}
+}
+
+func RecordGitHubAPIRequest(ctx context.Context, endpoint, status string) {
+	if metrics := componentMetrics.Load(); metrics != nil {
+		metrics.RecordGitHubAPIRequest(ctx, endpoint, status)
+	}
+}
@@ -0,0 +1,96 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func collectMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Aggregation {
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+
+	return nil
+}
+
+func Test__ComponentMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	metrics, err := NewComponentMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	require.NoError(t, err)
+
+	t.Run("executions are counted by component and outcome", func(t *testing.T) {
+		metrics.RecordExecution(context.Background(), "github.createIssueComment", ExecutionOutcomeOK, time.Second)
+		metrics.RecordExecution(context.Background(), "github.createIssueComment", ExecutionOutcomeOK, 2*time.Second)
+		metrics.RecordExecution(context.Background(), "github.createIssueComment", ExecutionOutcomeError, time.Second)
+
+		executions := collectMetric(t, reader, "component.executions").(metricdata.Sum[int64])
+		require.Len(t, executions.DataPoints, 2)
+		for _, point := range executions.DataPoints {
+			outcome, _ := point.Attributes.Value("outcome")
+			component, _ := point.Attributes.Value("component")
+			assert.Equal(t, "github.createIssueComment", component.AsString())
+			if outcome.AsString() == ExecutionOutcomeOK {
+				assert.Equal(t, int64(2), point.Value)
+			} else {
+				assert.Equal(t, int64(1), point.Value)
+			}
+		}
+
+		durations := collectMetric(t, reader, "component.execution.duration").(metricdata.Histogram[float64])
+		require.Len(t, durations.DataPoints, 2)
+		set := attribute.NewSet(
+			attribute.String("component", "github.createIssueComment"),
+			attribute.String("outcome", ExecutionOutcomeOK),
+		)
+
+		for _, point := range durations.DataPoints {
+			if point.Attributes.Equals(&set) {
+				assert.Equal(t, uint64(2), point.Count)
+				assert.Equal(t, 3.0, point.Sum)
+			}
+		}
+	})
+
+	t.Run("GitHub API requests are counted by endpoint and status", func(t *testing.T) {
+		metrics.RecordGitHubAPIRequest(context.Background(), "issues.createComment", "201")
+
+		requests := collectMetric(t, reader, "github.api.requests").(metricdata.Sum[int64])
+		require.Len(t, requests.DataPoints, 1)
+		assert.Equal(t, int64(1), requests.DataPoints[0].Value)
+		assert.Equal(t, attribute.NewSet(
+			attribute.String("endpoint", "issues.createComment"),
+			attribute.String("status", "201"),
+		), requests.DataPoints[0].Attributes)
+	})
+
+	t.Run("nothing is recorded without metrics", func(t *testing.T) {
+		SetComponentMetrics(nil)
+		RecordComponentExecution(context.Background(), "noop", ExecutionOutcomeOK, time.Second)
+		RecordGitHubAPIRequest(context.Background(), "issues.createComment", "201")
+	})
+
+	t.Run("recorded on the metrics that were set", func(t *testing.T) {
+		SetComponentMetrics(metrics)
+		defer SetComponentMetrics(nil)
+
+		RecordGitHubAPIRequest(context.Background(), "issues.createComment", "201")
+
+		requests := collectMetric(t, reader, "github.api.requests").(metricdata.Sum[int64])
+		require.Len(t, requests.DataPoints, 1)
+		assert.Equal(t, int64(2), requests.DataPoints[0].Value)
+	})
+}
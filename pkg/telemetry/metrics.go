@@ -34,6 +34,9 @@ var (
 
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
+
+	webhookDuplicateDeliveriesCounter metric.Int64Counter
+	concurrencyLockContentionCounter  metric.Int64Counter
 )
 
 func InitMetrics(ctx context.Context) error {
@@ -159,6 +162,24 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	webhookDuplicateDeliveriesCounter, err = meter.Int64Counter(
+		"webhooks.deliveries.duplicate",
+		metric.WithDescription("Number of webhook deliveries skipped because they were already processed"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	concurrencyLockContentionCounter, err = meter.Int64Counter(
+		"concurrency_locks.contention",
+		metric.WithDescription("Number of executions rescheduled because their concurrency lock was held"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
 	queueWorkerStuckItems, err = meter.Int64Histogram(
 		"queue_items.stuck.count",
 		metric.WithDescription("Number of stuck workflow node queue items"),
@@ -168,6 +189,12 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	components, err := NewComponentMetrics(provider)
+	if err != nil {
+		return err
+	}
+
+	SetComponentMetrics(components)
 	StartPeriodicMetricsReporter()
 
 	metricsReady.Store(true)
@@ -283,3 +310,19 @@ func RecordDBLongQueriesCount(ctx context.Context, count int64) {
 
 	dbLongQueriesCountHistogram.Record(ctx, count)
 }
+
+func RecordWebhookDuplicateDelivery(ctx context.Context) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	webhookDuplicateDeliveriesCounter.Add(ctx, 1)
+}
+
+func RecordConcurrencyLockContention(ctx context.Context) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	concurrencyLockContentionCounter.Add(ctx, 1)
+}
@@ -0,0 +1,71 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const TracerName = "superplane"
+
+/*
+ * Spans are created with the global OpenTelemetry tracer provider,
+ * which is a no-op until one is registered with otel.SetTracerProvider.
+ * Tests - and deployments that export traces some other way - can inject their own with SetTracerProvider.
+ */
+var tracerProvider trace.TracerProvider
+
+func SetTracerProvider(provider trace.TracerProvider) {
+	tracerProvider = provider
+}
+
+func Tracer() trace.Tracer {
+	if tracerProvider == nil {
+		return otel.GetTracerProvider().Tracer(TracerName)
+	}
+
+	return tracerProvider.Tracer(TracerName)
+}
+
+const (
+	ExecutionOutcomeOK      = "ok"
+	ExecutionOutcomeError   = "error"
+	ExecutionOutcomeSkipped = "skipped"
+)
+
+/*
+ * Starts the span around the execution of a component.
+ * The repository is only tagged for components configured with one, like the GitHub ones.
+ */
+func StartExecutionSpan(ctx context.Context, component, workflowID, nodeID, executionID string, configuration any) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("superplane.component", component),
+		attribute.String("superplane.workflow_id", workflowID),
+		attribute.String("superplane.node_id", nodeID),
+		attribute.String("superplane.execution_id", executionID),
+	}
+
+	if config, ok := configuration.(map[string]any); ok {
+		if repository, ok := config["repository"].(string); ok && repository != "" {
+			attributes = append(attributes, attribute.String("superplane.repository", repository))
+		}
+	}
+
+	return Tracer().Start(ctx, "component.execute "+component,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+func EndExecutionSpan(span trace.Span, outcome string, err error) {
+	span.SetAttributes(attribute.String("superplane.outcome", outcome))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,55 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test__ExecutionSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer SetTracerProvider(nil)
+
+	t.Run("successful execution", func(t *testing.T) {
+		_, span := StartExecutionSpan(context.Background(), "github.createIssue", "w-1", "n-1", "e-1", map[string]any{"repository": "hello"})
+		EndExecutionSpan(span, ExecutionOutcomeOK, nil)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "component.execute github.createIssue", spans[0].Name())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+		assert.Contains(t, spans[0].Attributes(), attribute.String("superplane.component", "github.createIssue"))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("superplane.repository", "hello"))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("superplane.outcome", ExecutionOutcomeOK))
+	})
+
+	t.Run("failed execution", func(t *testing.T) {
+		_, span := StartExecutionSpan(context.Background(), "noop", "w-1", "n-1", "e-2", map[string]any{})
+		EndExecutionSpan(span, ExecutionOutcomeError, errors.New("boom"))
+
+		spans := recorder.Ended()
+		span2 := spans[len(spans)-1]
+		assert.Equal(t, codes.Error, span2.Status().Code)
+		assert.Equal(t, "boom", span2.Status().Description)
+		assert.Contains(t, span2.Attributes(), attribute.String("superplane.outcome", ExecutionOutcomeError))
+		for _, kv := range span2.Attributes() {
+			assert.NotEqual(t, attribute.Key("superplane.repository"), kv.Key)
+		}
+	})
+
+	t.Run("no tracer provider -> no-op spans", func(t *testing.T) {
+		SetTracerProvider(nil)
+		ctx, span := StartExecutionSpan(context.Background(), "noop", "w-1", "n-1", "e-3", nil)
+		EndExecutionSpan(span, ExecutionOutcomeOK, nil)
+		assert.NotNil(t, ctx)
+		assert.False(t, span.SpanContext().IsValid())
+	})
+}
@@ -21,6 +21,10 @@ func NewEventContext(tx *gorm.DB, node *models.CanvasNode) *EventContext {
 }
 
 func (s *EventContext) Emit(payloadType string, payload any) error {
+	return s.EmitOnChannel("default", payloadType, payload)
+}
+
+func (s *EventContext) EmitOnChannel(channel, payloadType string, payload any) error {
 	var v any
 
 	structuredPayload := map[string]any{
@@ -38,7 +42,7 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 	event := models.CanvasEvent{
 		WorkflowID: s.node.WorkflowID,
 		NodeID:     s.node.NodeID,
-		Channel:    "default",
+		Channel:    channel,
 		Data:       datatypes.NewJSONType(v),
 		State:      models.CanvasEventStatePending,
 		CreatedAt:  &now,
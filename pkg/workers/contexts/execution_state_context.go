@@ -3,6 +3,7 @@ package contexts
 import (
 	"time"
 
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
@@ -30,6 +31,24 @@ func (s *ExecutionStateContext) Pass() error {
 }
 
 func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	_, err := s.execution.PassInTransaction(s.tx, outputs(channel, payloadType, payloads))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ExecutionStateContext) EmitPartial(channel, payloadType string, payloads []any) error {
+	_, err := s.execution.CreateOutputsInTransaction(s.tx, outputs(channel, payloadType, payloads))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func outputs(channel, payloadType string, payloads []any) map[string][]any {
 	outputs := map[string][]any{
 		channel: {},
 	}
@@ -42,12 +61,11 @@ func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 		})
 	}
 
-	_, err := s.execution.PassInTransaction(s.tx, outputs)
-	if err != nil {
-		return err
-	}
+	return outputs
+}
 
-	return nil
+func (s *ExecutionStateContext) EmitError(channel string, err error) error {
+	return s.Emit(channel, core.ErrorPayloadType, []any{core.NewErrorEvent(err)})
 }
 
 func (s *ExecutionStateContext) Fail(reason, message string) error {
@@ -0,0 +1,49 @@
+package contexts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * IdempotencyContext does not receive the transaction of the execution on purpose.
+ * Its records must be committed before the side effect they protect happens,
+ * so they are not lost if the transaction of the execution is rolled back.
+ */
+type IdempotencyContext struct {
+	execution *models.CanvasNodeExecution
+}
+
+func NewIdempotencyContext(execution *models.CanvasNodeExecution) *IdempotencyContext {
+	return &IdempotencyContext{execution: execution}
+}
+
+func (c *IdempotencyContext) Claim(key string) (*core.IdempotencyClaim, error) {
+	record, existing, err := models.ClaimNodeExecutionIdempotencyKey(c.execution.WorkflowID, c.execution.NodeID, c.execution.ID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	claim := &core.IdempotencyClaim{Existing: existing}
+	if len(record.Result) == 0 {
+		return claim, nil
+	}
+
+	if err := json.Unmarshal(record.Result, &claim.Result); err != nil {
+		return nil, fmt.Errorf("failed to decode recorded result: %w", err)
+	}
+
+	return claim, nil
+}
+
+func (c *IdempotencyContext) Complete(key string, result any) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+
+	return models.CompleteNodeExecutionIdempotencyKey(c.execution.ID, key, data)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/expr-lang/expr/parser"
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/integrations/shared/template"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
@@ -334,6 +335,8 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 		}),
 	}
 
+	exprOptions = append(exprOptions, template.GitHubExprFunctions()...)
+
 	vm, err := expr.Compile(expression, exprOptions...)
 	if err != nil {
 		return "", err
@@ -133,6 +133,63 @@ func Test_NodeConfigurationBuilder_WorkflowLevelNode_RootFunction(t *testing.T)
 	assert.Equal(t, "42", result["count"])
 }
 
+func Test_NodeConfigurationBuilder_GitHubFunctions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Name:   triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNode,
+				Name:   componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	rootEventData := map[string]any{
+		"after":      "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"repository": map[string]any{"full_name": "testhq/hello"},
+		"number":     42,
+		"sender":     map[string]any{"login": "octocat"},
+	}
+	rootEvent := support.EmitCanvasEventForNodeWithData(t, canvas.ID, triggerNode, "default", nil, rootEventData)
+
+	builder := NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+		WithRootEvent(&rootEvent.ID).
+		WithInput(map[string]any{triggerNode: rootEventData})
+
+	configuration := map[string]any{
+		"sha":    "{{ shortSha(root().after) }}",
+		"url":    "{{ prUrl(root().repository.full_name, root().number) }}",
+		"author": "{{ mentionUser(root().sender.login) }}",
+	}
+
+	result, err := builder.Build(configuration)
+	require.NoError(t, err)
+	assert.Equal(t, "6dcb09b", result["sha"])
+	assert.Equal(t, "https://github.com/testhq/hello/pull/42", result["url"])
+	assert.Equal(t, "@octocat", result["author"])
+
+	_, err = builder.Build(map[string]any{"sha": "{{ shortSha(root().number) }}"})
+	require.ErrorContains(t, err, "shortSha() takes a string")
+}
+
 func Test_NodeConfigurationBuilder_WorkflowLevelNode_Root_ByName(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -73,6 +73,8 @@ func BuildProcessQueueContext(httpClient *http.Client, tx *gorm.DB, node *models
 		EventID:       event.ID.String(),
 		SourceNodeID:  event.NodeID,
 		Input:         event.Data.Data(),
+
+		RescheduleAttempts: queueItem.RescheduleAttempts,
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
 		builder := NewNodeConfigurationBuilder(tx, queueItem.WorkflowID).
@@ -135,6 +137,10 @@ func BuildProcessQueueContext(httpClient *http.Client, tx *gorm.DB, node *models
 		return queueItem.Delete(tx)
 	}
 
+	ctx.RescheduleAfter = func(interval time.Duration) error {
+		return queueItem.Reschedule(tx, time.Now().Add(interval))
+	}
+
 	ctx.UpdateNodeState = func(state string) error {
 		return node.UpdateState(tx, state)
 	}
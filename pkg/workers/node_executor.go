@@ -290,6 +290,7 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Idempotency:    contexts.NewIdempotencyContext(execution),
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
 		builder := contexts.NewNodeConfigurationBuilder(tx, execution.WorkflowID).
@@ -318,13 +319,68 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry)
 	}
 
+	//
+	// Components log through ctx.Logger, so every log line
+	// is tagged with the node, execution, and component.
+	// Sensitive configuration values are never logged.
+	//
+	logger = logger.WithField("component", ref.Component.Name)
+	logger.WithField("configuration", configuration.Redact(component.Configuration(), execution.Configuration.Data())).
+		Debug("Executing component")
+
 	ctx.Logger = logger
+
+	spanCtx, span := telemetry.StartExecutionSpan(
+		context.Background(),
+		ref.Component.Name,
+		execution.WorkflowID.String(),
+		execution.NodeID,
+		execution.ID.String(),
+		ctx.Configuration,
+	)
+
+	ctx.Context = spanCtx
+
+	//
+	// Every execution ends its span and records its outcome and duration,
+	// whether it is skipped, fails, or succeeds.
+	//
+	startedAt := time.Now()
+	endExecution := func(outcome string, err error) {
+		telemetry.EndExecutionSpan(span, outcome, err)
+		telemetry.RecordComponentExecution(context.Background(), ref.Component.Name, outcome, time.Since(startedAt))
+	}
+
+	//
+	// Components that support run if conditions are not executed
+	// when their condition is falsy - a skipped event is emitted instead.
+	// Invalid expressions already fail the execution when the configuration is built.
+	//
+	if core.SupportsRunIf(component.Configuration()) && !core.ShouldRun(ctx.Configuration) {
+		logger.Info("Run if condition is falsy - skipping execution")
+		err := ctx.ExecutionState.Emit(
+			core.SkippedOutputChannel.Name,
+			core.SkippedPayloadType,
+			[]any{map[string]any{core.RunIfFieldName: execution.Configuration.Data()[core.RunIfFieldName]}},
+		)
+
+		if err != nil {
+			endExecution(telemetry.ExecutionOutcomeError, err)
+			return fmt.Errorf("failed to skip execution: %w", err)
+		}
+
+		endExecution(telemetry.ExecutionOutcomeSkipped, nil)
+		return tx.Save(execution).Error
+	}
+
 	if err := component.Execute(ctx); err != nil {
+		endExecution(telemetry.ExecutionOutcomeError, err)
 		logger.Errorf("failed to execute component: %v", err)
 		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
 		return err
 	}
 
+	endExecution(telemetry.ExecutionOutcomeOK, nil)
 	logger.Info("Component executed successfully")
 
 	return tx.Save(execution).Error
@@ -126,6 +126,15 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 	}
 
 	logger = logging.WithQueueItem(logger, *queueItem)
+
+	//
+	// The item at the head of the queue was rescheduled by its component,
+	// so the items after it wait too, to keep the queue order.
+	//
+	if !queueItem.IsDue(time.Now()) {
+		return nil, nil, nil
+	}
+
 	logger.Info("Processing queue item")
 
 	configFields, err := w.configurationFieldsForNode(tx, node)
@@ -162,6 +171,18 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		return executions, queueItem, nil
 	}
 
+	rescheduled := false
+	reschedule := ctx.RescheduleAfter
+	ctx.RescheduleAfter = func(interval time.Duration) error {
+		if err := reschedule(interval); err != nil {
+			return err
+		}
+
+		rescheduled = true
+		logger.Infof("Queue item rescheduled in %s - attempt %d", interval, ctx.RescheduleAttempts+1)
+		return nil
+	}
+
 	var executionID *uuid.UUID
 	switch node.Type {
 	case models.NodeTypeComponent:
@@ -170,6 +191,13 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		 * the processing.
 		 */
 		executionID, err = w.processComponentNode(ctx, node)
+
+		//
+		// The item is still in the queue, so it is not reported as consumed.
+		//
+		if err == nil && rescheduled {
+			return nil, nil, nil
+		}
 	case models.NodeTypeBlueprint:
 		/*
 		 * For blueprint nodes, use the default processing logic.
@@ -8,7 +8,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/components/noop"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -805,3 +807,100 @@ func Test__WorkflowNodeQueueWorker_ConfigurationBuildFailure_PropagateToParent(t
 	assert.Equal(t, models.CanvasNodeExecutionResultFailed, updatedParent.Result)
 	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, updatedParent.ResultReason)
 }
+
+type reschedulingComponent struct {
+	noop.NoOp
+	reschedules int
+	attempts    []int
+}
+
+func (c *reschedulingComponent) Name() string {
+	return "test-reschedule"
+}
+
+func (c *reschedulingComponent) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	c.attempts = append(c.attempts, ctx.RescheduleAttempts)
+	if ctx.RescheduleAttempts < c.reschedules {
+		return nil, ctx.RescheduleAfter(time.Hour)
+	}
+
+	return ctx.DefaultProcessing()
+}
+
+func Test__NodeQueueWorker_RescheduledQueueItem(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	worker := NewNodeQueueWorker(r.Registry)
+	logger := log.NewEntry(log.New())
+
+	component := &reschedulingComponent{reschedules: 1}
+	r.Registry.Components[component.Name()] = component
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{NodeID: componentNode, Type: models.NodeTypeComponent, Ref: datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: component.Name()}})},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	support.CreateQueueItem(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+
+	//
+	// The component reschedules the item:
+	// it stays in the queue, with the attempt and the time it is due stored on it.
+	//
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+	assert.Equal(t, []int{0}, component.attempts)
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	assert.Equal(t, 1, queueItems[0].RescheduleAttempts)
+	require.NotNil(t, queueItems[0].RunAt)
+	assert.True(t, queueItems[0].RunAt.After(time.Now().Add(59*time.Minute)))
+
+	executions, err := models.ListNodeExecutions(canvas.ID, componentNode, nil, nil, 10, nil)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+
+	//
+	// Before it is due, the item is not processed, and the node is not listed as ready.
+	//
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+	assert.Equal(t, []int{0}, component.attempts)
+
+	nodes, err := models.ListCanvasNodesReady()
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	//
+	// Once it is due, the component gets the number of attempts so far.
+	//
+	require.NoError(t, database.Conn().
+		Model(&queueItems[0]).
+		Update("run_at", time.Now().Add(-time.Second)).
+		Error)
+
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+	assert.Equal(t, []int{0, 1}, component.attempts)
+
+	queueItems, err = models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+	require.NoError(t, err)
+	assert.Empty(t, queueItems)
+
+	executions, err = models.ListNodeExecutions(canvas.ID, componentNode, nil, nil, 10, nil)
+	require.NoError(t, err)
+	assert.Len(t, executions, 1)
+}
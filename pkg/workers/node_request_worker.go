@@ -174,6 +174,11 @@ func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.C
 		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
 	}
 
+	spec := request.Spec.Data()
+	if spec.InvokeAction != nil && spec.InvokeAction.ActionName == core.RetryExecutionAction {
+		return w.retryExecution(tx, request, execution)
+	}
+
 	if execution.ParentExecutionID == nil {
 		return w.invokeParentNodeComponentAction(tx, request, execution)
 	}
@@ -181,6 +186,23 @@ func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.C
 	return w.invokeChildNodeComponentAction(tx, request, execution)
 }
 
+func (w *NodeRequestWorker) retryExecution(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution) error {
+	//
+	// The execution might have been cancelled while waiting for the retry.
+	//
+	if execution.State != models.CanvasNodeExecutionStateStarted {
+		w.log("Execution %s is %s - not retrying", execution.ID, execution.State)
+		return request.Complete(tx)
+	}
+
+	err := execution.RetryInTransaction(tx)
+	if err != nil {
+		return fmt.Errorf("error retrying execution: %v", err)
+	}
+
+	return request.Complete(tx)
+}
+
 func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution) error {
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
 	if err != nil {
@@ -203,6 +225,11 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("action '%s' not found for component '%s'", actionName, component.Name())
 	}
 
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("canvas not found: %w", err)
+	}
+
 	logger := logging.ForExecution(execution, nil)
 	actionCtx := core.ActionContext{
 		Name:           actionName,
@@ -213,6 +240,7 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
+		Secrets:        contexts.NewSecretsContext(tx, canvas.OrganizationID, w.encryptor),
 	}
 
 	if node.AppInstallationID != nil {
@@ -277,6 +305,11 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("action '%s' not found for component '%s'", actionName, component.Name())
 	}
 
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("canvas not found: %w", err)
+	}
+
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  childNode.Configuration,
@@ -287,6 +320,7 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
+		Secrets:        contexts.NewSecretsContext(tx, canvas.OrganizationID, w.encryptor),
 	}
 
 	err = component.HandleAction(actionCtx)
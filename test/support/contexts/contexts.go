@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
 )
 
 type EventContext struct {
@@ -14,12 +15,17 @@ type EventContext struct {
 }
 
 type Payload struct {
-	Type string
-	Data any
+	Channel string
+	Type    string
+	Data    any
 }
 
 func (e *EventContext) Emit(payloadType string, payload any) error {
-	e.Payloads = append(e.Payloads, Payload{Type: payloadType, Data: payload})
+	return e.EmitOnChannel("default", payloadType, payload)
+}
+
+func (e *EventContext) EmitOnChannel(channel, payloadType string, payload any) error {
+	e.Payloads = append(e.Payloads, Payload{Channel: channel, Type: payloadType, Data: payload})
 	return nil
 }
 
@@ -44,6 +50,14 @@ func (w *WebhookContext) SetSecret(secret []byte) error {
 	return nil
 }
 
+/*
+ * Signs a recorded webhook payload with the webhook secret,
+ * so tests can replay it through HandleWebhook as if it was received live.
+ */
+func (w *WebhookContext) Sign(body []byte) string {
+	return crypto.Sign([]byte(w.Secret), body)
+}
+
 func (w *WebhookContext) Setup() (string, error) {
 	id := uuid.New()
 	return id.String(), nil
@@ -193,6 +207,7 @@ type ExecutionStateContext struct {
 	Channel        string
 	Type           string
 	Payloads       []any
+	Partial        []any
 	KVs            map[string]string
 }
 
@@ -212,8 +227,9 @@ func (c *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 	c.Channel = channel
 	c.Type = payloadType
 
-	// Wrap payloads like the real ExecutionStateContext does
-	wrappedPayloads := make([]any, 0, len(payloads))
+	// Wrap payloads like the real ExecutionStateContext does,
+	// after the ones emitted with EmitPartial()
+	wrappedPayloads := append([]any{}, c.Partial...)
 	for _, payload := range payloads {
 		wrappedPayloads = append(wrappedPayloads, map[string]any{
 			"type":      payloadType,
@@ -225,6 +241,28 @@ func (c *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 	return nil
 }
 
+/*
+ * Partial payloads are recorded in Partial,
+ * and included in Payloads when the execution is emitted.
+ */
+func (c *ExecutionStateContext) EmitPartial(channel, payloadType string, payloads []any) error {
+	c.Channel = channel
+	c.Type = payloadType
+	for _, payload := range payloads {
+		c.Partial = append(c.Partial, map[string]any{
+			"type":      payloadType,
+			"timestamp": time.Now(),
+			"data":      payload,
+		})
+	}
+
+	return nil
+}
+
+func (c *ExecutionStateContext) EmitError(channel string, err error) error {
+	return c.Emit(channel, core.ErrorPayloadType, []any{core.NewErrorEvent(err)})
+}
+
 func (c *ExecutionStateContext) Fail(reason, message string) error {
 	c.Finished = true
 	c.Passed = false
@@ -238,6 +276,38 @@ func (c *ExecutionStateContext) SetKV(key, value string) error {
 	return nil
 }
 
+/*
+ * Records are kept in memory, for as long as the context is,
+ * so tests can attempt the same execution again with it.
+ * A recorded key without a result maps to nil.
+ */
+type IdempotencyContext struct {
+	Records map[string]any
+}
+
+func (c *IdempotencyContext) Claim(key string) (*core.IdempotencyClaim, error) {
+	if c.Records == nil {
+		c.Records = map[string]any{}
+	}
+
+	result, ok := c.Records[key]
+	if ok {
+		return &core.IdempotencyClaim{Existing: true, Result: result}, nil
+	}
+
+	c.Records[key] = nil
+	return &core.IdempotencyClaim{}, nil
+}
+
+func (c *IdempotencyContext) Complete(key string, result any) error {
+	if _, ok := c.Records[key]; !ok {
+		return fmt.Errorf("key %s was not claimed", key)
+	}
+
+	c.Records[key] = result
+	return nil
+}
+
 type AuthContext struct {
 	User   *core.User
 	Users  map[string]*core.User
@@ -314,3 +384,16 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Responses = c.Responses[1:]
 	return response, nil
 }
+
+type SecretsContext struct {
+	Values map[string]map[string][]byte
+}
+
+func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
+	value, ok := c.Values[secretName][keyName]
+	if !ok {
+		return nil, core.ErrSecretKeyNotFound
+	}
+
+	return value, nil
+}
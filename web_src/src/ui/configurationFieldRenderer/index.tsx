@@ -366,6 +366,7 @@ export const ConfigurationFieldRenderer = ({
         return <AnyPredicateListFieldRenderer {...commonProps} />;
 
       case "object":
+      case "json":
         return <ObjectFieldRenderer {...commonProps} domainId={domainId} domainType={domainType} />;
 
       case "timezone":